@@ -73,6 +73,10 @@ func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc
 				functions = maps.Keys(driver.Rest.GetDef())
 			case *minderv1.DataSource_Structured:
 				functions = maps.Keys(driver.Structured.GetDef())
+			case *minderv1.DataSource_Graphql:
+				functions = maps.Keys(driver.Graphql.GetDef())
+			case *minderv1.DataSource_Sql:
+				functions = maps.Keys(driver.Sql.GetDef())
 			}
 			t.AddRow(ds.Name, ds.GetDriverType(), strings.Join(slices.Sorted(functions), "\n"))
 		}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exemption provides the CLI subcommands for managing rule exemptions
+package exemption
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const dateLayout = "2006-01-02"
+
+// ExemptionCmd is the root command for the exemption subcommands
+var ExemptionCmd = &cobra.Command{
+	Use:   "exemption",
+	Short: "Manage rule exemptions",
+	Long: `The exemption subcommands manage rule exemptions within Minder.
+
+An exemption allows a rule of a profile to fail for an entity until it expires.
+While it is active, the rule is not evaluated for the entity, the evaluation is
+recorded as waived, and no alerts or remediations are issued. Every exemption
+records a justification, who approved it and who created it.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	app.RootCmd.AddCommand(ExemptionCmd)
+	// Flags for all subcommands
+	ExemptionCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
+
+// parseExpiry parses the expiry of an exemption, given either as a
+// timestamp, a date or a duration from now
+func parseExpiry(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid expiry %q, expected a RFC3339 timestamp, a date (YYYY-MM-DD) or a duration", value)
+}
+
+func renderExemptions(cmd *cobra.Command, format string, resp proto.Message, exemptions ...*minderv1.RuleExemption) error {
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := initializeTable(cmd.OutOrStdout())
+		for _, ex := range exemptions {
+			t.AddRow(
+				ex.GetId(),
+				ex.GetProfile(),
+				ex.GetRuleName(),
+				fmt.Sprintf("%s/%s", ex.GetEntity().GetType().ToString(), ex.GetEntity().GetName()),
+				ex.GetApprover(),
+				ex.GetExpiresAt().AsTime().Format(time.DateTime),
+				ex.GetJustification(),
+			)
+		}
+		t.Render()
+	}
+	return nil
+}
+
+func initializeTable(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"ID", "Profile", "Rule", "Entity", "Approver", "Expires", "Justification"})
+}
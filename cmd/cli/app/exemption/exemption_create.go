@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemption
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a rule exemption",
+	Long: `The exemption create subcommand lets you allow a rule of a profile to fail
+for an entity until the exemption expires.
+
+The expiry can be given as a RFC3339 timestamp, a date (YYYY-MM-DD, UTC) or a
+duration from now, such as 720h.`,
+	Example: `  minder exemption create --profile security --rule branch_protection \
+    --entity-type repository --entity mindersec/minder \
+    --justification "Migration tracked in TICKET-123" --approver alice --expires 2026-12-31`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: createCommand,
+}
+
+// createCommand is the exemption "create" subcommand
+func createCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	provider := viper.GetString("provider")
+	entityID := viper.GetString("entity")
+	entityType := minderv1.EntityFromString(viper.GetString("entity-type"))
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	if !entityType.IsValid() {
+		return cli.MessageAndError(fmt.Sprintf("Entity type %s not supported", viper.GetString("entity-type")),
+			fmt.Errorf("invalid argument"))
+	}
+
+	expiresAt, err := parseExpiry(viper.GetString("expires"), time.Now())
+	if err != nil {
+		return cli.MessageAndError("Error parsing expiry", err)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	entity := &minderv1.EntityTypedId{Type: entityType}
+	// If the entity is a UUID, fill the `id` field, otherwise fill the name field.
+	if _, err := uuid.Parse(entityID); err == nil {
+		entity.Id = entityID
+	} else {
+		entity.Name = entityID
+	}
+
+	resp, err := client.CreateRuleExemption(cmd.Context(), &minderv1.CreateRuleExemptionRequest{
+		Context: &minderv1.Context{Project: &project, Provider: &provider},
+		Exemption: &minderv1.RuleExemption{
+			Profile:       viper.GetString("profile"),
+			RuleName:      viper.GetString("rule"),
+			Entity:        entity,
+			Justification: viper.GetString("justification"),
+			Approver:      viper.GetString("approver"),
+			ExpiresAt:     timestamppb.New(expiresAt),
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error creating exemption", err)
+	}
+
+	return renderExemptions(cmd, format, resp, resp.GetExemption())
+}
+
+func init() {
+	ExemptionCmd.AddCommand(createCmd)
+	// Flags
+	createCmd.Flags().StringP("profile", "n", "", "Name of the profile containing the rule")
+	createCmd.Flags().StringP("rule", "r", "", "Name of the rule in the profile")
+	createCmd.Flags().StringP("entity", "e", "", "ID or name of the entity the rule is waived for")
+	createCmd.Flags().StringP("entity-type", "t", "",
+		fmt.Sprintf("Type of the entity (one of %s)", entities.KnownTypesCSV()))
+	createCmd.Flags().StringP("provider", "p", "", "Name of the provider of the entity, when given by name")
+	createCmd.Flags().String("justification", "", "Why the rule may fail, e.g. a reference to a ticket")
+	createCmd.Flags().String("approver", "", "Who approved the exemption")
+	createCmd.Flags().String("expires", "", "When the exemption expires (timestamp, date or duration)")
+	createCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	// Required
+	for _, flag := range []string{"profile", "rule", "entity", "entity-type", "justification", "approver", "expires"} {
+		if err := createCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+}
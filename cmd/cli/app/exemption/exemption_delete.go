@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemption
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a rule exemption",
+	Long: `The exemption delete subcommand lets you delete a rule exemption. Deleting an
+exemption removes it from the audit trail; to end an exemption early, update its
+expiry instead.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: deleteCommand,
+}
+
+// deleteCommand is the exemption "delete" subcommand
+func deleteCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	id := viper.GetString("id")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	_, err = client.DeleteRuleExemption(cmd.Context(), &minderv1.DeleteRuleExemptionRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      id,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting exemption", err)
+	}
+
+	cmd.Println("Successfully deleted exemption with id:", id)
+
+	return nil
+}
+
+func init() {
+	ExemptionCmd.AddCommand(deleteCmd)
+	// Flags
+	deleteCmd.Flags().StringP("id", "i", "", "ID of the exemption to delete")
+	// Required
+	if err := deleteCmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+}
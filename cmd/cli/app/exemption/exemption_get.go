@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemption
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var getCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get details for a rule exemption",
+	Long:  `The exemption get subcommand lets you retrieve details for a rule exemption within Minder.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: getCommand,
+}
+
+// getCommand is the exemption "get" subcommand
+func getCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	id := viper.GetString("id")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.GetRuleExemption(cmd.Context(), &minderv1.GetRuleExemptionRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      id,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error getting exemption", err)
+	}
+
+	return renderExemptions(cmd, format, resp, resp.GetExemption())
+}
+
+func init() {
+	ExemptionCmd.AddCommand(getCmd)
+	// Flags
+	getCmd.Flags().StringP("id", "i", "", "ID of the exemption")
+	getCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	// Required
+	if err := getCmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+}
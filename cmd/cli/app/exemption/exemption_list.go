@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemption
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List rule exemptions",
+	Long: `The exemption list subcommand lets you list the rule exemptions of a project.
+Expired exemptions are kept as an audit trail, and are only listed on request.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: listCommand,
+}
+
+// listCommand is the exemption "list" subcommand
+func listCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.ListRuleExemptions(cmd.Context(), &minderv1.ListRuleExemptionsRequest{
+		Context:        &minderv1.Context{Project: &project},
+		Profile:        viper.GetString("profile"),
+		IncludeExpired: viper.GetBool("include-expired"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing exemptions", err)
+	}
+
+	return renderExemptions(cmd, format, resp, resp.GetExemptions()...)
+}
+
+func init() {
+	ExemptionCmd.AddCommand(listCmd)
+	// Flags
+	listCmd.Flags().StringP("profile", "n", "", "Only list the exemptions of the profile with this name")
+	listCmd.Flags().Bool("include-expired", false, "Also list expired exemptions")
+	listCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
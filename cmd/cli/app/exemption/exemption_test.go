@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemption
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+const testID = "00000000-0000-0000-0000-000000000001"
+
+func loadExemption(t *testing.T) *minderv1.RuleExemption {
+	t.Helper()
+	ex := &minderv1.RuleExemption{}
+	cli.LoadFixture(t, "mock_exemption.json", ex)
+	return ex
+}
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestExemptionCommands(t *testing.T) {
+	tests := []cli.CmdTestCase{
+		{
+			Name: "create by entity name",
+			Args: []string{"exemption", "create", "-n", "security", "-r", "branch_protection",
+				"-t", "repository", "-e", "mindersec/minder", "-p", "github",
+				"--justification", "Migration tracked in TICKET-123", "--approver", "alice",
+				"--expires", "2026-12-31"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+				client.EXPECT().
+					CreateRuleExemption(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.CreateRuleExemptionRequest, _ ...any) (
+						*minderv1.CreateRuleExemptionResponse, error) {
+						assert.Equal(t, "mindersec/minder", req.GetExemption().GetEntity().GetName())
+						assert.Empty(t, req.GetExemption().GetEntity().GetId())
+						assert.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, req.GetExemption().GetEntity().GetType())
+						assert.Equal(t, "github", req.GetContext().GetProvider())
+						assert.Equal(t, time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC),
+							req.GetExemption().GetExpiresAt().AsTime())
+						return &minderv1.CreateRuleExemptionResponse{Exemption: loadExemption(t)}, nil
+					})
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "create_table.txt",
+		},
+		{
+			Name: "create with invalid expiry",
+			Args: []string{"exemption", "create", "-n", "security", "-r", "branch_protection",
+				"-t", "repository", "-e", "mindersec/minder",
+				"--justification", "tracked", "--approver", "alice", "--expires", "next week"},
+			ExpectedError: "invalid expiry",
+		},
+		{
+			Name:          "create without justification",
+			Args:          []string{"exemption", "create", "-n", "security", "-r", "branch_protection"},
+			ExpectedError: "required flag(s)",
+		},
+		{
+			Name: "get json",
+			Args: []string{"exemption", "get", "-i", testID, "-o", "json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+				client.EXPECT().
+					GetRuleExemption(gomock.Any(), gomock.Any()).
+					Return(&minderv1.GetRuleExemptionResponse{Exemption: loadExemption(t)}, nil)
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "get.json",
+		},
+		{
+			Name: "list table",
+			Args: []string{"exemption", "list", "-n", "security", "--include-expired"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+				client.EXPECT().
+					ListRuleExemptions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.ListRuleExemptionsRequest, _ ...any) (
+						*minderv1.ListRuleExemptionsResponse, error) {
+						assert.Equal(t, "security", req.GetProfile())
+						assert.True(t, req.GetIncludeExpired())
+						return &minderv1.ListRuleExemptionsResponse{
+							Exemptions: []*minderv1.RuleExemption{loadExemption(t)},
+						}, nil
+					})
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "list_table.txt",
+		},
+		{
+			Name:          "update without changes",
+			Args:          []string{"exemption", "update", "-i", testID},
+			ExpectedError: "at least one of the flags",
+		},
+		{
+			Name: "delete not found",
+			Args: []string{"exemption", "delete", "-i", testID},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+				client.EXPECT().
+					DeleteRuleExemption(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "exemption not found"))
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			ExpectedError: "exemption not found",
+		},
+		{
+			Name: "delete success",
+			Args: []string{"exemption", "delete", "-i", testID},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+				client.EXPECT().
+					DeleteRuleExemption(gomock.Any(), gomock.Any()).
+					Return(&minderv1.DeleteRuleExemptionResponse{}, nil)
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "delete.txt",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, ExemptionCmd)
+}
+
+func TestParseExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "timestamp", value: "2026-11-01T10:00:00Z", want: time.Date(2026, time.November, 1, 10, 0, 0, 0, time.UTC)},
+		{name: "date", value: "2026-11-01", want: time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "duration", value: "48h", want: now.Add(48 * time.Hour)},
+		{name: "invalid", value: "tomorrow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseExpiry(tt.value, now)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "expected %s, got %s", tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemption
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a rule exemption",
+	Long: `The exemption update subcommand lets you change the justification, approver
+or expiry of a rule exemption. To end an exemption early while keeping it as an
+audit trail, set its expiry to now.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: updateCommand,
+}
+
+// updateCommand is the exemption "update" subcommand
+func updateCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	req := &minderv1.UpdateRuleExemptionRequest{
+		Context:       &minderv1.Context{Project: &project},
+		Id:            viper.GetString("id"),
+		Justification: viper.GetString("justification"),
+		Approver:      viper.GetString("approver"),
+	}
+	if expires := viper.GetString("expires"); expires != "" {
+		expiresAt, err := parseExpiry(expires, time.Now())
+		if err != nil {
+			return cli.MessageAndError("Error parsing expiry", err)
+		}
+		req.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProfileServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.UpdateRuleExemption(cmd.Context(), req)
+	if err != nil {
+		return cli.MessageAndError("Error updating exemption", err)
+	}
+
+	return renderExemptions(cmd, format, resp, resp.GetExemption())
+}
+
+func init() {
+	ExemptionCmd.AddCommand(updateCmd)
+	// Flags
+	updateCmd.Flags().StringP("id", "i", "", "ID of the exemption")
+	updateCmd.Flags().String("justification", "", "New justification of the exemption")
+	updateCmd.Flags().String("approver", "", "New approver of the exemption")
+	updateCmd.Flags().String("expires", "", "New expiry of the exemption (timestamp, date or duration)")
+	updateCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	// Required
+	if err := updateCmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+	updateCmd.MarkFlagsOneRequired("justification", "approver", "expires")
+}
@@ -268,6 +268,7 @@ var evalStatuses = []string{
 	string(db.EvalStatusTypesError),
 	string(db.EvalStatusTypesSuccess),
 	string(db.EvalStatusTypesSkipped),
+	string(db.EvalStatusTypesWaived),
 }
 
 var remediationStatuses = []string{
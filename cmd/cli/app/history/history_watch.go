@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/types"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch evaluation results as they happen",
+	Long: `The history watch subcommand prints evaluation results as they are stored
+by Minder, until interrupted.
+
+When interrupted, it prints a cursor which can be passed to --cursor to
+resume watching without missing any evaluation.`,
+	RunE: watchCommand,
+}
+
+// watchCommand is the history "watch" subcommand. It does not use
+// cli.GRPCClientWrapRunE, since the stream must not time out.
+func watchCommand(cmd *cobra.Command, _ []string) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+
+	project := viper.GetString("project")
+	profileName := viper.GetStringSlice("profile-name")
+	entityType := viper.GetStringSlice("entity-type")
+	evalStatus := viper.GetStringSlice("eval-status")
+	labels := viper.GetStringSlice("label")
+	cursor := viper.GetString("cursor")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	if err := validatedFilter(evalStatus, evalStatuses); err != nil {
+		return err
+	}
+
+	if err := validatedFilter(entityType, entityTypes); err != nil {
+		return err
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	conn, err := cli.GrpcForCommand(cmd, viper.GetViper())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer cancel()
+
+	client := minderv1.NewEvalResultsServiceClient(conn)
+	stream, err := client.WatchEvaluationResults(ctx, &minderv1.WatchEvaluationResultsRequest{
+		Context:     &minderv1.Context{Project: &project},
+		ProfileName: profileName,
+		EntityType:  entityType,
+		LabelFilter: labels,
+		Status:      evalStatus,
+		Cursor:      cursor,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error watching evaluation results", err)
+	}
+
+	emoji := viper.GetBool("emoji")
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil || status.Code(err) == codes.Canceled {
+				printResumeCursor(cmd.ErrOrStderr(), cursor)
+				return nil
+			}
+			return cli.MessageAndError("Error watching evaluation results", err)
+		}
+		cursor = resp.GetCursor()
+
+		switch format {
+		case app.JSON:
+			out, err := util.GetJsonFromProto(resp)
+			if err != nil {
+				return cli.MessageAndError("Error getting json from proto", err)
+			}
+			cmd.Println(out)
+		case app.YAML:
+			out, err := util.GetYamlFromProto(resp)
+			if err != nil {
+				return cli.MessageAndError("Error getting yaml from proto", err)
+			}
+			cmd.Println("---")
+			cmd.Println(out)
+		case app.Table:
+			printEvaluationLine(cmd.OutOrStdout(), resp.GetEvaluation(), emoji)
+		}
+	}
+}
+
+// printEvaluationLine prints a single evaluation. A table can't be used,
+// since the rows need to be printed as they arrive.
+func printEvaluationLine(w io.Writer, eval *minderv1.EvaluationHistory, emoji bool) {
+	evalStatus := table.GetStatusIcon(types.HistoryStatus(eval), emoji)
+	fmt.Fprintf(w, "%s  %-20s  %-40s  %s\n",
+		eval.GetEvaluatedAt().AsTime().Local().Format(time.DateTime),
+		strings.TrimSpace(evalStatus.Column),
+		eval.GetRule().GetName(),
+		eval.GetEntity().GetName(),
+	)
+}
+
+func printResumeCursor(w io.Writer, cursor string) {
+	if cursor == "" {
+		return
+	}
+	fmt.Fprintf(w, "Resume watching with: --cursor %s\n", cli.CursorStyle.Render(cursor))
+}
+
+func init() {
+	historyCmd.AddCommand(watchCmd)
+
+	basicMsg := "Filter watched evaluations by %s - one of %s"
+	evalFilterMsg := fmt.Sprintf(basicMsg, "evaluation status", strings.Join(evalStatuses, ", "))
+	entityTypesMsg := fmt.Sprintf(basicMsg, "entity type", strings.Join(entityTypes, ", "))
+
+	// Flags
+	watchCmd.Flags().StringSlice("profile-name", nil, "Filter watched evaluations by profile name")
+	watchCmd.Flags().StringSlice("entity-type", nil, entityTypesMsg)
+	watchCmd.Flags().StringSlice("eval-status", nil, evalFilterMsg)
+	watchCmd.Flags().StringSliceP("label", "l", nil, "Filter watched evaluations by profile label")
+	watchCmd.Flags().StringP("cursor", "c", "", "Resume watching after the evaluation this cursor was returned with")
+	watchCmd.Flags().Bool("emoji", true, "Use emojis in the output")
+}
@@ -23,7 +23,15 @@ import (
 var applyCmd = &cobra.Command{
 	Use:   "apply [files]",
 	Short: "Create or update a profile",
-	Long:  `The profile apply subcommand lets you create or update new profiles for a project within Minder.`,
+	Long: `The profile apply subcommand lets you create or update new profiles for a project within Minder.
+
+With --plan, the profiles are not applied. Instead, the entities which each profile would
+start or stop selecting are listed, along with the evaluations whose status would change.
+The rules are evaluated without running their alerts or remediations, and the evaluations
+are not recorded. Rules which act on pull requests, such as vulncheck, behave as with the
+profile_only action. The ingesters and data sources of the rules do run, though: planning
+calls the provider and the external services the rule types use, with the same requests as
+an evaluation, which may include REST calls with methods other than GET.`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		fileFlag, err := cmd.Flags().GetStringArray("file")
 		if err != nil {
@@ -68,6 +76,7 @@ func applyCommand(cmd *cobra.Command, args []string) error {
 	defer closeConn()
 
 	project := viper.GetString("project")
+	plan := viper.GetBool("plan")
 
 	var failedFiles []string
 
@@ -109,9 +118,15 @@ func applyCommand(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		table := NewProfileRulesTable(cmd.OutOrStdout())
-
-		if _, err = ExecOnOneProfile(cmd.Context(), table, f.Path, os.Stdin, project, applyFunc); err != nil {
+		if plan {
+			err = planOneProfile(cmd, client, f.Path, project)
+		} else {
+			table := NewProfileRulesTable(cmd.OutOrStdout())
+			if _, err = ExecOnOneProfile(cmd.Context(), table, f.Path, os.Stdin, project, applyFunc); err == nil {
+				table.Render()
+			}
+		}
+		if err != nil {
 			if f.Expanded && minderv1.YouMayHaveTheWrongResource(err) {
 				cmd.PrintErrf("Skipping file %s: not a profile\n", f.Path)
 				continue
@@ -121,7 +136,6 @@ func applyCommand(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		table.Render()
 		cmd.Println()
 	}
 
@@ -136,9 +150,54 @@ func applyCommand(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// planOneProfile prints the impact applying a profile would have, without applying it
+func planOneProfile(cmd *cobra.Command, client minderv1.ProfileServiceClient, f string, project string) error {
+	ctx, cancel := cli.GetAppContext(cmd.Context(), viper.GetViper())
+	defer cancel()
+
+	reader, closer, err := util.OpenFileArg(f, os.Stdin)
+	if err != nil {
+		return fmt.Errorf("error opening file arg: %w", err)
+	}
+	defer closer()
+
+	p, err := parseProfile(reader, project)
+	if err != nil {
+		return fmt.Errorf("error parsing profile: %w", err)
+	}
+
+	resp, err := client.PlanProfile(ctx, &minderv1.PlanProfileRequest{
+		Profile: p,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.GetSelections()) == 0 && len(resp.GetEvaluations()) == 0 {
+		cmd.Printf("Applying profile %s would not change any evaluation\n", p.GetName())
+		return nil
+	}
+
+	cmd.Printf("Applying profile %s would change the selection of %d entities and the status of %d evaluations\n",
+		p.GetName(), len(resp.GetSelections()), len(resp.GetEvaluations()))
+	if len(resp.GetSelections()) > 0 {
+		table := NewProfilePlanSelectionsTable(cmd.OutOrStdout())
+		RenderProfilePlanSelectionsTable(resp.GetSelections(), table)
+		table.Render()
+	}
+	if len(resp.GetEvaluations()) > 0 {
+		table := NewProfilePlanEvaluationsTable(cmd.OutOrStdout())
+		RenderProfilePlanEvaluationsTable(resp.GetEvaluations(), table)
+		table.Render()
+	}
+	return nil
+}
+
 func init() {
 	ProfileCmd.AddCommand(applyCmd)
 	// Flags
 	applyCmd.Flags().StringArrayP("file", "f", []string{},
 		"Path to the YAML defining the profile (or - for stdin). Can be specified multiple files")
+	applyCmd.Flags().Bool("plan", false,
+		"Show the impact of applying the profiles on the evaluations, without applying them")
 }
@@ -92,6 +92,55 @@ func TestApplyCommand(t *testing.T) {
 			},
 			GoldenFileName: "apply_multiple.table",
 		},
+		{
+			Name: "plan profile without applying it",
+			Args: []string{"profile", "apply", "--plan", "-f", fixtureDependabot},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				repo := &minderv1.EntityTypedId{
+					Type: minderv1.Entity_ENTITY_REPOSITORIES,
+					Id:   "d3b7c9a4-4f8e-4b3a-9b67-1f2c3d4e5f60",
+					Name: "acme/api",
+				}
+				client.EXPECT().
+					PlanProfile(gomock.Any(), gomock.Any()).
+					Return(&minderv1.PlanProfileResponse{
+						Selections: []*minderv1.ProfilePlanSelection{
+							{Entity: repo, SelectedBefore: false, SelectedAfter: true},
+						},
+						Evaluations: []*minderv1.ProfilePlanEvaluation{
+							{
+								Entity:         repo,
+								RuleTypeName:   "dependabot_configured",
+								RuleName:       "dependabot_configured",
+								CurrentStatus:  "skipped",
+								PlannedStatus:  "failure",
+								PlannedDetails: "dependabot is not configured for gomod",
+							},
+						},
+					}, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "apply_plan.table",
+		},
+		{
+			Name: "plan profile without changes",
+			Args: []string{"profile", "apply", "--plan", fixtureDependabot},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProfileServiceClient(ctrl)
+
+				client.EXPECT().
+					PlanProfile(gomock.Any(), gomock.Any()).
+					Return(&minderv1.PlanProfileResponse{}, nil)
+
+				return cli.WithRPCClient[minderv1.ProfileServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "apply_plan_none.table",
+		},
 		{
 			Name:          "no files specified",
 			Args:          []string{"profile", "apply"},
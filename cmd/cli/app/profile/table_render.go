@@ -178,3 +178,50 @@ func RenderRuleEvaluationStatusTable(
 		)
 	}
 }
+
+// NewProfilePlanSelectionsTable creates a new table for rendering the entities
+// which a planned profile would start or stop selecting
+func NewProfilePlanSelectionsTable(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"Entity", "Selected Before", "Selected After"}).
+		SetAutoMerge(true).
+		SetEqualColumns(false)
+}
+
+// RenderProfilePlanSelectionsTable renders the selections table of a profile plan
+func RenderProfilePlanSelectionsTable(selections []*minderv1.ProfilePlanSelection, t table.Table) {
+	for _, sel := range selections {
+		t.AddRow(
+			planEntityName(sel.GetEntity()),
+			fmt.Sprintf("%t", sel.GetSelectedBefore()),
+			fmt.Sprintf("%t", sel.GetSelectedAfter()),
+		)
+	}
+}
+
+// NewProfilePlanEvaluationsTable creates a new table for rendering the
+// evaluations whose status a planned profile would change
+func NewProfilePlanEvaluationsTable(out io.Writer) table.Table {
+	return table.New(table.Simple, layouts.Default, out,
+		[]string{"Entity", "Rule", "Current", "Planned", "Details"}).
+		SetAutoMerge(true).
+		SetEqualColumns(false)
+}
+
+// RenderProfilePlanEvaluationsTable renders the evaluations table of a profile
+// plan. An empty status means that the rule is not evaluated against the entity.
+func RenderProfilePlanEvaluationsTable(evaluations []*minderv1.ProfilePlanEvaluation, t table.Table) {
+	for _, ev := range evaluations {
+		t.AddRow(
+			planEntityName(ev.GetEntity()),
+			ev.GetRuleName(),
+			cmp.Or(ev.GetCurrentStatus(), "-"),
+			cmp.Or(ev.GetPlannedStatus(), "-"),
+			cmp.Or(strings.TrimSpace(ev.GetPlannedDetails()), "-"),
+		)
+	}
+}
+
+func planEntityName(ent *minderv1.EntityTypedId) string {
+	return fmt.Sprintf("%s\n[%s]", ent.GetName(), ent.GetType().ToString())
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package alertwebhook is the root command for the alert-webhook subcommands
+package alertwebhook
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app/project"
+)
+
+// AlertWebhookCmd is the root command for the alert-webhook subcommands
+var AlertWebhookCmd = &cobra.Command{
+	Use:   "alert-webhook",
+	Short: "Manage alert webhooks within a minder control plane",
+	Long: `The minder project alert-webhook commands manage the webhooks that
+rule types with a webhook alert post to, such as Slack or Microsoft Teams
+incoming webhooks.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	project.ProjectCmd.AddCommand(AlertWebhookCmd)
+	AlertWebhookCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertwebhook
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an alert webhook on a project within the minder control plane",
+	Long: `The minder project alert-webhook create command stores a webhook URL,
+and optionally a signing secret, on a particular project. Both are stored
+encrypted. Rule types refer to the webhook by name.`,
+	RunE: cli.GRPCClientWrapRunE(CreateCommand),
+}
+
+// CreateCommand is the command for creating an alert webhook
+func CreateCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	url := viper.GetString("url")
+	secret := viper.GetString("signing-secret")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err := client.CreateAlertWebhook(ctx, &minderv1.CreateAlertWebhookRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name:          name,
+		Url:           url,
+		SigningSecret: secret,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error creating alert webhook", err)
+	}
+
+	cmd.Printf("Created alert webhook %s\n", name)
+	return nil
+}
+
+func init() {
+	AlertWebhookCmd.AddCommand(createCmd)
+
+	createCmd.Flags().StringP("name", "n", "", "name of the webhook, as referenced by rule types")
+	createCmd.Flags().StringP("url", "u", "", "URL to post the alerts to")
+	createCmd.Flags().StringP("signing-secret", "s", "",
+		"secret used to sign the payload in the X-Minder-Signature header")
+	if err := createCmd.MarkFlagRequired("name"); err != nil {
+		createCmd.Print("Error marking `name` flag as required.")
+		os.Exit(1)
+	}
+	if err := createCmd.MarkFlagRequired("url"); err != nil {
+		createCmd.Print("Error marking `url` flag as required.")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertwebhook
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete an alert webhook from a project within the minder control plane",
+	Long: `The minder project alert-webhook delete command removes a webhook
+from a particular project. Rule types referring to it will fail to alert.`,
+	RunE: cli.GRPCClientWrapRunE(DeleteCommand),
+}
+
+// DeleteCommand is the command for deleting an alert webhook
+func DeleteCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProjectsServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err := client.DeleteAlertWebhook(ctx, &minderv1.DeleteAlertWebhookRequest{
+		Context: &minderv1.Context{
+			Project: &project,
+		},
+		Name: name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting alert webhook", err)
+	}
+
+	cmd.Printf("Deleted alert webhook %s\n", name)
+	return nil
+}
+
+func init() {
+	AlertWebhookCmd.AddCommand(deleteCmd)
+
+	deleteCmd.Flags().StringP("name", "n", "", "name of the webhook to delete")
+	if err := deleteCmd.MarkFlagRequired("name"); err != nil {
+		deleteCmd.Print("Error marking `name` flag as required.")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bundle is the root command for the project bundle subcommands
+package bundle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/cmd/cli/app/project"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// BundleCmd is the root command for the project bundle subcommands
+var BundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Manage the bundle subscriptions of a project",
+	Long: `The minder project bundle commands manage the subscriptions of a project to
+the bundles of the marketplace.
+
+A subscription adds the rule types and data sources of a bundle to the project,
+and keeps them at the version of the bundle the project is subscribed to.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	project.ProjectCmd.AddCommand(BundleCmd)
+	BundleCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
+
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
+
+// parseBundleRef parses a reference to a bundle of the form
+// namespace/name[@version]
+func parseBundleRef(ref string) (namespace, name, version string, err error) {
+	id, version, _ := strings.Cut(ref, "@")
+	namespace, name, ok := strings.Cut(id, "/")
+	if !ok || namespace == "" || name == "" {
+		return "", "", "", fmt.Errorf("expected namespace/name[@version], got %s", ref)
+	}
+	return namespace, name, version, nil
+}
+
+// renderBundles prints bundles in the given format
+func renderBundles(cmd *cobra.Command, format string, msg proto.Message, bundles []*minderv1.MarketplaceBundle) error {
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(msg)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(msg)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
+			[]string{"Bundle", "Subscribed", "Previous", "Latest", "Versions"})
+		for _, bundle := range bundles {
+			latest := ""
+			if len(bundle.GetVersions()) > 0 {
+				latest = bundle.GetVersions()[0]
+			}
+			t.AddRow(
+				fmt.Sprintf("%s/%s", bundle.GetNamespace(), bundle.GetName()),
+				bundle.GetSubscribedVersion(),
+				bundle.GetPreviousVersion(),
+				latest,
+				strings.Join(bundle.GetVersions(), ", "),
+			)
+		}
+		t.Render()
+	}
+	return nil
+}
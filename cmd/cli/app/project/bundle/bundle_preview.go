@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var previewCmd = &cobra.Command{
+	Use:   "preview namespace/name[@version]",
+	Short: "Preview the changes of subscribing a project to a bundle version",
+	Long: `The minder project bundle preview command lists the changes subscribing a
+project to a version of a bundle would make to the rule types, data sources and
+profiles the project installed from the bundle, without making them. Without a
+version, the latest version of the bundle is previewed.
+
+Updates of rule types whose rule or parameter schema changes in a way profiles
+using them may not satisfy are flagged as breaking. Subscribing to a version
+with breaking changes fails.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: previewCommand,
+}
+
+// previewCommand is the project bundle "preview" subcommand
+func previewCommand(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	namespace, name, version, err := parseBundleRef(args[0])
+	if err != nil {
+		return cli.MessageAndError("Invalid bundle", err)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProjectsServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.PreviewSubscriptionUpgrade(cmd.Context(), &minderv1.PreviewSubscriptionUpgradeRequest{
+		Context:   &minderv1.Context{Project: &project},
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error previewing subscription", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		from := resp.GetFromVersion()
+		if from == "" {
+			from = "not subscribed"
+		}
+		cmd.Printf("%s/%s: %s -> %s\n", namespace, name, from, resp.GetToVersion())
+		if len(resp.GetChanges()) == 0 {
+			cmd.Println("No changes")
+			return nil
+		}
+		t := table.New(table.Simple, layouts.Default, cmd.OutOrStdout(),
+			[]string{"Kind", "Name", "Action", "Breaking"})
+		for _, change := range resp.GetChanges() {
+			t.AddRow(change.GetKind(), change.GetName(), change.GetAction(), change.GetBreaking())
+		}
+		t.Render()
+	}
+	return nil
+}
+
+func init() {
+	BundleCmd.AddCommand(previewCmd)
+	// Flags
+	addOutputFlag(previewCmd)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback namespace/name",
+	Short: "Roll back the subscription of a project to its previous bundle version",
+	Long: `The minder project bundle rollback command subscribes a project to the version
+of a bundle it was subscribed to before its last upgrade, or downgrade. Rolling
+back twice restores the version rolled back from.
+
+Rollbacks with breaking changes are refused, such as rolling back an upgrade
+which added properties to the rule schema of a rule type. The minder project
+bundle preview command, given the previous version, lists the breaking changes.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: rollbackCommand,
+}
+
+// rollbackCommand is the project bundle "rollback" subcommand
+func rollbackCommand(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	namespace, name, version, err := parseBundleRef(args[0])
+	if err == nil && version != "" {
+		err = fmt.Errorf("expected namespace/name, got %s", args[0])
+	}
+	if err != nil {
+		return cli.MessageAndError("Invalid bundle", err)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProjectsServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.RollbackBundleSubscription(cmd.Context(), &minderv1.RollbackBundleSubscriptionRequest{
+		Context:   &minderv1.Context{Project: &project},
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error rolling back subscription", err)
+	}
+
+	return renderBundles(cmd, format, resp, []*minderv1.MarketplaceBundle{resp.GetBundle()})
+}
+
+func init() {
+	BundleCmd.AddCommand(rollbackCmd)
+	// Flags
+	addOutputFlag(rollbackCmd)
+}
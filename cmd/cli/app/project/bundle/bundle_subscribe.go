@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var subscribeCmd = &cobra.Command{
+	Use:   "subscribe namespace/name[@version]",
+	Short: "Subscribe a project to a bundle version",
+	Long: `The minder project bundle subscribe command subscribes a project to a version
+of a bundle, adding the rule types and data sources of the bundle to the
+project. If the project is already subscribed to the bundle, its rule types and
+data sources are upgraded, or downgraded, to the given version. Without a
+version, the latest version of the bundle is used.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: subscribeCommand,
+}
+
+// subscribeCommand is the project bundle "subscribe" subcommand
+func subscribeCommand(cmd *cobra.Command, args []string) error {
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	namespace, name, version, err := parseBundleRef(args[0])
+	if err != nil {
+		return cli.MessageAndError("Invalid bundle", err)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProjectsServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.SubscribeBundle(cmd.Context(), &minderv1.SubscribeBundleRequest{
+		Context:   &minderv1.Context{Project: &project},
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error subscribing to bundle", err)
+	}
+
+	return renderBundles(cmd, format, resp, []*minderv1.MarketplaceBundle{resp.GetBundle()})
+}
+
+func init() {
+	BundleCmd.AddCommand(subscribeCmd)
+	// Flags
+	addOutputFlag(subscribeCmd)
+}
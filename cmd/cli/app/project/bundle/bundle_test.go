@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+func loadBundles(t *testing.T) *minderv1.ListBundlesResponse {
+	t.Helper()
+	resp := &minderv1.ListBundlesResponse{}
+	cli.LoadFixture(t, "mock_bundles.json", resp)
+	return resp
+}
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestBundleCommands(t *testing.T) {
+	tests := []cli.CmdTestCase{
+		{
+			Name: "list table",
+			Args: []string{"project", "bundle", "list"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					ListBundles(gomock.Any(), gomock.Any()).
+					Return(loadBundles(t), nil)
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "list_table.txt",
+		},
+		{
+			Name: "list json",
+			Args: []string{"project", "bundle", "list", "-o", "json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					ListBundles(gomock.Any(), gomock.Any()).
+					Return(loadBundles(t), nil)
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "list.json",
+		},
+		{
+			Name: "subscribe version",
+			Args: []string{"project", "bundle", "subscribe", "acme/security-baseline@1.2.0"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					SubscribeBundle(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.SubscribeBundleRequest, _ ...any) (
+						*minderv1.SubscribeBundleResponse, error) {
+						assert.Equal(t, "acme", req.GetNamespace())
+						assert.Equal(t, "security-baseline", req.GetName())
+						assert.Equal(t, "1.2.0", req.GetVersion())
+						bundle := loadBundles(t).GetBundles()[0]
+						bundle.PreviousVersion, bundle.SubscribedVersion = bundle.SubscribedVersion, req.GetVersion()
+						return &minderv1.SubscribeBundleResponse{Bundle: bundle}, nil
+					})
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "subscribe_table.txt",
+		},
+		{
+			Name:          "subscribe invalid bundle",
+			Args:          []string{"project", "bundle", "subscribe", "security-baseline"},
+			ExpectedError: "expected namespace/name[@version], got security-baseline",
+		},
+		{
+			Name: "subscribe unknown version",
+			Args: []string{"project", "bundle", "subscribe", "acme/security-baseline@9.9.9"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					SubscribeBundle(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "unknown bundle version: acme/security-baseline@9.9.9"))
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			ExpectedError: "unknown bundle version",
+		},
+		{
+			Name: "preview table",
+			Args: []string{"project", "bundle", "preview", "acme/security-baseline"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					PreviewSubscriptionUpgrade(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.PreviewSubscriptionUpgradeRequest, _ ...any) (
+						*minderv1.PreviewSubscriptionUpgradeResponse, error) {
+						assert.Equal(t, "acme", req.GetNamespace())
+						assert.Equal(t, "security-baseline", req.GetName())
+						assert.Empty(t, req.GetVersion())
+						return &minderv1.PreviewSubscriptionUpgradeResponse{
+							FromVersion: "1.1.0",
+							ToVersion:   "1.2.0",
+							Changes: []*minderv1.BundleResourceChange{
+								{Kind: "data_source", Name: "acme/osv", Action: "add"},
+								{
+									Kind:     "rule_type",
+									Name:     "acme/branch_protection",
+									Action:   "update",
+									Breaking: "parameter schema update is invalid: cannot change type of rule schema",
+								},
+								{Kind: "profile", Name: "acme/baseline", Action: "update"},
+								{Kind: "rule_type", Name: "acme/legacy", Action: "unmanaged"},
+							},
+						}, nil
+					})
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "preview_table.txt",
+		},
+		{
+			Name: "preview no changes",
+			Args: []string{"project", "bundle", "preview", "acme/security-baseline@1.1.0"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					PreviewSubscriptionUpgrade(gomock.Any(), gomock.Any()).
+					Return(&minderv1.PreviewSubscriptionUpgradeResponse{FromVersion: "1.1.0", ToVersion: "1.1.0"}, nil)
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "preview_none.txt",
+		},
+		{
+			Name: "rollback",
+			Args: []string{"project", "bundle", "rollback", "acme/security-baseline"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					RollbackBundleSubscription(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.RollbackBundleSubscriptionRequest, _ ...any) (
+						*minderv1.RollbackBundleSubscriptionResponse, error) {
+						assert.Equal(t, "acme", req.GetNamespace())
+						assert.Equal(t, "security-baseline", req.GetName())
+						bundle := loadBundles(t).GetBundles()[0]
+						bundle.SubscribedVersion, bundle.PreviousVersion = bundle.PreviousVersion, bundle.SubscribedVersion
+						return &minderv1.RollbackBundleSubscriptionResponse{Bundle: bundle}, nil
+					})
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "rollback_table.txt",
+		},
+		{
+			Name:          "rollback to a version",
+			Args:          []string{"project", "bundle", "rollback", "acme/security-baseline@1.0.0"},
+			ExpectedError: "expected namespace/name, got acme/security-baseline@1.0.0",
+		},
+		{
+			Name: "rollback without previous version",
+			Args: []string{"project", "bundle", "rollback", "stacklok/healthcheck"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					RollbackBundleSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.FailedPrecondition, "no previous version of the bundle"))
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			ExpectedError: "no previous version of the bundle",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, BundleCmd)
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package projectsync is the root command for the project sync subcommands
+package projectsync
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/cmd/cli/app/project"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// SyncCmd is the root command for the project sync subcommands
+var SyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Sync a project from a git repository",
+	Long: `The minder project sync commands manage the git repository a project
+is synced from.
+
+The rule types, profiles and data sources in the repository are periodically
+applied to the project. Resources created in the project which are not in the
+repository are deleted, while the resources of bundle subscriptions are left
+alone.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	project.ProjectCmd.AddCommand(SyncCmd)
+	SyncCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
+
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
+
+// renderStatus prints the sync status of a project in the given format
+func renderStatus(cmd *cobra.Command, format string, status *minderv1.ProjectSyncStatus) error {
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(status)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(status)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		renderStatusTable(cmd.OutOrStdout(), status)
+	}
+	return nil
+}
+
+func renderStatusTable(out io.Writer, status *minderv1.ProjectSyncStatus) {
+	src := status.GetSource()
+	lastSync := ""
+	if status.GetLastSyncAt() != nil {
+		lastSync = status.GetLastSyncAt().AsTime().Format(timeLayout)
+	}
+
+	t := table.New(table.Simple, layouts.Default, out, []string{"Key", "Value"})
+	t.AddRow("Provider", src.GetProvider())
+	t.AddRow("Repository", src.GetRepository())
+	t.AddRow("Branch", src.GetBranch())
+	t.AddRow("Path", src.GetPath())
+	t.AddRow("Interval", src.GetInterval())
+	t.AddRow("Status", status.GetStatus())
+	t.AddRow("Message", status.GetMessage())
+	t.AddRow("Commit", status.GetCommit())
+	t.AddRow("Last sync", lastSync)
+	t.AddRow("Next sync", status.GetNextSyncAt().AsTime().Format(timeLayout))
+	t.Render()
+
+	if len(status.GetDrift()) == 0 {
+		return
+	}
+	d := table.New(table.Simple, layouts.Default, out, []string{"Kind", "Name", "Action"})
+	for _, drift := range status.GetDrift() {
+		d.AddRow(drift.GetKind(), drift.GetName(), drift.GetAction())
+	}
+	d.Render()
+}
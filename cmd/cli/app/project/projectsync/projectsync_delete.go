@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectsync
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Stop syncing a project from a git repository",
+	Long: `The minder project sync delete command stops syncing a project from its git
+repository. The rule types, profiles and data sources of the project are kept
+as they are.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: deleteCommand,
+}
+
+// deleteCommand is the project sync "delete" subcommand
+func deleteCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProjectsServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	_, err = client.DeleteProjectSyncSource(cmd.Context(), &minderv1.DeleteProjectSyncSourceRequest{
+		Context: &minderv1.Context{Project: &project},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting project sync source", err)
+	}
+
+	cmd.Println("Stopped syncing the project from its repository")
+	return nil
+}
+
+func init() {
+	SyncCmd.AddCommand(deleteCmd)
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectsync
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var setCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set the git repository a project is synced from",
+	Long: `The minder project sync set command sets the git repository a project is
+synced from, through a git provider of the project, and schedules a sync right
+away. The repository must be hosted by the git provider, which clones it with
+its own credentials. The directory given by --path holds the resources in the
+bundle layout, that is profiles, rule_types and data_sources directories.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("error binding flags: %s", err)
+		}
+		return nil
+	},
+	RunE: setCommand,
+}
+
+// setCommand is the project sync "set" subcommand
+func setCommand(cmd *cobra.Command, _ []string) error {
+	project := viper.GetString("project")
+	provider := viper.GetString("provider")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	client, closeConn, err := cli.GetCLIClient(cmd, minderv1.NewProjectsServiceClient)
+	if err != nil {
+		return cli.MessageAndError("Error connecting to server", err)
+	}
+	defer closeConn()
+
+	resp, err := client.SetProjectSyncSource(cmd.Context(), &minderv1.SetProjectSyncSourceRequest{
+		Context: &minderv1.Context{Project: &project, Provider: &provider},
+		Source: &minderv1.ProjectSyncSource{
+			Provider:   provider,
+			Repository: viper.GetString("repository"),
+			Branch:     viper.GetString("branch"),
+			Path:       viper.GetString("path"),
+			Interval:   viper.GetString("interval"),
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error setting project sync source", err)
+	}
+
+	return renderStatus(cmd, format, resp.GetStatus())
+}
+
+func init() {
+	SyncCmd.AddCommand(setCmd)
+	// Flags
+	setCmd.Flags().StringP("repository", "r", "", "HTTPS URL of the git repository")
+	setCmd.Flags().StringP("branch", "b", "", "branch to sync from (default \"main\")")
+	setCmd.Flags().String("path", "", "directory of the repository holding the resources")
+	setCmd.Flags().StringP("interval", "i", "", "time between two syncs, such as 30m (default 1h)")
+	setCmd.Flags().StringP("provider", "p", "", "name of the git provider to clone the repository with")
+	addOutputFlag(setCmd)
+	// Required
+	if err := setCmd.MarkFlagRequired("repository"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mockv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1/mock"
+)
+
+func loadStatus(t *testing.T) *minderv1.ProjectSyncStatus {
+	t.Helper()
+	st := &minderv1.ProjectSyncStatus{}
+	cli.LoadFixture(t, "mock_status.json", st)
+	return st
+}
+
+//nolint:paralleltest // Cannot run in parallel because it swaps global Viper/Stdout state
+func TestSyncCommands(t *testing.T) {
+	tests := []cli.CmdTestCase{
+		{
+			Name: "set table",
+			Args: []string{"project", "sync", "set", "-r", "https://github.com/mindersec/policies",
+				"--path", "policies", "-p", "git"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					SetProjectSyncSource(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *minderv1.SetProjectSyncSourceRequest, _ ...any) (
+						*minderv1.SetProjectSyncSourceResponse, error) {
+						assert.Equal(t, "https://github.com/mindersec/policies", req.GetSource().GetRepository())
+						assert.Equal(t, "policies", req.GetSource().GetPath())
+						assert.Equal(t, "git", req.GetSource().GetProvider())
+						assert.Empty(t, req.GetSource().GetBranch())
+						return &minderv1.SetProjectSyncSourceResponse{Status: loadStatus(t)}, nil
+					})
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "set_table.txt",
+		},
+		{
+			Name:          "set without repository",
+			Args:          []string{"project", "sync", "set", "--path", "policies"},
+			ExpectedError: "required flag(s)",
+		},
+		{
+			Name: "status json",
+			Args: []string{"project", "sync", "status", "-o", "json"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					GetProjectSyncStatus(gomock.Any(), gomock.Any()).
+					Return(&minderv1.GetProjectSyncStatusResponse{Status: loadStatus(t)}, nil)
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "status.json",
+		},
+		{
+			Name: "delete not synced",
+			Args: []string{"project", "sync", "delete"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					DeleteProjectSyncSource(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, "project is not synced from a repository"))
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			ExpectedError: "project is not synced from a repository",
+		},
+		{
+			Name: "delete success",
+			Args: []string{"project", "sync", "delete"},
+			MockSetup: func(t *testing.T, ctrl *gomock.Controller) context.Context {
+				t.Helper()
+				client := mockv1.NewMockProjectsServiceClient(ctrl)
+				client.EXPECT().
+					DeleteProjectSyncSource(gomock.Any(), gomock.Any()).
+					Return(&minderv1.DeleteProjectSyncSourceResponse{}, nil)
+				return cli.WithRPCClient[minderv1.ProjectsServiceClient](context.Background(), client)
+			},
+			GoldenFileName: "delete.txt",
+		},
+	}
+
+	cli.RunCmdTests(t, tests, SyncCmd)
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package report provides the CLI command for exporting compliance reports
+package report
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var reportFormats = map[string]minderv1.ComplianceReportFormat{
+	"sarif": minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_SARIF,
+	"csv":   minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_CSV,
+	"oscal": minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_OSCAL,
+}
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Export a compliance report",
+	Long: `The report command exports the latest evaluation results of a project as a
+compliance report.
+
+The report includes the evaluation status of every rule against every entity,
+together with its alert and remediation state and the guidance of the rule type.
+It can be rendered as SARIF (suitable for upload to code scanning tools), CSV or
+an OSCAL assessment-results document.`,
+	RunE: cli.GRPCClientWrapRunE(reportCommand),
+}
+
+// reportCommand is the "report" command
+func reportCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	project := viper.GetString("project")
+	profileName := viper.GetString("profile")
+	labelFilter := viper.GetString("label-filter")
+	formatName := strings.ToLower(viper.GetString("format"))
+	outputFlag := viper.GetString("output")
+
+	format, ok := reportFormats[formatName]
+	if !ok {
+		return cli.MessageAndError(fmt.Sprintf("Report format %s not supported", formatName), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	req := &minderv1.GenerateComplianceReportRequest{
+		Context: &minderv1.Context{Project: &project},
+		Format:  format,
+	}
+
+	switch {
+	case profileName != "":
+		// The report selects profiles by ID, resolve the name first
+		prof, err := minderv1.NewProfileServiceClient(conn).GetProfileByName(ctx, &minderv1.GetProfileByNameRequest{
+			Context: &minderv1.Context{Project: &project},
+			Name:    profileName,
+		})
+		if err != nil {
+			return cli.MessageAndError("Error getting profile", err)
+		}
+		req.ProfileSelector = &minderv1.GenerateComplianceReportRequest_Profile{Profile: prof.GetProfile().GetId()}
+	case labelFilter != "":
+		req.ProfileSelector = &minderv1.GenerateComplianceReportRequest_LabelFilter{LabelFilter: labelFilter}
+	}
+
+	resp, err := minderv1.NewEvalResultsServiceClient(conn).GenerateComplianceReport(ctx, req)
+	if err != nil {
+		return cli.MessageAndError("Error generating compliance report", err)
+	}
+
+	if outputFlag == "" || outputFlag == "-" {
+		if _, err := cmd.OutOrStdout().Write(resp.GetContent()); err != nil {
+			return cli.MessageAndError("Error writing report", err)
+		}
+		return nil
+	}
+
+	if err := os.WriteFile(filepath.Clean(outputFlag), resp.GetContent(), 0600); err != nil {
+		return cli.MessageAndError("Error writing report", err)
+	}
+	cmd.Printf("Report written to %s\n", outputFlag)
+	return nil
+}
+
+func init() {
+	app.RootCmd.AddCommand(reportCmd)
+
+	formats := make([]string, 0, len(reportFormats))
+	for f := range reportFormats {
+		formats = append(formats, f)
+	}
+	slices.Sort(formats)
+
+	// Flags
+	reportCmd.Flags().StringP("project", "j", "", "ID of the project")
+	reportCmd.Flags().StringP("format", "f", "sarif",
+		fmt.Sprintf("Report format (one of %s)", strings.Join(formats, ",")))
+	reportCmd.Flags().StringP("output", "o", "-", "Output file (or stdout)")
+	reportCmd.Flags().StringP("profile", "p", "", "Only report on the profile with this name")
+	reportCmd.Flags().StringP("label-filter", "l", "", "Only report on profiles matching this label filter")
+	reportCmd.MarkFlagsMutuallyExclusive("profile", "label-filter")
+}
@@ -13,14 +13,19 @@ import (
 	_ "github.com/mindersec/minder/cmd/cli/app/datasource"
 	_ "github.com/mindersec/minder/cmd/cli/app/docs"
 	_ "github.com/mindersec/minder/cmd/cli/app/entity"
+	_ "github.com/mindersec/minder/cmd/cli/app/exemption"
 	_ "github.com/mindersec/minder/cmd/cli/app/history"
 	_ "github.com/mindersec/minder/cmd/cli/app/profile"
 	_ "github.com/mindersec/minder/cmd/cli/app/profile/status"
 	_ "github.com/mindersec/minder/cmd/cli/app/project"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/alertwebhook"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/bundle"
+	_ "github.com/mindersec/minder/cmd/cli/app/project/projectsync"
 	_ "github.com/mindersec/minder/cmd/cli/app/project/role"
 	_ "github.com/mindersec/minder/cmd/cli/app/provider"
 	_ "github.com/mindersec/minder/cmd/cli/app/quickstart"
 	_ "github.com/mindersec/minder/cmd/cli/app/repo"
+	_ "github.com/mindersec/minder/cmd/cli/app/report"
 	_ "github.com/mindersec/minder/cmd/cli/app/ruletype"
 	_ "github.com/mindersec/minder/cmd/cli/app/set_project"
 	_ "github.com/mindersec/minder/cmd/cli/app/version"
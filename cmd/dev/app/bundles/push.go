@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundles
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/internal/marketplaces/registries"
+)
+
+// CmdPush is the push command
+func CmdPush() *cobra.Command {
+	var pushCmd = &cobra.Command{
+		Use:   "push bundle repository",
+		Short: "push a mindpak bundle to an OCI registry",
+		Args:  cobra.ExactArgs(2),
+		Long: `
+The 'bundle push' subcommand allows you to push a mindpak bundle built by 'bundle build'
+to an OCI repository, tagged with the version of the bundle. The credentials of the
+docker configuration are used to push.
+
+The reference printed by digest is the one to sign, e.g. with 'cosign sign', so that
+Minder servers which verify the bundles of the repository accept it.
+
+Arguments:
+
+bundle: Path to the bundle tar built by 'bundle build'
+repository: OCI repository holding the versions of the bundle, e.g. ghcr.io/acme/mindpaks/baseline
+`,
+		RunE:         pushCmdRun,
+		SilenceUsage: true,
+	}
+	return pushCmd
+}
+
+func pushCmdRun(cmd *cobra.Command, args []string) error {
+	content, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return fmt.Errorf("error reading bundle: %w", err)
+	}
+
+	ref, err := registries.PushOCI(cmd.Context(), args[1], content)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(ref)
+	return nil
+}
@@ -5,20 +5,27 @@ package rule_type
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
-	"strings"
+	"sort"
 
 	"github.com/spf13/cobra"
 	"github.com/styrainc/regal/pkg/linter"
+	"github.com/styrainc/regal/pkg/report"
 	"github.com/styrainc/regal/pkg/rules"
 	"gopkg.in/yaml.v3"
 
 	"github.com/mindersec/minder/internal/engine/eval/rego"
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles"
+)
+
+const (
+	lintOutputText = "text"
+	lintOutputJSON = "json"
 )
 
 // CmdLint is the command for linting a rule type definition
@@ -32,6 +39,9 @@ func CmdLint() *cobra.Command {
 	}
 	lintCmd.Flags().StringP("rule-type", "r", "", "file to read rule type definition from")
 	lintCmd.Flags().BoolP("skip-rego", "s", false, "skip rego rule validation")
+	lintCmd.Flags().StringArrayP("profile", "p", []string{},
+		"profile whose rules and selectors are checked against the rule types (optional)")
+	lintCmd.Flags().StringP("output", "o", lintOutputText, "output format, one of text or json")
 
 	if err := lintCmd.MarkFlagRequired("rule-type"); err != nil {
 		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
@@ -44,6 +54,15 @@ func CmdLint() *cobra.Command {
 func lintCmdRun(cmd *cobra.Command, _ []string) error {
 	rtpath := cmd.Flag("rule-type")
 	skipRego := cmd.Flag("skip-rego").Value.String() == "true"
+	output := cmd.Flag("output").Value.String()
+	if output != lintOutputText && output != lintOutputJSON {
+		return fmt.Errorf("unknown output format %q", output)
+	}
+
+	profilePaths, err := cmd.Flags().GetStringArray("profile")
+	if err != nil {
+		return fmt.Errorf("error getting profile files: %w", err)
+	}
 
 	ctx := cmd.Context()
 	rtpathStr := rtpath.Value.String()
@@ -53,7 +72,17 @@ func lintCmdRun(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("error expanding file args: %w", err)
 	}
 
-	var errors []error
+	profs := make([]*lintedProfile, 0, len(profilePaths))
+	for _, p := range profilePaths {
+		prof, err := profiles.ReadProfileFromFile(p)
+		if err != nil {
+			return fmt.Errorf("error reading profile from file %s: %w", p, err)
+		}
+		root, _ := readYAMLNode(p)
+		profs = append(profs, &lintedProfile{path: p, profile: prof, root: root})
+	}
+
+	var problems []lintProblem
 	for _, f := range files {
 		if shouldSkipFile(f.Path) {
 			continue
@@ -65,36 +94,82 @@ func lintCmdRun(cmd *cobra.Command, _ []string) error {
 			continue
 		}
 		if err != nil {
-			errors = append(errors, fmt.Errorf("error reading rule type from file %s: %w", f.Path, err))
+			problems = append(problems, lintProblem{
+				File:     f.Path,
+				Severity: severityError,
+				Check:    "parse",
+				Message:  fmt.Sprintf("error reading rule type: %s", err),
+			})
 			continue
 		}
 
-		if err := rt.Validate(); err != nil {
-			errors = append(errors, fmt.Errorf("error validating rule type from file %s: %w", f.Path, err))
-			continue
-		}
-		// get file name without extension
-		ruleName := strings.TrimSuffix(filepath.Base(f.Path), filepath.Ext(f.Path))
-		if rt.Name != ruleName {
-			errors = append(errors, fmt.Errorf("rule type name does not match file name: %s != %s", rt.Name, ruleName))
+		root, lines := readYAMLNode(f.Path)
+		l := &ruleTypeLinter{path: f.Path, root: root, lines: lines, ruleType: rt}
+		if !l.lint(ctx) {
+			problems = append(problems, l.problems...)
 			continue
 		}
 
 		if rt.Def.Eval.Type == rego.RegoEvalType && !skipRego {
-			if err := validateRegoRule(ctx, rt.Def.Eval.Rego, rtpathStr, cmd.OutOrStdout()); err != nil {
-				errors = append(errors, fmt.Errorf("failed validating rego rule from file %s: %w", f.Path, err))
-				continue
+			res, err := lintRegoRule(ctx, rt.Def.Eval.Rego, f.Path)
+			if err != nil {
+				l.reportRegoError(err)
+			} else if output == lintOutputJSON {
+				l.reportRegoViolations(res)
+			} else if err := yaml.NewEncoder(cmd.OutOrStdout()).Encode(res); err != nil {
+				return fmt.Errorf("failed writing lint results: %w", err)
 			}
 		}
+
+		for _, prof := range profs {
+			l.lintProfile(prof)
+		}
+		problems = append(problems, l.problems...)
 	}
 
-	if len(errors) > 0 {
-		for _, err := range errors {
-			cmd.PrintErrf("%s\n", err)
+	for _, prof := range profs {
+		problems = append(problems, prof.lintSelectors()...)
+	}
+
+	// problems are reported in the order they appear in each file, keeping
+	// the files in the order they were linted
+	fileOrder := make(map[string]int)
+	for _, p := range problems {
+		if _, ok := fileOrder[p.File]; !ok {
+			fileOrder[p.File] = len(fileOrder)
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		a, b := problems[i], problems[j]
+		if a.File != b.File {
+			return fileOrder[a.File] < fileOrder[b.File]
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	if output == lintOutputJSON {
+		if problems == nil {
+			problems = []lintProblem{}
+		}
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(problems); err != nil {
+			return fmt.Errorf("failed writing lint results: %w", err)
+		}
+	} else {
+		for _, p := range problems {
+			cmd.PrintErrf("%s\n", p)
 		}
-		return fmt.Errorf("failed linting rule type")
 	}
 
+	for _, p := range problems {
+		if p.Severity == severityError {
+			return fmt.Errorf("failed linting rule type")
+		}
+	}
 	return nil
 }
 
@@ -111,30 +186,26 @@ func shouldSkipFile(f string) bool {
 	}
 }
 
-func validateRegoRule(ctx context.Context, r *minderv1.RuleType_Definition_Eval_Rego, path string, out io.Writer) error {
+func lintRegoRule(ctx context.Context, r *minderv1.RuleType_Definition_Eval_Rego, path string) (report.Report, error) {
 	if r == nil {
-		return fmt.Errorf("rego rule is nil")
+		return report.Report{}, fmt.Errorf("rego rule is nil")
 	}
 
 	if r.Def == "" {
-		return fmt.Errorf("rego rule definition is empty")
+		return report.Report{}, fmt.Errorf("rego rule definition is empty")
 	}
 
 	inputs, err := rules.InputFromText(path, r.Def)
 	if err != nil {
-		return fmt.Errorf("failed parsing rego rule: %w", err)
+		return report.Report{}, fmt.Errorf("failed parsing rego rule: %w", err)
 	}
 
 	lint := linter.NewLinter().WithInputModules(&inputs)
 
 	res, err := lint.Lint(ctx)
 	if err != nil {
-		return fmt.Errorf("failed linting rego rule: %w", err)
+		return report.Report{}, fmt.Errorf("failed linting rego rule: %w", err)
 	}
 
-	if err := yaml.NewEncoder(out).Encode(res); err != nil {
-		return fmt.Errorf("failed writing lint results: %w", err)
-	}
-
-	return nil
+	return res, nil
 }
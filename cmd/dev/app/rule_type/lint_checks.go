@@ -0,0 +1,452 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/itchyny/gojq"
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/styrainc/regal/pkg/report"
+	"gopkg.in/yaml.v3"
+
+	"github.com/mindersec/minder/internal/engine/actions/alert/security_advisory"
+	"github.com/mindersec/minder/internal/engine/actions/remediate/pull_request"
+	"github.com/mindersec/minder/internal/engine/eval/cel"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/profiles"
+)
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+var regoDefPath = []string{"def", "eval", "rego", "def"}
+
+// lintProblem is a problem found in a file. Line and column are 1-based,
+// and left out when the position of the problem isn't known.
+type lintProblem struct {
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Severity string `json:"severity"`
+	// Check is the kind of check which found the problem, e.g. jq or rego
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+func (p lintProblem) String() string {
+	pos := p.File
+	if p.Line > 0 {
+		pos = fmt.Sprintf("%s:%d:%d", pos, p.Line, p.Column)
+	}
+	return fmt.Sprintf("%s: %s: [%s] %s", pos, p.Severity, p.Check, p.Message)
+}
+
+// ruleTypeLinter gathers the problems found in a rule type file
+type ruleTypeLinter struct {
+	path string
+	root *yaml.Node
+	// lines of the file, to find the indentation of block scalars
+	lines    []string
+	ruleType *minderv1.RuleType
+	problems []lintProblem
+}
+
+// report adds a problem found at the given path in the rule type, e.g.
+// "def", "eval", "jq", "0". If the path is not in the file, the problem is
+// reported at its closest ancestor.
+func (l *ruleTypeLinter) report(severity, check string, err error, path ...string) {
+	line, col := yamlPosition(l.root, path...)
+	l.problems = append(l.problems, lintProblem{
+		File:     l.path,
+		Line:     line,
+		Column:   col,
+		Severity: severity,
+		Check:    check,
+		Message:  err.Error(),
+	})
+}
+
+// lint runs the checks which don't depend on the evaluation engine. It
+// returns false if the rule type is too broken for further checks.
+func (l *ruleTypeLinter) lint(ctx context.Context) bool {
+	rt := l.ruleType
+
+	// these checks report the position of the problem, so they run before
+	// the validation of the whole rule type, which would find some of them
+	before := len(l.problems)
+	l.lintSchemas()
+	l.lintJQ()
+	l.lintCEL()
+	if err := rt.Validate(); err != nil {
+		if len(l.problems) == before {
+			l.report(severityError, "validate", err)
+		}
+		return false
+	}
+
+	// get file name without extension
+	ruleName := strings.TrimSuffix(filepath.Base(l.path), filepath.Ext(l.path))
+	if rt.Name != ruleName {
+		l.report(severityError, "name",
+			fmt.Errorf("rule type name does not match file name: %s != %s", rt.Name, ruleName), "name")
+	}
+
+	l.lintTemplates(ctx)
+	return true
+}
+
+func (l *ruleTypeLinter) lintSchemas() {
+	def := l.ruleType.GetDef()
+	if def.GetRuleSchema() != nil {
+		if _, err := schemavalidate.CompileSchemaFromPB(def.GetRuleSchema()); err != nil {
+			l.report(severityError, "schema", fmt.Errorf("invalid rule schema: %w", err), "def", "rule_schema")
+		}
+	}
+	if _, err := schemavalidate.CompileSchemaFromPB(def.GetParamSchema()); err != nil {
+		l.report(severityError, "schema", fmt.Errorf("invalid param schema: %w", err), "def", "param_schema")
+	}
+}
+
+func (l *ruleTypeLinter) lintJQ() {
+	if l.ruleType.GetDef().GetEval().GetType() != "jq" {
+		return
+	}
+
+	for i, a := range l.ruleType.GetDef().GetEval().GetJq() {
+		idx := strconv.Itoa(i)
+		if err := compileJQ(a.GetIngested().GetDef()); err != nil {
+			l.report(severityError, "jq", err, "def", "eval", "jq", idx, "ingested", "def")
+		}
+		if a.GetProfile() != nil {
+			if err := compileJQ(a.GetProfile().GetDef()); err != nil {
+				l.report(severityError, "jq", err, "def", "eval", "jq", idx, "profile", "def")
+			}
+		}
+	}
+}
+
+func compileJQ(expr string) error {
+	q, err := gojq.Parse(expr)
+	if err != nil {
+		return fmt.Errorf("cannot parse jq expression: %w", err)
+	}
+	if _, err := gojq.Compile(q); err != nil {
+		return fmt.Errorf("cannot compile jq expression: %w", err)
+	}
+	return nil
+}
+
+func (l *ruleTypeLinter) lintCEL() {
+	cfg := l.ruleType.GetDef().GetEval().GetCel()
+	if l.ruleType.GetDef().GetEval().GetType() != cel.CELEvalType || cfg == nil {
+		return
+	}
+
+	// compile the expressions separately, to report each at its position
+	if _, err := cel.NewCELEvaluator(&minderv1.RuleType_Definition_Eval_CEL{Def: cfg.GetDef()}); err != nil {
+		l.report(severityError, "cel", err, "def", "eval", "cel", "def")
+	}
+	if cfg.GetFailureMessage() != "" {
+		if _, err := cel.NewCELEvaluator(&minderv1.RuleType_Definition_Eval_CEL{
+			Def:            "true",
+			FailureMessage: cfg.FailureMessage,
+		}); err != nil {
+			l.report(severityError, "cel", err, "def", "eval", "cel", "failure_message")
+		}
+	}
+}
+
+// lintTemplates renders the templates of the alert and remediation with
+// sample data
+func (l *ruleTypeLinter) lintTemplates(ctx context.Context) {
+	def := l.ruleType.GetDef()
+
+	if def.GetAlert().GetType() == security_advisory.AlertType {
+		if err := security_advisory.CheckTemplates(l.ruleType); err != nil {
+			l.report(severityError, "security_advisory", err, "short_failure_message")
+		}
+	}
+
+	if def.GetRemediate().GetType() == pull_request.RemediateType && def.GetRemediate().GetPullRequest() != nil {
+		data := &pull_request.PrTemplateParams{
+			Entity:  sampleRepository(),
+			Profile: sampleFromSchema(def.GetRuleSchema().AsMap()),
+			Params:  sampleFromSchema(def.GetParamSchema().AsMap()),
+		}
+		for _, problem := range pull_request.CheckTemplates(ctx, def.GetRemediate().GetPullRequest(), data) {
+			path := append([]string{"def", "remediate", "pull_request"}, fieldPath(problem.Field)...)
+			l.report(severityError, "pull_request", problem.Err, path...)
+		}
+	}
+}
+
+// reportRegoViolations reports the violations found by the Rego linter.
+// They are warnings, as they don't prevent the rule type from working.
+func (l *ruleTypeLinter) reportRegoViolations(res report.Report) {
+	startLine, col := l.regoDefStart()
+	for _, v := range res.Violations {
+		line := 0
+		if startLine > 0 {
+			line = startLine + v.Location.Row - 1
+		}
+		l.problems = append(l.problems, lintProblem{
+			File:     l.path,
+			Line:     line,
+			Column:   col + v.Location.Column - 1,
+			Severity: severityWarning,
+			Check:    "rego",
+			Message:  fmt.Sprintf("%s: %s", v.Title, v.Description),
+		})
+	}
+}
+
+// reportRegoError reports an error linting the Rego definition, at the
+// positions of the parse errors if there are any
+func (l *ruleTypeLinter) reportRegoError(err error) {
+	var parseErrs ast.Errors
+	startLine, col := l.regoDefStart()
+	if !errors.As(err, &parseErrs) || startLine == 0 {
+		l.report(severityError, "rego", err, regoDefPath...)
+		return
+	}
+
+	for _, e := range parseErrs {
+		p := lintProblem{
+			File:     l.path,
+			Line:     startLine,
+			Column:   col,
+			Severity: severityError,
+			Check:    "rego",
+			Message:  e.Message,
+		}
+		if e.Location != nil {
+			p.Line = startLine + e.Location.Row - 1
+			p.Column = col + e.Location.Col - 1
+		}
+		l.problems = append(l.problems, p)
+	}
+}
+
+// regoDefStart returns the position of the first line of the Rego
+// definition in the file
+func (l *ruleTypeLinter) regoDefStart() (int, int) {
+	node := yamlNode(l.root, regoDefPath...)
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return 0, 0
+	}
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		// block scalars start on the line after the indicator
+		line := node.Line + 1
+		if line > len(l.lines) {
+			return 0, 0
+		}
+		text := l.lines[line-1]
+		return line, len(text) - len(strings.TrimLeft(text, " ")) + 1
+	}
+	return node.Line, node.Column
+}
+
+// lintedProfile is a profile whose rules are checked against the rule types
+type lintedProfile struct {
+	path    string
+	root    *yaml.Node
+	profile *minderv1.Profile
+}
+
+// lintProfile validates the definitions and parameters of the profile's
+// rules of this rule type against the rule type's schemas
+func (l *ruleTypeLinter) lintProfile(prof *lintedProfile) {
+	rt := l.ruleType
+	validator, err := profiles.NewRuleValidator(rt)
+	if err != nil {
+		// reported by the schema checks
+		return
+	}
+
+	entity := rt.GetDef().GetInEntity()
+	rules, err := profiles.GetRulesForEntity(prof.profile, minderv1.EntityFromString(entity))
+	if err != nil {
+		return
+	}
+
+	for i, rule := range rules {
+		if rule.GetType() != rt.GetName() {
+			continue
+		}
+
+		idx := strconv.Itoa(i)
+		if err := validator.ValidateRuleDefAgainstSchema(rule.GetDef().AsMap()); err != nil {
+			l.problems = append(l.problems, prof.problem("schema", err, entity, idx, "def"))
+		}
+		if err := validator.ValidateParamsAgainstSchema(rule.GetParams().AsMap()); err != nil {
+			l.problems = append(l.problems, prof.problem("schema", err, entity, idx, "params"))
+		}
+	}
+}
+
+// lintSelectors compiles the CEL selectors of the profile
+func (prof *lintedProfile) lintSelectors() []lintProblem {
+	var problems []lintProblem
+	env := selectors.NewEnv()
+	for i, sel := range prof.profile.GetSelection() {
+		if err := env.CheckSelector(sel); err != nil {
+			problems = append(problems, prof.problem("selector", err, "selection", strconv.Itoa(i), "selector"))
+		}
+	}
+	return problems
+}
+
+func (prof *lintedProfile) problem(check string, err error, path ...string) lintProblem {
+	line, col := yamlPosition(prof.root, path...)
+	return lintProblem{
+		File:     prof.path,
+		Line:     line,
+		Column:   col,
+		Severity: severityError,
+		Check:    check,
+		Message:  err.Error(),
+	}
+}
+
+// readYAMLNode reads the file as a YAML node, to find the position of the
+// problems in it, along with its lines. JSON files are YAML as well.
+func readYAMLNode(path string) (*yaml.Node, []string) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, nil
+	}
+
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(data, root); err != nil {
+		return nil, nil
+	}
+	return root, strings.Split(string(data), "\n")
+}
+
+// yamlPosition returns the position of the value at the path, or of its
+// closest ancestor in the document
+func yamlPosition(root *yaml.Node, path ...string) (int, int) {
+	if root == nil {
+		return 0, 0
+	}
+
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	for _, elem := range path {
+		next := childNode(node, elem)
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return node.Line, node.Column
+}
+
+// yamlNode returns the node at the path, or nil
+func yamlNode(root *yaml.Node, path ...string) *yaml.Node {
+	if root == nil {
+		return nil
+	}
+
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	for _, elem := range path {
+		node = childNode(node, elem)
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func childNode(node *yaml.Node, elem string) *yaml.Node {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == elem {
+				return node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		idx, err := strconv.Atoi(elem)
+		if err == nil && idx >= 0 && idx < len(node.Content) {
+			return node.Content[idx]
+		}
+	}
+	return nil
+}
+
+// fieldPath splits a field such as contents[0].path into path elements
+func fieldPath(field string) []string {
+	field = strings.ReplaceAll(field, "[", ".")
+	field = strings.ReplaceAll(field, "]", "")
+	return strings.Split(field, ".")
+}
+
+// sampleFromSchema returns a value which conforms to the schema, using the
+// defaults and examples of the schema when there are any
+func sampleFromSchema(schema map[string]any) map[string]any {
+	out := map[string]any{}
+	props, _ := schema["properties"].(map[string]any)
+	for name, p := range props {
+		if prop, ok := p.(map[string]any); ok {
+			out[name] = sampleValue(prop)
+		}
+	}
+	return out
+}
+
+func sampleValue(schema map[string]any) any {
+	if def, ok := schema["default"]; ok {
+		return def
+	}
+	if examples, ok := schema["examples"].([]any); ok && len(examples) > 0 {
+		return examples[0]
+	}
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 {
+		return enum[0]
+	}
+
+	switch schema["type"] {
+	case "object":
+		return sampleFromSchema(schema)
+	case "array":
+		items, ok := schema["items"].(map[string]any)
+		if !ok {
+			return []any{}
+		}
+		return []any{sampleValue(items)}
+	case "integer", "number":
+		return float64(1)
+	case "boolean":
+		return true
+	default:
+		return "sample"
+	}
+}
+
+func sampleRepository() *minderv1.Repository {
+	return &minderv1.Repository{
+		Owner:         "owner",
+		Name:          "repo",
+		RepoId:        1,
+		CloneUrl:      "https://github.com/owner/repo.git",
+		DefaultBranch: "main",
+	}
+}
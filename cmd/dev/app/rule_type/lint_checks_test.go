@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLintChecks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		file string
+		// wantProblem is the only problem expected, if any. Its message
+		// only needs to be contained in the reported one.
+		wantProblem *lintProblem
+	}{
+		{
+			name: "broken jq expression",
+			file: "testdata/lint/broken_jq.yaml",
+			wantProblem: &lintProblem{
+				Line:     27,
+				Column:   16,
+				Severity: severityError,
+				Check:    "jq",
+				Message:  "cannot parse jq expression: unexpected EOF",
+			},
+		},
+		{
+			name: "bad param schema",
+			file: "testdata/lint/bad_param_schema.yaml",
+			wantProblem: &lintProblem{
+				Line:     15,
+				Column:   5,
+				Severity: severityError,
+				Check:    "schema",
+				Message:  "at '/properties/branch/type': value must be one of",
+			},
+		},
+		{
+			name: "bad yq patch",
+			file: "testdata/lint/bad_yq_patch.yaml",
+			wantProblem: &lintProblem{
+				Line:     30,
+				Column:   21,
+				Severity: severityError,
+				Check:    "pull_request",
+				Message:  "cannot parse expression: bad expression, could not find matching `}`",
+			},
+		},
+		{
+			name: "valid rule type",
+			file: "testdata/lint/valid.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := CmdLint()
+			var stdout, stderr bytes.Buffer
+			cmd.SetOut(&stdout)
+			cmd.SetErr(&stderr)
+			cmd.SetArgs([]string{"-r", tt.file, "-o", "json"})
+
+			err := cmd.Execute()
+			if tt.wantProblem != nil {
+				require.ErrorContains(t, err, "failed linting rule type")
+			} else {
+				require.NoError(t, err)
+			}
+
+			// the problems are reported as an array of objects, with
+			// exactly these fields
+			var raw []map[string]any
+			require.NoError(t, json.Unmarshal(stdout.Bytes(), &raw))
+			if tt.wantProblem == nil {
+				require.Equal(t, "[]\n", stdout.String())
+				return
+			}
+			require.Len(t, raw, 1)
+			require.ElementsMatch(t,
+				[]string{"file", "line", "column", "severity", "check", "message"},
+				keys(raw[0]))
+
+			var problems []lintProblem
+			require.NoError(t, json.Unmarshal(stdout.Bytes(), &problems))
+			got := problems[0]
+			require.Equal(t, tt.file, got.File)
+			require.Equal(t, tt.wantProblem.Line, got.Line, "line")
+			require.Equal(t, tt.wantProblem.Column, got.Column, "column")
+			require.Equal(t, tt.wantProblem.Severity, got.Severity)
+			require.Equal(t, tt.wantProblem.Check, got.Check)
+			require.Contains(t, got.Message, tt.wantProblem.Message)
+		})
+	}
+}
+
+func TestLintProblemString(t *testing.T) {
+	t.Parallel()
+
+	p := lintProblem{File: "rule.yaml", Line: 3, Column: 7, Severity: severityError, Check: "jq", Message: "boom"}
+	require.Equal(t, "rule.yaml:3:7: error: [jq] boom", p.String())
+
+	// problems without a position are reported at the file, and leave the
+	// position out of the JSON output
+	p.Line, p.Column = 0, 0
+	require.Equal(t, "rule.yaml: error: [jq] boom", p.String())
+	out, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"file":"rule.yaml","severity":"error","check":"jq","message":"boom"}`, string(out))
+}
+
+func TestYAMLPosition(t *testing.T) {
+	t.Parallel()
+
+	root := &yaml.Node{}
+	require.NoError(t, yaml.Unmarshal([]byte("def:\n  eval:\n    jq:\n      - ingested:\n          def: .a\n"), root))
+
+	tests := []struct {
+		name     string
+		path     []string
+		wantLine int
+		wantCol  int
+	}{
+		{name: "value", path: []string{"def", "eval", "jq", "0", "ingested", "def"}, wantLine: 5, wantCol: 16},
+		{name: "sequence element", path: []string{"def", "eval", "jq", "0"}, wantLine: 4, wantCol: 9},
+		{name: "missing key", path: []string{"def", "eval", "jq", "0", "profile", "def"}, wantLine: 4, wantCol: 9},
+		{name: "index out of range", path: []string{"def", "eval", "jq", "1"}, wantLine: 4, wantCol: 7},
+		{name: "document", wantLine: 1, wantCol: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			line, col := yamlPosition(root, tt.path...)
+			require.Equal(t, tt.wantLine, line, "line")
+			require.Equal(t, tt.wantCol, col, "column")
+		})
+	}
+}
+
+func keys(m map[string]any) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
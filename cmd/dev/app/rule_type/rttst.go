@@ -25,8 +25,10 @@ import (
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
 	"github.com/mindersec/minder/internal/engine/options"
 	entModels "github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/providers/bitbucket"
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
+	"github.com/mindersec/minder/internal/providers/gitea"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/github/properties"
 	"github.com/mindersec/minder/internal/providers/gitlab"
@@ -44,6 +46,7 @@ import (
 	"github.com/mindersec/minder/pkg/profiles"
 	"github.com/mindersec/minder/pkg/profiles/models"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
 )
 
 // CmdTest is the root command for the rule subcommands
@@ -68,15 +71,19 @@ func CmdTest() *cobra.Command {
 		"Can also be set via the TEST_AUTH_TOKEN environment variable.")
 	testCmd.Flags().StringArrayP("data-source", "d", []string{}, "YAML file containing the data source to test the rule with")
 
-	if err := testCmd.MarkFlagRequired("rule-type"); err != nil {
-		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
-		os.Exit(1)
-	}
+	testCmd.Flags().StringP("suite", "s", "", "YAML file containing a test suite to run instead of a single evaluation")
+	testCmd.Flags().String("junit", "", "file to write the JUnit XML report of the test suite to, or - for stdout")
 
-	if err := testCmd.MarkFlagRequired("entity"); err != nil {
-		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
-		os.Exit(1)
-	}
+	testCmd.Flags().String("record", "", "file to record the HTTP interactions with the provider to")
+	testCmd.Flags().String("replay", "", "file containing recorded HTTP interactions to serve instead of calling the provider")
+
+	// a test suite names its own rule type and entities
+	testCmd.MarkFlagsOneRequired("rule-type", "suite")
+	testCmd.MarkFlagsMutuallyExclusive("rule-type", "suite")
+	testCmd.MarkFlagsMutuallyExclusive("entity", "suite")
+	testCmd.MarkFlagsMutuallyExclusive("profile", "suite")
+	testCmd.MarkFlagsMutuallyExclusive("data-source", "suite")
+	testCmd.MarkFlagsMutuallyExclusive("record", "replay", "suite")
 
 	if err := viper.BindPFlag("test.auth.token", testCmd.Flags().Lookup("token")); err != nil {
 		fmt.Fprintf(os.Stderr, "Error binding flag: %s\n", err)
@@ -90,6 +97,16 @@ func CmdTest() *cobra.Command {
 
 //nolint:gocyclo // this function is a cobra command and is expected to be complex
 func testCmdRun(cmd *cobra.Command, _ []string) error {
+	if suitePath := cmd.Flag("suite").Value.String(); suitePath != "" {
+		return suiteCmdRun(cmd, suitePath)
+	}
+	if cmd.Flag("junit").Value.String() != "" {
+		return fmt.Errorf("--junit can only be used with --suite")
+	}
+	if cmd.Flag("entity").Value.String() == "" {
+		return fmt.Errorf("required flag \"entity\" not set")
+	}
+
 	rtpath := cmd.Flag("rule-type")
 	epath := cmd.Flag("entity")
 	ppath := cmd.Flag("profile")
@@ -190,7 +207,15 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 		Alert:     actionOptFromString(profile.Alert, models.ActionOptOff),
 	}
 
-	dsRegistry, err := getDataSources(dataSourcefiles, prov)
+	// the HTTP interactions of the rule type may be recorded or replayed,
+	// but the entity is still converted by the real provider
+	engineProv, saveCassette, err := cassetteProvider(
+		prov, cmd.Flag("record").Value.String(), cmd.Flag("replay").Value.String())
+	if err != nil {
+		return err
+	}
+
+	dsRegistry, err := getDataSources(dataSourcefiles, engineProv)
 	if err != nil {
 		return fmt.Errorf("error getting data sources: %w", err)
 	}
@@ -198,11 +223,11 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 	// TODO: use cobra context here
 	ctx := context.Background()
 	// TODO: accomodate flags here or enable them all
-	eng, err := rtengine.NewRuleTypeEngine(ctx, ruletype, prov, options.WithDataSources(dsRegistry))
+	eng, err := rtengine.NewRuleTypeEngine(ctx, ruletype, engineProv, options.WithDataSources(dsRegistry))
 	if err != nil {
 		return fmt.Errorf("cannot create rule type engine: %w", err)
 	}
-	actionEngine, err := actions.NewRuleActions(ctx, ruletype, prov, &actionConfig)
+	actionEngine, err := actions.NewRuleActions(ctx, ruletype, prov, &actionConfig, nil)
 	if err != nil {
 		return fmt.Errorf("cannot create rule actions engine: %w", err)
 	}
@@ -212,7 +237,45 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("error creating selectors: %w", err)
 	}
 
-	return runEvaluationForRules(cmd, eng, ewp, profSel, remediateStatus, remMetadata, rules, actionEngine, prov)
+	evalErr := runEvaluationForRules(cmd, eng, ewp, profSel, remediateStatus, remMetadata, rules, actionEngine, prov)
+	if err := saveCassette(); err != nil {
+		return err
+	}
+	return evalErr
+}
+
+// cassetteProvider returns the provider the rule type engine should use.
+// When recording, this is a testkit proxying the HTTP requests to the
+// provider, and the returned function saves the recorded interactions.
+// When replaying, this is a testkit serving the recorded interactions.
+func cassetteProvider(
+	prov provifv1.Provider, recordPath, replayPath string,
+) (provifv1.Provider, func() error, error) {
+	noop := func() error { return nil }
+
+	switch {
+	case recordPath != "":
+		rest, err := provifv1.As[provifv1.REST](prov)
+		if err != nil {
+			return nil, nil, fmt.Errorf("provider can't be recorded: %w", err)
+		}
+		cassette := tkv1.NewCassette()
+		save := func() error {
+			if err := cassette.Save(recordPath); err != nil {
+				return fmt.Errorf("error saving recorded interactions: %w", err)
+			}
+			return nil
+		}
+		return tkv1.NewTestKit(tkv1.WithRecorder(rest, cassette)), save, nil
+	case replayPath != "":
+		cassette, err := tkv1.LoadCassette(replayPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		return tkv1.NewTestKit(tkv1.WithCassette(cassette)), noop, nil
+	default:
+		return prov, noop, nil
+	}
 }
 
 func getProfileSelectors(entType minderv1.Entity, profile *minderv1.Profile) (selectors.Selection, error) {
@@ -461,6 +524,32 @@ func getProvider(pstr string, token string, providerConfigFile string) (provifv1
 			return nil, fmt.Errorf("error instantiating gitlab provider: %w", err)
 		}
 		return client, nil
+	case "bitbucket":
+		// read provider config
+		cfg, err := bitbucket.ParseV1Config(cfgbytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing bitbucket provider config: %w", err)
+		}
+
+		// We may pass a "fake" webhook URL here as it is not used in the test
+		client, err := bitbucket.New(credentials.NewBitbucketTokenCredential(token), cfg, "fake", "fake")
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating bitbucket provider: %w", err)
+		}
+		return client, nil
+	case "gitea":
+		// read provider config
+		cfg, err := gitea.ParseV1Config(cfgbytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing gitea provider config: %w", err)
+		}
+
+		// We may pass a "fake" webhook URL here as it is not used in the test
+		client, err := gitea.New(credentials.NewGiteaTokenCredential(token), cfg, "fake", "fake")
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating gitea provider: %w", err)
+		}
+		return client, nil
 	default:
 		return nil, fmt.Errorf("unknown or unsupported provider: %s", pstr)
 	}
@@ -503,7 +592,16 @@ func actionOptFromString(s *string, defAction models.ActionOpt) models.ActionOpt
 }
 
 func getDataSources(readers []*os.File, provider provifv1.Provider) (*v1datasources.DataSourceRegistry, error) {
-	reg := v1datasources.NewDataSourceRegistry()
+	dss, err := readDataSources(readers)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildDataSourceRegistry(dss, provider)
+}
+
+func readDataSources(readers []*os.File) ([]*minderv1.DataSource, error) {
+	dss := make([]*minderv1.DataSource, 0, len(readers))
 	for _, r := range readers {
 		fname := r.Name()
 		ds := &minderv1.DataSource{}
@@ -515,13 +613,24 @@ func getDataSources(readers []*os.File, provider provifv1.Provider) (*v1datasour
 			return nil, fmt.Errorf("error validating data source %s: %w", fname, err)
 		}
 
+		dss = append(dss, ds)
+	}
+
+	return dss, nil
+}
+
+func buildDataSourceRegistry(
+	dss []*minderv1.DataSource, provider provifv1.Provider,
+) (*v1datasources.DataSourceRegistry, error) {
+	reg := v1datasources.NewDataSourceRegistry()
+	for _, ds := range dss {
 		intds, err := internalds.BuildFromProtobuf(ds, provider)
 		if err != nil {
-			return nil, fmt.Errorf("error building data source %s: %w", fname, err)
+			return nil, fmt.Errorf("error building data source %s: %w", ds.GetName(), err)
 		}
 
 		if err := reg.RegisterDataSource(ds.GetName(), intds); err != nil {
-			return nil, fmt.Errorf("error registering data source %s: %w", fname, err)
+			return nil, fmt.Errorf("error registering data source %s: %w", ds.GetName(), err)
 		}
 	}
 
@@ -0,0 +1,468 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/engine/options"
+	"github.com/mindersec/minder/internal/util/jsonyaml"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/engine/v1/rtengine"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
+)
+
+// testSuite is a set of test cases for a rule type. All the paths in the
+// suite are relative to the file it is read from.
+type testSuite struct {
+	// RuleType is the file containing the rule type under test
+	RuleType string `json:"rule_type"`
+	// DataSources are files containing the data sources used by the rule type
+	DataSources []string `json:"data_sources,omitempty"`
+	// Provider is the provider class the entities belong to. It defaults
+	// to the --provider flag.
+	Provider string `json:"provider,omitempty"`
+	// ProviderConfig is a file containing the provider configuration. It
+	// defaults to the --provider-config flag.
+	ProviderConfig string     `json:"provider_config,omitempty"`
+	Cases          []testCase `json:"cases"`
+}
+
+// testCase is the evaluation of the rule type against an entity, with
+// the outcome it is expected to have
+type testCase struct {
+	Name string `json:"name"`
+	// Entity is a file containing the properties of the entity
+	Entity string         `json:"entity"`
+	Def    map[string]any `json:"def,omitempty"`
+	Params map[string]any `json:"params,omitempty"`
+	// Git is a directory which is ingested instead of cloning the
+	// repository, for rule types using the git ingester
+	Git string `json:"git,omitempty"`
+	// HTTP is the response to every request made through the provider
+	HTTP *httpFixture `json:"http,omitempty"`
+	// Cassette is a file containing the HTTP interactions with the
+	// provider, as recorded by `mindev ruletype test --record`
+	Cassette string      `json:"cassette,omitempty"`
+	Expect   expectation `json:"expect"`
+}
+
+type httpFixture struct {
+	Status int    `json:"status,omitempty"`
+	Body   string `json:"body,omitempty"`
+	// BodyFile is a file containing the body, as an alternative to Body
+	BodyFile string            `json:"body_file,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+}
+
+type expectation struct {
+	// Status is the evaluation status, and defaults to success
+	Status string `json:"status,omitempty"`
+	// Message must be contained in the details of the evaluation
+	Message string `json:"message,omitempty"`
+}
+
+var validEvalStatuses = []db.EvalStatusTypes{
+	db.EvalStatusTypesSuccess,
+	db.EvalStatusTypesFailure,
+	db.EvalStatusTypesError,
+	db.EvalStatusTypesSkipped,
+	db.EvalStatusTypesWaived,
+}
+
+// caseResult is the outcome of a test case. A case which could not be
+// evaluated at all (e.g. because its entity file is missing) has setupErr
+// set, while failure is set when the evaluation didn't go as expected.
+type caseResult struct {
+	name     string
+	status   db.EvalStatusTypes
+	details  string
+	failure  string
+	setupErr error
+	duration time.Duration
+}
+
+func (r *caseResult) passed() bool {
+	return r.setupErr == nil && r.failure == ""
+}
+
+func suiteCmdRun(cmd *cobra.Command, suitePath string) error {
+	suite, err := readTestSuite(suitePath)
+	if err != nil {
+		return fmt.Errorf("error reading test suite: %w", err)
+	}
+	baseDir := filepath.Dir(suitePath)
+
+	// set rego env variable for debugging
+	if err := os.Setenv(rego.EnablePrintEnvVar, "true"); err != nil {
+		cmd.Printf("Unable to set %s environment variable: %s\n", rego.EnablePrintEnvVar, err)
+	}
+
+	ruletype, err := readRuleTypeFromFile(resolvePath(baseDir, suite.RuleType))
+	if err != nil {
+		return fmt.Errorf("error reading rule type from file: %w", err)
+	}
+	provider := "test"
+	rootProject := "00000000-0000-0000-0000-000000000002"
+	ruletype.Context = &minderv1.Context{
+		Provider: &provider,
+		Project:  &rootProject,
+	}
+
+	dataSourceFiles, err := getDataSourceFiles(resolvePaths(baseDir, suite.DataSources))
+	if err != nil {
+		return fmt.Errorf("error getting data source files: %w", err)
+	}
+	dss, err := readDataSources(dataSourceFiles)
+	for _, f := range dataSourceFiles {
+		//nolint:gosec // we are closing the file
+		f.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("error getting data sources: %w", err)
+	}
+
+	providerClass := cmd.Flag("provider").Value.String()
+	if suite.Provider != "" {
+		providerClass = suite.Provider
+	}
+	providerConfig := cmd.Flag("provider-config").Value.String()
+	if suite.ProviderConfig != "" {
+		providerConfig = resolvePath(baseDir, suite.ProviderConfig)
+	}
+	prov, err := getProvider(providerClass, viper.GetString("test.auth.token"), providerConfig)
+	if err != nil {
+		return err
+	}
+
+	logConfig := serverconfig.LoggingConfig{Level: cmd.Flag("log-level").Value.String()}
+	ctx := serverconfig.LoggerFromConfigFlags(logConfig).WithContext(cmd.Context())
+
+	start := time.Now()
+	results := make([]*caseResult, len(suite.Cases))
+	var g errgroup.Group
+	g.SetLimit(runtime.NumCPU())
+	for i := range suite.Cases {
+		g.Go(func() error {
+			results[i] = runTestCase(ctx, ruletype, &suite.Cases[i], baseDir, dss, prov)
+			return nil
+		})
+	}
+	_ = g.Wait()
+	elapsed := time.Since(start)
+
+	failed := 0
+	for _, r := range results {
+		switch {
+		case r.setupErr != nil:
+			cmd.Printf("ERROR %s: %s\n", r.name, r.setupErr)
+		case r.failure != "":
+			cmd.Printf("FAIL  %s: %s\n", r.name, r.failure)
+		default:
+			cmd.Printf("PASS  %s\n", r.name)
+		}
+		if !r.passed() {
+			failed++
+		}
+	}
+	cmd.Printf("\n%d passed, %d failed\n", len(results)-failed, failed)
+
+	if junitPath := cmd.Flag("junit").Value.String(); junitPath != "" {
+		if err := writeJUnitReport(cmd.OutOrStdout(), junitPath, ruletype.GetName(), results, elapsed); err != nil {
+			return fmt.Errorf("error writing JUnit report: %w", err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d test cases failed", failed, len(results))
+	}
+	return nil
+}
+
+func readTestSuite(fpath string) (*testSuite, error) {
+	f, err := os.Open(filepath.Clean(fpath))
+	if err != nil {
+		return nil, fmt.Errorf("error opening file: %w", err)
+	}
+	defer f.Close()
+
+	w := &bytes.Buffer{}
+	if err := jsonyaml.TranscodeYAMLToJSON(f, w); err != nil {
+		return nil, fmt.Errorf("error converting yaml to json: %w", err)
+	}
+
+	suite := &testSuite{}
+	dec := json.NewDecoder(w)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(suite); err != nil {
+		return nil, fmt.Errorf("error decoding test suite: %w", err)
+	}
+
+	if suite.RuleType == "" {
+		return nil, fmt.Errorf("test suite must set rule_type")
+	}
+	if len(suite.Cases) == 0 {
+		return nil, fmt.Errorf("test suite has no cases")
+	}
+
+	names := make(map[string]struct{}, len(suite.Cases))
+	for i, tc := range suite.Cases {
+		if tc.Name == "" {
+			return nil, fmt.Errorf("case %d has no name", i)
+		}
+		if _, ok := names[tc.Name]; ok {
+			return nil, fmt.Errorf("duplicate case name %q", tc.Name)
+		}
+		names[tc.Name] = struct{}{}
+
+		if tc.Entity == "" {
+			return nil, fmt.Errorf("case %q has no entity", tc.Name)
+		}
+		fixtures := 0
+		for _, set := range []bool{tc.Git != "", tc.HTTP != nil, tc.Cassette != ""} {
+			if set {
+				fixtures++
+			}
+		}
+		if fixtures > 1 {
+			return nil, fmt.Errorf("case %q can only set one of git, http and cassette", tc.Name)
+		}
+		if tc.HTTP != nil && tc.HTTP.Body != "" && tc.HTTP.BodyFile != "" {
+			return nil, fmt.Errorf("case %q can't set both http.body and http.body_file", tc.Name)
+		}
+		if tc.Expect.Status != "" &&
+			!slices.Contains(validEvalStatuses, db.EvalStatusTypes(tc.Expect.Status)) {
+			return nil, fmt.Errorf("case %q expects unknown status %q", tc.Name, tc.Expect.Status)
+		}
+	}
+
+	return suite, nil
+}
+
+// runTestCase evaluates the rule type for a test case. The entity is
+// converted by the real provider, but the data is ingested from the
+// fixture of the case, if any.
+func runTestCase(
+	ctx context.Context,
+	ruletype *minderv1.RuleType,
+	tc *testCase,
+	baseDir string,
+	dss []*minderv1.DataSource,
+	prov provifv1.Provider,
+) *caseResult {
+	res := &caseResult{name: tc.Name}
+	start := time.Now()
+	defer func() {
+		res.duration = time.Since(start)
+	}()
+
+	// the engine may hold on to the rule type, and cases run in parallel
+	ruletype = proto.Clone(ruletype).(*minderv1.RuleType)
+
+	ewp, err := readEntityWithPropertiesFromFile(
+		resolvePath(baseDir, tc.Entity), uuid.MustParse(ruletype.GetContext().GetProject()),
+		minderv1.EntityFromString(ruletype.GetDef().GetInEntity()))
+	if err != nil {
+		res.setupErr = fmt.Errorf("error reading entity from file: %w", err)
+		return res
+	}
+
+	inf, err := entityWithPropertiesToEntityInfoWrapper(ewp, prov)
+	if err != nil {
+		res.setupErr = fmt.Errorf("error converting entity to entity info wrapper: %w", err)
+		return res
+	}
+
+	var tk *tkv1.TestKit
+	var engineProv provifv1.Provider = prov
+	switch {
+	case tc.Git != "":
+		tk = tkv1.NewTestKit(tkv1.WithGitDir(resolvePath(baseDir, tc.Git)))
+		engineProv = tk
+	case tc.HTTP != nil:
+		body := []byte(tc.HTTP.Body)
+		if tc.HTTP.BodyFile != "" {
+			body, err = os.ReadFile(filepath.Clean(resolvePath(baseDir, tc.HTTP.BodyFile)))
+			if err != nil {
+				res.setupErr = fmt.Errorf("error reading http body: %w", err)
+				return res
+			}
+		}
+		status := tc.HTTP.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		tk = tkv1.NewTestKit(tkv1.WithHTTP(status, body, tc.HTTP.Headers))
+		engineProv = tk
+	case tc.Cassette != "":
+		cassette, err := tkv1.LoadCassette(resolvePath(baseDir, tc.Cassette))
+		if err != nil {
+			res.setupErr = err
+			return res
+		}
+		tk = tkv1.NewTestKit(tkv1.WithCassette(cassette))
+		engineProv = tk
+	}
+
+	dsRegistry, err := buildDataSourceRegistry(dss, engineProv)
+	if err != nil {
+		res.setupErr = fmt.Errorf("error getting data sources: %w", err)
+		return res
+	}
+
+	eng, err := rtengine.NewRuleTypeEngine(ctx, ruletype, engineProv, options.WithDataSources(dsRegistry))
+	if err != nil {
+		res.setupErr = fmt.Errorf("cannot create rule type engine: %w", err)
+		return res
+	}
+	if tk != nil && tk.ShouldOverrideIngest() {
+		eng.WithCustomIngester(tk)
+	}
+
+	// the definition and parameters are validated, and defaulted, by Eval
+	def := tc.Def
+	if def == nil {
+		def = map[string]any{}
+	}
+	params := tc.Params
+	if params == nil {
+		params = map[string]any{}
+	}
+
+	_, evalErr := eng.Eval(ctx, inf.Entity, def, params, tkv1.NewVoidResultSink())
+	res.status = dbadapter.ErrorAsEvalStatus(evalErr)
+	res.details = dbadapter.ErrorAsEvalDetails(evalErr)
+
+	expected := db.EvalStatusTypes(tc.Expect.Status)
+	if expected == "" {
+		expected = db.EvalStatusTypesSuccess
+	}
+	if res.status != expected {
+		res.failure = fmt.Sprintf("expected status %s, got %s", expected, res.status)
+		if res.details != "" {
+			res.failure += fmt.Sprintf(" (%s)", res.details)
+		}
+	} else if !strings.Contains(res.details, tc.Expect.Message) {
+		res.failure = fmt.Sprintf("expected message to contain %q, got %q", tc.Expect.Message, res.details)
+	}
+
+	return res
+}
+
+func resolvePath(baseDir, fpath string) string {
+	if fpath == "" || filepath.IsAbs(fpath) {
+		return fpath
+	}
+	return filepath.Join(baseDir, fpath)
+}
+
+func resolvePaths(baseDir string, fpaths []string) []string {
+	out := make([]string, 0, len(fpaths))
+	for _, fpath := range fpaths {
+		out = append(out, resolvePath(baseDir, fpath))
+	}
+	return out
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func writeJUnitReport(stdout io.Writer, fpath string, name string, results []*caseResult, elapsed time.Duration) error {
+	suite := junitTestSuite{
+		Name:  name,
+		Tests: len(results),
+		Time:  formatSeconds(elapsed),
+		Cases: make([]junitTestCase, 0, len(results)),
+	}
+	for _, r := range results {
+		tc := junitTestCase{
+			Name:      r.name,
+			ClassName: name,
+			Time:      formatSeconds(r.duration),
+		}
+		switch {
+		case r.setupErr != nil:
+			suite.Errors++
+			tc.Error = &junitProblem{Message: r.setupErr.Error(), Text: r.setupErr.Error()}
+		case r.failure != "":
+			suite.Failures++
+			tc.Failure = &junitProblem{Message: r.failure, Text: r.details}
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	out, err := xml.MarshalIndent(junitTestSuites{
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Time:     suite.Time,
+		Suites:   []junitTestSuite{suite},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	out = append([]byte(xml.Header), out...)
+	out = append(out, '\n')
+
+	if fpath == "-" {
+		_, err = stdout.Write(out)
+		return err
+	}
+	return os.WriteFile(filepath.Clean(fpath), out, 0600)
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// timeAttr matches the durations of a JUnit report, which vary between runs
+var timeAttr = regexp.MustCompile(`time="[0-9.]+"`)
+
+//nolint:paralleltest // the suite runner sets an environment variable and reads viper
+func TestSuiteCmdRun(t *testing.T) {
+	junitPath := filepath.Join(t.TempDir(), "junit.xml")
+
+	cmd := CmdTest()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"--suite", "testdata/suite/suite.yaml", "--junit", junitPath})
+
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "3 of 5 test cases failed")
+
+	output := out.String()
+	require.Contains(t, output, "PASS  private repository\n")
+	require.Contains(t, output, "PASS  public repository\n")
+	require.Contains(t, output,
+		`FAIL  wrong expectation: expected status success, got failure`)
+	require.Contains(t, output,
+		`FAIL  wrong message: expected message to contain "no such message"`)
+	require.Contains(t, output, "ERROR missing entity: error reading entity from file")
+	require.Contains(t, output, "2 passed, 3 failed\n")
+
+	report, err := os.ReadFile(junitPath)
+	require.NoError(t, err)
+	checkGoldenFile(t, "testdata/suite/junit.golden.xml", timeAttr.ReplaceAllString(string(report), `time="0.000"`))
+}
+
+func checkGoldenFile(t *testing.T, fpath string, actual string) {
+	t.Helper()
+
+	if *update {
+		require.NoError(t, os.WriteFile(fpath, []byte(actual), 0600))
+	}
+	expected, err := os.ReadFile(filepath.Clean(fpath))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), actual)
+}
+
+func TestReadTestSuite(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		suite   string
+		wantErr string
+	}{
+		{
+			name: "valid suite",
+			suite: `
+rule_type: rule_type.yaml
+cases:
+  - name: git
+    entity: repository.yaml
+    git: repo
+  - name: http
+    entity: repository.yaml
+    http:
+      body_file: body.json
+    expect:
+      status: failure
+      message: denied
+`,
+		},
+		{
+			name:    "no rule type",
+			suite:   "cases: [{name: a, entity: repository.yaml}]",
+			wantErr: "test suite must set rule_type",
+		},
+		{
+			name:    "no cases",
+			suite:   "rule_type: rule_type.yaml",
+			wantErr: "test suite has no cases",
+		},
+		{
+			name:    "unknown field",
+			suite:   "rule_type: rule_type.yaml\nrules: []\ncases: [{name: a, entity: repository.yaml}]",
+			wantErr: `unknown field "rules"`,
+		},
+		{
+			name:    "case without a name",
+			suite:   "rule_type: rule_type.yaml\ncases: [{entity: repository.yaml}]",
+			wantErr: "case 0 has no name",
+		},
+		{
+			name: "duplicate names",
+			suite: `
+rule_type: rule_type.yaml
+cases:
+  - {name: a, entity: repository.yaml}
+  - {name: a, entity: other.yaml}
+`,
+			wantErr: `duplicate case name "a"`,
+		},
+		{
+			name:    "case without an entity",
+			suite:   "rule_type: rule_type.yaml\ncases: [{name: a}]",
+			wantErr: `case "a" has no entity`,
+		},
+		{
+			name: "git and http fixtures",
+			suite: `
+rule_type: rule_type.yaml
+cases:
+  - name: a
+    entity: repository.yaml
+    git: repo
+    http: {body: "{}"}
+`,
+			wantErr: `case "a" can only set one of git, http and cassette`,
+		},
+		{
+			name: "http and cassette fixtures",
+			suite: `
+rule_type: rule_type.yaml
+cases:
+  - name: a
+    entity: repository.yaml
+    http: {body: "{}"}
+    cassette: cassette.yaml
+`,
+			wantErr: `case "a" can only set one of git, http and cassette`,
+		},
+		{
+			name: "body and body file",
+			suite: `
+rule_type: rule_type.yaml
+cases:
+  - name: a
+    entity: repository.yaml
+    http: {body: "{}", body_file: body.json}
+`,
+			wantErr: `case "a" can't set both http.body and http.body_file`,
+		},
+		{
+			name: "unknown status",
+			suite: `
+rule_type: rule_type.yaml
+cases:
+  - name: a
+    entity: repository.yaml
+    expect: {status: pass}
+`,
+			wantErr: `case "a" expects unknown status "pass"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fpath := filepath.Join(t.TempDir(), "suite.yaml")
+			require.NoError(t, os.WriteFile(fpath, []byte(tt.suite), 0600))
+
+			suite, err := readTestSuite(fpath)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, suite.Cases, 2)
+			require.Equal(t, "repo", suite.Cases[0].Git)
+			require.Equal(t, "body.json", suite.Cases[1].HTTP.BodyFile)
+			require.Equal(t, expectation{Status: "failure", Message: "denied"}, suite.Cases[1].Expect)
+		})
+	}
+}
+
+func TestWriteJUnitReport(t *testing.T) {
+	t.Parallel()
+
+	results := []*caseResult{
+		{name: "passes", status: db.EvalStatusTypesSuccess, duration: 1500 * time.Millisecond},
+		{
+			name:     "fails",
+			status:   db.EvalStatusTypesFailure,
+			details:  "denied <by policy>",
+			failure:  "expected status success, got failure",
+			duration: 20 * time.Millisecond,
+		},
+		{name: "breaks", setupErr: errors.New("error reading entity"), duration: time.Millisecond},
+	}
+
+	var stdout bytes.Buffer
+	require.NoError(t, writeJUnitReport(&stdout, "-", "my_rule", results, 2*time.Second))
+
+	require.Equal(t, strings.Join([]string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<testsuites tests="3" failures="1" errors="1" time="2.000">`,
+		`  <testsuite name="my_rule" tests="3" failures="1" errors="1" time="2.000">`,
+		`    <testcase name="passes" classname="my_rule" time="1.500"></testcase>`,
+		`    <testcase name="fails" classname="my_rule" time="0.020">`,
+		`      <failure message="expected status success, got failure">denied &lt;by policy&gt;</failure>`,
+		`    </testcase>`,
+		`    <testcase name="breaks" classname="my_rule" time="0.001">`,
+		`      <error message="error reading entity">error reading entity</error>`,
+		`    </testcase>`,
+		`  </testsuite>`,
+		`</testsuites>`,
+		``,
+	}, "\n"), stdout.String())
+
+	// reports are written to files as well
+	fpath := filepath.Join(t.TempDir(), "junit.xml")
+	require.NoError(t, writeJUnitReport(&stdout, fpath, "my_rule", results, 2*time.Second))
+	written, err := os.ReadFile(fpath)
+	require.NoError(t, err)
+	require.Equal(t, stdout.String(), string(written))
+}
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// runsCmd represents the runs command
+var runsCmd = &cobra.Command{
+	Use:   "runs",
+	Short: "Pipeline and task runs",
+	Long:  `Manage the pipeline and task run entities created from CI webhooks with subcommands.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(runsCmd)
+}
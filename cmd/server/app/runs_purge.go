@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// runsPurgeCmd represents the `runs purge` command
+var runsPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Removes old pipeline and task runs",
+	Long: `deletes the completed pipeline and task runs first seen more than 30 days ago, and
+those of any status first seen more than 90 days ago, along with their properties and
+evaluations. CI webhook events create one of these entities for every run, so this
+command is meant to run periodically.`,
+	RunE: runsPurgeCommand,
+}
+
+func runsPurgeCommand(cmd *cobra.Command, _ []string) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	batchSize := viper.GetUint("batch-size")
+	dryRun := viper.GetBool("dry-run")
+	if batchSize == 0 {
+		cliErrorf(cmd, "batch size must be greater than zero")
+	}
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	// instantiate `db.Store` so we can run queries
+	store, closer, err := wireUpDB(ctx, cfg)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to database: %s", err)
+	}
+	defer closer()
+
+	now := time.Now().UTC()
+	completedThreshold := now.Add(-viper.GetDuration("completed-age"))
+	staleThreshold := now.Add(-viper.GetDuration("stale-age"))
+	zerolog.Ctx(ctx).Info().
+		Time("completed_threshold", completedThreshold).
+		Time("stale_threshold", staleThreshold).
+		Msg("Calculated thresholds")
+
+	if err := purgeRunsLoop(ctx, store, completedThreshold, staleThreshold, batchSize, dryRun); err != nil {
+		cliErrorf(cmd, "failed purging runs: %s", err)
+	}
+
+	return nil
+}
+
+// purgeRunsLoop deletes the stale pipeline and task runs in batches,
+// listing each batch anew so the memory used stays bounded by the
+// batch size. Entities originating from a deleted run, as well as its
+// properties and evaluations, are deleted along with it.
+func purgeRunsLoop(
+	ctx context.Context,
+	store db.Store,
+	completedThreshold time.Time,
+	staleThreshold time.Time,
+	batchSize uint,
+	dryRun bool,
+) error {
+	params := db.ListStaleRunEntitiesParams{
+		CompletedThreshold: completedThreshold,
+		StaleThreshold:     staleThreshold,
+		Size:               int32(min(batchSize, math.MaxInt32)), // #nosec G115 -- bounded above
+	}
+
+	if dryRun {
+		params.Size = math.MaxInt32
+		ids, err := store.ListStaleRunEntities(ctx, params)
+		if err != nil {
+			return fmt.Errorf("error listing stale runs: %w", err)
+		}
+		zerolog.Ctx(ctx).Info().Msgf("Dry run, would delete %d runs", len(ids))
+		return nil
+	}
+
+	deleted := int64(0)
+	for {
+		ids, err := store.ListStaleRunEntities(ctx, params)
+		if err != nil {
+			return fmt.Errorf("error listing stale runs: %w", err)
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		partial, err := store.DeleteEntitiesByIDs(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("error deleting stale runs: %w", err)
+		}
+		deleted += partial
+
+		// Stop on a short batch, or should nothing listed be
+		// deletable, rather than listing the same runs forever.
+		if len(ids) < int(params.Size) || partial == 0 {
+			break
+		}
+	}
+
+	zerolog.Ctx(ctx).Info().Msgf("Done purging runs, deleted %d runs", deleted)
+
+	return nil
+}
+
+func init() {
+	runsCmd.AddCommand(runsPurgeCmd)
+	runsPurgeCmd.Flags().UintP("batch-size", "", 1000, "Size of the deletion batch")
+	runsPurgeCmd.Flags().Bool("dry-run", false, "Avoids deleting, printing out the number of runs to delete")
+	runsPurgeCmd.Flags().Duration("completed-age", 30*24*time.Hour, "Age after which completed runs are deleted")
+	runsPurgeCmd.Flags().Duration("stale-age", 90*24*time.Hour,
+		"Age after which runs are deleted whatever their status, should their final events have been missed")
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/db"
+	dbf "github.com/mindersec/minder/internal/db/fixtures"
+)
+
+func TestPurgeRunsLoop(t *testing.T) {
+	t.Parallel()
+
+	completedThreshold := time.Now().AddDate(0, 0, -30)
+	staleThreshold := time.Now().AddDate(0, 0, -90)
+	listParams := func(size int32) db.ListStaleRunEntitiesParams {
+		return db.ListStaleRunEntitiesParams{
+			CompletedThreshold: completedThreshold,
+			StaleThreshold:     staleThreshold,
+			Size:               size,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		dbSetup dbf.DBMockBuilder
+		dryRun  bool
+		size    uint
+		err     bool
+	}{
+		{
+			name: "happy path",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(nil, listParams(5), uuid1, uuid2),
+				withDeleteEntitiesByIDs(nil, []uuid.UUID{uuid1, uuid2}),
+			),
+			size: 5,
+		},
+		{
+			name: "batches",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(nil, listParams(2), uuid1, uuid2),
+				withListStaleRunEntities(nil, listParams(2), uuid3),
+				withDeleteEntitiesByIDs(nil, []uuid.UUID{uuid1, uuid2}, []uuid.UUID{uuid3}),
+			),
+			size: 2,
+		},
+		{
+			name: "full last batch",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(nil, listParams(2), uuid1, uuid2),
+				withListStaleRunEntities(nil, listParams(2)),
+				withDeleteEntitiesByIDs(nil, []uuid.UUID{uuid1, uuid2}),
+			),
+			size: 2,
+		},
+		{
+			name: "dry run",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(nil, listParams(math.MaxInt32), uuid1, uuid2, uuid3),
+			),
+			dryRun: true,
+			size:   2,
+		},
+		{
+			name: "no runs",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(nil, listParams(5)),
+			),
+			size: 5,
+		},
+		{
+			name: "nothing deletable",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(nil, listParams(1), uuid1),
+				func(mock dbf.DBMock) {
+					mock.EXPECT().
+						DeleteEntitiesByIDs(gomock.Any(), []uuid.UUID{uuid1}).
+						Return(int64(0), nil)
+				},
+			),
+			size: 1,
+		},
+		{
+			name: "read error",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(errors.New("boom"), listParams(5)),
+			),
+			size: 5,
+			err:  true,
+		},
+		{
+			name: "write error",
+			dbSetup: dbf.NewDBMock(
+				withListStaleRunEntities(nil, listParams(5), uuid1),
+				withDeleteEntitiesByIDs(errors.New("boom"), []uuid.UUID{uuid1}),
+			),
+			size: 5,
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			store := tt.dbSetup(ctrl)
+
+			err := purgeRunsLoop(ctx, store, completedThreshold, staleThreshold, tt.size, tt.dryRun)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func withListStaleRunEntities(
+	err error,
+	params db.ListStaleRunEntitiesParams,
+	ids ...uuid.UUID,
+) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		if err != nil {
+			mock.EXPECT().
+				ListStaleRunEntities(gomock.Any(), params).
+				Return(nil, err)
+			return
+		}
+		mock.EXPECT().
+			ListStaleRunEntities(gomock.Any(), params).
+			Return(ids, nil)
+	}
+}
+
+func withDeleteEntitiesByIDs(
+	err error,
+	batches ...[]uuid.UUID,
+) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		calls := []any{}
+		for _, batch := range batches {
+			call := mock.EXPECT().
+				DeleteEntitiesByIDs(gomock.Any(), batch).
+				Return(int64(len(batch)), err)
+			calls = append(calls, call)
+		}
+		gomock.InOrder(calls...)
+	}
+}
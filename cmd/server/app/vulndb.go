@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// vulndbCmd represents the vulndb command
+var vulndbCmd = &cobra.Command{
+	Use:   "vulndb",
+	Short: "Vulnerability database",
+	Long:  `Manage the local mirror of the OSV vulnerability database with subcommands.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(vulndbCmd)
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/mindersec/minder/internal/vulndb"
+	"github.com/mindersec/minder/pkg/config"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// vulndbSyncCmd represents the `vulndb sync` command
+var vulndbSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Refreshes the vulnerability database mirror",
+	Long: `loads the OSV exports of the given ecosystems into the minder database. Once an
+ecosystem has been synced, the vulncheck evaluator looks up its packages in the
+local mirror instead of querying osv.dev. Run this command periodically to keep
+the mirror up to date.`,
+	RunE: vulndbSyncCommand,
+}
+
+func vulndbSyncCommand(cmd *cobra.Command, _ []string) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("error binding flags: %s", err)
+	}
+	cfg, err := config.ReadConfigFromViper[serverconfig.Config](viper.GetViper())
+	if err != nil {
+		cliErrorf(cmd, "unable to read config: %s", err)
+	}
+
+	ecosystems := viper.GetStringSlice("ecosystem")
+	source := viper.GetString("source")
+
+	ctx := serverconfig.LoggerFromConfigFlags(cfg.LoggingConfig).WithContext(context.Background())
+
+	// instantiate `db.Store` so we can run queries
+	store, closer, err := wireUpDB(ctx, cfg)
+	if err != nil {
+		cliErrorf(cmd, "unable to connect to database: %s", err)
+	}
+	defer closer()
+
+	syncer := vulndb.NewSyncer(store, source)
+	for _, ecosystem := range ecosystems {
+		result, err := syncer.Sync(ctx, ecosystem)
+		if err != nil {
+			cliErrorf(cmd, "failed syncing %s vulnerabilities: %s", ecosystem, err)
+		}
+		cmd.Printf("Synced %d %s vulnerabilities, %d updated\n", result.Total, result.Ecosystem, result.Updated)
+	}
+
+	return nil
+}
+
+func init() {
+	vulndbCmd.AddCommand(vulndbSyncCmd)
+	vulndbSyncCmd.Flags().StringSlice("ecosystem", vulndb.DefaultEcosystems,
+		"OSV ecosystem to sync, may be repeated")
+	vulndbSyncCmd.Flags().String("source", vulndb.DefaultSource,
+		"Base URL or local directory of the OSV exports, laid out as <source>/<ecosystem>/all.zip")
+}
@@ -0,0 +1,25 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Alert webhooks are the destinations of the `webhook` alert type. The
+-- URL of incoming webhooks in Slack and Teams is itself a credential,
+-- so we store it encrypted, as we do with provider access tokens. The
+-- optional signing secret is used to sign the payload of generic JSON
+-- webhooks.
+
+CREATE TABLE alert_webhooks(
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    encrypted_url JSONB NOT NULL,
+    encrypted_signing_secret JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX alert_webhooks_name_lower_idx ON alert_webhooks (project_id, lower(name));
+
+COMMIT;
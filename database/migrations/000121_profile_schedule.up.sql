@@ -0,0 +1,8 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add schedule column to profiles. The schedule controls how often the
+-- reminder service re-evaluates the profile, and when it must not. It
+-- is stored as the JSON encoding of minder.v1.Profile.Schedule; NULL
+-- means the reminder defaults apply.
+ALTER TABLE profiles ADD COLUMN schedule JSONB;
@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+DROP TABLE IF EXISTS project_sync_sources;
+
+DROP TYPE IF EXISTS project_sync_status;
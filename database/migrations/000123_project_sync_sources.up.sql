@@ -0,0 +1,29 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TYPE project_sync_status AS ENUM ('pending', 'success', 'failure');
+
+-- A project sync source is a git repository the rule types, profiles and
+-- data sources of a project are periodically reconciled from. The outcome
+-- of the last sync is kept alongside the configuration; drift holds the
+-- JSON encoded list of changes the last sync applied.
+CREATE TABLE project_sync_sources(
+    project_id UUID NOT NULL PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    repository TEXT NOT NULL,
+    branch TEXT NOT NULL DEFAULT '',
+    path TEXT NOT NULL DEFAULT '',
+    interval_seconds INTEGER NOT NULL,
+    status project_sync_status NOT NULL DEFAULT 'pending',
+    message TEXT NOT NULL DEFAULT '',
+    last_commit TEXT NOT NULL DEFAULT '',
+    drift JSONB NOT NULL DEFAULT '[]'::jsonb,
+    last_synced_at TIMESTAMP WITH TIME ZONE,
+    next_sync_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (provider_id) REFERENCES providers(id) ON DELETE CASCADE
+);
+
+CREATE INDEX project_sync_sources_next_sync_idx ON project_sync_sources (next_sync_at);
@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+DROP TABLE IF EXISTS osv_sync_state;
+DROP TABLE IF EXISTS osv_affected_packages;
+DROP TABLE IF EXISTS osv_vulnerabilities;
@@ -0,0 +1,31 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- A local mirror of the OSV vulnerability database, loaded from the OSV
+-- zip exports by `minder-server vulndb sync`. Records are stored as they
+-- were exported; the affected packages are extracted to look them up.
+CREATE TABLE osv_vulnerabilities(
+    id TEXT NOT NULL PRIMARY KEY,
+    modified TIMESTAMP WITH TIME ZONE NOT NULL,
+    data JSONB NOT NULL
+);
+
+-- Package names are stored normalized, as described by the ecosystem
+-- (e.g. PyPI names are case-insensitive).
+CREATE TABLE osv_affected_packages(
+    vulnerability_id TEXT NOT NULL,
+    ecosystem TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY (vulnerability_id, ecosystem, name),
+    FOREIGN KEY (vulnerability_id) REFERENCES osv_vulnerabilities(id) ON DELETE CASCADE
+);
+
+CREATE INDEX osv_affected_packages_name_idx ON osv_affected_packages (ecosystem, name);
+
+-- The ecosystems which have been synced. Vulnerability lookups only use
+-- the mirror for ecosystems listed here.
+CREATE TABLE osv_sync_state(
+    ecosystem TEXT NOT NULL PRIMARY KEY,
+    vulnerability_count INTEGER NOT NULL,
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,6 @@
+-- SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The version of the bundle the project was subscribed to before its last
+-- upgrade, or downgrade, so that it can be rolled back.
+ALTER TABLE subscriptions ADD COLUMN previous_version TEXT DEFAULT NULL;
@@ -118,6 +118,21 @@ func (mr *MockStoreMockRecorder) CheckHealth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockStore)(nil).CheckHealth))
 }
 
+// ClaimDueProjectSyncSources mocks base method.
+func (m *MockStore) ClaimDueProjectSyncSources(ctx context.Context, maxSources int32) ([]db.ProjectSyncSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueProjectSyncSources", ctx, maxSources)
+	ret0, _ := ret[0].([]db.ProjectSyncSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueProjectSyncSources indicates an expected call of ClaimDueProjectSyncSources.
+func (mr *MockStoreMockRecorder) ClaimDueProjectSyncSources(ctx, maxSources any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueProjectSyncSources", reflect.TypeOf((*MockStore)(nil).ClaimDueProjectSyncSources), ctx, maxSources)
+}
+
 // Commit mocks base method.
 func (m *MockStore) Commit(tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -222,6 +237,21 @@ func (mr *MockStoreMockRecorder) CountUsers(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockStore)(nil).CountUsers), ctx)
 }
 
+// CreateAlertWebhook mocks base method.
+func (m *MockStore) CreateAlertWebhook(ctx context.Context, arg db.CreateAlertWebhookParams) (db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertWebhook indicates an expected call of CreateAlertWebhook.
+func (mr *MockStoreMockRecorder) CreateAlertWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertWebhook", reflect.TypeOf((*MockStore)(nil).CreateAlertWebhook), ctx, arg)
+}
+
 // CreateDataSource mocks base method.
 func (m *MockStore) CreateDataSource(ctx context.Context, arg db.CreateDataSourceParams) (db.DataSource, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +416,21 @@ func (mr *MockStoreMockRecorder) CreateProvider(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProvider", reflect.TypeOf((*MockStore)(nil).CreateProvider), ctx, arg)
 }
 
+// CreateRuleExemption mocks base method.
+func (m *MockStore) CreateRuleExemption(ctx context.Context, arg db.CreateRuleExemptionParams) (db.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRuleExemption", ctx, arg)
+	ret0, _ := ret[0].(db.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRuleExemption indicates an expected call of CreateRuleExemption.
+func (mr *MockStoreMockRecorder) CreateRuleExemption(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRuleExemption", reflect.TypeOf((*MockStore)(nil).CreateRuleExemption), ctx, arg)
+}
+
 // CreateRuleType mocks base method.
 func (m *MockStore) CreateRuleType(ctx context.Context, arg db.CreateRuleTypeParams) (db.RuleType, error) {
 	m.ctrl.T.Helper()
@@ -461,6 +506,21 @@ func (mr *MockStoreMockRecorder) CreateUser(ctx, identitySubject any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, identitySubject)
 }
 
+// DeleteAlertWebhook mocks base method.
+func (m *MockStore) DeleteAlertWebhook(ctx context.Context, arg db.DeleteAlertWebhookParams) (db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlertWebhook indicates an expected call of DeleteAlertWebhook.
+func (mr *MockStoreMockRecorder) DeleteAlertWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertWebhook", reflect.TypeOf((*MockStore)(nil).DeleteAlertWebhook), ctx, arg)
+}
+
 // DeleteAllPropertiesForEntity mocks base method.
 func (m *MockStore) DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -520,6 +580,21 @@ func (mr *MockStoreMockRecorder) DeleteDataSourceFunctions(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataSourceFunctions", reflect.TypeOf((*MockStore)(nil).DeleteDataSourceFunctions), ctx, arg)
 }
 
+// DeleteEntitiesByIDs mocks base method.
+func (m *MockStore) DeleteEntitiesByIDs(ctx context.Context, entityIds []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntitiesByIDs", ctx, entityIds)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEntitiesByIDs indicates an expected call of DeleteEntitiesByIDs.
+func (mr *MockStoreMockRecorder) DeleteEntitiesByIDs(ctx, entityIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntitiesByIDs", reflect.TypeOf((*MockStore)(nil).DeleteEntitiesByIDs), ctx, entityIds)
+}
+
 // DeleteEntity mocks base method.
 func (m *MockStore) DeleteEntity(ctx context.Context, arg db.DeleteEntityParams) error {
 	m.ctrl.T.Helper()
@@ -622,6 +697,20 @@ func (mr *MockStoreMockRecorder) DeleteNonUpdatedRules(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNonUpdatedRules", reflect.TypeOf((*MockStore)(nil).DeleteNonUpdatedRules), ctx, arg)
 }
 
+// DeleteOSVAffectedPackages mocks base method.
+func (m *MockStore) DeleteOSVAffectedPackages(ctx context.Context, vulnerabilityID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOSVAffectedPackages", ctx, vulnerabilityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOSVAffectedPackages indicates an expected call of DeleteOSVAffectedPackages.
+func (mr *MockStoreMockRecorder) DeleteOSVAffectedPackages(ctx, vulnerabilityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOSVAffectedPackages", reflect.TypeOf((*MockStore)(nil).DeleteOSVAffectedPackages), ctx, vulnerabilityID)
+}
+
 // DeleteProfile mocks base method.
 func (m *MockStore) DeleteProfile(ctx context.Context, arg db.DeleteProfileParams) error {
 	m.ctrl.T.Helper()
@@ -665,6 +754,21 @@ func (mr *MockStoreMockRecorder) DeleteProject(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockStore)(nil).DeleteProject), ctx, id)
 }
 
+// DeleteProjectSyncSource mocks base method.
+func (m *MockStore) DeleteProjectSyncSource(ctx context.Context, projectID uuid.UUID) (db.ProjectSyncSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectSyncSource", ctx, projectID)
+	ret0, _ := ret[0].(db.ProjectSyncSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectSyncSource indicates an expected call of DeleteProjectSyncSource.
+func (mr *MockStoreMockRecorder) DeleteProjectSyncSource(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSyncSource", reflect.TypeOf((*MockStore)(nil).DeleteProjectSyncSource), ctx, projectID)
+}
+
 // DeleteProperty mocks base method.
 func (m *MockStore) DeleteProperty(ctx context.Context, arg db.DeletePropertyParams) error {
 	m.ctrl.T.Helper()
@@ -693,6 +797,21 @@ func (mr *MockStoreMockRecorder) DeleteProvider(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvider", reflect.TypeOf((*MockStore)(nil).DeleteProvider), ctx, arg)
 }
 
+// DeleteRuleExemption mocks base method.
+func (m *MockStore) DeleteRuleExemption(ctx context.Context, arg db.DeleteRuleExemptionParams) (db.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRuleExemption", ctx, arg)
+	ret0, _ := ret[0].(db.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRuleExemption indicates an expected call of DeleteRuleExemption.
+func (mr *MockStoreMockRecorder) DeleteRuleExemption(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRuleExemption", reflect.TypeOf((*MockStore)(nil).DeleteRuleExemption), ctx, arg)
+}
+
 // DeleteRuleInstanceOfProfileInProject mocks base method.
 func (m *MockStore) DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg db.DeleteRuleInstanceOfProfileInProjectParams) error {
 	m.ctrl.T.Helper()
@@ -911,6 +1030,21 @@ func (mr *MockStoreMockRecorder) GetAccessTokenSinceDate(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenSinceDate", reflect.TypeOf((*MockStore)(nil).GetAccessTokenSinceDate), ctx, arg)
 }
 
+// GetAlertWebhookByName mocks base method.
+func (m *MockStore) GetAlertWebhookByName(ctx context.Context, arg db.GetAlertWebhookByNameParams) (db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertWebhookByName", ctx, arg)
+	ret0, _ := ret[0].(db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertWebhookByName indicates an expected call of GetAlertWebhookByName.
+func (mr *MockStoreMockRecorder) GetAlertWebhookByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertWebhookByName", reflect.TypeOf((*MockStore)(nil).GetAlertWebhookByName), ctx, arg)
+}
+
 // GetAllPropertiesForEntity mocks base method.
 func (m *MockStore) GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]db.Property, error) {
 	m.ctrl.T.Helper()
@@ -1436,6 +1570,21 @@ func (mr *MockStoreMockRecorder) GetProjectIDBySessionState(ctx, sessionState an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIDBySessionState", reflect.TypeOf((*MockStore)(nil).GetProjectIDBySessionState), ctx, sessionState)
 }
 
+// GetProjectSyncSource mocks base method.
+func (m *MockStore) GetProjectSyncSource(ctx context.Context, projectID uuid.UUID) (db.GetProjectSyncSourceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectSyncSource", ctx, projectID)
+	ret0, _ := ret[0].(db.GetProjectSyncSourceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectSyncSource indicates an expected call of GetProjectSyncSource.
+func (mr *MockStoreMockRecorder) GetProjectSyncSource(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectSyncSource", reflect.TypeOf((*MockStore)(nil).GetProjectSyncSource), ctx, projectID)
+}
+
 // GetProperty mocks base method.
 func (m *MockStore) GetProperty(ctx context.Context, arg db.GetPropertyParams) (db.Property, error) {
 	m.ctrl.T.Helper()
@@ -1540,6 +1689,21 @@ func (mr *MockStoreMockRecorder) GetRuleEvaluationByProfileIdAndRuleType(ctx, pr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleEvaluationByProfileIdAndRuleType", reflect.TypeOf((*MockStore)(nil).GetRuleEvaluationByProfileIdAndRuleType), ctx, profileID, ruleName, entityID, ruleTypeName)
 }
 
+// GetRuleExemptionByID mocks base method.
+func (m *MockStore) GetRuleExemptionByID(ctx context.Context, arg db.GetRuleExemptionByIDParams) (db.GetRuleExemptionByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleExemptionByID", ctx, arg)
+	ret0, _ := ret[0].(db.GetRuleExemptionByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleExemptionByID indicates an expected call of GetRuleExemptionByID.
+func (mr *MockStoreMockRecorder) GetRuleExemptionByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleExemptionByID", reflect.TypeOf((*MockStore)(nil).GetRuleExemptionByID), ctx, arg)
+}
+
 // GetRuleInstancesEntityInProjects mocks base method.
 func (m *MockStore) GetRuleInstancesEntityInProjects(ctx context.Context, arg db.GetRuleInstancesEntityInProjectsParams) ([]db.RuleInstance, error) {
 	m.ctrl.T.Helper()
@@ -1839,6 +2003,20 @@ func (mr *MockStoreMockRecorder) InsertEvaluationStatus(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEvaluationStatus", reflect.TypeOf((*MockStore)(nil).InsertEvaluationStatus), ctx, arg)
 }
 
+// InsertOSVAffectedPackage mocks base method.
+func (m *MockStore) InsertOSVAffectedPackage(ctx context.Context, arg db.InsertOSVAffectedPackageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOSVAffectedPackage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertOSVAffectedPackage indicates an expected call of InsertOSVAffectedPackage.
+func (mr *MockStoreMockRecorder) InsertOSVAffectedPackage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOSVAffectedPackage", reflect.TypeOf((*MockStore)(nil).InsertOSVAffectedPackage), ctx, arg)
+}
+
 // InsertRemediationEvent mocks base method.
 func (m *MockStore) InsertRemediationEvent(ctx context.Context, arg db.InsertRemediationEventParams) error {
 	m.ctrl.T.Helper()
@@ -1853,6 +2031,36 @@ func (mr *MockStoreMockRecorder) InsertRemediationEvent(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRemediationEvent", reflect.TypeOf((*MockStore)(nil).InsertRemediationEvent), ctx, arg)
 }
 
+// ListActiveRuleExemptionsByEntity mocks base method.
+func (m *MockStore) ListActiveRuleExemptionsByEntity(ctx context.Context, entityInstanceID uuid.UUID) ([]db.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveRuleExemptionsByEntity", ctx, entityInstanceID)
+	ret0, _ := ret[0].([]db.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveRuleExemptionsByEntity indicates an expected call of ListActiveRuleExemptionsByEntity.
+func (mr *MockStoreMockRecorder) ListActiveRuleExemptionsByEntity(ctx, entityInstanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveRuleExemptionsByEntity", reflect.TypeOf((*MockStore)(nil).ListActiveRuleExemptionsByEntity), ctx, entityInstanceID)
+}
+
+// ListAlertWebhooksByProject mocks base method.
+func (m *MockStore) ListAlertWebhooksByProject(ctx context.Context, projectID uuid.UUID) ([]db.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertWebhooksByProject", ctx, projectID)
+	ret0, _ := ret[0].([]db.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertWebhooksByProject indicates an expected call of ListAlertWebhooksByProject.
+func (mr *MockStoreMockRecorder) ListAlertWebhooksByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertWebhooksByProject", reflect.TypeOf((*MockStore)(nil).ListAlertWebhooksByProject), ctx, projectID)
+}
+
 // ListAllRootProjects mocks base method.
 func (m *MockStore) ListAllRootProjects(ctx context.Context) ([]db.Project, error) {
 	m.ctrl.T.Helper()
@@ -1973,6 +2181,51 @@ func (mr *MockStoreMockRecorder) ListInvitationsForProject(ctx, project any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitationsForProject", reflect.TypeOf((*MockStore)(nil).ListInvitationsForProject), ctx, project)
 }
 
+// ListOSVSyncState mocks base method.
+func (m *MockStore) ListOSVSyncState(ctx context.Context) ([]db.OsvSyncState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOSVSyncState", ctx)
+	ret0, _ := ret[0].([]db.OsvSyncState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOSVSyncState indicates an expected call of ListOSVSyncState.
+func (mr *MockStoreMockRecorder) ListOSVSyncState(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOSVSyncState", reflect.TypeOf((*MockStore)(nil).ListOSVSyncState), ctx)
+}
+
+// ListOSVVulnerabilitiesByPackages mocks base method.
+func (m *MockStore) ListOSVVulnerabilitiesByPackages(ctx context.Context, arg db.ListOSVVulnerabilitiesByPackagesParams) ([]db.ListOSVVulnerabilitiesByPackagesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOSVVulnerabilitiesByPackages", ctx, arg)
+	ret0, _ := ret[0].([]db.ListOSVVulnerabilitiesByPackagesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOSVVulnerabilitiesByPackages indicates an expected call of ListOSVVulnerabilitiesByPackages.
+func (mr *MockStoreMockRecorder) ListOSVVulnerabilitiesByPackages(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOSVVulnerabilitiesByPackages", reflect.TypeOf((*MockStore)(nil).ListOSVVulnerabilitiesByPackages), ctx, arg)
+}
+
+// ListOldestRuleEvaluationsByEntityAndProfile mocks base method.
+func (m *MockStore) ListOldestRuleEvaluationsByEntityAndProfile(ctx context.Context, entityIds []uuid.UUID) ([]db.ListOldestRuleEvaluationsByEntityAndProfileRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOldestRuleEvaluationsByEntityAndProfile", ctx, entityIds)
+	ret0, _ := ret[0].([]db.ListOldestRuleEvaluationsByEntityAndProfileRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOldestRuleEvaluationsByEntityAndProfile indicates an expected call of ListOldestRuleEvaluationsByEntityAndProfile.
+func (mr *MockStoreMockRecorder) ListOldestRuleEvaluationsByEntityAndProfile(ctx, entityIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOldestRuleEvaluationsByEntityAndProfile", reflect.TypeOf((*MockStore)(nil).ListOldestRuleEvaluationsByEntityAndProfile), ctx, entityIds)
+}
+
 // ListOldestRuleEvaluationsByEntityID mocks base method.
 func (m *MockStore) ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]db.ListOldestRuleEvaluationsByEntityIDRow, error) {
 	m.ctrl.T.Helper()
@@ -2078,6 +2331,21 @@ func (mr *MockStoreMockRecorder) ListRuleEvaluationsByProfileId(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleEvaluationsByProfileId", reflect.TypeOf((*MockStore)(nil).ListRuleEvaluationsByProfileId), ctx, arg)
 }
 
+// ListRuleExemptionsByProject mocks base method.
+func (m *MockStore) ListRuleExemptionsByProject(ctx context.Context, arg db.ListRuleExemptionsByProjectParams) ([]db.ListRuleExemptionsByProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleExemptionsByProject", ctx, arg)
+	ret0, _ := ret[0].([]db.ListRuleExemptionsByProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleExemptionsByProject indicates an expected call of ListRuleExemptionsByProject.
+func (mr *MockStoreMockRecorder) ListRuleExemptionsByProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleExemptionsByProject", reflect.TypeOf((*MockStore)(nil).ListRuleExemptionsByProject), ctx, arg)
+}
+
 // ListRuleTypesByProject mocks base method.
 func (m *MockStore) ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]db.RuleType, error) {
 	m.ctrl.T.Helper()
@@ -2108,6 +2376,36 @@ func (mr *MockStoreMockRecorder) ListRuleTypesReferencesByDataSource(ctx, dataSo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTypesReferencesByDataSource", reflect.TypeOf((*MockStore)(nil).ListRuleTypesReferencesByDataSource), ctx, dataSourcesID)
 }
 
+// ListStaleRunEntities mocks base method.
+func (m *MockStore) ListStaleRunEntities(ctx context.Context, arg db.ListStaleRunEntitiesParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaleRunEntities", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaleRunEntities indicates an expected call of ListStaleRunEntities.
+func (mr *MockStoreMockRecorder) ListStaleRunEntities(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleRunEntities", reflect.TypeOf((*MockStore)(nil).ListStaleRunEntities), ctx, arg)
+}
+
+// ListSubscriptionsByProject mocks base method.
+func (m *MockStore) ListSubscriptionsByProject(ctx context.Context, projectID uuid.UUID) ([]db.ListSubscriptionsByProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptionsByProject", ctx, projectID)
+	ret0, _ := ret[0].([]db.ListSubscriptionsByProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionsByProject indicates an expected call of ListSubscriptionsByProject.
+func (mr *MockStoreMockRecorder) ListSubscriptionsByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionsByProject", reflect.TypeOf((*MockStore)(nil).ListSubscriptionsByProject), ctx, projectID)
+}
+
 // ListTokensToMigrate mocks base method.
 func (m *MockStore) ListTokensToMigrate(ctx context.Context, arg db.ListTokensToMigrateParams) ([]db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -2210,6 +2508,20 @@ func (mr *MockStoreMockRecorder) SetSubscriptionBundleVersion(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscriptionBundleVersion", reflect.TypeOf((*MockStore)(nil).SetSubscriptionBundleVersion), ctx, arg)
 }
 
+// SetSubscriptionVersion mocks base method.
+func (m *MockStore) SetSubscriptionVersion(ctx context.Context, arg db.SetSubscriptionVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSubscriptionVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSubscriptionVersion indicates an expected call of SetSubscriptionVersion.
+func (mr *MockStoreMockRecorder) SetSubscriptionVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscriptionVersion", reflect.TypeOf((*MockStore)(nil).SetSubscriptionVersion), ctx, arg)
+}
+
 // UpdateDataSource mocks base method.
 func (m *MockStore) UpdateDataSource(ctx context.Context, arg db.UpdateDataSourceParams) (db.DataSource, error) {
 	m.ctrl.T.Helper()
@@ -2313,6 +2625,20 @@ func (mr *MockStoreMockRecorder) UpdateProjectMeta(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectMeta", reflect.TypeOf((*MockStore)(nil).UpdateProjectMeta), ctx, arg)
 }
 
+// UpdateProjectSyncStatus mocks base method.
+func (m *MockStore) UpdateProjectSyncStatus(ctx context.Context, arg db.UpdateProjectSyncStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectSyncStatus", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectSyncStatus indicates an expected call of UpdateProjectSyncStatus.
+func (mr *MockStoreMockRecorder) UpdateProjectSyncStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectSyncStatus", reflect.TypeOf((*MockStore)(nil).UpdateProjectSyncStatus), ctx, arg)
+}
+
 // UpdateProvider mocks base method.
 func (m *MockStore) UpdateProvider(ctx context.Context, arg db.UpdateProviderParams) error {
 	m.ctrl.T.Helper()
@@ -2327,6 +2653,21 @@ func (mr *MockStoreMockRecorder) UpdateProvider(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProvider", reflect.TypeOf((*MockStore)(nil).UpdateProvider), ctx, arg)
 }
 
+// UpdateRuleExemption mocks base method.
+func (m *MockStore) UpdateRuleExemption(ctx context.Context, arg db.UpdateRuleExemptionParams) (db.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRuleExemption", ctx, arg)
+	ret0, _ := ret[0].(db.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRuleExemption indicates an expected call of UpdateRuleExemption.
+func (mr *MockStoreMockRecorder) UpdateRuleExemption(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRuleExemption", reflect.TypeOf((*MockStore)(nil).UpdateRuleExemption), ctx, arg)
+}
+
 // UpdateRuleType mocks base method.
 func (m *MockStore) UpdateRuleType(ctx context.Context, arg db.UpdateRuleTypeParams) (db.RuleType, error) {
 	m.ctrl.T.Helper()
@@ -2429,6 +2770,35 @@ func (mr *MockStoreMockRecorder) UpsertLatestEvaluationStatus(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLatestEvaluationStatus", reflect.TypeOf((*MockStore)(nil).UpsertLatestEvaluationStatus), ctx, arg)
 }
 
+// UpsertOSVSyncState mocks base method.
+func (m *MockStore) UpsertOSVSyncState(ctx context.Context, arg db.UpsertOSVSyncStateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOSVSyncState", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertOSVSyncState indicates an expected call of UpsertOSVSyncState.
+func (mr *MockStoreMockRecorder) UpsertOSVSyncState(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOSVSyncState", reflect.TypeOf((*MockStore)(nil).UpsertOSVSyncState), ctx, arg)
+}
+
+// UpsertOSVVulnerability mocks base method.
+func (m *MockStore) UpsertOSVVulnerability(ctx context.Context, arg db.UpsertOSVVulnerabilityParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOSVVulnerability", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertOSVVulnerability indicates an expected call of UpsertOSVVulnerability.
+func (mr *MockStoreMockRecorder) UpsertOSVVulnerability(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOSVVulnerability", reflect.TypeOf((*MockStore)(nil).UpsertOSVVulnerability), ctx, arg)
+}
+
 // UpsertProfileForEntity mocks base method.
 func (m *MockStore) UpsertProfileForEntity(ctx context.Context, arg db.UpsertProfileForEntityParams) (db.EntityProfile, error) {
 	m.ctrl.T.Helper()
@@ -2444,6 +2814,21 @@ func (mr *MockStoreMockRecorder) UpsertProfileForEntity(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProfileForEntity", reflect.TypeOf((*MockStore)(nil).UpsertProfileForEntity), ctx, arg)
 }
 
+// UpsertProjectSyncSource mocks base method.
+func (m *MockStore) UpsertProjectSyncSource(ctx context.Context, arg db.UpsertProjectSyncSourceParams) (db.ProjectSyncSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProjectSyncSource", ctx, arg)
+	ret0, _ := ret[0].(db.ProjectSyncSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProjectSyncSource indicates an expected call of UpsertProjectSyncSource.
+func (mr *MockStoreMockRecorder) UpsertProjectSyncSource(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProjectSyncSource", reflect.TypeOf((*MockStore)(nil).UpsertProjectSyncSource), ctx, arg)
+}
+
 // UpsertProperty mocks base method.
 func (m *MockStore) UpsertProperty(ctx context.Context, arg db.UpsertPropertyParams) (db.Property, error) {
 	m.ctrl.T.Helper()
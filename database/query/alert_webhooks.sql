@@ -0,0 +1,28 @@
+-- CreateAlertWebhook creates a new alert webhook in a given project.
+
+-- name: CreateAlertWebhook :one
+INSERT INTO alert_webhooks (project_id, name, encrypted_url, encrypted_signing_secret)
+VALUES ($1, $2, $3, sqlc.narg(encrypted_signing_secret)) RETURNING *;
+
+-- GetAlertWebhookByName retrieves an alert webhook by its name and
+-- a project hierarchy. The webhook of the closest project wins, so
+-- child projects can override the webhooks of their parents.
+--
+-- Note that to get a webhook for a given project, one can simply
+-- pass one project id in the project_id array.
+
+-- name: GetAlertWebhookByName :one
+SELECT * FROM alert_webhooks
+WHERE lower(name) = lower(sqlc.arg(name)) AND project_id = ANY(sqlc.arg(projects)::uuid[])
+ORDER BY array_position(sqlc.arg(projects)::uuid[], project_id)
+LIMIT 1;
+
+-- name: ListAlertWebhooksByProject :many
+SELECT * FROM alert_webhooks
+WHERE project_id = $1
+ORDER BY name;
+
+-- name: DeleteAlertWebhook :one
+DELETE FROM alert_webhooks
+WHERE project_id = $1 AND lower(name) = lower(sqlc.arg(name))
+RETURNING *;
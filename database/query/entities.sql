@@ -152,4 +152,27 @@ WHERE ei.entity_type = sqlc.arg(entity_type)
   AND (sqlc.arg(project_id)::uuid = '00000000-0000-0000-0000-000000000000'::uuid OR ei.project_id = sqlc.arg(project_id))
   AND (sqlc.arg(provider_id)::uuid = '00000000-0000-0000-0000-000000000000'::uuid OR ei.provider_id = sqlc.arg(provider_id))
   AND p.key = sqlc.arg(key)
-  AND p.value @> sqlc.arg(value)::jsonb;
\ No newline at end of file
+  AND p.value @> sqlc.arg(value)::jsonb;
+
+-- ListStaleRunEntities lists the pipeline and task runs which are no longer worth
+-- keeping: completed runs created before the completed threshold, and runs of any
+-- status created before the stale threshold, whose final events were likely missed.
+-- name: ListStaleRunEntities :many
+SELECT ei.id
+FROM entity_instances ei
+WHERE ei.entity_type IN ('pipeline_run', 'task_run')
+  AND (ei.created_at < sqlc.arg(stale_threshold)
+    OR (ei.created_at < sqlc.arg(completed_threshold)
+      AND EXISTS (
+        SELECT 1 FROM properties p
+        WHERE p.entity_id = ei.id
+          AND p.key = 'status'
+          AND p.value->>'value' = 'completed')))
+ORDER BY ei.created_at
+LIMIT sqlc.arg(size)::integer;
+
+-- DeleteEntitiesByIDs removes the given entities, along with their properties,
+-- evaluations and originated entities.
+-- name: DeleteEntitiesByIDs :execrows
+DELETE FROM entity_instances
+WHERE id = ANY(sqlc.arg(entity_ids)::uuid[]);
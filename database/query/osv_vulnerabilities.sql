@@ -0,0 +1,38 @@
+-- UpsertOSVVulnerability stores a vulnerability record, unless the stored
+-- copy is already up to date. No rows are affected in that case.
+
+-- name: UpsertOSVVulnerability :execrows
+INSERT INTO osv_vulnerabilities (id, modified, data)
+VALUES ($1, $2, sqlc.arg(data)::jsonb)
+ON CONFLICT (id) DO UPDATE SET
+    modified = EXCLUDED.modified,
+    data = EXCLUDED.data
+WHERE osv_vulnerabilities.modified < EXCLUDED.modified;
+
+-- name: DeleteOSVAffectedPackages :exec
+DELETE FROM osv_affected_packages WHERE vulnerability_id = $1;
+
+-- name: InsertOSVAffectedPackage :exec
+INSERT INTO osv_affected_packages (vulnerability_id, ecosystem, name)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- ListOSVVulnerabilitiesByPackages returns the vulnerabilities which affect
+-- any version of the given packages of an ecosystem.
+
+-- name: ListOSVVulnerabilitiesByPackages :many
+SELECT a.name, v.data
+FROM osv_affected_packages a
+JOIN osv_vulnerabilities v ON v.id = a.vulnerability_id
+WHERE a.ecosystem = sqlc.arg(ecosystem) AND a.name = ANY(sqlc.arg(names)::text[])
+ORDER BY a.name, v.id;
+
+-- name: UpsertOSVSyncState :exec
+INSERT INTO osv_sync_state (ecosystem, vulnerability_count, synced_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (ecosystem) DO UPDATE SET
+    vulnerability_count = EXCLUDED.vulnerability_count,
+    synced_at = EXCLUDED.synced_at;
+
+-- name: ListOSVSyncState :many
+SELECT * FROM osv_sync_state ORDER BY ecosystem;
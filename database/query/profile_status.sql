@@ -28,6 +28,7 @@ GROUP BY ere.entity_instance_id;
 
 -- ListOldestRuleEvaluationsByEntityID returns the oldest evaluation time for each entity.
 -- cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
+-- DEPRECATED: Use ListOldestRuleEvaluationsByEntityAndProfile instead
 
 -- name: ListOldestRuleEvaluationsByEntityID :many
 SELECT ere.entity_instance_id, MIN(es.evaluation_time)::timestamp AS oldest_last_updated
@@ -37,6 +38,21 @@ FROM evaluation_rule_entities AS ere
 WHERE ere.entity_instance_id = ANY (sqlc.arg('entity_ids')::uuid[])
 GROUP BY ere.entity_instance_id;
 
+-- ListOldestRuleEvaluationsByEntityAndProfile returns the oldest evaluation time
+-- for each pair of entity and profile, together with the schedule of the profile.
+-- cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
+
+-- name: ListOldestRuleEvaluationsByEntityAndProfile :many
+SELECT ere.entity_instance_id, ri.profile_id, p.schedule AS profile_schedule,
+    MIN(es.evaluation_time)::timestamp AS oldest_last_updated
+FROM evaluation_rule_entities AS ere
+    INNER JOIN rule_instances AS ri ON ere.rule_id = ri.id
+    INNER JOIN profiles AS p ON ri.profile_id = p.id
+    INNER JOIN latest_evaluation_statuses AS les ON ere.id = les.rule_entity_id
+    INNER JOIN evaluation_statuses AS es ON les.evaluation_history_id = es.id
+WHERE ere.entity_instance_id = ANY (sqlc.arg('entity_ids')::uuid[])
+GROUP BY ere.entity_instance_id, ri.profile_id, p.schedule;
+
 -- name: ListRuleEvaluationsByProfileId :many
 WITH
    eval_details AS (
@@ -6,8 +6,9 @@ INSERT INTO profiles (
     name,
     subscription_id,
     display_name,
-    labels
-) VALUES ($1, $2, $3, $4, sqlc.narg(subscription_id), sqlc.arg(display_name), COALESCE(sqlc.arg(labels)::text[], '{}'::text[])) RETURNING *;
+    labels,
+    schedule
+) VALUES ($1, $2, $3, $4, sqlc.narg(subscription_id), sqlc.arg(display_name), COALESCE(sqlc.arg(labels)::text[], '{}'::text[]), sqlc.narg(schedule)::jsonb) RETURNING *;
 
 -- name: UpdateProfile :one
 UPDATE profiles SET
@@ -15,7 +16,8 @@ UPDATE profiles SET
     alert = $4,
     updated_at = NOW(),
     display_name = sqlc.arg(display_name),
-    labels = COALESCE(sqlc.arg(labels)::TEXT[], '{}'::TEXT[])
+    labels = COALESCE(sqlc.arg(labels)::TEXT[], '{}'::TEXT[]),
+    schedule = sqlc.narg(schedule)::jsonb
 WHERE id = $1 AND project_id = $2 RETURNING *;
 
 -- name: CreateProfileForEntity :one
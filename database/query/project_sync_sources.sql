@@ -0,0 +1,62 @@
+-- UpsertProjectSyncSource sets the repository a project is synced from.
+-- Changing the configuration resets the status, and schedules a sync
+-- right away.
+
+-- name: UpsertProjectSyncSource :one
+INSERT INTO project_sync_sources (
+    project_id,
+    provider_id,
+    repository,
+    branch,
+    path,
+    interval_seconds
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (project_id) DO UPDATE SET
+    provider_id = EXCLUDED.provider_id,
+    repository = EXCLUDED.repository,
+    branch = EXCLUDED.branch,
+    path = EXCLUDED.path,
+    interval_seconds = EXCLUDED.interval_seconds,
+    status = 'pending',
+    message = '',
+    next_sync_at = NOW(),
+    updated_at = NOW()
+RETURNING *;
+
+-- GetProjectSyncSource retrieves the sync source of a project, together
+-- with the name of its provider.
+
+-- name: GetProjectSyncSource :one
+SELECT sqlc.embed(project_sync_sources), p.name AS provider_name
+FROM project_sync_sources
+JOIN providers p ON p.id = project_sync_sources.provider_id
+WHERE project_sync_sources.project_id = $1;
+
+-- name: DeleteProjectSyncSource :one
+DELETE FROM project_sync_sources WHERE project_id = $1 RETURNING *;
+
+-- ClaimDueProjectSyncSources returns the sync sources which are due, and
+-- moves their next sync forward by their interval. Rows being claimed by
+-- another server are skipped, so that every sync runs once.
+
+-- name: ClaimDueProjectSyncSources :many
+UPDATE project_sync_sources
+SET next_sync_at = NOW() + make_interval(secs => interval_seconds)
+WHERE project_id IN (
+    SELECT due.project_id FROM project_sync_sources due
+    WHERE due.next_sync_at <= NOW()
+    ORDER BY due.next_sync_at
+    LIMIT sqlc.arg(max_sources)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateProjectSyncStatus :exec
+UPDATE project_sync_sources
+SET status = $2,
+    message = $3,
+    last_commit = $4,
+    drift = $5,
+    last_synced_at = NOW(),
+    updated_at = NOW()
+WHERE project_id = $1;
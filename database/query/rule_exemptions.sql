@@ -0,0 +1,69 @@
+-- CreateRuleExemption creates a new exemption for a rule of a profile
+-- and an entity.
+
+-- name: CreateRuleExemption :one
+INSERT INTO rule_exemptions (
+    project_id,
+    profile_id,
+    rule_name,
+    entity_instance_id,
+    justification,
+    approver,
+    created_by,
+    expires_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
+
+-- GetRuleExemptionByID retrieves an exemption of a project, together with
+-- the names of the profile and entity it applies to.
+
+-- name: GetRuleExemptionByID :one
+SELECT sqlc.embed(rule_exemptions),
+       p.name AS profile_name,
+       ei.name AS entity_name,
+       ei.entity_type AS entity_type
+FROM rule_exemptions
+JOIN profiles p ON p.id = rule_exemptions.profile_id
+JOIN entity_instances ei ON ei.id = rule_exemptions.entity_instance_id
+WHERE rule_exemptions.id = $1 AND rule_exemptions.project_id = $2;
+
+-- ListRuleExemptionsByProject lists the exemptions of a project, optionally
+-- filtered by profile. Expired exemptions are only included if requested.
+
+-- name: ListRuleExemptionsByProject :many
+SELECT sqlc.embed(rule_exemptions),
+       p.name AS profile_name,
+       ei.name AS entity_name,
+       ei.entity_type AS entity_type
+FROM rule_exemptions
+JOIN profiles p ON p.id = rule_exemptions.profile_id
+JOIN entity_instances ei ON ei.id = rule_exemptions.entity_instance_id
+WHERE rule_exemptions.project_id = $1
+  AND (sqlc.narg(profile_id)::uuid IS NULL OR rule_exemptions.profile_id = sqlc.narg(profile_id)::uuid)
+  AND (sqlc.arg(include_expired)::boolean OR rule_exemptions.expires_at > NOW())
+ORDER BY p.name, rule_exemptions.rule_name, ei.name, rule_exemptions.expires_at;
+
+-- ListActiveRuleExemptionsByEntity lists the exemptions which currently
+-- apply to an entity. It is used by the executor to waive the evaluation
+-- of rules.
+
+-- name: ListActiveRuleExemptionsByEntity :many
+SELECT * FROM rule_exemptions
+WHERE entity_instance_id = $1 AND expires_at > NOW()
+ORDER BY expires_at DESC;
+
+-- UpdateRuleExemption updates the justification, approver and expiry of
+-- an exemption. Null values leave the current value in place.
+
+-- name: UpdateRuleExemption :one
+UPDATE rule_exemptions
+SET justification = COALESCE(sqlc.narg(justification), justification),
+    approver = COALESCE(sqlc.narg(approver), approver),
+    expires_at = COALESCE(sqlc.narg(expires_at), expires_at),
+    updated_at = NOW()
+WHERE id = $1 AND project_id = $2
+RETURNING *;
+
+-- name: DeleteRuleExemption :one
+DELETE FROM rule_exemptions
+WHERE id = $1 AND project_id = $2
+RETURNING *;
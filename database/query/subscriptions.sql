@@ -24,3 +24,16 @@ WHERE bu.namespace = $1 AND bu.name = $2 AND su.project_id = $3;
 
 -- name: SetSubscriptionBundleVersion :exec
 UPDATE subscriptions SET current_version = $2 WHERE project_id = $1;
+
+-- SetSubscriptionVersion moves the subscription to a version of the bundle,
+-- recording the version it is moved from so that it can be rolled back.
+
+-- name: SetSubscriptionVersion :exec
+UPDATE subscriptions SET previous_version = current_version, current_version = $2
+WHERE id = $1;
+
+-- name: ListSubscriptionsByProject :many
+SELECT su.*, bu.namespace, bu.name FROM subscriptions AS su
+JOIN bundles AS bu ON bu.id = su.bundle_id
+WHERE su.project_id = $1
+ORDER BY bu.namespace, bu.name;
@@ -20,6 +20,8 @@ func ErrorAsEvalStatus(err error) db.EvalStatusTypes {
 		return db.EvalStatusTypesFailure
 	} else if errors.Is(err, interfaces.ErrEvaluationSkipped) {
 		return db.EvalStatusTypesSkipped
+	} else if errors.Is(err, interfaces.ErrEvaluationWaived) {
+		return db.EvalStatusTypesWaived
 	} else if err != nil {
 		return db.EvalStatusTypesError
 	}
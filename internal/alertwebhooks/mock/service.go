@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_alertwebhooks -destination=./mock/service.go -source=./service.go
+//
+
+// Package mock_alertwebhooks is a generated GoMock package.
+package mock_alertwebhooks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	alertwebhooks "github.com/mindersec/minder/internal/alertwebhooks"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResolver is a mock of Resolver interface.
+type MockResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockResolverMockRecorder
+	isgomock struct{}
+}
+
+// MockResolverMockRecorder is the mock recorder for MockResolver.
+type MockResolverMockRecorder struct {
+	mock *MockResolver
+}
+
+// NewMockResolver creates a new mock instance.
+func NewMockResolver(ctrl *gomock.Controller) *MockResolver {
+	mock := &MockResolver{ctrl: ctrl}
+	mock.recorder = &MockResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResolver) EXPECT() *MockResolverMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MockResolver) Resolve(ctx context.Context, name string) (*alertwebhooks.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, name)
+	ret0, _ := ret[0].(*alertwebhooks.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockResolverMockRecorder) Resolve(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockResolver)(nil).Resolve), ctx, name)
+}
+
+// MockAlertWebhookService is a mock of AlertWebhookService interface.
+type MockAlertWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertWebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAlertWebhookServiceMockRecorder is the mock recorder for MockAlertWebhookService.
+type MockAlertWebhookServiceMockRecorder struct {
+	mock *MockAlertWebhookService
+}
+
+// NewMockAlertWebhookService creates a new mock instance.
+func NewMockAlertWebhookService(ctrl *gomock.Controller) *MockAlertWebhookService {
+	mock := &MockAlertWebhookService{ctrl: ctrl}
+	mock.recorder = &MockAlertWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertWebhookService) EXPECT() *MockAlertWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAlertWebhookService) Create(ctx context.Context, projectID uuid.UUID, name, webhookURL, signingSecret string) (*v1.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, projectID, name, webhookURL, signingSecret)
+	ret0, _ := ret[0].(*v1.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAlertWebhookServiceMockRecorder) Create(ctx, projectID, name, webhookURL, signingSecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAlertWebhookService)(nil).Create), ctx, projectID, name, webhookURL, signingSecret)
+}
+
+// Delete mocks base method.
+func (m *MockAlertWebhookService) Delete(ctx context.Context, projectID uuid.UUID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, projectID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAlertWebhookServiceMockRecorder) Delete(ctx, projectID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAlertWebhookService)(nil).Delete), ctx, projectID, name)
+}
+
+// List mocks base method.
+func (m *MockAlertWebhookService) List(ctx context.Context, projectID uuid.UUID) ([]*v1.AlertWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID)
+	ret0, _ := ret[0].([]*v1.AlertWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAlertWebhookServiceMockRecorder) List(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAlertWebhookService)(nil).List), ctx, projectID)
+}
+
+// ResolverFor mocks base method.
+func (m *MockAlertWebhookService) ResolverFor(projectID uuid.UUID) alertwebhooks.Resolver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolverFor", projectID)
+	ret0, _ := ret[0].(alertwebhooks.Resolver)
+	return ret0
+}
+
+// ResolverFor indicates an expected call of ResolverFor.
+func (mr *MockAlertWebhookServiceMockRecorder) ResolverFor(projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolverFor", reflect.TypeOf((*MockAlertWebhookService)(nil).ResolverFor), projectID)
+}
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package alertwebhooks manages the destinations of webhook alerts.
+// The URL and signing secret of each webhook are stored encrypted,
+// the same way provider credentials are.
+package alertwebhooks
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+var (
+	// ErrAlertWebhookAlreadyExists is returned when a webhook with the same name exists in the project
+	ErrAlertWebhookAlreadyExists = util.UserVisibleError(codes.AlreadyExists, "alert webhook already exists")
+	// ErrAlertWebhookNotFound is returned when the webhook does not exist
+	ErrAlertWebhookNotFound = util.UserVisibleError(codes.NotFound, "alert webhook not found")
+)
+
+// Endpoint is an alert webhook with its secrets decrypted
+type Endpoint struct {
+	// Name is the name of the webhook
+	Name string
+	// URL is where the alerts are posted to
+	URL string
+	// SigningSecret is used to sign the payload, if not empty
+	SigningSecret string
+}
+
+// Resolver resolves the alert webhooks visible from a project
+type Resolver interface {
+	// Resolve returns the webhook with the given name, looking it up
+	// in the project and its parents.
+	Resolve(ctx context.Context, name string) (*Endpoint, error)
+}
+
+// AlertWebhookService encapsulates the methods to manage alert webhooks
+type AlertWebhookService interface {
+	// Create creates a new alert webhook in the project
+	Create(ctx context.Context, projectID uuid.UUID, name, webhookURL, signingSecret string) (*minderv1.AlertWebhook, error)
+
+	// List lists the alert webhooks of the project
+	List(ctx context.Context, projectID uuid.UUID) ([]*minderv1.AlertWebhook, error)
+
+	// Delete deletes the alert webhook with the given name from the project
+	Delete(ctx context.Context, projectID uuid.UUID, name string) error
+
+	// ResolverFor returns a Resolver for the webhooks visible from the project
+	ResolverFor(projectID uuid.UUID) Resolver
+}
+
+type alertWebhookService struct {
+	store    db.Store
+	crypteng crypto.Engine
+}
+
+// NewAlertWebhookService creates a new alert webhook service
+func NewAlertWebhookService(store db.Store, crypteng crypto.Engine) AlertWebhookService {
+	return &alertWebhookService{
+		store:    store,
+		crypteng: crypteng,
+	}
+}
+
+func (s *alertWebhookService) Create(
+	ctx context.Context, projectID uuid.UUID, name, webhookURL, signingSecret string,
+) (*minderv1.AlertWebhook, error) {
+	if err := validateURL(webhookURL); err != nil {
+		return nil, err
+	}
+
+	encryptedURL, err := s.encrypt(webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting webhook URL: %w", err)
+	}
+
+	var encryptedSecret pqtype.NullRawMessage
+	if signingSecret != "" {
+		secret, err := s.encrypt(signingSecret)
+		if err != nil {
+			return nil, fmt.Errorf("error encrypting signing secret: %w", err)
+		}
+		encryptedSecret = pqtype.NullRawMessage{RawMessage: secret, Valid: true}
+	}
+
+	wh, err := s.store.CreateAlertWebhook(ctx, db.CreateAlertWebhookParams{
+		ProjectID:              projectID,
+		Name:                   name,
+		EncryptedUrl:           encryptedURL,
+		EncryptedSigningSecret: encryptedSecret,
+	})
+	if err != nil {
+		if db.ErrIsUniqueViolation(err) {
+			return nil, ErrAlertWebhookAlreadyExists
+		}
+		return nil, fmt.Errorf("error creating alert webhook: %w", err)
+	}
+
+	return toProto(&wh), nil
+}
+
+func (s *alertWebhookService) List(ctx context.Context, projectID uuid.UUID) ([]*minderv1.AlertWebhook, error) {
+	whs, err := s.store.ListAlertWebhooksByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing alert webhooks: %w", err)
+	}
+
+	out := make([]*minderv1.AlertWebhook, 0, len(whs))
+	for i := range whs {
+		out = append(out, toProto(&whs[i]))
+	}
+	return out, nil
+}
+
+func (s *alertWebhookService) Delete(ctx context.Context, projectID uuid.UUID, name string) error {
+	_, err := s.store.DeleteAlertWebhook(ctx, db.DeleteAlertWebhookParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAlertWebhookNotFound
+	} else if err != nil {
+		return fmt.Errorf("error deleting alert webhook: %w", err)
+	}
+	return nil
+}
+
+func (s *alertWebhookService) ResolverFor(projectID uuid.UUID) Resolver {
+	return &projectResolver{svc: s, projectID: projectID}
+}
+
+func (s *alertWebhookService) resolve(ctx context.Context, projectID uuid.UUID, name string) (*Endpoint, error) {
+	projects, err := s.store.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project hierarchy: %w", err)
+	}
+
+	wh, err := s.store.GetAlertWebhookByName(ctx, db.GetAlertWebhookByNameParams{
+		Name:     name,
+		Projects: projects,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAlertWebhookNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting alert webhook: %w", err)
+	}
+
+	webhookURL, err := s.decrypt(wh.EncryptedUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting webhook URL: %w", err)
+	}
+
+	ep := &Endpoint{
+		Name: wh.Name,
+		URL:  webhookURL,
+	}
+
+	if wh.EncryptedSigningSecret.Valid {
+		ep.SigningSecret, err = s.decrypt(wh.EncryptedSigningSecret.RawMessage)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting signing secret: %w", err)
+		}
+	}
+
+	return ep, nil
+}
+
+func (s *alertWebhookService) encrypt(value string) (json.RawMessage, error) {
+	encrypted, err := s.crypteng.EncryptString(value)
+	if err != nil {
+		return nil, err
+	}
+	return encrypted.Serialize()
+}
+
+func (s *alertWebhookService) decrypt(value json.RawMessage) (string, error) {
+	encrypted, err := crypto.DeserializeEncryptedData(value)
+	if err != nil {
+		return "", err
+	}
+	return s.crypteng.DecryptString(encrypted)
+}
+
+type projectResolver struct {
+	svc       *alertWebhookService
+	projectID uuid.UUID
+}
+
+func (r *projectResolver) Resolve(ctx context.Context, name string) (*Endpoint, error) {
+	return r.svc.resolve(ctx, r.projectID, name)
+}
+
+// validateURL makes sure we only post alerts over HTTP(S)
+func validateURL(webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return util.UserVisibleError(codes.InvalidArgument, "invalid webhook URL: %s", err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return util.UserVisibleError(codes.InvalidArgument, "webhook URL must use http or https")
+	}
+	if u.Host == "" {
+		return util.UserVisibleError(codes.InvalidArgument, "webhook URL must have a host")
+	}
+	return nil
+}
+
+func toProto(wh *db.AlertWebhook) *minderv1.AlertWebhook {
+	projectID := wh.ProjectID.String()
+	return &minderv1.AlertWebhook{
+		Id:   wh.ID.String(),
+		Name: wh.Name,
+		Context: &minderv1.Context{
+			Project: &projectID,
+		},
+		HasSigningSecret: wh.EncryptedSigningSecret.Valid,
+		CreatedAt:        timestamppb.New(wh.CreatedAt),
+	}
+}
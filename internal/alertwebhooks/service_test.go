@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alertwebhooks
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/lib/pq/pqerror"
+	"github.com/sqlc-dev/pqtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/crypto"
+	"github.com/mindersec/minder/internal/crypto/algorithms"
+	mockcrypto "github.com/mindersec/minder/internal/crypto/mock"
+	"github.com/mindersec/minder/internal/db"
+)
+
+const encPrefix = "encrypted:"
+
+// fakeCrypto sets up a reversible "encryption" on the mock engine
+func fakeCrypto(ctrl *gomock.Controller) *mockcrypto.MockEngine {
+	eng := mockcrypto.NewMockEngine(ctrl)
+	eng.EXPECT().EncryptString(gomock.Any()).AnyTimes().
+		DoAndReturn(func(s string) (crypto.EncryptedData, error) {
+			return crypto.EncryptedData{Algorithm: algorithms.Aes256Gcm, EncodedData: encPrefix + s}, nil
+		})
+	eng.EXPECT().DecryptString(gomock.Any()).AnyTimes().
+		DoAndReturn(func(d crypto.EncryptedData) (string, error) {
+			return strings.TrimPrefix(d.EncodedData, encPrefix), nil
+		})
+	return eng
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	tests := []struct {
+		name       string
+		url        string
+		secret     string
+		setup      func(store *mockdb.MockStore)
+		wantErr    error
+		wantErrMsg string
+		wantSigned bool
+	}{
+		{
+			name:   "stores the URL and secret encrypted",
+			url:    "https://hooks.slack.com/services/T000/B000/XXXX",
+			secret: "s3cr3t",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAlertWebhook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateAlertWebhookParams) (db.AlertWebhook, error) {
+						assert.Contains(t, string(arg.EncryptedUrl), encPrefix)
+						assert.True(t, arg.EncryptedSigningSecret.Valid)
+						return db.AlertWebhook{
+							ID:                     uuid.New(),
+							ProjectID:              arg.ProjectID,
+							Name:                   arg.Name,
+							EncryptedUrl:           arg.EncryptedUrl,
+							EncryptedSigningSecret: arg.EncryptedSigningSecret,
+						}, nil
+					})
+			},
+			wantSigned: true,
+		},
+		{
+			name: "without a signing secret",
+			url:  "https://example.com/hook",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAlertWebhook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateAlertWebhookParams) (db.AlertWebhook, error) {
+						assert.False(t, arg.EncryptedSigningSecret.Valid)
+						return db.AlertWebhook{ProjectID: arg.ProjectID, Name: arg.Name}, nil
+					})
+			},
+		},
+		{
+			name: "duplicate name",
+			url:  "https://example.com/hook",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAlertWebhook(gomock.Any(), gomock.Any()).
+					Return(db.AlertWebhook{}, &pq.Error{Code: pqerror.UniqueViolation})
+			},
+			wantErr: ErrAlertWebhookAlreadyExists,
+		},
+		{
+			name:       "unsupported scheme",
+			url:        "file:///etc/passwd",
+			setup:      func(_ *mockdb.MockStore) {},
+			wantErrMsg: "webhook URL",
+		},
+		{
+			name:       "missing host",
+			url:        "https:///hook",
+			setup:      func(_ *mockdb.MockStore) {},
+			wantErrMsg: "webhook URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tt.setup(store)
+
+			svc := NewAlertWebhookService(store, fakeCrypto(ctrl))
+			wh, err := svc.Create(context.Background(), projectID, "team", tt.url, tt.secret)
+			if tt.wantErrMsg != "" {
+				require.ErrorContains(t, err, tt.wantErrMsg)
+				return
+			} else if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "team", wh.Name)
+			assert.Equal(t, projectID.String(), wh.GetContext().GetProject())
+			assert.Equal(t, tt.wantSigned, wh.HasSigningSecret)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	parentID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	eng := fakeCrypto(ctrl)
+
+	encURL, err := eng.EncryptString("https://example.com/hook")
+	require.NoError(t, err)
+	rawURL, err := encURL.Serialize()
+	require.NoError(t, err)
+	encSecret, err := eng.EncryptString("s3cr3t")
+	require.NoError(t, err)
+	rawSecret, err := encSecret.Serialize()
+	require.NoError(t, err)
+
+	store.EXPECT().GetParentProjects(gomock.Any(), projectID).Return([]uuid.UUID{projectID, parentID}, nil)
+	store.EXPECT().GetAlertWebhookByName(gomock.Any(), db.GetAlertWebhookByNameParams{
+		Name:     "team",
+		Projects: []uuid.UUID{projectID, parentID},
+	}).Return(db.AlertWebhook{
+		ProjectID:              parentID,
+		Name:                   "team",
+		EncryptedUrl:           rawURL,
+		EncryptedSigningSecret: pqtype.NullRawMessage{RawMessage: rawSecret, Valid: true},
+	}, nil)
+
+	ep, err := NewAlertWebhookService(store, eng).ResolverFor(projectID).Resolve(context.Background(), "team")
+	require.NoError(t, err)
+	assert.Equal(t, &Endpoint{Name: "team", URL: "https://example.com/hook", SigningSecret: "s3cr3t"}, ep)
+}
+
+func TestResolveNotFound(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetParentProjects(gomock.Any(), projectID).Return([]uuid.UUID{projectID}, nil)
+	store.EXPECT().GetAlertWebhookByName(gomock.Any(), gomock.Any()).Return(db.AlertWebhook{}, sql.ErrNoRows)
+
+	_, err := NewAlertWebhookService(store, fakeCrypto(ctrl)).ResolverFor(projectID).Resolve(context.Background(), "team")
+	require.ErrorIs(t, err, ErrAlertWebhookNotFound)
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().DeleteAlertWebhook(gomock.Any(), gomock.Any()).Return(db.AlertWebhook{}, sql.ErrNoRows)
+
+	err := NewAlertWebhookService(store, fakeCrypto(ctrl)).Delete(context.Background(), uuid.New(), "team")
+	require.ErrorIs(t, err, ErrAlertWebhookNotFound)
+}
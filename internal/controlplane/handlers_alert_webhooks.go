@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// CreateAlertWebhook creates an alert webhook in the project
+func (s *Server) CreateAlertWebhook(ctx context.Context,
+	in *minderv1.CreateAlertWebhookRequest) (*minderv1.CreateAlertWebhookResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	wh, err := s.alertWebhooks.Create(ctx, entityCtx.Project.ID, in.GetName(), in.GetUrl(), in.GetSigningSecret())
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.CreateAlertWebhookResponse{AlertWebhook: wh}, nil
+}
+
+// ListAlertWebhooks lists the alert webhooks of the project
+func (s *Server) ListAlertWebhooks(ctx context.Context,
+	_ *minderv1.ListAlertWebhooksRequest) (*minderv1.ListAlertWebhooksResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	whs, err := s.alertWebhooks.List(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.ListAlertWebhooksResponse{AlertWebhooks: whs}, nil
+}
+
+// DeleteAlertWebhook deletes an alert webhook from the project
+func (s *Server) DeleteAlertWebhook(ctx context.Context,
+	in *minderv1.DeleteAlertWebhookRequest) (*minderv1.DeleteAlertWebhookResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	if err := s.alertWebhooks.Delete(ctx, entityCtx.Project.ID, in.GetName()); err != nil {
+		return nil, err
+	}
+
+	return &minderv1.DeleteAlertWebhookResponse{Name: in.GetName()}, nil
+}
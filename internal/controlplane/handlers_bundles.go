@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/marketplaces"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// ListBundles lists the bundles available in the marketplace, and the
+// versions the project is subscribed to
+func (s *Server) ListBundles(ctx context.Context,
+	_ *minderv1.ListBundlesRequest) (*minderv1.ListBundlesResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	bundles, err := s.listProjectBundles(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.ListBundlesResponse{Bundles: bundles}, nil
+}
+
+// SubscribeBundle subscribes the project to a version of a bundle, or moves
+// its subscription to that version
+func (s *Server) SubscribeBundle(ctx context.Context,
+	in *minderv1.SubscribeBundleRequest) (*minderv1.SubscribeBundleResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	projectID := entityCtx.Project.ID
+	bundleID := mindpak.ID(in.GetNamespace(), in.GetName())
+
+	_, err = db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (any, error) {
+		return nil, s.marketplace.SubscribeVersion(ctx, projectID, bundleID, in.GetVersion(), qtx)
+	})
+	if err != nil {
+		return nil, subscriptionError(err, "failed to subscribe to bundle")
+	}
+
+	bundle, err := s.projectBundle(ctx, projectID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.SubscribeBundleResponse{Bundle: bundle}, nil
+}
+
+// PreviewSubscriptionUpgrade lists the changes subscribing the project to a
+// version of a bundle would make, without making them
+func (s *Server) PreviewSubscriptionUpgrade(ctx context.Context,
+	in *minderv1.PreviewSubscriptionUpgradeRequest) (*minderv1.PreviewSubscriptionUpgradeResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	bundleID := mindpak.ID(in.GetNamespace(), in.GetName())
+	preview, err := s.marketplace.PreviewVersion(ctx, entityCtx.Project.ID, bundleID, in.GetVersion(), s.store)
+	if err != nil {
+		return nil, subscriptionError(err, "failed to preview subscription")
+	}
+
+	resp := &minderv1.PreviewSubscriptionUpgradeResponse{
+		FromVersion: preview.FromVersion,
+		ToVersion:   preview.ToVersion,
+		Changes:     make([]*minderv1.BundleResourceChange, 0, len(preview.Changes)),
+	}
+	for _, change := range preview.Changes {
+		resp.Changes = append(resp.Changes, &minderv1.BundleResourceChange{
+			Kind:     change.Kind,
+			Name:     change.Name,
+			Action:   change.Action,
+			Breaking: change.Breaking,
+		})
+	}
+
+	return resp, nil
+}
+
+// RollbackBundleSubscription moves the subscription of the project to the
+// version of the bundle it was subscribed to before its last upgrade
+func (s *Server) RollbackBundleSubscription(ctx context.Context,
+	in *minderv1.RollbackBundleSubscriptionRequest) (*minderv1.RollbackBundleSubscriptionResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	projectID := entityCtx.Project.ID
+	bundleID := mindpak.ID(in.GetNamespace(), in.GetName())
+
+	_, err = db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (any, error) {
+		return nil, s.marketplace.Rollback(ctx, projectID, bundleID, qtx)
+	})
+	if err != nil {
+		return nil, subscriptionError(err, "failed to roll back subscription")
+	}
+
+	bundle, err := s.projectBundle(ctx, projectID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.RollbackBundleSubscriptionResponse{Bundle: bundle}, nil
+}
+
+// subscriptionError converts the errors of the marketplace to gRPC errors
+func subscriptionError(err error, msg string) error {
+	switch {
+	case errors.Is(err, marketplaces.ErrUnknownBundle), errors.Is(err, marketplaces.ErrUnknownBundleVersion):
+		return util.UserVisibleError(codes.NotFound, "%s", err)
+	case errors.Is(err, marketplaces.ErrNoPreviousVersion), errors.Is(err, marketplaces.ErrBreakingChange):
+		return util.UserVisibleError(codes.FailedPrecondition, "%s", err)
+	case errors.Is(err, ruletypes.ErrRuleTypeInvalid):
+		return util.UserVisibleError(codes.FailedPrecondition, "bundle cannot be applied to the project: %s", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %s", msg, err)
+}
+
+// projectBundle returns a bundle of the marketplace along with the
+// subscription of the project to it
+func (s *Server) projectBundle(
+	ctx context.Context, projectID uuid.UUID, bundleID mindpak.BundleID,
+) (*minderv1.MarketplaceBundle, error) {
+	bundles, err := s.listProjectBundles(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, bundle := range bundles {
+		if bundle.GetNamespace() == bundleID.Namespace && bundle.GetName() == bundleID.Name {
+			return bundle, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.Internal, "subscription to bundle %s not found", bundleID)
+}
+
+// listProjectBundles lists the bundles of the marketplace along with the
+// subscriptions of the project, which may be to bundles no longer available
+func (s *Server) listProjectBundles(ctx context.Context, projectID uuid.UUID) ([]*minderv1.MarketplaceBundle, error) {
+	available, err := s.marketplace.ListBundles()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bundles: %s", err)
+	}
+
+	subscriptions, err := s.store.ListSubscriptionsByProject(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list subscriptions: %s", err)
+	}
+
+	bundles := make([]*minderv1.MarketplaceBundle, 0, len(available))
+	byID := make(map[mindpak.BundleID]*minderv1.MarketplaceBundle, len(available))
+	for _, bundle := range available {
+		pb := &minderv1.MarketplaceBundle{
+			Namespace: bundle.ID.Namespace,
+			Name:      bundle.ID.Name,
+			Versions:  bundle.Versions,
+		}
+		bundles = append(bundles, pb)
+		byID[bundle.ID] = pb
+	}
+
+	for _, sub := range subscriptions {
+		if pb, ok := byID[mindpak.ID(sub.Namespace, sub.Name)]; ok {
+			pb.SubscribedVersion = sub.CurrentVersion
+			pb.PreviousVersion = sub.PreviousVersion.String
+			continue
+		}
+		bundles = append(bundles, &minderv1.MarketplaceBundle{
+			Namespace:         sub.Namespace,
+			Name:              sub.Name,
+			SubscribedVersion: sub.CurrentVersion,
+			PreviousVersion:   sub.PreviousVersion.String,
+		})
+	}
+
+	return bundles, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/util"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/flags"
 )
 
 // CreateDataSource creates a data source
@@ -38,6 +39,10 @@ func (s *Server) CreateDataSource(ctx context.Context,
 		return nil, err
 	}
 
+	if dsReq.GetSql() != nil && !flags.Bool(ctx, s.featureFlags, flags.SQLDataSources) {
+		return nil, util.UserVisibleError(codes.Unimplemented, "SQL data sources are not enabled")
+	}
+
 	// Process the request
 	ret, err := s.dataSourcesService.Create(ctx, projectID, uuid.Nil, dsReq, nil)
 	if err != nil {
@@ -157,6 +162,10 @@ func (s *Server) UpdateDataSource(ctx context.Context,
 		return nil, err
 	}
 
+	if dsReq.GetSql() != nil && !flags.Bool(ctx, s.featureFlags, flags.SQLDataSources) {
+		return nil, util.UserVisibleError(codes.Unimplemented, "SQL data sources are not enabled")
+	}
+
 	// Process the request
 	ret, err := s.dataSourcesService.Update(ctx, projectID, uuid.Nil, dsReq, nil)
 	if err != nil {
@@ -52,6 +52,17 @@ func TestCreateDataSource(t *testing.T) {
 			expectedResponse:  nil,
 			expectedErrorCode: codes.InvalidArgument,
 		},
+		{
+			name: "sql data sources not enabled",
+			request: &minderv1.CreateDataSourceRequest{
+				DataSource: &minderv1.DataSource{
+					Name:   "test-ds",
+					Driver: &minderv1.DataSource_Sql{Sql: &minderv1.SQLDataSource{}},
+				},
+			},
+			expectedResponse:  nil,
+			expectedErrorCode: codes.Unimplemented,
+		},
 	}
 
 	for _, tt := range tests {
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -39,6 +40,13 @@ const (
 	// performance impact.
 	maxPageSize uint32 = 25
 	evalErrMsg  string = "error retrieving evaluation history"
+	// watchPollInterval is how often WatchEvaluationResults looks for new
+	// evaluations when it was not notified of any.
+	watchPollInterval = 10 * time.Second
+	// watchOverlap is how far behind the newest evaluation sent
+	// WatchEvaluationResults looks again, so that evaluations committed
+	// after newer ones are not missed.
+	watchOverlap = 30 * time.Second
 )
 
 // GetEvaluationHistory returns a single evaluation history record by ID
@@ -222,6 +230,184 @@ func (s *Server) ListEvaluationHistory(
 	return resp, nil
 }
 
+// WatchEvaluationResults streams evaluations as they are stored.
+func (s *Server) WatchEvaluationResults(
+	in *minderv1.WatchEvaluationResultsRequest,
+	stream minderv1.EvalResultsService_WatchEvaluationResultsServer,
+) error {
+	ctx := stream.Context()
+	projectID := GetProjectID(ctx)
+
+	// By default, only stream evaluations stored from now on
+	watch := &evaluationWatch{
+		since: time.Now().UTC(),
+		sent:  make(map[uuid.UUID]time.Time),
+	}
+	if in.GetCursor() != "" {
+		parsedCursor, err := history.ParseListEvaluationCursor(in.GetCursor())
+		if err != nil {
+			return util.UserVisibleError(codes.InvalidArgument, "invalid cursor: %s", err)
+		}
+		// The stream always moves towards newer evaluations
+		watch.since = parsedCursor.Time
+	}
+
+	opts := []history.FilterOpt{}
+	opts = append(opts, FilterOptsFromStrings(in.GetEntityType(), history.WithEntityType)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetProfileName(), history.WithProfileName)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetLabelFilter(), history.WithLabel)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetStatus(), history.WithStatus)...)
+	opts = append(opts, history.WithProjectID(projectID))
+
+	filter, err := history.NewListEvaluationFilter(opts...)
+	if err != nil {
+		return util.UserVisibleError(codes.InvalidArgument, "invalid filter: %s", err)
+	}
+
+	// Start watching before looking for evaluations, so that none stored
+	// in the meantime is missed.
+	notifications := s.history.WatchEvaluations(ctx, projectID)
+
+	// Evaluations stored by other server instances are not notified, so
+	// we also look for them periodically.
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.sendNewEvaluations(ctx, stream, watch, filter); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notifications:
+		case <-ticker.C:
+		}
+	}
+}
+
+// evaluationWatch tracks the evaluations already streamed by
+// WatchEvaluationResults.
+type evaluationWatch struct {
+	// since is the time requested by the client, evaluations stored at
+	// or before it are never sent.
+	since time.Time
+	// newest is the time of the newest evaluation sent.
+	newest time.Time
+	// sent holds the time of the evaluations sent within the overlap
+	// window, keyed by evaluation ID.
+	sent map[uuid.UUID]time.Time
+}
+
+// sendNewEvaluations streams the evaluations stored after those already
+// sent, oldest first.
+//
+// Evaluations may share the same time, and may be committed after newer
+// ones, so the evaluations within the overlap window are listed again and
+// those already sent are skipped.
+func (s *Server) sendNewEvaluations(
+	ctx context.Context,
+	stream minderv1.EvalResultsService_WatchEvaluationResultsServer,
+	watch *evaluationWatch,
+	filter history.ListEvaluationFilter,
+) error {
+	from := watch.since
+	if overlap := watch.newest.Add(-watchOverlap); overlap.After(from) {
+		from = overlap
+	}
+	for id, evaluatedAt := range watch.sent {
+		if !evaluatedAt.After(from) {
+			delete(watch.sent, id)
+		}
+	}
+
+	for {
+		rows, err := s.listEvaluationHistoryPage(ctx, &history.ListEvaluationCursor{
+			Time:      from,
+			Direction: history.Prev,
+		}, filter)
+		if err != nil {
+			return err
+		}
+
+		data, err := fromEvaluationHistoryRows(ctx, rows)
+		if err != nil {
+			return err
+		}
+
+		// Rows are sorted newest first
+		for i := len(data) - 1; i >= 0; i-- {
+			evaluationID := rows[i].EvalHistoryRow.EvaluationID
+			evaluatedAt := rows[i].EvalHistoryRow.EvaluatedAt
+			if _, ok := watch.sent[evaluationID]; ok {
+				continue
+			}
+			err := stream.Send(&minderv1.WatchEvaluationResultsResponse{
+				Evaluation: data[i],
+				Cursor: base64.StdEncoding.EncodeToString(
+					[]byte(fmt.Sprintf("-%d", evaluatedAt.UnixMicro())),
+				),
+			})
+			if err != nil {
+				return err
+			}
+			watch.sent[evaluationID] = evaluatedAt
+			if evaluatedAt.After(watch.newest) {
+				watch.newest = evaluatedAt
+			}
+		}
+
+		// A partial page means we're caught up
+		if len(rows) < int(maxPageSize) {
+			return nil
+		}
+
+		// Evaluations sharing the time of the newest one in the page
+		// may continue on the next page, so we list them again, unless
+		// the whole page shares that time.
+		newest := rows[0].EvalHistoryRow.EvaluatedAt
+		next := newest.Add(-time.Microsecond)
+		if !next.After(from) {
+			next = newest
+		}
+		from = next
+	}
+}
+
+func (s *Server) listEvaluationHistoryPage(
+	ctx context.Context,
+	cursor *history.ListEvaluationCursor,
+	filter history.ListEvaluationFilter,
+) ([]*history.OneEvalHistoryAndEntity, error) {
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error starting transaction")
+		return nil, status.Error(codes.Internal, evalErrMsg)
+	}
+	defer s.store.Rollback(tx)
+
+	result, err := s.history.ListEvaluationHistory(
+		ctx,
+		s.store.GetQuerierWithTransaction(tx),
+		cursor,
+		maxPageSize,
+		filter,
+		false,
+	)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error retrieving evaluations")
+		return nil, status.Error(codes.Internal, evalErrMsg)
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		// non-fatal: this just refreshes properties but doesn't write any new data
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error committing transaction")
+	}
+
+	return result.Data, nil
+}
+
 func fromEvaluationHistoryRows(
 	ctx context.Context,
 	rows []*history.OneEvalHistoryAndEntity,
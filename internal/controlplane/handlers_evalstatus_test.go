@@ -844,3 +844,229 @@ func TestListEvaluationHistoryIncludeOutputs(t *testing.T) {
 		})
 	}
 }
+
+type fakeWatchStream struct {
+	minderv1.EvalResultsService_WatchEvaluationResultsServer
+	ctx    context.Context
+	onSend func(*minderv1.WatchEvaluationResultsResponse)
+}
+
+func (f *fakeWatchStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchStream) Send(resp *minderv1.WatchEvaluationResultsResponse) error {
+	f.onSend(resp)
+	return nil
+}
+
+func TestWatchEvaluationResults(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.New()
+	entityID := uuid.New()
+	resumeFrom := time.UnixMicro(1700000000000000).UTC()
+	first := resumeFrom.Add(time.Second)
+	second := first.Add(time.Second)
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockHist := mockhistory.NewMockEvaluationHistoryService(ctrl)
+
+	mockStore.EXPECT().BeginTransaction().Return(nil, nil).Times(2)
+	mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).Times(2)
+	mockStore.EXPECT().Rollback(gomock.Any()).Return(nil).Times(2)
+	mockStore.EXPECT().Commit(gomock.Any()).Return(nil).Times(2)
+
+	notifications := make(chan struct{}, 1)
+	mockHist.EXPECT().WatchEvaluations(gomock.Any(), projectID).Return(notifications)
+
+	evalAt := func(ts time.Time) *history.ListEvaluationHistoryResult {
+		return &history.ListEvaluationHistoryResult{
+			Data: []*history.OneEvalHistoryAndEntity{{
+				EntityWithProperties: entmodels.NewEntityWithPropertiesFromInstance(
+					entmodels.EntityInstance{
+						ID:   entityID,
+						Type: minderv1.Entity_ENTITY_REPOSITORIES,
+						Name: "mindersec/minder",
+					}, nil),
+				EvalHistoryRow: db.ListEvaluationHistoryRow{
+					EvaluationID:     uuid.New(),
+					EvaluatedAt:      ts,
+					EntityType:       db.EntitiesRepository,
+					EntityID:         entityID,
+					ProjectID:        projectID,
+					RuleSeverity:     "unknown",
+					EvaluationStatus: db.EvalStatusTypesFailure,
+				},
+			}},
+		}
+	}
+
+	gomock.InOrder(
+		mockHist.EXPECT().
+			ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), maxPageSize, gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _ db.ExtendQuerier, cursor *history.ListEvaluationCursor,
+				_ uint32, _ history.ListEvaluationFilter, _ bool) (*history.ListEvaluationHistoryResult, error) {
+				require.Equal(t, history.Prev, cursor.Direction)
+				require.True(t, resumeFrom.Equal(cursor.Time))
+				return evalAt(first), nil
+			}),
+		mockHist.EXPECT().
+			ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), maxPageSize, gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _ db.ExtendQuerier, cursor *history.ListEvaluationCursor,
+				_ uint32, _ history.ListEvaluationFilter, _ bool) (*history.ListEvaluationHistoryResult, error) {
+				// evaluations sent recently are listed again, in case
+				// older ones were committed in the meantime
+				require.True(t, resumeFrom.Equal(cursor.Time))
+				return evalAt(second), nil
+			}),
+	)
+
+	ctx, cancel := context.WithCancel(engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	}))
+	defer cancel()
+
+	var sent []*minderv1.WatchEvaluationResultsResponse
+	stream := &fakeWatchStream{
+		ctx: ctx,
+		onSend: func(resp *minderv1.WatchEvaluationResultsResponse) {
+			sent = append(sent, resp)
+			if len(sent) == 1 {
+				notifications <- struct{}{}
+			} else {
+				cancel()
+			}
+		},
+	}
+
+	server := Server{store: mockStore, history: mockHist}
+	err := server.WatchEvaluationResults(&minderv1.WatchEvaluationResultsRequest{
+		Cursor: makeCursor([]byte("-1700000000000000"), 0).Cursor,
+	}, stream)
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2)
+	require.True(t, first.Equal(sent[0].GetEvaluation().GetEvaluatedAt().AsTime()))
+	require.True(t, second.Equal(sent[1].GetEvaluation().GetEvaluatedAt().AsTime()))
+
+	// The cursor resumes right after the evaluation it was sent with
+	resumed, err := history.ParseListEvaluationCursor(sent[0].GetCursor())
+	require.NoError(t, err)
+	require.True(t, first.Equal(resumed.Time))
+}
+
+func TestWatchEvaluationResultsSameTime(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.New()
+	entityID := uuid.New()
+	resumeFrom := time.UnixMicro(1700000000000000).UTC()
+	last := resumeFrom.Add(time.Minute)
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockHist := mockhistory.NewMockEvaluationHistoryService(ctrl)
+
+	mockStore.EXPECT().BeginTransaction().Return(nil, nil).Times(2)
+	mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).Times(2)
+	mockStore.EXPECT().Rollback(gomock.Any()).Return(nil).Times(2)
+	mockStore.EXPECT().Commit(gomock.Any()).Return(nil).Times(2)
+
+	mockHist.EXPECT().WatchEvaluations(gomock.Any(), projectID).Return(make(chan struct{}))
+
+	evalAt := func(id uuid.UUID, ts time.Time) *history.OneEvalHistoryAndEntity {
+		return &history.OneEvalHistoryAndEntity{
+			EntityWithProperties: entmodels.NewEntityWithPropertiesFromInstance(
+				entmodels.EntityInstance{
+					ID:   entityID,
+					Type: minderv1.Entity_ENTITY_REPOSITORIES,
+					Name: "mindersec/minder",
+				}, nil),
+			EvalHistoryRow: db.ListEvaluationHistoryRow{
+				EvaluationID:     id,
+				EvaluatedAt:      ts,
+				EntityType:       db.EntitiesRepository,
+				EntityID:         entityID,
+				ProjectID:        projectID,
+				RuleSeverity:     "unknown",
+				EvaluationStatus: db.EvalStatusTypesFailure,
+			},
+		}
+	}
+
+	// A full page, newest first, ending with an evaluation at the same
+	// time as the first one of the next page
+	lastID := uuid.New()
+	firstPage := []*history.OneEvalHistoryAndEntity{evalAt(lastID, last)}
+	for i := 1; i < int(maxPageSize); i++ {
+		firstPage = append(firstPage, evalAt(uuid.New(), last.Add(-time.Duration(i)*time.Second)))
+	}
+	sameTimeID := uuid.New()
+
+	gomock.InOrder(
+		mockHist.EXPECT().
+			ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), maxPageSize, gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _ db.ExtendQuerier, cursor *history.ListEvaluationCursor,
+				_ uint32, _ history.ListEvaluationFilter, _ bool) (*history.ListEvaluationHistoryResult, error) {
+				require.True(t, resumeFrom.Equal(cursor.Time))
+				return &history.ListEvaluationHistoryResult{Data: firstPage}, nil
+			}),
+		mockHist.EXPECT().
+			ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), maxPageSize, gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _ db.ExtendQuerier, cursor *history.ListEvaluationCursor,
+				_ uint32, _ history.ListEvaluationFilter, _ bool) (*history.ListEvaluationHistoryResult, error) {
+				// the evaluations at the time of the newest one are
+				// listed again
+				require.True(t, last.Add(-time.Microsecond).Equal(cursor.Time))
+				return &history.ListEvaluationHistoryResult{
+					Data: []*history.OneEvalHistoryAndEntity{
+						evalAt(sameTimeID, last),
+						evalAt(lastID, last),
+					},
+				}, nil
+			}),
+	)
+
+	ctx, cancel := context.WithCancel(engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	}))
+	defer cancel()
+
+	var sent []*minderv1.WatchEvaluationResultsResponse
+	stream := &fakeWatchStream{
+		ctx: ctx,
+		onSend: func(resp *minderv1.WatchEvaluationResultsResponse) {
+			sent = append(sent, resp)
+			if len(sent) == int(maxPageSize)+1 {
+				cancel()
+			}
+		},
+	}
+
+	server := Server{store: mockStore, history: mockHist}
+	err := server.WatchEvaluationResults(&minderv1.WatchEvaluationResultsRequest{
+		Cursor: makeCursor([]byte("-1700000000000000"), 0).Cursor,
+	}, stream)
+	require.NoError(t, err)
+
+	// Every evaluation is sent exactly once
+	require.Len(t, sent, int(maxPageSize)+1)
+	require.Equal(t, lastID.String(), sent[maxPageSize-1].GetEvaluation().GetId())
+	require.Equal(t, sameTimeID.String(), sent[maxPageSize].GetEvaluation().GetId())
+}
+
+func TestWatchEvaluationResultsInvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	server := Server{}
+	err := server.WatchEvaluationResults(&minderv1.WatchEvaluationResultsRequest{
+		Cursor: "not base64!",
+	}, &fakeWatchStream{ctx: context.Background()})
+	require.ErrorContains(t, err, "invalid cursor")
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/exemptions"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// CreateRuleExemption creates an exemption for a rule of a profile and an entity
+func (s *Server) CreateRuleExemption(ctx context.Context,
+	in *minderv1.CreateRuleExemptionRequest) (*minderv1.CreateRuleExemptionResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	exemption := in.GetExemption()
+	if exemption == nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "exemption is required")
+	}
+
+	entity := exemption.GetEntity()
+	if entity.GetId() == "" {
+		if entity.GetName() == "" || entity.GetType() == minderv1.Entity_ENTITY_UNSPECIFIED {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "entity id, or type and name are required")
+		}
+
+		// Look up ID given name, the provider comes from the context
+		dbProvider, err := s.providerStore.GetByName(ctx, entityCtx.Project.ID, entityCtx.Provider.Name)
+		if err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+		}
+		dbEntity, err := s.store.GetEntityByName(ctx, db.GetEntityByNameParams{
+			ProjectID:  entityCtx.Project.ID,
+			EntityType: entities.EntityTypeToDB(entity.GetType()),
+			Name:       entity.GetName(),
+			ProviderID: dbProvider.ID,
+		})
+		if err != nil {
+			return nil, util.UserVisibleError(codes.NotFound,
+				"Unable to find entity %q of type %s in provider %s",
+				entity.GetName(), entity.GetType(), dbProvider.Name)
+		}
+		entity.Id = dbEntity.ID.String()
+	}
+
+	ex, err := s.exemptions.Create(ctx, entityCtx.Project.ID, exemption, auth.IdentityFromContext(ctx).Human())
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.CreateRuleExemptionResponse{Exemption: ex}, nil
+}
+
+// GetRuleExemption gets an exemption by its ID
+func (s *Server) GetRuleExemption(ctx context.Context,
+	in *minderv1.GetRuleExemptionRequest) (*minderv1.GetRuleExemptionResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid exemption ID")
+	}
+
+	ex, err := s.exemptions.Get(ctx, entityCtx.Project.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.GetRuleExemptionResponse{Exemption: ex}, nil
+}
+
+// ListRuleExemptions lists the exemptions of the project
+func (s *Server) ListRuleExemptions(ctx context.Context,
+	in *minderv1.ListRuleExemptionsRequest) (*minderv1.ListRuleExemptionsResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	exs, err := s.exemptions.List(ctx, entityCtx.Project.ID, in.GetProfile(), in.GetIncludeExpired())
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.ListRuleExemptionsResponse{Exemptions: exs}, nil
+}
+
+// UpdateRuleExemption updates the justification, approver or expiry of an exemption
+func (s *Server) UpdateRuleExemption(ctx context.Context,
+	in *minderv1.UpdateRuleExemptionRequest) (*minderv1.UpdateRuleExemptionResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid exemption ID")
+	}
+
+	params := exemptions.UpdateParams{
+		Justification: in.GetJustification(),
+		Approver:      in.GetApprover(),
+	}
+	if in.GetExpiresAt() != nil {
+		expiresAt := in.GetExpiresAt().AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	ex, err := s.exemptions.Update(ctx, entityCtx.Project.ID, id, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.UpdateRuleExemptionResponse{Exemption: ex}, nil
+}
+
+// DeleteRuleExemption deletes an exemption
+func (s *Server) DeleteRuleExemption(ctx context.Context,
+	in *minderv1.DeleteRuleExemptionRequest) (*minderv1.DeleteRuleExemptionResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid exemption ID")
+	}
+
+	if err := s.exemptions.Delete(ctx, entityCtx.Project.ID, id); err != nil {
+		return nil, err
+	}
+
+	return &minderv1.DeleteRuleExemptionResponse{}, nil
+}
@@ -74,6 +74,14 @@ func (s *Server) GetAuthorizationURL(ctx context.Context,
 		!flags.Bool(ctx, s.featureFlags, flags.GitLabProvider) {
 		return nil, util.UserVisibleError(codes.Unimplemented, "GitLab provider is not enabled")
 	}
+	if providerClass == string(db.ProviderClassBitbucket) &&
+		!flags.Bool(ctx, s.featureFlags, flags.BitbucketProvider) {
+		return nil, util.UserVisibleError(codes.Unimplemented, "Bitbucket provider is not enabled")
+	}
+	if providerClass == string(db.ProviderClassGitea) &&
+		!flags.Bool(ctx, s.featureFlags, flags.GiteaProvider) {
+		return nil, util.UserVisibleError(codes.Unimplemented, "Gitea provider is not enabled")
+	}
 
 	// Telemetry logging
 	logger.BusinessRecord(ctx).Provider = providerName
@@ -227,6 +235,12 @@ func (s *Server) alllowedRedirectURL(redirectUrl *url.URL) bool {
 	if providerCfg.GitLab != nil && strings.HasPrefix(providerCfg.GitLab.RedirectURI, hostUrlString) {
 		return true
 	}
+	if providerCfg.Bitbucket != nil && strings.HasPrefix(providerCfg.Bitbucket.RedirectURI, hostUrlString) {
+		return true
+	}
+	if providerCfg.Gitea != nil && strings.HasPrefix(providerCfg.Gitea.RedirectURI, hostUrlString) {
+		return true
+	}
 
 	if slices.ContainsFunc(s.cfg.HTTPServer.CORS.AllowOrigins, func(u string) bool {
 		return u == hostUrlString || u+"/" == hostUrlString
@@ -536,6 +536,70 @@ func (s *Server) UpdateProfile(ctx context.Context,
 	}, nil
 }
 
+// PlanProfile computes the impact of creating or updating a profile, without
+// applying it
+func (s *Server) PlanProfile(ctx context.Context,
+	in *minderv1.PlanProfileRequest) (*minderv1.PlanProfileResponse, error) {
+	profile := in.GetProfile()
+	if err := profile.Validate(); err != nil {
+		if errors.Is(err, minderv1.ErrValidationFailed) {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "Couldn't plan profile: %s", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid profile: %v", err)
+	}
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	plan, err := s.planner.PlanProfile(ctx, entityCtx.Project.ID, profile)
+	if err != nil {
+		// validation errors are already meaningful
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "error planning profile: %v", err)
+	}
+
+	resp := &minderv1.PlanProfileResponse{
+		Selections:  make([]*minderv1.ProfilePlanSelection, 0, len(plan.Selections)),
+		Evaluations: make([]*minderv1.ProfilePlanEvaluation, 0, len(plan.Evaluations)),
+	}
+	for _, sel := range plan.Selections {
+		resp.Selections = append(resp.Selections, &minderv1.ProfilePlanSelection{
+			Entity:         entityInstanceToTypedID(sel.Entity),
+			SelectedBefore: sel.SelectedBefore,
+			SelectedAfter:  sel.SelectedAfter,
+		})
+	}
+	for _, ev := range plan.Evaluations {
+		resp.Evaluations = append(resp.Evaluations, &minderv1.ProfilePlanEvaluation{
+			Entity:         entityInstanceToTypedID(ev.Entity),
+			RuleTypeName:   ev.RuleTypeName,
+			RuleName:       ev.RuleName,
+			CurrentStatus:  ev.CurrentStatus,
+			PlannedStatus:  ev.PlannedStatus,
+			PlannedDetails: ev.PlannedDetails,
+		})
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
+
+	return resp, nil
+}
+
+func entityInstanceToTypedID(ent db.EntityInstance) *minderv1.EntityTypedId {
+	return &minderv1.EntityTypedId{
+		Type: entities.EntityTypeFromDB(ent.EntityType),
+		Id:   ent.ID.String(),
+		Name: ent.Name,
+	}
+}
+
 // GetProfileStatusByName retrieves profile status by name
 func (s *Server) GetProfileStatusByName(
 	ctx context.Context,
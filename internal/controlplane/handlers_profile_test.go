@@ -6,6 +6,7 @@ package controlplane
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -15,13 +16,19 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/db/embedded"
+	"github.com/mindersec/minder/internal/engine"
 	"github.com/mindersec/minder/internal/engine/engcontext"
+	mock_engine "github.com/mindersec/minder/internal/engine/mock"
 	stubeventer "github.com/mindersec/minder/internal/events/stubs"
 	"github.com/mindersec/minder/internal/providers"
 	"github.com/mindersec/minder/internal/util"
@@ -1749,3 +1756,116 @@ func TestGetProfileByName(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanProfile(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	repositoryID := uuid.New()
+	repository := db.EntityInstance{
+		ID:         repositoryID,
+		EntityType: db.EntitiesRepository,
+		Name:       "foo/bar",
+		ProjectID:  projectID,
+	}
+	profile := &minderv1.Profile{
+		Name: "test-profile",
+		Repository: []*minderv1.Profile_Rule{
+			{Type: "rule_type_1", Def: &structpb.Struct{}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		plan      *engine.ProfilePlan
+		planErr   error
+		wantCode  codes.Code
+		wantResp  *minderv1.PlanProfileResponse
+		wantError bool
+	}{
+		{
+			name: "plan is converted to the response",
+			plan: &engine.ProfilePlan{
+				Selections: []engine.PlannedSelection{
+					{Entity: repository, SelectedBefore: false, SelectedAfter: true},
+				},
+				Evaluations: []engine.PlannedEvaluation{
+					{
+						Entity:         repository,
+						RuleTypeName:   "rule_type_1",
+						RuleName:       "rule_type_1",
+						PlannedStatus:  "failure",
+						PlannedDetails: "branch protection is off",
+					},
+				},
+			},
+			wantResp: &minderv1.PlanProfileResponse{
+				Selections: []*minderv1.ProfilePlanSelection{
+					{
+						Entity: &minderv1.EntityTypedId{
+							Type: minderv1.Entity_ENTITY_REPOSITORIES,
+							Id:   repositoryID.String(),
+							Name: "foo/bar",
+						},
+						SelectedAfter: true,
+					},
+				},
+				Evaluations: []*minderv1.ProfilePlanEvaluation{
+					{
+						Entity: &minderv1.EntityTypedId{
+							Type: minderv1.Entity_ENTITY_REPOSITORIES,
+							Id:   repositoryID.String(),
+							Name: "foo/bar",
+						},
+						RuleTypeName:   "rule_type_1",
+						RuleName:       "rule_type_1",
+						PlannedStatus:  "failure",
+						PlannedDetails: "branch protection is off",
+					},
+				},
+			},
+		},
+		{
+			name:      "validation errors are passed through",
+			planErr:   util.UserVisibleError(codes.InvalidArgument, "profile contained invalid rule"),
+			wantCode:  codes.InvalidArgument,
+			wantError: true,
+		},
+		{
+			name:      "other errors are internal",
+			planErr:   errors.New("cannot instantiate provider"),
+			wantCode:  codes.Internal,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProjectByID(gomock.Any(), projectID).Return(db.Project{ID: projectID}, nil)
+
+			planner := mock_engine.NewMockPlanner(ctrl)
+			planner.EXPECT().PlanProfile(gomock.Any(), projectID, profile).Return(tt.plan, tt.planErr)
+
+			s := &Server{
+				store:   mockStore,
+				planner: planner,
+			}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := s.PlanProfile(ctx, &minderv1.PlanProfileRequest{Profile: profile})
+			if tt.wantError {
+				require.Error(t, err)
+				require.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.wantResp, resp), "got %v", resp)
+		})
+	}
+}
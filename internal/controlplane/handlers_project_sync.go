@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// SetProjectSyncSource sets the git repository the project is synced from
+func (s *Server) SetProjectSyncSource(ctx context.Context,
+	in *minderv1.SetProjectSyncSourceRequest) (*minderv1.SetProjectSyncSourceResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	source := in.GetSource()
+	if source == nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "source is required")
+	}
+	if source.GetProvider() == "" {
+		// the provider may also be given in the context
+		source = proto.Clone(source).(*minderv1.ProjectSyncSource)
+		source.Provider = entityCtx.Provider.Name
+	}
+
+	status, err := s.projectSync.SetSource(ctx, entityCtx.Project.ID, source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.SetProjectSyncSourceResponse{Status: status}, nil
+}
+
+// GetProjectSyncStatus returns the repository the project is synced from,
+// and the outcome of the last sync
+func (s *Server) GetProjectSyncStatus(ctx context.Context,
+	_ *minderv1.GetProjectSyncStatusRequest) (*minderv1.GetProjectSyncStatusResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	status, err := s.projectSync.GetStatus(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.GetProjectSyncStatusResponse{Status: status}, nil
+}
+
+// DeleteProjectSyncSource stops syncing the project from a git repository
+func (s *Server) DeleteProjectSyncSource(ctx context.Context,
+	_ *minderv1.DeleteProjectSyncSourceRequest) (*minderv1.DeleteProjectSyncSourceResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	err := entityCtx.ValidateProject(ctx, s.store)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	if err := s.projectSync.DeleteSource(ctx, entityCtx.Project.ID); err != nil {
+		return nil, err
+	}
+
+	return &minderv1.DeleteProjectSyncSourceResponse{}, nil
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/reports"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// reportRenderer renders a compliance report in a given format
+type reportRenderer struct {
+	render      func(*reports.Report) ([]byte, error)
+	contentType string
+}
+
+var reportRenderers = map[minderv1.ComplianceReportFormat]reportRenderer{
+	minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_SARIF: {reports.ToSARIF, reports.SARIFContentType},
+	minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_CSV:   {reports.ToCSV, reports.CSVContentType},
+	minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_OSCAL: {reports.ToOSCAL, reports.OSCALContentType},
+}
+
+// GenerateComplianceReport renders the latest evaluation results of a
+// project as a SARIF, CSV or OSCAL document
+func (s *Server) GenerateComplianceReport(
+	ctx context.Context,
+	in *minderv1.GenerateComplianceReportRequest,
+) (*minderv1.GenerateComplianceReportResponse, error) {
+	renderer, ok := reportRenderers[in.GetFormat()]
+	if !ok {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "unsupported report format: %s", in.GetFormat())
+	}
+
+	listReq := &minderv1.ListEvaluationResultsRequest{Context: in.GetContext()}
+	switch sel := in.GetProfileSelector().(type) {
+	case *minderv1.GenerateComplianceReportRequest_Profile:
+		listReq.ProfileSelector = &minderv1.ListEvaluationResultsRequest_Profile{Profile: sel.Profile}
+	case *minderv1.GenerateComplianceReportRequest_LabelFilter:
+		listReq.ProfileSelector = &minderv1.ListEvaluationResultsRequest_LabelFilter{LabelFilter: sel.LabelFilter}
+	}
+
+	results, err := s.ListEvaluationResults(ctx, listReq)
+	if err != nil {
+		return nil, err
+	}
+
+	report := buildComplianceReport(results)
+	report.ProjectID = engcontext.EntityFromContext(ctx).Project.ID.String()
+	report.GeneratedAt = time.Now().UTC()
+	report.Sort()
+
+	content, err := renderer.render(report)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error rendering report: %v", err)
+	}
+
+	return &minderv1.GenerateComplianceReportResponse{
+		Content:     content,
+		ContentType: renderer.contentType,
+		GeneratedAt: timestamppb.New(report.GeneratedAt),
+	}, nil
+}
+
+// buildComplianceReport flattens the evaluation results into one finding
+// per rule and entity
+func buildComplianceReport(results *minderv1.ListEvaluationResultsResponse) *reports.Report {
+	report := &reports.Report{}
+	for _, ent := range results.GetEntities() {
+		entity := ent.GetEntity()
+		for _, prof := range ent.GetProfiles() {
+			for _, res := range prof.GetResults() {
+				sev := res.GetSeverity().GetValue()
+				report.Findings = append(report.Findings, reports.Finding{
+					EvaluationID: res.GetRuleEvaluationId(),
+					Profile:      prof.GetProfileStatus().GetProfileName(),
+					// RuleName carries the name of the rule in the profile
+					RuleName:          res.GetRuleName(), //nolint:staticcheck // no replacement field yet
+					RuleType:          res.GetRuleTypeName(),
+					RuleDisplayName:   res.GetRuleDisplayName(),
+					Severity:          cmp.Or(sev.AsString(), "unknown"),
+					EntityType:        entity.GetType().ToString(),
+					EntityID:          entity.GetId(),
+					EntityName:        entity.GetName(),
+					Status:            res.GetStatus(),
+					Details:           res.GetDetails(),
+					Guidance:          res.GetGuidance(),
+					AlertStatus:       res.GetAlert().GetStatus(),
+					AlertURL:          res.GetAlert().GetUrl(),
+					RemediationStatus: res.GetRemediationStatus(),
+					RemediationURL:    res.GetRemediationUrl(),
+					LastUpdated:       res.GetLastUpdated().AsTime(),
+				})
+			}
+		}
+	}
+	return report
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	entmodels "github.com/mindersec/minder/internal/entities/models"
+	mockpropssvc "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	"github.com/mindersec/minder/internal/reports"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestGenerateComplianceReport(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	profileID := uuid.New()
+	entityID := uuid.New()
+
+	tests := []struct {
+		name        string
+		format      minderv1.ComplianceReportFormat
+		contentType string
+		check       func(t *testing.T, content []byte)
+	}{
+		{
+			name:        "sarif",
+			format:      minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_SARIF,
+			contentType: reports.SARIFContentType,
+			check: func(t *testing.T, content []byte) {
+				t.Helper()
+				var doc struct {
+					Runs []struct {
+						Results []struct {
+							RuleID string `json:"ruleId"`
+							Kind   string `json:"kind"`
+							Level  string `json:"level"`
+						} `json:"results"`
+					} `json:"runs"`
+				}
+				require.NoError(t, json.Unmarshal(content, &doc))
+				require.Len(t, doc.Runs, 1)
+				require.Len(t, doc.Runs[0].Results, 1)
+				require.Equal(t, "branch_protection", doc.Runs[0].Results[0].RuleID)
+				require.Equal(t, "fail", doc.Runs[0].Results[0].Kind)
+				require.Equal(t, "error", doc.Runs[0].Results[0].Level)
+			},
+		},
+		{
+			name:        "csv",
+			format:      minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_CSV,
+			contentType: reports.CSVContentType,
+			check: func(t *testing.T, content []byte) {
+				t.Helper()
+				records, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 2)
+				require.Contains(t, records[1], "security-profile")
+				require.Contains(t, records[1], "mindersec/minder")
+				require.Contains(t, records[1], "failure")
+				require.Contains(t, records[1], "high")
+			},
+		},
+		{
+			name:        "oscal",
+			format:      minderv1.ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_OSCAL,
+			contentType: reports.OSCALContentType,
+			check: func(t *testing.T, content []byte) {
+				t.Helper()
+				var doc map[string]any
+				require.NoError(t, json.Unmarshal(content, &doc))
+				require.Contains(t, doc, "assessment-results")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockProps := mockpropssvc.NewMockPropertiesService(ctrl)
+
+			efp := entmodels.NewEntityWithPropertiesFromInstance(
+				entmodels.EntityInstance{
+					ID:   entityID,
+					Type: minderv1.Entity_ENTITY_REPOSITORIES,
+					Name: "mindersec/minder",
+				}, nil)
+
+			mockProps.EXPECT().
+				EntityWithPropertiesByID(gomock.Any(), entityID, gomock.Any()).
+				Return(efp, nil)
+			mockProps.EXPECT().
+				RetrieveAllPropertiesForEntity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil)
+			mockStore.EXPECT().
+				GetProfileStatusByProject(gomock.Any(), projectID).
+				Return([]db.GetProfileStatusByProjectRow{
+					{ID: profileID, ProfileStatus: db.EvalStatusTypesFailure},
+				}, nil)
+			mockStore.EXPECT().
+				ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).
+				Return([]db.ListProfilesByProjectIDAndLabelRow{
+					{Profile: db.Profile{ID: profileID, Name: "security-profile", UpdatedAt: time.Now()}},
+				}, nil)
+			mockStore.EXPECT().
+				ListRuleEvaluationsByProfileId(gomock.Any(), gomock.Any()).
+				Return([]db.ListRuleEvaluationsByProfileIdRow{
+					{
+						RuleEvaluationID:      uuid.New(),
+						EntityType:            db.EntitiesRepository,
+						EntityID:              entityID,
+						EntityName:            "mindersec/minder",
+						ProjectID:             projectID,
+						RuleTypeID:            uuid.New(),
+						RuleName:              "protect_main",
+						RuleTypeName:          "branch_protection",
+						RuleTypeGuidance:      "Enable branch protection",
+						RuleTypeReleasePhase:  db.ReleaseStatusAlpha,
+						EvalStatus:            db.EvalStatusTypesFailure,
+						EvalDetails:           "branch main is not protected",
+						EvalLastUpdated:       time.Now(),
+						RemStatus:             db.RemediationStatusTypesSkipped,
+						RemLastUpdated:        time.Now(),
+						AlertStatus:           db.AlertStatusTypesOff,
+						AlertLastUpdated:      time.Now(),
+						RuleTypeSeverityValue: db.SeverityHigh,
+					},
+				}, nil)
+
+			server := Server{store: mockStore, props: mockProps}
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+
+			resp, err := server.GenerateComplianceReport(ctx, &minderv1.GenerateComplianceReportRequest{
+				Format: tt.format,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.contentType, resp.GetContentType())
+			require.NotNil(t, resp.GetGeneratedAt())
+			tt.check(t, resp.GetContent())
+		})
+	}
+}
+
+func TestGenerateComplianceReportUnspecifiedFormat(t *testing.T) {
+	t.Parallel()
+
+	server := Server{}
+	ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: uuid.New()},
+	})
+
+	_, err := server.GenerateComplianceReport(ctx, &minderv1.GenerateComplianceReportRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
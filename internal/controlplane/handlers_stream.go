@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// StreamFromUnaryInterceptors applies the given unary interceptors to
+// server-streaming RPCs.
+//
+// The request message is received up-front, and the whole stream is then
+// run as the handler of the unary interceptor chain. This way, request
+// validation, authentication, authorization and logging behave exactly
+// as they do for unary RPCs.
+func StreamFromUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			// Client streams, such as the gRPC reflection service, don't
+			// carry a single request to run the interceptors against.
+			if strings.HasPrefix(info.FullMethod, "/minder.") {
+				return status.Error(codes.Unimplemented, "client streaming RPCs are not supported")
+			}
+			return handler(srv, ss)
+		}
+
+		req, err := newRequestForMethod(info.FullMethod)
+		if err != nil {
+			return status.Errorf(codes.Internal, "error creating request message: %v", err)
+		}
+		if err := ss.RecvMsg(req); err != nil {
+			return err
+		}
+
+		unaryInfo := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: info.FullMethod,
+		}
+		_, err = chainUnaryInterceptors(interceptors)(ss.Context(), req, unaryInfo,
+			func(ctx context.Context, req any) (any, error) {
+				return nil, handler(srv, &interceptedServerStream{
+					ServerStream: ss,
+					ctx:          ctx,
+					req:          req.(proto.Message),
+				})
+			})
+		return err
+	}
+}
+
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// newRequestForMethod returns an empty request message for the given
+// fully qualified gRPC method name, e.g. `/minder.v1.Service/Method`.
+func newRequestForMethod(fullMethod string) (proto.Message, error) {
+	formattedName := strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(formattedName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to find descriptor for %q: %w", formattedName, err)
+	}
+	method, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a method", formattedName)
+	}
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("unable to find request type for %q: %w", formattedName, err)
+	}
+	return msgType.New().Interface(), nil
+}
+
+// interceptedServerStream replays the request message which was received
+// by StreamFromUnaryInterceptors, and carries the context decorated by the
+// unary interceptors.
+type interceptedServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	req      proto.Message
+	received bool
+}
+
+func (s *interceptedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *interceptedServerStream) RecvMsg(m any) error {
+	if s.received {
+		return s.ServerStream.RecvMsg(m)
+	}
+	s.received = true
+
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	proto.Merge(msg, s.req)
+	return nil
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+type ctxKey struct{}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServerStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), f.req)
+	return nil
+}
+
+func TestStreamFromUnaryInterceptors(t *testing.T) {
+	t.Parallel()
+
+	info := &grpc.StreamServerInfo{
+		FullMethod:     minderv1.EvalResultsService_WatchEvaluationResults_FullMethodName,
+		IsServerStream: true,
+	}
+	req := &minderv1.WatchEvaluationResultsRequest{ProfileName: []string{"my-profile"}}
+
+	var order []string
+	decorate := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		order = append(order, "first")
+		require.Equal(t, []string{"my-profile"}, req.(*minderv1.WatchEvaluationResultsRequest).GetProfileName())
+		return handler(context.WithValue(ctx, ctxKey{}, "decorated"), req)
+	}
+	second := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		order = append(order, "second")
+		require.Equal(t, minderv1.EvalResultsService_WatchEvaluationResults_FullMethodName, info.FullMethod)
+		return handler(ctx, req)
+	}
+
+	interceptor := StreamFromUnaryInterceptors(decorate, second)
+	err := interceptor(nil, &fakeServerStream{ctx: context.Background(), req: req}, info,
+		func(_ any, ss grpc.ServerStream) error {
+			order = append(order, "handler")
+			require.Equal(t, "decorated", ss.Context().Value(ctxKey{}))
+
+			got := &minderv1.WatchEvaluationResultsRequest{}
+			require.NoError(t, ss.RecvMsg(got))
+			require.True(t, proto.Equal(req, got))
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", "handler"}, order)
+}
+
+func TestStreamFromUnaryInterceptorsRejects(t *testing.T) {
+	t.Parallel()
+
+	info := &grpc.StreamServerInfo{
+		FullMethod:     minderv1.EvalResultsService_WatchEvaluationResults_FullMethodName,
+		IsServerStream: true,
+	}
+	deny := func(_ context.Context, _ any, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (any, error) {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+
+	interceptor := StreamFromUnaryInterceptors(deny)
+	err := interceptor(nil, &fakeServerStream{
+		ctx: context.Background(),
+		req: &minderv1.WatchEvaluationResultsRequest{},
+	}, info, func(_ any, _ grpc.ServerStream) error {
+		t.Fatal("handler should not be called")
+		return nil
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestStreamFromUnaryInterceptorsClientStreams(t *testing.T) {
+	t.Parallel()
+
+	interceptor := StreamFromUnaryInterceptors()
+
+	// Non-minder client streams, like reflection, are passed through
+	called := false
+	err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{
+		FullMethod:     "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+		IsClientStream: true,
+	}, func(_ any, _ grpc.ServerStream) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, called)
+
+	err = interceptor(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{
+		FullMethod:     "/minder.v1.SomeService/SomeClientStream",
+		IsClientStream: true,
+	}, func(_ any, _ grpc.ServerStream) error {
+		t.Fatal("handler should not be called")
+		return nil
+	})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
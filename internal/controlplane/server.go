@@ -39,6 +39,7 @@ import (
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
+	"github.com/mindersec/minder/internal/alertwebhooks"
 	"github.com/mindersec/minder/internal/api"
 	"github.com/mindersec/minder/internal/assets"
 	"github.com/mindersec/minder/internal/auth"
@@ -49,11 +50,15 @@ import (
 	"github.com/mindersec/minder/internal/crypto"
 	datasourcessvc "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine"
 	propSvc "github.com/mindersec/minder/internal/entities/properties/service"
 	entitySvc "github.com/mindersec/minder/internal/entities/service"
+	"github.com/mindersec/minder/internal/exemptions"
+	"github.com/mindersec/minder/internal/gitsync"
 	"github.com/mindersec/minder/internal/history"
 	"github.com/mindersec/minder/internal/invites"
 	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/marketplaces"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
@@ -101,12 +106,17 @@ type Server struct {
 	invites             invites.InviteService
 	ruleTypes           ruletypes.RuleTypeService
 	dataSourcesService  datasourcessvc.DataSourcesService
+	alertWebhooks       alertwebhooks.AlertWebhookService
+	exemptions          exemptions.ExemptionService
+	projectSync         gitsync.SyncService
+	marketplace         marketplaces.Marketplace
 	repos               reposvc.RepositoryService
 	entityService       entitySvc.EntityService
 	entityCreator       entitySvc.EntityCreator
 	roles               roles.RoleService
 	profiles            profiles.ProfileService
 	history             history.EvaluationHistoryService
+	planner             engine.Planner
 	ghProviders         service.GitHubProviderService
 	providerStore       providers.ProviderStore
 	ghClient            ghprov.ClientService
@@ -150,8 +160,13 @@ func NewServer(
 	roleService roles.RoleService,
 	profileService profiles.ProfileService,
 	historyService history.EvaluationHistoryService,
+	planner engine.Planner,
 	ruleService ruletypes.RuleTypeService,
 	dataSourcesService datasourcessvc.DataSourcesService,
+	alertWebhooks alertwebhooks.AlertWebhookService,
+	exemptionService exemptions.ExemptionService,
+	projectSync gitsync.SyncService,
+	marketplace marketplaces.Marketplace,
 	ghProviders service.GitHubProviderService,
 	providerManager manager.ProviderManager,
 	providerAuthManager manager.AuthManager,
@@ -173,8 +188,13 @@ func NewServer(
 		mt:                  serverMetrics,
 		profiles:            profileService,
 		history:             historyService,
+		planner:             planner,
 		ruleTypes:           ruleService,
 		dataSourcesService:  dataSourcesService,
+		alertWebhooks:       alertWebhooks,
+		exemptions:          exemptionService,
+		projectSync:         projectSync,
+		marketplace:         marketplace,
 		providerStore:       providerStore,
 		featureFlags:        featureFlagClient,
 		ghClient:            &ghprov.ClientServiceImplementation{},
@@ -276,6 +296,11 @@ func (s *Server) StartGRPCServer(ctx context.Context) error {
 	options := []grpc.ServerOption{
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.ChainUnaryInterceptor(interceptors...),
+		// Streaming RPCs go through the same interceptors as unary ones
+		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(recovery.WithRecoveryHandlerContext(recoveryHandler)),
+			StreamFromUnaryInterceptors(interceptors...),
+		),
 	}
 
 	otelGRPCOpts := s.getOTELGRPCInterceptorOpts()
@@ -345,7 +370,9 @@ func (s *Server) StartHTTPServer(ctx context.Context) error {
 		})
 	}
 
-	gwmux := runtime.NewServeMux()
+	gwmux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(mimeEventStream, newEventStreamMarshaler()),
+	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	// register the services (declared within register_handlers.go)
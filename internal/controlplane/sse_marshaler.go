@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// mimeEventStream is the MIME type of server-sent events
+const mimeEventStream = "text/event-stream"
+
+// eventStreamMarshaler streams the results of server-streaming RPCs as
+// server-sent events, one `data:` event per message, when the client
+// accepts `text/event-stream`.
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+var _ runtime.Delimited = (*eventStreamMarshaler)(nil)
+var _ runtime.StreamContentType = (*eventStreamMarshaler)(nil)
+
+func newEventStreamMarshaler() *eventStreamMarshaler {
+	return &eventStreamMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// Marshal implements the runtime.Marshaler interface
+func (m *eventStreamMarshaler) Marshal(v any) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSONPb does not indent by default, but make sure that a multi-line
+	// payload is still a single event.
+	var buf bytes.Buffer
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// Delimiter implements the runtime.Delimited interface. A blank line
+// terminates an event.
+func (*eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+// ContentType implements the runtime.Marshaler interface. Errors returned
+// before the stream starts are also sent as an event.
+func (*eventStreamMarshaler) ContentType(_ any) string {
+	return mimeEventStream
+}
+
+// StreamContentType implements the runtime.StreamContentType interface
+func (*eventStreamMarshaler) StreamContentType(_ any) string {
+	return mimeEventStream
+}
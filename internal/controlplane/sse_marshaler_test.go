@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestEventStreamMarshaler(t *testing.T) {
+	t.Parallel()
+
+	m := newEventStreamMarshaler()
+
+	out, err := m.Marshal(map[string]any{
+		"result": &minderv1.WatchEvaluationResultsResponse{Cursor: "abc"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `data: {"result":{"evaluation":null,"cursor":"abc"}}`+"\n", string(out))
+
+	// Together with the delimiter, each message ends with a blank line
+	require.Equal(t, "\n", string(m.Delimiter()))
+	require.Equal(t, mimeEventStream, m.StreamContentType(nil))
+}
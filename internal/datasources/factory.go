@@ -7,16 +7,38 @@ package datasources
 import (
 	"fmt"
 
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/datasources/graphql"
 	"github.com/mindersec/minder/internal/datasources/rest"
+	"github.com/mindersec/minder/internal/datasources/sql"
 	"github.com/mindersec/minder/internal/datasources/structured"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
 	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
+// BuildOption is an option for building a data source.
+type BuildOption func(*buildOptions)
+
+type buildOptions struct {
+	project uuid.UUID
+}
+
+// WithEvaluationProject sets the project the data source is built to be
+// evaluated in. This lets data sources keep state, like cached responses,
+// across evaluations, which isn't wanted when they are only validated.
+func WithEvaluationProject(project uuid.UUID) BuildOption {
+	return func(o *buildOptions) {
+		o.project = project
+	}
+}
+
 // BuildFromProtobuf is a factory function that builds a new data source based on the given
 // data source type.
-func BuildFromProtobuf(ds *minderv1.DataSource, provider provinfv1.Provider) (v1datasources.DataSource, error) {
+func BuildFromProtobuf(
+	ds *minderv1.DataSource, provider provinfv1.Provider, opts ...BuildOption,
+) (v1datasources.DataSource, error) {
 	if ds == nil {
 		return nil, fmt.Errorf("data source is nil")
 	}
@@ -25,11 +47,28 @@ func BuildFromProtobuf(ds *minderv1.DataSource, provider provinfv1.Provider) (v1
 		return nil, fmt.Errorf("data source driver is nil")
 	}
 
+	var o buildOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	switch ds.GetDriver().(type) {
 	case *minderv1.DataSource_Structured:
 		return structured.NewStructDataSource(ds.GetStructured())
 	case *minderv1.DataSource_Rest:
-		return rest.NewRestDataSource(ds.GetRest(), provider)
+		var restOpts []rest.Option
+		if o.project != uuid.Nil && ds.GetId() != "" {
+			restOpts = append(restOpts, rest.WithSharedState(ds.GetId(), o.project))
+		}
+		return rest.NewRestDataSource(ds.GetRest(), provider, restOpts...)
+	case *minderv1.DataSource_Graphql:
+		return graphql.NewGraphQLDataSource(ds.GetGraphql(), provider)
+	case *minderv1.DataSource_Sql:
+		var sqlOpts []sql.Option
+		if ds.GetId() != "" {
+			sqlOpts = append(sqlOpts, sql.WithDataSourceID(ds.GetId()))
+		}
+		return sql.NewSQLDataSource(ds.GetSql(), sqlOpts...)
 	default:
 		return nil, fmt.Errorf("unknown data source type: %T", ds)
 	}
@@ -170,6 +170,70 @@ func TestBuildFromProtobuf(t *testing.T) {
 			},
 			expectedFuncs: []string{"post_data"},
 		},
+		{
+			name: "successful GraphQL data source creation with provider",
+			ds: &minderv1.DataSource{
+				Version: "v1",
+				Type:    "graphql",
+				Name:    "test-graphql-ds",
+				Id:      "12345",
+				Driver: &minderv1.DataSource_Graphql{
+					Graphql: &minderv1.GraphQLDataSource{
+						Def: map[string]*minderv1.GraphQLDataSource_Def{
+							"rulesets": {
+								Endpoint: "https://api.github.com/graphql",
+								Query: `query($owner: String!, $name: String!) {
+  repository(owner: $owner, name: $name) { rulesets(first: 10) { nodes { name } } }
+}`,
+								InputSchema: func() *structpb.Struct {
+									s, _ := structpb.NewStruct(map[string]any{
+										"type": "object",
+										"properties": map[string]any{
+											"owner": map[string]any{"type": "string"},
+											"name":  map[string]any{"type": "string"},
+										},
+									})
+									return s
+								}(),
+							},
+						},
+						ProviderAuth: true,
+					},
+				},
+			},
+			withProvider:  true,
+			expectedFuncs: []string{"rulesets"},
+		},
+		{
+			name: "successful SQL data source creation",
+			ds: &minderv1.DataSource{
+				Version: "v1",
+				Type:    "sql",
+				Name:    "test-sql-ds",
+				Id:      "12345",
+				Driver: &minderv1.DataSource_Sql{
+					Sql: &minderv1.SQLDataSource{
+						Def: map[string]*minderv1.SQLDataSource_Def{
+							"owners": {
+								Query:  "SELECT owner FROM repo_owners WHERE repo = $1",
+								Params: []string{"repo"},
+								InputSchema: func() *structpb.Struct {
+									s, _ := structpb.NewStruct(map[string]any{
+										"type": "object",
+										"properties": map[string]any{
+											"repo": map[string]any{"type": "string"},
+										},
+									})
+									return s
+								}(),
+							},
+						},
+						ConnectionString: "postgres://inventory@localhost:5432/inventory?sslmode=disable",
+					},
+				},
+			},
+			expectedFuncs: []string{"owners"},
+		},
 		{
 			name: "invalid structured data source",
 			ds: &minderv1.DataSource{
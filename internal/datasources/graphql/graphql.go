@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package graphql implements a GraphQL data source.
+//
+// The GraphQL data source runs a GraphQL operation against an API, with the
+// arguments of the function as the variables of the operation. Connections
+// may be paginated, in which case the nodes of all the pages are returned
+// together.
+//
+// An example of the output is:
+//
+//	{
+//	  "status_code": 200,
+//	  "data": {
+//	    "repository": {
+//	      "rulesets": {
+//	        "nodes": [{"name": "main"}]
+//	      }
+//	    }
+//	  },
+//	  "errors": []
+//	}
+package graphql
+
+import (
+	"errors"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+type graphqlDataSource struct {
+	handlers map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef
+}
+
+// ensure that graphqlDataSource implements the v1datasources.DataSource interface
+var _ v1datasources.DataSource = (*graphqlDataSource)(nil)
+
+// GetFuncs implements the v1datasources.DataSource interface.
+func (g *graphqlDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef {
+	return g.handlers
+}
+
+// NewGraphQLDataSource builds a new GraphQL data source.
+func NewGraphQLDataSource(gql *minderv1.GraphQLDataSource, provider provinfv1.Provider) (v1datasources.DataSource, error) {
+	if gql == nil {
+		return nil, errors.New("graphql data source is nil")
+	}
+
+	if gql.GetDef() == nil {
+		return nil, errors.New("graphql data source definition is nil")
+	}
+
+	// Provider auth is opt-in, so the property must be true to pass along the provider.
+	if !gql.GetProviderAuth() {
+		provider = nil
+	}
+
+	out := &graphqlDataSource{
+		handlers: make(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef, len(gql.GetDef())),
+	}
+
+	for key, handlerCfg := range gql.GetDef() {
+		handler, err := newHandlerFromDef(handlerCfg, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		out.handlers[v1datasources.DataSourceFuncKey(key)] = handler
+	}
+
+	return out, nil
+}
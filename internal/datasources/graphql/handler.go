@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/datasources/rest"
+	"github.com/mindersec/minder/internal/util/schemaupdate"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	defaultCursorVariable = "cursor"
+	defaultMaxPages       = 10
+)
+
+type graphqlHandler struct {
+	rawInputSchema *structpb.Struct
+	inputSchema    *jsonschema.Schema
+	endpoint       string
+	query          string
+	// the variables declared by the operation
+	variables  ast.VariableDefinitionList
+	headers    map[string]string
+	pagination *pagination
+	// used only to allow requests to localhost during tests
+	testOnlyTransport http.RoundTripper
+	provider          interfaces.RESTProvider
+}
+
+type pagination struct {
+	// path to the connection, under `data`
+	path           []string
+	cursorVariable string
+	maxPages       int
+}
+
+func newHandlerFromDef(def *minderv1.GraphQLDataSource_Def, provider provinfv1.Provider) (*graphqlHandler, error) {
+	if def == nil {
+		return nil, errors.New("graphql data source handler definition is nil")
+	}
+
+	// schema may be nil
+	schema, err := schemavalidate.CompileSchemaFromPB(def.GetInputSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: def.GetQuery()})
+	if err != nil {
+		return nil, fmt.Errorf("invalid graphql query: %w", err)
+	}
+	if len(doc.Operations) != 1 {
+		return nil, fmt.Errorf("graphql query must contain a single operation, found %d", len(doc.Operations))
+	}
+	variables := doc.Operations[0].VariableDefinitions
+
+	var pages *pagination
+	if pdef := def.GetPagination(); pdef != nil {
+		pages = &pagination{
+			path:           strings.Split(pdef.GetPath(), "."),
+			cursorVariable: cmp.Or(pdef.GetCursorVariable(), defaultCursorVariable),
+			maxPages:       cmp.Or(int(pdef.GetMaxPages()), defaultMaxPages),
+		}
+		if variables.ForName(pages.cursorVariable) == nil {
+			return nil, fmt.Errorf("graphql query does not declare the cursor variable $%s", pages.cursorVariable)
+		}
+	}
+
+	if err := checkVariables(variables, def.GetInputSchema().AsMap(), pages); err != nil {
+		return nil, err
+	}
+
+	// If this is not a RESTProvider, restProvider will be nil, which we already need to handle.
+	restProvider, _ := interfaces.As[interfaces.RESTProvider](provider)
+
+	return &graphqlHandler{
+		rawInputSchema: def.GetInputSchema(),
+		inputSchema:    schema,
+		endpoint:       def.GetEndpoint(),
+		query:          def.GetQuery(),
+		variables:      variables,
+		headers:        def.GetHeaders(),
+		pagination:     pages,
+		provider:       restProvider,
+	}, nil
+}
+
+func (h *graphqlHandler) GetArgsSchema() *structpb.Struct {
+	return h.rawInputSchema
+}
+
+func (h *graphqlHandler) ValidateArgs(args any) error {
+	if h.inputSchema == nil {
+		return errors.New("input schema cannot be nil")
+	}
+
+	mapobj, ok := args.(map[string]any)
+	if !ok {
+		return errors.New("args is not a map")
+	}
+
+	return schemavalidate.ValidateAgainstSchema(h.inputSchema, mapobj)
+}
+
+func (h *graphqlHandler) ValidateUpdate(argsSchema *structpb.Struct) error {
+	if argsSchema == nil {
+		return errors.New("update schema cannot be nil")
+	}
+
+	if _, err := schemavalidate.CompileSchemaFromPB(argsSchema); err != nil {
+		return fmt.Errorf("update validation failed due to invalid schema: %w", err)
+	}
+	return schemaupdate.ValidateSchemaUpdate(h.rawInputSchema, argsSchema)
+}
+
+// response is the body of a GraphQL response
+type response struct {
+	Data   map[string]any `json:"data"`
+	Errors []any          `json:"errors"`
+}
+
+func (h *graphqlHandler) Call(ctx context.Context, _ *interfaces.Ingested, args any) (any, error) {
+	argsMap, ok := args.(map[string]any)
+	if !ok {
+		return nil, errors.New("args is not a map")
+	}
+
+	variables, err := buildVariables(h.variables, argsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode, page, err := h.doRequest(ctx, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	data := page.Data
+	gqlErrors := append([]any{}, page.Errors...)
+
+	if h.pagination != nil {
+		connection := lookupConnection(data, h.pagination.path)
+		for pages := 1; pages < h.pagination.maxPages; pages++ {
+			if statusCode != http.StatusOK || len(page.Errors) > 0 {
+				break
+			}
+			cursor, ok := nextCursor(lookupConnection(page.Data, h.pagination.path))
+			if !ok {
+				break
+			}
+
+			variables[h.pagination.cursorVariable] = cursor
+			statusCode, page, err = h.doRequest(ctx, variables)
+			if err != nil {
+				return nil, err
+			}
+			gqlErrors = append(gqlErrors, page.Errors...)
+			appendPage(connection, lookupConnection(page.Data, h.pagination.path))
+		}
+	}
+
+	return buildGraphQLOutput(statusCode, data, gqlErrors), nil
+}
+
+func (h *graphqlHandler) doRequest(ctx context.Context, variables map[string]any) (int, *response, error) {
+	body, err := json.Marshal(map[string]any{
+		"query":     h.query,
+		"variables": variables,
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("cannot marshal graphql request: %w", err)
+	}
+
+	req, doer, err := rest.NewRequest(
+		ctx, h.provider, h.testOnlyTransport, http.MethodPost, h.endpoint, bytes.NewReader(body), len(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for k, v := range h.headers {
+		req.Header.Add(k, v)
+	}
+
+	resp, err := rest.RetriableDo(doer, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	var out response
+	if err := json.NewDecoder(io.LimitReader(resp.Body, rest.MaxBytesLimit)).Decode(&out); err != nil {
+		if resp.StatusCode == http.StatusOK {
+			return 0, nil, fmt.Errorf("cannot decode graphql response: %w", err)
+		}
+		// errors from the server (rather than the API) may not be GraphQL responses
+		out.Errors = []any{map[string]any{
+			"message": fmt.Sprintf("unexpected status code %d", resp.StatusCode),
+		}}
+	}
+
+	return resp.StatusCode, &out, nil
+}
+
+// lookupConnection returns the object at the given path, or nil
+func lookupConnection(data map[string]any, path []string) map[string]any {
+	current := data
+	for _, field := range path {
+		next, ok := current[field].(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// nextCursor returns the cursor of the page after the given connection
+func nextCursor(connection map[string]any) (string, bool) {
+	pageInfo, ok := connection["pageInfo"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	hasNext, _ := pageInfo["hasNextPage"].(bool)
+	cursor, _ := pageInfo["endCursor"].(string)
+	return cursor, hasNext && cursor != ""
+}
+
+// appendPage adds the nodes and edges of a page to the connection
+func appendPage(connection, page map[string]any) {
+	if connection == nil || page == nil {
+		return
+	}
+	for _, key := range []string{"nodes", "edges"} {
+		items, ok := page[key].([]any)
+		if !ok {
+			continue
+		}
+		existing, _ := connection[key].([]any)
+		connection[key] = append(existing, items...)
+	}
+	if pageInfo, ok := page["pageInfo"]; ok {
+		connection["pageInfo"] = pageInfo
+	}
+}
+
+func buildGraphQLOutput(statusCode int, data map[string]any, errs []any) any {
+	return map[string]any{
+		"status_code": statusCode,
+		"data":        data,
+		"errors":      errs,
+	}
+}
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mock_v1 "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+const rulesetsQuery = `query($owner: String!, $name: String!, $first: Int = 2, $cursor: String) {
+  repository(owner: $owner, name: $name) {
+    rulesets(first: $first, after: $cursor) {
+      nodes { name }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+func repoSchema(t *testing.T) *structpb.Struct {
+	t.Helper()
+
+	s, err := structpb.NewStruct(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"owner": map[string]any{"type": "string"},
+			"name":  map[string]any{"type": "string"},
+			"first": map[string]any{"type": "integer"},
+		},
+		"required": []any{"owner", "name"},
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func TestNewHandlerFromDef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		def      *minderv1.GraphQLDataSource_Def
+		errorMsg string
+	}{
+		{
+			name: "valid paginated query",
+			def: &minderv1.GraphQLDataSource_Def{
+				Endpoint:   "https://api.github.com/graphql",
+				Query:      rulesetsQuery,
+				Pagination: &minderv1.GraphQLDataSource_Def_Pagination{Path: "repository.rulesets"},
+			},
+		},
+		{
+			name:     "nil definition",
+			errorMsg: "graphql data source handler definition is nil",
+		},
+		{
+			name: "invalid query",
+			def: &minderv1.GraphQLDataSource_Def{
+				Endpoint: "https://api.github.com/graphql",
+				Query:    `query { repository(`,
+			},
+			errorMsg: "invalid graphql query",
+		},
+		{
+			name: "several operations",
+			def: &minderv1.GraphQLDataSource_Def{
+				Endpoint: "https://api.github.com/graphql",
+				Query:    `query a { viewer { login } } query b { viewer { name } }`,
+			},
+			errorMsg: "graphql query must contain a single operation, found 2",
+		},
+		{
+			name: "required variable missing from the schema",
+			def: &minderv1.GraphQLDataSource_Def{
+				Endpoint: "https://api.github.com/graphql",
+				Query:    `query($owner: String!, $team: String!) { organization(login: $owner) { team(slug: $team) { id } } }`,
+			},
+			errorMsg: "variable $team is required by the query but is not in the input schema",
+		},
+		{
+			name: "incompatible variable type",
+			def: &minderv1.GraphQLDataSource_Def{
+				Endpoint: "https://api.github.com/graphql",
+				Query:    `query($owner: Int!) { organization(login: $owner) { id } }`,
+			},
+			errorMsg: "variable $owner of type Int! can't be taken from a property of type string",
+		},
+		{
+			name: "cursor variable not declared",
+			def: &minderv1.GraphQLDataSource_Def{
+				Endpoint: "https://api.github.com/graphql",
+				Query:    `query($owner: String!) { organization(login: $owner) { id } }`,
+				Pagination: &minderv1.GraphQLDataSource_Def_Pagination{
+					Path:           "organization.teams",
+					CursorVariable: "after",
+				},
+			},
+			errorMsg: "graphql query does not declare the cursor variable $after",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.def != nil {
+				tt.def.InputSchema = repoSchema(t)
+			}
+
+			h, err := newHandlerFromDef(tt.def, nil)
+			if tt.errorMsg != "" {
+				assert.ErrorContains(t, err, tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, h)
+		})
+	}
+}
+
+func TestGraphQLHandler_Call(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]string{
+		"": `{"data": {"repository": {"rulesets": {
+  "nodes": [{"name": "one"}, {"name": "two"}],
+  "pageInfo": {"hasNextPage": true, "endCursor": "c2"}}}}}`,
+		"c2": `{"data": {"repository": {"rulesets": {
+  "nodes": [{"name": "three"}],
+  "pageInfo": {"hasNextPage": true, "endCursor": "c3"}}}}}`,
+		"c3": `{"data": {"repository": {"rulesets": {
+  "nodes": [{"name": "four"}],
+  "pageInfo": {"hasNextPage": false, "endCursor": "c4"}}}}}`,
+	}
+
+	tests := []struct {
+		name      string
+		maxPages  int32
+		args      map[string]any
+		wantNames []any
+		wantErr   string
+	}{
+		{
+			name:      "all pages",
+			args:      map[string]any{"owner": "mindersec", "name": "minder", "first": float64(2)},
+			wantNames: []any{"one", "two", "three", "four"},
+		},
+		{
+			name:      "limited pages",
+			maxPages:  2,
+			args:      map[string]any{"owner": "mindersec", "name": "minder", "first": json.Number("2")},
+			wantNames: []any{"one", "two", "three"},
+		},
+		{
+			name:    "non-integer variable",
+			args:    map[string]any{"owner": "mindersec", "name": "minder", "first": 2.5},
+			wantErr: "invalid value for variable $first",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "test", r.Header.Get("X-Test"))
+
+				var req struct {
+					Query     string         `json:"query"`
+					Variables map[string]any `json:"variables"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, rulesetsQuery, req.Query)
+				// integers are sent as integers, and only declared variables are sent
+				assert.Equal(t, map[string]any{
+					"owner": "mindersec", "name": "minder", "first": float64(2),
+				}, without(req.Variables, "cursor"))
+
+				cursor, _ := req.Variables["cursor"].(string)
+				_, err := w.Write([]byte(pages[cursor]))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			h, err := newHandlerFromDef(&minderv1.GraphQLDataSource_Def{
+				Endpoint:    server.URL,
+				Query:       rulesetsQuery,
+				Headers:     map[string]string{"X-Test": "test"},
+				InputSchema: repoSchema(t),
+				Pagination: &minderv1.GraphQLDataSource_Def_Pagination{
+					Path:     "repository.rulesets",
+					MaxPages: tt.maxPages,
+				},
+			}, nil)
+			require.NoError(t, err)
+			h.testOnlyTransport = http.DefaultTransport
+
+			args := map[string]any{"unused": true}
+			for k, v := range tt.args {
+				args[k] = v
+			}
+
+			got, err := h.Call(context.Background(), nil, args)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			out := got.(map[string]any)
+			assert.Equal(t, http.StatusOK, out["status_code"])
+			assert.Empty(t, out["errors"])
+
+			data := out["data"].(map[string]any)
+			rulesets := lookupConnection(data, []string{"repository", "rulesets"})
+			var names []any
+			for _, node := range rulesets["nodes"].([]any) {
+				names = append(names, node.(map[string]any)["name"])
+			}
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+func TestGraphQLHandler_Errors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"data": {"repository": null},
+"errors": [{"type": "NOT_FOUND", "message": "Could not resolve to a Repository"}]}`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	h, err := newHandlerFromDef(&minderv1.GraphQLDataSource_Def{
+		Endpoint:    server.URL,
+		Query:       rulesetsQuery,
+		InputSchema: repoSchema(t),
+		Pagination:  &minderv1.GraphQLDataSource_Def_Pagination{Path: "repository.rulesets"},
+	}, nil)
+	require.NoError(t, err)
+	h.testOnlyTransport = http.DefaultTransport
+
+	got, err := h.Call(context.Background(), nil, map[string]any{"owner": "mindersec", "name": "gone"})
+	require.NoError(t, err)
+
+	// GraphQL errors are returned to the rule, and stop the pagination
+	assert.Equal(t, buildGraphQLOutput(http.StatusOK,
+		map[string]any{"repository": nil},
+		[]any{map[string]any{"type": "NOT_FOUND", "message": "Could not resolve to a Repository"}},
+	), got)
+}
+
+func TestGraphQLHandler_ProviderCall(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	provider := mock_v1.NewMockREST(ctrl)
+
+	provider.EXPECT().GetBaseURL().Return("https://api.github.com/")
+	provider.EXPECT().NewRequest(http.MethodPost, "https://api.github.com/graphql", nil).Return(
+		http.NewRequest(http.MethodPost, "https://api.github.com/graphql", nil))
+	provider.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), `"variables":{"login":"mindersec"}`)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data": {"organization": {"id": "O_1"}}}`)),
+				Request:    req,
+			}, nil
+		})
+
+	h, err := newHandlerFromDef(&minderv1.GraphQLDataSource_Def{
+		Endpoint: "https://api.github.com/graphql",
+		Query:    `query($login: String!) { organization(login: $login) { id } }`,
+		InputSchema: func() *structpb.Struct {
+			s, err := structpb.NewStruct(map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"login": map[string]any{"type": "string"}},
+			})
+			require.NoError(t, err)
+			return s
+		}(),
+	}, provider)
+	require.NoError(t, err)
+
+	got, err := h.Call(context.Background(), nil, map[string]any{"login": "mindersec"})
+	require.NoError(t, err)
+	assert.Equal(t, buildGraphQLOutput(http.StatusOK,
+		map[string]any{"organization": map[string]any{"id": "O_1"}}, []any{}), got)
+}
+
+func without(m map[string]any, key string) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		if k != key {
+			out[k] = v
+		}
+	}
+	return out
+}
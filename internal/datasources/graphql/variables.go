@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// checkVariables verifies that the input schema provides the variables the
+// operation requires, with types which are compatible with the declared ones.
+func checkVariables(variables ast.VariableDefinitionList, schema map[string]any, pages *pagination) error {
+	properties, _ := schema["properties"].(map[string]any)
+
+	for _, v := range variables {
+		prop, ok := properties[v.Variable].(map[string]any)
+		if !ok {
+			required := v.Type.NonNull && v.DefaultValue == nil
+			if required && (pages == nil || pages.cursorVariable != v.Variable) {
+				return fmt.Errorf("variable $%s is required by the query but is not in the input schema", v.Variable)
+			}
+			continue
+		}
+
+		schemaType, ok := prop["type"].(string)
+		if !ok {
+			// untyped, or one of several types
+			continue
+		}
+		if !compatibleTypes(v.Type, schemaType) {
+			return fmt.Errorf("variable $%s of type %s can't be taken from a property of type %s",
+				v.Variable, v.Type.String(), schemaType)
+		}
+	}
+
+	return nil
+}
+
+func compatibleTypes(gqlType *ast.Type, schemaType string) bool {
+	if gqlType.Elem != nil {
+		return schemaType == "array"
+	}
+
+	switch gqlType.NamedType {
+	case "Int":
+		return schemaType == "integer"
+	case "Float":
+		return schemaType == "number" || schemaType == "integer"
+	case "Boolean":
+		return schemaType == "boolean"
+	case "String":
+		return schemaType == "string"
+	case "ID":
+		return schemaType == "string" || schemaType == "integer"
+	default:
+		// custom scalars and input objects are passed as they are
+		return true
+	}
+}
+
+// buildVariables returns the variables of the operation from the arguments,
+// converted to the declared types. Arguments which aren't variables of the
+// operation are left out.
+func buildVariables(variables ast.VariableDefinitionList, args map[string]any) (map[string]any, error) {
+	out := make(map[string]any, len(variables))
+	for _, v := range variables {
+		arg, ok := args[v.Variable]
+		if !ok {
+			continue
+		}
+		value, err := coerceVariable(v.Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for variable $%s: %w", v.Variable, err)
+		}
+		out[v.Variable] = value
+	}
+	return out, nil
+}
+
+// coerceVariable converts the numbers of the arguments, which have no
+// specific type once decoded, to the type of the variable.
+func coerceVariable(gqlType *ast.Type, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if gqlType.Elem != nil {
+		items, ok := value.([]any)
+		if !ok {
+			// a single value is accepted where a list is expected
+			items = []any{value}
+		}
+		out := make([]any, 0, len(items))
+		for _, item := range items {
+			coerced, err := coerceVariable(gqlType.Elem, item)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, coerced)
+		}
+		return out, nil
+	}
+
+	switch gqlType.NamedType {
+	case "Int":
+		return toInt(value)
+	case "Float":
+		return toFloat(value)
+	default:
+		return value, nil
+	}
+}
+
+func toInt(value any) (int64, error) {
+	switch n := value.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		if n != math.Trunc(n) || n > math.MaxInt64 || n < math.MinInt64 {
+			return 0, fmt.Errorf("%v is not an integer", n)
+		}
+		return int64(n), nil
+	case int:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	default:
+		return 0, fmt.Errorf("%v is not an integer", value)
+	}
+}
+
+func toFloat(value any) (float64, error) {
+	switch n := value.(type) {
+	case json.Number:
+		return n.Float64()
+	case float64:
+		return n, nil
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	default:
+		return 0, fmt.Errorf("%v is not a number", value)
+	}
+}
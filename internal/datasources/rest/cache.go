@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"container/list"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// the cached responses of all the data sources share this budget
+	defaultCacheMaxBytes = 64 << 20
+)
+
+// responses is the cache shared by all the REST data sources
+var responses = newResponseCache(defaultCacheMaxBytes)
+
+// cachedResponse is a response as received, so that it can be parsed anew
+// for each call
+type cachedResponse struct {
+	statusCode   int
+	body         []byte
+	etag         string
+	lastModified string
+	// the response can be used without revalidation until then
+	freshUntil time.Time
+}
+
+func (c *cachedResponse) fresh(now time.Time) bool {
+	return now.Before(c.freshUntil)
+}
+
+// addConditions makes the request conditional, so that the server can
+// answer that the cached response is still valid
+func (c *cachedResponse) addConditions(req *http.Request) {
+	if c.etag != "" {
+		req.Header.Set("If-None-Match", c.etag)
+	}
+	if c.lastModified != "" {
+		req.Header.Set("If-Modified-Since", c.lastModified)
+	}
+}
+
+// revalidated returns the cached response with the freshness of the
+// response which validated it
+func (c *cachedResponse) revalidated(header http.Header, now time.Time, ttl time.Duration) *cachedResponse {
+	lifetime, ok := freshnessLifetime(header, ttl)
+	if !ok {
+		return nil
+	}
+	out := *c
+	out.freshUntil = now.Add(lifetime)
+	if etag := header.Get("ETag"); etag != "" {
+		out.etag = etag
+	}
+	return &out
+}
+
+// newCachedResponse returns the response to store in the cache, or nil if
+// it must not be stored
+func newCachedResponse(resp *http.Response, body []byte, now time.Time, ttl time.Duration) *cachedResponse {
+	// errors may be transient, and rate limits certainly are
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return nil
+	}
+
+	lifetime, ok := freshnessLifetime(resp.Header, ttl)
+	if !ok {
+		return nil
+	}
+
+	entry := &cachedResponse{
+		statusCode:   resp.StatusCode,
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+		freshUntil:   now.Add(lifetime),
+	}
+	// responses which are neither fresh nor can be revalidated are useless
+	if lifetime == 0 && entry.etag == "" && entry.lastModified == "" {
+		return nil
+	}
+	return entry
+}
+
+// freshnessLifetime returns how long a response may be used without
+// revalidation, and whether it may be stored at all. A ttl overrides the
+// lifetime from the Cache-Control header.
+func freshnessLifetime(header http.Header, ttl time.Duration) (time.Duration, bool) {
+	directives := parseCacheControl(header.Get("Cache-Control"))
+	if _, ok := directives["no-store"]; ok {
+		return 0, false
+	}
+	if ttl > 0 {
+		return ttl, true
+	}
+	if _, ok := directives["no-cache"]; ok {
+		return 0, true
+	}
+
+	// this cache is shared by the evaluations of a project
+	for _, directive := range []string{"s-maxage", "max-age"} {
+		if value, ok := directives[directive]; ok {
+			seconds, err := strconv.Atoi(value)
+			if err != nil || seconds < 0 {
+				return 0, true
+			}
+			age, _ := strconv.Atoi(header.Get("Age"))
+			return max(time.Duration(seconds-age)*time.Second, 0), true
+		}
+	}
+	return 0, true
+}
+
+func parseCacheControl(value string) map[string]string {
+	directives := map[string]string{}
+	for _, part := range strings.Split(value, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name == "" {
+			continue
+		}
+		directives[strings.ToLower(name)] = strings.Trim(arg, `"`)
+	}
+	return directives
+}
+
+// cacheKey identifies a request within the cache. The scope keeps the
+// responses of different data sources, and projects, apart.
+func cacheKey(scope string, req *http.Request) string {
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(scope)
+	b.WriteString("\n")
+	b.WriteString(req.Method)
+	b.WriteString(" ")
+	b.WriteString(req.URL.String())
+	for _, name := range names {
+		b.WriteString("\n")
+		b.WriteString(name)
+		b.WriteString(": ")
+		b.WriteString(strings.Join(req.Header[name], ", "))
+	}
+	return b.String()
+}
+
+// responseCache is a LRU cache of responses, bounded by the size of the
+// bodies it holds
+type responseCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	size     int
+	entries  map[string]*list.Element
+	// most recently used first
+	lru *list.List
+}
+
+type cacheItem struct {
+	key      string
+	response *cachedResponse
+}
+
+func newResponseCache(maxBytes int) *responseCache {
+	return &responseCache{
+		maxBytes: maxBytes,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+	}
+}
+
+func (c *responseCache) get(key string) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cacheItem).response
+}
+
+func (c *responseCache) put(key string, response *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeLocked(key)
+	if len(response.body) > c.maxBytes {
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheItem{key: key, response: response})
+	c.size += len(response.body)
+	for c.size > c.maxBytes {
+		c.removeLocked(c.lru.Back().Value.(*cacheItem).key)
+	}
+}
+
+func (c *responseCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeLocked(key)
+}
+
+func (c *responseCache) removeLocked(key string) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	c.lru.Remove(elem)
+	delete(c.entries, key)
+	c.size -= len(elem.Value.(*cacheItem).response.body)
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestFreshnessLifetime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		cacheControl string
+		age          string
+		ttl          time.Duration
+		wantLifetime time.Duration
+		wantStore    bool
+	}{
+		{name: "no header", wantStore: true},
+		{name: "max-age", cacheControl: "private, max-age=60", wantLifetime: time.Minute, wantStore: true},
+		{name: "s-maxage wins", cacheControl: "max-age=60, s-maxage=30", wantLifetime: 30 * time.Second, wantStore: true},
+		{name: "age is subtracted", cacheControl: "max-age=60", age: "20", wantLifetime: 40 * time.Second, wantStore: true},
+		{name: "no-cache", cacheControl: "no-cache", wantStore: true},
+		{name: "no-store", cacheControl: "no-store", ttl: time.Hour},
+		{name: "ttl overrides", cacheControl: "no-cache, max-age=0", ttl: time.Hour, wantLifetime: time.Hour, wantStore: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			header := http.Header{}
+			header.Set("Cache-Control", tt.cacheControl)
+			header.Set("Age", tt.age)
+
+			lifetime, store := freshnessLifetime(header, tt.ttl)
+			assert.Equal(t, tt.wantLifetime, lifetime)
+			assert.Equal(t, tt.wantStore, store)
+		})
+	}
+}
+
+func TestResponseCache_Eviction(t *testing.T) {
+	t.Parallel()
+
+	cache := newResponseCache(10)
+	cache.put("a", &cachedResponse{body: []byte("aaaa")})
+	cache.put("b", &cachedResponse{body: []byte("bbbb")})
+	// a is now the most recently used
+	require.NotNil(t, cache.get("a"))
+	cache.put("c", &cachedResponse{body: []byte("cccc")})
+
+	assert.NotNil(t, cache.get("a"))
+	assert.Nil(t, cache.get("b"))
+	assert.NotNil(t, cache.get("c"))
+	assert.Equal(t, 8, cache.size)
+
+	// too large to be cached at all
+	cache.put("d", &cachedResponse{body: []byte("ddddddddddd")})
+	assert.Nil(t, cache.get("d"))
+	assert.Equal(t, 8, cache.size)
+}
+
+func TestRestHandler_Cache(t *testing.T) {
+	t.Parallel()
+
+	var requests, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		switch r.URL.Path {
+		case "/fresh":
+			w.Header().Set("Cache-Control", "max-age=60")
+		case "/revalidate":
+			w.Header().Set("Cache-Control", "no-cache")
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				notModified.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		case "/no-store":
+			w.Header().Set("Cache-Control", "no-store")
+		}
+		_, err := w.Write([]byte(`{"path": "` + r.URL.Path + `"}`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		path             string
+		disabled         bool
+		wantRequests     int32
+		wantNotModified  int32
+		wantCachedOutput bool
+	}{
+		{name: "fresh responses are reused", path: "/fresh", wantRequests: 1},
+		{name: "stale responses are revalidated", path: "/revalidate", wantRequests: 3, wantNotModified: 2},
+		{name: "no-store responses are not cached", path: "/no-store", wantRequests: 3},
+		{name: "caching can be disabled", path: "/fresh", disabled: true, wantRequests: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests.Store(0)
+			notModified.Store(0)
+
+			ds, err := NewRestDataSource(&minderv1.RestDataSource{
+				Def: map[string]*minderv1.RestDataSource_Def{
+					"get": {
+						Endpoint: server.URL + tt.path,
+						Parse:    "json",
+						Cache:    &minderv1.RestDataSource_Def_Cache{Disabled: tt.disabled},
+					},
+				},
+			}, nil, WithSharedState(uuid.NewString(), uuid.New()))
+			require.NoError(t, err)
+
+			h := ds.GetFuncs()["get"].(*restHandler)
+			h.testOnlyTransport = http.DefaultTransport
+
+			for range 3 {
+				got, err := h.Call(context.Background(), nil, map[string]any{})
+				require.NoError(t, err)
+				assert.Equal(t, buildRestOutput(http.StatusOK, map[string]any{"path": tt.path}), got)
+			}
+
+			assert.Equal(t, tt.wantRequests, requests.Load())
+			assert.Equal(t, tt.wantNotModified, notModified.Load())
+		})
+	}
+}
+
+func TestRestHandler_CacheScope(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Cache-Control", "max-age=60")
+		_, err := w.Write([]byte(`ok`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	dsID := uuid.NewString()
+	call := func(project uuid.UUID) {
+		ds, err := NewRestDataSource(&minderv1.RestDataSource{
+			Def: map[string]*minderv1.RestDataSource_Def{
+				"get": {Endpoint: server.URL},
+			},
+		}, nil, WithSharedState(dsID, project))
+		require.NoError(t, err)
+
+		h := ds.GetFuncs()["get"].(*restHandler)
+		h.testOnlyTransport = http.DefaultTransport
+		_, err = h.Call(context.Background(), nil, map[string]any{})
+		require.NoError(t, err)
+	}
+
+	project := uuid.New()
+	call(project)
+	// another instance of the data source, e.g. in the next evaluation
+	call(project)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// other projects don't see the cached response
+	call(uuid.New())
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestRestHandler_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`ok`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	ds, err := NewRestDataSource(&minderv1.RestDataSource{
+		Def: map[string]*minderv1.RestDataSource_Def{
+			"get": {Endpoint: server.URL, Cache: &minderv1.RestDataSource_Def_Cache{Disabled: true}},
+		},
+		RateLimit: &minderv1.RestDataSource_RateLimit{RequestsPerSecond: 20, Burst: 2},
+	}, nil, WithSharedState(uuid.NewString(), uuid.New()))
+	require.NoError(t, err)
+
+	h := ds.GetFuncs()["get"].(*restHandler)
+	h.testOnlyTransport = http.DefaultTransport
+
+	start := time.Now()
+	for range 4 {
+		_, err := h.Call(context.Background(), nil, map[string]any{})
+		require.NoError(t, err)
+	}
+	// the burst is let through, and the other two wait 50ms each
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// requests which can't wait fail
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = h.Call(ctx, nil, map[string]any{})
+	assert.ErrorContains(t, err, "rate limit wait failed")
+}
+
+func TestGetLimiter_Update(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.NewString()
+	limiter := getLimiter(id, &minderv1.RestDataSource_RateLimit{RequestsPerSecond: 1})
+	require.NotNil(t, limiter)
+	assert.Equal(t, 1, limiter.Burst())
+
+	updated := getLimiter(id, &minderv1.RestDataSource_RateLimit{RequestsPerSecond: 5, Burst: 3})
+	assert.Same(t, limiter, updated)
+	assert.InDelta(t, 5, float64(updated.Limit()), 0.001)
+	assert.Equal(t, 3, updated.Burst())
+
+	assert.Nil(t, getLimiter(id, nil))
+}
+
+func TestNotModifiedIsNotAnError(t *testing.T) {
+	t.Parallel()
+
+	// clients like the GitHub one return an error with unsuccessful responses
+	doer := notModifiedIsNotAnError(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusNotModified, Request: req}, errors.New("not modified")
+	})
+
+	resp, err := doer(httptest.NewRequest(http.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+}
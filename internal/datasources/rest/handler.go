@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/mindersec/minder/internal/engine/eval/rego"
@@ -43,7 +44,17 @@ const (
 var (
 	metricsInit sync.Once
 
-	dataSourceLatencyHistogram metric.Int64Histogram
+	dataSourceLatencyHistogram  metric.Int64Histogram
+	dataSourceCacheCounter      metric.Int64Counter
+	dataSourceThrottledCounter  metric.Int64Counter
+	dataSourceThrottleHistogram metric.Int64Histogram
+)
+
+// results of looking up a response in the cache, as recorded in the metrics
+const (
+	cacheResultHit         = "hit"
+	cacheResultRevalidated = "revalidated"
+	cacheResultMiss        = "miss"
 )
 
 type restHandler struct {
@@ -61,6 +72,14 @@ type restHandler struct {
 	// TODO implement fallback
 	// TODO implement auth
 	provider interfaces.RESTProvider
+
+	// set when the handler shares state across evaluations
+	dataSourceID string
+	// responses are only cached when set
+	cacheScope    string
+	cacheDisabled bool
+	cacheTTL      time.Duration
+	limiter       *rate.Limiter
 }
 
 func initMetrics() {
@@ -75,6 +94,28 @@ func initMetrics() {
 		if err != nil {
 			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating histogram for data source requests failed")
 		}
+		dataSourceCacheCounter, err = meter.Int64Counter(
+			"datasource.rest.cache",
+			metric.WithDescription("Number of cacheable data source requests, by whether the cached response was used"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for data source cache failed")
+		}
+		dataSourceThrottledCounter, err = meter.Int64Counter(
+			"datasource.rest.throttled",
+			metric.WithDescription("Number of data source requests delayed by the rate limit"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for data source rate limits failed")
+		}
+		dataSourceThrottleHistogram, err = meter.Int64Histogram(
+			"datasource.rest.throttle_delay",
+			metric.WithDescription("Delay of data source requests by the rate limit in milliseconds"),
+			metric.WithUnit("ms"),
+		)
+		if err != nil {
+			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating histogram for data source rate limits failed")
+		}
 	})
 }
 
@@ -109,6 +150,8 @@ func newHandlerFromDef(def *minderv1.RestDataSource_Def, provider provinfv1.Prov
 		bodyFromInput:  bodyFromInput,
 		parse:          def.GetParse(),
 		provider:       restProvider,
+		cacheDisabled:  def.GetCache().GetDisabled(),
+		cacheTTL:       time.Duration(def.GetCache().GetTtlSeconds()) * time.Second,
 	}, nil
 }
 
@@ -151,7 +194,40 @@ func (h *restHandler) Call(ctx context.Context, _ *interfaces.Ingested, args any
 		return nil, err
 	}
 
-	transport := h.testOnlyTransport
+	b, bLen, err := h.getBody(argsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	req, doer, err := NewRequest(ctx, h.provider, h.testOnlyTransport, h.method, expandedEndpoint, b, bLen)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range h.headers {
+		req.Header.Add(k, v)
+	}
+
+	return h.doRequest(doer, req)
+}
+
+// RequestDoer sends a request and returns its response.
+type RequestDoer func(*http.Request) (*http.Response, error)
+
+// NewRequest creates a data source request to the given endpoint, along with
+// the function to send it with. If the endpoint is part of the provider's API,
+// the request is made through the provider, so that it is authenticated with
+// the provider's credentials. Otherwise, it is sent by a plain HTTP client
+// which can't reach non-public addresses; transport overrides this in tests.
+func NewRequest(
+	ctx context.Context,
+	provider interfaces.RESTProvider,
+	transport http.RoundTripper,
+	method string,
+	endpoint string,
+	body io.Reader,
+	bodyLen int,
+) (*http.Request, RequestDoer, error) {
 	if transport == nil {
 		transport = rego.LimitedDialer(nil)
 	}
@@ -163,41 +239,32 @@ func (h *restHandler) Call(ctx context.Context, _ *interfaces.Ingested, args any
 		Transport: transport,
 	}
 
-	b, bLen, err := h.getBody(argsMap)
-	if err != nil {
-		return nil, err
-	}
-
 	// Adapt slightly different calling patterns for Providers vs http.Client
 	var req *http.Request
-	var doer func(*http.Request) (*http.Response, error)
-	if h.provider != nil && urlContains(h.provider.GetBaseURL(), expandedEndpoint) {
+	var doer RequestDoer
+	var err error
+	if provider != nil && urlContains(provider.GetBaseURL(), endpoint) {
 		// The RESTProvider NewRequest method inconsistently assumes either
 		// parsed data (GitHub) or unparsed data (e.g. REST).  Explicitly set
 		// body separately to avoid ambiguity.
-		req, err = h.provider.NewRequest(h.method, expandedEndpoint, nil)
+		req, err = provider.NewRequest(method, endpoint, nil)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		req.Body = io.NopCloser(b)
-		req.ContentLength = int64(bLen)
+		req.Body = io.NopCloser(body)
+		req.ContentLength = int64(bodyLen)
 		doer = func(req *http.Request) (*http.Response, error) {
-			return h.provider.Do(req.Context(), req)
+			return provider.Do(req.Context(), req)
 		}
 	} else {
-		req, err = http.NewRequest(h.method, expandedEndpoint, b)
+		req, err = http.NewRequest(method, endpoint, body)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		doer = cli.Do
 	}
-	req = req.WithContext(ctx)
-
-	for k, v := range h.headers {
-		req.Header.Add(k, v)
-	}
 
-	return h.doRequest(doer, req)
+	return req.WithContext(ctx), doer, nil
 }
 
 func recordMetrics(ctx context.Context, resp *http.Response, start time.Time) {
@@ -210,24 +277,112 @@ func recordMetrics(ctx context.Context, resp *http.Response, start time.Time) {
 	dataSourceLatencyHistogram.Record(ctx, time.Since(start).Milliseconds(), metric.WithAttributes(attrs...))
 }
 
+func (h *restHandler) recordCacheResult(ctx context.Context, result string) {
+	dataSourceCacheCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("data_source", h.dataSourceID),
+		attribute.String("result", result),
+	))
+}
+
+func (h *restHandler) recordThrottled(ctx context.Context, delay time.Duration) {
+	attrs := metric.WithAttributes(attribute.String("data_source", h.dataSourceID))
+	dataSourceThrottledCounter.Add(ctx, 1, attrs)
+	dataSourceThrottleHistogram.Record(ctx, delay.Milliseconds(), attrs)
+}
+
 func (h *restHandler) doRequest(dofunc func(*http.Request) (*http.Response, error), req *http.Request) (any, error) {
+	ctx := req.Context()
+
+	// Only GET requests are cached, with the key taken before the request
+	// is made conditional.
+	var key string
+	var cached *cachedResponse
+	if h.cacheScope != "" && !h.cacheDisabled && req.Method == http.MethodGet {
+		key = cacheKey(h.cacheScope, req)
+		cached = responses.get(key)
+		if cached != nil && cached.fresh(time.Now()) {
+			h.recordCacheResult(ctx, cacheResultHit)
+			return h.buildOutput(cached.statusCode, cached.body)
+		}
+		if cached != nil {
+			cached.addConditions(req)
+		}
+	}
+
+	if h.limiter != nil {
+		delay, err := waitForLimit(ctx, h.limiter)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit wait failed: %w", err)
+		}
+		if delay > 0 {
+			h.recordThrottled(ctx, delay)
+		}
+	}
+
 	start := time.Now()
-	resp, err := retriableDo(dofunc, req)
+	resp, err := RetriableDo(notModifiedIsNotAnError(dofunc), req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	recordMetrics(req.Context(), resp, start)
+	recordMetrics(ctx, resp, start)
+
+	if key == "" {
+		bout, err := h.parseResponseBody(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		// TODO: Handle fallback here.
+
+		return buildRestOutput(resp.StatusCode, bout), nil
+	}
+
+	now := time.Now()
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		if refreshed := cached.revalidated(resp.Header, now, h.cacheTTL); refreshed != nil {
+			responses.put(key, refreshed)
+		} else {
+			responses.remove(key)
+		}
+		h.recordCacheResult(ctx, cacheResultRevalidated)
+		return h.buildOutput(cached.statusCode, cached.body)
+	}
+	h.recordCacheResult(ctx, cacheResultMiss)
 
-	bout, err := h.parseResponseBody(resp.Body)
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxBytesLimit))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("cannot read response body: %w", err)
+	}
+	if entry := newCachedResponse(resp, body, now, h.cacheTTL); entry != nil {
+		responses.put(key, entry)
+	} else {
+		responses.remove(key)
 	}
 
-	// TODO: Handle fallback here.
+	return h.buildOutput(resp.StatusCode, body)
+}
 
-	return buildRestOutput(resp.StatusCode, bout), nil
+func (h *restHandler) buildOutput(statusCode int, body []byte) (any, error) {
+	bout, err := h.parseResponseBody(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return buildRestOutput(statusCode, bout), nil
+}
+
+// notModifiedIsNotAnError wraps a RequestDoer for the clients, like the
+// GitHub one, which return an error along with responses which aren't
+// successful, so that revalidated responses aren't retried.
+func notModifiedIsNotAnError(dofunc func(*http.Request) (*http.Response, error)) RequestDoer {
+	return func(req *http.Request) (*http.Response, error) {
+		resp, err := dofunc(req)
+		if err != nil && resp != nil && resp.StatusCode == http.StatusNotModified {
+			return resp, nil
+		}
+		return resp, err
+	}
 }
 
 func (h *restHandler) getBody(args map[string]any) (io.Reader, int, error) {
@@ -329,7 +484,9 @@ func buildRestOutput(statusCode int, body any) any {
 	}
 }
 
-func retriableDo(dofunc func(*http.Request) (*http.Response, error), req *http.Request) (*http.Response, error) {
+// RetriableDo sends a request with dofunc, retrying with an exponential
+// backoff when it fails or is rate limited.
+func RetriableDo(dofunc func(*http.Request) (*http.Response, error), req *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	retryCount := 0
 
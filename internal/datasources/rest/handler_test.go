@@ -691,7 +691,7 @@ func (tc *trackingCloser) wasClosed() bool {
 	return tc.closed
 }
 
-func Test_retriableDo_ClosesBodyOnRateLimit(t *testing.T) {
+func Test_RetriableDo_ClosesBodyOnRateLimit(t *testing.T) {
 	t.Parallel()
 
 	var bodies []*trackingCloser
@@ -714,7 +714,7 @@ func Test_retriableDo_ClosesBodyOnRateLimit(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
 	initMetrics()
 
-	_, err := retriableDo(dofunc, req)
+	_, err := RetriableDo(dofunc, req)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "rate limited")
 
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var (
+	limitersMu sync.Mutex
+	// limiters are keyed by data source ID, since the data sources are built
+	// again for every evaluation
+	limiters = map[string]*rate.Limiter{}
+)
+
+// getLimiter returns the rate limiter of a data source, updated to the
+// given configuration, or nil if the data source has no rate limit.
+func getLimiter(dataSourceID string, cfg *minderv1.RestDataSource_RateLimit) *rate.Limiter {
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+
+	if cfg == nil {
+		delete(limiters, dataSourceID)
+		return nil
+	}
+
+	limit := rate.Limit(cfg.GetRequestsPerSecond())
+	burst := max(int(cfg.GetBurst()), 1)
+
+	limiter, ok := limiters[dataSourceID]
+	if !ok {
+		limiter = rate.NewLimiter(limit, burst)
+		limiters[dataSourceID] = limiter
+		return limiter
+	}
+
+	// the data source may have been updated since
+	if limiter.Limit() != limit {
+		limiter.SetLimit(limit)
+	}
+	if limiter.Burst() != burst {
+		limiter.SetBurst(burst)
+	}
+	return limiter
+}
+
+// waitForLimit blocks until the limiter allows a request, and returns how
+// long it waited.
+func waitForLimit(ctx context.Context, limiter *rate.Limiter) (time.Duration, error) {
+	reservation := limiter.Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return 0, ctx.Err()
+	}
+}
@@ -24,6 +24,8 @@ package rest
 import (
 	"errors"
 
+	"github.com/google/uuid"
+
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
 	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
@@ -41,8 +43,31 @@ func (r *restDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1dataso
 	return r.handlers
 }
 
+// Option is an option for building a REST data source.
+type Option func(*options)
+
+type options struct {
+	dataSourceID string
+	project      uuid.UUID
+}
+
+// WithSharedState makes the data source share its cached responses and its
+// rate limit with the other instances of the data source with the given ID.
+// Cached responses are only shared within the project, since they may
+// depend on the credentials of its provider.
+//
+// Without it, responses aren't cached and requests aren't rate limited.
+func WithSharedState(dataSourceID string, project uuid.UUID) Option {
+	return func(o *options) {
+		o.dataSourceID = dataSourceID
+		o.project = project
+	}
+}
+
 // NewRestDataSource builds a new REST data source.
-func NewRestDataSource(rest *minderv1.RestDataSource, provider provinfv1.Provider) (v1datasources.DataSource, error) {
+func NewRestDataSource(
+	rest *minderv1.RestDataSource, provider provinfv1.Provider, opts ...Option,
+) (v1datasources.DataSource, error) {
 	if rest == nil {
 		return nil, errors.New("rest data source is nil")
 	}
@@ -56,6 +81,11 @@ func NewRestDataSource(rest *minderv1.RestDataSource, provider provinfv1.Provide
 		provider = nil
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	out := &restDataSource{
 		handlers: make(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef, len(rest.GetDef())),
 	}
@@ -65,6 +95,11 @@ func NewRestDataSource(rest *minderv1.RestDataSource, provider provinfv1.Provide
 		if err != nil {
 			return nil, err
 		}
+		if o.dataSourceID != "" {
+			handler.dataSourceID = o.dataSourceID
+			handler.cacheScope = o.project.String() + "/" + o.dataSourceID + "/" + key
+			handler.limiter = getLimiter(o.dataSourceID, rest.GetRateLimit())
+		}
 
 		out.handlers[v1datasources.DataSourceFuncKey(key)] = handler
 	}
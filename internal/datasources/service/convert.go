@@ -11,6 +11,7 @@ import (
 
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/db"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
@@ -20,6 +21,18 @@ import (
 type DataSourceMetadata struct {
 	Type         string `json:"type"`
 	ProviderAuth bool   `json:"providerAuth"`
+	// Connection is the encrypted connection string of SQL data sources
+	Connection *crypto.EncryptedData `json:"connection,omitempty"`
+}
+
+func dataSourceMetadataFromDB(ds db.DataSource) (DataSourceMetadata, error) {
+	var metadata DataSourceMetadata
+	if ds.Metadata.Valid {
+		if err := json.Unmarshal(ds.Metadata.RawMessage, &metadata); err != nil {
+			return DataSourceMetadata{}, fmt.Errorf("unable to unmarshal metadata: %w", err)
+		}
+	}
+	return metadata, nil
 }
 
 func dataSourceDBToProtobuf(ds db.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
@@ -37,11 +50,9 @@ func dataSourceDBToProtobuf(ds db.DataSource, dsfuncs []db.DataSourcesFunction)
 		return nil, errors.New("data source is invalid and has no defintions")
 	}
 
-	var metadata DataSourceMetadata
-	if ds.Metadata.Valid {
-		if err := json.Unmarshal(ds.Metadata.RawMessage, &metadata); err != nil {
-			return nil, fmt.Errorf("unable to unmarshal metadata: %w", err)
-		}
+	metadata, err := dataSourceMetadataFromDB(ds)
+	if err != nil {
+		return nil, err
 	}
 
 	// If we didn't record the type in metadata, use the first function to guess.
@@ -58,6 +69,18 @@ func dataSourceDBToProtobuf(ds db.DataSource, dsfuncs []db.DataSourcesFunction)
 		}
 		outds.GetRest().ProviderAuth = metadata.ProviderAuth
 		return dataSourceRestDBToProtobuf(outds, dsfuncs)
+	case v1datasources.DataSourceDriverGraphQL:
+		outds.Driver = &minderv1.DataSource_Graphql{
+			Graphql: &minderv1.GraphQLDataSource{},
+		}
+		outds.GetGraphql().ProviderAuth = metadata.ProviderAuth
+		return dataSourceGraphQLDBToProtobuf(outds, dsfuncs)
+	case v1datasources.DataSourceDriverSQL:
+		// the connection string is left out on purpose
+		outds.Driver = &minderv1.DataSource_Sql{
+			Sql: &minderv1.SQLDataSource{},
+		}
+		return dataSourceSQLDBToProtobuf(outds, dsfuncs)
 	default:
 		return nil, fmt.Errorf("unknown data source type: %s", dsfType)
 	}
@@ -80,6 +103,38 @@ func dataSourceRestDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSource
 	return ds, nil
 }
 
+func dataSourceGraphQLDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
+	ds.GetGraphql().Def = make(map[string]*minderv1.GraphQLDataSource_Def, len(dsfuncs))
+
+	for _, dsf := range dsfuncs {
+		key := dsf.Name
+		dsfToParse := &minderv1.GraphQLDataSource_Def{}
+		if err := protojson.Unmarshal(dsf.Definition, dsfToParse); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data source definition for %s: %w", key, err)
+		}
+
+		ds.GetGraphql().Def[key] = dsfToParse
+	}
+
+	return ds, nil
+}
+
+func dataSourceSQLDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
+	ds.GetSql().Def = make(map[string]*minderv1.SQLDataSource_Def, len(dsfuncs))
+
+	for _, dsf := range dsfuncs {
+		key := dsf.Name
+		dsfToParse := &minderv1.SQLDataSource_Def{}
+		if err := protojson.Unmarshal(dsf.Definition, dsfToParse); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal data source definition for %s: %w", key, err)
+		}
+
+		ds.GetSql().Def[key] = dsfToParse
+	}
+
+	return ds, nil
+}
+
 func dataSourceStructDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSourcesFunction) (*minderv1.DataSource, error) {
 	ds.GetStructured().Def = make(map[string]*minderv1.StructDataSource_Def, len(dsfuncs))
 
@@ -98,7 +153,7 @@ func dataSourceStructDBToProtobuf(ds *minderv1.DataSource, dsfuncs []db.DataSour
 	return ds, nil
 }
 
-func metadataForDataSource(ds *minderv1.DataSource) (json.RawMessage, error) {
+func metadataForDataSource(ds *minderv1.DataSource, connection *crypto.EncryptedData) (json.RawMessage, error) {
 	metadata := DataSourceMetadata{
 		Type: v1datasources.DataSourceDriverStruct,
 	}
@@ -106,6 +161,12 @@ func metadataForDataSource(ds *minderv1.DataSource) (json.RawMessage, error) {
 	case *minderv1.DataSource_Rest:
 		metadata.Type = v1datasources.DataSourceDriverRest
 		metadata.ProviderAuth = ds.GetRest().GetProviderAuth()
+	case *minderv1.DataSource_Graphql:
+		metadata.Type = v1datasources.DataSourceDriverGraphQL
+		metadata.ProviderAuth = ds.GetGraphql().GetProviderAuth()
+	case *minderv1.DataSource_Sql:
+		metadata.Type = v1datasources.DataSourceDriverSQL
+		metadata.Connection = connection
 	case *minderv1.DataSource_Structured:
 		metadata.Type = v1datasources.DataSourceDriverStruct
 	default:
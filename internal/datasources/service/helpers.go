@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 
+	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/datasources"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/util"
@@ -147,12 +148,34 @@ func (d *dataSourceService) instantiateDataSource(
 		return nil, errors.New("data source name is empty")
 	}
 
-	ds, err := d.GetByName(ctx, ref.GetName(), uuid.Nil,
-		ReadBuilder().withHierarchy(projectHierarchy).WithTransaction(tx))
+	dbds, err := getDataSourceFromDb(ctx, uuid.Nil, ReadBuilder().withHierarchy(projectHierarchy), tx,
+		func(ctx context.Context, tx db.ExtendQuerier, projs []uuid.UUID) (db.DataSource, error) {
+			return getByNameQuery(ctx, tx, projs, ref.GetName())
+		})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get data source by name: %w", err)
 	}
 
+	dsfuncs, err := getDataSourceFunctions(ctx, tx, dbds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data source functions: %w", err)
+	}
+
+	ds, err := dataSourceDBToProtobuf(*dbds, dsfuncs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The connection string is never returned by the API, so it is only
+	// decrypted when the data source is about to be used.
+	if ds.GetSql() != nil {
+		connection, err := d.decryptConnection(*dbds)
+		if err != nil {
+			return nil, err
+		}
+		ds.GetSql().ConnectionString = connection
+	}
+
 	return ds, nil
 }
 
@@ -211,3 +234,59 @@ func validateDataSourceFunctionsUpdate(
 
 	return nil
 }
+
+// encryptConnection returns the encrypted connection string of a SQL data
+// source, or the existing one if the data source doesn't set a new one.
+func (d *dataSourceService) encryptConnection(
+	ds *minderv1.DataSource, existing *crypto.EncryptedData,
+) (*crypto.EncryptedData, error) {
+	if ds.GetSql() == nil {
+		return nil, nil
+	}
+
+	connection := ds.GetSql().GetConnectionString()
+	if connection == "" {
+		if existing == nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument,
+				"sql data source %s has no connection string", ds.GetName())
+		}
+		return existing, nil
+	}
+
+	if d.cryptoEngine == nil {
+		return nil, errors.New("cannot store sql connection string without a crypto engine")
+	}
+	encrypted, err := d.cryptoEngine.EncryptString(connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt sql connection string: %w", err)
+	}
+	return &encrypted, nil
+}
+
+// decryptConnection returns the connection string of a SQL data source
+func (d *dataSourceService) decryptConnection(ds db.DataSource) (string, error) {
+	metadata, err := dataSourceMetadataFromDB(ds)
+	if err != nil {
+		return "", err
+	}
+	if metadata.Connection == nil {
+		return "", fmt.Errorf("sql data source %s has no connection string", ds.Name)
+	}
+
+	if d.cryptoEngine == nil {
+		return "", errors.New("cannot read sql connection string without a crypto engine")
+	}
+	connection, err := d.cryptoEngine.DecryptString(*metadata.Connection)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt sql connection string: %w", err)
+	}
+	return connection, nil
+}
+
+// clearConnection removes the connection string of a SQL data source, which
+// must not be returned once stored.
+func clearConnection(ds *minderv1.DataSource) {
+	if ds.GetSql() != nil {
+		ds.GetSql().ConnectionString = ""
+	}
+}
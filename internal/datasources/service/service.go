@@ -15,7 +15,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/datasources"
+	sqlds "github.com/mindersec/minder/internal/datasources/sql"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/marketplaces/namespaces"
 	"github.com/mindersec/minder/internal/util"
@@ -73,17 +75,21 @@ type DataSourcesService interface {
 
 type dataSourceService struct {
 	store db.Store
+	// used for the connection strings of SQL data sources, may be nil
+	cryptoEngine crypto.Engine
 
 	// This is a function that will begin a transaction for the service.
 	// We make this a function so that we can mock it in tests.
 	txBuilder func(d *dataSourceService, opts txGetter) (serviceTX, error)
 }
 
-// NewDataSourceService creates a new data source service.
-func NewDataSourceService(store db.Store) *dataSourceService {
+// NewDataSourceService creates a new data source service. The crypto engine
+// is only needed to create and use SQL data sources, and may be nil otherwise.
+func NewDataSourceService(store db.Store, cryptoEngine crypto.Engine) *dataSourceService {
 	return &dataSourceService{
-		store:     store,
-		txBuilder: beginTx,
+		store:        store,
+		cryptoEngine: cryptoEngine,
+		txBuilder:    beginTx,
 	}
 }
 
@@ -228,7 +234,11 @@ func (d *dataSourceService) Create(
 	}
 
 	// Create data source record
-	metadataBytes, err := metadataForDataSource(ds)
+	connection, err := d.encryptConnection(ds, nil)
+	if err != nil {
+		return nil, err
+	}
+	metadataBytes, err := metadataForDataSource(ds, connection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
 	}
@@ -254,6 +264,7 @@ func (d *dataSourceService) Create(
 	stx = nil // Don't try to rollback
 
 	ds.Id = dsRecord.ID.String()
+	clearConnection(ds)
 
 	return ds, nil
 }
@@ -314,7 +325,16 @@ func (d *dataSourceService) Update(
 		return nil, err
 	}
 
-	metadataBytes, err := metadataForDataSource(ds)
+	// The connection string is kept if the update doesn't have one
+	existingMetadata, err := dataSourceMetadataFromDB(*existingDS)
+	if err != nil {
+		return nil, err
+	}
+	connection, err := d.encryptConnection(ds, existingMetadata.Connection)
+	if err != nil {
+		return nil, err
+	}
+	metadataBytes, err := metadataForDataSource(ds, connection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize metadata: %w", err)
 	}
@@ -343,9 +363,13 @@ func (d *dataSourceService) Update(
 	}
 	stx = nil // Don't try to rollback
 
+	// The connection string may have changed
+	sqlds.ClosePool(existingDS.ID.String())
+
 	if ds.Id == "" {
 		ds.Id = existingDS.ID.String()
 	}
+	clearConnection(ds)
 
 	return ds, nil
 }
@@ -446,6 +470,8 @@ func (d *dataSourceService) Delete(
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 	stx = nil // Don't try to rollback
+
+	sqlds.ClosePool(id.String())
 	return nil
 }
 
@@ -493,7 +519,7 @@ func (d *dataSourceService) BuildDataSourceRegistry(
 
 		// Get provider from options if available, needed for authenticated data sources
 		provider := opts.getProvider()
-		impl, err := datasources.BuildFromProtobuf(inst, provider)
+		impl, err := datasources.BuildFromProtobuf(inst, provider, datasources.WithEvaluationProject(proj))
 		if err != nil {
 			return nil, fmt.Errorf("failed to build data source from protobuf: %w", err)
 		}
@@ -559,6 +585,40 @@ func addDataSourceFunctions(
 				return fmt.Errorf("failed to create data source function: %w", err)
 			}
 		}
+	case *minderv1.DataSource_Graphql:
+		for name, def := range drv.Graphql.GetDef() {
+			defBytes, err := protojson.Marshal(def)
+			if err != nil {
+				return fmt.Errorf("failed to marshal GraphQL definition: %w", err)
+			}
+
+			if _, err := tx.AddDataSourceFunction(ctx, db.AddDataSourceFunctionParams{
+				DataSourceID: dsID,
+				ProjectID:    projectID,
+				Name:         name,
+				Type:         v1datasources.DataSourceDriverGraphQL,
+				Definition:   defBytes,
+			}); err != nil {
+				return fmt.Errorf("failed to create data source function: %w", err)
+			}
+		}
+	case *minderv1.DataSource_Sql:
+		for name, def := range drv.Sql.GetDef() {
+			defBytes, err := protojson.Marshal(def)
+			if err != nil {
+				return fmt.Errorf("failed to marshal SQL definition: %w", err)
+			}
+
+			if _, err := tx.AddDataSourceFunction(ctx, db.AddDataSourceFunctionParams{
+				DataSourceID: dsID,
+				ProjectID:    projectID,
+				Name:         name,
+				Type:         v1datasources.DataSourceDriverSQL,
+				Definition:   defBytes,
+			}); err != nil {
+				return fmt.Errorf("failed to create data source function: %w", err)
+			}
+		}
 	default:
 		return fmt.Errorf("unsupported data source driver type: %T", drv)
 	}
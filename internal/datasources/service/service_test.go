@@ -6,11 +6,13 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -18,6 +20,8 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/crypto"
+	mockcrypto "github.com/mindersec/minder/internal/crypto/mock"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/util/ptr"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -166,7 +170,7 @@ func TestGetByName(t *testing.T) {
 			// Setup
 			mockStore := mockdb.NewMockStore(ctrl)
 
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
@@ -283,7 +287,7 @@ func TestGetByID(t *testing.T) {
 			// Setup
 			mockStore := mockdb.NewMockStore(ctrl)
 
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
@@ -388,7 +392,7 @@ func TestList(t *testing.T) {
 			// Setup
 			mockStore := mockdb.NewMockStore(ctrl)
 
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
@@ -547,7 +551,7 @@ func TestCreate(t *testing.T) {
 
 			mockStore := mockdb.NewMockStore(ctrl)
 
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
@@ -849,7 +853,7 @@ func TestBuildDataSourceRegistry(t *testing.T) {
 			// Setup
 			mockStore := mockdb.NewMockStore(ctrl)
 
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
@@ -897,6 +901,131 @@ func restDriverToJson(t *testing.T, rs *minderv1.RestDataSource_Def) []byte {
 	return out
 }
 
+func TestSQLConnection(t *testing.T) {
+	t.Parallel()
+
+	const connection = "postgres://inventory@db.example.com/inventory"
+	encrypted := crypto.EncryptedData{Algorithm: "aes-256-gcm", EncodedData: "ZW5jcnlwdGVk", KeyVersion: "v1"}
+
+	sqlDef := &minderv1.SQLDataSource_Def{
+		Query:  "SELECT owner FROM repo_owners WHERE repo = $1",
+		Params: []string{"repo"},
+		InputSchema: func() *structpb.Struct {
+			s, _ := structpb.NewStruct(map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"repo": map[string]any{"type": "string"}},
+			})
+			return s
+		}(),
+	}
+	newDS := func(connection string) *minderv1.DataSource {
+		return &minderv1.DataSource{
+			Name: "inventory",
+			Driver: &minderv1.DataSource_Sql{
+				Sql: &minderv1.SQLDataSource{
+					Def:              map[string]*minderv1.SQLDataSource_Def{"owners": sqlDef},
+					ConnectionString: connection,
+				},
+			},
+		}
+	}
+
+	t.Run("create encrypts the connection string", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		mockStore := mockdb.NewMockStore(ctrl)
+		mockCrypto := mockcrypto.NewMockEngine(ctrl)
+
+		mockStore.EXPECT().GetParentProjects(gomock.Any(), gomock.Any()).Return([]uuid.UUID{projectID}, nil)
+		mockStore.EXPECT().GetDataSourceByName(gomock.Any(), gomock.Any()).Return(db.DataSource{}, sql.ErrNoRows)
+		mockCrypto.EXPECT().EncryptString(connection).Return(encrypted, nil)
+		mockStore.EXPECT().CreateDataSource(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, arg db.CreateDataSourceParams) (db.DataSource, error) {
+				var metadata DataSourceMetadata
+				require.NoError(t, json.Unmarshal(arg.Metadata, &metadata))
+				assert.Equal(t, v1.DataSourceDriverSQL, metadata.Type)
+				assert.Equal(t, &encrypted, metadata.Connection)
+				assert.NotContains(t, string(arg.Metadata), connection)
+				return db.DataSource{ID: uuid.New(), Name: arg.Name}, nil
+			})
+		mockStore.EXPECT().AddDataSourceFunction(gomock.Any(), gomock.Any()).Return(db.DataSourcesFunction{}, nil)
+
+		svc := NewDataSourceService(mockStore, mockCrypto)
+		svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
+			return &fakeTxBuilder{store: mockStore}, nil
+		}
+
+		got, err := svc.Create(context.Background(), projectID, uuid.Nil, newDS(connection), &Options{})
+		require.NoError(t, err)
+		assert.Empty(t, got.GetSql().GetConnectionString())
+	})
+
+	t.Run("create requires a connection string", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		mockStore := mockdb.NewMockStore(ctrl)
+
+		mockStore.EXPECT().GetParentProjects(gomock.Any(), gomock.Any()).Return([]uuid.UUID{projectID}, nil)
+		mockStore.EXPECT().GetDataSourceByName(gomock.Any(), gomock.Any()).Return(db.DataSource{}, sql.ErrNoRows)
+
+		svc := NewDataSourceService(mockStore, mockcrypto.NewMockEngine(ctrl))
+		svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
+			return &fakeTxBuilder{store: mockStore}, nil
+		}
+
+		_, err := svc.Create(context.Background(), projectID, uuid.Nil, newDS(""), &Options{})
+		assert.ErrorContains(t, err, "sql data source inventory has no connection string")
+	})
+
+	t.Run("registry decrypts the connection string", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		mockStore := mockdb.NewMockStore(ctrl)
+		mockCrypto := mockcrypto.NewMockEngine(ctrl)
+
+		metadata, err := json.Marshal(DataSourceMetadata{Type: v1.DataSourceDriverSQL, Connection: &encrypted})
+		require.NoError(t, err)
+		defBytes, err := protojson.Marshal(sqlDef)
+		require.NoError(t, err)
+		dsID := uuid.New()
+
+		mockStore.EXPECT().GetParentProjects(gomock.Any(), projectID).Return([]uuid.UUID{projectID}, nil)
+		mockStore.EXPECT().GetDataSourceByName(gomock.Any(), gomock.Any()).Return(db.DataSource{
+			ID:        dsID,
+			Name:      "inventory",
+			ProjectID: projectID,
+			Metadata:  pqtype.NullRawMessage{RawMessage: metadata, Valid: true},
+		}, nil)
+		mockStore.EXPECT().ListDataSourceFunctions(gomock.Any(), gomock.Any()).Return([]db.DataSourcesFunction{{
+			DataSourceID: dsID,
+			ProjectID:    projectID,
+			Name:         "owners",
+			Type:         v1.DataSourceDriverSQL,
+			Definition:   defBytes,
+		}}, nil)
+		mockCrypto.EXPECT().DecryptString(encrypted).Return(connection, nil)
+
+		svc := NewDataSourceService(mockStore, mockCrypto)
+		svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
+			return &fakeTxBuilder{store: mockStore}, nil
+		}
+
+		reg, err := svc.BuildDataSourceRegistry(context.Background(), &minderv1.RuleType{
+			Context: &minderv1.Context{Project: ptr.Ptr(projectID.String())},
+			Def: &minderv1.RuleType_Definition{
+				Eval: &minderv1.RuleType_Definition_Eval{
+					DataSources: []*minderv1.DataSourceReference{{Name: "inventory"}},
+				},
+			},
+		}, &Options{})
+		require.NoError(t, err)
+		assert.Len(t, reg.GetFuncs(), 1)
+	})
+}
+
 func TestDelete(t *testing.T) {
 	t.Parallel()
 
@@ -1065,7 +1194,7 @@ func TestDelete(t *testing.T) {
 			// Setup
 			mockStore := mockdb.NewMockStore(ctrl)
 
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
@@ -1526,7 +1655,7 @@ func TestUpdate(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mockdb.NewMockStore(ctrl)
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
@@ -1698,7 +1827,7 @@ func TestUpsert(t *testing.T) {
 
 			mockStore := mockdb.NewMockStore(ctrl)
 
-			svc := NewDataSourceService(mockStore)
+			svc := NewDataSourceService(mockStore, nil)
 			svc.txBuilder = func(_ *dataSourceService, _ txGetter) (serviceTX, error) {
 				return &fakeTxBuilder{
 					store: mockStore,
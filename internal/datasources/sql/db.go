@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// the connections to each database are shared by all evaluations
+	maxOpenConns    = 5
+	maxIdleConns    = 2
+	connMaxIdleTime = 5 * time.Minute
+)
+
+// rowQuerier runs read-only queries
+type rowQuerier interface {
+	// QueryRows returns at most limit rows of the result of the query
+	QueryRows(ctx context.Context, query string, args []any, limit int) ([]map[string]any, error)
+}
+
+// readOnlySession is run on every new connection, so that even a query
+// ending the read-only transaction it is run in cannot write
+const readOnlySession = "SET SESSION CHARACTERISTICS AS TRANSACTION READ ONLY"
+
+// readOnlyConnector opens connections whose transactions are read-only by
+// default
+type readOnlyConnector struct {
+	driver.Connector
+}
+
+func (c *readOnlyConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		_ = conn.Close()
+		return nil, errors.New("sql connection cannot be made read-only")
+	}
+	if _, err := execer.ExecContext(ctx, readOnlySession, nil); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("cannot make sql connection read-only: %w", err)
+	}
+
+	return conn, nil
+}
+
+type pool struct {
+	db *sql.DB
+	// connectionHash is the hash of the connection string the pool
+	// connects with, so that the pool is replaced when it changes
+	connectionHash string
+}
+
+var (
+	poolsMu sync.Mutex
+	// pools are keyed by data source ID, since the data source registry
+	// is built again for every evaluation
+	pools = map[string]*pool{}
+)
+
+func hashConnection(connection string) string {
+	sum := sha256.Sum256([]byte(connection))
+	return hex.EncodeToString(sum[:])
+}
+
+func getPool(key string, connection string) (*sql.DB, error) {
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+
+	connectionHash := hashConnection(connection)
+	if p, ok := pools[key]; ok {
+		if p.connectionHash == connectionHash {
+			return p.db, nil
+		}
+		// the data source was updated by another server instance
+		_ = p.db.Close()
+		delete(pools, key)
+	}
+
+	connector, err := pq.NewConnector(connection)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sql connection string: %w", err)
+	}
+	// this doesn't connect to the database yet
+	db := sql.OpenDB(&readOnlyConnector{Connector: connector})
+	db.SetMaxOpenConns(maxOpenConns)
+	db.SetMaxIdleConns(maxIdleConns)
+	db.SetConnMaxIdleTime(connMaxIdleTime)
+
+	pools[key] = &pool{db: db, connectionHash: connectionHash}
+	return db, nil
+}
+
+// ClosePool closes the connections to the database of a data source. It is
+// called when the data source is updated or deleted, so that they are not
+// kept open with a connection string no longer in use.
+func ClosePool(dataSourceID string) {
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+
+	if p, ok := pools[dataSourceID]; ok {
+		_ = p.db.Close()
+		delete(pools, dataSourceID)
+	}
+}
+
+type dbQuerier struct {
+	// poolKey is the data source ID, or the hash of the connection
+	// string when the data source has no ID
+	poolKey    string
+	connection string
+}
+
+func (q *dbQuerier) QueryRows(ctx context.Context, query string, args []any, limit int) ([]map[string]any, error) {
+	db, err := getPool(q.poolKey, q.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot start sql transaction: %w", err)
+	}
+	// nothing is ever written
+	defer func() { _ = tx.Rollback() }()
+
+	// Queries are always prepared, even without arguments, since the
+	// driver would otherwise send them with the simple query protocol,
+	// which runs several statements separated by semicolons.
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("sql query failed: %w", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sql query failed: %w", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read sql columns: %w", err)
+	}
+
+	out := []map[string]any{}
+	for len(out) < limit && rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, fmt.Errorf("cannot read sql row: %w", err)
+		}
+
+		row := make(map[string]any, len(columns))
+		for i, column := range columns {
+			row[column] = normalizeValue(values[i])
+		}
+		out = append(out, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sql query failed: %w", err)
+	}
+
+	return out, nil
+}
+
+// normalizeValue converts the values returned by the driver to the types
+// used by the rule evaluation
+func normalizeValue(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		// text, and types without a specific representation
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
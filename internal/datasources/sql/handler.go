@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/lib/pq"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/util/schemaupdate"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	defaultMaxRows = 100
+	defaultTimeout = 5 * time.Second
+)
+
+type sqlHandler struct {
+	rawInputSchema *structpb.Struct
+	inputSchema    *jsonschema.Schema
+	query          string
+	// the names of the arguments passed as $1, $2, etc.
+	params  []string
+	maxRows int
+	timeout time.Duration
+	querier rowQuerier
+}
+
+func newHandlerFromDef(def *minderv1.SQLDataSource_Def, querier rowQuerier) (*sqlHandler, error) {
+	if def == nil {
+		return nil, errors.New("sql data source handler definition is nil")
+	}
+
+	// schema may be nil
+	schema, err := schemavalidate.CompileSchemaFromPB(def.GetInputSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSingleStatement(def.GetQuery()); err != nil {
+		return nil, err
+	}
+
+	properties, _ := def.GetInputSchema().AsMap()["properties"].(map[string]any)
+	for _, param := range def.GetParams() {
+		if _, ok := properties[param]; !ok {
+			return nil, fmt.Errorf("query parameter %s is not in the input schema", param)
+		}
+	}
+
+	return &sqlHandler{
+		rawInputSchema: def.GetInputSchema(),
+		inputSchema:    schema,
+		query:          def.GetQuery(),
+		params:         def.GetParams(),
+		maxRows:        cmp.Or(int(def.GetMaxRows()), defaultMaxRows),
+		timeout:        cmp.Or(time.Duration(def.GetTimeoutSeconds())*time.Second, defaultTimeout),
+		querier:        querier,
+	}, nil
+}
+
+func (h *sqlHandler) GetArgsSchema() *structpb.Struct {
+	return h.rawInputSchema
+}
+
+func (h *sqlHandler) ValidateArgs(args any) error {
+	if h.inputSchema == nil {
+		return errors.New("input schema cannot be nil")
+	}
+
+	mapobj, ok := args.(map[string]any)
+	if !ok {
+		return errors.New("args is not a map")
+	}
+
+	return schemavalidate.ValidateAgainstSchema(h.inputSchema, mapobj)
+}
+
+func (h *sqlHandler) ValidateUpdate(argsSchema *structpb.Struct) error {
+	if argsSchema == nil {
+		return errors.New("update schema cannot be nil")
+	}
+
+	if _, err := schemavalidate.CompileSchemaFromPB(argsSchema); err != nil {
+		return fmt.Errorf("update validation failed due to invalid schema: %w", err)
+	}
+	return schemaupdate.ValidateSchemaUpdate(h.rawInputSchema, argsSchema)
+}
+
+func (h *sqlHandler) Call(ctx context.Context, _ *interfaces.Ingested, args any) (any, error) {
+	if h.querier == nil {
+		return nil, errors.New("sql data source has no connection string")
+	}
+
+	argsMap, ok := args.(map[string]any)
+	if !ok {
+		return nil, errors.New("args is not a map")
+	}
+
+	params := make([]any, 0, len(h.params))
+	for _, name := range h.params {
+		value, err := toParam(argsMap[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for query parameter %s: %w", name, err)
+		}
+		params = append(params, value)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	// one more row than returned, to know whether the result was truncated
+	rows, err := h.querier.QueryRows(ctx, h.query, params, h.maxRows+1)
+	if err != nil {
+		return nil, err
+	}
+
+	truncated := len(rows) > h.maxRows
+	if truncated {
+		rows = rows[:h.maxRows]
+	}
+
+	return buildSQLOutput(rows, truncated), nil
+}
+
+// dollarQuoteRegex matches the opening tag of a dollar-quoted string, such
+// as $$ or $body$, but not a parameter such as $1
+var dollarQuoteRegex = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+// checkSingleStatement returns an error if the query holds more than one
+// statement. Semicolons within literals, quoted identifiers and comments
+// are ignored, and a trailing semicolon is allowed.
+func checkSingleStatement(query string) error {
+	ended := false
+	for i := 0; i < len(query); i++ {
+		var end int
+		switch c := query[i]; {
+		case c == '\'':
+			end = endOfQuoted(query, i, isEscapeString(query, i))
+		case c == '"':
+			end = strings.IndexByte(query[i+1:], '"')
+			if end != -1 {
+				end += i + 1
+			}
+		case strings.HasPrefix(query[i:], "--"):
+			end = strings.IndexByte(query[i:], '\n')
+			if end == -1 {
+				return nil
+			}
+			end += i
+		case strings.HasPrefix(query[i:], "/*"):
+			end = endOfBlockComment(query, i)
+		case c == '$' && (i == 0 || !isIdentifierChar(query[i-1])):
+			tag := dollarQuoteRegex.FindString(query[i:])
+			if tag == "" {
+				// a parameter
+				if ended {
+					return errors.New("sql query must be a single statement")
+				}
+				continue
+			}
+			end = strings.Index(query[i+len(tag):], tag)
+			if end != -1 {
+				end += i + 2*len(tag) - 1
+			}
+		case c == ';':
+			ended = true
+			continue
+		case unicode.IsSpace(rune(c)):
+			continue
+		default:
+			if ended {
+				return errors.New("sql query must be a single statement")
+			}
+			continue
+		}
+
+		if end == -1 {
+			return errors.New("sql query has an unterminated literal or comment")
+		}
+		// comments may follow the trailing semicolon, but nothing else
+		if ended && query[i] != '-' && query[i] != '/' {
+			return errors.New("sql query must be a single statement")
+		}
+		i = end
+	}
+	return nil
+}
+
+// isEscapeString tells whether the string literal starting at i is an
+// escape string, such as E'it\'s', in which backslashes escape quotes
+func isEscapeString(query string, i int) bool {
+	return i > 0 && (query[i-1] == 'E' || query[i-1] == 'e') &&
+		(i == 1 || !isIdentifierChar(query[i-2]))
+}
+
+// endOfQuoted returns the index of the quote ending the string literal
+// starting at i, or -1 if it is not terminated. Doubled quotes are part of
+// the literal.
+func endOfQuoted(query string, i int, backslashEscapes bool) int {
+	for j := i + 1; j < len(query); j++ {
+		switch query[j] {
+		case '\\':
+			if backslashEscapes {
+				j++
+			}
+		case '\'':
+			if j+1 < len(query) && query[j+1] == '\'' {
+				j++
+				continue
+			}
+			return j
+		}
+	}
+	return -1
+}
+
+// endOfBlockComment returns the index of the last character of the block
+// comment starting at i, or -1 if it is not terminated. Block comments may
+// be nested.
+func endOfBlockComment(query string, i int) int {
+	depth := 0
+	for j := i; j+1 < len(query); j++ {
+		switch query[j : j+2] {
+		case "/*":
+			depth++
+			j++
+		case "*/":
+			depth--
+			j++
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= '0' && c <= '9') ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// toParam converts an argument to a value the driver accepts
+func toParam(value any) (any, error) {
+	switch v := value.(type) {
+	case nil, string, bool, int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case float64:
+		// integral numbers are sent as integers, so that they can be
+		// compared with integer columns
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+			return int64(v), nil
+		}
+		return v, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			converted, err := toParam(item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, converted)
+		}
+		// lists are passed as arrays, e.g. for `col = ANY($1)`
+		return pq.Array(items), nil
+	case map[string]any:
+		// objects are passed as JSON, e.g. for `$1::jsonb`
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+func buildSQLOutput(rows []map[string]any, truncated bool) any {
+	out := make([]any, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row)
+	}
+	return map[string]any{
+		"rows":      out,
+		"truncated": truncated,
+	}
+}
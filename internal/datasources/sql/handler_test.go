@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const ownersQuery = `SELECT team, owner FROM repo_owners WHERE repo = $1 AND team = ANY($2)`
+
+func ownersSchema(t *testing.T) *structpb.Struct {
+	t.Helper()
+
+	s, err := structpb.NewStruct(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"repo":  map[string]any{"type": "string"},
+			"teams": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+		},
+		"required": []any{"repo"},
+	})
+	require.NoError(t, err)
+	return s
+}
+
+type fakeQuerier struct {
+	rows []map[string]any
+	err  error
+
+	query    string
+	args     []any
+	limit    int
+	deadline time.Time
+}
+
+func (f *fakeQuerier) QueryRows(ctx context.Context, query string, args []any, limit int) ([]map[string]any, error) {
+	f.query = query
+	f.args = args
+	f.limit = limit
+	f.deadline, _ = ctx.Deadline()
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.rows[:min(limit, len(f.rows))], nil
+}
+
+func TestNewHandlerFromDef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		def      *minderv1.SQLDataSource_Def
+		errorMsg string
+	}{
+		{
+			name: "valid query",
+			def: &minderv1.SQLDataSource_Def{
+				Query:  ownersQuery,
+				Params: []string{"repo", "teams"},
+			},
+		},
+		{
+			name:     "nil definition",
+			errorMsg: "sql data source handler definition is nil",
+		},
+		{
+			name: "parameter missing from the schema",
+			def: &minderv1.SQLDataSource_Def{
+				Query:  ownersQuery,
+				Params: []string{"repo", "team"},
+			},
+			errorMsg: "query parameter team is not in the input schema",
+		},
+		{
+			name: "stacked statements",
+			def: &minderv1.SQLDataSource_Def{
+				Query:  ownersQuery + "; COMMIT; DELETE FROM repo_owners",
+				Params: []string{"repo", "teams"},
+			},
+			errorMsg: "sql query must be a single statement",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.def != nil {
+				tt.def.InputSchema = ownersSchema(t)
+			}
+
+			h, err := newHandlerFromDef(tt.def, nil)
+			if tt.errorMsg != "" {
+				assert.ErrorContains(t, err, tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, defaultMaxRows, h.maxRows)
+			assert.Equal(t, defaultTimeout, h.timeout)
+		})
+	}
+}
+
+func TestSQLHandler_Call(t *testing.T) {
+	t.Parallel()
+
+	rows := func(n int) []map[string]any {
+		out := make([]map[string]any, 0, n)
+		for i := range n {
+			out = append(out, map[string]any{"team": "security", "owner": fmt.Sprintf("user%d", i)})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name          string
+		maxRows       int32
+		rows          []map[string]any
+		queryErr      error
+		args          map[string]any
+		wantRows      int
+		wantTruncated bool
+		wantErr       string
+	}{
+		{
+			name:     "all rows",
+			maxRows:  3,
+			rows:     rows(3),
+			args:     map[string]any{"repo": "mindersec/minder", "teams": []any{"security"}},
+			wantRows: 3,
+		},
+		{
+			name:          "truncated rows",
+			maxRows:       3,
+			rows:          rows(10),
+			args:          map[string]any{"repo": "mindersec/minder", "teams": []any{"security"}},
+			wantRows:      3,
+			wantTruncated: true,
+		},
+		{
+			name:     "query error",
+			args:     map[string]any{"repo": "mindersec/minder"},
+			queryErr: errors.New("relation does not exist"),
+			wantErr:  "relation does not exist",
+		},
+		{
+			name:    "unsupported argument",
+			args:    map[string]any{"repo": struct{}{}},
+			wantErr: "invalid value for query parameter repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			querier := &fakeQuerier{rows: tt.rows, err: tt.queryErr}
+
+			h, err := newHandlerFromDef(&minderv1.SQLDataSource_Def{
+				Query:          ownersQuery,
+				Params:         []string{"repo", "teams"},
+				InputSchema:    ownersSchema(t),
+				MaxRows:        tt.maxRows,
+				TimeoutSeconds: 2,
+			}, querier)
+			require.NoError(t, err)
+
+			start := time.Now()
+			got, err := h.Call(context.Background(), nil, tt.args)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, ownersQuery, querier.query)
+			assert.Equal(t, int(tt.maxRows)+1, querier.limit)
+			assert.WithinDuration(t, start.Add(2*time.Second), querier.deadline, time.Second)
+			require.Len(t, querier.args, 2)
+			assert.Equal(t, "mindersec/minder", querier.args[0])
+			assert.Equal(t, pq.Array([]any{"security"}), querier.args[1])
+
+			out := got.(map[string]any)
+			assert.Len(t, out["rows"], tt.wantRows)
+			assert.Equal(t, tt.wantTruncated, out["truncated"])
+		})
+	}
+}
+
+func TestSQLHandler_NoConnection(t *testing.T) {
+	t.Parallel()
+
+	h, err := newHandlerFromDef(&minderv1.SQLDataSource_Def{
+		Query:       ownersQuery,
+		InputSchema: ownersSchema(t),
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = h.Call(context.Background(), nil, map[string]any{"repo": "mindersec/minder"})
+	assert.ErrorContains(t, err, "sql data source has no connection string")
+}
+
+func TestCheckSingleStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"SELECT 1":                        "",
+		"SELECT 1;":                       "",
+		"SELECT 1; -- done":               "",
+		"SELECT 1; /* done */ ":           "",
+		"SELECT ';' AS a":                 "",
+		"SELECT 'it''s; fine'":            "",
+		`SELECT E'it\'s; fine'`:           "",
+		`SELECT 1 AS "a;b"`:               "",
+		"SELECT $$;$$, $body$ ; $body$":   "",
+		"SELECT 1 -- ; DROP TABLE t":      "",
+		"SELECT /* /* ; */ ; */ 1":        "",
+		"SELECT * FROM t WHERE a = $1":    "",
+		"SELECT 1; COMMIT; DELETE FROM t": "sql query must be a single statement",
+		"SELECT 1;SELECT 2":               "sql query must be a single statement",
+		"SELECT 1; 'a'":                   "sql query must be a single statement",
+		"SELECT 1; $$a$$":                 "sql query must be a single statement",
+		`SELECT 'a\'; DELETE FROM t; --'`: "sql query must be a single statement",
+		"SELECT 'a":                       "sql query has an unterminated literal or comment",
+		"SELECT 1 /* ; ":                  "sql query has an unterminated literal or comment",
+	}
+	for query, errorMsg := range tests {
+		t.Run(query, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkSingleStatement(query)
+			if errorMsg != "" {
+				assert.ErrorContains(t, err, errorMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetPool(t *testing.T) {
+	t.Parallel()
+
+	key := uuid.NewString()
+	first, err := getPool(key, "postgres://localhost/first")
+	require.NoError(t, err)
+
+	same, err := getPool(key, "postgres://localhost/first")
+	require.NoError(t, err)
+	assert.Same(t, first, same)
+
+	// an updated connection string replaces the pool
+	second, err := getPool(key, "postgres://localhost/second")
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.ErrorContains(t, first.Ping(), "sql: database is closed")
+
+	ClosePool(key)
+	assert.ErrorContains(t, second.Ping(), "sql: database is closed")
+}
+
+func TestToParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value any
+		want  any
+	}{
+		{name: "string", value: "a", want: "a"},
+		{name: "nil", value: nil, want: nil},
+		{name: "integral float", value: float64(42), want: int64(42)},
+		{name: "float", value: 4.2, want: 4.2},
+		{name: "json integer", value: json.Number("7"), want: int64(7)},
+		{name: "object", value: map[string]any{"a": "b"}, want: `{"a":"b"}`},
+		{name: "list", value: []any{"a", float64(1)}, want: pq.Array([]any{"a", int64(1)})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := toParam(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
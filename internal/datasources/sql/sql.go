@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sql implements a SQL data source.
+//
+// The SQL data source runs parameterized queries against a PostgreSQL
+// database, with arguments of the function as the parameters of the query.
+// Queries must be a single statement, and are run in read-only transactions,
+// with a limit on the number of rows returned and on the time they may take.
+//
+// An example of the output is:
+//
+//	{
+//	  "rows": [
+//	    {"team": "security", "owner": "alice"}
+//	  ],
+//	  "truncated": false
+//	}
+package sql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+)
+
+type sqlDataSource struct {
+	handlers map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef
+}
+
+// ensure that sqlDataSource implements the v1datasources.DataSource interface
+var _ v1datasources.DataSource = (*sqlDataSource)(nil)
+
+// GetFuncs implements the v1datasources.DataSource interface.
+func (s *sqlDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef {
+	return s.handlers
+}
+
+// Option is an option for building a SQL data source.
+type Option func(*options)
+
+type options struct {
+	dataSourceID string
+}
+
+// WithDataSourceID makes the data source share its connections with the
+// other instances of the data source with the given ID, until ClosePool is
+// called for it.
+//
+// Without it, connections are shared by the data sources with the same
+// connection string.
+func WithDataSourceID(dataSourceID string) Option {
+	return func(o *options) {
+		o.dataSourceID = dataSourceID
+	}
+}
+
+// NewSQLDataSource builds a new SQL data source. The connection string may
+// be empty when the data source is only validated, in which case the
+// functions fail when called.
+func NewSQLDataSource(ds *minderv1.SQLDataSource, opts ...Option) (v1datasources.DataSource, error) {
+	if ds == nil {
+		return nil, errors.New("sql data source is nil")
+	}
+
+	if ds.GetDef() == nil {
+		return nil, errors.New("sql data source definition is nil")
+	}
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var querier rowQuerier
+	if connection := ds.GetConnectionString(); connection != "" {
+		if _, err := pq.NewConnector(connection); err != nil {
+			return nil, fmt.Errorf("invalid sql connection string: %w", err)
+		}
+		poolKey := o.dataSourceID
+		if poolKey == "" {
+			poolKey = hashConnection(connection)
+		}
+		querier = &dbQuerier{poolKey: poolKey, connection: connection}
+	}
+
+	out := &sqlDataSource{
+		handlers: make(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef, len(ds.GetDef())),
+	}
+
+	for key, handlerCfg := range ds.GetDef() {
+		handler, err := newHandlerFromDef(handlerCfg, querier)
+		if err != nil {
+			return nil, err
+		}
+
+		out.handlers[v1datasources.DataSourceFuncKey(key)] = handler
+	}
+
+	return out, nil
+}
@@ -0,0 +1,146 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: alert_webhooks.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sqlc-dev/pqtype"
+)
+
+const createAlertWebhook = `-- name: CreateAlertWebhook :one
+
+INSERT INTO alert_webhooks (project_id, name, encrypted_url, encrypted_signing_secret)
+VALUES ($1, $2, $3, $4) RETURNING id, project_id, name, encrypted_url, encrypted_signing_secret, created_at, updated_at
+`
+
+type CreateAlertWebhookParams struct {
+	ProjectID              uuid.UUID             `json:"project_id"`
+	Name                   string                `json:"name"`
+	EncryptedUrl           json.RawMessage       `json:"encrypted_url"`
+	EncryptedSigningSecret pqtype.NullRawMessage `json:"encrypted_signing_secret"`
+}
+
+// CreateAlertWebhook creates a new alert webhook in a given project.
+func (q *Queries) CreateAlertWebhook(ctx context.Context, arg CreateAlertWebhookParams) (AlertWebhook, error) {
+	row := q.db.QueryRowContext(ctx, createAlertWebhook,
+		arg.ProjectID,
+		arg.Name,
+		arg.EncryptedUrl,
+		arg.EncryptedSigningSecret,
+	)
+	var i AlertWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.Name,
+		&i.EncryptedUrl,
+		&i.EncryptedSigningSecret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteAlertWebhook = `-- name: DeleteAlertWebhook :one
+DELETE FROM alert_webhooks
+WHERE project_id = $1 AND lower(name) = lower($2)
+RETURNING id, project_id, name, encrypted_url, encrypted_signing_secret, created_at, updated_at
+`
+
+type DeleteAlertWebhookParams struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Name      string    `json:"name"`
+}
+
+func (q *Queries) DeleteAlertWebhook(ctx context.Context, arg DeleteAlertWebhookParams) (AlertWebhook, error) {
+	row := q.db.QueryRowContext(ctx, deleteAlertWebhook, arg.ProjectID, arg.Name)
+	var i AlertWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.Name,
+		&i.EncryptedUrl,
+		&i.EncryptedSigningSecret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAlertWebhookByName = `-- name: GetAlertWebhookByName :one
+
+SELECT id, project_id, name, encrypted_url, encrypted_signing_secret, created_at, updated_at FROM alert_webhooks
+WHERE lower(name) = lower($1) AND project_id = ANY($2::uuid[])
+ORDER BY array_position($2::uuid[], project_id)
+LIMIT 1
+`
+
+type GetAlertWebhookByNameParams struct {
+	Name     string      `json:"name"`
+	Projects []uuid.UUID `json:"projects"`
+}
+
+// GetAlertWebhookByName retrieves an alert webhook by its name and
+// a project hierarchy. The webhook of the closest project wins, so
+// child projects can override the webhooks of their parents.
+//
+// Note that to get a webhook for a given project, one can simply
+// pass one project id in the project_id array.
+func (q *Queries) GetAlertWebhookByName(ctx context.Context, arg GetAlertWebhookByNameParams) (AlertWebhook, error) {
+	row := q.db.QueryRowContext(ctx, getAlertWebhookByName, arg.Name, pq.Array(arg.Projects))
+	var i AlertWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.Name,
+		&i.EncryptedUrl,
+		&i.EncryptedSigningSecret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listAlertWebhooksByProject = `-- name: ListAlertWebhooksByProject :many
+SELECT id, project_id, name, encrypted_url, encrypted_signing_secret, created_at, updated_at FROM alert_webhooks
+WHERE project_id = $1
+ORDER BY name
+`
+
+func (q *Queries) ListAlertWebhooksByProject(ctx context.Context, projectID uuid.UUID) ([]AlertWebhook, error) {
+	rows, err := q.db.QueryContext(ctx, listAlertWebhooksByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AlertWebhook{}
+	for rows.Next() {
+		var i AlertWebhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.Name,
+			&i.EncryptedUrl,
+			&i.EncryptedSigningSecret,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -8,6 +8,7 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -191,6 +192,21 @@ func (q *Queries) DeleteAllPropertiesForEntity(ctx context.Context, entityID uui
 	return err
 }
 
+const deleteEntitiesByIDs = `-- name: DeleteEntitiesByIDs :execrows
+DELETE FROM entity_instances
+WHERE id = ANY($1::uuid[])
+`
+
+// DeleteEntitiesByIDs removes the given entities, along with their properties,
+// evaluations and originated entities.
+func (q *Queries) DeleteEntitiesByIDs(ctx context.Context, entityIds []uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteEntitiesByIDs, pq.Array(entityIds))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteEntity = `-- name: DeleteEntity :exec
 DELETE FROM entity_instances
 WHERE id = $1 AND project_id = $2
@@ -590,6 +606,53 @@ func (q *Queries) ListEntitiesAfterID(ctx context.Context, arg ListEntitiesAfter
 	return items, nil
 }
 
+const listStaleRunEntities = `-- name: ListStaleRunEntities :many
+SELECT ei.id
+FROM entity_instances ei
+WHERE ei.entity_type IN ('pipeline_run', 'task_run')
+  AND (ei.created_at < $1
+    OR (ei.created_at < $2
+      AND EXISTS (
+        SELECT 1 FROM properties p
+        WHERE p.entity_id = ei.id
+          AND p.key = 'status'
+          AND p.value->>'value' = 'completed')))
+ORDER BY ei.created_at
+LIMIT $3::integer
+`
+
+type ListStaleRunEntitiesParams struct {
+	StaleThreshold     time.Time `json:"stale_threshold"`
+	CompletedThreshold time.Time `json:"completed_threshold"`
+	Size               int32     `json:"size"`
+}
+
+// ListStaleRunEntities lists the pipeline and task runs which are no longer worth
+// keeping: completed runs created before the completed threshold, and runs of any
+// status created before the stale threshold, whose final events were likely missed.
+func (q *Queries) ListStaleRunEntities(ctx context.Context, arg ListStaleRunEntitiesParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listStaleRunEntities, arg.StaleThreshold, arg.CompletedThreshold, arg.Size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertProperty = `-- name: UpsertProperty :one
 INSERT INTO properties (
     entity_id,
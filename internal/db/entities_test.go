@@ -323,6 +323,76 @@ func propertyByKey(t *testing.T, props []Property, key string) any {
 	return nil
 }
 
+func Test_StaleRunEntities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	org := createRandomOrganization(t)
+	proj := createRandomProject(t, org.ID)
+	prov := createRandomProvider(t, proj.ID)
+
+	createRun := func(entType Entities, name string, status string) EntityInstance {
+		t.Helper()
+		ent, err := testQueries.CreateEntity(ctx, CreateEntityParams{
+			EntityType: entType,
+			Name:       name,
+			ProjectID:  proj.ID,
+			ProviderID: prov.ID,
+		})
+		require.NoError(t, err)
+		_, err = testQueries.UpsertPropertyValueV1(ctx, UpsertPropertyValueV1Params{
+			EntityID: ent.ID,
+			Key:      "status",
+			Value:    status,
+		})
+		require.NoError(t, err)
+		return ent
+	}
+
+	completedRun := createRun(EntitiesPipelineRun, "testorg/testrepo/1", "completed")
+	completedJob := createRun(EntitiesTaskRun, "testorg/testrepo/1/1", "completed")
+	runningRun := createRun(EntitiesPipelineRun, "testorg/testrepo/2", "in_progress")
+	completedRepo := createRun(EntitiesRepository, "testorg/testrepo", "completed")
+
+	now := time.Now().UTC()
+
+	// Only completed runs are past the completed threshold.
+	ids, err := testQueries.ListStaleRunEntities(ctx, ListStaleRunEntitiesParams{
+		CompletedThreshold: now.Add(time.Hour),
+		StaleThreshold:     now.Add(-time.Hour),
+		Size:               1000,
+	})
+	require.NoError(t, err)
+	require.Contains(t, ids, completedRun.ID)
+	require.Contains(t, ids, completedJob.ID)
+	require.NotContains(t, ids, runningRun.ID)
+	require.NotContains(t, ids, completedRepo.ID)
+
+	// Past the stale threshold, runs are listed whatever their status.
+	ids, err = testQueries.ListStaleRunEntities(ctx, ListStaleRunEntitiesParams{
+		CompletedThreshold: now.Add(-time.Hour),
+		StaleThreshold:     now.Add(time.Hour),
+		Size:               1000,
+	})
+	require.NoError(t, err)
+	require.Contains(t, ids, completedRun.ID)
+	require.Contains(t, ids, runningRun.ID)
+	require.NotContains(t, ids, completedRepo.ID)
+
+	deleted, err := testQueries.DeleteEntitiesByIDs(ctx, []uuid.UUID{completedRun.ID, runningRun.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	_, err = testQueries.GetEntityByID(ctx, completedRun.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	props, err := testQueries.GetAllPropertiesForEntity(ctx, completedRun.ID)
+	require.NoError(t, err)
+	require.Empty(t, props)
+
+	_, err = testQueries.GetEntityByID(ctx, completedJob.ID)
+	require.NoError(t, err)
+}
+
 func Test_PropertyHelpers(t *testing.T) {
 	t.Parallel()
 
@@ -203,6 +203,7 @@ const (
 	EvalStatusTypesError   EvalStatusTypes = "error"
 	EvalStatusTypesSkipped EvalStatusTypes = "skipped"
 	EvalStatusTypesPending EvalStatusTypes = "pending"
+	EvalStatusTypesWaived  EvalStatusTypes = "waived"
 )
 
 func (e *EvalStatusTypes) Scan(src interface{}) error {
@@ -240,6 +241,49 @@ func (ns NullEvalStatusTypes) Value() (driver.Value, error) {
 	return string(ns.EvalStatusTypes), nil
 }
 
+type ProjectSyncStatus string
+
+const (
+	ProjectSyncStatusPending ProjectSyncStatus = "pending"
+	ProjectSyncStatusSuccess ProjectSyncStatus = "success"
+	ProjectSyncStatusFailure ProjectSyncStatus = "failure"
+)
+
+func (e *ProjectSyncStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectSyncStatus(s)
+	case string:
+		*e = ProjectSyncStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectSyncStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProjectSyncStatus struct {
+	ProjectSyncStatus ProjectSyncStatus `json:"project_sync_status"`
+	Valid             bool              `json:"valid"` // Valid is true if ProjectSyncStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectSyncStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectSyncStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectSyncStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectSyncStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectSyncStatus), nil
+}
+
 type ProviderClass string
 
 const (
@@ -248,6 +292,8 @@ const (
 	ProviderClassGhcr      ProviderClass = "ghcr"
 	ProviderClassDockerhub ProviderClass = "dockerhub"
 	ProviderClassGitlab    ProviderClass = "gitlab"
+	ProviderClassBitbucket ProviderClass = "bitbucket"
+	ProviderClassGitea     ProviderClass = "gitea"
 )
 
 func (e *ProviderClass) Scan(src interface{}) error {
@@ -476,6 +522,16 @@ type AlertEvent struct {
 	CreatedAt    time.Time        `json:"created_at"`
 }
 
+type AlertWebhook struct {
+	ID                     uuid.UUID             `json:"id"`
+	ProjectID              uuid.UUID             `json:"project_id"`
+	Name                   string                `json:"name"`
+	EncryptedUrl           json.RawMessage       `json:"encrypted_url"`
+	EncryptedSigningSecret pqtype.NullRawMessage `json:"encrypted_signing_secret"`
+	CreatedAt              time.Time             `json:"created_at"`
+	UpdatedAt              time.Time             `json:"updated_at"`
+}
+
 type Bundle struct {
 	ID        uuid.UUID `json:"id"`
 	Namespace string    `json:"namespace"`
@@ -583,19 +639,38 @@ type LatestEvaluationStatus struct {
 	ProfileID           uuid.UUID `json:"profile_id"`
 }
 
+type OsvAffectedPackage struct {
+	VulnerabilityID string `json:"vulnerability_id"`
+	Ecosystem       string `json:"ecosystem"`
+	Name            string `json:"name"`
+}
+
+type OsvSyncState struct {
+	Ecosystem          string    `json:"ecosystem"`
+	VulnerabilityCount int32     `json:"vulnerability_count"`
+	SyncedAt           time.Time `json:"synced_at"`
+}
+
+type OsvVulnerability struct {
+	ID       string          `json:"id"`
+	Modified time.Time       `json:"modified"`
+	Data     json.RawMessage `json:"data"`
+}
+
 type Profile struct {
-	ID             uuid.UUID      `json:"id"`
-	Name           string         `json:"name"`
-	Provider       sql.NullString `json:"provider"`
-	ProjectID      uuid.UUID      `json:"project_id"`
-	Remediate      NullActionType `json:"remediate"`
-	Alert          NullActionType `json:"alert"`
-	CreatedAt      time.Time      `json:"created_at"`
-	UpdatedAt      time.Time      `json:"updated_at"`
-	ProviderID     uuid.NullUUID  `json:"provider_id"`
-	SubscriptionID uuid.NullUUID  `json:"subscription_id"`
-	DisplayName    string         `json:"display_name"`
-	Labels         []string       `json:"labels"`
+	ID             uuid.UUID             `json:"id"`
+	Name           string                `json:"name"`
+	Provider       sql.NullString        `json:"provider"`
+	ProjectID      uuid.UUID             `json:"project_id"`
+	Remediate      NullActionType        `json:"remediate"`
+	Alert          NullActionType        `json:"alert"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+	ProviderID     uuid.NullUUID         `json:"provider_id"`
+	SubscriptionID uuid.NullUUID         `json:"subscription_id"`
+	DisplayName    string                `json:"display_name"`
+	Labels         []string              `json:"labels"`
+	Schedule       pqtype.NullRawMessage `json:"schedule"`
 }
 
 type ProfileSelector struct {
@@ -634,6 +709,23 @@ type Project struct {
 	UpdatedAt      time.Time       `json:"updated_at"`
 }
 
+type ProjectSyncSource struct {
+	ProjectID       uuid.UUID         `json:"project_id"`
+	ProviderID      uuid.UUID         `json:"provider_id"`
+	Repository      string            `json:"repository"`
+	Branch          string            `json:"branch"`
+	Path            string            `json:"path"`
+	IntervalSeconds int32             `json:"interval_seconds"`
+	Status          ProjectSyncStatus `json:"status"`
+	Message         string            `json:"message"`
+	LastCommit      string            `json:"last_commit"`
+	Drift           json.RawMessage   `json:"drift"`
+	LastSyncedAt    sql.NullTime      `json:"last_synced_at"`
+	NextSyncAt      time.Time         `json:"next_sync_at"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+}
+
 type Property struct {
 	ID        uuid.UUID       `json:"id"`
 	EntityID  uuid.UUID       `json:"entity_id"`
@@ -689,6 +781,20 @@ type RemediationEvent struct {
 	CreatedAt    time.Time              `json:"created_at"`
 }
 
+type RuleExemption struct {
+	ID               uuid.UUID `json:"id"`
+	ProjectID        uuid.UUID `json:"project_id"`
+	ProfileID        uuid.UUID `json:"profile_id"`
+	RuleName         string    `json:"rule_name"`
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	Justification    string    `json:"justification"`
+	Approver         string    `json:"approver"`
+	CreatedBy        string    `json:"created_by"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
 type RuleInstance struct {
 	ID         uuid.UUID       `json:"id"`
 	ProfileID  uuid.UUID       `json:"profile_id"`
@@ -742,10 +848,11 @@ type SessionStore struct {
 }
 
 type Subscription struct {
-	ID             uuid.UUID `json:"id"`
-	ProjectID      uuid.UUID `json:"project_id"`
-	BundleID       uuid.UUID `json:"bundle_id"`
-	CurrentVersion string    `json:"current_version"`
+	ID              uuid.UUID      `json:"id"`
+	ProjectID       uuid.UUID      `json:"project_id"`
+	BundleID        uuid.UUID      `json:"bundle_id"`
+	CurrentVersion  string         `json:"current_version"`
+	PreviousVersion sql.NullString `json:"previous_version"`
 }
 
 type User struct {
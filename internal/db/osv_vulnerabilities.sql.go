@@ -0,0 +1,155 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: osv_vulnerabilities.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const deleteOSVAffectedPackages = `-- name: DeleteOSVAffectedPackages :exec
+DELETE FROM osv_affected_packages WHERE vulnerability_id = $1
+`
+
+func (q *Queries) DeleteOSVAffectedPackages(ctx context.Context, vulnerabilityID string) error {
+	_, err := q.db.ExecContext(ctx, deleteOSVAffectedPackages, vulnerabilityID)
+	return err
+}
+
+const insertOSVAffectedPackage = `-- name: InsertOSVAffectedPackage :exec
+INSERT INTO osv_affected_packages (vulnerability_id, ecosystem, name)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type InsertOSVAffectedPackageParams struct {
+	VulnerabilityID string `json:"vulnerability_id"`
+	Ecosystem       string `json:"ecosystem"`
+	Name            string `json:"name"`
+}
+
+func (q *Queries) InsertOSVAffectedPackage(ctx context.Context, arg InsertOSVAffectedPackageParams) error {
+	_, err := q.db.ExecContext(ctx, insertOSVAffectedPackage, arg.VulnerabilityID, arg.Ecosystem, arg.Name)
+	return err
+}
+
+const listOSVSyncState = `-- name: ListOSVSyncState :many
+SELECT ecosystem, vulnerability_count, synced_at FROM osv_sync_state ORDER BY ecosystem
+`
+
+func (q *Queries) ListOSVSyncState(ctx context.Context) ([]OsvSyncState, error) {
+	rows, err := q.db.QueryContext(ctx, listOSVSyncState)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OsvSyncState{}
+	for rows.Next() {
+		var i OsvSyncState
+		if err := rows.Scan(&i.Ecosystem, &i.VulnerabilityCount, &i.SyncedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOSVVulnerabilitiesByPackages = `-- name: ListOSVVulnerabilitiesByPackages :many
+
+SELECT a.name, v.data
+FROM osv_affected_packages a
+JOIN osv_vulnerabilities v ON v.id = a.vulnerability_id
+WHERE a.ecosystem = $1 AND a.name = ANY($2::text[])
+ORDER BY a.name, v.id
+`
+
+type ListOSVVulnerabilitiesByPackagesParams struct {
+	Ecosystem string   `json:"ecosystem"`
+	Names     []string `json:"names"`
+}
+
+type ListOSVVulnerabilitiesByPackagesRow struct {
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data"`
+}
+
+// ListOSVVulnerabilitiesByPackages returns the vulnerabilities which affect
+// any version of the given packages of an ecosystem.
+func (q *Queries) ListOSVVulnerabilitiesByPackages(ctx context.Context, arg ListOSVVulnerabilitiesByPackagesParams) ([]ListOSVVulnerabilitiesByPackagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOSVVulnerabilitiesByPackages, arg.Ecosystem, pq.Array(arg.Names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOSVVulnerabilitiesByPackagesRow{}
+	for rows.Next() {
+		var i ListOSVVulnerabilitiesByPackagesRow
+		if err := rows.Scan(&i.Name, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertOSVSyncState = `-- name: UpsertOSVSyncState :exec
+INSERT INTO osv_sync_state (ecosystem, vulnerability_count, synced_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (ecosystem) DO UPDATE SET
+    vulnerability_count = EXCLUDED.vulnerability_count,
+    synced_at = EXCLUDED.synced_at
+`
+
+type UpsertOSVSyncStateParams struct {
+	Ecosystem          string `json:"ecosystem"`
+	VulnerabilityCount int32  `json:"vulnerability_count"`
+}
+
+func (q *Queries) UpsertOSVSyncState(ctx context.Context, arg UpsertOSVSyncStateParams) error {
+	_, err := q.db.ExecContext(ctx, upsertOSVSyncState, arg.Ecosystem, arg.VulnerabilityCount)
+	return err
+}
+
+const upsertOSVVulnerability = `-- name: UpsertOSVVulnerability :execrows
+
+INSERT INTO osv_vulnerabilities (id, modified, data)
+VALUES ($1, $2, $3::jsonb)
+ON CONFLICT (id) DO UPDATE SET
+    modified = EXCLUDED.modified,
+    data = EXCLUDED.data
+WHERE osv_vulnerabilities.modified < EXCLUDED.modified
+`
+
+type UpsertOSVVulnerabilityParams struct {
+	ID       string          `json:"id"`
+	Modified time.Time       `json:"modified"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// UpsertOSVVulnerability stores a vulnerability record, unless the stored
+// copy is already up to date. No rows are affected in that case.
+func (q *Queries) UpsertOSVVulnerability(ctx context.Context, arg UpsertOSVVulnerabilityParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertOSVVulnerability, arg.ID, arg.Modified, arg.Data)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -117,6 +117,57 @@ func (q *Queries) GetProfileStatusByProject(ctx context.Context, projectID uuid.
 	return items, nil
 }
 
+const listOldestRuleEvaluationsByEntityAndProfile = `-- name: ListOldestRuleEvaluationsByEntityAndProfile :many
+
+SELECT ere.entity_instance_id, ri.profile_id, p.schedule AS profile_schedule,
+    MIN(es.evaluation_time)::timestamp AS oldest_last_updated
+FROM evaluation_rule_entities AS ere
+    INNER JOIN rule_instances AS ri ON ere.rule_id = ri.id
+    INNER JOIN profiles AS p ON ri.profile_id = p.id
+    INNER JOIN latest_evaluation_statuses AS les ON ere.id = les.rule_entity_id
+    INNER JOIN evaluation_statuses AS es ON les.evaluation_history_id = es.id
+WHERE ere.entity_instance_id = ANY ($1::uuid[])
+GROUP BY ere.entity_instance_id, ri.profile_id, p.schedule
+`
+
+type ListOldestRuleEvaluationsByEntityAndProfileRow struct {
+	EntityInstanceID  uuid.UUID             `json:"entity_instance_id"`
+	ProfileID         uuid.UUID             `json:"profile_id"`
+	ProfileSchedule   pqtype.NullRawMessage `json:"profile_schedule"`
+	OldestLastUpdated time.Time             `json:"oldest_last_updated"`
+}
+
+// ListOldestRuleEvaluationsByEntityAndProfile returns the oldest evaluation time
+// for each pair of entity and profile, together with the schedule of the profile.
+// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
+func (q *Queries) ListOldestRuleEvaluationsByEntityAndProfile(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityAndProfileRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOldestRuleEvaluationsByEntityAndProfile, pq.Array(entityIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOldestRuleEvaluationsByEntityAndProfileRow{}
+	for rows.Next() {
+		var i ListOldestRuleEvaluationsByEntityAndProfileRow
+		if err := rows.Scan(
+			&i.EntityInstanceID,
+			&i.ProfileID,
+			&i.ProfileSchedule,
+			&i.OldestLastUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOldestRuleEvaluationsByEntityID = `-- name: ListOldestRuleEvaluationsByEntityID :many
 
 SELECT ere.entity_instance_id, MIN(es.evaluation_time)::timestamp AS oldest_last_updated
@@ -134,6 +185,7 @@ type ListOldestRuleEvaluationsByEntityIDRow struct {
 
 // ListOldestRuleEvaluationsByEntityID returns the oldest evaluation time for each entity.
 // cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
+// DEPRECATED: Use ListOldestRuleEvaluationsByEntityAndProfile instead
 func (q *Queries) ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityIDRow, error) {
 	rows, err := q.db.QueryContext(ctx, listOldestRuleEvaluationsByEntityID, pq.Array(entityIds))
 	if err != nil {
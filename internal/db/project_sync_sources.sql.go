@@ -0,0 +1,226 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: project_sync_sources.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+const claimDueProjectSyncSources = `-- name: ClaimDueProjectSyncSources :many
+
+UPDATE project_sync_sources
+SET next_sync_at = NOW() + make_interval(secs => interval_seconds)
+WHERE project_id IN (
+    SELECT due.project_id FROM project_sync_sources due
+    WHERE due.next_sync_at <= NOW()
+    ORDER BY due.next_sync_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING project_id, provider_id, repository, branch, path, interval_seconds, status, message, last_commit, drift, last_synced_at, next_sync_at, created_at, updated_at
+`
+
+// ClaimDueProjectSyncSources returns the sync sources which are due, and
+// moves their next sync forward by their interval. Rows being claimed by
+// another server are skipped, so that every sync runs once.
+func (q *Queries) ClaimDueProjectSyncSources(ctx context.Context, maxSources int32) ([]ProjectSyncSource, error) {
+	rows, err := q.db.QueryContext(ctx, claimDueProjectSyncSources, maxSources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProjectSyncSource{}
+	for rows.Next() {
+		var i ProjectSyncSource
+		if err := rows.Scan(
+			&i.ProjectID,
+			&i.ProviderID,
+			&i.Repository,
+			&i.Branch,
+			&i.Path,
+			&i.IntervalSeconds,
+			&i.Status,
+			&i.Message,
+			&i.LastCommit,
+			&i.Drift,
+			&i.LastSyncedAt,
+			&i.NextSyncAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteProjectSyncSource = `-- name: DeleteProjectSyncSource :one
+DELETE FROM project_sync_sources WHERE project_id = $1 RETURNING project_id, provider_id, repository, branch, path, interval_seconds, status, message, last_commit, drift, last_synced_at, next_sync_at, created_at, updated_at
+`
+
+func (q *Queries) DeleteProjectSyncSource(ctx context.Context, projectID uuid.UUID) (ProjectSyncSource, error) {
+	row := q.db.QueryRowContext(ctx, deleteProjectSyncSource, projectID)
+	var i ProjectSyncSource
+	err := row.Scan(
+		&i.ProjectID,
+		&i.ProviderID,
+		&i.Repository,
+		&i.Branch,
+		&i.Path,
+		&i.IntervalSeconds,
+		&i.Status,
+		&i.Message,
+		&i.LastCommit,
+		&i.Drift,
+		&i.LastSyncedAt,
+		&i.NextSyncAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getProjectSyncSource = `-- name: GetProjectSyncSource :one
+
+SELECT project_sync_sources.project_id, project_sync_sources.provider_id, project_sync_sources.repository, project_sync_sources.branch, project_sync_sources.path, project_sync_sources.interval_seconds, project_sync_sources.status, project_sync_sources.message, project_sync_sources.last_commit, project_sync_sources.drift, project_sync_sources.last_synced_at, project_sync_sources.next_sync_at, project_sync_sources.created_at, project_sync_sources.updated_at, p.name AS provider_name
+FROM project_sync_sources
+JOIN providers p ON p.id = project_sync_sources.provider_id
+WHERE project_sync_sources.project_id = $1
+`
+
+type GetProjectSyncSourceRow struct {
+	ProjectSyncSource ProjectSyncSource `json:"project_sync_source"`
+	ProviderName      string            `json:"provider_name"`
+}
+
+// GetProjectSyncSource retrieves the sync source of a project, together
+// with the name of its provider.
+func (q *Queries) GetProjectSyncSource(ctx context.Context, projectID uuid.UUID) (GetProjectSyncSourceRow, error) {
+	row := q.db.QueryRowContext(ctx, getProjectSyncSource, projectID)
+	var i GetProjectSyncSourceRow
+	err := row.Scan(
+		&i.ProjectSyncSource.ProjectID,
+		&i.ProjectSyncSource.ProviderID,
+		&i.ProjectSyncSource.Repository,
+		&i.ProjectSyncSource.Branch,
+		&i.ProjectSyncSource.Path,
+		&i.ProjectSyncSource.IntervalSeconds,
+		&i.ProjectSyncSource.Status,
+		&i.ProjectSyncSource.Message,
+		&i.ProjectSyncSource.LastCommit,
+		&i.ProjectSyncSource.Drift,
+		&i.ProjectSyncSource.LastSyncedAt,
+		&i.ProjectSyncSource.NextSyncAt,
+		&i.ProjectSyncSource.CreatedAt,
+		&i.ProjectSyncSource.UpdatedAt,
+		&i.ProviderName,
+	)
+	return i, err
+}
+
+const updateProjectSyncStatus = `-- name: UpdateProjectSyncStatus :exec
+UPDATE project_sync_sources
+SET status = $2,
+    message = $3,
+    last_commit = $4,
+    drift = $5,
+    last_synced_at = NOW(),
+    updated_at = NOW()
+WHERE project_id = $1
+`
+
+type UpdateProjectSyncStatusParams struct {
+	ProjectID  uuid.UUID         `json:"project_id"`
+	Status     ProjectSyncStatus `json:"status"`
+	Message    string            `json:"message"`
+	LastCommit string            `json:"last_commit"`
+	Drift      json.RawMessage   `json:"drift"`
+}
+
+func (q *Queries) UpdateProjectSyncStatus(ctx context.Context, arg UpdateProjectSyncStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateProjectSyncStatus,
+		arg.ProjectID,
+		arg.Status,
+		arg.Message,
+		arg.LastCommit,
+		arg.Drift,
+	)
+	return err
+}
+
+const upsertProjectSyncSource = `-- name: UpsertProjectSyncSource :one
+
+INSERT INTO project_sync_sources (
+    project_id,
+    provider_id,
+    repository,
+    branch,
+    path,
+    interval_seconds
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (project_id) DO UPDATE SET
+    provider_id = EXCLUDED.provider_id,
+    repository = EXCLUDED.repository,
+    branch = EXCLUDED.branch,
+    path = EXCLUDED.path,
+    interval_seconds = EXCLUDED.interval_seconds,
+    status = 'pending',
+    message = '',
+    next_sync_at = NOW(),
+    updated_at = NOW()
+RETURNING project_id, provider_id, repository, branch, path, interval_seconds, status, message, last_commit, drift, last_synced_at, next_sync_at, created_at, updated_at
+`
+
+type UpsertProjectSyncSourceParams struct {
+	ProjectID       uuid.UUID `json:"project_id"`
+	ProviderID      uuid.UUID `json:"provider_id"`
+	Repository      string    `json:"repository"`
+	Branch          string    `json:"branch"`
+	Path            string    `json:"path"`
+	IntervalSeconds int32     `json:"interval_seconds"`
+}
+
+// UpsertProjectSyncSource sets the repository a project is synced from.
+// Changing the configuration resets the status, and schedules a sync
+// right away.
+func (q *Queries) UpsertProjectSyncSource(ctx context.Context, arg UpsertProjectSyncSourceParams) (ProjectSyncSource, error) {
+	row := q.db.QueryRowContext(ctx, upsertProjectSyncSource,
+		arg.ProjectID,
+		arg.ProviderID,
+		arg.Repository,
+		arg.Branch,
+		arg.Path,
+		arg.IntervalSeconds,
+	)
+	var i ProjectSyncSource
+	err := row.Scan(
+		&i.ProjectID,
+		&i.ProviderID,
+		&i.Repository,
+		&i.Branch,
+		&i.Path,
+		&i.IntervalSeconds,
+		&i.Status,
+		&i.Message,
+		&i.LastCommit,
+		&i.Drift,
+		&i.LastSyncedAt,
+		&i.NextSyncAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -20,6 +20,10 @@ type Querier interface {
 	//
 	AddRuleTypeDataSourceReference(ctx context.Context, arg AddRuleTypeDataSourceReferenceParams) (RuleTypeDataSource, error)
 	BulkGetProfilesByID(ctx context.Context, profileIds []uuid.UUID) ([]BulkGetProfilesByIDRow, error)
+	// ClaimDueProjectSyncSources returns the sync sources which are due, and
+	// moves their next sync forward by their interval. Rows being claimed by
+	// another server are skipped, so that every sync runs once.
+	ClaimDueProjectSyncSources(ctx context.Context, maxSources int32) ([]ProjectSyncSource, error)
 	// CountEntitiesByType counts all entities of a given type (across all projects/providers).
 	CountEntitiesByType(ctx context.Context, entityType Entities) (int64, error)
 	// CountEntitiesByTypeAndProject counts entities of a given type for a specific project.
@@ -28,6 +32,8 @@ type Querier interface {
 	CountProfilesByName(ctx context.Context, name string) (int64, error)
 	CountProfilesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	// CreateAlertWebhook creates a new alert webhook in a given project.
+	CreateAlertWebhook(ctx context.Context, arg CreateAlertWebhookParams) (AlertWebhook, error)
 	// CreateDataSource creates a new datasource in a given project.
 	CreateDataSource(ctx context.Context, arg CreateDataSourceParams) (DataSource, error)
 	CreateEntitlements(ctx context.Context, arg CreateEntitlementsParams) error
@@ -48,18 +54,25 @@ type Querier interface {
 	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
 	CreateProjectWithID(ctx context.Context, arg CreateProjectWithIDParams) (Project, error)
 	CreateProvider(ctx context.Context, arg CreateProviderParams) (Provider, error)
+	// CreateRuleExemption creates a new exemption for a rule of a profile
+	// and an entity.
+	CreateRuleExemption(ctx context.Context, arg CreateRuleExemptionParams) (RuleExemption, error)
 	CreateRuleType(ctx context.Context, arg CreateRuleTypeParams) (RuleType, error)
 	CreateSelector(ctx context.Context, arg CreateSelectorParams) (ProfileSelector, error)
 	CreateSessionState(ctx context.Context, arg CreateSessionStateParams) (SessionStore, error)
 	// Subscriptions --
 	CreateSubscription(ctx context.Context, arg CreateSubscriptionParams) (Subscription, error)
 	CreateUser(ctx context.Context, identitySubject string) (User, error)
+	DeleteAlertWebhook(ctx context.Context, arg DeleteAlertWebhookParams) (AlertWebhook, error)
 	DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error
 	DeleteDataSource(ctx context.Context, arg DeleteDataSourceParams) (DataSource, error)
 	DeleteDataSourceFunction(ctx context.Context, arg DeleteDataSourceFunctionParams) (DataSourcesFunction, error)
 	// DeleteDataSourceFunctions deletes all functions associated with a given datasource
 	// in a specific project.
 	DeleteDataSourceFunctions(ctx context.Context, arg DeleteDataSourceFunctionsParams) ([]DataSourcesFunction, error)
+	// DeleteEntitiesByIDs removes the given entities, along with their properties,
+	// evaluations and originated entities.
+	DeleteEntitiesByIDs(ctx context.Context, entityIds []uuid.UUID) (int64, error)
 	// DeleteEntity removes an entity from the entity_instances table for a project.
 	DeleteEntity(ctx context.Context, arg DeleteEntityParams) error
 	DeleteEvaluationHistoryByIDs(ctx context.Context, evaluationids []uuid.UUID) (int64, error)
@@ -71,11 +84,14 @@ type Querier interface {
 	// it or the sponsor has decided to revoke it.
 	DeleteInvitation(ctx context.Context, code string) (UserInvite, error)
 	DeleteNonUpdatedRules(ctx context.Context, arg DeleteNonUpdatedRulesParams) error
+	DeleteOSVAffectedPackages(ctx context.Context, vulnerabilityID string) error
 	DeleteProfile(ctx context.Context, arg DeleteProfileParams) error
 	DeleteProfileForEntity(ctx context.Context, arg DeleteProfileForEntityParams) error
 	DeleteProject(ctx context.Context, id uuid.UUID) ([]DeleteProjectRow, error)
+	DeleteProjectSyncSource(ctx context.Context, projectID uuid.UUID) (ProjectSyncSource, error)
 	DeleteProperty(ctx context.Context, arg DeletePropertyParams) error
 	DeleteProvider(ctx context.Context, arg DeleteProviderParams) error
+	DeleteRuleExemption(ctx context.Context, arg DeleteRuleExemptionParams) (RuleExemption, error)
 	DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg DeleteRuleInstanceOfProfileInProjectParams) error
 	DeleteRuleType(ctx context.Context, id uuid.UUID) error
 	DeleteRuleTypeDataSource(ctx context.Context, arg DeleteRuleTypeDataSourceParams) error
@@ -95,6 +111,13 @@ type Querier interface {
 	GetAccessTokenByProjectID(ctx context.Context, arg GetAccessTokenByProjectIDParams) (ProviderAccessToken, error)
 	GetAccessTokenByProvider(ctx context.Context, provider string) ([]ProviderAccessToken, error)
 	GetAccessTokenSinceDate(ctx context.Context, arg GetAccessTokenSinceDateParams) (ProviderAccessToken, error)
+	// GetAlertWebhookByName retrieves an alert webhook by its name and
+	// a project hierarchy. The webhook of the closest project wins, so
+	// child projects can override the webhooks of their parents.
+	//
+	// Note that to get a webhook for a given project, one can simply
+	// pass one project id in the project_id array.
+	GetAlertWebhookByName(ctx context.Context, arg GetAlertWebhookByNameParams) (AlertWebhook, error)
 	GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]Property, error)
 	GetBundle(ctx context.Context, arg GetBundleParams) (Bundle, error)
 	GetChildrenProjects(ctx context.Context, id uuid.UUID) ([]GetChildrenProjectsRow, error)
@@ -163,6 +186,9 @@ type Querier interface {
 	GetProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
 	GetProjectByName(ctx context.Context, name string) (Project, error)
 	GetProjectIDBySessionState(ctx context.Context, sessionState string) (GetProjectIDBySessionStateRow, error)
+	// GetProjectSyncSource retrieves the sync source of a project, together
+	// with the name of its provider.
+	GetProjectSyncSource(ctx context.Context, projectID uuid.UUID) (GetProjectSyncSourceRow, error)
 	GetProperty(ctx context.Context, arg GetPropertyParams) (Property, error)
 	GetProviderByID(ctx context.Context, id uuid.UUID) (Provider, error)
 	GetProviderByIDAndProject(ctx context.Context, arg GetProviderByIDAndProjectParams) (Provider, error)
@@ -172,6 +198,9 @@ type Querier interface {
 	// provider that matches the name.
 	GetProviderByName(ctx context.Context, arg GetProviderByNameParams) (Provider, error)
 	GetRootProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
+	// GetRuleExemptionByID retrieves an exemption of a project, together with
+	// the names of the profile and entity it applies to.
+	GetRuleExemptionByID(ctx context.Context, arg GetRuleExemptionByIDParams) (GetRuleExemptionByIDRow, error)
 	GetRuleInstancesEntityInProjects(ctx context.Context, arg GetRuleInstancesEntityInProjectsParams) ([]RuleInstance, error)
 	GetRuleInstancesForProfile(ctx context.Context, profileID uuid.UUID) ([]RuleInstance, error)
 	GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType, error)
@@ -195,7 +224,13 @@ type Querier interface {
 	InsertAlertEvent(ctx context.Context, arg InsertAlertEventParams) error
 	InsertEvaluationRuleEntity(ctx context.Context, arg InsertEvaluationRuleEntityParams) (uuid.UUID, error)
 	InsertEvaluationStatus(ctx context.Context, arg InsertEvaluationStatusParams) (uuid.UUID, error)
+	InsertOSVAffectedPackage(ctx context.Context, arg InsertOSVAffectedPackageParams) error
 	InsertRemediationEvent(ctx context.Context, arg InsertRemediationEventParams) error
+	// ListActiveRuleExemptionsByEntity lists the exemptions which currently
+	// apply to an entity. It is used by the executor to waive the evaluation
+	// of rules.
+	ListActiveRuleExemptionsByEntity(ctx context.Context, entityInstanceID uuid.UUID) ([]RuleExemption, error)
+	ListAlertWebhooksByProject(ctx context.Context, projectID uuid.UUID) ([]AlertWebhook, error)
 	ListAllRootProjects(ctx context.Context) ([]Project, error)
 	// ListDataSourceFunctions retrieves all functions for a datasource.
 	ListDataSourceFunctions(ctx context.Context, arg ListDataSourceFunctionsParams) ([]DataSourcesFunction, error)
@@ -215,8 +250,17 @@ type Querier interface {
 	// *does not* report the invitation code, which is a secret intended for
 	// the invitee.
 	ListInvitationsForProject(ctx context.Context, project uuid.UUID) ([]ListInvitationsForProjectRow, error)
+	ListOSVSyncState(ctx context.Context) ([]OsvSyncState, error)
+	// ListOSVVulnerabilitiesByPackages returns the vulnerabilities which affect
+	// any version of the given packages of an ecosystem.
+	ListOSVVulnerabilitiesByPackages(ctx context.Context, arg ListOSVVulnerabilitiesByPackagesParams) ([]ListOSVVulnerabilitiesByPackagesRow, error)
+	// ListOldestRuleEvaluationsByEntityAndProfile returns the oldest evaluation time
+	// for each pair of entity and profile, together with the schedule of the profile.
+	// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
+	ListOldestRuleEvaluationsByEntityAndProfile(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityAndProfileRow, error)
 	// ListOldestRuleEvaluationsByEntityID returns the oldest evaluation time for each entity.
 	// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
+	// DEPRECATED: Use ListOldestRuleEvaluationsByEntityAndProfile instead
 	ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityIDRow, error)
 	// ListOldestRuleEvaluationsByRepositoryId has casts in select statement as sqlc generates incorrect types.
 	// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
@@ -231,11 +275,19 @@ type Querier interface {
 	// with pagination taken into account. In this case, the cursor is the creation date.
 	ListProvidersByProjectIDPaginated(ctx context.Context, arg ListProvidersByProjectIDPaginatedParams) ([]Provider, error)
 	ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error)
+	// ListRuleExemptionsByProject lists the exemptions of a project, optionally
+	// filtered by profile. Expired exemptions are only included if requested.
+	ListRuleExemptionsByProject(ctx context.Context, arg ListRuleExemptionsByProjectParams) ([]ListRuleExemptionsByProjectRow, error)
 	ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]RuleType, error)
 	// ListRuleTypesReferencesByDataSource retrieves all rule types
 	// referencing a given data source in a given project.
 	//
 	ListRuleTypesReferencesByDataSource(ctx context.Context, dataSourcesID uuid.UUID) ([]RuleTypeDataSource, error)
+	// ListStaleRunEntities lists the pipeline and task runs which are no longer worth
+	// keeping: completed runs created before the completed threshold, and runs of any
+	// status created before the stale threshold, whose final events were likely missed.
+	ListStaleRunEntities(ctx context.Context, arg ListStaleRunEntitiesParams) ([]uuid.UUID, error)
+	ListSubscriptionsByProject(ctx context.Context, projectID uuid.UUID) ([]ListSubscriptionsByProjectRow, error)
 	// When doing a key/algorithm rotation, identify the secrets which need to be
 	// rotated. The criteria for rotation are:
 	// 1) The encrypted_access_token is NULL (this should be removed when we make
@@ -259,6 +311,9 @@ type Querier interface {
 	// value.
 	ReleaseLock(ctx context.Context, arg ReleaseLockParams) error
 	SetSubscriptionBundleVersion(ctx context.Context, arg SetSubscriptionBundleVersionParams) error
+	// SetSubscriptionVersion moves the subscription to a version of the bundle,
+	// recording the version it is moved from so that it can be rolled back.
+	SetSubscriptionVersion(ctx context.Context, arg SetSubscriptionVersionParams) error
 	// UpdateDataSource updates a datasource in a given project.
 	UpdateDataSource(ctx context.Context, arg UpdateDataSourceParams) (DataSource, error)
 	// UpdateDataSourceFunction updates a function in a datasource. We're
@@ -272,7 +327,11 @@ type Querier interface {
 	UpdateLease(ctx context.Context, arg UpdateLeaseParams) error
 	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error)
 	UpdateProjectMeta(ctx context.Context, arg UpdateProjectMetaParams) (Project, error)
+	UpdateProjectSyncStatus(ctx context.Context, arg UpdateProjectSyncStatusParams) error
 	UpdateProvider(ctx context.Context, arg UpdateProviderParams) error
+	// UpdateRuleExemption updates the justification, approver and expiry of
+	// an exemption. Null values leave the current value in place.
+	UpdateRuleExemption(ctx context.Context, arg UpdateRuleExemptionParams) (RuleExemption, error)
 	UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) (RuleType, error)
 	UpdateSelector(ctx context.Context, arg UpdateSelectorParams) (ProfileSelector, error)
 	UpsertAccessToken(ctx context.Context, arg UpsertAccessTokenParams) (ProviderAccessToken, error)
@@ -285,7 +344,15 @@ type Querier interface {
 	UpsertEvaluationOutput(ctx context.Context, arg UpsertEvaluationOutputParams) error
 	UpsertInstallationID(ctx context.Context, arg UpsertInstallationIDParams) (ProviderGithubAppInstallation, error)
 	UpsertLatestEvaluationStatus(ctx context.Context, arg UpsertLatestEvaluationStatusParams) error
+	UpsertOSVSyncState(ctx context.Context, arg UpsertOSVSyncStateParams) error
+	// UpsertOSVVulnerability stores a vulnerability record, unless the stored
+	// copy is already up to date. No rows are affected in that case.
+	UpsertOSVVulnerability(ctx context.Context, arg UpsertOSVVulnerabilityParams) (int64, error)
 	UpsertProfileForEntity(ctx context.Context, arg UpsertProfileForEntityParams) (EntityProfile, error)
+	// UpsertProjectSyncSource sets the repository a project is synced from.
+	// Changing the configuration resets the status, and schedules a sync
+	// right away.
+	UpsertProjectSyncSource(ctx context.Context, arg UpsertProjectSyncSourceParams) (ProjectSyncSource, error)
 	UpsertProperty(ctx context.Context, arg UpsertPropertyParams) (Property, error)
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
@@ -0,0 +1,306 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: rule_exemptions.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createRuleExemption = `-- name: CreateRuleExemption :one
+
+INSERT INTO rule_exemptions (
+    project_id,
+    profile_id,
+    rule_name,
+    entity_instance_id,
+    justification,
+    approver,
+    created_by,
+    expires_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, project_id, profile_id, rule_name, entity_instance_id, justification, approver, created_by, expires_at, created_at, updated_at
+`
+
+type CreateRuleExemptionParams struct {
+	ProjectID        uuid.UUID `json:"project_id"`
+	ProfileID        uuid.UUID `json:"profile_id"`
+	RuleName         string    `json:"rule_name"`
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	Justification    string    `json:"justification"`
+	Approver         string    `json:"approver"`
+	CreatedBy        string    `json:"created_by"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+// CreateRuleExemption creates a new exemption for a rule of a profile
+// and an entity.
+func (q *Queries) CreateRuleExemption(ctx context.Context, arg CreateRuleExemptionParams) (RuleExemption, error) {
+	row := q.db.QueryRowContext(ctx, createRuleExemption,
+		arg.ProjectID,
+		arg.ProfileID,
+		arg.RuleName,
+		arg.EntityInstanceID,
+		arg.Justification,
+		arg.Approver,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	var i RuleExemption
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.ProfileID,
+		&i.RuleName,
+		&i.EntityInstanceID,
+		&i.Justification,
+		&i.Approver,
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteRuleExemption = `-- name: DeleteRuleExemption :one
+DELETE FROM rule_exemptions
+WHERE id = $1 AND project_id = $2
+RETURNING id, project_id, profile_id, rule_name, entity_instance_id, justification, approver, created_by, expires_at, created_at, updated_at
+`
+
+type DeleteRuleExemptionParams struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+}
+
+func (q *Queries) DeleteRuleExemption(ctx context.Context, arg DeleteRuleExemptionParams) (RuleExemption, error) {
+	row := q.db.QueryRowContext(ctx, deleteRuleExemption, arg.ID, arg.ProjectID)
+	var i RuleExemption
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.ProfileID,
+		&i.RuleName,
+		&i.EntityInstanceID,
+		&i.Justification,
+		&i.Approver,
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRuleExemptionByID = `-- name: GetRuleExemptionByID :one
+
+SELECT rule_exemptions.id, rule_exemptions.project_id, rule_exemptions.profile_id, rule_exemptions.rule_name, rule_exemptions.entity_instance_id, rule_exemptions.justification, rule_exemptions.approver, rule_exemptions.created_by, rule_exemptions.expires_at, rule_exemptions.created_at, rule_exemptions.updated_at,
+       p.name AS profile_name,
+       ei.name AS entity_name,
+       ei.entity_type AS entity_type
+FROM rule_exemptions
+JOIN profiles p ON p.id = rule_exemptions.profile_id
+JOIN entity_instances ei ON ei.id = rule_exemptions.entity_instance_id
+WHERE rule_exemptions.id = $1 AND rule_exemptions.project_id = $2
+`
+
+type GetRuleExemptionByIDParams struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+}
+
+type GetRuleExemptionByIDRow struct {
+	RuleExemption RuleExemption `json:"rule_exemption"`
+	ProfileName   string        `json:"profile_name"`
+	EntityName    string        `json:"entity_name"`
+	EntityType    Entities      `json:"entity_type"`
+}
+
+// GetRuleExemptionByID retrieves an exemption of a project, together with
+// the names of the profile and entity it applies to.
+func (q *Queries) GetRuleExemptionByID(ctx context.Context, arg GetRuleExemptionByIDParams) (GetRuleExemptionByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getRuleExemptionByID, arg.ID, arg.ProjectID)
+	var i GetRuleExemptionByIDRow
+	err := row.Scan(
+		&i.RuleExemption.ID,
+		&i.RuleExemption.ProjectID,
+		&i.RuleExemption.ProfileID,
+		&i.RuleExemption.RuleName,
+		&i.RuleExemption.EntityInstanceID,
+		&i.RuleExemption.Justification,
+		&i.RuleExemption.Approver,
+		&i.RuleExemption.CreatedBy,
+		&i.RuleExemption.ExpiresAt,
+		&i.RuleExemption.CreatedAt,
+		&i.RuleExemption.UpdatedAt,
+		&i.ProfileName,
+		&i.EntityName,
+		&i.EntityType,
+	)
+	return i, err
+}
+
+const listActiveRuleExemptionsByEntity = `-- name: ListActiveRuleExemptionsByEntity :many
+
+SELECT id, project_id, profile_id, rule_name, entity_instance_id, justification, approver, created_by, expires_at, created_at, updated_at FROM rule_exemptions
+WHERE entity_instance_id = $1 AND expires_at > NOW()
+ORDER BY expires_at DESC
+`
+
+// ListActiveRuleExemptionsByEntity lists the exemptions which currently
+// apply to an entity. It is used by the executor to waive the evaluation
+// of rules.
+func (q *Queries) ListActiveRuleExemptionsByEntity(ctx context.Context, entityInstanceID uuid.UUID) ([]RuleExemption, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveRuleExemptionsByEntity, entityInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RuleExemption{}
+	for rows.Next() {
+		var i RuleExemption
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.ProfileID,
+			&i.RuleName,
+			&i.EntityInstanceID,
+			&i.Justification,
+			&i.Approver,
+			&i.CreatedBy,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRuleExemptionsByProject = `-- name: ListRuleExemptionsByProject :many
+
+SELECT rule_exemptions.id, rule_exemptions.project_id, rule_exemptions.profile_id, rule_exemptions.rule_name, rule_exemptions.entity_instance_id, rule_exemptions.justification, rule_exemptions.approver, rule_exemptions.created_by, rule_exemptions.expires_at, rule_exemptions.created_at, rule_exemptions.updated_at,
+       p.name AS profile_name,
+       ei.name AS entity_name,
+       ei.entity_type AS entity_type
+FROM rule_exemptions
+JOIN profiles p ON p.id = rule_exemptions.profile_id
+JOIN entity_instances ei ON ei.id = rule_exemptions.entity_instance_id
+WHERE rule_exemptions.project_id = $1
+  AND ($2::uuid IS NULL OR rule_exemptions.profile_id = $2::uuid)
+  AND ($3::boolean OR rule_exemptions.expires_at > NOW())
+ORDER BY p.name, rule_exemptions.rule_name, ei.name, rule_exemptions.expires_at
+`
+
+type ListRuleExemptionsByProjectParams struct {
+	ProjectID      uuid.UUID     `json:"project_id"`
+	ProfileID      uuid.NullUUID `json:"profile_id"`
+	IncludeExpired bool          `json:"include_expired"`
+}
+
+type ListRuleExemptionsByProjectRow struct {
+	RuleExemption RuleExemption `json:"rule_exemption"`
+	ProfileName   string        `json:"profile_name"`
+	EntityName    string        `json:"entity_name"`
+	EntityType    Entities      `json:"entity_type"`
+}
+
+// ListRuleExemptionsByProject lists the exemptions of a project, optionally
+// filtered by profile. Expired exemptions are only included if requested.
+func (q *Queries) ListRuleExemptionsByProject(ctx context.Context, arg ListRuleExemptionsByProjectParams) ([]ListRuleExemptionsByProjectRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRuleExemptionsByProject, arg.ProjectID, arg.ProfileID, arg.IncludeExpired)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRuleExemptionsByProjectRow{}
+	for rows.Next() {
+		var i ListRuleExemptionsByProjectRow
+		if err := rows.Scan(
+			&i.RuleExemption.ID,
+			&i.RuleExemption.ProjectID,
+			&i.RuleExemption.ProfileID,
+			&i.RuleExemption.RuleName,
+			&i.RuleExemption.EntityInstanceID,
+			&i.RuleExemption.Justification,
+			&i.RuleExemption.Approver,
+			&i.RuleExemption.CreatedBy,
+			&i.RuleExemption.ExpiresAt,
+			&i.RuleExemption.CreatedAt,
+			&i.RuleExemption.UpdatedAt,
+			&i.ProfileName,
+			&i.EntityName,
+			&i.EntityType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRuleExemption = `-- name: UpdateRuleExemption :one
+
+UPDATE rule_exemptions
+SET justification = COALESCE($3, justification),
+    approver = COALESCE($4, approver),
+    expires_at = COALESCE($5, expires_at),
+    updated_at = NOW()
+WHERE id = $1 AND project_id = $2
+RETURNING id, project_id, profile_id, rule_name, entity_instance_id, justification, approver, created_by, expires_at, created_at, updated_at
+`
+
+type UpdateRuleExemptionParams struct {
+	ID            uuid.UUID      `json:"id"`
+	ProjectID     uuid.UUID      `json:"project_id"`
+	Justification sql.NullString `json:"justification"`
+	Approver      sql.NullString `json:"approver"`
+	ExpiresAt     sql.NullTime   `json:"expires_at"`
+}
+
+// UpdateRuleExemption updates the justification, approver and expiry of
+// an exemption. Null values leave the current value in place.
+func (q *Queries) UpdateRuleExemption(ctx context.Context, arg UpdateRuleExemptionParams) (RuleExemption, error) {
+	row := q.db.QueryRowContext(ctx, updateRuleExemption,
+		arg.ID,
+		arg.ProjectID,
+		arg.Justification,
+		arg.Approver,
+		arg.ExpiresAt,
+	)
+	var i RuleExemption
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.ProfileID,
+		&i.RuleName,
+		&i.EntityInstanceID,
+		&i.Justification,
+		&i.Approver,
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
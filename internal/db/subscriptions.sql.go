@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
@@ -15,7 +16,7 @@ const createSubscription = `-- name: CreateSubscription :one
 
 INSERT INTO subscriptions (project_id, bundle_id, current_version)
 VALUES ($1, $2, $3)
-RETURNING id, project_id, bundle_id, current_version
+RETURNING id, project_id, bundle_id, current_version, previous_version
 `
 
 type CreateSubscriptionParams struct {
@@ -33,6 +34,7 @@ func (q *Queries) CreateSubscription(ctx context.Context, arg CreateSubscription
 		&i.ProjectID,
 		&i.BundleID,
 		&i.CurrentVersion,
+		&i.PreviousVersion,
 	)
 	return i, err
 }
@@ -54,7 +56,7 @@ func (q *Queries) GetBundle(ctx context.Context, arg GetBundleParams) (Bundle, e
 }
 
 const getSubscriptionByProjectBundle = `-- name: GetSubscriptionByProjectBundle :one
-SELECT su.id, su.project_id, su.bundle_id, su.current_version FROM subscriptions AS su
+SELECT su.id, su.project_id, su.bundle_id, su.current_version, su.previous_version FROM subscriptions AS su
 JOIN bundles AS bu ON bu.id = su.bundle_id
 WHERE bu.namespace = $1 AND bu.name = $2 AND su.project_id = $3
 `
@@ -73,10 +75,59 @@ func (q *Queries) GetSubscriptionByProjectBundle(ctx context.Context, arg GetSub
 		&i.ProjectID,
 		&i.BundleID,
 		&i.CurrentVersion,
+		&i.PreviousVersion,
 	)
 	return i, err
 }
 
+const listSubscriptionsByProject = `-- name: ListSubscriptionsByProject :many
+SELECT su.id, su.project_id, su.bundle_id, su.current_version, su.previous_version, bu.namespace, bu.name FROM subscriptions AS su
+JOIN bundles AS bu ON bu.id = su.bundle_id
+WHERE su.project_id = $1
+ORDER BY bu.namespace, bu.name
+`
+
+type ListSubscriptionsByProjectRow struct {
+	ID              uuid.UUID      `json:"id"`
+	ProjectID       uuid.UUID      `json:"project_id"`
+	BundleID        uuid.UUID      `json:"bundle_id"`
+	CurrentVersion  string         `json:"current_version"`
+	PreviousVersion sql.NullString `json:"previous_version"`
+	Namespace       string         `json:"namespace"`
+	Name            string         `json:"name"`
+}
+
+func (q *Queries) ListSubscriptionsByProject(ctx context.Context, projectID uuid.UUID) ([]ListSubscriptionsByProjectRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSubscriptionsByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSubscriptionsByProjectRow{}
+	for rows.Next() {
+		var i ListSubscriptionsByProjectRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.BundleID,
+			&i.CurrentVersion,
+			&i.PreviousVersion,
+			&i.Namespace,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setSubscriptionBundleVersion = `-- name: SetSubscriptionBundleVersion :exec
 UPDATE subscriptions SET current_version = $2 WHERE project_id = $1
 `
@@ -91,6 +142,24 @@ func (q *Queries) SetSubscriptionBundleVersion(ctx context.Context, arg SetSubsc
 	return err
 }
 
+const setSubscriptionVersion = `-- name: SetSubscriptionVersion :exec
+
+UPDATE subscriptions SET previous_version = current_version, current_version = $2
+WHERE id = $1
+`
+
+type SetSubscriptionVersionParams struct {
+	ID             uuid.UUID `json:"id"`
+	CurrentVersion string    `json:"current_version"`
+}
+
+// SetSubscriptionVersion moves the subscription to a version of the bundle,
+// recording the version it is moved from so that it can be rolled back.
+func (q *Queries) SetSubscriptionVersion(ctx context.Context, arg SetSubscriptionVersionParams) error {
+	_, err := q.db.ExecContext(ctx, setSubscriptionVersion, arg.ID, arg.CurrentVersion)
+	return err
+}
+
 const upsertBundle = `-- name: UpsertBundle :exec
 
 
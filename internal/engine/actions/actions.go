@@ -15,6 +15,7 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/mindersec/minder/internal/alertwebhooks"
 	"github.com/mindersec/minder/internal/engine/actions/alert"
 	"github.com/mindersec/minder/internal/engine/actions/remediate"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/pull_request"
@@ -37,6 +38,7 @@ func NewRuleActions(
 	ruletype *minderv1.RuleType,
 	provider provinfv1.Provider,
 	actionConfig *models.ActionConfiguration,
+	webhooks alertwebhooks.Resolver,
 ) (*RuleActionsEngine, error) {
 	// Create the remediation engine
 	remEngine, err := remediate.NewRuleRemediator(ruletype, provider, actionConfig.Remediate)
@@ -45,7 +47,7 @@ func NewRuleActions(
 	}
 
 	// Create the alert engine
-	alertEngine, err := alert.NewRuleAlert(ctx, ruletype, provider, actionConfig.Alert)
+	alertEngine, err := alert.NewRuleAlert(ctx, ruletype, provider, actionConfig.Alert, webhooks)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create rule alerter: %w", err)
 	}
@@ -179,8 +181,7 @@ func shouldRemediate(prevEval *previousEval, evalStatus EvalStatus) engif.Action
 		}
 		// Do nothing if the Remediation is something else other than skipped, i.e. pending, success, error, etc.
 		return engif.ActionCmdDoNothing
-	case EvalStatusSkipped:
-	case EvalStatusPending:
+	case EvalStatusSkipped, EvalStatusPending, EvalStatusWaived:
 		return engif.ActionCmdDoNothing
 	}
 
@@ -235,8 +236,7 @@ func shouldAlert(
 		}
 		// We should do nothing if the Alert is already OFF
 		return engif.ActionCmdDoNothing
-	case EvalStatusSkipped:
-	case EvalStatusPending:
+	case EvalStatusSkipped, EvalStatusPending, EvalStatusWaived:
 		return engif.ActionCmdDoNothing
 	}
 
@@ -275,7 +275,9 @@ func (rae *RuleActionsEngine) isSkippable(ctx context.Context, actionType engif.
 			// rule evaluation was skipped, skip action too
 			errors.Is(evalErr, interfaces.ErrEvaluationSkipped) ||
 				// rule evaluation was skipped silently, skip action
-				errors.Is(evalErr, enginerr.ErrEvaluationSkipSilently)
+				errors.Is(evalErr, enginerr.ErrEvaluationSkipSilently) ||
+				// rule is waived by an exemption, skip action
+				errors.Is(evalErr, interfaces.ErrEvaluationWaived)
 	}
 	logger.Bool("skip_action", skipAction).Msg("action skip decision")
 	// Everything else, do not skip
@@ -327,6 +329,10 @@ func mapEvalStatus(err error) EvalStatus {
 		return EvalStatusSkipped
 	}
 
+	if errors.Is(err, interfaces.ErrEvaluationWaived) {
+		return EvalStatusWaived
+	}
+
 	if errors.Is(err, interfaces.ErrEvaluationFailed) {
 		return EvalStatusFailure
 	}
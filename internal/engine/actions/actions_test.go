@@ -94,6 +94,13 @@ func TestShouldRemediate(t *testing.T) {
 			evalErr:    enginerr.ErrEvaluationSkipSilently,
 			expected:   engif.ActionCmdDoNothing,
 		},
+		{
+			name:       "eval waived, prev pending -> do nothing",
+			prevStatus: RemediationStatusPending,
+			hasPrev:    true,
+			evalErr:    enginerr.NewErrEvaluationWaived("exempted"),
+			expected:   engif.ActionCmdDoNothing,
+		},
 		{
 			name:     "no previous eval, eval failure -> on",
 			hasPrev:  false,
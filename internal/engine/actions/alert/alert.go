@@ -11,9 +11,12 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/mindersec/minder/internal/alertwebhooks"
+	"github.com/mindersec/minder/internal/engine/actions/alert/issue"
 	"github.com/mindersec/minder/internal/engine/actions/alert/noop"
 	"github.com/mindersec/minder/internal/engine/actions/alert/pull_request_comment"
 	"github.com/mindersec/minder/internal/engine/actions/alert/security_advisory"
+	"github.com/mindersec/minder/internal/engine/actions/alert/webhook"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/profiles/models"
@@ -29,6 +32,7 @@ func NewRuleAlert(
 	ruletype *pb.RuleType,
 	provider provinfv1.Provider,
 	setting models.ActionOpt,
+	webhooks alertwebhooks.Resolver,
 ) (engif.Action, error) {
 	alertCfg := ruletype.Def.GetAlert()
 	if alertCfg == nil {
@@ -61,6 +65,29 @@ func NewRuleAlert(
 		}
 		return pull_request_comment.NewPullRequestCommentAlert(
 			ActionType, alertCfg.GetPullRequestComment(), client, setting)
+	case issue.AlertType:
+		if alertCfg.GetIssue() == nil {
+			return nil, fmt.Errorf("alert engine missing issue configuration")
+		}
+		client, err := provinfv1.As[provinfv1.IssuePublisher](provider)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Str("rule-type", ruletype.GetName()).
+				Msg("provider does not support publishing issues. Silently skipping alerts.")
+			return noop.NewNoopAlert(ActionType)
+		}
+		return issue.NewIssueAlert(
+			ActionType, ruletype, alertCfg.GetIssue(), client, setting)
+	case webhook.AlertType:
+		if alertCfg.GetWebhook() == nil {
+			return nil, fmt.Errorf("alert engine missing webhook configuration")
+		}
+		if webhooks == nil {
+			zerolog.Ctx(ctx).Debug().Str("rule-type", ruletype.GetName()).
+				Msg("no alert webhooks available. Silently skipping alerts.")
+			return noop.NewNoopAlert(ActionType)
+		}
+		return webhook.NewWebhookAlert(
+			ActionType, ruletype, alertCfg.GetWebhook(), webhooks, setting)
 	}
 
 	return nil, fmt.Errorf("unknown alert type: %s", alertCfg.GetType())
@@ -0,0 +1,472 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package issue provides necessary interfaces and implementations for
+// opening and closing issues as alerts.
+package issue
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	engifv1 "github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// AlertType is the type of the issue alert engine
+	AlertType = "issue"
+	// TitleMaxLength is the maximum length of the issue title
+	TitleMaxLength = 256
+	// BodyMaxLength is the maximum length of the issue body and comments
+	// (this was derived from the limit of the GitHub API)
+	BodyMaxLength = 65536
+
+	defaultTitle = `Minder: {{ .RuleName }} failed on {{ .Repository }}`
+	// nolint:lll
+	defaultBody = `{{ .EvalErrorDetails }}
+
+Minder has detected that the repository **{{ .Repository }}** does not comply with the **{{ .RuleName }}** rule of the **{{ .Profile }}** profile.
+
+**Guidance**
+
+{{ .Guidance }}
+
+**Details**
+
+- Profile: {{ .Profile }}
+- Rule: {{ .RuleName }}
+- Rule type: {{ .RuleType }}
+- Severity: {{ .Severity }}
+
+This issue will be closed automatically once the rule passes again.
+`
+	defaultComment = `The **{{ .RuleName }}** rule is still failing:
+
+{{ .EvalErrorDetails }}
+`
+	resolvedComment = "The rule passes again, closing this issue."
+)
+
+// Alert is the structure backing the issue alert action
+type Alert struct {
+	actionType  interfaces.ActionType
+	cli         provifv1.IssuePublisher
+	ruleType    *pb.RuleType
+	issueCfg    *pb.RuleType_Definition_Alert_AlertTypeIssue
+	titleTmpl   *util.SafeTemplate
+	bodyTmpl    *util.SafeTemplate
+	commentTmpl *util.SafeTemplate
+	setting     models.ActionOpt
+}
+
+// TemplateParams is the parameters for the issue templates
+type TemplateParams struct {
+	// EvalErrorDetails is the details of the error that occurred during evaluation, which may be empty
+	EvalErrorDetails string
+
+	// EvalResultOutput is the output of the evaluation, which may be empty
+	EvalResultOutput any
+
+	// Profile is the name of the profile
+	Profile string
+
+	// RuleType is the name of the rule type
+	RuleType string
+
+	// RuleName is the name of the rule in the profile
+	RuleName string
+
+	// Repository is the full name of the repository
+	Repository string
+
+	// Severity is the severity of the rule type
+	Severity string
+
+	// Guidance is the guidance of the rule type
+	Guidance string
+}
+
+type paramsIssue struct {
+	Owner       string
+	Repo        string
+	Title       string
+	Body        string
+	Comment     string
+	DetailsHash string
+	RuleName    string
+	failing     bool
+	Metadata    *alertMetadata
+	prevStatus  *db.ListRuleEvaluationsByProfileIdRow
+}
+
+type alertMetadata struct {
+	IssueNumber int        `json:"issue_number,omitempty"`
+	IssueURL    string     `json:"issue_url,omitempty"`
+	OpenedAt    *time.Time `json:"opened_at,omitempty"`
+	// DetailsHash identifies the last failure reported on the issue,
+	// so repeated evaluations only comment when the failure changes.
+	DetailsHash string `json:"details_hash,omitempty"`
+}
+
+// NewIssueAlert creates a new issue alert action
+func NewIssueAlert(
+	actionType interfaces.ActionType,
+	ruleType *pb.RuleType,
+	issueCfg *pb.RuleType_Definition_Alert_AlertTypeIssue,
+	cli provifv1.IssuePublisher,
+	setting models.ActionOpt,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	title := issueCfg.GetTitle()
+	if title == "" {
+		title = defaultTitle
+	}
+	titleTmpl, err := util.NewSafeTextTemplate(&title, "title")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse issue title template: %w", err)
+	}
+
+	body := issueCfg.GetBody()
+	if body == "" {
+		body = defaultBody
+	}
+	bodyTmpl, err := util.NewSafeTextTemplate(&body, "body")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse issue body template: %w", err)
+	}
+
+	comment := issueCfg.GetComment()
+	if comment == "" {
+		comment = defaultComment
+	}
+	commentTmpl, err := util.NewSafeTextTemplate(&comment, "comment")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse issue comment template: %w", err)
+	}
+
+	return &Alert{
+		actionType:  actionType,
+		cli:         cli,
+		ruleType:    ruleType,
+		issueCfg:    issueCfg,
+		titleTmpl:   titleTmpl,
+		bodyTmpl:    bodyTmpl,
+		commentTmpl: commentTmpl,
+		setting:     setting,
+	}, nil
+}
+
+// Class returns the action type of the issue alert engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the issue alert engine
+func (*Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (alert *Alert) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(alert.setting, models.ActionOptOff)
+}
+
+// Do opens, comments on or closes an issue
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	p, err := alert.getParamsForIssue(ctx, entity, params, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting parameters for issue: %w", err)
+	}
+
+	// Process the command based on the action setting
+	switch alert.setting {
+	case models.ActionOptOn:
+		return alert.run(ctx, p, cmd)
+	case models.ActionOptDryRun:
+		return alert.runDry(ctx, p, cmd)
+	case models.ActionOptOff, models.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+func (alert *Alert) run(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("owner", params.Owner).
+		Str("repo", params.Repo).
+		Logger()
+
+	// Process the command
+	switch cmd {
+	// Open an issue, or keep using the one we opened before
+	case interfaces.ActionCmdOn:
+		if params.Metadata != nil && params.Metadata.IssueNumber != 0 {
+			meta, err := alert.updateExistingIssue(ctx, params)
+			if err == nil {
+				return meta, nil
+			} else if !errors.Is(err, enginerr.ErrNotFound) {
+				return nil, fmt.Errorf("error updating issue: %w, %w", err, enginerr.ErrActionFailed)
+			}
+			// The issue was deleted, so open a new one
+			logger.Debug().Int("issue", params.Metadata.IssueNumber).Msg("issue not found, opening a new one")
+		}
+
+		req := &github.IssueRequest{
+			Title: github.String(params.Title),
+			Body:  github.String(params.Body),
+		}
+		if labels := alert.issueCfg.GetLabels(); len(labels) > 0 {
+			req.Labels = &labels
+		}
+		issue, err := alert.cli.CreateIssue(ctx, params.Owner, params.Repo, req)
+		if err != nil {
+			return nil, fmt.Errorf("error creating issue: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		logger.Info().Int("issue", issue.GetNumber()).Msg("issue opened")
+
+		now := time.Now()
+		return marshalMetadata(&alertMetadata{
+			IssueNumber: issue.GetNumber(),
+			IssueURL:    issue.GetHTMLURL(),
+			OpenedAt:    &now,
+			DetailsHash: params.DetailsHash,
+		})
+	// Close the issue
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.IssueNumber == 0 {
+			// We cannot do anything without the issue number, so we assume that closing this is a success
+			return nil, fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+		}
+
+		number := params.Metadata.IssueNumber
+		if _, err := alert.cli.CreateIssueComment(ctx, params.Owner, params.Repo, number, resolvedComment); err != nil {
+			if errors.Is(err, enginerr.ErrNotFound) {
+				return nil, fmt.Errorf("issue already deleted: %w, %w", err, enginerr.ErrActionTurnedOff)
+			}
+			return nil, fmt.Errorf("error commenting on issue: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		if _, err := alert.cli.EditIssue(ctx, params.Owner, params.Repo, number, &github.IssueRequest{
+			State:       github.String("closed"),
+			StateReason: github.String("completed"),
+		}); err != nil {
+			if errors.Is(err, enginerr.ErrNotFound) {
+				return nil, fmt.Errorf("issue already deleted: %w, %w", err, enginerr.ErrActionTurnedOff)
+			}
+			return nil, fmt.Errorf("error closing issue: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		logger.Info().Int("issue", number).Msg("issue closed")
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		// The rule keeps failing; comment if the failure changed
+		if alert.shouldComment(params) {
+			meta, err := alert.updateExistingIssue(ctx, params)
+			if err != nil {
+				return nil, fmt.Errorf("error commenting on issue: %w, %w", err, enginerr.ErrActionFailed)
+			}
+			return meta, nil
+		}
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// updateExistingIssue reopens the issue if it was closed by hand, and
+// comments on it if the failure changed since the last report.
+func (alert *Alert) updateExistingIssue(ctx context.Context, params *paramsIssue) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+	number := params.Metadata.IssueNumber
+
+	issue, err := alert.cli.GetIssue(ctx, params.Owner, params.Repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.GetState() == "closed" {
+		if _, err := alert.cli.EditIssue(ctx, params.Owner, params.Repo, number, &github.IssueRequest{
+			State: github.String("open"),
+		}); err != nil {
+			return nil, err
+		}
+		logger.Info().Int("issue", number).Msg("issue reopened")
+	}
+
+	meta := *params.Metadata
+	if meta.DetailsHash != params.DetailsHash || issue.GetState() == "closed" {
+		if _, err := alert.cli.CreateIssueComment(ctx, params.Owner, params.Repo, number, params.Comment); err != nil {
+			return nil, err
+		}
+		logger.Info().Int("issue", number).Msg("issue commented")
+		meta.DetailsHash = params.DetailsHash
+	}
+
+	return marshalMetadata(&meta)
+}
+
+// shouldComment returns true if the rule is still failing on an open
+// issue, but with different details than the ones last reported.
+func (*Alert) shouldComment(params *paramsIssue) bool {
+	if !params.failing || params.Metadata == nil || params.Metadata.IssueNumber == 0 {
+		return false
+	}
+	if params.prevStatus == nil || params.prevStatus.AlertStatus != db.AlertStatusTypesOn {
+		return false
+	}
+	return params.Metadata.DetailsHash != params.DetailsHash
+}
+
+// runDry runs the issue action in dry run mode
+func (alert *Alert) runDry(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	// Process the command
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		logger.Info().Msgf("dry run: open an issue in repo %s/%s with title %q and body: %s",
+			params.Owner, params.Repo, params.Title, params.Body)
+		return nil, nil
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.IssueNumber == 0 {
+			// We cannot do anything without the issue number, so we assume that closing this is a success
+			return nil, fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		logger.Info().Msgf("dry run: close issue %d in repo %s/%s",
+			params.Metadata.IssueNumber, params.Owner, params.Repo)
+	case interfaces.ActionCmdDoNothing:
+		if alert.shouldComment(params) {
+			logger.Info().Msgf("dry run: comment on issue %d in repo %s/%s with the following body: %s",
+				params.Metadata.IssueNumber, params.Owner, params.Repo, params.Comment)
+		}
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDoNothing returns the previous alert status
+func (*Alert) runDoNothing(ctx context.Context, params *paramsIssue) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().Str("repo", params.Repo).Logger()
+
+	logger.Debug().Msg("Running do nothing")
+
+	// Return the previous alert status.
+	err := dbadapter.AlertStatusAsError(params.prevStatus)
+	// If there is a valid alert metadata, return it too
+	if params.prevStatus != nil {
+		return params.prevStatus.AlertMetadata, err
+	}
+	// If there is no alert metadata, return nil as the metadata and the error
+	return nil, err
+}
+
+// getParamsForIssue extracts the details from the entity
+func (alert *Alert) getParamsForIssue(
+	ctx context.Context,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (*paramsIssue, error) {
+	logger := zerolog.Ctx(ctx)
+	result := &paramsIssue{
+		prevStatus: params.GetEvalStatusFromDb(),
+		RuleName:   params.GetRule().Name,
+		failing:    errors.Is(params.GetEvalErr(), engifv1.ErrEvaluationFailed),
+	}
+
+	// Get the owner and repo from the entity
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		result.Owner = entity.GetOwner()
+		result.Repo = entity.GetName()
+	case *pbinternal.PullRequest:
+		result.Owner = entity.GetRepoOwner()
+		result.Repo = entity.GetRepoName()
+	case *pb.Artifact:
+		result.Owner = entity.GetOwner()
+		result.Repo = entity.GetRepository()
+	default:
+		return nil, fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+	}
+
+	tmplParams := &TemplateParams{
+		EvalErrorDetails: dbadapter.ErrorAsEvalDetails(params.GetEvalErr()),
+		Profile:          params.GetProfile().Name,
+		RuleType:         alert.ruleType.GetName(),
+		RuleName:         result.RuleName,
+		Repository:       fmt.Sprintf("%s/%s", result.Owner, result.Repo),
+		Severity:         alert.ruleType.GetSeverity().GetValue().Enum().AsString(),
+		Guidance:         alert.ruleType.GetGuidance(),
+	}
+	if params.GetEvalResult() != nil {
+		tmplParams.EvalResultOutput = params.GetEvalResult().Output
+	}
+
+	var err error
+	result.Title, err = alert.titleTmpl.Render(ctx, tmplParams, TitleMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute title template: %w", err)
+	}
+	body, err := alert.bodyTmpl.Render(ctx, tmplParams, BodyMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute body template: %w", err)
+	}
+	// Add magic comment to identify Minder issues for this rule
+	result.Body = fmt.Sprintf("%s\n\n<!-- minder-rule: %s -->", body, result.RuleName)
+	result.Comment, err = alert.commentTmpl.Render(ctx, tmplParams, BodyMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute comment template: %w", err)
+	}
+
+	sum := sha256.Sum256([]byte(tmplParams.EvalErrorDetails))
+	result.DetailsHash = hex.EncodeToString(sum[:8])
+
+	// Unmarshal the existing alert metadata, if any
+	if metadata != nil {
+		meta := &alertMetadata{}
+		err := json.Unmarshal(*metadata, meta)
+		if err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			logger.Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		} else {
+			result.Metadata = meta
+		}
+	}
+
+	return result, nil
+}
+
+func marshalMetadata(meta *alertMetadata) (json.RawMessage, error) {
+	newMeta, err := json.Marshal(meta)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+	}
+	return newMeta, nil
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mindersec/minder/internal/db"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	mock_provifv1 "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+const (
+	testActionType engif.ActionType = "alert-test"
+	failureDetails                  = "evaluation failure reason"
+	issueNumber                     = 42
+)
+
+func metaWithHash(hash string) *json.RawMessage {
+	m := json.RawMessage(fmt.Sprintf(`{"issue_number":%d,"details_hash":"%s"}`, issueNumber, hash))
+	return &m
+}
+
+func TestIssueAlert(t *testing.T) {
+	t.Parallel()
+
+	// The hash of failureDetails, as computed by getParamsForIssue
+	const currentHash = "368a885726c9bd78"
+
+	tests := []struct {
+		name          string
+		cmd           engif.ActionCmd
+		prevAlert     db.AlertStatusTypes
+		inputMetadata *json.RawMessage
+		mockSetup     func(*mock_provifv1.MockIssuePublisher)
+		wantErr       error
+		wantNumber    int
+	}{
+		{
+			name: "opens an issue",
+			cmd:  engif.ActionCmdOn,
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().CreateIssue(gomock.Any(), "mindersec", "minder", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, req *github.IssueRequest) (*github.Issue, error) {
+						assert.Equal(t, "Minder: test-rule failed on mindersec/minder", req.GetTitle())
+						assert.Contains(t, req.GetBody(), failureDetails)
+						assert.Contains(t, req.GetBody(), "<!-- minder-rule: test-rule -->")
+						assert.Equal(t, []string{"security"}, req.GetLabels())
+						return &github.Issue{Number: github.Int(issueNumber)}, nil
+					})
+			},
+			wantNumber: issueNumber,
+		},
+		{
+			name:          "keeps using an open issue",
+			cmd:           engif.ActionCmdOn,
+			inputMetadata: metaWithHash(currentHash),
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().GetIssue(gomock.Any(), "mindersec", "minder", issueNumber).
+					Return(&github.Issue{Number: github.Int(issueNumber), State: github.String("open")}, nil)
+			},
+			wantNumber: issueNumber,
+		},
+		{
+			name:          "reopens an issue closed by hand",
+			cmd:           engif.ActionCmdOn,
+			inputMetadata: metaWithHash(currentHash),
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().GetIssue(gomock.Any(), "mindersec", "minder", issueNumber).
+					Return(&github.Issue{Number: github.Int(issueNumber), State: github.String("closed")}, nil)
+				m.EXPECT().EditIssue(gomock.Any(), "mindersec", "minder", issueNumber, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, req *github.IssueRequest) (*github.Issue, error) {
+						assert.Equal(t, "open", req.GetState())
+						return &github.Issue{}, nil
+					})
+				m.EXPECT().CreateIssueComment(gomock.Any(), "mindersec", "minder", issueNumber, gomock.Any()).
+					Return(&github.IssueComment{}, nil)
+			},
+			wantNumber: issueNumber,
+		},
+		{
+			name:          "opens a new issue if the old one was deleted",
+			cmd:           engif.ActionCmdOn,
+			inputMetadata: metaWithHash(currentHash),
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().GetIssue(gomock.Any(), "mindersec", "minder", issueNumber).
+					Return(nil, enginerr.ErrNotFound)
+				m.EXPECT().CreateIssue(gomock.Any(), "mindersec", "minder", gomock.Any()).
+					Return(&github.Issue{Number: github.Int(issueNumber + 1)}, nil)
+			},
+			wantNumber: issueNumber + 1,
+		},
+		{
+			name: "error opening an issue",
+			cmd:  engif.ActionCmdOn,
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().CreateIssue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("boom"))
+			},
+			wantErr: enginerr.ErrActionFailed,
+		},
+		{
+			name:          "comments when the failure changes",
+			cmd:           engif.ActionCmdDoNothing,
+			prevAlert:     db.AlertStatusTypesOn,
+			inputMetadata: metaWithHash("previous"),
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().GetIssue(gomock.Any(), "mindersec", "minder", issueNumber).
+					Return(&github.Issue{Number: github.Int(issueNumber), State: github.String("open")}, nil)
+				m.EXPECT().CreateIssueComment(gomock.Any(), "mindersec", "minder", issueNumber, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, comment string) (*github.IssueComment, error) {
+						assert.Contains(t, comment, failureDetails)
+						return &github.IssueComment{}, nil
+					})
+			},
+			wantNumber: issueNumber,
+		},
+		{
+			name:          "does not comment on the same failure",
+			cmd:           engif.ActionCmdDoNothing,
+			prevAlert:     db.AlertStatusTypesOn,
+			inputMetadata: metaWithHash(currentHash),
+			mockSetup:     func(_ *mock_provifv1.MockIssuePublisher) {},
+		},
+		{
+			name:          "closes the issue",
+			cmd:           engif.ActionCmdOff,
+			inputMetadata: metaWithHash(currentHash),
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().CreateIssueComment(gomock.Any(), "mindersec", "minder", issueNumber, resolvedComment).
+					Return(&github.IssueComment{}, nil)
+				m.EXPECT().EditIssue(gomock.Any(), "mindersec", "minder", issueNumber, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, req *github.IssueRequest) (*github.Issue, error) {
+						assert.Equal(t, "closed", req.GetState())
+						return &github.Issue{}, nil
+					})
+			},
+			wantErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:          "closing a deleted issue",
+			cmd:           engif.ActionCmdOff,
+			inputMetadata: metaWithHash(currentHash),
+			mockSetup: func(m *mock_provifv1.MockIssuePublisher) {
+				m.EXPECT().CreateIssueComment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, enginerr.ErrNotFound)
+			},
+			wantErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:      "nothing to close",
+			cmd:       engif.ActionCmdOff,
+			mockSetup: func(_ *mock_provifv1.MockIssuePublisher) {},
+			wantErr:   enginerr.ErrActionTurnedOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockClient := mock_provifv1.NewMockIssuePublisher(ctrl)
+			tt.mockSetup(mockClient)
+
+			alert, err := NewIssueAlert(testActionType, &pb.RuleType{Name: "test-rule-type"},
+				&pb.RuleType_Definition_Alert_AlertTypeIssue{Labels: []string{"security"}},
+				mockClient, models.ActionOptOn)
+			require.NoError(t, err)
+
+			prevStatus := &db.ListRuleEvaluationsByProfileIdRow{AlertStatus: tt.prevAlert}
+			if tt.inputMetadata != nil {
+				prevStatus.AlertMetadata = *tt.inputMetadata
+			}
+			evalParams := &engif.EvalStatusParams{
+				EvalStatusFromDb: prevStatus,
+				Profile:          &models.ProfileAggregate{Name: "test-profile"},
+				Rule:             &models.RuleInstance{Name: "test-rule"},
+			}
+			evalParams.SetEvalErr(enginerr.NewErrEvaluationFailed(failureDetails))
+
+			repo := &pb.Repository{Owner: "mindersec", Name: "minder"}
+			meta, err := alert.Do(context.Background(), tt.cmd, repo, evalParams, tt.inputMetadata)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.wantNumber != 0 {
+				am := &alertMetadata{}
+				require.NoError(t, json.Unmarshal(meta, am))
+				assert.Equal(t, tt.wantNumber, am.IssueNumber)
+				assert.Equal(t, currentHash, am.DetailsHash)
+			}
+		})
+	}
+}
+
+func TestNewIssueAlertInvalidTemplate(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewIssueAlert(testActionType, &pb.RuleType{},
+		&pb.RuleType_Definition_Alert_AlertTypeIssue{Title: proto.String("{{ .RuleName ")},
+		mock_provifv1.NewMockIssuePublisher(gomock.NewController(t)), models.ActionOptOn)
+	require.ErrorContains(t, err, "cannot parse issue title template")
+}
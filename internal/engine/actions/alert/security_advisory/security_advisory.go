@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	htmltemplate "html/template"
+	"io"
 	"strings"
 
 	"github.com/google/go-github/v63/github"
@@ -137,11 +138,9 @@ func NewSecurityAdvisoryAlert(
 		return nil, fmt.Errorf("action type cannot be empty")
 	}
 	// Parse the templates for summary and description
-	sumT, err := htmltemplate.New(tmplSummaryName).
-		Option("missingkey=error").
-		Parse(tmplSummary + " - " + ruleType.ShortFailureMessage)
+	sumT, err := parseSummaryTemplate(ruleType)
 	if err != nil {
-		return nil, fmt.Errorf("cannot parse summary template: %w", err)
+		return nil, err
 	}
 	descriptionTmplNoRemStr := strings.Join([]string{tmplPart1Top, tmplPart2MiddleNoRem, tmplPart3Bottom}, "\n")
 	descNoRemT, err := htmltemplate.New(tmplDescriptionNameNoRem).Option("missingkey=error").Parse(descriptionTmplNoRemStr)
@@ -167,6 +166,38 @@ func NewSecurityAdvisoryAlert(
 	}, nil
 }
 
+// CheckTemplates parses the summary template of the alert, which includes
+// the short failure message of the rule type, and renders it with sample
+// data.
+func CheckTemplates(ruleType *pb.RuleType) error {
+	sumT, err := parseSummaryTemplate(ruleType)
+	if err != nil {
+		return err
+	}
+
+	sample := templateParamsSA{
+		Profile:    "profile",
+		Rule:       ruleType.GetName(),
+		Repository: "owner/repo",
+		Severity:   "low",
+		Name:       ruleType.GetName(),
+	}
+	if err := sumT.Execute(io.Discard, sample); err != nil {
+		return fmt.Errorf("error executing summary template: %w", err)
+	}
+	return nil
+}
+
+func parseSummaryTemplate(ruleType *pb.RuleType) (*htmltemplate.Template, error) {
+	sumT, err := htmltemplate.New(tmplSummaryName).
+		Option("missingkey=error").
+		Parse(tmplSummary + " - " + ruleType.ShortFailureMessage)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse summary template: %w", err)
+	}
+	return sumT, nil
+}
+
 // Class returns the action type of the security-advisory engine
 func (alert *Alert) Class() interfaces.ActionType {
 	return alert.actionType
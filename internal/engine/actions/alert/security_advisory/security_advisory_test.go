@@ -112,3 +112,48 @@ func TestSecurityAdvisoryAlert(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckTemplates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		failureMessage string
+		wantErr        string
+	}{
+		{
+			name:           "plain message",
+			failureMessage: "This is a failure message",
+		},
+		{
+			name:           "message using the template parameters",
+			failureMessage: "{{ .Rule }} failed on {{ .Repository }}",
+		},
+		{
+			name:           "unparseable message",
+			failureMessage: "{{ .Rule ",
+			wantErr:        "cannot parse summary template",
+		},
+		{
+			name:           "unknown field",
+			failureMessage: "{{ .Nope }}",
+			wantErr:        "error executing summary template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckTemplates(&pb.RuleType{
+				Name:                "rule_type_1",
+				ShortFailureMessage: tt.failureMessage,
+			})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+)
+
+// slackMessage builds a Slack message out of blocks. The text field
+// is used by Slack for notifications, so it repeats the header.
+func slackMessage(p *Payload) map[string]any {
+	title := payloadTitle(p)
+	blocks := []map[string]any{
+		{
+			"type": "header",
+			"text": map[string]any{"type": "plain_text", "text": title},
+		},
+		{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": p.Message},
+		},
+	}
+	if p.Details != "" && p.Event == EventOpened {
+		blocks = append(blocks, map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": "```" + p.Details + "```"},
+		})
+	}
+	blocks = append(blocks, map[string]any{
+		"type": "context",
+		"elements": []map[string]any{
+			{"type": "mrkdwn", "text": fmt.Sprintf("*Profile:* %s", p.Profile)},
+			{"type": "mrkdwn", "text": fmt.Sprintf("*Rule:* %s", p.RuleName)},
+			{"type": "mrkdwn", "text": fmt.Sprintf("*Severity:* %s", p.Severity)},
+			{"type": "mrkdwn", "text": fmt.Sprintf("*Alert:* %s", p.AlertID)},
+		},
+	})
+
+	return map[string]any{
+		"text":   title,
+		"blocks": blocks,
+	}
+}
+
+// teamsMessage builds a Microsoft Teams message with an adaptive card
+func teamsMessage(p *Payload) map[string]any {
+	body := []map[string]any{
+		{
+			"type":   "TextBlock",
+			"size":   "Medium",
+			"weight": "Bolder",
+			"text":   payloadTitle(p),
+			"wrap":   true,
+		},
+		{
+			"type": "TextBlock",
+			"text": p.Message,
+			"wrap": true,
+		},
+	}
+	if p.Details != "" && p.Event == EventOpened {
+		body = append(body, map[string]any{
+			"type":     "TextBlock",
+			"text":     p.Details,
+			"fontType": "Monospace",
+			"wrap":     true,
+		})
+	}
+	body = append(body, map[string]any{
+		"type": "FactSet",
+		"facts": []map[string]any{
+			{"title": "Profile", "value": p.Profile},
+			{"title": "Rule", "value": p.RuleName},
+			{"title": "Severity", "value": p.Severity},
+			{"title": "Alert", "value": p.AlertID},
+		},
+	})
+
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content": map[string]any{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body":    body,
+				},
+			},
+		},
+	}
+}
+
+func payloadTitle(p *Payload) string {
+	if p.Event == EventResolved {
+		return fmt.Sprintf("Resolved: %s on %s", p.RuleType, p.Entity.Name)
+	}
+	return fmt.Sprintf("Minder alert: %s failed on %s", p.RuleType, p.Entity.Name)
+}
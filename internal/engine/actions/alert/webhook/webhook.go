@@ -0,0 +1,447 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook provides necessary interfaces and implementations for
+// posting alerts to Slack, Microsoft Teams or generic JSON webhooks.
+package webhook
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/alertwebhooks"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const (
+	// AlertType is the type of the webhook alert engine
+	AlertType = "webhook"
+
+	// FormatSlack posts a Slack message built out of blocks
+	FormatSlack = "slack"
+	// FormatTeams posts a Microsoft Teams message with an adaptive card
+	FormatTeams = "teams"
+	// FormatJSON posts the plain JSON payload
+	FormatJSON = "json"
+
+	// SignatureHeader is the header carrying the HMAC signature of the payload
+	SignatureHeader = "X-Minder-Signature"
+
+	// EventOpened is sent when the alert is opened
+	EventOpened = "opened"
+	// EventResolved is sent when the alert is resolved
+	EventResolved = "resolved"
+
+	// MessageMaxLength is the maximum length of the rendered message
+	MessageMaxLength = 4096
+
+	requestTimeout = 10 * time.Second
+	// maxErrorBodyLength is how much of an error response we keep for the logs
+	maxErrorBodyLength = 512
+)
+
+// Alert is the structure backing the webhook alert action
+type Alert struct {
+	actionType interfaces.ActionType
+	ruleType   *pb.RuleType
+	whCfg      *pb.RuleType_Definition_Alert_AlertTypeWebhook
+	resolver   alertwebhooks.Resolver
+	cli        *http.Client
+	msgTmpl    *util.SafeTemplate
+	setting    models.ActionOpt
+}
+
+// MessageTemplateParams is the parameters for the message template
+type MessageTemplateParams struct {
+	// EvalErrorDetails is the details of the error that occurred during evaluation, which may be empty
+	EvalErrorDetails string
+
+	// EvalResultOutput is the output of the evaluation, which may be empty
+	EvalResultOutput any
+
+	// Profile is the name of the profile
+	Profile string
+
+	// RuleType is the name of the rule type
+	RuleType string
+
+	// RuleName is the name of the rule in the profile
+	RuleName string
+
+	// Entity is the name of the entity
+	Entity string
+
+	// Severity is the severity of the rule type
+	Severity string
+}
+
+// Payload is the generic JSON payload posted to the webhook
+type Payload struct {
+	Event    string        `json:"event"`
+	AlertID  string        `json:"alert_id"`
+	RuleType string        `json:"rule_type"`
+	RuleName string        `json:"rule_name"`
+	Profile  string        `json:"profile"`
+	Entity   PayloadEntity `json:"entity"`
+	Severity string        `json:"severity"`
+	Message  string        `json:"message"`
+	Details  string        `json:"details,omitempty"`
+	Time     time.Time     `json:"time"`
+}
+
+// PayloadEntity is the entity an alert refers to
+type PayloadEntity struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type paramsWebhook struct {
+	Payload    Payload
+	Metadata   *alertMetadata
+	prevStatus *db.ListRuleEvaluationsByProfileIdRow
+}
+
+type alertMetadata struct {
+	AlertID  string     `json:"alert_id,omitempty"`
+	Webhook  string     `json:"webhook,omitempty"`
+	OpenedAt *time.Time `json:"opened_at,omitempty"`
+}
+
+// NewWebhookAlert creates a new webhook alert action
+func NewWebhookAlert(
+	actionType interfaces.ActionType,
+	ruleType *pb.RuleType,
+	whCfg *pb.RuleType_Definition_Alert_AlertTypeWebhook,
+	resolver alertwebhooks.Resolver,
+	setting models.ActionOpt,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	switch whCfg.GetFormat() {
+	case "", FormatSlack, FormatTeams, FormatJSON:
+	default:
+		return nil, fmt.Errorf("unknown webhook format: %s", whCfg.GetFormat())
+	}
+
+	msg := cmp.Or(whCfg.GetMessage(), ruleType.GetShortFailureMessage(),
+		"Rule {{.RuleName}} of profile {{.Profile}} failed on {{.Entity}}")
+	msgTmpl, err := util.NewSafeTextTemplate(&msg, "message")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse webhook message template: %w", err)
+	}
+
+	return &Alert{
+		actionType: actionType,
+		ruleType:   ruleType,
+		whCfg:      whCfg,
+		resolver:   resolver,
+		cli:        &http.Client{Timeout: requestTimeout},
+		msgTmpl:    msgTmpl,
+		setting:    setting,
+	}, nil
+}
+
+// Class returns the action type of the webhook alert engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the webhook alert engine
+func (*Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (alert *Alert) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(alert.setting, models.ActionOptOff)
+}
+
+// Do posts the alert to the webhook
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	p, err := alert.getParamsForWebhook(ctx, entity, params, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting parameters for webhook: %w", err)
+	}
+
+	// Process the command based on the action setting
+	switch alert.setting {
+	case models.ActionOptOn:
+		return alert.run(ctx, p, cmd)
+	case models.ActionOptDryRun:
+		return alert.runDry(ctx, p, cmd)
+	case models.ActionOptOff, models.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+func (alert *Alert) run(ctx context.Context, params *paramsWebhook, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("webhook", alert.whCfg.GetWebhook()).
+		Str("entity", params.Payload.Entity.Name).
+		Logger()
+
+	// Process the command
+	switch cmd {
+	// Open the alert, unless it is already open
+	case interfaces.ActionCmdOn:
+		if params.Metadata != nil && params.Metadata.AlertID != "" {
+			logger.Debug().Str("alert_id", params.Metadata.AlertID).Msg("webhook alert already open")
+			return json.Marshal(params.Metadata)
+		}
+
+		now := time.Now()
+		params.Payload.Event = EventOpened
+		params.Payload.AlertID = uuid.New().String()
+		params.Payload.Time = now
+		if err := alert.post(ctx, &params.Payload); err != nil {
+			return nil, fmt.Errorf("error posting webhook alert: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		logger.Info().Str("alert_id", params.Payload.AlertID).Msg("webhook alert opened")
+
+		newMeta, err := json.Marshal(alertMetadata{
+			AlertID:  params.Payload.AlertID,
+			Webhook:  alert.whCfg.GetWebhook(),
+			OpenedAt: &now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+		}
+		return newMeta, nil
+	// Resolve the alert, if we opened one
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.AlertID == "" {
+			// Nothing was sent, so there is nothing to resolve
+			return nil, fmt.Errorf("no webhook alert ID provided: %w", enginerr.ErrActionTurnedOff)
+		}
+
+		params.Payload.Event = EventResolved
+		params.Payload.AlertID = params.Metadata.AlertID
+		params.Payload.Time = time.Now()
+		if err := alert.post(ctx, &params.Payload); err != nil {
+			return nil, fmt.Errorf("error posting webhook alert: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		logger.Info().Str("alert_id", params.Metadata.AlertID).Msg("webhook alert resolved")
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDry runs the webhook action in dry run mode, which logs the payload that would be posted
+func (alert *Alert) runDry(ctx context.Context, params *paramsWebhook, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	// Process the command
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		params.Payload.Event = EventOpened
+		body, err := alert.buildBody(&params.Payload)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info().Msgf("dry run: post the following payload to webhook %s: %s",
+			alert.whCfg.GetWebhook(), body)
+		return nil, nil
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.AlertID == "" {
+			// We cannot do anything without the alert ID, so we assume that turning the alert off is a success
+			return nil, fmt.Errorf("no webhook alert ID provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		logger.Info().Msgf("dry run: resolve alert %s on webhook %s",
+			params.Metadata.AlertID, alert.whCfg.GetWebhook())
+	case interfaces.ActionCmdDoNothing:
+		// Return the previous alert status.
+		return alert.runDoNothing(ctx, params)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDoNothing returns the previous alert status
+func (*Alert) runDoNothing(ctx context.Context, params *paramsWebhook) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().Str("entity", params.Payload.Entity.Name).Logger()
+
+	logger.Debug().Msg("Running do nothing")
+
+	// Return the previous alert status.
+	err := dbadapter.AlertStatusAsError(params.prevStatus)
+	// If there is a valid alert metadata, return it too
+	if params.prevStatus != nil {
+		return params.prevStatus.AlertMetadata, err
+	}
+	// If there is no alert metadata, return nil as the metadata and the error
+	return nil, err
+}
+
+// post resolves the webhook and posts the payload to it
+func (alert *Alert) post(ctx context.Context, payload *Payload) error {
+	endpoint, err := alert.resolver.Resolve(ctx, alert.whCfg.GetWebhook())
+	if err != nil {
+		return fmt.Errorf("cannot resolve webhook %s: %w", alert.whCfg.GetWebhook(), err)
+	}
+
+	body, err := alert.buildBody(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("cannot create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if endpoint.SigningSecret != "" {
+		req.Header.Set(SignatureHeader, Sign(endpoint.SigningSecret, body))
+	}
+
+	resp, err := alert.cli.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+		return fmt.Errorf("webhook returned status %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// buildBody renders the payload in the configured format
+func (alert *Alert) buildBody(payload *Payload) ([]byte, error) {
+	var out any
+	switch alert.whCfg.GetFormat() {
+	case FormatSlack:
+		out = slackMessage(payload)
+	case FormatTeams:
+		out = teamsMessage(payload)
+	default:
+		out = payload
+	}
+
+	body, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal webhook payload: %w", err)
+	}
+	return body, nil
+}
+
+// Sign returns the signature of the payload, in the form sent in the signature header
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// getParamsForWebhook extracts the details from the entity
+func (alert *Alert) getParamsForWebhook(
+	ctx context.Context,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (*paramsWebhook, error) {
+	logger := zerolog.Ctx(ctx)
+	result := &paramsWebhook{
+		prevStatus: params.GetEvalStatusFromDb(),
+	}
+
+	entType, entName := getEntityTypeAndName(entity)
+	if entType == "" {
+		return nil, fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+	}
+
+	tmplParams := &MessageTemplateParams{
+		EvalErrorDetails: dbadapter.ErrorAsEvalDetails(params.GetEvalErr()),
+		Profile:          params.GetProfile().Name,
+		RuleType:         alert.ruleType.GetName(),
+		RuleName:         params.GetRule().Name,
+		Entity:           entName,
+		Severity:         alert.getSeverityString(),
+	}
+	if params.GetEvalResult() != nil {
+		tmplParams.EvalResultOutput = params.GetEvalResult().Output
+	}
+
+	msg, err := alert.msgTmpl.Render(ctx, tmplParams, MessageMaxLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute message template: %w", err)
+	}
+
+	result.Payload = Payload{
+		RuleType: tmplParams.RuleType,
+		RuleName: tmplParams.RuleName,
+		Profile:  tmplParams.Profile,
+		Entity: PayloadEntity{
+			Type: entType,
+			Name: entName,
+		},
+		Severity: tmplParams.Severity,
+		Message:  msg,
+		Details:  tmplParams.EvalErrorDetails,
+	}
+
+	// Unmarshal the existing alert metadata, if any
+	if metadata != nil {
+		meta := &alertMetadata{}
+		err := json.Unmarshal(*metadata, meta)
+		if err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			logger.Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		} else {
+			result.Metadata = meta
+		}
+	}
+
+	return result, nil
+}
+
+func (alert *Alert) getSeverityString() string {
+	sev := alert.ruleType.GetSeverity().GetValue().Enum().AsString()
+	if sev == "" {
+		return "unknown"
+	}
+	return sev
+}
+
+func getEntityTypeAndName(entity protoreflect.ProtoMessage) (string, string) {
+	switch inner := entity.(type) {
+	case *pbinternal.PullRequest:
+		return "pull_request", fmt.Sprintf("%s/%s#%d", inner.RepoOwner, inner.RepoName, inner.Number)
+	case *pb.Repository:
+		return "repository", fmt.Sprintf("%s/%s", inner.Owner, inner.Name)
+	case *pb.Artifact:
+		return "artifact", fmt.Sprintf("%s/%s (%s)", inner.Owner, inner.Name, inner.Type)
+	default:
+		return "", ""
+	}
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/alertwebhooks"
+	"github.com/mindersec/minder/internal/db"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	enginerr "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const (
+	testActionType engif.ActionType = "alert-test"
+	testSecret                      = "test-secret"
+	openAlertID                     = "5f2a3c1e-1111-4222-8333-444455556666"
+)
+
+type fakeResolver struct {
+	endpoint *alertwebhooks.Endpoint
+	err      error
+}
+
+func (f *fakeResolver) Resolve(_ context.Context, _ string) (*alertwebhooks.Endpoint, error) {
+	return f.endpoint, f.err
+}
+
+type recorder struct {
+	mu       sync.Mutex
+	bodies   [][]byte
+	sigs     []string
+	respCode int
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	r.bodies = append(r.bodies, body)
+	r.sigs = append(r.sigs, req.Header.Get(SignatureHeader))
+	r.mu.Unlock()
+	w.WriteHeader(r.respCode)
+}
+
+func TestWebhookAlert(t *testing.T) {
+	t.Parallel()
+
+	openMeta := json.RawMessage(`{"alert_id":"` + openAlertID + `","webhook":"team"}`)
+
+	tests := []struct {
+		name          string
+		cmd           engif.ActionCmd
+		format        string
+		inputMetadata *json.RawMessage
+		respCode      int
+		resolveErr    error
+		wantPosts     int
+		wantEvent     string
+		wantErr       error
+		wantMeta      bool
+	}{
+		{
+			name:      "opens an alert",
+			cmd:       engif.ActionCmdOn,
+			respCode:  http.StatusOK,
+			wantPosts: 1,
+			wantEvent: EventOpened,
+			wantMeta:  true,
+		},
+		{
+			name:          "does not resend an open alert",
+			cmd:           engif.ActionCmdOn,
+			inputMetadata: &openMeta,
+			respCode:      http.StatusOK,
+			wantMeta:      true,
+		},
+		{
+			name:          "resolves an open alert",
+			cmd:           engif.ActionCmdOff,
+			inputMetadata: &openMeta,
+			respCode:      http.StatusOK,
+			wantPosts:     1,
+			wantEvent:     EventResolved,
+			wantErr:       enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:     "nothing to resolve",
+			cmd:      engif.ActionCmdOff,
+			respCode: http.StatusOK,
+			wantErr:  enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:      "webhook returns an error",
+			cmd:       engif.ActionCmdOn,
+			respCode:  http.StatusBadRequest,
+			wantPosts: 1,
+			wantErr:   enginerr.ErrActionFailed,
+		},
+		{
+			name:       "webhook cannot be resolved",
+			cmd:        engif.ActionCmdOn,
+			resolveErr: alertwebhooks.ErrAlertWebhookNotFound,
+			wantErr:    enginerr.ErrActionFailed,
+		},
+		{
+			name:      "slack format",
+			cmd:       engif.ActionCmdOn,
+			format:    FormatSlack,
+			respCode:  http.StatusOK,
+			wantPosts: 1,
+			wantMeta:  true,
+		},
+		{
+			name:      "teams format",
+			cmd:       engif.ActionCmdOn,
+			format:    FormatTeams,
+			respCode:  http.StatusAccepted,
+			wantPosts: 1,
+			wantMeta:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := &recorder{respCode: tt.respCode}
+			srv := httptest.NewServer(rec)
+			t.Cleanup(srv.Close)
+
+			resolver := &fakeResolver{
+				endpoint: &alertwebhooks.Endpoint{Name: "team", URL: srv.URL, SigningSecret: testSecret},
+				err:      tt.resolveErr,
+			}
+			ruleType := &pb.RuleType{
+				Name:                "test-rule-type",
+				ShortFailureMessage: "{{ .RuleName }} failed on {{ .Entity }}",
+			}
+			format := tt.format
+			alert, err := NewWebhookAlert(testActionType, ruleType, &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Webhook: "team",
+				Format:  &format,
+			}, resolver, models.ActionOptOn)
+			require.NoError(t, err)
+
+			evalParams := &engif.EvalStatusParams{
+				EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{},
+				Profile:          &models.ProfileAggregate{Name: "test-profile"},
+				Rule:             &models.RuleInstance{Name: "test-rule"},
+			}
+			evalParams.SetEvalErr(enginerr.NewErrEvaluationFailed("evaluation failure reason"))
+
+			repo := &pb.Repository{Owner: "mindersec", Name: "minder"}
+			meta, err := alert.Do(context.Background(), tt.cmd, repo, evalParams, tt.inputMetadata)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantMeta {
+				require.NotNil(t, meta)
+				am := &alertMetadata{}
+				require.NoError(t, json.Unmarshal(meta, am))
+				assert.NotEmpty(t, am.AlertID)
+			} else {
+				assert.Nil(t, meta)
+			}
+
+			require.Len(t, rec.bodies, tt.wantPosts)
+			if tt.wantPosts == 0 {
+				return
+			}
+			assert.Equal(t, Sign(testSecret, rec.bodies[0]), rec.sigs[0])
+
+			out := map[string]any{}
+			require.NoError(t, json.Unmarshal(rec.bodies[0], &out))
+			switch tt.format {
+			case FormatSlack:
+				assert.Contains(t, out["text"], "test-rule-type failed on mindersec/minder")
+				assert.NotEmpty(t, out["blocks"])
+			case FormatTeams:
+				assert.Equal(t, "message", out["type"])
+				assert.NotEmpty(t, out["attachments"])
+			default:
+				if tt.wantEvent != "" {
+					assert.Equal(t, tt.wantEvent, out["event"])
+				}
+				assert.Equal(t, "test-rule failed on mindersec/minder", out["message"])
+				assert.Equal(t, "evaluation failure reason", out["details"])
+				assert.Equal(t, map[string]any{"type": "repository", "name": "mindersec/minder"}, out["entity"])
+				if tt.wantEvent == EventResolved {
+					assert.Equal(t, openAlertID, out["alert_id"])
+				}
+			}
+		})
+	}
+}
+
+func TestNewWebhookAlertInvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	format := "xml"
+	_, err := NewWebhookAlert(testActionType, &pb.RuleType{}, &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+		Webhook: "team",
+		Format:  &format,
+	}, &fakeResolver{}, models.ActionOptOn)
+	require.ErrorContains(t, err, "unknown webhook format")
+}
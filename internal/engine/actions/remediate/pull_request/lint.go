@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mikefarah/yq/v4/pkg/yqlib"
+
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// TemplateError is a problem with one of the templates of a pull request
+// remediation
+type TemplateError struct {
+	// Field is the path of the template in the remediation, e.g. contents[0].path
+	Field string
+	Err   error
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Err)
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// CheckTemplates parses the templates of a pull request remediation, and
+// renders them with the given data, without cloning or changing anything.
+// Templates using the output of the evaluation are only parsed, since the
+// output is not known before evaluating the rule. For the minder.yq.evaluate
+// method, the rendered expression is parsed as well.
+func CheckTemplates(
+	ctx context.Context,
+	prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation,
+	data *PrTemplateParams,
+) []*TemplateError {
+	var problems []*TemplateError
+	check := func(field string, tmpl string, html bool, limit int) string {
+		var t *util.SafeTemplate
+		var err error
+		if html {
+			t, err = util.NewSafeHTMLTemplate(&tmpl, field)
+		} else {
+			t, err = util.NewSafeTextTemplate(&tmpl, field)
+		}
+		if err != nil {
+			problems = append(problems, &TemplateError{Field: field, Err: err})
+			return ""
+		}
+		if usesEvalResult(tmpl) {
+			return ""
+		}
+		out, err := t.Render(ctx, data, limit)
+		if err != nil {
+			problems = append(problems, &TemplateError{Field: field, Err: err})
+			return ""
+		}
+		return out
+	}
+
+	check("title", prCfg.GetTitle(), true, TitleMaxLength)
+	check("body", prCfg.GetBody(), true, BodyMaxLength)
+
+	switch getMethod(prCfg) {
+	case minderContentModification:
+		for i, cnt := range prCfg.GetContents() {
+			check(fmt.Sprintf("contents[%d].path", i), cnt.GetPath(), false, PathBytesLimit)
+			check(fmt.Sprintf("contents[%d].content", i), cnt.GetContent(), false, ContentBytesLimit)
+		}
+	case minderYQEvaluate:
+		conf, err := parseYqExecuteConfig(prCfg)
+		if err != nil {
+			return append(problems, &TemplateError{Field: "params", Err: err})
+		}
+		for i, pattern := range conf.Patterns {
+			if pattern.Type != string(patternTypeGlob) {
+				problems = append(problems, &TemplateError{
+					Field: fmt.Sprintf("params.patterns[%d].type", i),
+					Err:   fmt.Errorf("unsupported pattern type %q", pattern.Type),
+				})
+			}
+		}
+		// the expression is empty as well when it can't be rendered
+		expression := check("params.expression", conf.Expression, false, maxExpressionSize)
+		if expression == "" {
+			break
+		}
+		if _, err := yqlib.ExpressionParser.ParseExpression(expression); err != nil {
+			problems = append(problems, &TemplateError{
+				Field: "params.expression",
+				Err:   fmt.Errorf("cannot parse expression: %w", err),
+			})
+		}
+	}
+
+	return problems
+}
+
+func usesEvalResult(tmpl string) bool {
+	return strings.Contains(tmpl, ".EvalResultOutput")
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pull_request
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCheckTemplates(t *testing.T) {
+	t.Parallel()
+
+	yqParams := func(expression string, patternType string) *structpb.Struct {
+		t.Helper()
+		params, err := structpb.NewStruct(map[string]any{
+			"expression": expression,
+			"patterns": []any{
+				map[string]any{"pattern": ".github/workflows/*.yml", "type": patternType},
+			},
+		})
+		require.NoError(t, err)
+		return params
+	}
+
+	tests := []struct {
+		name       string
+		prCfg      *pb.RuleType_Definition_Remediate_PullRequestRemediation
+		wantFields []string
+	}{
+		{
+			name: "valid content remediation",
+			prCfg: &pb.RuleType_Definition_Remediate_PullRequestRemediation{
+				Title: "Add {{ .Profile.filename }}",
+				Body:  "Adds {{ .Profile.filename }} to {{ .Entity.Name }}",
+				Contents: []*pb.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+					{Path: "{{ .Profile.filename }}", Content: "{{ .Params.content }}"},
+				},
+			},
+		},
+		{
+			name: "broken content templates",
+			prCfg: &pb.RuleType_Definition_Remediate_PullRequestRemediation{
+				Title: "Add {{ .Profile.filename",
+				Body:  "Adds {{ .Profile.missing }}",
+				Contents: []*pb.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+					{Path: "{{ .Profile.filename }}", Content: "{{ .Nope }}"},
+				},
+			},
+			wantFields: []string{"title", "body", "contents[0].content"},
+		},
+		{
+			name: "evaluation output is only parsed",
+			prCfg: &pb.RuleType_Definition_Remediate_PullRequestRemediation{
+				Title: "Fix",
+				Body:  "{{ .EvalResultOutput.whatever }}",
+			},
+		},
+		{
+			name: "valid yq remediation",
+			prCfg: &pb.RuleType_Definition_Remediate_PullRequestRemediation{
+				Title:  "Fix",
+				Body:   "Fix",
+				Method: minderYQEvaluate,
+				Params: yqParams(`.on.push.branches = ["{{ .Profile.filename }}"]`, "glob"),
+			},
+		},
+		{
+			name: "broken yq remediation",
+			prCfg: &pb.RuleType_Definition_Remediate_PullRequestRemediation{
+				Title:  "Fix",
+				Body:   "Fix",
+				Method: minderYQEvaluate,
+				Params: yqParams(`.on |= (`, "regex"),
+			},
+			wantFields: []string{"params.patterns[0].type", "params.expression"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &PrTemplateParams{
+				Entity:  &pb.Repository{Name: "repo"},
+				Profile: map[string]any{"filename": "LICENSE"},
+				Params:  map[string]any{"content": "MIT"},
+			}
+			problems := CheckTemplates(context.Background(), tt.prCfg, data)
+
+			fields := make([]string, 0, len(problems))
+			for _, p := range problems {
+				fields = append(fields, p.Field)
+			}
+			require.ElementsMatch(t, tt.wantFields, fields)
+		})
+	}
+}
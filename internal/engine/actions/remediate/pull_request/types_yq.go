@@ -18,6 +18,7 @@ import (
 
 	"github.com/mindersec/minder/internal/engine/interfaces"
 	textutil "github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
 // TODO: document the YQ remediation model.
@@ -62,30 +63,38 @@ var _ modificationConstructor = newYqExecute
 func newYqExecute(
 	params *modificationConstructorParams,
 ) (fsModifier, error) {
+	conf, err := parseYqExecuteConfig(params.prCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &yqExecute{
+		fsChangeSet: fsChangeSet{
+			fs: params.bfs,
+		},
+
+		config: conf,
+	}, nil
+}
 
+func parseYqExecuteConfig(prCfg *pb.RuleType_Definition_Remediate_PullRequestRemediation) (yqExecuteConfig, error) {
 	confMap := make(map[string]any)
-	if params.prCfg.GetParams() != nil {
-		confMap = params.prCfg.Params.AsMap()
+	if prCfg.GetParams() != nil {
+		confMap = prCfg.Params.AsMap()
 	}
 
 	rawConfig, err := json.Marshal(confMap)
 	if err != nil {
-		return nil, fmt.Errorf("cannot marshal config")
+		return yqExecuteConfig{}, fmt.Errorf("cannot marshal config")
 	}
 
 	var conf yqExecuteConfig
 	err = json.Unmarshal(rawConfig, &conf)
 	if err != nil {
-		return nil, fmt.Errorf("cannot marshal config")
+		return yqExecuteConfig{}, fmt.Errorf("cannot marshal config")
 	}
 
-	return &yqExecute{
-		fsChangeSet: fsChangeSet{
-			fs: params.bfs,
-		},
-
-		config: conf,
-	}, nil
+	return conf, nil
 }
 
 // templateParams are the parameters for template expansion for the expression template
@@ -40,6 +40,7 @@ const (
 	EvalStatusError   EvalStatus = "error"
 	EvalStatusSkipped EvalStatus = "skipped"
 	EvalStatusPending EvalStatus = "pending"
+	EvalStatusWaived  EvalStatus = "waived"
 )
 
 // previousEval captures previous remediation and alert state.
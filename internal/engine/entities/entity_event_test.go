@@ -393,6 +393,34 @@ func TestEntityInfoWrapper_ToMessage(t *testing.T) {
 	}
 }
 
+func TestEntityInfoWrapper_ProfileIDsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	profileIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	eiw := NewEntityInfoWrapper().
+		WithProviderID(uuid.New()).
+		WithProjectID(uuid.New()).
+		WithRepository(&pb.Repository{
+			Owner:  "test",
+			RepoId: 123,
+		}).
+		WithID(uuid.New()).
+		WithProfileIDs(profileIDs)
+
+	msg, err := eiw.BuildMessage()
+	require.NoError(t, err)
+	require.Equal(t, profileIDs[0].String()+","+profileIDs[1].String(), msg.Metadata.Get(ProfileIDsEventKey))
+
+	parsed, err := ParseEntityEvent(msg)
+	require.NoError(t, err)
+	require.Equal(t, profileIDs, parsed.ProfileIDs)
+
+	msg.Metadata.Set(ProfileIDsEventKey, "not-a-uuid")
+	_, err = ParseEntityEvent(msg)
+	require.ErrorContains(t, err, "error parsing profile ID")
+}
+
 func TestEntityInfoWrapper_FailsWithoutProjectID(t *testing.T) {
 	t.Parallel()
 
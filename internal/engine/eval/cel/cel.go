@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cel provides the CEL rule evaluator
+package cel
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/engine/eval/templates"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// CELEvalType is the type of the CEL evaluator
+	CELEvalType = "cel"
+)
+
+const (
+	// IngestedVar is the variable holding the ingested object
+	IngestedVar = "ingested"
+	// ProfileVar is the variable holding the rule definition set in the profile
+	ProfileVar = "profile"
+	// ParamsVar is the variable holding the rule parameters set in the profile
+	ParamsVar = "params"
+	// PropertiesVar is the variable holding the entity's properties
+	PropertiesVar = "properties"
+)
+
+// defaultFailureMessage is used when neither the rule type nor the
+// failure_message expression provide a message
+const defaultFailureMessage = "denied"
+
+// Evaluator is the evaluator for CEL rules. The expressions are compiled
+// once when the evaluator is created and re-used for every evaluation.
+type Evaluator struct {
+	program             cel.Program
+	failureProgram      cel.Program
+	shortFailureMessage string
+}
+
+// NewEnv creates the CEL environment used to compile rule type expressions.
+// It is exported so that tooling (e.g. linters) can type-check expressions
+// the same way the evaluator does.
+func NewEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(IngestedVar, cel.DynType),
+		cel.Variable(ProfileVar, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(ParamsVar, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(PropertiesVar, cel.MapType(cel.StringType, cel.DynType)),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+		ext.Encoders(),
+	)
+}
+
+// NewCELEvaluator creates a new CEL evaluator
+func NewCELEvaluator(
+	cfg *minderv1.RuleType_Definition_Eval_CEL,
+	opts ...interfaces.Option,
+) (*Evaluator, error) {
+	if cfg == nil {
+		return nil, errors.New("config was missing")
+	}
+
+	env, err := NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("could not create CEL environment: %w", err)
+	}
+
+	prg, err := compile(env, cfg.GetDef(), cel.BoolType)
+	if err != nil {
+		return nil, fmt.Errorf("could not compile CEL definition: %w", err)
+	}
+
+	eval := &Evaluator{
+		program: prg,
+	}
+
+	if cfg.GetFailureMessage() != "" {
+		eval.failureProgram, err = compile(env, cfg.GetFailureMessage(), cel.StringType)
+		if err != nil {
+			return nil, fmt.Errorf("could not compile CEL failure message: %w", err)
+		}
+	}
+
+	for _, opt := range opts {
+		if err := opt(eval); err != nil {
+			return nil, err
+		}
+	}
+
+	return eval, nil
+}
+
+// compile parses and type-checks the expression, making sure that it
+// evaluates to the expected type.
+func compile(env *cel.Env, expr string, want *cel.Type) (cel.Program, error) {
+	if expr == "" {
+		return nil, errors.New("expression is empty")
+	}
+
+	ast, issues := env.Compile(expr)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if !ast.OutputType().IsExactType(want) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to %s, got %s", want, ast.OutputType())
+	}
+
+	return env.Program(ast)
+}
+
+// SetShortFailureMessage sets the message used when the rule fails and no
+// failure message expression is defined.
+func (e *Evaluator) SetShortFailureMessage(msg string) error {
+	e.shortFailureMessage = msg
+	return nil
+}
+
+// Eval implements the Evaluator interface.
+func (e *Evaluator) Eval(
+	ctx context.Context, pol map[string]any, entity protoreflect.ProtoMessage, res *interfaces.Ingested,
+) (*interfaces.EvaluationResult, error) {
+	return e.EvalWithParams(ctx, pol, nil, entity, res)
+}
+
+// EvalWithParams implements the ParamsEvaluator interface.
+func (e *Evaluator) EvalWithParams(
+	ctx context.Context,
+	pol map[string]any,
+	params map[string]any,
+	entity protoreflect.ProtoMessage,
+	res *interfaces.Ingested,
+) (*interfaces.EvaluationResult, error) {
+	var obj any
+	if res != nil {
+		obj = res.Object
+	}
+
+	ingested, err := toCELValue(obj)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert ingested data: %w", err)
+	}
+
+	vars := map[string]any{
+		IngestedVar:   ingested,
+		ProfileVar:    nonNilMap(pol),
+		ParamsVar:     nonNilMap(params),
+		PropertiesVar: entityProperties(entity),
+	}
+
+	out, _, err := e.program.ContextEval(ctx, vars)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating CEL expression: %w", err)
+	}
+
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return nil, engerrors.NewErrEvaluationFailed("CEL expression did not evaluate to a boolean: %v", out)
+	}
+
+	if allowed {
+		return &interfaces.EvaluationResult{}, nil
+	}
+
+	message, err := e.failureMessage(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interfaces.EvaluationResult{Output: message}, engerrors.NewDetailedErrEvaluationFailed(
+		templates.CelTemplate,
+		map[string]any{
+			"message":    message,
+			"entityName": getEntityName(entity),
+		},
+		"%s",
+		message,
+	)
+}
+
+func (e *Evaluator) failureMessage(ctx context.Context, vars map[string]any) (string, error) {
+	var message string
+	if e.failureProgram != nil {
+		out, _, err := e.failureProgram.ContextEval(ctx, vars)
+		if err != nil {
+			return "", fmt.Errorf("error evaluating CEL failure message: %w", err)
+		}
+		message, _ = out.Value().(string)
+	}
+
+	// The failure message expression takes precedence over the rule type's
+	// short failure message, which in turn takes precedence over the default.
+	return cmp.Or(message, e.shortFailureMessage, defaultFailureMessage), nil
+}
+
+// toCELValue converts the ingested object into a value made of maps, lists
+// and scalars which CEL knows how to navigate. Ingesters return all kinds
+// of Go types, so we normalize them through their JSON representation.
+func toCELValue(obj any) (any, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	var data []byte
+	var err error
+	if msg, ok := obj.(proto.Message); ok {
+		data, err = protojson.Marshal(msg)
+	} else {
+		data, err = json.Marshal(obj)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type propertiesFetcher interface {
+	GetProperties() *structpb.Struct
+}
+
+func entityProperties(entity protoreflect.ProtoMessage) map[string]any {
+	if inner, ok := entity.(propertiesFetcher); ok && inner.GetProperties() != nil {
+		return inner.GetProperties().AsMap()
+	}
+	return map[string]any{}
+}
+
+func nonNilMap(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
+
+func getEntityName(entity protoreflect.ProtoMessage) string {
+	switch inner := entity.(type) {
+	case *pbinternal.PullRequest:
+		return fmt.Sprintf("%s/%s#%d", inner.RepoOwner, inner.RepoName, inner.Number)
+	case *minderv1.Repository:
+		return fmt.Sprintf("%s/%s", inner.Owner, inner.Name)
+	case *minderv1.Artifact:
+		return fmt.Sprintf("%s/%s (%s)", inner.Owner, inner.Name, inner.Type)
+	default:
+		return ""
+	}
+}
+
+// WithShortFailureMessage returns an Option that sets the failure message used
+// when the rule does not define a failure_message expression.
+func WithShortFailureMessage(msg string) interfaces.Option {
+	return func(eval interfaces.Evaluator) error {
+		if e, ok := eval.(*Evaluator); ok {
+			return e.SetShortFailureMessage(msg)
+		}
+		return nil
+	}
+}
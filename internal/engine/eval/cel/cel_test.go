@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cel_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/engine/eval/cel"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+func TestNewCELEvaluatorErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     *minderv1.RuleType_Definition_Eval_CEL
+		errText string
+	}{
+		{
+			name:    "missing config",
+			cfg:     nil,
+			errText: "config was missing",
+		},
+		{
+			name:    "empty definition",
+			cfg:     &minderv1.RuleType_Definition_Eval_CEL{},
+			errText: "expression is empty",
+		},
+		{
+			name:    "syntax error",
+			cfg:     &minderv1.RuleType_Definition_Eval_CEL{Def: "ingested.foo =="},
+			errText: "could not compile CEL definition",
+		},
+		{
+			name:    "undeclared variable",
+			cfg:     &minderv1.RuleType_Definition_Eval_CEL{Def: "input.foo == 1"},
+			errText: "undeclared reference",
+		},
+		{
+			name:    "non-boolean definition",
+			cfg:     &minderv1.RuleType_Definition_Eval_CEL{Def: `"foo"`},
+			errText: "expression must evaluate to bool",
+		},
+		{
+			name: "non-string failure message",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def:            "true",
+				FailureMessage: ptr.Ptr("1 + 2"),
+			},
+			errText: "expression must evaluate to string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cel.NewCELEvaluator(tt.cfg)
+			assert.ErrorContains(t, err, tt.errText)
+		})
+	}
+}
+
+func TestCELEvaluatorEval(t *testing.T) {
+	t.Parallel()
+
+	entityProps, err := structpb.NewStruct(map[string]any{
+		"is_private": true,
+	})
+	require.NoError(t, err)
+	entity := &minderv1.Repository{
+		Owner:      "mindersec",
+		Name:       "minder",
+		Properties: entityProps,
+	}
+
+	tests := []struct {
+		name         string
+		cfg          *minderv1.RuleType_Definition_Eval_CEL
+		shortMessage string
+		profile      map[string]any
+		params       map[string]any
+		ingested     any
+		wantErr      bool
+		wantMessage  string
+	}{
+		{
+			name: "ingested data matches",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def: `ingested.data == "foo"`,
+			},
+			ingested: map[string]any{"data": "foo"},
+		},
+		{
+			name: "ingested data does not match",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def: `ingested.data == "foo"`,
+			},
+			ingested:    map[string]any{"data": "bar"},
+			wantErr:     true,
+			wantMessage: "denied",
+		},
+		{
+			name: "compare against profile and params",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def: `ingested.reviews >= profile.min_reviews && params.branch == "main"`,
+			},
+			profile:  map[string]any{"min_reviews": 2},
+			params:   map[string]any{"branch": "main"},
+			ingested: map[string]any{"reviews": 3},
+		},
+		{
+			name: "entity properties are exposed",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def: `properties.is_private == true`,
+			},
+		},
+		{
+			name: "short failure message is used as fallback",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def: `ingested.enabled`,
+			},
+			shortMessage: "feature is disabled",
+			ingested:     map[string]any{"enabled": false},
+			wantErr:      true,
+			wantMessage:  "feature is disabled",
+		},
+		{
+			name: "failure message expression takes precedence",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def:            `ingested.reviews >= profile.min_reviews`,
+				FailureMessage: ptr.Ptr(`"expected " + string(profile.min_reviews) + " reviews"`),
+			},
+			shortMessage: "not enough reviews",
+			profile:      map[string]any{"min_reviews": 2},
+			ingested:     map[string]any{"reviews": 1},
+			wantErr:      true,
+			wantMessage:  "expected 2 reviews",
+		},
+		{
+			name: "structs are converted through JSON",
+			cfg: &minderv1.RuleType_Definition_Eval_CEL{
+				Def: `ingested.name == "minder"`,
+			},
+			ingested: struct {
+				Name string `json:"name"`
+			}{Name: "minder"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opts []interfaces.Option
+			if tt.shortMessage != "" {
+				opts = append(opts, cel.WithShortFailureMessage(tt.shortMessage))
+			}
+			e, err := cel.NewCELEvaluator(tt.cfg, opts...)
+			require.NoError(t, err)
+
+			res, err := e.EvalWithParams(context.Background(), tt.profile, tt.params, entity, &interfaces.Ingested{
+				Object: tt.ingested,
+			})
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+			assert.Equal(t, tt.wantMessage, res.Output)
+
+			var evalErr *engerrors.EvaluationError
+			require.ErrorAs(t, err, &evalErr)
+			assert.Contains(t, evalErr.Details(), tt.wantMessage)
+			assert.Contains(t, evalErr.Details(), "mindersec/minder")
+		})
+	}
+}
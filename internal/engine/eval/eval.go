@@ -10,8 +10,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/mindersec/minder/internal/engine/eval/cel"
 	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/application"
 	"github.com/mindersec/minder/internal/engine/eval/jq"
+	"github.com/mindersec/minder/internal/engine/eval/license"
 	"github.com/mindersec/minder/internal/engine/eval/rego"
 	"github.com/mindersec/minder/internal/engine/eval/trusty"
 	"github.com/mindersec/minder/internal/engine/eval/vulncheck"
@@ -32,7 +34,7 @@ func NewRuleEvaluator(
 	}
 
 	// TODO: make this more generic and/or use constants
-	// Note that the JQ and Rego evaluators get the data through ingestion.
+	// Note that the JQ, Rego and CEL evaluators get the data through ingestion.
 	switch ruletype.Def.Eval.Type {
 	case "jq":
 		if ruletype.Def.Eval.GetJq() == nil {
@@ -45,12 +47,22 @@ func NewRuleEvaluator(
 			opts = append(opts, rego.WithShortFailureMessage(ruletype.ShortFailureMessage))
 		}
 		return rego.NewRegoEvaluator(e.GetRego(), opts...)
+	case cel.CELEvalType:
+		if ruletype.ShortFailureMessage != "" {
+			opts = append(opts, cel.WithShortFailureMessage(ruletype.ShortFailureMessage))
+		}
+		return cel.NewCELEvaluator(e.GetCel(), opts...)
 	case vulncheck.VulncheckEvalType:
 		client, err := interfaces.As[vulncheck.GitHubRESTAndPRClient](provider)
 		if err != nil {
 			return nil, errors.New("provider does not implement github trait")
 		}
 		return vulncheck.NewVulncheckEvaluator(client, opts...)
+	case license.LicenseEvalType:
+		// the client is only needed to act on pull requests, so providers
+		// without the github trait can still check repository dependencies
+		client, _ := interfaces.As[license.GitHubPRClient](provider)
+		return license.NewLicenseEvaluator(client, opts...)
 	case trusty.TrustyEvalType:
 		client, err := interfaces.As[interfaces.GitHubIssuePRClient](provider)
 		if err != nil {
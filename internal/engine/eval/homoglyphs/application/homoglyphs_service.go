@@ -50,7 +50,8 @@ func NewHomoglyphsEvaluator(
 	}
 }
 
-// evaluateHomoglyphs is a helper function to evaluate the homoglyphs rule type
+// evaluateHomoglyphs is a helper function to evaluate the homoglyphs rule type.
+// The violations are only reviewed when the review handler is not nil.
 // Return parameters:
 // - bool: whether the evaluation has found violations
 // - error: an error if the evaluation failed
@@ -83,7 +84,9 @@ func evaluateHomoglyphs(
 
 	// Note: This is a mandatory step to reassign certain fields in the handler.
 	// This is a workaround to avoid recreating the object.
-	reviewHandler.Hydrate(ctx, prContents.Pr)
+	if reviewHandler != nil {
+		reviewHandler.Hydrate(ctx, prContents.Pr)
+	}
 
 	for _, file := range prContents.Files {
 		for _, line := range file.PatchLines {
@@ -100,6 +103,9 @@ func evaluateHomoglyphs(
 				commentBody.WriteString(processor.GetLineCommentText(v))
 			}
 
+			if reviewHandler == nil {
+				continue
+			}
 			reviewComment := &github.DraftReviewComment{
 				Path: github.String(file.Name),
 				Body: github.String(commentBody.String()),
@@ -110,7 +116,7 @@ func evaluateHomoglyphs(
 		}
 	}
 
-	if len(reviewHandler.GetComments()) > 0 {
+	if reviewHandler != nil && len(reviewHandler.GetComments()) > 0 {
 		return violationsList, reviewHandler.SubmitReview(ctx, processor.GetFailedReviewText())
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/communication"
 	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/domain"
 	"github.com/mindersec/minder/internal/engine/eval/templates"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
 	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
@@ -21,6 +22,14 @@ type InvisibleCharactersEvaluator struct {
 	reviewHandler *communication.GhReviewPrHandler
 }
 
+var _ eoptions.SupportsProfileOnly = (*InvisibleCharactersEvaluator)(nil)
+
+// SetProfileOnly makes the evaluator only report the violations, without
+// reviewing the pull request
+func (ice *InvisibleCharactersEvaluator) SetProfileOnly() {
+	ice.reviewHandler = nil
+}
+
 // NewInvisibleCharactersEvaluator creates a new invisible characters evaluator
 func NewInvisibleCharactersEvaluator(
 	_ context.Context,
@@ -12,6 +12,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/communication"
 	"github.com/mindersec/minder/internal/engine/eval/homoglyphs/domain"
 	"github.com/mindersec/minder/internal/engine/eval/templates"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
 	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
@@ -22,6 +23,14 @@ type MixedScriptsEvaluator struct {
 	reviewHandler *communication.GhReviewPrHandler
 }
 
+var _ eoptions.SupportsProfileOnly = (*MixedScriptsEvaluator)(nil)
+
+// SetProfileOnly makes the evaluator only report the violations, without
+// reviewing the pull request
+func (mse *MixedScriptsEvaluator) SetProfileOnly() {
+	mse.reviewHandler = nil
+}
+
 // NewMixedScriptEvaluator creates a new mixed scripts evaluator
 func NewMixedScriptEvaluator(
 	ctx context.Context,
@@ -0,0 +1,356 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"text/template"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/engine/eval/pr_actions"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+const (
+	commitStatusContext = "minder.stacklok.dev/pr-license"
+
+	violationsFoundTextShort = "Dependencies violate the license policy"
+	noViolationsFoundText    = "No license policy violations found"
+	reviewDismissText        = "Dismissing, superseded by a license check of a newer commit"
+
+	magicCommentPrefix = "<!-- minder: pr-license-review: "
+
+	//nolint:lll
+	reportTemplate = magicCommentPrefix + `{ "ContentSha": "{{ .CommitSHA }}" } -->
+
+### License policy violations
+
+Minder found dependencies in this pull request whose licenses don't comply with the license policy of this repository.
+
+| Package | Version | Ecosystem | License | Reason |
+| --- | --- | --- | --- | --- |
+{{ range .Violations -}}
+| ` + "`{{ .Name }}`" + ` | {{ .Version }} | {{ .Ecosystem }} | {{ if .License }}{{ .License }}{{ else }}unknown{{ end }} | {{ .Reason }} |
+{{ end }}`
+)
+
+var magicCommentRe = regexp.MustCompile(regexp.QuoteMeta(magicCommentPrefix) + `(\{.*?\}) -->`)
+
+type prStatusHandler interface {
+	trackViolation(v Violation)
+	submit(ctx context.Context) error
+}
+
+func newPrStatusHandler(
+	ctx context.Context,
+	action pr_actions.Action,
+	pr *pbinternal.PullRequest,
+	client GitHubPRClient,
+) (prStatusHandler, error) {
+	// dependencies of a repository aren't attached to a pull request, so
+	// the only thing to do is to report the evaluation status
+	if action == pr_actions.ActionProfileOnly || pr == nil {
+		return &profileOnlyPrHandler{}, nil
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("action %s needs a provider which implements the github trait", action)
+	}
+
+	switch action {
+	case pr_actions.ActionReviewPr:
+		return newReviewPrHandler(ctx, pr, client)
+	case pr_actions.ActionCommitStatus:
+		return newCommitStatusPrHandler(ctx, pr, client)
+	case pr_actions.ActionComment:
+		return newReviewPrHandler(ctx, pr, client, withFailStatus("COMMENT"))
+	case pr_actions.ActionSummary:
+		return newSummaryPrHandler(pr, client), nil
+	default:
+		return nil, fmt.Errorf("unknown action: %s", action)
+	}
+}
+
+// reviewPrHandler reports violations as a pull request review, which
+// requests changes unless configured otherwise. The review carries the
+// commit it was made on, so that later evaluations of the same commit don't
+// review it again, and evaluations of later commits can dismiss it.
+type reviewPrHandler struct {
+	cli GitHubPRClient
+	pr  *pbinternal.PullRequest
+
+	violations     []Violation
+	authorizedUser int64
+	failStatus     string
+
+	logger zerolog.Logger
+}
+
+type reviewPrHandlerOption func(*reviewPrHandler)
+
+// withFailStatus sets the review event used when violations are found
+func withFailStatus(status string) reviewPrHandlerOption {
+	return func(r *reviewPrHandler) {
+		r.failStatus = status
+	}
+}
+
+func newReviewPrHandler(
+	ctx context.Context,
+	pr *pbinternal.PullRequest,
+	cli GitHubPRClient,
+	opts ...reviewPrHandlerOption,
+) (*reviewPrHandler, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Int64("pull-number", pr.Number).
+		Str("repo-owner", pr.RepoOwner).
+		Str("repo-name", pr.RepoName).
+		Logger()
+	cliUserId, err := cli.GetUserId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get authenticated user: %w", err)
+	}
+
+	// GitHub doesn't let users request changes on their own pull requests
+	failStatus := "REQUEST_CHANGES"
+	if pr.AuthorId == cliUserId {
+		failStatus = "COMMENT"
+		logger.Debug().Msg("author is the same as the authenticated user, can only comment")
+	}
+
+	handler := &reviewPrHandler{
+		cli:            cli,
+		pr:             pr,
+		authorizedUser: cliUserId,
+		failStatus:     failStatus,
+		logger:         logger,
+	}
+
+	for _, opt := range opts {
+		opt(handler)
+	}
+
+	return handler, nil
+}
+
+func (ra *reviewPrHandler) trackViolation(v Violation) {
+	ra.violations = append(ra.violations, v)
+}
+
+func (ra *reviewPrHandler) submit(ctx context.Context) error {
+	previous, err := ra.findPreviousReview(ctx)
+	if err != nil {
+		return fmt.Errorf("could not find previous review: %w", err)
+	}
+
+	if previous != nil && reviewedSha(previous) == ra.pr.GetCommitSha() {
+		ra.logger.Debug().
+			Int64("review-id", previous.GetID()).
+			Msg("previous review was on the same commit, will keep it")
+		return nil
+	}
+
+	// the previous review is outdated either way, so it shouldn't keep
+	// blocking the pull request
+	if err := ra.dismissReview(ctx, previous); err != nil {
+		ra.logger.Warn().Err(err).Msg("could not dismiss previous review")
+	}
+
+	if len(ra.violations) == 0 {
+		return nil
+	}
+
+	body, err := renderReport(ra.pr.GetCommitSha(), ra.violations)
+	if err != nil {
+		return err
+	}
+
+	_, err = ra.cli.CreateReview(
+		ctx,
+		ra.pr.GetRepoOwner(),
+		ra.pr.GetRepoName(),
+		int(ra.pr.GetNumber()),
+		&github.PullRequestReviewRequest{
+			CommitID: github.String(ra.pr.GetCommitSha()),
+			Body:     github.String(body),
+			Event:    github.String(ra.failStatus),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not create review: %w", err)
+	}
+
+	return nil
+}
+
+func (ra *reviewPrHandler) findPreviousReview(ctx context.Context) (*github.PullRequestReview, error) {
+	reviews, err := ra.cli.ListReviews(ctx, ra.pr.GetRepoOwner(), ra.pr.GetRepoName(), int(ra.pr.GetNumber()),
+		&github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, fmt.Errorf("could not list reviews: %w", err)
+	}
+
+	// reviews are listed in chronological order, the last one is current
+	var previous *github.PullRequestReview
+	for _, review := range reviews {
+		if review.GetUser().GetID() == ra.authorizedUser && magicCommentRe.MatchString(review.GetBody()) {
+			previous = review
+		}
+	}
+
+	return previous, nil
+}
+
+func (ra *reviewPrHandler) dismissReview(ctx context.Context, review *github.PullRequestReview) error {
+	if review.GetState() != "CHANGES_REQUESTED" {
+		return nil
+	}
+
+	_, err := ra.cli.DismissReview(
+		ctx,
+		ra.pr.GetRepoOwner(),
+		ra.pr.GetRepoName(),
+		int(ra.pr.GetNumber()),
+		review.GetID(),
+		&github.PullRequestReviewDismissalRequest{Message: github.String(reviewDismissText)},
+	)
+	return err
+}
+
+// reviewedSha returns the commit a previous review was made on
+func reviewedSha(review *github.PullRequestReview) string {
+	matches := magicCommentRe.FindStringSubmatch(review.GetBody())
+	if len(matches) != 2 {
+		return ""
+	}
+
+	var info struct {
+		ContentSha string `json:"ContentSha"`
+	}
+	if err := json.Unmarshal([]byte(matches[1]), &info); err != nil {
+		return ""
+	}
+	return info.ContentSha
+}
+
+// commitStatusPrHandler sets a commit status which can be used to block
+// merging, and reviews the pull request with comments only.
+type commitStatusPrHandler struct {
+	// embed the reviewPrHandler to automatically satisfy the prStatusHandler interface
+	reviewPrHandler
+}
+
+func newCommitStatusPrHandler(
+	ctx context.Context,
+	pr *pbinternal.PullRequest,
+	client GitHubPRClient,
+) (prStatusHandler, error) {
+	rph, err := newReviewPrHandler(ctx, pr, client, withFailStatus("COMMENT"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create review handler: %w", err)
+	}
+
+	return &commitStatusPrHandler{
+		reviewPrHandler: *rph,
+	}, nil
+}
+
+func (csh *commitStatusPrHandler) submit(ctx context.Context) error {
+	if err := csh.reviewPrHandler.submit(ctx); err != nil {
+		csh.logger.Error().Err(err).Msg("could not submit review")
+		// the commit status is what blocks the pull request, so set it anyway
+	}
+
+	commitStatus := &github.RepoStatus{
+		Context:     github.String(commitStatusContext),
+		State:       github.String("success"),
+		Description: github.String(noViolationsFoundText),
+	}
+	if len(csh.violations) > 0 {
+		commitStatus.State = github.String("failure")
+		commitStatus.Description = github.String(violationsFoundTextShort)
+	}
+
+	if _, err := csh.cli.SetCommitStatus(
+		ctx, csh.pr.GetRepoOwner(), csh.pr.GetRepoName(), csh.pr.GetCommitSha(), commitStatus,
+	); err != nil {
+		return fmt.Errorf("could not set commit status: %w", err)
+	}
+
+	return nil
+}
+
+// summaryPrHandler adds the list of violations to the pull request as a comment
+type summaryPrHandler struct {
+	cli GitHubPRClient
+	pr  *pbinternal.PullRequest
+
+	violations []Violation
+}
+
+func newSummaryPrHandler(pr *pbinternal.PullRequest, cli GitHubPRClient) *summaryPrHandler {
+	return &summaryPrHandler{
+		cli: cli,
+		pr:  pr,
+	}
+}
+
+func (sph *summaryPrHandler) trackViolation(v Violation) {
+	sph.violations = append(sph.violations, v)
+}
+
+func (sph *summaryPrHandler) submit(ctx context.Context) error {
+	if len(sph.violations) == 0 {
+		return nil
+	}
+
+	summary, err := renderReport(sph.pr.GetCommitSha(), sph.violations)
+	if err != nil {
+		return err
+	}
+
+	_, err = sph.cli.CreateIssueComment(ctx, sph.pr.GetRepoOwner(), sph.pr.GetRepoName(), int(sph.pr.GetNumber()), summary)
+	if err != nil {
+		return fmt.Errorf("could not create comment: %w", err)
+	}
+
+	return nil
+}
+
+// profileOnlyPrHandler only reports the evaluation status
+type profileOnlyPrHandler struct{}
+
+func (profileOnlyPrHandler) trackViolation(Violation) {}
+
+func (profileOnlyPrHandler) submit(context.Context) error {
+	return nil
+}
+
+func renderReport(commitSha string, violations []Violation) (string, error) {
+	if len(violations) == 0 {
+		return "", errors.New("no violations to report")
+	}
+
+	tmpl, err := template.New("licenseReport").Option("missingkey=error").Parse(reportTemplate)
+	if err != nil {
+		return "", fmt.Errorf("could not parse report template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]any{
+		"CommitSHA":  commitSha,
+		"Violations": violations,
+	}); err != nil {
+		return "", fmt.Errorf("could not render report: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/mindersec/minder/internal/engine/eval/pr_actions"
+)
+
+const (
+	defaultAction           = pr_actions.ActionReviewPr
+	defaultMetadataEndpoint = "https://api.deps.dev/v3"
+)
+
+// config is the configuration for the license evaluator
+type config struct {
+	Action pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
+	// Allow lists the licenses dependencies may use. If empty, any license
+	// which is not denied is accepted.
+	Allow []string `json:"allow" mapstructure:"allow"`
+	// Deny lists the licenses dependencies may not use
+	Deny []string `json:"deny" mapstructure:"deny"`
+	// FailOnUnknown reports dependencies whose license can't be determined
+	FailOnUnknown bool `json:"fail_on_unknown" mapstructure:"fail_on_unknown"`
+	//nolint:lll
+	MetadataEndpoint string `json:"metadata_endpoint" mapstructure:"metadata_endpoint" validate:"required,url"`
+}
+
+func populateDefaultsIfEmpty(ruleCfg map[string]any) {
+	if ruleCfg["action"] == nil {
+		ruleCfg["action"] = defaultAction
+	}
+
+	if ruleCfg["metadata_endpoint"] == nil {
+		ruleCfg["metadata_endpoint"] = defaultMetadataEndpoint
+	}
+}
+
+func parseConfig(ruleCfg map[string]any) (*config, error) {
+	populateDefaultsIfEmpty(ruleCfg)
+
+	var conf config
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	if err := mapstructure.Decode(ruleCfg, &conf); err != nil {
+		return nil, fmt.Errorf("could not parse config: %w", err)
+	}
+
+	if err := validate.Struct(&conf); err != nil {
+		return nil, fmt.Errorf("config failed validation: %w", err)
+	}
+
+	if len(conf.Allow) == 0 && len(conf.Deny) == 0 && !conf.FailOnUnknown {
+		return nil, fmt.Errorf("config failed validation: at least one of allow, deny or fail_on_unknown must be set")
+	}
+
+	return &conf, nil
+}
+
+func (c *config) policy() *policy {
+	return &policy{allow: c.Allow, deny: c.Deny}
+}
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Reason explains why a dependency's license violates the policy
+type Reason string
+
+const (
+	// ReasonDenied means the license matches an entry in the deny list
+	ReasonDenied Reason = "denied"
+	// ReasonNotAllowed means the license doesn't match any entry in the allow list
+	ReasonNotAllowed Reason = "not_allowed"
+	// ReasonUnknown means the license could not be determined
+	ReasonUnknown Reason = "unknown"
+)
+
+var errInvalidExpression = errors.New("invalid SPDX expression")
+
+// licenses which SPDX and deps.dev use to say that nothing is known
+var unknownLicenses = map[string]bool{
+	"":             true,
+	"NOASSERTION":  true,
+	"NONE":         true,
+	"NON-STANDARD": true,
+	"UNKNOWN":      true,
+}
+
+// policy holds the allow and deny patterns configured in the profile.
+// Patterns are matched case-insensitively and may end in `*` to match
+// every license with that prefix, e.g. `GPL-*`.
+type policy struct {
+	allow []string
+	deny  []string
+}
+
+// check evaluates an SPDX license expression against the policy. It
+// returns an empty reason when the expression is acceptable, otherwise
+// the reason and the license that caused the violation.
+func (p *policy) check(expression string) (Reason, string) {
+	if unknownLicenses[strings.ToUpper(strings.TrimSpace(expression))] {
+		return ReasonUnknown, expression
+	}
+
+	tree, err := parseExpression(expression)
+	if err != nil {
+		return ReasonUnknown, expression
+	}
+
+	v := tree.eval(p)
+	return v.reason, v.license
+}
+
+func (p *policy) checkLicense(id string) verdict {
+	full := id
+	base, _, _ := strings.Cut(id, " WITH ")
+	if unknownLicenses[strings.ToUpper(base)] {
+		return verdict{reason: ReasonUnknown, license: full}
+	}
+	if matchesAny(p.deny, full) || matchesAny(p.deny, base) {
+		return verdict{reason: ReasonDenied, license: full}
+	}
+	if len(p.allow) > 0 && !matchesAny(p.allow, full) && !matchesAny(p.allow, base) {
+		return verdict{reason: ReasonNotAllowed, license: full}
+	}
+	return verdict{}
+}
+
+func matchesAny(patterns []string, id string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if len(id) >= len(prefix) && strings.EqualFold(id[:len(prefix)], prefix) {
+				return true
+			}
+		} else if strings.EqualFold(pattern, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// verdict is the outcome of evaluating (part of) an expression. The zero
+// value means the expression is acceptable.
+type verdict struct {
+	reason  Reason
+	license string
+}
+
+func (v verdict) ok() bool {
+	return v.reason == ""
+}
+
+// worse picks the verdict to report when neither is acceptable. An
+// explicitly denied license is more interesting than one which just
+// wasn't allowed, which in turn is more interesting than an unknown one.
+func worse(a, b verdict) verdict {
+	rank := map[Reason]int{ReasonDenied: 3, ReasonNotAllowed: 2, ReasonUnknown: 1}
+	if rank[b.reason] > rank[a.reason] {
+		return b
+	}
+	return a
+}
+
+type operator int
+
+const (
+	opLicense operator = iota
+	opAnd
+	opOr
+)
+
+// node is an element of a parsed SPDX expression
+type node struct {
+	op       operator
+	license  string
+	children []*node
+}
+
+func (n *node) eval(p *policy) verdict {
+	switch n.op {
+	case opAnd:
+		// every term must be acceptable
+		var failed verdict
+		for _, child := range n.children {
+			if v := child.eval(p); !v.ok() {
+				failed = worse(failed, v)
+			}
+		}
+		return failed
+	case opOr:
+		// the dependency can be used under any one of the terms
+		var failed verdict
+		for _, child := range n.children {
+			v := child.eval(p)
+			if v.ok() {
+				return v
+			}
+			failed = worse(failed, v)
+		}
+		return failed
+	default:
+		return p.checkLicense(n.license)
+	}
+}
+
+// parseExpression parses an SPDX license expression as described in
+// https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/
+// Operators are accepted in any case; WITH binds tighter than AND, which
+// binds tighter than OR.
+func parseExpression(expression string) (*node, error) {
+	p := &parser{tokens: tokenize(expression)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", errInvalidExpression)
+	}
+
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidExpression, p.tokens[p.pos])
+	}
+	return n, nil
+}
+
+func tokenize(expression string) []string {
+	expression = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expression)
+	return strings.Fields(expression)
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *parser) parseOr() (*node, error) {
+	return p.parseBinary(opOr, "OR", p.parseAnd)
+}
+
+func (p *parser) parseAnd() (*node, error) {
+	return p.parseBinary(opAnd, "AND", p.parseWith)
+}
+
+func (p *parser) parseBinary(op operator, keyword string, operand func() (*node, error)) (*node, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	children := []*node{first}
+	for strings.EqualFold(p.peek(), keyword) {
+		p.next()
+		n, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, n)
+	}
+
+	if len(children) == 1 {
+		return first, nil
+	}
+	return &node{op: op, children: children}, nil
+}
+
+func (p *parser) parseWith() (*node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(p.peek(), "WITH") {
+		return n, nil
+	}
+	p.next()
+	if n.op != opLicense {
+		return nil, fmt.Errorf("%w: WITH must follow a license", errInvalidExpression)
+	}
+	exception := p.next()
+	if !isIdentifier(exception) {
+		return nil, fmt.Errorf("%w: WITH must be followed by an exception", errInvalidExpression)
+	}
+	n.license = n.license + " WITH " + exception
+	return n, nil
+}
+
+func (p *parser) parsePrimary() (*node, error) {
+	tok := p.next()
+	switch {
+	case tok == "(":
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("%w: unbalanced parentheses", errInvalidExpression)
+		}
+		return n, nil
+	case isIdentifier(tok):
+		return &node{op: opLicense, license: tok}, nil
+	case tok == "":
+		return nil, fmt.Errorf("%w: unexpected end of expression", errInvalidExpression)
+	default:
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidExpression, tok)
+	}
+}
+
+func isIdentifier(tok string) bool {
+	if tok == "" || tok == "(" || tok == ")" {
+		return false
+	}
+	switch strings.ToUpper(tok) {
+	case "AND", "OR", "WITH":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"MIT",
+		"MIT OR Apache-2.0",
+		"(MIT OR Apache-2.0) AND BSD-3-Clause",
+		"GPL-2.0-only WITH Classpath-exception-2.0",
+		"mit or apache-2.0",
+		"LicenseRef-Proprietary",
+	} {
+		_, err := parseExpression(expr)
+		assert.NoError(t, err, expr)
+	}
+
+	for _, expr := range []string{
+		"",
+		"MIT OR",
+		"(MIT AND Apache-2.0",
+		"MIT Apache-2.0",
+		"(MIT OR Apache-2.0) WITH Classpath-exception-2.0",
+		"AND",
+	} {
+		_, err := parseExpression(expr)
+		assert.ErrorIs(t, err, errInvalidExpression, expr)
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		allow         []string
+		deny          []string
+		expression    string
+		expectReason  Reason
+		expectLicense string
+	}{
+		{
+			name:       "allowed",
+			allow:      []string{"MIT", "Apache-2.0"},
+			expression: "MIT",
+		},
+		{
+			name:          "not allowed",
+			allow:         []string{"MIT", "Apache-2.0"},
+			expression:    "MPL-2.0",
+			expectReason:  ReasonNotAllowed,
+			expectLicense: "MPL-2.0",
+		},
+		{
+			name:          "denied by wildcard",
+			deny:          []string{"GPL-*", "AGPL-*"},
+			expression:    "gpl-3.0-only",
+			expectReason:  ReasonDenied,
+			expectLicense: "gpl-3.0-only",
+		},
+		{
+			name:       "dual licensed with an acceptable choice",
+			deny:       []string{"GPL-*"},
+			expression: "GPL-2.0-only OR MIT",
+		},
+		{
+			name:          "every term of a conjunction must be acceptable",
+			deny:          []string{"GPL-*"},
+			expression:    "MIT AND (BSD-3-Clause OR GPL-2.0-only) AND GPL-3.0-only",
+			expectReason:  ReasonDenied,
+			expectLicense: "GPL-3.0-only",
+		},
+		{
+			name:          "denied takes priority over not allowed",
+			allow:         []string{"MIT"},
+			deny:          []string{"GPL-*"},
+			expression:    "MPL-2.0 OR GPL-3.0-only",
+			expectReason:  ReasonDenied,
+			expectLicense: "GPL-3.0-only",
+		},
+		{
+			name:       "exception allowed explicitly",
+			allow:      []string{"GPL-2.0-only WITH Classpath-exception-2.0"},
+			expression: "GPL-2.0-only WITH Classpath-exception-2.0",
+		},
+		{
+			name:          "exception doesn't lift a denied license",
+			deny:          []string{"GPL-2.0-only"},
+			expression:    "GPL-2.0-only WITH Classpath-exception-2.0",
+			expectReason:  ReasonDenied,
+			expectLicense: "GPL-2.0-only WITH Classpath-exception-2.0",
+		},
+		{
+			name:          "no assertion",
+			deny:          []string{"GPL-*"},
+			expression:    "NOASSERTION",
+			expectReason:  ReasonUnknown,
+			expectLicense: "NOASSERTION",
+		},
+		{
+			name:          "unparseable",
+			deny:          []string{"GPL-*"},
+			expression:    "MIT OR",
+			expectReason:  ReasonUnknown,
+			expectLicense: "MIT OR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &policy{allow: tt.allow, deny: tt.deny}
+			reason, license := p.check(tt.expression)
+			require.Equal(t, tt.expectReason, reason)
+			if tt.expectReason != "" {
+				assert.Equal(t, tt.expectLicense, license)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package license provides an evaluator which checks the licenses of
+// dependencies against allowed and denied SPDX license expressions.
+package license
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/osv-scalibr/purl"
+	protobom "github.com/protobom/protobom/pkg/sbom"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/engine/eval/pr_actions"
+	"github.com/mindersec/minder/internal/engine/eval/templates"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	// LicenseEvalType is the type of the license evaluator
+	LicenseEvalType = "license"
+)
+
+// GitHubPRClient is the set of provider methods needed to act on pull requests
+type GitHubPRClient interface {
+	interfaces.GitHubIssuePRClient
+	interfaces.SelfAwareness
+}
+
+// Violation is a dependency whose license doesn't comply with the policy.
+// The list of violations is the output of the evaluation.
+type Violation struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Ecosystem string `json:"ecosystem"`
+	License   string `json:"license"`
+	Reason    Reason `json:"reason"`
+	File      string `json:"file,omitempty"`
+}
+
+// Evaluator is the license evaluator
+type Evaluator struct {
+	cli         GitHubPRClient
+	profileOnly bool
+}
+
+var _ eoptions.SupportsProfileOnly = (*Evaluator)(nil)
+
+// SetProfileOnly makes the evaluator only report the violations, without
+// acting on the pull request
+func (e *Evaluator) SetProfileOnly() {
+	e.profileOnly = true
+}
+
+// NewLicenseEvaluator creates a new license evaluator. The client is only
+// needed to act on pull requests, so it may be nil for providers which
+// can't, in which case only the profile_only action is available.
+func NewLicenseEvaluator(
+	ghcli GitHubPRClient,
+	opts ...interfaces.Option,
+) (*Evaluator, error) {
+	evaluator := &Evaluator{
+		cli: ghcli,
+	}
+
+	for _, opt := range opts {
+		if err := opt(evaluator); err != nil {
+			return nil, err
+		}
+	}
+
+	return evaluator, nil
+}
+
+// dependency is a dependency to check, regardless of the ingester it came from
+type dependency struct {
+	dep *pbinternal.Dependency
+	// license is the license expression declared in the ingested data, if any
+	license string
+	file    string
+}
+
+// Eval implements the Evaluator interface.
+func (e *Evaluator) Eval(
+	ctx context.Context,
+	pol map[string]any,
+	entity protoreflect.ProtoMessage,
+	res *interfaces.Ingested,
+) (*interfaces.EvaluationResult, error) {
+	ruleConfig, err := parseConfig(pol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	deps, pr, err := readDependencies(res, entity)
+	if err != nil {
+		return nil, err
+	}
+	if len(deps) == 0 {
+		return &interfaces.EvaluationResult{}, nil
+	}
+
+	if e.profileOnly {
+		ruleConfig.Action = pr_actions.ActionProfileOnly
+	}
+	prReplyHandler, err := newPrStatusHandler(ctx, ruleConfig.Action, pr, e.cli)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pr action: %w", err)
+	}
+
+	violations, err := checkLicenses(ctx, deps, ruleConfig, newResolver(ruleConfig.MetadataEndpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range violations {
+		prReplyHandler.trackViolation(v)
+	}
+
+	if err := prReplyHandler.submit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to submit pr action: %w", err)
+	}
+
+	if len(violations) == 0 {
+		return &interfaces.EvaluationResult{}, nil
+	}
+
+	names := make([]string, 0, len(violations))
+	for _, v := range violations {
+		names = append(names, v.Name)
+	}
+
+	return &interfaces.EvaluationResult{Output: violations}, evalerrors.NewDetailedErrEvaluationFailed(
+		templates.LicenseTemplate,
+		map[string]any{"violations": violations},
+		"dependencies violating the license policy: %s",
+		strings.Join(names, ","),
+	)
+}
+
+// checkLicenses resolves the license of every dependency and returns the
+// ones which violate the policy
+func checkLicenses(
+	ctx context.Context,
+	deps []dependency,
+	ruleConfig *config,
+	licenses *resolver,
+) ([]Violation, error) {
+	logger := zerolog.Ctx(ctx)
+	pol := ruleConfig.policy()
+
+	var violations []Violation
+	for _, d := range deps {
+		expression := d.license
+		if expression == "" {
+			var err error
+			expression, err = licenses.resolve(ctx, d.dep)
+			if errors.Is(err, errUnsupportedEcosystem) {
+				logger.Info().
+					Str("ecosystem", d.dep.GetEcosystem().AsString()).
+					Str("dependency", d.dep.GetName()).
+					Msg("Skipping dependency because its license can't be resolved")
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to resolve license of %s: %w", d.dep.GetName(), err)
+			}
+		}
+
+		reason, offending := pol.check(expression)
+		if reason == "" || (reason == ReasonUnknown && !ruleConfig.FailOnUnknown) {
+			continue
+		}
+
+		violations = append(violations, Violation{
+			Name:      d.dep.GetName(),
+			Version:   d.dep.GetVersion(),
+			Ecosystem: d.dep.GetEcosystem().AsString(),
+			License:   offending,
+			Reason:    reason,
+			File:      d.file,
+		})
+	}
+
+	return violations, nil
+}
+
+// readDependencies normalizes the output of the diff and deps ingesters.
+// The pull request is nil unless the dependencies were introduced by one.
+func readDependencies(
+	res *interfaces.Ingested,
+	entity protoreflect.ProtoMessage,
+) ([]dependency, *pbinternal.PullRequest, error) {
+	switch obj := res.Object.(type) {
+	case *pbinternal.PrDependencies:
+		deps := make([]dependency, 0, len(obj.GetDeps()))
+		for _, d := range obj.GetDeps() {
+			if d.GetDep() == nil || d.GetDep().GetVersion() == "" {
+				continue
+			}
+			deps = append(deps, dependency{dep: d.GetDep(), file: d.GetFile().GetName()})
+		}
+		return deps, obj.GetPr(), nil
+	case map[string]any:
+		nodes, ok := obj["node_list"].(*protobom.NodeList)
+		if !ok {
+			return nil, nil, errors.New("invalid object type for license evaluator")
+		}
+		pr, _ := entity.(*pbinternal.PullRequest)
+		return nodeListDependencies(nodes), pr, nil
+	default:
+		return nil, nil, errors.New("invalid object type for license evaluator")
+	}
+}
+
+func nodeListDependencies(nodes *protobom.NodeList) []dependency {
+	deps := make([]dependency, 0, len(nodes.GetNodes()))
+	for _, node := range nodes.GetNodes() {
+		if node.GetType() != protobom.Node_PACKAGE {
+			continue
+		}
+		id := node.GetIdentifiers()[int32(protobom.SoftwareIdentifierType_PURL)]
+		if id == "" {
+			continue
+		}
+		p, err := purl.FromString(id)
+		if err != nil {
+			continue
+		}
+
+		version := node.GetVersion()
+		if version == "" {
+			version = p.Version
+		}
+		if version == "" {
+			continue
+		}
+
+		license := node.GetLicenseConcluded()
+		if license == "" {
+			license = joinLicenses(node.GetLicenses())
+		}
+
+		deps = append(deps, dependency{
+			dep: &pbinternal.Dependency{
+				Ecosystem: purlEcosystem(p.Type),
+				Name:      purlName(p),
+				Version:   version,
+			},
+			license: license,
+		})
+	}
+	return deps
+}
+
+func purlEcosystem(purlType string) pbinternal.DepEcosystem {
+	switch purlType {
+	case purl.TypePyPi:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_PYPI
+	case purl.TypeNPM:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM
+	case purl.TypeGolang:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO
+	case purl.TypeMaven:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_MAVEN
+	case purl.TypeCargo:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO
+	case purl.TypeGem:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_RUBYGEMS
+	case purl.TypeNuget:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_NUGET
+	default:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_UNSPECIFIED
+	}
+}
+
+// purlName returns the package name as the ecosystem spells it
+func purlName(p purl.PackageURL) string {
+	switch {
+	case p.Namespace == "":
+		return p.Name
+	case p.Type == purl.TypeMaven:
+		return p.Namespace + ":" + p.Name
+	default:
+		return p.Namespace + "/" + p.Name
+	}
+}
@@ -0,0 +1,337 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	protobom "github.com/protobom/protobom/pkg/sbom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	mock_github "github.com/mindersec/minder/internal/providers/github/mock"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const (
+	minderUserID = int64(1)
+	authorID     = int64(2)
+	headSha      = "1ff2a2cdc3bc52d0c1e7ce4d6dc1e4a3f0dbdf92"
+)
+
+var licensesByPackage = map[string]string{
+	"/systems/NPM/packages/left-pad/versions/1.3.0":  `{"licenses": ["WTFPL"]}`,
+	"/systems/NPM/packages/readline/versions/1.3.0":  `{"licenses": ["GPL-3.0-only"]}`,
+	"/systems/NPM/packages/lodash/versions/4.17.21":  `{"licenses": ["MIT"]}`,
+	"/systems/PYPI/packages/requests/versions/2.0.0": `{"licenses": ["Apache-2.0"]}`,
+}
+
+func newMetadataServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply, ok := licensesByPackage[r.URL.EscapedPath()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(reply))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func prDependencies(names ...string) *pbinternal.PrDependencies {
+	prdeps := &pbinternal.PrDependencies{
+		Pr: &pbinternal.PullRequest{
+			Number:    42,
+			RepoOwner: "stacklok",
+			RepoName:  "demo",
+			CommitSha: headSha,
+			AuthorId:  authorID,
+		},
+	}
+	for _, name := range names {
+		version := "1.3.0"
+		if name == "lodash" {
+			version = "4.17.21"
+		}
+		prdeps.Deps = append(prdeps.Deps, &pbinternal.PrDependencies_ContextualDependency{
+			Dep: &pbinternal.Dependency{
+				Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM,
+				Name:      name,
+				Version:   version,
+			},
+			File: &pbinternal.PrDependencies_ContextualDependency_FilePatch{Name: "package-lock.json"},
+		})
+	}
+	return prdeps
+}
+
+func TestEvalProfileOnly(t *testing.T) {
+	t.Parallel()
+
+	server := newMetadataServer(t)
+
+	nodes := protobom.NewNodeList()
+	nodes.AddNode(&protobom.Node{
+		Id:   "requests",
+		Type: protobom.Node_PACKAGE,
+		Name: "requests",
+		Identifiers: map[int32]string{
+			int32(protobom.SoftwareIdentifierType_PURL): "pkg:pypi/requests@2.0.0",
+		},
+	})
+	nodes.AddNode(&protobom.Node{
+		Id:       "internal-tool",
+		Type:     protobom.Node_PACKAGE,
+		Name:     "internal-tool",
+		Version:  "0.1.0",
+		Licenses: []string{"LicenseRef-Proprietary"},
+		Identifiers: map[int32]string{
+			int32(protobom.SoftwareIdentifierType_PURL): "pkg:pypi/internal-tool@0.1.0",
+		},
+	})
+	nodes.AddNode(&protobom.Node{
+		Id:   "mystery",
+		Type: protobom.Node_PACKAGE,
+		Name: "mystery",
+		Identifiers: map[int32]string{
+			int32(protobom.SoftwareIdentifierType_PURL): "pkg:pypi/mystery@1.0.0",
+		},
+	})
+
+	tests := []struct {
+		name          string
+		ingested      any
+		pol           map[string]any
+		expectFailure bool
+		expectOutput  []Violation
+	}{
+		{
+			name:     "pull request dependencies",
+			ingested: prDependencies("left-pad", "readline", "lodash"),
+			pol: map[string]any{
+				"action": "profile_only",
+				"allow":  []any{"MIT", "Apache-2.0", "BSD-*"},
+				"deny":   []any{"GPL-*"},
+			},
+			expectFailure: true,
+			expectOutput: []Violation{{
+				Name: "left-pad", Version: "1.3.0", Ecosystem: "npm",
+				License: "WTFPL", Reason: ReasonNotAllowed, File: "package-lock.json",
+			}, {
+				Name: "readline", Version: "1.3.0", Ecosystem: "npm",
+				License: "GPL-3.0-only", Reason: ReasonDenied, File: "package-lock.json",
+			}},
+		},
+		{
+			name:     "repository node list",
+			ingested: map[string]any{"node_list": nodes},
+			pol: map[string]any{
+				"allow":           []any{"Apache-2.0"},
+				"fail_on_unknown": true,
+			},
+			expectFailure: true,
+			expectOutput: []Violation{{
+				Name: "internal-tool", Version: "0.1.0", Ecosystem: "PyPI",
+				License: "LicenseRef-Proprietary", Reason: ReasonNotAllowed,
+			}, {
+				Name: "mystery", Version: "1.0.0", Ecosystem: "PyPI",
+				Reason: ReasonUnknown,
+			}},
+		},
+		{
+			name:     "unknown licenses are ignored by default",
+			ingested: map[string]any{"node_list": nodes},
+			pol: map[string]any{
+				"allow": []any{"Apache-2.0", "LicenseRef-*"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			eval, err := NewLicenseEvaluator(nil)
+			require.NoError(t, err)
+
+			tt.pol["metadata_endpoint"] = server.URL
+			res, err := eval.Eval(context.Background(), tt.pol, nil, &interfaces.Ingested{Object: tt.ingested})
+			if !tt.expectFailure {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+			assert.Equal(t, tt.expectOutput, res.Output)
+		})
+	}
+}
+
+func TestEvalReview(t *testing.T) {
+	t.Parallel()
+
+	previousReview := func(sha, state string) *github.PullRequestReview {
+		body, err := renderReport(sha, []Violation{{Name: "readline", Reason: ReasonDenied}})
+		require.NoError(t, err)
+		return &github.PullRequestReview{
+			ID:    github.Int64(7),
+			User:  &github.User{ID: github.Int64(minderUserID)},
+			Body:  github.String(body),
+			State: github.String(state),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		action        string
+		deps          []string
+		setup         func(*mock_github.MockGitHub)
+		expectFailure bool
+	}{
+		{
+			name:   "violations request changes",
+			action: "review",
+			deps:   []string{"readline", "lodash"},
+			setup: func(gh *mock_github.MockGitHub) {
+				gh.EXPECT().ListReviews(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).Return(nil, nil)
+				gh.EXPECT().CreateReview(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, review *github.PullRequestReviewRequest) (
+						*github.PullRequestReview, error) {
+						assert.Equal(t, "REQUEST_CHANGES", review.GetEvent())
+						assert.Equal(t, headSha, review.GetCommitID())
+						assert.Contains(t, review.GetBody(), "| `readline` | 1.3.0 | npm | GPL-3.0-only | denied |")
+						assert.NotContains(t, review.GetBody(), "lodash")
+						return &github.PullRequestReview{}, nil
+					})
+			},
+			expectFailure: true,
+		},
+		{
+			name:   "comment action only comments",
+			action: "comment",
+			deps:   []string{"readline"},
+			setup: func(gh *mock_github.MockGitHub) {
+				gh.EXPECT().ListReviews(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).Return(nil, nil)
+				gh.EXPECT().CreateReview(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, review *github.PullRequestReviewRequest) (
+						*github.PullRequestReview, error) {
+						assert.Equal(t, "COMMENT", review.GetEvent())
+						return &github.PullRequestReview{}, nil
+					})
+			},
+			expectFailure: true,
+		},
+		{
+			name:   "same commit is not reviewed again",
+			action: "review",
+			deps:   []string{"readline"},
+			setup: func(gh *mock_github.MockGitHub) {
+				gh.EXPECT().ListReviews(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).
+					Return([]*github.PullRequestReview{previousReview(headSha, "CHANGES_REQUESTED")}, nil)
+			},
+			expectFailure: true,
+		},
+		{
+			name:   "resolved violations dismiss the previous review",
+			action: "review",
+			deps:   []string{"lodash"},
+			setup: func(gh *mock_github.MockGitHub) {
+				gh.EXPECT().ListReviews(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).
+					Return([]*github.PullRequestReview{previousReview("oldsha", "CHANGES_REQUESTED")}, nil)
+				gh.EXPECT().DismissReview(gomock.Any(), "stacklok", "demo", 42, int64(7), gomock.Any()).
+					Return(&github.PullRequestReview{}, nil)
+			},
+		},
+		{
+			name:   "commit status",
+			action: "commit_status",
+			deps:   []string{"readline"},
+			setup: func(gh *mock_github.MockGitHub) {
+				gh.EXPECT().ListReviews(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).Return(nil, nil)
+				gh.EXPECT().CreateReview(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, review *github.PullRequestReviewRequest) (
+						*github.PullRequestReview, error) {
+						assert.Equal(t, "COMMENT", review.GetEvent())
+						return &github.PullRequestReview{}, nil
+					})
+				gh.EXPECT().SetCommitStatus(gomock.Any(), "stacklok", "demo", headSha, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _, _ string, status *github.RepoStatus) (*github.RepoStatus, error) {
+						assert.Equal(t, commitStatusContext, status.GetContext())
+						assert.Equal(t, "failure", status.GetState())
+						return status, nil
+					})
+			},
+			expectFailure: true,
+		},
+		{
+			name:   "summary",
+			action: "summary",
+			deps:   []string{"readline"},
+			setup: func(gh *mock_github.MockGitHub) {
+				gh.EXPECT().CreateIssueComment(gomock.Any(), "stacklok", "demo", 42, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, comment string) (*github.IssueComment, error) {
+						assert.True(t, strings.Contains(comment, "readline"))
+						return &github.IssueComment{}, nil
+					})
+			},
+			expectFailure: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMetadataServer(t)
+
+			ctrl := gomock.NewController(t)
+			gh := mock_github.NewMockGitHub(ctrl)
+			if tt.action != "summary" {
+				gh.EXPECT().GetUserId(gomock.Any()).Return(minderUserID, nil)
+			}
+			tt.setup(gh)
+
+			eval, err := NewLicenseEvaluator(gh)
+			require.NoError(t, err)
+
+			pol := map[string]any{
+				"action":            tt.action,
+				"deny":              []any{"GPL-*"},
+				"metadata_endpoint": server.URL,
+			}
+			_, err = eval.Eval(context.Background(), pol, nil, &interfaces.Ingested{Object: prDependencies(tt.deps...)})
+			if !tt.expectFailure {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+			var evalErr *evalerrors.EvaluationError
+			require.ErrorAs(t, err, &evalErr)
+			assert.Equal(t, "Dependencies violating the license policy:\n* readline@1.3.0: GPL-3.0-only (denied)\n",
+				evalErr.Details())
+		})
+	}
+}
+
+func TestEvalNeedsClientForPullRequestActions(t *testing.T) {
+	t.Parallel()
+
+	eval, err := NewLicenseEvaluator(nil)
+	require.NoError(t, err)
+
+	_, err = eval.Eval(context.Background(), map[string]any{"deny": []any{"GPL-*"}}, nil,
+		&interfaces.Ingested{Object: prDependencies("readline")})
+	require.ErrorContains(t, err, "github trait")
+}
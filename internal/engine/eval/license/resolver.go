@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+var errUnsupportedEcosystem = errors.New("ecosystem not supported by the license metadata service")
+
+// depsDevSystems maps our ecosystems to the system names used by deps.dev
+var depsDevSystems = map[pbinternal.DepEcosystem]string{
+	pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO:       "GO",
+	pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM:      "NPM",
+	pbinternal.DepEcosystem_DEP_ECOSYSTEM_PYPI:     "PYPI",
+	pbinternal.DepEcosystem_DEP_ECOSYSTEM_MAVEN:    "MAVEN",
+	pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO:    "CARGO",
+	pbinternal.DepEcosystem_DEP_ECOSYSTEM_RUBYGEMS: "RUBYGEMS",
+	pbinternal.DepEcosystem_DEP_ECOSYSTEM_NUGET:    "NUGET",
+}
+
+// depsDevVersion is the subset of the deps.dev GetVersion reply we use
+type depsDevVersion struct {
+	Licenses       []string `json:"licenses"`
+	LicenseDetails []struct {
+		License string `json:"license"`
+		Spdx    string `json:"spdx"`
+	} `json:"licenseDetails"`
+}
+
+// resolver looks up the declared license of package versions using the
+// deps.dev v3 API. Results are cached for the lifetime of the resolver,
+// which is a single evaluation.
+type resolver struct {
+	client   *http.Client
+	endpoint string
+	cache    map[string]string
+}
+
+func newResolver(endpoint string) *resolver {
+	return &resolver{
+		client:   &http.Client{},
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		cache:    make(map[string]string),
+	}
+}
+
+// resolve returns the SPDX license expression of a dependency, or an empty
+// string if the metadata service doesn't know it.
+func (r *resolver) resolve(ctx context.Context, dep *pbinternal.Dependency) (string, error) {
+	system, ok := depsDevSystems[dep.GetEcosystem()]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", errUnsupportedEcosystem, dep.GetEcosystem())
+	}
+
+	version := dep.GetVersion()
+	if dep.GetEcosystem() == pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO && !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	key := system + "/" + dep.GetName() + "@" + version
+	if expression, ok := r.cache[key]; ok {
+		return expression, nil
+	}
+
+	reqURL := fmt.Sprintf("%s/systems/%s/packages/%s/versions/%s",
+		r.endpoint, system, url.PathEscape(dep.GetName()), url.PathEscape(version))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "minder (https://github.com/mindersec/minder)")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var expression string
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var reply depsDevVersion
+		if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+			return "", fmt.Errorf("could not decode reply: %w", err)
+		}
+		expression = reply.expression()
+	case http.StatusNotFound:
+		// unknown package or version, the policy decides what to do
+	default:
+		return "", fmt.Errorf("unexpected status code from license metadata service: %d", resp.StatusCode)
+	}
+
+	r.cache[key] = expression
+	return expression, nil
+}
+
+// expression combines the licenses of a version into a single SPDX
+// expression. deps.dev reports every license found in a package, all of
+// which apply, so they are joined with AND.
+func (v *depsDevVersion) expression() string {
+	var licenses []string
+	if len(v.LicenseDetails) > 0 {
+		for _, detail := range v.LicenseDetails {
+			if detail.Spdx != "" {
+				licenses = append(licenses, detail.Spdx)
+			} else {
+				licenses = append(licenses, detail.License)
+			}
+		}
+	} else {
+		licenses = v.Licenses
+	}
+
+	return joinLicenses(licenses)
+}
+
+func joinLicenses(licenses []string) string {
+	switch len(licenses) {
+	case 0:
+		return ""
+	case 1:
+		return licenses[0]
+	}
+
+	terms := make([]string, 0, len(licenses))
+	for _, l := range licenses {
+		if strings.ContainsAny(l, " ") {
+			l = "(" + l + ")"
+		}
+		terms = append(terms, l)
+	}
+	return strings.Join(terms, " AND ")
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package license
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dep           *pbinternal.Dependency
+		path          string
+		reply         string
+		expectLicense string
+		expectError   error
+	}{
+		{
+			name: "npm scoped package",
+			dep: &pbinternal.Dependency{
+				Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM,
+				Name:      "@babel/core",
+				Version:   "7.24.0",
+			},
+			path:          "/systems/NPM/packages/@babel%2Fcore/versions/7.24.0",
+			reply:         `{"licenses": ["MIT"]}`,
+			expectLicense: "MIT",
+		},
+		{
+			name: "go version without prefix",
+			dep: &pbinternal.Dependency{
+				Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO,
+				Name:      "github.com/google/uuid",
+				Version:   "1.6.0",
+			},
+			path:          "/systems/GO/packages/github.com%2Fgoogle%2Fuuid/versions/v1.6.0",
+			reply:         `{"licenses": ["BSD-3-Clause"]}`,
+			expectLicense: "BSD-3-Clause",
+		},
+		{
+			name: "several licenses are combined",
+			dep: &pbinternal.Dependency{
+				Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO,
+				Name:      "serde",
+				Version:   "1.0.200",
+			},
+			path: "/systems/CARGO/packages/serde/versions/1.0.200",
+			reply: `{"licenseDetails": [{"license": "MIT OR Apache-2.0", "spdx": "MIT OR Apache-2.0"},
+{"license": "Unicode-DFS-2016", "spdx": "Unicode-DFS-2016"}]}`,
+			expectLicense: "(MIT OR Apache-2.0) AND Unicode-DFS-2016",
+		},
+		{
+			name: "unknown version",
+			dep: &pbinternal.Dependency{
+				Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+				Name:      "requests",
+				Version:   "0.0.0",
+			},
+			path: "/systems/PYPI/packages/requests/versions/0.0.0",
+		},
+		{
+			name: "unsupported ecosystem",
+			dep: &pbinternal.Dependency{
+				Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_UNSPECIFIED,
+				Name:      "something",
+				Version:   "1.0.0",
+			},
+			expectError: errUnsupportedEcosystem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, tt.path, r.URL.EscapedPath(), "unexpected path")
+				assert.NotEmpty(t, r.Header.Get("User-Agent"))
+				if tt.reply == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, err := w.Write([]byte(tt.reply))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			r := newResolver(server.URL)
+			license, err := r.resolve(context.Background(), tt.dep)
+			if tt.expectError != nil {
+				require.ErrorIs(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectLicense, license)
+
+			// the second lookup is served from the cache
+			_, err = r.resolve(context.Background(), tt.dep)
+			require.NoError(t, err)
+			assert.Equal(t, 1, requests)
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/mindersec/minder/internal/deps/scalibr"
+	msbom "github.com/mindersec/minder/internal/sbom"
 	"github.com/mindersec/minder/internal/util"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
@@ -58,6 +59,8 @@ var MinderRegoLib = []func(res *interfaces.Ingested) func(*rego.Rego){
 	BaseListGithubActions,
 	DependencyExtract,
 	BaseDependencyExtract,
+	FileSBOM,
+	BaseFileSBOM,
 }
 
 func instantiateRegoLib(res *interfaces.Ingested) []func(*rego.Rego) {
@@ -888,3 +891,99 @@ func fsExtractDeps(vfs billy.Filesystem) func(rego.BuiltinContext, *ast.Term) (*
 		return &ast.Term{Value: astValue}, err
 	}
 }
+
+// FileSBOM adds the `file.sbom` function to the Rego engine.
+func FileSBOM(res *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function2(
+		&rego.Function{
+			Name: "file.sbom",
+			Description: `file.sbom returns an SBOM for a file or subtree of the
+			filesystem being evaluated (which comes from the ingester). It takes
+			two arguments: the path and the format of the returned document,
+			either "cyclonedx" or "spdx". When the path is a CycloneDX or SPDX
+			document, it is parsed (and converted if needed); when the path is a
+			directory, it is scanned for dependencies to generate the SBOM.`,
+			Decl: types.NewFunction(types.Args(types.S, types.S), types.A),
+		},
+		fsSBOM(res.Fs),
+	)
+}
+
+// BaseFileSBOM adds the `base_file.sbom` function to the Rego engine.
+func BaseFileSBOM(res *interfaces.Ingested) func(*rego.Rego) {
+	return rego.Function2(
+		&rego.Function{
+			Name: "base_file.sbom",
+			Description: `base_file.sbom returns an SBOM for a file or subtree of
+			the base filesystem in a pull_request or other diff context. It takes
+			two arguments: the path and the format of the returned document,
+			either "cyclonedx" or "spdx". When the path is a CycloneDX or SPDX
+			document, it is parsed (and converted if needed); when the path is a
+			directory, it is scanned for dependencies to generate the SBOM.`,
+			Decl: types.NewFunction(types.Args(types.S, types.S), types.A),
+		},
+		fsSBOM(res.BaseFs),
+	)
+}
+
+func fsSBOM(vfs billy.Filesystem) func(rego.BuiltinContext, *ast.Term, *ast.Term) (*ast.Term, error) {
+	return func(bctx rego.BuiltinContext, op1 *ast.Term, op2 *ast.Term) (*ast.Term, error) {
+		var path, formatStr string
+		if err := ast.As(op1.Value, &path); err != nil {
+			return nil, err
+		}
+		if err := ast.As(op2.Value, &formatStr); err != nil {
+			return nil, err
+		}
+
+		format, err := msbom.ParseFormat(formatStr)
+		if err != nil {
+			return nil, err
+		}
+
+		if vfs == nil {
+			return nil, fmt.Errorf("file system is not available")
+		}
+
+		path = filepath.Clean(path)
+		target, err := vfs.Stat(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("file or path %q does not exist", path)
+			}
+			return nil, err
+		}
+
+		var doc *msbom.Document
+		if target.IsDir() {
+			subtree, err := vfs.Chroot(path)
+			if err != nil {
+				return nil, err
+			}
+			nodes, err := scalibr.NewExtractor().ScanFilesystem(bctx.Context, iofs.New(subtree))
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan filesystem: %v", err)
+			}
+			doc = msbom.FromNodeList(path, "", nodes)
+		} else {
+			data, err := billyutil.ReadFile(vfs, path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+			}
+			doc, err = msbom.Read(data)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rendered, err := doc.Render(format)
+		if err != nil {
+			return nil, err
+		}
+		value, err := ast.InterfaceToValue(rendered)
+		if err != nil {
+			return nil, fmt.Errorf("error converting to AST value: %w", err)
+		}
+		return ast.NewTerm(value), nil
+	}
+}
@@ -1322,3 +1322,106 @@ allow if {
 		})
 	}
 }
+
+func TestFileSBOM(t *testing.T) {
+	t.Parallel()
+
+	scenario := []struct {
+		name        string
+		path        string
+		format      string
+		expected    []string
+		expectedErr error
+	}{{
+		name:     "scan directory as cyclonedx",
+		path:     "foo",
+		format:   "cyclonedx",
+		expected: []string{"example.com/othermodule", "stdlib"},
+	}, {
+		name:     "read document as cyclonedx",
+		path:     "bom.json",
+		format:   "cyclonedx",
+		expected: []string{"github.com/google/uuid"},
+	}, {
+		name:     "read document as spdx",
+		path:     "bom.json",
+		format:   "spdx",
+		expected: []string{"github.com/google/uuid"},
+	}, {
+		name:        "unknown format",
+		path:        "bom.json",
+		format:      "swid",
+		expectedErr: engerrors.NewErrEvaluationFailed("denied"),
+	}, {
+		name:        "parse non-existent file",
+		path:        "missing",
+		format:      "cyclonedx",
+		expectedErr: engerrors.NewErrEvaluationFailed("denied"),
+	}}
+
+	fs := memfs.New()
+	require.NoError(t, fs.MkdirAll("foo", 0755), "could not create directory")
+	goMod := `
+module example.com/mymodule
+
+go 1.14
+
+require example.com/othermodule v1.2.3
+`
+	bom := `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "version": 1,
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{"bom-ref": "uuid", "type": "library", "name": "github.com/google/uuid", "version": "v1.6.0"}],
+  "dependencies": [{"ref": "app", "dependsOn": ["uuid"]}]
+}`
+	require.NoError(t, billyutil.WriteFile(fs, "foo/go.mod", []byte(goMod), 0644))
+	require.NoError(t, billyutil.WriteFile(fs, "bom.json", []byte(bom), 0644))
+
+	e, err := rego.NewRegoEvaluator(
+		&minderv1.RuleType_Definition_Eval_Rego{
+			Type: rego.DenyByDefaultEvaluationType.String(),
+			Def: `package minder
+
+import rego.v1
+
+doc := file.sbom(input.profile.path, input.profile.format)
+cdxNames := {x | x = doc.components[_].name}
+spdxNames := {x | x = doc.packages[_].name; x != doc.name}
+names := cdxNames | spdxNames
+expected := {x | x = input.profile.expected[_]}
+
+default allow := false
+
+allow if {
+	count(names) > 0
+	count(expected - names) == 0
+}
+`,
+		},
+	)
+	require.NoError(t, err, "could not create evaluator")
+
+	for _, tc := range scenario {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := map[string]any{
+				"path":     tc.path,
+				"format":   tc.format,
+				"expected": tc.expected,
+			}
+
+			_, err := e.Eval(context.Background(), policy, nil, &interfaces.Ingested{
+				Fs: fs,
+			})
+
+			if tc.expectedErr == nil {
+				require.NoError(t, err, "could not evaluate")
+			} else {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			}
+		})
+	}
+}
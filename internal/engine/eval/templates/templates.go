@@ -34,6 +34,14 @@ var RegoConstraints string
 //go:embed vulncheckTemplate.tmpl
 var VulncheckTemplate string
 
+// LicenseTemplate is the template for evaluation details of the
+// `license` evaluation engine.
+//
+// It expects a list of license violations named `violations`.
+//
+//go:embed licenseTemplate.tmpl
+var LicenseTemplate string
+
 // TrustyTemplate is the template for evaluation details of the
 // `trusty` evaluation engine.
 //
@@ -65,3 +73,11 @@ var InvisibleCharactersTemplate string
 //
 //go:embed jq.tmpl
 var JqTemplate string
+
+// CelTemplate is the template for details of the `cel` evaluation engine.
+//
+// It expects a `message` scalar value to be set. It optionally
+// accepts an `entityName` string.
+//
+//go:embed celTemplate.tmpl
+var CelTemplate string
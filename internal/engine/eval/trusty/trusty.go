@@ -20,6 +20,7 @@ import (
 	"github.com/mindersec/minder/internal/constants"
 	"github.com/mindersec/minder/internal/engine/eval/pr_actions"
 	"github.com/mindersec/minder/internal/engine/eval/templates"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
 	pbinternal "github.com/mindersec/minder/internal/proto"
 	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
@@ -34,9 +35,18 @@ const (
 
 // Evaluator is the trusty evaluator
 type Evaluator struct {
-	cli      interfaces.GitHubIssuePRClient
-	endpoint string
-	client   trusty.Trusty
+	cli         interfaces.GitHubIssuePRClient
+	endpoint    string
+	client      trusty.Trusty
+	profileOnly bool
+}
+
+var _ eoptions.SupportsProfileOnly = (*Evaluator)(nil)
+
+// SetProfileOnly makes the evaluator only report the problematic
+// dependencies, without submitting a summary to the pull request
+func (e *Evaluator) SetProfileOnly() {
+	e.profileOnly = true
 }
 
 // NewTrustyEvaluator creates a new trusty evaluator
@@ -139,9 +149,11 @@ func (e *Evaluator) Eval(
 		return &interfaces.EvaluationResult{}, nil
 	}
 
-	if err := submitSummary(ctx, prSummaryHandler, ruleConfig); err != nil {
-		logger.Err(err).Msgf("Failed generating PR summary: %s", err.Error())
-		return nil, fmt.Errorf("submitting pull request summary: %w", err)
+	if !e.profileOnly {
+		if err := submitSummary(ctx, prSummaryHandler, ruleConfig); err != nil {
+			logger.Err(err).Msgf("Failed generating PR summary: %s", err.Error())
+			return nil, fmt.Errorf("submitting pull request summary: %w", err)
+		}
 	}
 
 	err = buildEvalResult(prSummaryHandler)
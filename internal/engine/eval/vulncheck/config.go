@@ -51,6 +51,38 @@ var (
 				Url: "https://sum.golang.org",
 			},
 		},
+		{
+			Name:       "maven",
+			DbType:     vulnDbTypeOsv,
+			DbEndpoint: "https://api.osv.dev/v1/query",
+			PackageRepository: packageRepository{
+				Url: "https://repo1.maven.org/maven2",
+			},
+		},
+		{
+			Name:       "crates.io",
+			DbType:     vulnDbTypeOsv,
+			DbEndpoint: "https://api.osv.dev/v1/query",
+			PackageRepository: packageRepository{
+				Url: "https://crates.io/api/v1/crates",
+			},
+		},
+		{
+			Name:       "rubygems",
+			DbType:     vulnDbTypeOsv,
+			DbEndpoint: "https://api.osv.dev/v1/query",
+			PackageRepository: packageRepository{
+				Url: "https://rubygems.org/api/v1",
+			},
+		},
+		{
+			Name:       "nuget",
+			DbType:     vulnDbTypeOsv,
+			DbEndpoint: "https://api.osv.dev/v1/query",
+			PackageRepository: packageRepository{
+				Url: "https://api.nuget.org/v3-flatcontainer",
+			},
+		},
 	}
 )
 
@@ -82,6 +82,14 @@ func (rc *repoCache) newRepository(ecoConfig *ecosystemConfig) (RepoQuerier, err
 		repo = newGoProxySumRepository(ecoConfig.PackageRepository.Url, ecoConfig.SumRepository.Url)
 	case "pypi":
 		repo = newPyPIRepository(ecoConfig.PackageRepository.Url)
+	case "maven":
+		repo = newMavenRepository(ecoConfig.PackageRepository.Url)
+	case "crates.io":
+		repo = newCratesRepository(ecoConfig.PackageRepository.Url)
+	case "rubygems":
+		repo = newRubyGemsRepository(ecoConfig.PackageRepository.Url)
+	case "nuget":
+		repo = newNuGetRepository(ecoConfig.PackageRepository.Url)
 	default:
 		return nil, fmt.Errorf("unknown ecosystem: %s", ecoConfig.Name)
 	}
@@ -0,0 +1,438 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulncheck
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+// dependencyContextLines is the number of lines preceding the version of a
+// dependency which may be needed to tell which dependency it belongs to,
+// e.g. the artifactId of a pom.xml dependency, or the name of a Cargo.lock
+// package.
+const dependencyContextLines = 3
+
+// hasContextualVersion reports whether the version of a dependency of the
+// ecosystem may be declared on a different line than its name
+func hasContextualVersion(eco pbinternal.DepEcosystem) bool {
+	switch eco {
+	case pbinternal.DepEcosystem_DEP_ECOSYSTEM_MAVEN,
+		pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO,
+		pbinternal.DepEcosystem_DEP_ECOSYSTEM_NUGET:
+		return true
+	default:
+		return false
+	}
+}
+
+// versionLister lists the versions of a package in a package registry, and
+// returns the latest stable one
+type versionLister func(ctx context.Context, client *http.Client, endpoint, name string) (
+	latest string, versions []string, err error)
+
+// dependencyMatcher reports whether the last of the lines declares the
+// dependency with the given version, and returns the version as written
+// in the line. The preceding lines are the context of the last one.
+type dependencyMatcher func(lines []string, name, version string) (string, bool)
+
+// versionListRepository is a package registry which is only queried for the
+// versions of packages, the patch suggestion replacing the version in place
+type versionListRepository struct {
+	client   *http.Client
+	endpoint string
+	list     versionLister
+	matches  dependencyMatcher
+}
+
+// check that versionListRepository implements RepoQuerier
+var _ RepoQuerier = (*versionListRepository)(nil)
+
+func newMavenRepository(endpoint string) *versionListRepository {
+	return &versionListRepository{client: &http.Client{}, endpoint: endpoint, list: listMavenVersions, matches: mavenMatches}
+}
+
+func newCratesRepository(endpoint string) *versionListRepository {
+	return &versionListRepository{client: &http.Client{}, endpoint: endpoint, list: listCratesVersions, matches: cargoMatches}
+}
+
+func newRubyGemsRepository(endpoint string) *versionListRepository {
+	return &versionListRepository{client: &http.Client{}, endpoint: endpoint, list: listRubyGemsVersions, matches: gemMatches}
+}
+
+func newNuGetRepository(endpoint string) *versionListRepository {
+	return &versionListRepository{client: &http.Client{}, endpoint: endpoint, list: listNuGetVersions, matches: nugetMatches}
+}
+
+func (r *versionListRepository) SendRecvRequest(ctx context.Context, dep *pbinternal.Dependency, patched string, latest bool,
+) (patchLocatorFormatter, error) {
+	latestVersion, versions, err := r.list(ctx, r.client, r.endpoint, dep.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := r.newPackage(dep)
+	if latest {
+		pkg.Version = latestVersion
+	} else {
+		pkg.Version = findVersion(versions, patched)
+	}
+	if pkg.Version == "" {
+		return nil, ErrPkgNotFound
+	}
+
+	return pkg, nil
+}
+
+func (r *versionListRepository) NoPatchAvailableFormatter(dep *pbinternal.Dependency) patchLocatorFormatter {
+	return r.newPackage(dep)
+}
+
+func (r *versionListRepository) PkgRegistryErrorFormatter(dep *pbinternal.Dependency, registryErr error) patchLocatorFormatter {
+	pkg := r.newPackage(dep)
+	pkg.pkgRegistryLookupError = registryErr
+	return pkg
+}
+
+func (r *versionListRepository) newPackage(dep *pbinternal.Dependency) *versionedPackage {
+	return &versionedPackage{
+		Name:       dep.Name,
+		oldVersion: dep.Version,
+		matches:    r.matches,
+	}
+}
+
+// findVersion returns the version of the list equal to the given one, which
+// may be written differently, e.g. 1.2 and 1.2.0
+func findVersion(versions []string, want string) string {
+	if slices.Contains(versions, want) {
+		return want
+	}
+	wantVersion, err := version.NewVersion(want)
+	if err != nil {
+		return ""
+	}
+	for _, v := range versions {
+		if have, err := version.NewVersion(v); err == nil && have.Equal(wantVersion) {
+			return v
+		}
+	}
+	return ""
+}
+
+// versionedPackage is a package whose version is replaced in the line
+// declaring it
+type versionedPackage struct {
+	formatterMeta
+
+	// just for locating in the patch
+	oldVersion string
+	matches    dependencyMatcher
+	// the old version as written in the located line, e.g. 1.2 for 1.2.0
+	writtenVersion string
+
+	Name    string
+	Version string
+}
+
+// IndentedString replaces the version in the line declaring the dependency,
+// which is the last line of the context, keeping its indentation
+func (p *versionedPackage) IndentedString(_ int, oldDepLine string, oldDep *pbinternal.Dependency) string {
+	lines := strings.Split(oldDepLine, "\n")
+	old := p.writtenVersion
+	if old == "" {
+		old = oldDep.Version
+	}
+	return strings.Replace(lines[len(lines)-1], old, p.Version, 1)
+}
+
+func (p *versionedPackage) LineHasDependency(line string) bool {
+	written, ok := p.matches(strings.Split(line, "\n"), p.Name, p.oldVersion)
+	if ok {
+		p.writtenVersion = written
+	}
+	return ok
+}
+
+func (p *versionedPackage) HasPatchedVersion() bool {
+	return p.Version != ""
+}
+
+func (p *versionedPackage) GetPatchedVersion() string {
+	return p.Version
+}
+
+func (p *versionedPackage) GetFormatterMeta() formatterMeta {
+	if p == nil {
+		return formatterMeta{}
+	}
+	return p.formatterMeta
+}
+
+// getRegistry sends a GET request to a package registry, and decodes the
+// reply with the given function
+func getRegistry(ctx context.Context, client *http.Client, u string, decode func(io.Reader) error) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	// crates.io rejects requests without a user agent
+	req.Header.Set("User-Agent", "minder (https://github.com/mindersec/minder)")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrPkgNotFound
+	} else if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	if err := decode(resp.Body); err != nil {
+		return fmt.Errorf("could not unmarshal response: %w", err)
+	}
+	return nil
+}
+
+func decodeJSON(out any) func(io.Reader) error {
+	return func(r io.Reader) error {
+		return json.NewDecoder(r).Decode(out)
+	}
+}
+
+type mavenMetadata struct {
+	Versioning struct {
+		Latest   string   `xml:"latest"`
+		Release  string   `xml:"release"`
+		Versions []string `xml:"versions>version"`
+	} `xml:"versioning"`
+}
+
+// listMavenVersions reads the maven-metadata.xml of a groupId:artifactId
+// package in a Maven repository
+func listMavenVersions(ctx context.Context, client *http.Client, endpoint, name string) (string, []string, error) {
+	groupID, artifactID, ok := strings.Cut(name, ":")
+	if !ok {
+		return "", nil, fmt.Errorf("invalid maven package name %s", name)
+	}
+	paths := append(strings.Split(groupID, "."), artifactID, "maven-metadata.xml")
+	u, err := urlFromEndpointAndPaths(endpoint, paths...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var metadata mavenMetadata
+	err = getRegistry(ctx, client, u.String(), func(r io.Reader) error {
+		return xml.NewDecoder(r).Decode(&metadata)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	latest := metadata.Versioning.Release
+	if latest == "" {
+		latest = metadata.Versioning.Latest
+	}
+	return latest, metadata.Versioning.Versions, nil
+}
+
+type cratesReply struct {
+	Crate struct {
+		MaxStableVersion string `json:"max_stable_version"`
+	} `json:"crate"`
+	Versions []struct {
+		Num    string `json:"num"`
+		Yanked bool   `json:"yanked"`
+	} `json:"versions"`
+}
+
+// listCratesVersions lists the versions of a crate from the crates.io API.
+// Yanked versions are left out.
+func listCratesVersions(ctx context.Context, client *http.Client, endpoint, name string) (string, []string, error) {
+	u, err := urlFromEndpointAndPaths(endpoint, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var reply cratesReply
+	if err := getRegistry(ctx, client, u.String(), decodeJSON(&reply)); err != nil {
+		return "", nil, err
+	}
+
+	versions := make([]string, 0, len(reply.Versions))
+	for _, v := range reply.Versions {
+		if !v.Yanked {
+			versions = append(versions, v.Num)
+		}
+	}
+	return reply.Crate.MaxStableVersion, versions, nil
+}
+
+type rubyGemsVersion struct {
+	Number     string `json:"number"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+// listRubyGemsVersions lists the versions of a gem from the RubyGems API,
+// which returns the newest first
+func listRubyGemsVersions(ctx context.Context, client *http.Client, endpoint, name string) (string, []string, error) {
+	u, err := urlFromEndpointAndPaths(endpoint, "versions", name+".json")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var reply []rubyGemsVersion
+	if err := getRegistry(ctx, client, u.String(), decodeJSON(&reply)); err != nil {
+		return "", nil, err
+	}
+
+	latest := ""
+	versions := make([]string, 0, len(reply))
+	for _, v := range reply {
+		if latest == "" && !v.Prerelease {
+			latest = v.Number
+		}
+		versions = append(versions, v.Number)
+	}
+	return latest, versions, nil
+}
+
+type nugetReply struct {
+	Versions []string `json:"versions"`
+}
+
+// listNuGetVersions lists the versions of a package from the NuGet package
+// content API, which returns the oldest first
+func listNuGetVersions(ctx context.Context, client *http.Client, endpoint, name string) (string, []string, error) {
+	u, err := urlFromEndpointAndPaths(endpoint, strings.ToLower(name), "index.json")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var reply nugetReply
+	if err := getRegistry(ctx, client, u.String(), decodeJSON(&reply)); err != nil {
+		return "", nil, err
+	}
+
+	latest := ""
+	for _, v := range slices.Backward(reply.Versions) {
+		// prerelease versions have a label, e.g. 8.0.0-rc.1
+		if !strings.Contains(v, "-") {
+			latest = v
+			break
+		}
+	}
+	return latest, reply.Versions, nil
+}
+
+// precedingLines returns the lines of the context before the line
+// declaring the version, latest first, up to the start of the given block
+func precedingLines(lines []string, blockStart func(string) bool) []string {
+	var preceding []string
+	for i := len(lines) - 2; i >= 0; i-- {
+		preceding = append(preceding, lines[i])
+		if blockStart(lines[i]) {
+			break
+		}
+	}
+	return preceding
+}
+
+func mavenMatches(lines []string, name, oldVersion string) (string, bool) {
+	last := lines[len(lines)-1]
+	// build.gradle
+	if strings.Contains(last, name+":"+oldVersion) {
+		return oldVersion, true
+	}
+
+	// pom.xml
+	if !strings.Contains(last, "<version>"+oldVersion+"</version>") {
+		return "", false
+	}
+	_, artifactID, _ := strings.Cut(name, ":")
+	for _, line := range precedingLines(lines, func(l string) bool { return strings.Contains(l, "<dependency>") }) {
+		if strings.Contains(line, "<artifactId>"+artifactID+"</artifactId>") {
+			return oldVersion, true
+		}
+	}
+	return "", false
+}
+
+var (
+	tomlNameRegex    = regexp.MustCompile(`^\s*name\s*=\s*"([^"]+)"`)
+	tomlVersionRegex = regexp.MustCompile(`^\s*version\s*=\s*"([^"]+)"`)
+	// the version of a requirement, e.g. 1.2 in "^1.2" or "~1.2, <1.5"
+	cargoRequirementRegex = regexp.MustCompile(`"[\^~=>\s]*([0-9][^",\s]*)`)
+)
+
+func cargoMatches(lines []string, name, oldVersion string) (string, bool) {
+	last := lines[len(lines)-1]
+
+	// Cargo.lock
+	if m := tomlVersionRegex.FindStringSubmatch(last); m != nil && m[1] == oldVersion {
+		for _, line := range precedingLines(lines, func(l string) bool { return strings.Contains(l, "[[package]]") }) {
+			if m := tomlNameRegex.FindStringSubmatch(line); m != nil {
+				return oldVersion, m[1] == name
+			}
+		}
+	}
+
+	// Cargo.toml, where the requirement "1.2" stands for 1.2.0
+	key, value, ok := strings.Cut(last, "=")
+	if !ok || (strings.TrimSpace(key) != name && !strings.Contains(value, `"`+name+`"`)) {
+		return "", false
+	}
+	for _, m := range cargoRequirementRegex.FindAllStringSubmatch(value, -1) {
+		have, err := version.NewVersion(m[1])
+		if err != nil {
+			continue
+		}
+		if want, err := version.NewVersion(oldVersion); err == nil && have.Equal(want) {
+			return m[1], true
+		}
+	}
+	return "", false
+}
+
+func gemMatches(lines []string, name, oldVersion string) (string, bool) {
+	last := strings.TrimSpace(lines[len(lines)-1])
+	// Gemfile.lock, the version may be followed by a platform
+	return oldVersion, strings.HasPrefix(last, name+" ("+oldVersion)
+}
+
+var nugetNameRegex = regexp.MustCompile(`^\s*"([^"]+)"\s*:\s*{`)
+
+func nugetMatches(lines []string, name, oldVersion string) (string, bool) {
+	last := lines[len(lines)-1]
+
+	// .csproj
+	if strings.Contains(last, "<PackageReference") {
+		return oldVersion, strings.Contains(last, `"`+name+`"`) && strings.Contains(last, oldVersion)
+	}
+
+	// packages.lock.json
+	if !strings.Contains(last, `"resolved"`) || !strings.Contains(last, `"`+oldVersion+`"`) {
+		return "", false
+	}
+	for _, line := range precedingLines(lines, func(l string) bool { return nugetNameRegex.MatchString(l) }) {
+		if m := nugetNameRegex.FindStringSubmatch(line); m != nil {
+			return oldVersion, strings.EqualFold(m[1], name)
+		}
+	}
+	return "", false
+}
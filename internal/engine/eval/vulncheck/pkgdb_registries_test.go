@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulncheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+func TestVersionListRepositories(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		newRepo        func(endpoint string) *versionListRepository
+		path           string
+		reply          string
+		dep            *pbinternal.Dependency
+		patchedVersion string
+		latest         bool
+		expectVersion  string
+		expectError    error
+	}{
+		{
+			name:    "maven latest release",
+			newRepo: newMavenRepository,
+			path:    "/org/apache/logging/log4j/log4j-core/maven-metadata.xml",
+			reply: `<metadata><versioning><latest>3.0.0-beta1</latest><release>2.21.1</release>
+<versions><version>2.14.1</version><version>2.17.1</version><version>2.21.1</version></versions>
+</versioning></metadata>`,
+			dep:           &pbinternal.Dependency{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"},
+			latest:        true,
+			expectVersion: "2.21.1",
+		},
+		{
+			name:    "maven patched version",
+			newRepo: newMavenRepository,
+			path:    "/org/apache/logging/log4j/log4j-core/maven-metadata.xml",
+			reply: `<metadata><versioning><release>2.21.1</release>
+<versions><version>2.14.1</version><version>2.17.1</version></versions></versioning></metadata>`,
+			dep:            &pbinternal.Dependency{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"},
+			patchedVersion: "2.17.1",
+			expectVersion:  "2.17.1",
+		},
+		{
+			name:    "crates.io skips yanked versions",
+			newRepo: newCratesRepository,
+			path:    "/h2",
+			reply: `{"crate": {"max_stable_version": "0.4.0"},
+"versions": [{"num": "0.3.24", "yanked": true}, {"num": "0.3.26", "yanked": false}]}`,
+			dep:            &pbinternal.Dependency{Name: "h2", Version: "0.3.20"},
+			patchedVersion: "0.3.24",
+			expectError:    ErrPkgNotFound,
+		},
+		{
+			name:    "crates.io patched version written differently",
+			newRepo: newCratesRepository,
+			path:    "/h2",
+			reply: `{"crate": {"max_stable_version": "0.4.0"},
+"versions": [{"num": "0.3.26", "yanked": false}]}`,
+			dep:            &pbinternal.Dependency{Name: "h2", Version: "0.3.20"},
+			patchedVersion: "0.3.26.0",
+			expectVersion:  "0.3.26",
+		},
+		{
+			name:    "rubygems latest skips prereleases",
+			newRepo: newRubyGemsRepository,
+			path:    "/versions/nokogiri.json",
+			reply: `[{"number": "1.16.0.rc1", "prerelease": true}, {"number": "1.15.4", "prerelease": false},
+{"number": "1.13.9", "prerelease": false}]`,
+			dep:           &pbinternal.Dependency{Name: "nokogiri", Version: "1.13.9"},
+			latest:        true,
+			expectVersion: "1.15.4",
+		},
+		{
+			name:          "nuget latest skips prereleases",
+			newRepo:       newNuGetRepository,
+			path:          "/newtonsoft.json/index.json",
+			reply:         `{"versions": ["12.0.1", "13.0.1", "13.0.4-beta1"]}`,
+			dep:           &pbinternal.Dependency{Name: "Newtonsoft.Json", Version: "12.0.1"},
+			latest:        true,
+			expectVersion: "13.0.1",
+		},
+		{
+			name:        "package not found",
+			newRepo:     newNuGetRepository,
+			path:        "/missing/index.json",
+			dep:         &pbinternal.Dependency{Name: "Missing", Version: "1.0.0"},
+			latest:      true,
+			expectError: ErrPkgNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.path, r.URL.Path, "unexpected path")
+				assert.NotEmpty(t, r.Header.Get("User-Agent"))
+				if tt.reply == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, err := w.Write([]byte(tt.reply))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			repo := tt.newRepo(server.URL)
+			reply, err := repo.SendRecvRequest(context.Background(), tt.dep, tt.patchedVersion, tt.latest)
+			if tt.expectError != nil {
+				require.ErrorIs(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectVersion, reply.GetPatchedVersion())
+		})
+	}
+}
+
+func TestVersionedPackageSuggestion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		newRepo       func(endpoint string) *versionListRepository
+		dep           *pbinternal.Dependency
+		context       string
+		patched       string
+		expectMatch   bool
+		expectReplace string
+	}{
+		{
+			name:    "pom.xml",
+			newRepo: newMavenRepository,
+			dep:     &pbinternal.Dependency{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"},
+			context: `        <dependency>
+            <groupId>org.apache.logging.log4j</groupId>
+            <artifactId>log4j-core</artifactId>
+            <version>2.14.1</version>`,
+			patched:       "2.17.1",
+			expectMatch:   true,
+			expectReplace: "            <version>2.17.1</version>",
+		},
+		{
+			name:    "pom.xml other artifact with the same version",
+			newRepo: newMavenRepository,
+			dep:     &pbinternal.Dependency{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"},
+			context: `        <dependency>
+            <groupId>org.apache.logging.log4j</groupId>
+            <artifactId>log4j-api</artifactId>
+            <version>2.14.1</version>`,
+		},
+		{
+			name:          "build.gradle",
+			newRepo:       newMavenRepository,
+			dep:           &pbinternal.Dependency{Name: "org.yaml:snakeyaml", Version: "1.33"},
+			context:       `    implementation 'org.yaml:snakeyaml:1.33'`,
+			patched:       "2.0",
+			expectMatch:   true,
+			expectReplace: `    implementation 'org.yaml:snakeyaml:2.0'`,
+		},
+		{
+			name:    "Cargo.lock",
+			newRepo: newCratesRepository,
+			dep:     &pbinternal.Dependency{Name: "h2", Version: "0.3.20"},
+			context: `
+[[package]]
+name = "h2"
+version = "0.3.20"`,
+			patched:       "0.3.24",
+			expectMatch:   true,
+			expectReplace: `version = "0.3.24"`,
+		},
+		{
+			name:          "Cargo.toml requirement",
+			newRepo:       newCratesRepository,
+			dep:           &pbinternal.Dependency{Name: "tokio", Version: "1.0.0"},
+			context:       `tokio = { version = "^1", features = ["full"] }`,
+			patched:       "1.38.2",
+			expectMatch:   true,
+			expectReplace: `tokio = { version = "^1.38.2", features = ["full"] }`,
+		},
+		{
+			name:          "Gemfile.lock",
+			newRepo:       newRubyGemsRepository,
+			dep:           &pbinternal.Dependency{Name: "nokogiri", Version: "1.13.9"},
+			context:       `    nokogiri (1.13.9-x86_64-linux)`,
+			patched:       "1.15.4",
+			expectMatch:   true,
+			expectReplace: `    nokogiri (1.15.4-x86_64-linux)`,
+		},
+		{
+			name:    "packages.lock.json",
+			newRepo: newNuGetRepository,
+			dep:     &pbinternal.Dependency{Name: "Newtonsoft.Json", Version: "12.0.1"},
+			context: `      "Newtonsoft.Json": {
+        "type": "Direct",
+        "requested": "[12.0.1, )",
+        "resolved": "12.0.1",`,
+			patched:       "13.0.1",
+			expectMatch:   true,
+			expectReplace: `        "resolved": "13.0.1",`,
+		},
+		{
+			name:          ".csproj",
+			newRepo:       newNuGetRepository,
+			dep:           &pbinternal.Dependency{Name: "Serilog", Version: "2.12.0"},
+			context:       `    <PackageReference Include="Serilog" Version="2.12.0" />`,
+			patched:       "3.1.1",
+			expectMatch:   true,
+			expectReplace: `    <PackageReference Include="Serilog" Version="3.1.1" />`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pkg := tt.newRepo("").newPackage(tt.dep)
+			pkg.Version = tt.patched
+
+			require.Equal(t, tt.expectMatch, pkg.LineHasDependency(tt.context))
+			if tt.expectMatch {
+				assert.Equal(t, tt.expectReplace, pkg.IndentedString(0, tt.context, tt.dep))
+			}
+		})
+	}
+}
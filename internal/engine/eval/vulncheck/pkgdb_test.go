@@ -631,6 +631,16 @@ func TestRepoCache(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "ValidEcosystemWithVersionListCachesConnections",
+			ecoConfig: &ecosystemConfig{
+				Name: "crates.io",
+				PackageRepository: packageRepository{
+					Url: "http://mock.url",
+				},
+			},
+			expectError: false,
+		},
 		{
 			name: "ErrorWithUnknownEcosystem",
 			ecoConfig: &ecosystemConfig{
@@ -81,6 +81,12 @@ func locateDepInPr(
 		if dep.Dep.Ecosystem == pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM && i+1 < len(lines) {
 			line = strings.Join([]string{line, lines[i+1], dep.Dep.Version}, "\n")
 			loc.lineToChange = i + 2
+		} else if hasContextualVersion(dep.Dep.Ecosystem) {
+			// e.g. the version of a pom.xml dependency is on a different
+			// line than its artifactId, so the preceding lines are needed
+			// to tell which dependency the version belongs to
+			line = strings.Join(lines[max(0, i-dependencyContextLines):i+1], "\n")
+			loc.lineToChange = i + 1
 		} else {
 			loc.lineToChange = i + 1
 		}
@@ -14,9 +14,11 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/mindersec/minder/internal/engine/eval/pr_actions"
 	"github.com/mindersec/minder/internal/engine/eval/templates"
 	eoptions "github.com/mindersec/minder/internal/engine/options"
 	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/internal/vulndb"
 	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/flags"
@@ -31,9 +33,13 @@ const (
 type Evaluator struct {
 	cli          GitHubRESTAndPRClient
 	featureFlags flags.Interface
+	vulnDB       vulndb.Database
+	profileOnly  bool
 }
 
 var _ eoptions.SupportsFlags = (*Evaluator)(nil)
+var _ eoptions.SupportsVulnDB = (*Evaluator)(nil)
+var _ eoptions.SupportsProfileOnly = (*Evaluator)(nil)
 
 // SetFlagsClient sets the `openfeature` client in the underlying
 // `Evaluator` struct.
@@ -42,6 +48,19 @@ func (e *Evaluator) SetFlagsClient(client flags.Interface) error {
 	return nil
 }
 
+// SetVulnDB sets the local mirror of the vulnerability database. The
+// ecosystems which have been mirrored are looked up there instead of
+// the configured endpoint.
+func (e *Evaluator) SetVulnDB(db vulndb.Database) {
+	e.vulnDB = db
+}
+
+// SetProfileOnly makes the evaluator only report the vulnerable packages,
+// without acting on the pull request
+func (e *Evaluator) SetProfileOnly() {
+	e.profileOnly = true
+}
+
 // NewVulncheckEvaluator creates a new vulncheck evaluator
 func NewVulncheckEvaluator(
 	ghcli GitHubRESTAndPRClient,
@@ -109,6 +128,9 @@ func (e *Evaluator) getVulnerableDependencies(
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	if e.profileOnly {
+		ruleConfig.Action = pr_actions.ActionProfileOnly
+	}
 	prReplyHandler, err := newPrStatusHandler(ctx, ruleConfig.Action, prdeps.Pr, e.cli)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pr action: %w", err)
@@ -116,12 +138,17 @@ func (e *Evaluator) getVulnerableDependencies(
 
 	pkgRepoCache := newRepoCache()
 
-	for _, dep := range prdeps.Deps {
+	mirrored, err := e.queryMirror(ctx, prdeps.Deps, ruleConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vulnerability database mirror: %w", err)
+	}
+
+	for i, dep := range prdeps.Deps {
 		if dep.Dep == nil || dep.Dep.Version == "" {
 			continue
 		}
 
-		vulnerable, err := e.checkVulnerabilities(ctx, dep, ruleConfig, pkgRepoCache, prReplyHandler)
+		vulnerable, err := e.checkVulnerabilities(ctx, dep, ruleConfig, pkgRepoCache, prReplyHandler, mirrored[i])
 		if err != nil {
 			return nil, fmt.Errorf("failed to check vulnerabilities: %w", err)
 		}
@@ -194,13 +221,73 @@ func (*Evaluator) queryVulnDb(
 	return response, nil
 }
 
+// queryMirror looks up the dependencies of the mirrored ecosystems in the
+// local vulnerability database, all at once. The responses are keyed by the
+// index of the dependency; dependencies without a response have to be looked
+// up in the configured vulnerability database.
+func (e *Evaluator) queryMirror(
+	ctx context.Context,
+	deps []*pbinternal.PrDependencies_ContextualDependency,
+	cfg *config,
+) (map[int]*VulnerabilityResponse, error) {
+	if e.vulnDB == nil {
+		return nil, nil
+	}
+
+	states, err := e.vulnDB.Ecosystems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, nil
+	}
+	mirrored := make(map[string]bool, len(states))
+	for _, state := range states {
+		mirrored[state.Ecosystem] = true
+	}
+
+	var indexes []int
+	var pkgs []vulndb.Package
+	for i, dep := range deps {
+		if dep.Dep == nil || dep.Dep.Version == "" {
+			continue
+		}
+		ecoConfig := cfg.getEcosystemConfig(dep.Dep.Ecosystem)
+		if ecoConfig == nil || ecoConfig.DbType != vulnDbTypeOsv || !mirrored[dep.Dep.Ecosystem.AsString()] {
+			continue
+		}
+		indexes = append(indexes, i)
+		pkgs = append(pkgs, vulndb.Package{
+			Ecosystem: dep.Dep.Ecosystem.AsString(),
+			Name:      dep.Dep.Name,
+			Version:   dep.Dep.Version,
+		})
+	}
+	if len(pkgs) == 0 {
+		return nil, nil
+	}
+
+	results, err := e.vulnDB.Query(ctx, pkgs)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make(map[int]*VulnerabilityResponse, len(indexes))
+	for j, i := range indexes {
+		responses[i] = toVulnerabilityResponse(results[j], deps[i].Dep)
+	}
+	return responses, nil
+}
+
 // checkVulnerabilities checks whether a PR dependency contains any vulnerabilities.
+// If the dependency was already looked up in the local mirror, its response is used.
 func (e *Evaluator) checkVulnerabilities(
 	ctx context.Context,
 	dep *pbinternal.PrDependencies_ContextualDependency,
 	cfg *config,
 	cache *repoCache,
 	prHandler prStatusHandler,
+	mirrored *VulnerabilityResponse,
 ) (bool, error) {
 	ecoConfig := cfg.getEcosystemConfig(dep.Dep.Ecosystem)
 	if ecoConfig == nil {
@@ -211,14 +298,17 @@ func (e *Evaluator) checkVulnerabilities(
 		return false, nil
 	}
 
-	vdb, err := e.getVulnDb(ecoConfig.DbType, ecoConfig.DbEndpoint)
-	if err != nil {
-		return false, fmt.Errorf("failed to get vulncheck db: %w", err)
-	}
+	response := mirrored
+	if response == nil {
+		vdb, err := e.getVulnDb(ecoConfig.DbType, ecoConfig.DbEndpoint)
+		if err != nil {
+			return false, fmt.Errorf("failed to get vulncheck db: %w", err)
+		}
 
-	response, err := e.queryVulnDb(ctx, vdb, dep.Dep, dep.Dep.Ecosystem)
-	if err != nil {
-		return false, fmt.Errorf("failed to query vulncheck db: %w", err)
+		response, err = e.queryVulnDb(ctx, vdb, dep.Dep, dep.Dep.Ecosystem)
+		if err != nil {
+			return false, fmt.Errorf("failed to query vulncheck db: %w", err)
+		}
 	}
 
 	if len(response.Vulns) == 0 {
@@ -4,11 +4,17 @@
 package vulncheck
 
 import (
+	"context"
 	"testing"
 
+	"github.com/ossf/osv-schema/bindings/go/osvschema"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"github.com/mindersec/minder/internal/engine/eval/templates"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/internal/vulndb"
+	mock_vulndb "github.com/mindersec/minder/internal/vulndb/mock"
 	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 )
 
@@ -54,3 +60,70 @@ func TestEvaluationDetailRendering(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryMirror(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockDB := mock_vulndb.NewMockDatabase(ctrl)
+
+	deps := []*pbinternal.PrDependencies_ContextualDependency{
+		{Dep: &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO,
+			Name:      "golang.org/x/text",
+			Version:   "v0.3.7",
+		}},
+		// not mirrored, looked up in osv.dev
+		{Dep: &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			Name:      "lodash",
+			Version:   "4.17.20",
+		}},
+		{Dep: &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO,
+			Name:      "golang.org/x/net",
+			Version:   "v0.23.0",
+		}},
+	}
+
+	mockDB.EXPECT().Ecosystems(ctx).Return([]vulndb.SyncState{{Ecosystem: "Go"}}, nil)
+	mockDB.EXPECT().Query(ctx, []vulndb.Package{
+		{Ecosystem: "Go", Name: "golang.org/x/text", Version: "v0.3.7"},
+		{Ecosystem: "Go", Name: "golang.org/x/net", Version: "v0.23.0"},
+	}).Return([][]*osvschema.Vulnerability{
+		{{
+			ID:      "GO-2022-1059",
+			Summary: "Denial of service via crafted Accept-Language header",
+			Affected: []osvschema.Affected{{
+				Package: osvschema.Package{Ecosystem: "Go", Name: "golang.org/x/text"},
+				Ranges: []osvschema.Range{{
+					Type:   osvschema.RangeSemVer,
+					Events: []osvschema.Event{{Introduced: "0"}, {Fixed: "0.3.8"}},
+				}},
+			}},
+		}},
+		nil,
+	}, nil)
+
+	cfg, err := parseConfig(map[string]any{})
+	require.NoError(t, err)
+
+	e := &Evaluator{}
+	e.SetVulnDB(mockDB)
+	responses, err := e.queryMirror(ctx, deps, cfg)
+	require.NoError(t, err)
+
+	require.Len(t, responses, 2)
+	require.Equal(t, &VulnerabilityResponse{
+		Vulns: []Vulnerability{{
+			ID:         "GO-2022-1059",
+			Summary:    "Denial of service via crafted Accept-Language header",
+			Introduced: "0",
+			Fixed:      "0.3.8",
+			Type:       "SEMVER",
+		}},
+	}, responses[0])
+	require.NotContains(t, responses, 1)
+	require.Empty(t, responses[2].Vulns)
+}
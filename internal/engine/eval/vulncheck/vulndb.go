@@ -12,11 +12,11 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
-	"time"
 
-	"github.com/hashicorp/go-version"
+	"github.com/ossf/osv-schema/bindings/go/osvschema"
 
 	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/internal/vulndb"
 )
 
 // Vulnerability is a vulnerability JSON representation
@@ -40,115 +40,54 @@ type vulnDb interface {
 	SendRecvRequest(r *http.Request, dep *pbinternal.Dependency) (*VulnerabilityResponse, error)
 }
 
-// OSVResponse is a response from the OSV database
-type OSVResponse struct {
-	Vulns []struct {
-		ID               string    `json:"id"`
-		Summary          string    `json:"summary"`
-		Details          string    `json:"details"`
-		Aliases          []string  `json:"aliases"`
-		Modified         time.Time `json:"modified"`
-		Published        time.Time `json:"published"`
-		DatabaseSpecific struct {
-			GithubReviewedAt string   `json:"github_reviewed_at"`
-			GithubReviewed   bool     `json:"github_reviewed"`
-			Severity         string   `json:"severity"`
-			CweIDs           []string `json:"cwe_ids"`
-			NvdPublishedAt   string   `json:"nvd_published_at"`
-		} `json:"database_specific"`
-		References []struct {
-			Type string `json:"type"`
-			URL  string `json:"url"`
-		} `json:"references"`
-		Affected []struct {
-			Package struct {
-				Name      string `json:"name"`
-				Ecosystem string `json:"ecosystem"`
-				Purl      string `json:"purl"`
-			} `json:"package"`
-			Ranges []struct {
-				Type   string `json:"type"`
-				Events []struct {
-					Introduced string `json:"introduced,omitempty"`
-					Fixed      string `json:"fixed,omitempty"`
-				} `json:"events"`
-			} `json:"ranges"`
-			DatabaseSpecific struct {
-				Source string `json:"source"`
-			} `json:"database_specific"`
-		} `json:"affected"`
-		SchemaVersion string `json:"schema_version"`
-		Severity      []struct {
-			Type  string `json:"type"`
-			Score string `json:"score"`
-		} `json:"severity"`
-	} `json:"vulns"`
+// osvResponse is a response from the OSV query API
+type osvResponse struct {
+	Vulns []*osvschema.Vulnerability `json:"vulns"`
 }
 
-func toVulnerabilityResponse(osvResp *OSVResponse, dep *pbinternal.Dependency) *VulnerabilityResponse {
+func toVulnerabilityResponse(vulns []*osvschema.Vulnerability, dep *pbinternal.Dependency) *VulnerabilityResponse {
 	var vulnResp VulnerabilityResponse
-
-	for _, osvVuln := range osvResp.Vulns {
-		vuln := Vulnerability{
-			ID:      osvVuln.ID,
-			Summary: osvVuln.Summary,
-			Details: osvVuln.Details,
-		}
-
-	affectedLoop:
-		for _, affected := range osvVuln.Affected {
-			for _, r := range affected.Ranges {
-				vuln.Type = r.Type
-				var introduced string
-				var fixed string
-				for _, event := range r.Events {
-					if event.Introduced != "" {
-						introduced = event.Introduced
-					}
-					if event.Fixed != "" {
-						fixed = event.Fixed
-					}
-				}
-				if r.Type == "SEMVER" && currentVersionInRange(dep.Version, introduced, fixed) {
-					// we have found the fixed version with the smallest delta from the current version
-					vuln.Introduced = introduced
-					vuln.Fixed = fixed
-					break affectedLoop
-				}
-				// if we can't determine which range the current version belongs to, use any range
-				if introduced != "" {
-					vuln.Introduced = introduced
-				}
-				if fixed != "" {
-					vuln.Fixed = fixed
-				}
-			}
-		}
-
-		// Add to the result
-		vulnResp.Vulns = append(vulnResp.Vulns, vuln)
+	for _, vuln := range vulns {
+		vulnResp.Vulns = append(vulnResp.Vulns, toVulnerability(vuln, dep))
 	}
 	return &vulnResp
 }
 
-func currentVersionInRange(currentVersion string, introducedVersion string, fixedVersion string) bool {
-	if introducedVersion == "" || fixedVersion == "" {
-		return false
+func toVulnerability(osvVuln *osvschema.Vulnerability, dep *pbinternal.Dependency) Vulnerability {
+	vuln := Vulnerability{
+		ID:      osvVuln.ID,
+		Summary: osvVuln.Summary,
+		Details: osvVuln.Details,
 	}
-	versionString := strings.TrimPrefix(currentVersion, "v")
-	current, err := version.NewVersion(versionString)
-	if err != nil {
-		return false
+
+	pkg := vulndb.Package{
+		Ecosystem: dep.Ecosystem.AsString(),
+		Name:      dep.Name,
+		Version:   dep.Version,
 	}
-	introduced, err := version.NewVersion(introducedVersion)
-	if err != nil {
-		return false
+	if rng, ok := vulndb.Affects(osvVuln, pkg); ok && rng.Type != "" {
+		// we have found the range the current version belongs to
+		vuln.Type = string(rng.Type)
+		vuln.Introduced = rng.Introduced
+		vuln.Fixed = rng.Fixed
+		return vuln
 	}
-	fixed, err := version.NewVersion(fixedVersion)
-	if err != nil {
-		return false
+
+	// if we can't determine which range the current version belongs to, use any range
+	for _, affected := range osvVuln.Affected {
+		for _, r := range affected.Ranges {
+			vuln.Type = string(r.Type)
+			for _, event := range r.Events {
+				if event.Introduced != "" {
+					vuln.Introduced = event.Introduced
+				}
+				if event.Fixed != "" {
+					vuln.Fixed = event.Fixed
+				}
+			}
+		}
 	}
-	return introduced.LessThanOrEqual(current) && fixed.GreaterThan(current)
+	return vuln
 }
 
 type osvdb struct {
@@ -206,13 +145,13 @@ func (*osvdb) SendRecvRequest(r *http.Request, dep *pbinternal.Dependency) (*Vul
 	}
 
 	// TODO(jakub): use the JQ accessor isntead?
-	var response OSVResponse
+	var response osvResponse
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	if err != nil {
 		return nil, fmt.Errorf("could not decode response body: %w", err)
 	}
 
-	return toVulnerabilityResponse(&response, dep), nil
+	return toVulnerabilityResponse(response.Vulns, dep), nil
 }
 
 // Normalize the package name for PyPI
@@ -163,7 +163,9 @@ func (e *executor) createOrUpdateEvalStatus(
 		return err
 	}
 
-	return err
+	e.historyService.NotifyEvaluationStored(params.ProjectID)
+
+	return nil
 }
 
 func errorAsActionDetails(err error) string {
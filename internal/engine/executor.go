@@ -6,12 +6,15 @@ package engine
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
 	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/alertwebhooks"
+	"github.com/mindersec/minder/internal/crypto"
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/actions"
@@ -27,6 +30,7 @@ import (
 	minderlogger "github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/providers/manager"
 	provsel "github.com/mindersec/minder/internal/providers/selectors"
+	"github.com/mindersec/minder/internal/vulndb"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
 	"github.com/mindersec/minder/pkg/engine/selectors"
@@ -53,6 +57,8 @@ type executor struct {
 	profileStore    profiles.ProfileStore
 	selBuilder      selectors.SelectionBuilder
 	propService     service.PropertiesService
+	alertWebhooks   alertwebhooks.AlertWebhookService
+	cryptoEngine    crypto.Engine
 }
 
 // NewExecutor creates a new executor
@@ -65,6 +71,8 @@ func NewExecutor(
 	profileStore profiles.ProfileStore,
 	selBuilder selectors.SelectionBuilder,
 	propService service.PropertiesService,
+	alertWebhooks alertwebhooks.AlertWebhookService,
+	cryptoEngine crypto.Engine,
 ) Executor {
 	return &executor{
 		querier:         querier,
@@ -75,6 +83,8 @@ func NewExecutor(
 		profileStore:    profileStore,
 		selBuilder:      selBuilder,
 		propService:     propService,
+		alertWebhooks:   alertWebhooks,
+		cryptoEngine:    cryptoEngine,
 	}
 }
 
@@ -115,7 +125,7 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 
 	defer e.releaseLockAndFlush(ctx, inf)
 
-	dssvc := datasourceservice.NewDataSourceService(e.querier)
+	dssvc := datasourceservice.NewDataSourceService(e.querier, e.cryptoEngine)
 
 	entityType := entities.EntityTypeToDB(inf.Type)
 	// Load all the relevant rule type engines for this entity
@@ -129,6 +139,7 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 		ingestCache,
 		dssvc,
 		eoptions.WithFlagsClient(e.featureFlags),
+		eoptions.WithVulnDB(vulndb.NewDatabase(e.querier)),
 	)
 	if err != nil {
 		return fmt.Errorf("unable to fetch rule type instances for project: %w", err)
@@ -141,15 +152,37 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 		return fmt.Errorf("error while retrieving profiles and rule instances: %w", err)
 	}
 
+	// Reminders may target only the profiles which are due for re-evaluation
+	if len(inf.ProfileIDs) > 0 {
+		profileAggregates = slices.DeleteFunc(profileAggregates, func(p models.ProfileAggregate) bool {
+			return !slices.Contains(inf.ProfileIDs, p.ID)
+		})
+	}
+
+	entityID, err := inf.GetID()
+	if err != nil {
+		return fmt.Errorf("error getting entity id: %w", err)
+	}
+
+	exemptions, err := e.getRuleExemptions(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
 	// For each profile, get the profileEvalStatus first. Then, if the profileEvalStatus is nil
 	// evaluate each rule and store the outcome in the database. If profileEvalStatus is non-nil,
-	// just store it for all rules without evaluation.
+	// just store it for all rules without evaluation. Rules with an active exemption are not
+	// evaluated either, they are recorded as waived.
 	for _, profile := range profileAggregates {
 
 		profileEvalStatus := e.profileEvalStatus(ctx, inf, profile)
 
 		for _, rule := range profile.Rules {
-			if err := e.evaluateRule(ctx, inf, provider, &profile, &rule, ruleEngineCache, profileEvalStatus); err != nil {
+			ruleEvalStatus := profileEvalStatus
+			if ruleEvalStatus == nil {
+				ruleEvalStatus = exemptions.evalStatus(profile.ID, rule.Name)
+			}
+			if err := e.evaluateRule(ctx, inf, provider, &profile, &rule, ruleEngineCache, ruleEvalStatus); err != nil {
 				return fmt.Errorf("error evaluating entity event: %w", err)
 			}
 		}
@@ -181,7 +214,8 @@ func (e *executor) evaluateRule(
 
 	// create the action engine for this rule instance
 	// unlike the rule type engine, this cannot be cached
-	actionEngine, err := actions.NewRuleActions(ctx, ruleEngine.GetRuleType(), provider, &profile.ActionConfig)
+	actionEngine, err := actions.NewRuleActions(ctx, ruleEngine.GetRuleType(), provider, &profile.ActionConfig,
+		e.alertWebhooks.ResolverFor(inf.ProjectID))
 	if err != nil {
 		return fmt.Errorf("cannot create rule actions engine: %w", err)
 	}
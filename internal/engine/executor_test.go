@@ -21,6 +21,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/alertwebhooks"
 	"github.com/mindersec/minder/internal/controlplane/metrics"
 	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/db"
@@ -152,6 +153,7 @@ func TestExecutor_handleEntityEvent(t *testing.T) {
 		StoreEvaluationStatus(
 			gomock.Any(), gomock.Any(), ruleInstanceID, profileID, db.EntitiesRepository, repositoryID, gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(evaluationID, nil)
+	historyService.EXPECT().NotifyEvaluationStored(projectID)
 
 	mockStore.EXPECT().
 		InsertRemediationEvent(gomock.Any(), db.InsertRemediationEventParams{
@@ -244,6 +246,11 @@ default allow = true`,
 			},
 		}, nil)
 
+	// no exemptions for the entity
+	mockStore.EXPECT().
+		ListActiveRuleExemptionsByEntity(gomock.Any(), repositoryID).
+		Return(nil, nil)
+
 	// Mock update lease for lock
 	mockStore.EXPECT().
 		UpdateLease(gomock.Any(), db.UpdateLeaseParams{
@@ -337,6 +344,8 @@ default allow = true`,
 		profiles.NewProfileStore(mockStore),
 		selectors.NewEnv(),
 		mockPropSvc,
+		alertwebhooks.NewAlertWebhookService(mockStore, nil),
+		nil,
 	)
 
 	eiw := entities.NewEntityInfoWrapper().
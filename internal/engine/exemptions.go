@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/db"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+type exemptionKey struct {
+	profileID uuid.UUID
+	ruleName  string
+}
+
+// ruleExemptions are the exemptions which currently apply to an entity
+type ruleExemptions map[exemptionKey]db.RuleExemption
+
+func (e *executor) getRuleExemptions(ctx context.Context, entityID uuid.UUID) (ruleExemptions, error) {
+	return listRuleExemptions(ctx, e.querier, entityID)
+}
+
+func listRuleExemptions(ctx context.Context, querier db.Querier, entityID uuid.UUID) (ruleExemptions, error) {
+	exs, err := querier.ListActiveRuleExemptionsByEntity(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing rule exemptions: %w", err)
+	}
+
+	out := make(ruleExemptions, len(exs))
+	for _, ex := range exs {
+		key := exemptionKey{profileID: ex.ProfileID, ruleName: ex.RuleName}
+		// exemptions are sorted by expiry, keep the one lasting longest
+		if _, ok := out[key]; !ok {
+			out[key] = ex
+		}
+	}
+	return out, nil
+}
+
+// evalStatus returns the evaluation error for a waived rule, or nil if
+// the rule is not exempted.
+func (r ruleExemptions) evalStatus(profileID uuid.UUID, ruleName string) error {
+	ex, ok := r[exemptionKey{profileID: profileID, ruleName: ruleName}]
+	if !ok {
+		return nil
+	}
+	return evalerrors.NewErrEvaluationWaived("waived until %s, approved by %s: %s",
+		ex.ExpiresAt.UTC().Format(time.RFC3339), ex.Approver, ex.Justification)
+}
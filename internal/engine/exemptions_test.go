@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/db"
+)
+
+func TestGetRuleExemptions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	entityID := uuid.New()
+	profileID := uuid.New()
+	otherProfileID := uuid.New()
+	later := time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	mockStore.EXPECT().ListActiveRuleExemptionsByEntity(gomock.Any(), entityID).
+		Return([]db.RuleExemption{
+			{
+				ProfileID:        profileID,
+				RuleName:         "branch_protection",
+				EntityInstanceID: entityID,
+				Justification:    "migration tracked in TICKET-2",
+				Approver:         "alice",
+				ExpiresAt:        later,
+			},
+			{
+				ProfileID:        profileID,
+				RuleName:         "branch_protection",
+				EntityInstanceID: entityID,
+				Justification:    "tracked in TICKET-1",
+				Approver:         "bob",
+				ExpiresAt:        later.AddDate(0, -1, 0),
+			},
+		}, nil)
+
+	e := &executor{querier: mockStore}
+	exemptions, err := e.getRuleExemptions(context.Background(), entityID)
+	require.NoError(t, err)
+
+	evalErr := exemptions.evalStatus(profileID, "branch_protection")
+	require.Error(t, evalErr)
+	require.Equal(t, db.EvalStatusTypesWaived, dbadapter.ErrorAsEvalStatus(evalErr))
+	require.ErrorContains(t, evalErr, "waived until 2026-12-31T00:00:00Z, approved by alice: migration tracked in TICKET-2")
+
+	require.NoError(t, exemptions.evalStatus(profileID, "secret_scanning"))
+	require.NoError(t, exemptions.evalStatus(otherProfileID, "branch_protection"))
+}
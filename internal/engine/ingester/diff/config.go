@@ -14,6 +14,14 @@ const (
 	DepEcosystemGo DependencyEcosystem = "go"
 	// DepEcosystemPyPI is the python dependency ecosystem
 	DepEcosystemPyPI DependencyEcosystem = "pypi"
+	// DepEcosystemMaven is the java (maven and gradle) dependency ecosystem
+	DepEcosystemMaven DependencyEcosystem = "maven"
+	// DepEcosystemCargo is the rust dependency ecosystem
+	DepEcosystemCargo DependencyEcosystem = "cargo"
+	// DepEcosystemRubyGems is the ruby dependency ecosystem
+	DepEcosystemRubyGems DependencyEcosystem = "rubygems"
+	// DepEcosystemNuGet is the .NET dependency ecosystem
+	DepEcosystemNuGet DependencyEcosystem = "nuget"
 	// DepEcosystemNone is the fallback value
 	DepEcosystemNone DependencyEcosystem = ""
 )
@@ -309,6 +309,14 @@ func inventoryToEcosystem(inventory *extractor.Package) pbinternal.DepEcosystem
 		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM
 	case purl.TypeGolang:
 		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO
+	case purl.TypeMaven:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_MAVEN
+	case purl.TypeCargo:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO
+	case purl.TypeGem:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_RUBYGEMS
+	case purl.TypeNuget:
+		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_NUGET
 	default:
 		return pbinternal.DepEcosystem_DEP_ECOSYSTEM_UNSPECIFIED
 	}
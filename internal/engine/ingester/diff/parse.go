@@ -32,6 +32,18 @@ func newEcosystemParser(eco DependencyEcosystem) ecosystemParser {
 		// currently we only support requirements.txt
 		// (the name comes from the rule config, so e.g. requirements-dev.txt would be supported, too)
 		return requirementsParse
+	case string(DepEcosystemMaven):
+		// pom.xml and build.gradle(.kts)
+		return mavenParse
+	case string(DepEcosystemCargo):
+		// Cargo.toml and Cargo.lock
+		return cargoParse
+	case string(DepEcosystemRubyGems):
+		// Gemfile.lock
+		return gemfileLockParse
+	case string(DepEcosystemNuGet):
+		// packages.lock.json and .csproj files
+		return nugetParse
 	case string(DepEcosystemNone):
 		return nil
 	default:
@@ -251,3 +263,62 @@ func getDependencyName(dependencyPath string) string {
 
 	return dependencyName
 }
+
+// patchLineKind is the kind of a line of a patch
+type patchLineKind int
+
+const (
+	patchLineContext patchLineKind = iota
+	patchLineAdded
+	patchLineRemoved
+	patchLineHunk
+)
+
+// patchLineSplitter splits the lines of a patch, which may hold the diff
+// of several files
+type patchLineSplitter struct {
+	// inHunk tells whether a hunk of the current file was seen, after
+	// which lines starting with "+++" or "---" are added or removed
+	// content rather than file headers
+	inHunk bool
+}
+
+// split returns the content of a line of a patch without its prefix,
+// together with the kind of the line. File headers are reported as hunk
+// lines.
+func (p *patchLineSplitter) split(line string) (string, patchLineKind) {
+	switch {
+	case strings.HasPrefix(line, "diff "):
+		// a new file starts
+		p.inHunk = false
+		return line, patchLineHunk
+	case strings.HasPrefix(line, "@@"):
+		p.inHunk = true
+		return line, patchLineHunk
+	case !p.inHunk && (strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "---")):
+		return line, patchLineHunk
+	case strings.HasPrefix(line, "+"):
+		return line[1:], patchLineAdded
+	case strings.HasPrefix(line, "-"):
+		return line[1:], patchLineRemoved
+	case strings.HasPrefix(line, " "):
+		return line[1:], patchLineContext
+	default:
+		return line, patchLineContext
+	}
+}
+
+// lowestVersion returns the lowest version allowed by a version requirement
+// such as "^1.2", ">= 1.2.3, < 2" or "[1.2.3, )", or the empty string if
+// it cannot be told
+func lowestVersion(requirement string) string {
+	first, _, _ := strings.Cut(requirement, ",")
+	version := strings.TrimLeft(strings.TrimSpace(first), "^~=>[( ")
+	if version == "" || strings.ContainsAny(version, "*<xX$") {
+		return ""
+	}
+	if version[0] < '0' || version[0] > '9' {
+		return ""
+	}
+	return version
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+const cargoLockPackageSection = "[[package]]"
+
+var (
+	tomlSectionRegex = regexp.MustCompile(`^\s*(\[\[?)\s*([^\]]+?)\s*\]\]?\s*(?:#.*)?$`)
+	// e.g. serde = "1.0" or serde = { version = "1.0", features = ["derive"] }
+	cargoDependencyRegex = regexp.MustCompile(`^\s*([A-Za-z0-9_-]+)\s*=\s*(?:"([^"]*)"|\{(.*)\})`)
+	tomlKeyRegex         = regexp.MustCompile(`^\s*(name|version|source)\s*=\s*"([^"]*)"`)
+	tomlInlineKeyRegex   = regexp.MustCompile(`\b(version|package)\s*=\s*"([^"]*)"`)
+)
+
+// cargoLockPackage is a [[package]] table of a Cargo.lock being parsed
+type cargoLockPackage struct {
+	name         string
+	version      string
+	source       string
+	versionAdded bool
+}
+
+// cargoParse parses the dependencies added to a Cargo.toml or a Cargo.lock
+// file. For Cargo.toml, the lowest version allowed by the requirement is
+// used.
+func cargoParse(patch string) ([]*pbinternal.Dependency, error) {
+	var deps []*pbinternal.Dependency
+
+	// section is the table the current line is in, or the empty string
+	// if the hunk does not tell
+	section := ""
+	var pkg *cargoLockPackage
+	endPackage := func(complete bool) {
+		if dep := pkg.toDependency(section != "", complete); dep != nil {
+			deps = append(deps, dep)
+		}
+		pkg = nil
+	}
+
+	var patchLines patchLineSplitter
+	scanner := bufio.NewScanner(strings.NewReader(patch))
+	for scanner.Scan() {
+		content, kind := patchLines.split(scanner.Text())
+		switch kind {
+		case patchLineRemoved:
+			continue
+		case patchLineHunk:
+			endPackage(false)
+			section = ""
+			continue
+		case patchLineContext, patchLineAdded:
+		}
+
+		if m := tomlSectionRegex.FindStringSubmatch(content); m != nil {
+			endPackage(true)
+			section = m[2]
+			if m[1] == "[[" {
+				section = "[[" + section + "]]"
+			}
+			continue
+		}
+		if strings.TrimSpace(content) == "" {
+			endPackage(true)
+			continue
+		}
+
+		switch {
+		case section == cargoLockPackageSection || section == "":
+			if m := tomlKeyRegex.FindStringSubmatch(content); m != nil {
+				if m[1] == "name" {
+					endPackage(true)
+					pkg = &cargoLockPackage{}
+				}
+				if pkg != nil {
+					pkg.set(m[1], m[2], kind == patchLineAdded)
+					continue
+				}
+			}
+			if section == "" && kind == patchLineAdded && !isCargoPackageKey(content) {
+				if dep := cargoManifestDependency(content); dep != nil {
+					deps = append(deps, dep)
+				}
+			}
+		case kind != patchLineAdded:
+			continue
+		case strings.HasSuffix(section, "dependencies"):
+			if dep := cargoManifestDependency(content); dep != nil {
+				deps = append(deps, dep)
+			}
+		case strings.Contains(section, "dependencies."):
+			// e.g. [dependencies.serde]
+			_, name, _ := strings.Cut(section, "dependencies.")
+			if m := tomlKeyRegex.FindStringSubmatch(content); m != nil && m[1] == "version" {
+				if version := lowestVersion(m[2]); version != "" {
+					deps = append(deps, newCargoDependency(name, version))
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	endPackage(false)
+
+	return deps, nil
+}
+
+// cargoManifestDependency parses a dependency of a Cargo.toml dependency
+// table. Dependencies without a version, such as path and git ones, are
+// skipped.
+func cargoManifestDependency(content string) *pbinternal.Dependency {
+	m := cargoDependencyRegex.FindStringSubmatch(content)
+	if m == nil {
+		return nil
+	}
+	name, requirement := m[1], m[2]
+	if m[3] != "" {
+		for _, kv := range tomlInlineKeyRegex.FindAllStringSubmatch(m[3], -1) {
+			switch kv[1] {
+			case "version":
+				requirement = kv[2]
+			case "package":
+				// the dependency is renamed
+				name = kv[2]
+			}
+		}
+	}
+	version := lowestVersion(requirement)
+	if version == "" {
+		return nil
+	}
+	return newCargoDependency(name, version)
+}
+
+// isCargoPackageKey reports whether the line sets a key of the [package]
+// table of a Cargo.toml whose value may look like a version
+func isCargoPackageKey(content string) bool {
+	key, _, _ := strings.Cut(strings.TrimSpace(content), "=")
+	switch strings.TrimSpace(key) {
+	case "name", "version", "edition", "rust-version", "resolver", "source", "checksum":
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *cargoLockPackage) set(key, value string, added bool) {
+	switch key {
+	case "name":
+		p.name = value
+	case "version":
+		p.version = value
+		p.versionAdded = added
+	case "source":
+		p.source = value
+	}
+}
+
+// toDependency returns the dependency of the package if its version was
+// added. Packages of the workspace have no source, so a package is only
+// reported without a source if the patch ends before its source could be
+// read.
+func (p *cargoLockPackage) toDependency(knownSection, complete bool) *pbinternal.Dependency {
+	if p == nil || !p.versionAdded || p.name == "" || p.version == "" {
+		return nil
+	}
+	switch {
+	case strings.HasPrefix(p.source, "registry+"), strings.HasPrefix(p.source, "sparse+"):
+	case p.source == "" && knownSection && !complete:
+	default:
+		return nil
+	}
+	return &pbinternal.Dependency{
+		Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO,
+		Name:      p.name,
+		Version:   p.version,
+	}
+}
+
+// newCargoDependency returns a dependency with the version padded to the
+// three components of semver, as "1.2" requires 1.2.0 or later
+func newCargoDependency(name, version string) *pbinternal.Dependency {
+	core, rest, hasPre := strings.Cut(version, "-")
+	for strings.Count(core, ".") < 2 {
+		core += ".0"
+	}
+	if hasPre {
+		core += "-" + rest
+	}
+	return &pbinternal.Dependency{
+		Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO,
+		Name:      name,
+		Version:   core,
+	}
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+func TestCargoParse(t *testing.T) {
+	t.Parallel()
+
+	cargo := func(name, version string) *pbinternal.Dependency {
+		return &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_CARGO,
+			Name:      name,
+			Version:   version,
+		}
+	}
+
+	tests := []struct {
+		description          string
+		content              string
+		expectedDependencies []*pbinternal.Dependency
+	}{
+		{
+			description: "Cargo.toml dependencies",
+			content: `@@ -1,10 +1,14 @@
+ [package]
+ name = "app"
+-version = "0.1.0"
++version = "0.2.0"
+ edition = "2021"
+ 
+ [dependencies]
++serde = { version = "1.0.100", features = ["derive"] }
++tokio = "^1"
++local = { path = "../local" }
++yaml = { package = "serde_yaml", version = "~0.9.2" }
+ 
+ [dev-dependencies.criterion]
++version = "0.5"`,
+			expectedDependencies: []*pbinternal.Dependency{
+				cargo("serde", "1.0.100"),
+				cargo("tokio", "1.0.0"),
+				cargo("serde_yaml", "0.9.2"),
+				cargo("criterion", "0.5.0"),
+			},
+		},
+		{
+			description: "Cargo.toml hunk without section",
+			content: `@@ -12,3 +12,4 @@
+ regex = "1.9"
++time = "0.3.20"
++rust-version = "1.70"`,
+			expectedDependencies: []*pbinternal.Dependency{
+				cargo("time", "0.3.20"),
+			},
+		},
+		{
+			description: "Cargo.lock version bump",
+			content: `@@ -100,8 +100,8 @@
+ 
+ [[package]]
+ name = "h2"
+-version = "0.3.20"
++version = "0.3.24"
+ source = "registry+https://github.com/rust-lang/crates.io-index"
+-checksum = "97ec8491ebaf99c8eaa73058b045fe58073cd6be7f596ac993ced0b0a0c01049"
++checksum = "bb2c4422095b67ee78da96fbb51a4cc413b3b25883c7717ff7ca1ab31022c9c9"
+ dependencies = [`,
+			expectedDependencies: []*pbinternal.Dependency{
+				cargo("h2", "0.3.24"),
+			},
+		},
+		{
+			description: "Cargo.lock workspace package is skipped",
+			content: `@@ -1,7 +1,7 @@
+ [[package]]
+ name = "app"
+-version = "0.1.0"
++version = "0.2.0"
+ dependencies = [
+  "serde",
+ ]
+ 
+ [[package]]
+ name = "serde"`,
+			expectedDependencies: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			got, err := cargoParse(tt.content)
+			require.NoError(t, err)
+			assertDependencies(t, tt.expectedDependencies, got)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+var (
+	mavenTagRegex = regexp.MustCompile(`<(groupId|artifactId|version)>\s*([^<\s]+)\s*</`)
+	// e.g. implementation 'group:artifact:1.0' or api("group:artifact:1.0@jar")
+	gradleDependencyRegex = regexp.MustCompile(
+		`^\s*\w+\s*\(?\s*['"]([^:'"\s]+):([^:'"\s]+):([^:'"@\s]+)(?:@\w+)?['"]`)
+)
+
+// mavenDependency is a <dependency> element of a pom.xml being parsed
+type mavenDependency struct {
+	groupID    string
+	artifactID string
+	version    string
+	added      bool
+}
+
+// mavenParse parses the dependencies added to a pom.xml or a build.gradle
+// file. Versions given by properties cannot be resolved from the patch,
+// and are skipped.
+func mavenParse(patch string) ([]*pbinternal.Dependency, error) {
+	var deps []*pbinternal.Dependency
+
+	// a hunk may start in the middle of a <dependency> element
+	current := &mavenDependency{}
+	inExclusions := false
+
+	var patchLines patchLineSplitter
+	scanner := bufio.NewScanner(strings.NewReader(patch))
+	for scanner.Scan() {
+		content, kind := patchLines.split(scanner.Text())
+		switch kind {
+		case patchLineRemoved:
+			continue
+		case patchLineHunk:
+			current = &mavenDependency{}
+			inExclusions = false
+			continue
+		case patchLineContext, patchLineAdded:
+		}
+
+		if m := gradleDependencyRegex.FindStringSubmatch(content); m != nil {
+			if kind == patchLineAdded && !strings.Contains(m[3], "$") {
+				deps = append(deps, &pbinternal.Dependency{
+					Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_MAVEN,
+					Name:      m[1] + ":" + m[2],
+					Version:   m[3],
+				})
+			}
+			continue
+		}
+
+		if strings.Contains(content, "<dependency>") {
+			current = &mavenDependency{}
+		}
+		if strings.Contains(content, "<exclusions>") {
+			inExclusions = true
+		}
+		if current != nil && !inExclusions {
+			current.set(content, kind == patchLineAdded)
+		}
+		if strings.Contains(content, "</exclusions>") {
+			inExclusions = false
+		}
+		if strings.Contains(content, "</dependency>") && current != nil {
+			if dep := current.toDependency(); dep != nil {
+				deps = append(deps, dep)
+			}
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return deps, nil
+}
+
+func (d *mavenDependency) set(content string, added bool) {
+	for _, m := range mavenTagRegex.FindAllStringSubmatch(content, -1) {
+		switch m[1] {
+		case "groupId":
+			d.groupID = m[2]
+		case "artifactId":
+			d.artifactID = m[2]
+		case "version":
+			d.version = m[2]
+		}
+		d.added = d.added || added
+	}
+}
+
+func (d *mavenDependency) toDependency() *pbinternal.Dependency {
+	if !d.added || d.groupID == "" || d.artifactID == "" || d.version == "" || strings.Contains(d.version, "$") {
+		return nil
+	}
+	version := d.version
+	if strings.HasPrefix(version, "[") || strings.HasPrefix(version, "(") {
+		version = lowestVersion(version)
+		if version == "" {
+			return nil
+		}
+	}
+	return &pbinternal.Dependency{
+		Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_MAVEN,
+		Name:      d.groupID + ":" + d.artifactID,
+		Version:   version,
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+func TestMavenParse(t *testing.T) {
+	t.Parallel()
+
+	maven := func(name, version string) *pbinternal.Dependency {
+		return &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_MAVEN,
+			Name:      name,
+			Version:   version,
+		}
+	}
+
+	tests := []struct {
+		description          string
+		content              string
+		expectedDependencies []*pbinternal.Dependency
+	}{
+		{
+			description: "pom.xml new dependency",
+			content: `@@ -20,6 +20,11 @@
+     <dependencies>
++        <dependency>
++            <groupId>org.apache.logging.log4j</groupId>
++            <artifactId>log4j-core</artifactId>
++            <version>2.14.1</version>
++        </dependency>
+         <dependency>
+             <groupId>junit</groupId>`,
+			expectedDependencies: []*pbinternal.Dependency{
+				maven("org.apache.logging.log4j:log4j-core", "2.14.1"),
+			},
+		},
+		{
+			description: "pom.xml version bump with context",
+			content: `@@ -30,7 +30,7 @@
+         <dependency>
+             <groupId>com.fasterxml.jackson.core</groupId>
+             <artifactId>jackson-databind</artifactId>
+-            <version>2.9.10</version>
++            <version>2.9.10.1</version>
+         </dependency>`,
+			expectedDependencies: []*pbinternal.Dependency{
+				maven("com.fasterxml.jackson.core:jackson-databind", "2.9.10.1"),
+			},
+		},
+		{
+			description: "pom.xml ignores exclusions, properties and unchanged dependencies",
+			content: `@@ -30,7 +30,17 @@
+         <dependency>
+             <groupId>junit</groupId>
+             <artifactId>junit</artifactId>
+             <version>4.13.2</version>
++            <exclusions>
++                <exclusion>
++                    <groupId>org.hamcrest</groupId>
++                    <artifactId>hamcrest-core</artifactId>
++                </exclusion>
++            </exclusions>
+         </dependency>
++        <dependency>
++            <groupId>org.springframework</groupId>
++            <artifactId>spring-core</artifactId>
++            <version>${spring.version}</version>
++        </dependency>`,
+			expectedDependencies: nil,
+		},
+		{
+			description: "pom.xml project version is not a dependency",
+			content: `@@ -1,6 +1,6 @@
+     <groupId>com.example</groupId>
+     <artifactId>app</artifactId>
+-    <version>1.0.0</version>
++    <version>1.1.0</version>
+     <packaging>jar</packaging>`,
+			expectedDependencies: nil,
+		},
+		{
+			description: "build.gradle",
+			content: `@@ -10,4 +10,6 @@ dependencies {
+     implementation 'com.google.guava:guava:31.1-jre'
++    implementation("org.yaml:snakeyaml:1.33")
++    testImplementation 'org.mockito:mockito-core:5.2.0@jar'
++    implementation "org.slf4j:slf4j-api:$slf4jVersion"
+-    implementation 'commons-io:commons-io:2.6'`,
+			expectedDependencies: []*pbinternal.Dependency{
+				maven("org.yaml:snakeyaml", "1.33"),
+				maven("org.mockito:mockito-core", "5.2.0"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			got, err := mavenParse(tt.content)
+			require.NoError(t, err)
+			assertDependencies(t, tt.expectedDependencies, got)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"regexp"
+	"strings"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+var (
+	nugetResolvedRegex     = regexp.MustCompile(`^\+\s*"resolved"\s*:\s*"([^"]+)"`)
+	nugetIncludeRegex      = regexp.MustCompile(`\b(?:Include|Update)\s*=\s*"([^"]+)"`)
+	nugetVersionAttrRegex  = regexp.MustCompile(`\bVersion\s*=\s*"([^"]+)"`)
+	nugetVersionChildRegex = regexp.MustCompile(`<Version>\s*([^<\s]+)\s*</Version>`)
+)
+
+// nugetParse parses the packages added to a packages.lock.json or to a
+// project file such as a .csproj
+func nugetParse(patch string) ([]*pbinternal.Dependency, error) {
+	var deps []*pbinternal.Dependency
+	lines := strings.Split(patch, "\n")
+
+	// pending is the package of a <PackageReference> element whose version
+	// is given in a child element
+	pending := ""
+
+	var patchLines patchLineSplitter
+	for i, line := range lines {
+		if m := nugetResolvedRegex.FindStringSubmatch(line); m != nil {
+			// packages.lock.json
+			if name := findDependencyName(i, lines); name != "" {
+				deps = append(deps, newNuGetDependency(name, m[1]))
+			}
+			continue
+		}
+
+		content, kind := patchLines.split(line)
+		if kind == patchLineRemoved || kind == patchLineHunk {
+			continue
+		}
+
+		if strings.Contains(content, "<PackageReference") {
+			pending = ""
+			include := nugetIncludeRegex.FindStringSubmatch(content)
+			if include == nil {
+				continue
+			}
+			if version := nugetVersionAttrRegex.FindStringSubmatch(content); version != nil {
+				if kind == patchLineAdded {
+					deps = appendNuGetDependency(deps, include[1], version[1])
+				}
+			} else if !strings.Contains(content, "/>") {
+				pending = include[1]
+			}
+			continue
+		}
+
+		if pending == "" {
+			continue
+		}
+		if m := nugetVersionChildRegex.FindStringSubmatch(content); m != nil && kind == patchLineAdded {
+			deps = appendNuGetDependency(deps, pending, m[1])
+		}
+		if strings.Contains(content, "</PackageReference>") || nugetVersionChildRegex.MatchString(content) {
+			pending = ""
+		}
+	}
+
+	return deps, nil
+}
+
+// appendNuGetDependency appends the package with the lowest version allowed
+// by the version range, unless the range is floating
+func appendNuGetDependency(deps []*pbinternal.Dependency, name, requirement string) []*pbinternal.Dependency {
+	version := lowestVersion(requirement)
+	if version == "" {
+		return deps
+	}
+	return append(deps, newNuGetDependency(name, version))
+}
+
+func newNuGetDependency(name, version string) *pbinternal.Dependency {
+	return &pbinternal.Dependency{
+		Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_NUGET,
+		Name:      name,
+		Version:   version,
+	}
+}
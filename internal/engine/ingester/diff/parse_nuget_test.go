@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+func TestNuGetParse(t *testing.T) {
+	t.Parallel()
+
+	nuget := func(name, version string) *pbinternal.Dependency {
+		return &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_NUGET,
+			Name:      name,
+			Version:   version,
+		}
+	}
+
+	tests := []struct {
+		description          string
+		content              string
+		expectedDependencies []*pbinternal.Dependency
+	}{
+		{
+			description: "packages.lock.json",
+			content: `@@ -3,10 +3,10 @@
+   "dependencies": {
+     "net8.0": {
+       "Newtonsoft.Json": {
+         "type": "Direct",
+-        "requested": "[12.0.1, )",
+-        "resolved": "12.0.1",
+-        "contentHash": "abc"
++        "requested": "[13.0.1, )",
++        "resolved": "13.0.1",
++        "contentHash": "def"
+       },`,
+			expectedDependencies: []*pbinternal.Dependency{
+				nuget("Newtonsoft.Json", "13.0.1"),
+			},
+		},
+		{
+			description: ".csproj",
+			content: `@@ -8,6 +8,12 @@
+   <ItemGroup>
++    <PackageReference Include="Serilog" Version="2.12.0" />
++    <PackageReference Version="[6.0.0, )" Include="System.Text.Json" />
++    <PackageReference Include="Dapper" Version="2.*" />
++    <PackageReference Include="Npgsql">
++      <Version>7.0.4</Version>
++    </PackageReference>
+     <PackageReference Include="xunit" Version="2.4.2" />
+   </ItemGroup>`,
+			expectedDependencies: []*pbinternal.Dependency{
+				nuget("Serilog", "2.12.0"),
+				nuget("System.Text.Json", "6.0.0"),
+				nuget("Npgsql", "7.0.4"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			got, err := nugetParse(tt.content)
+			require.NoError(t, err)
+			assertDependencies(t, tt.expectedDependencies, got)
+		})
+	}
+}
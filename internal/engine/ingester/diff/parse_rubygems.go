@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+var (
+	// the gems of a Gemfile.lock are indented by four spaces, their own
+	// dependencies by six
+	gemSpecRegex = regexp.MustCompile(`^    ([A-Za-z0-9_.-]+) \(([^)]+)\)\s*$`)
+	// e.g. GEM, GIT, PATH or PLATFORMS
+	gemfileLockSectionRegex = regexp.MustCompile(`^([A-Z][A-Z ]*)$`)
+)
+
+// gemfileLockParse parses the gems added to a Gemfile.lock. Gems of git
+// and path sources are skipped.
+func gemfileLockParse(patch string) ([]*pbinternal.Dependency, error) {
+	var deps []*pbinternal.Dependency
+
+	// section is the section the current line is in, or the empty string
+	// if the hunk does not tell
+	section := ""
+
+	var patchLines patchLineSplitter
+	scanner := bufio.NewScanner(strings.NewReader(patch))
+	for scanner.Scan() {
+		content, kind := patchLines.split(scanner.Text())
+		switch kind {
+		case patchLineRemoved:
+			continue
+		case patchLineHunk:
+			section = ""
+			continue
+		case patchLineContext, patchLineAdded:
+		}
+
+		if m := gemfileLockSectionRegex.FindStringSubmatch(content); m != nil {
+			section = m[1]
+			continue
+		}
+		if kind != patchLineAdded || (section != "" && section != "GEM") {
+			continue
+		}
+
+		m := gemSpecRegex.FindStringSubmatch(content)
+		if m == nil {
+			continue
+		}
+		// strip the platform, e.g. 1.15.4-x86_64-linux
+		version, _, _ := strings.Cut(m[2], "-")
+		deps = append(deps, &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_RUBYGEMS,
+			Name:      m[1],
+			Version:   version,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return deps, nil
+}
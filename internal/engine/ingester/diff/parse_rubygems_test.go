@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+func TestGemfileLockParse(t *testing.T) {
+	t.Parallel()
+
+	gem := func(name, version string) *pbinternal.Dependency {
+		return &pbinternal.Dependency{
+			Ecosystem: pbinternal.DepEcosystem_DEP_ECOSYSTEM_RUBYGEMS,
+			Name:      name,
+			Version:   version,
+		}
+	}
+
+	tests := []struct {
+		description          string
+		content              string
+		expectedDependencies []*pbinternal.Dependency
+	}{
+		{
+			description: "gems and their dependencies",
+			content: `@@ -80,8 +80,10 @@ GEM
+     nio4r (2.5.9)
+-    nokogiri (1.13.9-x86_64-linux)
++    nokogiri (1.15.4-x86_64-linux)
++      racc (~> 1.4)
++    rack (2.2.3)
+-      racc (~> 1.4)`,
+			expectedDependencies: []*pbinternal.Dependency{
+				gem("nokogiri", "1.15.4"),
+				gem("rack", "2.2.3"),
+			},
+		},
+		{
+			description: "path gems are skipped",
+			content: `@@ -1,6 +1,6 @@
+ PATH
+   remote: .
+   specs:
+-    mygem (0.1.0)
++    mygem (0.2.0)
+ 
+ GEM
+   remote: https://rubygems.org/
+   specs:
++    rake (13.0.6)`,
+			expectedDependencies: []*pbinternal.Dependency{
+				gem("rake", "13.0.6"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			got, err := gemfileLockParse(tt.content)
+			require.NoError(t, err)
+			assertDependencies(t, tt.expectedDependencies, got)
+		})
+	}
+}
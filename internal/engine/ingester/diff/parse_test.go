@@ -522,3 +522,81 @@ func TestNpmParse(t *testing.T) {
 		})
 	}
 }
+
+func assertDependencies(t *testing.T, expected []*pbinternal.Dependency, got []*pbinternal.Dependency) {
+	t.Helper()
+
+	assert.Equal(t, len(expected), len(got), "mismatched dependency count")
+	for i := range min(len(expected), len(got)) {
+		if !proto.Equal(expected[i], got[i]) {
+			t.Errorf("mismatch at index %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestLowestVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"1.2.3":          "1.2.3",
+		"^1.2":           "1.2",
+		"~1.2.3":         "1.2.3",
+		">= 1.2.3, < 2":  "1.2.3",
+		"[13.0.1, )":     "13.0.1",
+		"(1.0,2.0]":      "1.0",
+		"=0.4.0":         "0.4.0",
+		"*":              "",
+		"13.*":           "",
+		"${jackson.ver}": "",
+		"latest":         "",
+	}
+	for requirement, expected := range tests {
+		t.Run(requirement, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, expected, lowestVersion(requirement))
+		})
+	}
+}
+
+func TestPatchLineSplitter(t *testing.T) {
+	t.Parallel()
+
+	patch := []string{
+		"diff --git a/README.md b/README.md",
+		"--- a/README.md",
+		"+++ b/README.md",
+		"@@ -1,3 +1,3 @@",
+		" # Title",
+		"---- old rule",
+		"++++ new rule",
+		"diff --git a/Cargo.toml b/Cargo.toml",
+		"--- a/Cargo.toml",
+		"+++ b/Cargo.toml",
+		"@@ -1 +1 @@",
+		"+serde = \"1.0\"",
+	}
+	expected := []struct {
+		content string
+		kind    patchLineKind
+	}{
+		{"diff --git a/README.md b/README.md", patchLineHunk},
+		{"--- a/README.md", patchLineHunk},
+		{"+++ b/README.md", patchLineHunk},
+		{"@@ -1,3 +1,3 @@", patchLineHunk},
+		{"# Title", patchLineContext},
+		{"--- old rule", patchLineRemoved},
+		{"+++ new rule", patchLineAdded},
+		{"diff --git a/Cargo.toml b/Cargo.toml", patchLineHunk},
+		{"--- a/Cargo.toml", patchLineHunk},
+		{"+++ b/Cargo.toml", patchLineHunk},
+		{"@@ -1 +1 @@", patchLineHunk},
+		{"serde = \"1.0\"", patchLineAdded},
+	}
+
+	var splitter patchLineSplitter
+	for i, line := range patch {
+		content, kind := splitter.split(line)
+		assert.Equal(t, expected[i].content, content, line)
+		assert.Equal(t, expected[i].kind, kind, line)
+	}
+}
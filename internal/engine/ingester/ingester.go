@@ -15,6 +15,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/ingester/diff"
 	"github.com/mindersec/minder/internal/engine/ingester/git"
 	"github.com/mindersec/minder/internal/engine/ingester/rest"
+	"github.com/mindersec/minder/internal/engine/ingester/sbom"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 )
@@ -27,6 +28,7 @@ var _ interfaces.Ingester = (*rest.Ingestor)(nil)
 var _ interfaces.Ingester = (*git.Git)(nil)
 var _ interfaces.Ingester = (*diff.Diff)(nil)
 var _ interfaces.Ingester = (*deps.Deps)(nil)
+var _ interfaces.Ingester = (*sbom.SBOM)(nil)
 
 // NewRuleDataIngest creates a new rule data ingest based no the given rule
 // type definition.
@@ -74,6 +76,8 @@ func NewRuleDataIngest(rt *pb.RuleType, provider interfaces.Provider) (interface
 			return nil, errors.New("provider does not implement git trait")
 		}
 		return deps.NewDepsIngester(ing.GetDeps(), client)
+	case sbom.SBOMRuleDataIngestType:
+		return sbom.NewSBOMIngester(ing.GetSbom(), provider)
 	default:
 		return nil, fmt.Errorf("unsupported rule type engine: %s", rt.Def.Ingest.Type)
 	}
@@ -0,0 +1,428 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sbom provides the sbom rule data ingest engine
+package sbom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/helper/iofs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mdeps "github.com/mindersec/minder/internal/deps"
+	"github.com/mindersec/minder/internal/deps/scalibr"
+	artif "github.com/mindersec/minder/internal/providers/artifact"
+	msbom "github.com/mindersec/minder/internal/sbom"
+	"github.com/mindersec/minder/internal/verifier"
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// SBOMRuleDataIngestType is the type of the sbom rule data ingest engine
+	SBOMRuleDataIngestType = "sbom"
+	defaultBranch          = "main"
+)
+
+const (
+	// SourceScan generates the SBOM by scanning a clone of the repository
+	SourceScan = "scan"
+	// SourceRepository reads an SBOM document checked into the repository
+	SourceRepository = "repository"
+	// SourceAttestation reads a verified SBOM attestation of a container artifact
+	SourceAttestation = "attestation"
+)
+
+// wellKnownPaths are tried in order when the repository source has no path
+var wellKnownPaths = []string{
+	"sbom.cdx.json",
+	"bom.json",
+	"sbom.spdx.json",
+	"sbom.json",
+	"sbom.spdx",
+}
+
+// sbomPredicateTypes are the in-toto predicate type prefixes of SBOM attestations
+var sbomPredicateTypes = []string{
+	"https://cyclonedx.org/bom",
+	"https://spdx.dev/Document",
+}
+
+// SBOM is the engine for a rule type that uses sbom data ingest
+type SBOM struct {
+	cfg    *pb.SBOMType
+	source string
+	format msbom.Format
+
+	prov      interfaces.Provider
+	extractor mdeps.Extractor
+
+	// artifactVerifier is only set by tests, the sigstore verifier is
+	// otherwise built on demand from the provider credentials.
+	artifactVerifier verifyif.ArtifactVerifier
+}
+
+// Config is the set of parameters to the sbom rule data ingest engine
+type Config struct {
+	// Branch overrides the branch for the scan and repository sources
+	Branch string `json:"branch" yaml:"branch" mapstructure:"branch"`
+	// Path overrides the document path for the repository source
+	Path string `json:"path" yaml:"path" mapstructure:"path"`
+	// Tags limits the artifact versions checked for the attestation source
+	Tags []string `json:"tags" yaml:"tags" mapstructure:"tags"`
+	// TagRegex limits the artifact versions checked for the attestation source
+	TagRegex string `json:"tag_regex" yaml:"tag_regex" mapstructure:"tag_regex"`
+	// Sigstore is the sigstore TUF repository used to verify attestations
+	Sigstore string `json:"sigstore" yaml:"sigstore" mapstructure:"sigstore"`
+}
+
+// NewSBOMIngester creates a new sbom rule data ingest engine
+func NewSBOMIngester(cfg *pb.SBOMType, prov interfaces.Provider) (*SBOM, error) {
+	if prov == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.SBOMType{}
+	}
+
+	format, err := msbom.ParseFormat(cfg.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	source := cfg.GetSource()
+	switch source {
+	case "":
+		source = SourceScan
+		fallthrough
+	case SourceScan, SourceRepository:
+		if _, err := interfaces.As[interfaces.GitProvider](prov); err != nil {
+			return nil, errors.New("provider does not implement git trait")
+		}
+	case SourceAttestation:
+		if _, err := interfaces.As[provifv1.ArtifactProvider](prov); err != nil {
+			return nil, errors.New("provider does not implement artifact trait")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sbom source: %q", source)
+	}
+
+	return &SBOM{
+		cfg:       cfg,
+		source:    source,
+		format:    format,
+		prov:      prov,
+		extractor: scalibr.NewExtractor(),
+	}, nil
+}
+
+// GetType returns the type of the sbom rule data ingest engine
+func (*SBOM) GetType() string {
+	return SBOMRuleDataIngestType
+}
+
+// GetConfig returns the config for the sbom rule data ingest engine
+func (si *SBOM) GetConfig() protoreflect.ProtoMessage {
+	return si.cfg
+}
+
+// Ingest produces the SBOM of the entity. The parsed document is exposed to
+// the rule as "document", rendered in the configured format.
+func (si *SBOM) Ingest(ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any) (*interfaces.Ingested, error) {
+	userCfg := &Config{}
+	if err := mapstructure.Decode(params, userCfg); err != nil {
+		return nil, fmt.Errorf("failed to read sbom ingester configuration from params: %w", err)
+	}
+
+	switch entity := ent.(type) {
+	case *pb.Repository:
+		if si.source == SourceAttestation {
+			return nil, fmt.Errorf("sbom source %q is only supported for artifacts", si.source)
+		}
+		return si.ingestRepository(ctx, entity, userCfg)
+	case *pb.Artifact:
+		if si.source != SourceAttestation {
+			return nil, fmt.Errorf("sbom source %q is only supported for repositories", si.source)
+		}
+		return si.ingestArtifact(ctx, entity, userCfg)
+	default:
+		return nil, fmt.Errorf("sbom is only supported for repositories and artifacts")
+	}
+}
+
+func (si *SBOM) ingestRepository(
+	ctx context.Context, repo *pb.Repository, userCfg *Config,
+) (*interfaces.Ingested, error) {
+	if repo.GetCloneUrl() == "" {
+		return nil, fmt.Errorf("could not get clone url")
+	}
+
+	branch := si.getBranch(repo, userCfg.Branch)
+	zerolog.Ctx(ctx).Debug().Str("source", si.source).
+		Msgf("building sbom from %s#%s", repo.GetCloneUrl(), branch)
+
+	gitprov, err := interfaces.As[interfaces.GitProvider](si.prov)
+	if err != nil {
+		return nil, errors.New("provider does not implement git trait")
+	}
+
+	clone, err := gitprov.Clone(ctx, repo.GetCloneUrl(), branch)
+	if err != nil {
+		if errors.Is(err, provifv1.ErrProviderGitBranchNotFound) {
+			return nil, fmt.Errorf("%w: %s: branch %s", interfaces.ErrEvaluationFailed,
+				provifv1.ErrProviderGitBranchNotFound, branch)
+		} else if errors.Is(err, provifv1.ErrRepositoryEmpty) {
+			return nil, fmt.Errorf("%w: %s", interfaces.ErrEvaluationSkipped, provifv1.ErrRepositoryEmpty)
+		}
+		return nil, err
+	}
+
+	wt, err := clone.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get worktree: %w", err)
+	}
+	if wt.Filesystem == nil {
+		return nil, fmt.Errorf("could not get filesystem")
+	}
+
+	head, err := clone.Head()
+	if err != nil {
+		return nil, fmt.Errorf("could not get head: %w", err)
+	}
+
+	var doc *msbom.Document
+	if si.source == SourceRepository {
+		doc, err = readDocument(wt.Filesystem, si.getPath(userCfg.Path))
+	} else {
+		doc, err = si.scan(ctx, wt, repoName(repo), head.Hash().String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := si.render(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interfaces.Ingested{
+		Object: obj,
+		Fs:     wt.Filesystem,
+		Storer: clone.Storer,
+		Checkpoint: checkpoints.NewCheckpointV1Now().
+			WithBranch(branch).
+			WithCommitHash(head.Hash().String()),
+	}, nil
+}
+
+func (si *SBOM) scan(ctx context.Context, wt *git.Worktree, name, version string) (*msbom.Document, error) {
+	nodes, err := si.extractor.ScanFilesystem(ctx, iofs.New(wt.Filesystem))
+	if err != nil {
+		return nil, fmt.Errorf("%T extractor: %w", si.extractor, err)
+	}
+	return msbom.FromNodeList(name, version, nodes), nil
+}
+
+// readDocument reads the SBOM at path, or the first well-known SBOM file
+// when path is empty.
+func readDocument(fs billy.Filesystem, path string) (*msbom.Document, error) {
+	candidates := wellKnownPaths
+	if path != "" {
+		candidates = []string{path}
+	}
+
+	for _, candidate := range candidates {
+		f, err := fs.Open(candidate)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not open %s: %w", candidate, err)
+		}
+		data, err := io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", candidate, err)
+		}
+
+		doc, err := msbom.Read(data)
+		if err != nil {
+			return nil, evalerrors.NewErrEvaluationFailed("invalid SBOM in %s: %s", candidate, err)
+		}
+		return doc, nil
+	}
+
+	if path != "" {
+		return nil, evalerrors.NewErrEvaluationFailed("SBOM %s not found in repository", path)
+	}
+	return nil, evalerrors.NewErrEvaluationFailed("no SBOM found in repository (tried %s)",
+		strings.Join(wellKnownPaths, ", "))
+}
+
+func (si *SBOM) ingestArtifact(
+	ctx context.Context, artifact *pb.Artifact, userCfg *Config,
+) (*interfaces.Ingested, error) {
+	if !strings.EqualFold(artifact.GetType(), string(verifyif.ArtifactTypeContainer)) {
+		return nil, evalerrors.NewErrEvaluationSkipSilently("only container artifacts are supported at the moment")
+	}
+
+	vers, err := interfaces.As[provifv1.ArtifactProvider](si.prov)
+	if err != nil {
+		return nil, errors.New("provider does not implement artifact trait")
+	}
+
+	filter, err := artif.BuildFilter(userCfg.Tags, userCfg.TagRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error building filter from sbom ingester config: %w", err)
+	}
+
+	versions, err := vers.GetArtifactVersions(ctx, artifact, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving artifact versions: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, evalerrors.NewErrEvaluationFailed("no applicable artifact versions found")
+	}
+
+	artifactVerifier, err := si.getVerifier(userCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error getting verifier: %w", err)
+	}
+
+	// Check the newest versions first, so this picks the SBOM of the most
+	// recent applicable version which carries one.
+	slices.SortFunc(versions, func(a, b *pb.ArtifactVersion) int {
+		return b.GetCreatedAt().AsTime().Compare(a.GetCreatedAt().AsTime())
+	})
+	for _, version := range versions {
+		results, err := artifactVerifier.Verify(ctx, verifyif.ArtifactTypeContainer,
+			artifact.GetOwner(), artifact.GetName(), version.GetSha())
+		if err != nil {
+			return nil, fmt.Errorf("failed getting attestations: %w", err)
+		}
+
+		doc, err := sbomFromResults(results)
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			continue
+		}
+
+		obj, err := si.render(doc)
+		if err != nil {
+			return nil, err
+		}
+		return &interfaces.Ingested{
+			Object:     obj,
+			Checkpoint: checkpoints.NewCheckpointV1Now().WithDigest(version.GetSha()),
+		}, nil
+	}
+
+	return nil, evalerrors.NewErrEvaluationFailed("no verified SBOM attestation found for %s", artifact.GetName())
+}
+
+// sbomFromResults returns the document of the first verified SBOM attestation
+// in results, or nil if there is none.
+func sbomFromResults(results []verifyif.Result) (*msbom.Document, error) {
+	for _, res := range results {
+		if !res.IsVerified || res.Statement == nil || !isSBOMPredicate(res.Statement.GetPredicateType()) {
+			continue
+		}
+		data, err := protojson.Marshal(res.Statement.GetPredicate())
+		if err != nil {
+			return nil, fmt.Errorf("error reading attestation predicate: %w", err)
+		}
+		doc, err := msbom.Read(data)
+		if err != nil {
+			return nil, evalerrors.NewErrEvaluationFailed("invalid SBOM attestation: %s", err)
+		}
+		return doc, nil
+	}
+	return nil, nil
+}
+
+func isSBOMPredicate(predicateType string) bool {
+	for _, prefix := range sbomPredicateTypes {
+		if strings.HasPrefix(predicateType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (si *SBOM) getVerifier(userCfg *Config) (verifyif.ArtifactVerifier, error) {
+	if si.artifactVerifier != nil {
+		return si.artifactVerifier, nil
+	}
+
+	var verifieropts []container.AuthMethod
+	if ghcli, err := interfaces.As[provifv1.GitHub](si.prov); err == nil {
+		verifieropts = append(verifieropts, container.WithGitHubClient(ghcli))
+	} else if ocicli, err := interfaces.As[provifv1.OCI](si.prov); err == nil {
+		cauthn, err := ocicli.GetAuthenticator()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get oci authenticator: %w", err)
+		}
+		verifieropts = append(verifieropts, container.WithRegistry(ocicli.GetRegistry()),
+			container.WithAuthenticator(cauthn))
+	}
+
+	return verifier.NewVerifier(verifier.VerifierSigstore, userCfg.Sigstore, verifieropts...)
+}
+
+func (si *SBOM) render(doc *msbom.Document) (map[string]any, error) {
+	rendered, err := doc.Render(si.format)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"source":   si.source,
+		"format":   string(si.format),
+		"document": rendered,
+	}, nil
+}
+
+func (si *SBOM) getBranch(repo *pb.Repository, userConfigBranch string) string {
+	if userConfigBranch != "" {
+		return userConfigBranch
+	}
+	if si.cfg.GetBranch() != "" {
+		return si.cfg.GetBranch()
+	}
+	if repo.GetDefaultBranch() != "" {
+		return repo.GetDefaultBranch()
+	}
+	return defaultBranch
+}
+
+func (si *SBOM) getPath(userConfigPath string) string {
+	if userConfigPath != "" {
+		return userConfigPath
+	}
+	return si.cfg.GetPath()
+}
+
+func repoName(repo *pb.Repository) string {
+	if repo.GetOwner() == "" {
+		return repo.GetName()
+	}
+	return repo.GetOwner() + "/" + repo.GetName()
+}
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sbom
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+	in_toto "github.com/in-toto/attestation/go/v1"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mock_github "github.com/mindersec/minder/internal/providers/github/mock"
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	mockverify "github.com/mindersec/minder/internal/verifier/verifyif/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+func TestNewSBOMIngester(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	gitProv := mock_github.NewMockGit(ctrl)
+
+	_, err := NewSBOMIngester(&pb.SBOMType{Source: "floppy"}, gitProv)
+	require.ErrorContains(t, err, "unsupported sbom source")
+
+	_, err = NewSBOMIngester(&pb.SBOMType{Format: "swid"}, gitProv)
+	require.ErrorContains(t, err, "unsupported SBOM format")
+
+	_, err = NewSBOMIngester(&pb.SBOMType{Source: SourceAttestation}, gitProv)
+	require.ErrorContains(t, err, "artifact trait")
+
+	ing, err := NewSBOMIngester(nil, gitProv)
+	require.NoError(t, err)
+	assert.Equal(t, SourceScan, ing.source)
+}
+
+func TestIngestRepository(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		sampleDir string
+		cfg       *pb.SBOMType
+		params    map[string]any
+		check     func(t *testing.T, obj map[string]any)
+		expectErr error
+	}{{
+		name:      "scan as cyclonedx",
+		sampleDir: "simple-python",
+		check: func(t *testing.T, obj map[string]any) {
+			t.Helper()
+			assert.Equal(t, "scan", obj["source"])
+			doc := obj["document"].(map[string]any)
+			assert.Equal(t, "CycloneDX", doc["bomFormat"])
+			component := doc["metadata"].(map[string]any)["component"].(map[string]any)
+			assert.Equal(t, "stacklok/demo", component["name"])
+			assert.ElementsMatch(t, []string{"requests", "flask"}, names(doc["components"]))
+		},
+	}, {
+		name:      "scan as spdx",
+		sampleDir: "simple-python",
+		cfg:       &pb.SBOMType{Format: "spdx"},
+		check: func(t *testing.T, obj map[string]any) {
+			t.Helper()
+			doc := obj["document"].(map[string]any)
+			assert.Equal(t, "SPDX-2.3", doc["spdxVersion"])
+			assert.ElementsMatch(t, []string{"stacklok/demo", "requests", "flask"}, names(doc["packages"]))
+		},
+	}, {
+		name:      "checked-in document at a well-known path",
+		sampleDir: "checked-in",
+		cfg:       &pb.SBOMType{Source: SourceRepository},
+		check: func(t *testing.T, obj map[string]any) {
+			t.Helper()
+			assert.Equal(t, "repository", obj["source"])
+			doc := obj["document"].(map[string]any)
+			assert.Equal(t, "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79", doc["serialNumber"])
+		},
+	}, {
+		name:      "checked-in document at a configured path",
+		sampleDir: "checked-in",
+		cfg:       &pb.SBOMType{Source: SourceRepository, Path: "missing.json"},
+		params:    map[string]any{"path": "bom.json"},
+		check: func(t *testing.T, obj map[string]any) {
+			t.Helper()
+			assert.Equal(t, []string{"github.com/google/uuid"}, names(obj["document"].(map[string]any)["components"]))
+		},
+	}, {
+		name:      "checked-in document missing",
+		sampleDir: "simple-python",
+		cfg:       &pb.SBOMType{Source: SourceRepository},
+		expectErr: interfaces.ErrEvaluationFailed,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			url := "https://some.url/repo"
+			repoPb := &pb.Repository{
+				Owner:    "stacklok",
+				Name:     "demo",
+				CloneUrl: url,
+			}
+
+			fs := osfs.New(filepath.Join("testdata", tc.sampleDir))
+
+			ctrl := gomock.NewController(t)
+			gitStore := memory.NewStorage()
+			require.NoError(t, gitStore.SetReference(plumbing.NewHashReference(plumbing.Main, plumbing.ZeroHash)))
+			repo, err := git.InitWithOptions(gitStore, fs, git.InitOptions{DefaultBranch: plumbing.Main})
+			require.NoError(t, err)
+
+			gitProv := mock_github.NewMockGit(ctrl)
+			gitProv.EXPECT().Clone(gomock.Any(), url, "main").Return(repo, nil)
+
+			ing, err := NewSBOMIngester(tc.cfg, gitProv)
+			require.NoError(t, err)
+
+			result, err := ing.Ingest(context.Background(), repoPb, tc.params)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, result.Fs)
+			tc.check(t, result.Object.(map[string]any))
+		})
+	}
+}
+
+func TestIngestAttestation(t *testing.T) {
+	t.Parallel()
+
+	cdx, err := os.ReadFile(filepath.Join("testdata", "checked-in", "bom.json"))
+	require.NoError(t, err)
+	predicate := &structpb.Struct{}
+	require.NoError(t, protojson.Unmarshal(cdx, predicate))
+
+	sbomResult := verifyif.Result{
+		IsSigned:   true,
+		IsVerified: true,
+		VerificationResult: verify.VerificationResult{
+			Statement: &in_toto.Statement{
+				PredicateType: "https://cyclonedx.org/bom",
+				Predicate:     predicate,
+			},
+		},
+	}
+	provenanceResult := verifyif.Result{
+		IsSigned:   true,
+		IsVerified: true,
+		VerificationResult: verify.VerificationResult{
+			Statement: &in_toto.Statement{
+				PredicateType: "https://slsa.dev/provenance/v1",
+				Predicate:     &structpb.Struct{},
+			},
+		},
+	}
+	now := time.Now()
+	versions := []*pb.ArtifactVersion{{
+		Sha:       "sha256:old",
+		CreatedAt: timestamppb.New(now.Add(-time.Hour)),
+	}, {
+		Sha:       "sha256:new",
+		CreatedAt: timestamppb.New(now),
+	}}
+
+	for _, tc := range []struct {
+		name      string
+		artifact  *pb.Artifact
+		setup     func(*mock_github.MockGitHub, *mockverify.MockArtifactVerifier)
+		expectSha string
+		expectErr error
+	}{{
+		name:     "newest version with an sbom",
+		artifact: &pb.Artifact{Owner: "stacklok", Name: "app", Type: "container"},
+		setup: func(gh *mock_github.MockGitHub, v *mockverify.MockArtifactVerifier) {
+			gh.EXPECT().GetArtifactVersions(gomock.Any(), gomock.Any(), gomock.Any()).Return(versions, nil)
+			v.EXPECT().Verify(gomock.Any(), verifyif.ArtifactTypeContainer, "stacklok", "app", "sha256:new").
+				Return([]verifyif.Result{provenanceResult}, nil)
+			v.EXPECT().Verify(gomock.Any(), verifyif.ArtifactTypeContainer, "stacklok", "app", "sha256:old").
+				Return([]verifyif.Result{provenanceResult, sbomResult}, nil)
+		},
+		expectSha: "sha256:old",
+	}, {
+		name:     "no sbom attestation",
+		artifact: &pb.Artifact{Owner: "stacklok", Name: "app", Type: "container"},
+		setup: func(gh *mock_github.MockGitHub, v *mockverify.MockArtifactVerifier) {
+			gh.EXPECT().GetArtifactVersions(gomock.Any(), gomock.Any(), gomock.Any()).Return(versions, nil)
+			v.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]verifyif.Result{provenanceResult}, nil).Times(2)
+		},
+		expectErr: interfaces.ErrEvaluationFailed,
+	}, {
+		name:      "non-container artifact",
+		artifact:  &pb.Artifact{Owner: "stacklok", Name: "app", Type: "npm"},
+		setup:     func(*mock_github.MockGitHub, *mockverify.MockArtifactVerifier) {},
+		expectErr: evalerrors.ErrEvaluationSkipSilently,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			gh := mock_github.NewMockGitHub(ctrl)
+			v := mockverify.NewMockArtifactVerifier(ctrl)
+			tc.setup(gh, v)
+
+			ing, err := NewSBOMIngester(&pb.SBOMType{Source: SourceAttestation}, gh)
+			require.NoError(t, err)
+			ing.artifactVerifier = v
+
+			result, err := ing.Ingest(context.Background(), tc.artifact, nil)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectSha, *result.Checkpoint.Checkpoint.Digest)
+			doc := result.Object.(map[string]any)["document"].(map[string]any)
+			assert.Equal(t, []string{"github.com/google/uuid"}, names(doc["components"]))
+		})
+	}
+}
+
+func names(list any) []string {
+	var out []string
+	items, _ := list.([]any)
+	for _, item := range items {
+		if pkg, ok := item.(map[string]any); ok {
+			out = append(out, pkg["name"].(string))
+		}
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./planner.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_engine -destination=./mock/planner.go -source=./planner.go
+//
+
+// Package mock_engine is a generated GoMock package.
+package mock_engine
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	engine "github.com/mindersec/minder/internal/engine"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlanner is a mock of Planner interface.
+type MockPlanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlannerMockRecorder
+	isgomock struct{}
+}
+
+// MockPlannerMockRecorder is the mock recorder for MockPlanner.
+type MockPlannerMockRecorder struct {
+	mock *MockPlanner
+}
+
+// NewMockPlanner creates a new mock instance.
+func NewMockPlanner(ctrl *gomock.Controller) *MockPlanner {
+	mock := &MockPlanner{ctrl: ctrl}
+	mock.recorder = &MockPlannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanner) EXPECT() *MockPlannerMockRecorder {
+	return m.recorder
+}
+
+// PlanProfile mocks base method.
+func (m *MockPlanner) PlanProfile(ctx context.Context, projectID uuid.UUID, profile *v1.Profile) (*engine.ProfilePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanProfile", ctx, projectID, profile)
+	ret0, _ := ret[0].(*engine.ProfilePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanProfile indicates an expected call of PlanProfile.
+func (mr *MockPlannerMockRecorder) PlanProfile(ctx, projectID, profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanProfile", reflect.TypeOf((*MockPlanner)(nil).PlanProfile), ctx, projectID, profile)
+}
@@ -6,6 +6,7 @@
 package options
 
 import (
+	"github.com/mindersec/minder/internal/vulndb"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/flags"
@@ -53,3 +54,45 @@ func WithDataSources(ds *v1datasources.DataSourceRegistry) interfaces.Option {
 		return nil
 	}
 }
+
+// SupportsProfileOnly interface advertises the fact that the implementer
+// acts on pull requests while evaluating, for instance by submitting a
+// review, and can be restricted to only report the outcome.
+type SupportsProfileOnly interface {
+	SetProfileOnly()
+}
+
+// WithProfileOnly restricts the evaluation engine to the profile_only pull
+// request action, whatever the action the rule configures, so that the
+// evaluation doesn't act on pull requests. In case the given evaluator
+// does not act on pull requests, WithProfileOnly silently ignores the error.
+func WithProfileOnly() interfaces.Option {
+	return func(e interfaces.Evaluator) error {
+		inner, ok := e.(SupportsProfileOnly)
+		if !ok {
+			return nil
+		}
+		inner.SetProfileOnly()
+		return nil
+	}
+}
+
+// SupportsVulnDB interface advertises the fact that the implementer
+// can look up vulnerabilities in the local mirror of the OSV database.
+type SupportsVulnDB interface {
+	SetVulnDB(db vulndb.Database)
+}
+
+// WithVulnDB provides the evaluation engine with the local mirror of
+// the vulnerability database. In case the given evaluator does not
+// look up vulnerabilities, WithVulnDB silently ignores the error.
+func WithVulnDB(db vulndb.Database) interfaces.Option {
+	return func(e interfaces.Evaluator) error {
+		inner, ok := e.(SupportsVulnDB)
+		if !ok {
+			return nil
+		}
+		inner.SetVulnDB(db)
+		return nil
+	}
+}
@@ -0,0 +1,534 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	dbadapter "github.com/mindersec/minder/internal/adapters/db"
+	"github.com/mindersec/minder/internal/crypto"
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/engine/ingestcache"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
+	"github.com/mindersec/minder/internal/engine/rtengine"
+	entmodels "github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/entities/properties/service"
+	internalpb "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/internal/providers/manager"
+	provsel "github.com/mindersec/minder/internal/providers/selectors"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/vulndb"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	evalerrors "github.com/mindersec/minder/pkg/engine/errors"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/flags"
+	"github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+// Planner computes the impact that applying a profile would have on the
+// entities of a project. Planning evaluates the rules of the profile, but
+// never runs their actions nor records the evaluations.
+type Planner interface {
+	// PlanProfile plans the creation of the profile, or its update if a
+	// profile with the same ID or name exists in the project
+	PlanProfile(ctx context.Context, projectID uuid.UUID, profile *pb.Profile) (*ProfilePlan, error)
+}
+
+// PlannedSelection is an entity which a profile would start or stop selecting
+type PlannedSelection struct {
+	Entity         db.EntityInstance
+	SelectedBefore bool
+	SelectedAfter  bool
+}
+
+// PlannedEvaluation is the projected change in the evaluation of a rule
+// against an entity. An empty status means that the rule is not evaluated
+// against the entity.
+type PlannedEvaluation struct {
+	Entity         db.EntityInstance
+	RuleTypeName   string
+	RuleName       string
+	CurrentStatus  string
+	PlannedStatus  string
+	PlannedDetails string
+}
+
+// ProfilePlan is the projected impact of applying a profile
+type ProfilePlan struct {
+	Selections  []PlannedSelection
+	Evaluations []PlannedEvaluation
+}
+
+type planner struct {
+	querier         db.Store
+	providerManager manager.ProviderManager
+	featureFlags    flags.Interface
+	selBuilder      selectors.SelectionBuilder
+	validator       *profiles.Validator
+	propService     service.PropertiesService
+	cryptoEngine    crypto.Engine
+}
+
+// NewPlanner creates a new profile planner
+func NewPlanner(
+	querier db.Store,
+	providerManager manager.ProviderManager,
+	featureFlags flags.Interface,
+	selEnv *selectors.Env,
+	propService service.PropertiesService,
+	cryptoEngine crypto.Engine,
+) Planner {
+	return &planner{
+		querier:         querier,
+		providerManager: providerManager,
+		featureFlags:    featureFlags,
+		selBuilder:      selEnv,
+		validator:       profiles.NewValidator(selEnv),
+		propService:     propService,
+		cryptoEngine:    cryptoEngine,
+	}
+}
+
+// maxPlannedEntities bounds the entities a plan evaluates rules against.
+// Plans are computed synchronously, and evaluating rules calls the provider
+// of the entity.
+const maxPlannedEntities = 500
+
+// plannedRule is a rule of the planned profile
+type plannedRule struct {
+	ruleTypeName string
+	rule         models.RuleInstance
+}
+
+type evalKey struct {
+	entityID     uuid.UUID
+	ruleTypeName string
+	ruleName     string
+}
+
+// ruleEngineKey identifies the entities which share rule engines
+type ruleEngineKey struct {
+	providerID uuid.UUID
+	projectID  uuid.UUID
+	entityType pb.Entity
+}
+
+type currentEval struct {
+	entity db.EntityInstance
+	status string
+}
+
+// PlanProfile plans the creation or update of a profile
+func (p *planner) PlanProfile(ctx context.Context, projectID uuid.UUID, profile *pb.Profile) (*ProfilePlan, error) {
+	ruleMapping, err := p.validator.ValidateAndExtractRules(ctx, p.querier, projectID, profile)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validator.ValidateSelection(profile.GetSelection()); err != nil {
+		return nil, err
+	}
+
+	rules := make(map[pb.Entity][]plannedRule)
+	_ = profiles.TraverseRuleTypesForEntities(profile, func(ent pb.Entity, r *pb.Profile_Rule) error {
+		info := ruleMapping[profiles.RuleTypeAndNamePair{RuleType: r.GetType(), RuleName: r.GetName()}]
+		rule := models.RuleFromPB(info.RuleID, r)
+		rule.Name = info.DerivedRuleName
+		rules[ent] = append(rules[ent], plannedRule{ruleTypeName: r.GetType(), rule: rule})
+		return nil
+	})
+
+	current, err := p.currentProfile(ctx, projectID, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentID uuid.UUID
+	currentEvals := make(map[evalKey]currentEval)
+	if current != nil {
+		currentID, err = uuid.Parse(current.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing profile ID: %w", err)
+		}
+		currentEvals, err = p.currentEvaluations(ctx, currentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The entities of every type evaluated before or after the update
+	entityTypes := make(map[pb.Entity]bool, len(rules))
+	for ent := range rules {
+		entityTypes[ent] = true
+	}
+	for _, ev := range currentEvals {
+		entityTypes[entities.EntityTypeFromDB(ev.entity.EntityType)] = true
+	}
+
+	selectionsBefore, err := p.selections(current.GetSelection(), entityTypes)
+	if err != nil {
+		return nil, err
+	}
+	selectionsAfter, err := p.selections(profile.GetSelection(), entityTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	ents, err := p.projectEntities(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	planned := make([]db.EntityInstance, 0, len(ents))
+	evaluated := 0
+	for _, ent := range ents {
+		entType := entities.EntityTypeFromDB(ent.EntityType)
+		if !entityTypes[entType] {
+			continue
+		}
+		planned = append(planned, ent)
+		if len(rules[entType]) > 0 {
+			evaluated++
+		}
+	}
+	if evaluated > maxPlannedEntities {
+		return nil, util.UserVisibleError(codes.FailedPrecondition,
+			"the profile applies to %d entities, but plans are limited to %d", evaluated, maxPlannedEntities)
+	}
+
+	plan := &ProfilePlan{}
+	ruleEngines := make(map[ruleEngineKey]rtengine.Cache)
+	for _, ent := range planned {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		entType := entities.EntityTypeFromDB(ent.EntityType)
+
+		ewp, err := p.propService.EntityWithPropertiesByID(ctx, ent.ID,
+			service.CallBuilder().WithStoreOrTransaction(p.querier))
+		if err != nil {
+			return nil, fmt.Errorf("error getting entity with properties: %w", err)
+		}
+		selEnt := provsel.EntityToSelectorEntity(ctx, p.querier, entType, ewp)
+		if selEnt == nil {
+			return nil, fmt.Errorf("error converting entity to selector entity")
+		}
+
+		selectedBefore := false
+		if current != nil {
+			selectedBefore = selects(selectionsBefore[entType], selEnt) == nil
+		}
+		selectionErr := selects(selectionsAfter[entType], selEnt)
+		if selectedAfter := selectionErr == nil; selectedBefore != selectedAfter {
+			plan.Selections = append(plan.Selections, PlannedSelection{
+				Entity:         ent,
+				SelectedBefore: selectedBefore,
+				SelectedAfter:  selectedAfter,
+			})
+		}
+
+		evals, err := p.evaluateEntity(ctx, ewp, currentID, rules[entType], selectionErr, ruleEngines)
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range evals {
+			key := evalKey{entityID: ent.ID, ruleTypeName: ev.RuleTypeName, ruleName: ev.RuleName}
+			ev.Entity = ent
+			ev.CurrentStatus = currentEvals[key].status
+			delete(currentEvals, key)
+			if ev.CurrentStatus != ev.PlannedStatus {
+				plan.Evaluations = append(plan.Evaluations, ev)
+			}
+		}
+	}
+
+	// Whatever is left are the rules removed from the profile
+	for key, ev := range currentEvals {
+		plan.Evaluations = append(plan.Evaluations, PlannedEvaluation{
+			Entity:        ev.entity,
+			RuleTypeName:  key.ruleTypeName,
+			RuleName:      key.ruleName,
+			CurrentStatus: ev.status,
+		})
+	}
+
+	slices.SortFunc(plan.Selections, func(a, b PlannedSelection) int {
+		return compareEntities(a.Entity, b.Entity)
+	})
+	slices.SortFunc(plan.Evaluations, func(a, b PlannedEvaluation) int {
+		return cmp.Or(
+			compareEntities(a.Entity, b.Entity),
+			cmp.Compare(a.RuleTypeName, b.RuleTypeName),
+			cmp.Compare(a.RuleName, b.RuleName),
+		)
+	})
+
+	return plan, nil
+}
+
+// evaluateEntity evaluates the rules of the planned profile against an
+// entity. Rules are not evaluated against entities which the profile does not
+// select, nor when the rule is exempted for the entity. The rule engines are
+// shared by the entities of the same provider, project and type.
+func (p *planner) evaluateEntity(
+	ctx context.Context,
+	ewp *entmodels.EntityWithProperties,
+	profileID uuid.UUID,
+	rules []plannedRule,
+	selectionErr error,
+	ruleEngines map[ruleEngineKey]rtengine.Cache,
+) ([]PlannedEvaluation, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	exemptions := ruleExemptions{}
+	if profileID != uuid.Nil && selectionErr == nil {
+		var err error
+		exemptions, err = listRuleExemptions(ctx, p.querier, ewp.Entity.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ruleEngineCache rtengine.Cache
+	var entity protoreflect.ProtoMessage
+	evals := make([]PlannedEvaluation, 0, len(rules))
+	for _, r := range rules {
+		evalErr := selectionErr
+		if evalErr == nil {
+			evalErr = exemptions.evalStatus(profileID, r.rule.Name)
+		}
+		if evalErr == nil {
+			// Only load the rule engines when a rule is actually evaluated
+			if ruleEngineCache == nil {
+				key := ruleEngineKey{
+					providerID: ewp.Entity.ProviderID,
+					projectID:  ewp.Entity.ProjectID,
+					entityType: ewp.Entity.Type,
+				}
+				var err error
+				if ruleEngineCache = ruleEngines[key]; ruleEngineCache == nil {
+					ruleEngineCache, err = p.ruleEngineCache(ctx, ewp)
+					if err != nil {
+						return nil, err
+					}
+					ruleEngines[key] = ruleEngineCache
+				}
+				entity, err = p.propService.EntityWithPropertiesAsProto(ctx, ewp, p.providerManager)
+				if err != nil {
+					return nil, fmt.Errorf("error converting entity to proto: %w", err)
+				}
+			}
+			evalErr = p.evaluateRule(ctx, ewp, entity, &r.rule, ruleEngineCache)
+		}
+
+		evals = append(evals, PlannedEvaluation{
+			RuleTypeName:   r.ruleTypeName,
+			RuleName:       r.rule.Name,
+			PlannedStatus:  dbadapter.EvalErrorAsString(evalErr),
+			PlannedDetails: dbadapter.ErrorAsEvalDetails(evalErr),
+		})
+	}
+	return evals, nil
+}
+
+// evaluateRule evaluates a rule against an entity, without running the
+// actions of the rule
+func (*planner) evaluateRule(
+	ctx context.Context,
+	ewp *entmodels.EntityWithProperties,
+	entity protoreflect.ProtoMessage,
+	rule *models.RuleInstance,
+	ruleEngineCache rtengine.Cache,
+) error {
+	ruleEngine, err := ruleEngineCache.GetRuleEngine(ctx, rule.RuleTypeID)
+	if err != nil {
+		return fmt.Errorf("error creating rule type engine: %w", err)
+	}
+
+	// The sink only collects the ingested data, which is discarded
+	sink := &engif.EvalStatusParams{
+		Rule:       rule,
+		ProjectID:  ewp.Entity.ProjectID,
+		EntityType: entities.EntityTypeToDB(ewp.Entity.Type),
+		EntityID:   ewp.Entity.ID,
+	}
+	_, err = ruleEngine.Eval(ctx, entity, rule.Def, rule.Params, sink)
+	return err
+}
+
+func (p *planner) ruleEngineCache(ctx context.Context, ewp *entmodels.EntityWithProperties) (rtengine.Cache, error) {
+	provider, err := p.providerManager.InstantiateFromID(ctx, ewp.Entity.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("could not instantiate provider: %w", err)
+	}
+
+	// As when executing, artifacts are not cached. The ingested data of
+	// other entities is keyed by the entity, so the cache can be shared.
+	var ingestCache ingestcache.Cache
+	if ewp.Entity.Type == pb.Entity_ENTITY_ARTIFACTS {
+		ingestCache = ingestcache.NewNoopCache()
+	} else {
+		ingestCache = ingestcache.NewCache()
+	}
+
+	ruleEngineCache, err := rtengine.NewRuleEngineCache(
+		ctx,
+		p.querier,
+		entities.EntityTypeToDB(ewp.Entity.Type),
+		ewp.Entity.ProjectID,
+		provider,
+		p.featureFlags,
+		ingestCache,
+		datasourceservice.NewDataSourceService(p.querier, p.cryptoEngine),
+		eoptions.WithFlagsClient(p.featureFlags),
+		eoptions.WithVulnDB(vulndb.NewDatabase(p.querier)),
+		// Evaluators which act on pull requests must not do so
+		eoptions.WithProfileOnly(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch rule type instances for project: %w", err)
+	}
+	return ruleEngineCache, nil
+}
+
+// currentProfile returns the profile which the planned profile would update,
+// or nil if the planned profile would be created
+func (p *planner) currentProfile(ctx context.Context, projectID uuid.UUID, profile *pb.Profile) (*pb.Profile, error) {
+	var current map[string]*pb.Profile
+	if profile.GetId() != "" {
+		id, err := uuid.Parse(profile.GetId())
+		if err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid profile ID")
+		}
+		rows, err := p.querier.GetProfileByProjectAndID(ctx, db.GetProfileByProjectAndIDParams{
+			ProjectID: projectID,
+			ID:        id,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting profile: %w", err)
+		}
+		current = profiles.MergeDatabaseGetIntoProfiles(rows)
+	} else {
+		rows, err := p.querier.GetProfileByProjectAndName(ctx, db.GetProfileByProjectAndNameParams{
+			ProjectID: projectID,
+			Name:      profile.GetName(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting profile: %w", err)
+		}
+		current = profiles.MergeDatabaseGetByNameIntoProfiles(rows)
+	}
+
+	for _, prof := range current {
+		return prof, nil
+	}
+	return nil, nil
+}
+
+// currentEvaluations returns the status of the latest evaluations of the rules
+// of a profile
+func (p *planner) currentEvaluations(ctx context.Context, profileID uuid.UUID) (map[evalKey]currentEval, error) {
+	rows, err := p.querier.ListRuleEvaluationsByProfileId(ctx, db.ListRuleEvaluationsByProfileIdParams{
+		ProfileID: profileID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing rule evaluations: %w", err)
+	}
+
+	evals := make(map[evalKey]currentEval, len(rows))
+	for _, row := range rows {
+		key := evalKey{entityID: row.EntityID, ruleTypeName: row.RuleTypeName, ruleName: row.RuleName}
+		evals[key] = currentEval{
+			entity: db.EntityInstance{
+				ID:         row.EntityID,
+				EntityType: row.EntityType,
+				Name:       row.EntityName,
+				ProjectID:  row.ProjectID,
+			},
+			status: string(row.EvalStatus),
+		}
+	}
+	return evals, nil
+}
+
+// projectEntities returns the entities of the project and of its children,
+// to which the profiles of the project apply
+func (p *planner) projectEntities(ctx context.Context, projectID uuid.UUID) ([]db.EntityInstance, error) {
+	projects, err := p.querier.GetChildrenProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting children projects: %w", err)
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(projects))
+	for _, proj := range projects {
+		projectIDs = append(projectIDs, proj.ID)
+	}
+
+	ents, err := p.querier.GetEntitiesByProjectHierarchy(ctx, projectIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error getting entities: %w", err)
+	}
+	return ents, nil
+}
+
+// selections compiles the selectors of a profile for each entity type. Entity
+// types without selectors are left out, since all their entities are selected.
+func (p *planner) selections(
+	sels []*pb.Profile_Selector,
+	entityTypes map[pb.Entity]bool,
+) (map[pb.Entity]selectors.Selection, error) {
+	out := make(map[pb.Entity]selectors.Selection, len(entityTypes))
+	if len(sels) == 0 {
+		return out, nil
+	}
+
+	profileSelectors := models.SelectorSliceFromPB(sels)
+	for ent := range entityTypes {
+		selection, err := p.selBuilder.NewSelectionFromProfile(ent, profileSelectors)
+		if err != nil {
+			return nil, fmt.Errorf("error creating selection from profile: %w", err)
+		}
+		out[ent] = selection
+	}
+	return out, nil
+}
+
+// selects returns nil if the selection selects the entity, or the error the
+// rules evaluate to otherwise
+func selects(selection selectors.Selection, selEnt *internalpb.SelectorEntity) error {
+	if selection == nil {
+		return nil
+	}
+
+	selected, matchedSelector, err := selection.Select(selEnt)
+	if err != nil {
+		return fmt.Errorf("error selecting entity: %w", err)
+	}
+	if !selected {
+		return evalerrors.NewErrEvaluationSkipped("entity not applicable due to profile selector %s", matchedSelector)
+	}
+	return nil
+}
+
+func compareEntities(a, b db.EntityInstance) int {
+	return cmp.Or(
+		cmp.Compare(a.EntityType, b.EntityType),
+		cmp.Compare(a.Name, b.Name),
+		cmp.Compare(a.ID.String(), b.ID.String()),
+	)
+}
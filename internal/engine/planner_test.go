@@ -0,0 +1,463 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine"
+	"github.com/mindersec/minder/internal/entities/models"
+	mockprops "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/flags"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+func TestPlanner_PlanProfile(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+	profileID := uuid.New()
+	ruleTypeID := uuid.New()
+	repositoryID := uuid.New()
+
+	repository := db.EntityInstance{
+		ID:         repositoryID,
+		EntityType: db.EntitiesRepository,
+		Name:       "foo/skip",
+		ProjectID:  projectID,
+		ProviderID: providerID,
+	}
+
+	ruleTypeDef, err := json.Marshal(&minderv1.RuleType_Definition{
+		InEntity:   minderv1.RepositoryEntity.String(),
+		RuleSchema: &structpb.Struct{},
+		Ingest: &minderv1.RuleType_Definition_Ingest{
+			Type:    "builtin",
+			Builtin: &minderv1.BuiltinType{Method: "Passthrough"},
+		},
+		Eval: &minderv1.RuleType_Definition_Eval{
+			Type: "rego",
+			Rego: &minderv1.RuleType_Definition_Eval_Rego{
+				Type: "deny-by-default",
+				Def: `package minder
+default allow = true`,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// validating the planned profile
+	mockStore.EXPECT().
+		GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil).
+		Times(2)
+	mockStore.EXPECT().
+		GetRuleTypeByName(gomock.Any(), db.GetRuleTypeByNameParams{
+			Projects: []uuid.UUID{projectID},
+			Name:     "passthrough",
+		}).
+		Return(db.RuleType{
+			ID:         ruleTypeID,
+			Name:       "passthrough",
+			ProjectID:  projectID,
+			Definition: ruleTypeDef,
+		}, nil).
+		Times(2)
+
+	// the profile currently in the project selects every repository
+	mockStore.EXPECT().
+		GetProfileByProjectAndName(gomock.Any(), db.GetProfileByProjectAndNameParams{
+			ProjectID: projectID,
+			Name:      "test-profile",
+		}).
+		Return([]db.GetProfileByProjectAndNameRow{
+			{Profile: db.Profile{ID: profileID, Name: "test-profile", ProjectID: projectID}},
+		}, nil)
+	mockStore.EXPECT().
+		ListRuleEvaluationsByProfileId(gomock.Any(), db.ListRuleEvaluationsByProfileIdParams{
+			ProfileID: profileID,
+		}).
+		Return([]db.ListRuleEvaluationsByProfileIdRow{
+			{
+				EvalStatus:   db.EvalStatusTypesSuccess,
+				EntityType:   db.EntitiesRepository,
+				EntityID:     repositoryID,
+				EntityName:   "foo/skip",
+				ProjectID:    projectID,
+				RuleTypeName: "passthrough",
+				RuleName:     "passthrough",
+			},
+			{
+				EvalStatus:   db.EvalStatusTypesFailure,
+				EntityType:   db.EntitiesRepository,
+				EntityID:     repositoryID,
+				EntityName:   "foo/skip",
+				ProjectID:    projectID,
+				RuleTypeName: "removed",
+				RuleName:     "removed",
+			},
+		}, nil)
+
+	mockStore.EXPECT().
+		GetChildrenProjects(gomock.Any(), projectID).
+		Return([]db.GetChildrenProjectsRow{{ID: projectID}}, nil)
+	mockStore.EXPECT().
+		GetEntitiesByProjectHierarchy(gomock.Any(), []uuid.UUID{projectID}).
+		Return([]db.EntityInstance{repository}, nil)
+	mockStore.EXPECT().
+		GetProviderByID(gomock.Any(), providerID).
+		Return(db.Provider{ID: providerID, Name: "github", Class: db.ProviderClassGithub}, nil)
+
+	mockPropSvc := mockprops.NewMockPropertiesService(ctrl)
+	mockPropSvc.EXPECT().
+		EntityWithPropertiesByID(gomock.Any(), repositoryID, gomock.Any()).
+		Return(&models.EntityWithProperties{
+			Entity: models.EntityInstance{
+				ID:         repositoryID,
+				Type:       minderv1.Entity_ENTITY_REPOSITORIES,
+				Name:       "foo/skip",
+				ProjectID:  projectID,
+				ProviderID: providerID,
+			},
+		}, nil)
+
+	planner := engine.NewPlanner(mockStore, nil, &flags.FakeClient{}, selectors.NewEnv(), mockPropSvc, nil)
+
+	plan, err := planner.PlanProfile(context.Background(), projectID, &minderv1.Profile{
+		Name: "test-profile",
+		Repository: []*minderv1.Profile_Rule{
+			{Type: "passthrough", Def: &structpb.Struct{}},
+		},
+		Selection: []*minderv1.Profile_Selector{
+			{Entity: "repository", Selector: "repository.name != 'foo/skip'"},
+		},
+	})
+	require.NoError(t, err)
+
+	// the repository is no longer selected, so its rule is skipped without
+	// being evaluated, and the rule removed from the profile is no longer
+	// evaluated at all
+	require.Equal(t, []engine.PlannedSelection{
+		{Entity: repository, SelectedBefore: true, SelectedAfter: false},
+	}, plan.Selections)
+	require.Len(t, plan.Evaluations, 2)
+	require.Equal(t, "passthrough", plan.Evaluations[0].RuleTypeName)
+	require.Equal(t, "success", plan.Evaluations[0].CurrentStatus)
+	require.Equal(t, "skipped", plan.Evaluations[0].PlannedStatus)
+	require.Equal(t, "removed", plan.Evaluations[1].RuleTypeName)
+	require.Equal(t, "failure", plan.Evaluations[1].CurrentStatus)
+	require.Empty(t, plan.Evaluations[1].PlannedStatus)
+}
+
+func TestPlanner_PlanProfileSharesRuleEngines(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+
+	repositories := []db.EntityInstance{
+		{ID: uuid.New(), EntityType: db.EntitiesRepository, Name: "foo/bar", ProjectID: projectID, ProviderID: providerID},
+		{ID: uuid.New(), EntityType: db.EntitiesRepository, Name: "foo/baz", ProjectID: projectID, ProviderID: providerID},
+	}
+
+	ruleType := passthroughRuleType(t, projectID)
+
+	// validating the planned profile, and loading the rule engines
+	mockStore.EXPECT().
+		GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil).
+		Times(3)
+	mockStore.EXPECT().
+		GetRuleTypeByName(gomock.Any(), db.GetRuleTypeByNameParams{
+			Projects: []uuid.UUID{projectID},
+			Name:     "passthrough",
+		}).
+		Return(ruleType, nil).
+		Times(2)
+
+	// the profile is created
+	mockStore.EXPECT().
+		GetProfileByProjectAndName(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+
+	mockStore.EXPECT().
+		GetChildrenProjects(gomock.Any(), projectID).
+		Return([]db.GetChildrenProjectsRow{{ID: projectID}}, nil)
+	mockStore.EXPECT().
+		GetEntitiesByProjectHierarchy(gomock.Any(), []uuid.UUID{projectID}).
+		Return(repositories, nil)
+	mockStore.EXPECT().
+		GetProviderByID(gomock.Any(), providerID).
+		Return(db.Provider{ID: providerID, Name: "github", Class: db.ProviderClassGithub}, nil).
+		AnyTimes()
+
+	mockPropSvc := mockprops.NewMockPropertiesService(ctrl)
+	for _, repo := range repositories {
+		ewp := &models.EntityWithProperties{
+			Entity: models.EntityInstance{
+				ID:         repo.ID,
+				Type:       minderv1.Entity_ENTITY_REPOSITORIES,
+				Name:       repo.Name,
+				ProjectID:  projectID,
+				ProviderID: providerID,
+			},
+		}
+		mockPropSvc.EXPECT().
+			EntityWithPropertiesByID(gomock.Any(), repo.ID, gomock.Any()).
+			Return(ewp, nil)
+		mockPropSvc.EXPECT().
+			EntityWithPropertiesAsProto(gomock.Any(), ewp, gomock.Any()).
+			Return(&minderv1.Repository{Name: repo.Name}, nil)
+	}
+
+	// the rule engines are loaded once for both repositories
+	mockProvider := mockprov.NewMockProvider(ctrl)
+	mockProviderManager := mockmanager.NewMockProviderManager(ctrl)
+	mockProviderManager.EXPECT().
+		InstantiateFromID(gomock.Any(), providerID).
+		Return(mockProvider, nil)
+	mockStore.EXPECT().
+		GetRuleTypesByEntityInHierarchy(gomock.Any(), gomock.Any()).
+		Return([]db.RuleType{ruleType}, nil)
+
+	planner := engine.NewPlanner(mockStore, mockProviderManager, &flags.FakeClient{}, selectors.NewEnv(), mockPropSvc, nil)
+
+	plan, err := planner.PlanProfile(context.Background(), projectID, &minderv1.Profile{
+		Name: "test-profile",
+		Repository: []*minderv1.Profile_Rule{
+			{Type: "passthrough", Def: &structpb.Struct{}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, plan.Selections, 2)
+	require.Len(t, plan.Evaluations, 2)
+	for i, ev := range plan.Evaluations {
+		require.Equal(t, repositories[i], ev.Entity)
+		require.Empty(t, ev.CurrentStatus)
+		require.Equal(t, "success", ev.PlannedStatus)
+	}
+}
+
+func TestPlanner_PlanProfileTooManyEntities(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	projectID := uuid.New()
+
+	mockStore.EXPECT().
+		GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil).
+		Times(2)
+	mockStore.EXPECT().
+		GetRuleTypeByName(gomock.Any(), gomock.Any()).
+		Return(passthroughRuleType(t, projectID), nil).
+		Times(2)
+	mockStore.EXPECT().
+		GetProfileByProjectAndName(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	mockStore.EXPECT().
+		GetChildrenProjects(gomock.Any(), projectID).
+		Return([]db.GetChildrenProjectsRow{{ID: projectID}}, nil)
+
+	repositories := make([]db.EntityInstance, 501)
+	for i := range repositories {
+		repositories[i] = db.EntityInstance{ID: uuid.New(), EntityType: db.EntitiesRepository, ProjectID: projectID}
+	}
+	mockStore.EXPECT().
+		GetEntitiesByProjectHierarchy(gomock.Any(), []uuid.UUID{projectID}).
+		Return(repositories, nil)
+
+	// no entity is evaluated
+	planner := engine.NewPlanner(mockStore, nil, &flags.FakeClient{}, selectors.NewEnv(), nil, nil)
+
+	_, err := planner.PlanProfile(context.Background(), projectID, &minderv1.Profile{
+		Name: "test-profile",
+		Repository: []*minderv1.Profile_Rule{
+			{Type: "passthrough", Def: &structpb.Struct{}},
+		},
+	})
+	require.ErrorContains(t, err, "the profile applies to 501 entities, but plans are limited to 500")
+}
+
+func TestPlanner_PlanProfileDoesNotActOnPullRequests(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+	pullRequest := db.EntityInstance{
+		ID:         uuid.New(),
+		EntityType: db.EntitiesPullRequest,
+		Name:       "foo/bar/1",
+		ProjectID:  projectID,
+		ProviderID: providerID,
+	}
+
+	// every package is vulnerable, without a fix
+	osv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"vulns": [{"id": "GHSA-0000-0000-0000", "summary": "vulnerable"}]}`))
+	}))
+	t.Cleanup(osv.Close)
+
+	ruleTypeDef, err := json.Marshal(&minderv1.RuleType_Definition{
+		InEntity:   minderv1.PullRequestEntity.String(),
+		RuleSchema: &structpb.Struct{},
+		Ingest: &minderv1.RuleType_Definition_Ingest{
+			Type: "diff",
+			Diff: &minderv1.DiffType{
+				Ecosystems: []*minderv1.DiffType_Ecosystem{{Name: "pypi", Depfile: "requirements.txt"}},
+			},
+		},
+		Eval: &minderv1.RuleType_Definition_Eval{Type: "vulncheck"},
+	})
+	require.NoError(t, err)
+	ruleType := db.RuleType{
+		ID:         uuid.New(),
+		Name:       "pr_vulnerability_check",
+		ProjectID:  projectID,
+		Definition: ruleTypeDef,
+	}
+
+	mockStore.EXPECT().
+		GetParentProjects(gomock.Any(), projectID).
+		Return([]uuid.UUID{projectID}, nil).
+		Times(3)
+	mockStore.EXPECT().
+		GetRuleTypeByName(gomock.Any(), gomock.Any()).
+		Return(ruleType, nil).
+		Times(2)
+	mockStore.EXPECT().
+		GetProfileByProjectAndName(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	mockStore.EXPECT().
+		GetChildrenProjects(gomock.Any(), projectID).
+		Return([]db.GetChildrenProjectsRow{{ID: projectID}}, nil)
+	mockStore.EXPECT().
+		GetEntitiesByProjectHierarchy(gomock.Any(), []uuid.UUID{projectID}).
+		Return([]db.EntityInstance{pullRequest}, nil)
+	mockStore.EXPECT().
+		GetProviderByID(gomock.Any(), providerID).
+		Return(db.Provider{ID: providerID, Name: "github", Class: db.ProviderClassGithub}, nil).
+		AnyTimes()
+	mockStore.EXPECT().
+		GetRuleTypesByEntityInHierarchy(gomock.Any(), gomock.Any()).
+		Return([]db.RuleType{ruleType}, nil)
+	mockStore.EXPECT().
+		ListOSVSyncState(gomock.Any()).
+		Return(nil, nil)
+
+	ewp := &models.EntityWithProperties{
+		Entity: models.EntityInstance{
+			ID:         pullRequest.ID,
+			Type:       minderv1.Entity_ENTITY_PULL_REQUESTS,
+			Name:       pullRequest.Name,
+			ProjectID:  projectID,
+			ProviderID: providerID,
+		},
+	}
+	mockPropSvc := mockprops.NewMockPropertiesService(ctrl)
+	mockPropSvc.EXPECT().
+		EntityWithPropertiesByID(gomock.Any(), pullRequest.ID, gomock.Any()).
+		Return(ewp, nil)
+	mockPropSvc.EXPECT().
+		EntityWithPropertiesAsProto(gomock.Any(), ewp, gomock.Any()).
+		Return(&pbinternal.PullRequest{RepoOwner: "foo", RepoName: "bar", Number: 1, CommitSha: "abc123"}, nil)
+
+	// the pull request adds a vulnerable dependency. Any review, comment or
+	// commit status would be an unexpected call of the provider.
+	mockGitHub := mockprov.NewMockGitHub(ctrl)
+	mockGitHub.EXPECT().
+		ListFiles(gomock.Any(), "foo", "bar", 1, gomock.Any(), 0).
+		Return([]*github.CommitFile{{
+			Filename: github.String("requirements.txt"),
+			Patch:    github.String("@@ -0,0 +1 @@\n+requests==2.19.0"),
+		}}, &github.Response{}, nil)
+	mockProviderManager := mockmanager.NewMockProviderManager(ctrl)
+	mockProviderManager.EXPECT().
+		InstantiateFromID(gomock.Any(), providerID).
+		Return(mockGitHub, nil)
+
+	planner := engine.NewPlanner(mockStore, mockProviderManager, &flags.FakeClient{}, selectors.NewEnv(), mockPropSvc, nil)
+
+	ruleDef, err := structpb.NewStruct(map[string]any{
+		"action": "review",
+		"ecosystem_config": []any{map[string]any{
+			"name":                            "pypi",
+			"vulnerability_database_type":     "osv",
+			"vulnerability_database_endpoint": osv.URL,
+			"package_repository":              map[string]any{"url": osv.URL},
+			"sum_repository":                  map[string]any{"url": osv.URL},
+		}},
+	})
+	require.NoError(t, err)
+	plan, err := planner.PlanProfile(context.Background(), projectID, &minderv1.Profile{
+		Name: "test-profile",
+		PullRequest: []*minderv1.Profile_Rule{
+			{Type: "pr_vulnerability_check", Def: ruleDef},
+		},
+	})
+	require.NoError(t, err)
+
+	// the vulnerable dependency is reported in the plan only
+	require.Len(t, plan.Evaluations, 1)
+	require.Equal(t, "failure", plan.Evaluations[0].PlannedStatus)
+	require.Contains(t, plan.Evaluations[0].PlannedDetails, "requests")
+}
+
+// passthroughRuleType is a repository rule type which always passes
+func passthroughRuleType(t *testing.T, projectID uuid.UUID) db.RuleType {
+	t.Helper()
+
+	def, err := json.Marshal(&minderv1.RuleType_Definition{
+		InEntity:   minderv1.RepositoryEntity.String(),
+		RuleSchema: &structpb.Struct{},
+		Ingest: &minderv1.RuleType_Definition_Ingest{
+			Type:    "builtin",
+			Builtin: &minderv1.BuiltinType{Method: "Passthrough"},
+		},
+		Eval: &minderv1.RuleType_Definition_Eval{
+			Type: "rego",
+			Rego: &minderv1.RuleType_Definition_Eval_Rego{
+				Type: "deny-by-default",
+				Def: `package minder
+default allow = true`,
+			},
+		},
+	})
+	require.NoError(t, err)
+	return db.RuleType{
+		ID:         uuid.New(),
+		Name:       "passthrough",
+		ProjectID:  projectID,
+		Definition: def,
+	}
+}
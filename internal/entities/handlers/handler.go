@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
 	"github.com/mindersec/minder/internal/entities/handlers/message"
 	"github.com/mindersec/minder/internal/entities/handlers/strategies"
 	entStrategies "github.com/mindersec/minder/internal/entities/handlers/strategies/entity"
@@ -109,6 +110,7 @@ func (b *handleEntityAndDoBase) handleRefreshEntityAndDo(msg *watermill.Message)
 
 	// If nextMsg is nil, it means we don't need to publish anything (entity not found)
 	if nextMsg != nil {
+		entities.SetProfileIDsInMessage(nextMsg, entMsg.ProfileIDs)
 		l.Debug().Msg("publishing message")
 		if err := b.evt.Publish(b.forwardHandlerName, nextMsg); err != nil {
 			l.Error().Err(err).Msg("error publishing message")
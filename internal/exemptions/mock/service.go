@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_exemptions -destination=./mock/service.go -source=./service.go
+//
+
+// Package mock_exemptions is a generated GoMock package.
+package mock_exemptions
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	exemptions "github.com/mindersec/minder/internal/exemptions"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExemptionService is a mock of ExemptionService interface.
+type MockExemptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExemptionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExemptionServiceMockRecorder is the mock recorder for MockExemptionService.
+type MockExemptionServiceMockRecorder struct {
+	mock *MockExemptionService
+}
+
+// NewMockExemptionService creates a new mock instance.
+func NewMockExemptionService(ctrl *gomock.Controller) *MockExemptionService {
+	mock := &MockExemptionService{ctrl: ctrl}
+	mock.recorder = &MockExemptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExemptionService) EXPECT() *MockExemptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExemptionService) Create(ctx context.Context, projectID uuid.UUID, exemption *v1.RuleExemption, createdBy string) (*v1.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, projectID, exemption, createdBy)
+	ret0, _ := ret[0].(*v1.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExemptionServiceMockRecorder) Create(ctx, projectID, exemption, createdBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExemptionService)(nil).Create), ctx, projectID, exemption, createdBy)
+}
+
+// Delete mocks base method.
+func (m *MockExemptionService) Delete(ctx context.Context, projectID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, projectID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExemptionServiceMockRecorder) Delete(ctx, projectID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExemptionService)(nil).Delete), ctx, projectID, id)
+}
+
+// Get mocks base method.
+func (m *MockExemptionService) Get(ctx context.Context, projectID, id uuid.UUID) (*v1.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, projectID, id)
+	ret0, _ := ret[0].(*v1.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExemptionServiceMockRecorder) Get(ctx, projectID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExemptionService)(nil).Get), ctx, projectID, id)
+}
+
+// List mocks base method.
+func (m *MockExemptionService) List(ctx context.Context, projectID uuid.UUID, profileName string, includeExpired bool) ([]*v1.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID, profileName, includeExpired)
+	ret0, _ := ret[0].([]*v1.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockExemptionServiceMockRecorder) List(ctx, projectID, profileName, includeExpired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExemptionService)(nil).List), ctx, projectID, profileName, includeExpired)
+}
+
+// Update mocks base method.
+func (m *MockExemptionService) Update(ctx context.Context, projectID, id uuid.UUID, params exemptions.UpdateParams) (*v1.RuleExemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, projectID, id, params)
+	ret0, _ := ret[0].(*v1.RuleExemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExemptionServiceMockRecorder) Update(ctx, projectID, id, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExemptionService)(nil).Update), ctx, projectID, id, params)
+}
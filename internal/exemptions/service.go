@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exemptions manages rule exemptions. An exemption allows a rule
+// of a profile to fail for an entity until it expires, and records who
+// approved it and why.
+package exemptions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+var (
+	// ErrExemptionNotFound is returned when the exemption does not exist
+	ErrExemptionNotFound = util.UserVisibleError(codes.NotFound, "exemption not found")
+	// ErrProfileNotFound is returned when the profile of the exemption does not exist
+	ErrProfileNotFound = util.UserVisibleError(codes.NotFound, "profile not found")
+	// ErrEntityNotFound is returned when the entity of the exemption does not exist
+	ErrEntityNotFound = util.UserVisibleError(codes.NotFound, "entity not found")
+)
+
+// UpdateParams are the fields of an exemption which can be changed.
+// Empty values keep the current value.
+type UpdateParams struct {
+	Justification string
+	Approver      string
+	ExpiresAt     *time.Time
+}
+
+// ExemptionService encapsulates the methods to manage rule exemptions
+type ExemptionService interface {
+	// Create creates a new exemption in the project. The entity of the
+	// exemption must be identified by its ID.
+	Create(ctx context.Context, projectID uuid.UUID, exemption *minderv1.RuleExemption,
+		createdBy string) (*minderv1.RuleExemption, error)
+
+	// Get returns the exemption with the given ID
+	Get(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (*minderv1.RuleExemption, error)
+
+	// List lists the exemptions of the project, optionally only those of
+	// the profile with the given name
+	List(ctx context.Context, projectID uuid.UUID, profileName string,
+		includeExpired bool) ([]*minderv1.RuleExemption, error)
+
+	// Update updates the justification, approver or expiry of an exemption
+	Update(ctx context.Context, projectID uuid.UUID, id uuid.UUID, params UpdateParams) (*minderv1.RuleExemption, error)
+
+	// Delete deletes the exemption with the given ID
+	Delete(ctx context.Context, projectID uuid.UUID, id uuid.UUID) error
+}
+
+type exemptionService struct {
+	store db.Store
+}
+
+// NewExemptionService creates a new exemption service
+func NewExemptionService(store db.Store) ExemptionService {
+	return &exemptionService{store: store}
+}
+
+func (s *exemptionService) Create(
+	ctx context.Context, projectID uuid.UUID, exemption *minderv1.RuleExemption, createdBy string,
+) (*minderv1.RuleExemption, error) {
+	justification := strings.TrimSpace(exemption.GetJustification())
+	approver := strings.TrimSpace(exemption.GetApprover())
+	if justification == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "justification is required")
+	}
+	if approver == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "approver is required")
+	}
+	if exemption.GetExpiresAt() == nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "expiry is required")
+	}
+	expiresAt := exemption.GetExpiresAt().AsTime()
+	if !expiresAt.After(time.Now()) {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "expiry must be in the future")
+	}
+
+	profiles, err := s.store.GetProfileByProjectAndName(ctx, db.GetProfileByProjectAndNameParams{
+		ProjectID: projectID,
+		Name:      exemption.GetProfile(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting profile: %w", err)
+	}
+	if len(profiles) == 0 {
+		return nil, ErrProfileNotFound
+	}
+	profile := profiles[0].Profile
+
+	entityID, err := uuid.Parse(exemption.GetEntity().GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid entity ID: %s", err)
+	}
+	entity, err := s.store.GetEntityByID(ctx, entityID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrEntityNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting entity: %w", err)
+	}
+	// Don't leak the existence of entities of other projects
+	if entity.ProjectID != projectID {
+		return nil, ErrEntityNotFound
+	}
+	if entType := exemption.GetEntity().GetType(); entType != minderv1.Entity_ENTITY_UNSPECIFIED &&
+		entities.EntityTypeToDB(entType) != entity.EntityType {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "entity %s is not of type %s", entityID, entType)
+	}
+
+	// The rule must exist in the profile for the type of the entity
+	_, err = s.store.GetRuleTypeIDByRuleNameEntityProfile(ctx, db.GetRuleTypeIDByRuleNameEntityProfileParams{
+		Name:       exemption.GetRuleName(),
+		EntityType: entity.EntityType,
+		ProfileID:  profile.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "rule %s not found in profile %s for entity type %s",
+			exemption.GetRuleName(), profile.Name, entity.EntityType)
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting rule: %w", err)
+	}
+
+	ex, err := s.store.CreateRuleExemption(ctx, db.CreateRuleExemptionParams{
+		ProjectID:        projectID,
+		ProfileID:        profile.ID,
+		RuleName:         exemption.GetRuleName(),
+		EntityInstanceID: entity.ID,
+		Justification:    justification,
+		Approver:         approver,
+		CreatedBy:        createdBy,
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating exemption: %w", err)
+	}
+
+	return toProto(&ex, profile.Name, entity.EntityType, entity.Name), nil
+}
+
+func (s *exemptionService) Get(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (*minderv1.RuleExemption, error) {
+	row, err := s.store.GetRuleExemptionByID(ctx, db.GetRuleExemptionByIDParams{
+		ID:        id,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExemptionNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting exemption: %w", err)
+	}
+
+	return toProto(&row.RuleExemption, row.ProfileName, row.EntityType, row.EntityName), nil
+}
+
+func (s *exemptionService) List(
+	ctx context.Context, projectID uuid.UUID, profileName string, includeExpired bool,
+) ([]*minderv1.RuleExemption, error) {
+	params := db.ListRuleExemptionsByProjectParams{
+		ProjectID:      projectID,
+		IncludeExpired: includeExpired,
+	}
+
+	if profileName != "" {
+		profiles, err := s.store.GetProfileByProjectAndName(ctx, db.GetProfileByProjectAndNameParams{
+			ProjectID: projectID,
+			Name:      profileName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting profile: %w", err)
+		}
+		if len(profiles) == 0 {
+			return nil, ErrProfileNotFound
+		}
+		params.ProfileID = uuid.NullUUID{UUID: profiles[0].Profile.ID, Valid: true}
+	}
+
+	rows, err := s.store.ListRuleExemptionsByProject(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error listing exemptions: %w", err)
+	}
+
+	out := make([]*minderv1.RuleExemption, 0, len(rows))
+	for i := range rows {
+		out = append(out, toProto(&rows[i].RuleExemption, rows[i].ProfileName, rows[i].EntityType, rows[i].EntityName))
+	}
+	return out, nil
+}
+
+func (s *exemptionService) Update(
+	ctx context.Context, projectID uuid.UUID, id uuid.UUID, params UpdateParams,
+) (*minderv1.RuleExemption, error) {
+	// An expiry in the past is allowed, it ends the exemption early but
+	// keeps it around as an audit trail.
+	upd := db.UpdateRuleExemptionParams{
+		ID:        id,
+		ProjectID: projectID,
+	}
+	if j := strings.TrimSpace(params.Justification); j != "" {
+		upd.Justification = sql.NullString{String: j, Valid: true}
+	}
+	if a := strings.TrimSpace(params.Approver); a != "" {
+		upd.Approver = sql.NullString{String: a, Valid: true}
+	}
+	if params.ExpiresAt != nil {
+		upd.ExpiresAt = sql.NullTime{Time: *params.ExpiresAt, Valid: true}
+	}
+
+	_, err := s.store.UpdateRuleExemption(ctx, upd)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExemptionNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("error updating exemption: %w", err)
+	}
+
+	return s.Get(ctx, projectID, id)
+}
+
+func (s *exemptionService) Delete(ctx context.Context, projectID uuid.UUID, id uuid.UUID) error {
+	_, err := s.store.DeleteRuleExemption(ctx, db.DeleteRuleExemptionParams{
+		ID:        id,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrExemptionNotFound
+	} else if err != nil {
+		return fmt.Errorf("error deleting exemption: %w", err)
+	}
+	return nil
+}
+
+func toProto(ex *db.RuleExemption, profileName string, entityType db.Entities, entityName string) *minderv1.RuleExemption {
+	projectID := ex.ProjectID.String()
+	return &minderv1.RuleExemption{
+		Id: ex.ID.String(),
+		Context: &minderv1.Context{
+			Project: &projectID,
+		},
+		Profile:  profileName,
+		RuleName: ex.RuleName,
+		Entity: &minderv1.EntityTypedId{
+			Type: entities.EntityTypeFromDB(entityType),
+			Id:   ex.EntityInstanceID.String(),
+			Name: entityName,
+		},
+		Justification: ex.Justification,
+		Approver:      ex.Approver,
+		ExpiresAt:     timestamppb.New(ex.ExpiresAt),
+		CreatedBy:     ex.CreatedBy,
+		CreatedAt:     timestamppb.New(ex.CreatedAt),
+		UpdatedAt:     timestamppb.New(ex.UpdatedAt),
+	}
+}
@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemptions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	profileID := uuid.New()
+	entityID := uuid.New()
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	validExemption := func() *minderv1.RuleExemption {
+		return &minderv1.RuleExemption{
+			Profile:  "security",
+			RuleName: "branch_protection",
+			Entity: &minderv1.EntityTypedId{
+				Type: minderv1.Entity_ENTITY_REPOSITORIES,
+				Id:   entityID.String(),
+			},
+			Justification: "migration tracked in TICKET-1",
+			Approver:      "alice",
+			ExpiresAt:     timestamppb.New(tomorrow),
+		}
+	}
+
+	expectProfile := func(store *mockdb.MockStore) {
+		store.EXPECT().GetProfileByProjectAndName(gomock.Any(), db.GetProfileByProjectAndNameParams{
+			ProjectID: projectID,
+			Name:      "security",
+		}).Return([]db.GetProfileByProjectAndNameRow{
+			{Profile: db.Profile{ID: profileID, Name: "security", ProjectID: projectID}},
+		}, nil)
+	}
+	expectEntity := func(store *mockdb.MockStore, project uuid.UUID) {
+		store.EXPECT().GetEntityByID(gomock.Any(), entityID).Return(db.EntityInstance{
+			ID:         entityID,
+			EntityType: db.EntitiesRepository,
+			Name:       "mindersec/minder",
+			ProjectID:  project,
+		}, nil)
+	}
+
+	tests := []struct {
+		name      string
+		exemption func() *minderv1.RuleExemption
+		setup     func(store *mockdb.MockStore)
+		wantErr   error
+		errMsg    string
+	}{
+		{
+			name:      "creates the exemption",
+			exemption: validExemption,
+			setup: func(store *mockdb.MockStore) {
+				expectProfile(store)
+				expectEntity(store, projectID)
+				store.EXPECT().GetRuleTypeIDByRuleNameEntityProfile(gomock.Any(), db.GetRuleTypeIDByRuleNameEntityProfileParams{
+					Name:       "branch_protection",
+					EntityType: db.EntitiesRepository,
+					ProfileID:  profileID,
+				}).Return(uuid.New(), nil)
+				store.EXPECT().CreateRuleExemption(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateRuleExemptionParams) (db.RuleExemption, error) {
+						assert.Equal(t, profileID, arg.ProfileID)
+						assert.Equal(t, entityID, arg.EntityInstanceID)
+						assert.Equal(t, "bob", arg.CreatedBy)
+						return db.RuleExemption{
+							ID:               uuid.New(),
+							ProjectID:        arg.ProjectID,
+							ProfileID:        arg.ProfileID,
+							RuleName:         arg.RuleName,
+							EntityInstanceID: arg.EntityInstanceID,
+							Justification:    arg.Justification,
+							Approver:         arg.Approver,
+							CreatedBy:        arg.CreatedBy,
+							ExpiresAt:        arg.ExpiresAt,
+						}, nil
+					})
+			},
+		},
+		{
+			name: "justification is required",
+			exemption: func() *minderv1.RuleExemption {
+				ex := validExemption()
+				ex.Justification = "  "
+				return ex
+			},
+			errMsg: "justification is required",
+		},
+		{
+			name: "expiry must be in the future",
+			exemption: func() *minderv1.RuleExemption {
+				ex := validExemption()
+				ex.ExpiresAt = timestamppb.New(time.Now().Add(-time.Hour))
+				return ex
+			},
+			errMsg: "expiry must be in the future",
+		},
+		{
+			name:      "unknown profile",
+			exemption: validExemption,
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetProfileByProjectAndName(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: ErrProfileNotFound,
+		},
+		{
+			name:      "entity of another project",
+			exemption: validExemption,
+			setup: func(store *mockdb.MockStore) {
+				expectProfile(store)
+				expectEntity(store, uuid.New())
+			},
+			wantErr: ErrEntityNotFound,
+		},
+		{
+			name: "entity of another type",
+			exemption: func() *minderv1.RuleExemption {
+				ex := validExemption()
+				ex.Entity.Type = minderv1.Entity_ENTITY_ARTIFACTS
+				return ex
+			},
+			setup: func(store *mockdb.MockStore) {
+				expectProfile(store)
+				expectEntity(store, projectID)
+			},
+			errMsg: "is not of type",
+		},
+		{
+			name:      "rule not in profile",
+			exemption: validExemption,
+			setup: func(store *mockdb.MockStore) {
+				expectProfile(store)
+				expectEntity(store, projectID)
+				store.EXPECT().GetRuleTypeIDByRuleNameEntityProfile(gomock.Any(), gomock.Any()).
+					Return(uuid.Nil, sql.ErrNoRows)
+			},
+			errMsg: "rule branch_protection not found in profile security",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tt.setup != nil {
+				tt.setup(store)
+			}
+
+			svc := NewExemptionService(store)
+			ex, err := svc.Create(context.Background(), projectID, tt.exemption(), "bob")
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			case tt.errMsg != "":
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "security", ex.GetProfile())
+			assert.Equal(t, "mindersec/minder", ex.GetEntity().GetName())
+			assert.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, ex.GetEntity().GetType())
+			assert.Equal(t, "alice", ex.GetApprover())
+			assert.Equal(t, "bob", ex.GetCreatedBy())
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	projectID := uuid.New()
+	id := uuid.New()
+	expiresAt := time.Now().Add(-time.Minute)
+
+	store.EXPECT().UpdateRuleExemption(gomock.Any(), db.UpdateRuleExemptionParams{
+		ID:        id,
+		ProjectID: projectID,
+		Approver:  sql.NullString{String: "carol", Valid: true},
+		ExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+	}).Return(db.RuleExemption{ID: id}, nil)
+	store.EXPECT().GetRuleExemptionByID(gomock.Any(), db.GetRuleExemptionByIDParams{
+		ID:        id,
+		ProjectID: projectID,
+	}).Return(db.GetRuleExemptionByIDRow{
+		RuleExemption: db.RuleExemption{ID: id, Approver: "carol", ExpiresAt: expiresAt},
+		ProfileName:   "security",
+		EntityType:    db.EntitiesRepository,
+		EntityName:    "mindersec/minder",
+	}, nil)
+
+	svc := NewExemptionService(store)
+	ex, err := svc.Update(context.Background(), projectID, id, UpdateParams{
+		Approver:  "carol",
+		ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "carol", ex.GetApprover())
+	assert.Equal(t, "security", ex.GetProfile())
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().DeleteRuleExemption(gomock.Any(), gomock.Any()).
+		Return(db.RuleExemption{}, sql.ErrNoRows)
+
+	svc := NewExemptionService(store)
+	err := svc.Delete(context.Background(), uuid.New(), uuid.New())
+	require.ErrorIs(t, err, ErrExemptionNotFound)
+}
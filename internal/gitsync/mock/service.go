@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_gitsync -destination=./mock/service.go -source=./service.go
+//
+
+// Package mock_gitsync is a generated GoMock package.
+package mock_gitsync
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSyncService is a mock of SyncService interface.
+type MockSyncService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncServiceMockRecorder is the mock recorder for MockSyncService.
+type MockSyncServiceMockRecorder struct {
+	mock *MockSyncService
+}
+
+// NewMockSyncService creates a new mock instance.
+func NewMockSyncService(ctrl *gomock.Controller) *MockSyncService {
+	mock := &MockSyncService{ctrl: ctrl}
+	mock.recorder = &MockSyncServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncService) EXPECT() *MockSyncServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteSource mocks base method.
+func (m *MockSyncService) DeleteSource(ctx context.Context, projectID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSource", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSource indicates an expected call of DeleteSource.
+func (mr *MockSyncServiceMockRecorder) DeleteSource(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSource", reflect.TypeOf((*MockSyncService)(nil).DeleteSource), ctx, projectID)
+}
+
+// GetStatus mocks base method.
+func (m *MockSyncService) GetStatus(ctx context.Context, projectID uuid.UUID) (*v1.ProjectSyncStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", ctx, projectID)
+	ret0, _ := ret[0].(*v1.ProjectSyncStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockSyncServiceMockRecorder) GetStatus(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockSyncService)(nil).GetStatus), ctx, projectID)
+}
+
+// Run mocks base method.
+func (m *MockSyncService) Run(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockSyncServiceMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockSyncService)(nil).Run), ctx)
+}
+
+// SetSource mocks base method.
+func (m *MockSyncService) SetSource(ctx context.Context, projectID uuid.UUID, source *v1.ProjectSyncSource) (*v1.ProjectSyncStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSource", ctx, projectID, source)
+	ret0, _ := ret[0].(*v1.ProjectSyncStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSource indicates an expected call of SetSource.
+func (mr *MockSyncServiceMockRecorder) SetSource(ctx, projectID, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSource", reflect.TypeOf((*MockSyncService)(nil).SetSource), ctx, projectID, source)
+}
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util/protodrift"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+	profsvc "github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+const (
+	// KindDataSource is the kind of data source changes
+	KindDataSource = "data_source"
+	// KindRuleType is the kind of rule type changes
+	KindRuleType = "rule_type"
+	// KindProfile is the kind of profile changes
+	KindProfile = "profile"
+
+	// ActionCreate is a resource of the repository created in the project
+	ActionCreate = "create"
+	// ActionUpdate is a resource of the project updated to match the repository
+	ActionUpdate = "update"
+	// ActionDelete is a resource of the project deleted as it is not in the repository
+	ActionDelete = "delete"
+)
+
+// Change is a difference between the repository and the project, and the
+// action applied to reconcile it
+type Change struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// resources are the rule types, profiles and data sources of a project or
+// repository, by name
+type resources struct {
+	dataSources map[string]*minderv1.DataSource
+	ruleTypes   map[string]*minderv1.RuleType
+	profiles    map[string]*minderv1.Profile
+}
+
+func newResources() *resources {
+	return &resources{
+		dataSources: map[string]*minderv1.DataSource{},
+		ruleTypes:   map[string]*minderv1.RuleType{},
+		profiles:    map[string]*minderv1.Profile{},
+	}
+}
+
+// readBundle reads the resources of the repository
+func readBundle(bundle reader.BundleReader) (*resources, error) {
+	res := newResources()
+
+	err := bundle.ForEachDataSource(func(ds *minderv1.DataSource) error {
+		return addResource(res.dataSources, KindDataSource, ds)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = bundle.ForEachRuleType(func(rt *minderv1.RuleType) error {
+		return addResource(res.ruleTypes, KindRuleType, rt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = bundle.ForEachProfile(func(p *minderv1.Profile) error {
+		return addResource(res.profiles, KindProfile, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type namedResource interface {
+	proto.Message
+	GetName() string
+}
+
+func addResource[T namedResource](byName map[string]T, kind string, r T) error {
+	if _, ok := byName[r.GetName()]; ok {
+		return fmt.Errorf("duplicate %s %q in repository", kind, r.GetName())
+	}
+	// IDs and contexts come from the project, not the repository
+	protodrift.ClearFields(r, "id", "context")
+	byName[r.GetName()] = r
+	return nil
+}
+
+// currentResources reads the resources of the project which the sync
+// manages. Resources of bundle subscriptions have namespaced names, and
+// are left to the subscription.
+func (s *syncService) currentResources(
+	ctx context.Context, qtx db.ExtendQuerier, projectID uuid.UUID,
+) (*resources, error) {
+	res := newResources()
+
+	dss, err := s.dataSources.List(ctx, projectID, datasourceservice.ReadBuilder().WithTransaction(qtx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing data sources: %w", err)
+	}
+	for _, ds := range dss {
+		if !isNamespaced(ds.GetName()) {
+			res.dataSources[ds.GetName()] = ds
+		}
+	}
+
+	rts, err := qtx.ListRuleTypesByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing rule types: %w", err)
+	}
+	for i := range rts {
+		if isNamespaced(rts[i].Name) {
+			continue
+		}
+		rt, err := ruletypes.RuleTypePBFromDB(&rts[i])
+		if err != nil {
+			return nil, fmt.Errorf("error converting rule type %s: %w", rts[i].Name, err)
+		}
+		res.ruleTypes[rt.GetName()] = rt
+	}
+
+	rows, err := qtx.ListProfilesByProjectIDAndLabel(ctx, db.ListProfilesByProjectIDAndLabelParams{
+		ProjectID:     projectID,
+		IncludeLabels: []string{"*"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing profiles: %w", err)
+	}
+	// The merged profiles are keyed by ID
+	for _, p := range profsvc.MergeDatabaseListIntoProfiles(rows) {
+		if !isNamespaced(p.GetName()) {
+			res.profiles[p.GetName()] = p
+		}
+	}
+
+	return res, nil
+}
+
+func isNamespaced(name string) bool {
+	return strings.Contains(name, "/")
+}
+
+// diff returns the changes needed for the project to match the repository,
+// in the order they must be applied: data sources are created before the
+// rule types using them, and rule types before the profiles using them.
+// Deletions go the other way round.
+func diff(desired, current *resources) []Change {
+	var changes []Change
+	changes = append(changes, upserts(KindDataSource, desired.dataSources, current.dataSources)...)
+	changes = append(changes, upserts(KindRuleType, desired.ruleTypes, current.ruleTypes)...)
+	changes = append(changes, upserts(KindProfile, desired.profiles, current.profiles)...)
+	changes = append(changes, deletes(KindProfile, desired.profiles, current.profiles)...)
+	changes = append(changes, deletes(KindRuleType, desired.ruleTypes, current.ruleTypes)...)
+	changes = append(changes, deletes(KindDataSource, desired.dataSources, current.dataSources)...)
+	return changes
+}
+
+func upserts[T proto.Message](kind string, desired, current map[string]T) []Change {
+	var changes []Change
+	for _, name := range sortedNames(desired) {
+		have, ok := current[name]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Kind: kind, Name: name, Action: ActionCreate})
+		case !contains(desired[name], have):
+			changes = append(changes, Change{Kind: kind, Name: name, Action: ActionUpdate})
+		}
+	}
+	return changes
+}
+
+func deletes[T proto.Message](kind string, desired, current map[string]T) []Change {
+	var changes []Change
+	for _, name := range sortedNames(current) {
+		if _, ok := desired[name]; !ok {
+			changes = append(changes, Change{Kind: kind, Name: name, Action: ActionDelete})
+		}
+	}
+	return changes
+}
+
+func sortedNames[T any](byName map[string]T) []string {
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// contains reports whether the resource of the project matches the resource
+// of the repository. The version and type only identify the kind of resource
+// in the repository.
+func contains(want, have proto.Message) bool {
+	return protodrift.Contains(want, have, "version", "type")
+}
+
+// apply applies the changes to the project
+func (s *syncService) apply(
+	ctx context.Context,
+	qtx db.ExtendQuerier,
+	projectID uuid.UUID,
+	desired, current *resources,
+	changes []Change,
+) error {
+	for _, c := range changes {
+		if err := s.applyChange(ctx, qtx, projectID, desired, current, c); err != nil {
+			return fmt.Errorf("error applying %s of %s %q: %w", c.Action, c.Kind, c.Name, err)
+		}
+	}
+	return nil
+}
+
+//nolint:gocyclo // one case per kind and action
+func (s *syncService) applyChange(
+	ctx context.Context,
+	qtx db.ExtendQuerier,
+	projectID uuid.UUID,
+	desired, current *resources,
+	c Change,
+) error {
+	dsOpts := datasourceservice.OptionsBuilder().WithTransaction(qtx)
+	var err error
+	switch c.Kind + "/" + c.Action {
+	case KindDataSource + "/" + ActionCreate:
+		_, err = s.dataSources.Create(ctx, projectID, uuid.Nil, desired.dataSources[c.Name], dsOpts)
+	case KindDataSource + "/" + ActionUpdate:
+		_, err = s.dataSources.Update(ctx, projectID, uuid.Nil, desired.dataSources[c.Name], dsOpts)
+	case KindDataSource + "/" + ActionDelete:
+		var id uuid.UUID
+		id, err = uuid.Parse(current.dataSources[c.Name].GetId())
+		if err == nil {
+			err = s.dataSources.Delete(ctx, id, projectID, dsOpts)
+		}
+	case KindRuleType + "/" + ActionCreate:
+		_, err = s.rules.CreateRuleType(ctx, projectID, uuid.Nil, desired.ruleTypes[c.Name], qtx)
+	case KindRuleType + "/" + ActionUpdate:
+		_, err = s.rules.UpdateRuleType(ctx, projectID, uuid.Nil, desired.ruleTypes[c.Name], qtx)
+	case KindRuleType + "/" + ActionDelete:
+		err = deleteRuleType(ctx, qtx, current.ruleTypes[c.Name])
+	case KindProfile + "/" + ActionCreate:
+		_, err = s.profiles.CreateProfile(ctx, projectID, uuid.Nil, desired.profiles[c.Name], qtx)
+	case KindProfile + "/" + ActionUpdate:
+		_, err = s.profiles.UpdateProfile(ctx, projectID, uuid.Nil, desired.profiles[c.Name], qtx)
+	case KindProfile + "/" + ActionDelete:
+		_, err = s.profiles.DeleteProfile(ctx, projectID, c.Name, qtx)
+	default:
+		err = fmt.Errorf("unknown change %s/%s", c.Kind, c.Action)
+	}
+	return err
+}
+
+func deleteRuleType(ctx context.Context, qtx db.ExtendQuerier, rt *minderv1.RuleType) error {
+	id, err := uuid.Parse(rt.GetId())
+	if err != nil {
+		return err
+	}
+	profiles, err := qtx.ListProfilesInstantiatingRuleType(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(profiles) > 0 {
+		return errors.New("rule type is used by profiles " + strings.Join(profiles, ", "))
+	}
+	return qtx.DeleteRuleType(ctx, id)
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	dsf "github.com/mindersec/minder/internal/datasources/service/mock/fixtures"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	params := func(v string) *structpb.Struct {
+		s, err := structpb.NewStruct(map[string]any{"branch": v})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	profile := func(branch string) *minderv1.Profile {
+		return &minderv1.Profile{
+			Name: "security",
+			Repository: []*minderv1.Profile_Rule{
+				{Type: "branch_protection", Params: params(branch)},
+			},
+		}
+	}
+
+	desired := newResources()
+	desired.ruleTypes["branch_protection"] = &minderv1.RuleType{
+		Name:    "branch_protection",
+		Version: "v1",
+		Type:    "rule-type",
+	}
+	desired.ruleTypes["secret_scanning"] = &minderv1.RuleType{Name: "secret_scanning"}
+	desired.profiles["security"] = profile("main")
+
+	current := newResources()
+	// Fields only set in the project are not drift
+	current.ruleTypes["branch_protection"] = &minderv1.RuleType{
+		Id:           ptr.Ptr("00000000-0000-0000-0000-000000000001"),
+		Name:         "branch_protection",
+		DisplayName:  "branch_protection",
+		ReleasePhase: minderv1.RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_GA,
+	}
+	current.profiles["security"] = profile("develop")
+	current.profiles["security"].Repository[0].Name = "branch_protection"
+	current.profiles["legacy"] = &minderv1.Profile{Name: "legacy"}
+	current.dataSources["osv"] = &minderv1.DataSource{Name: "osv"}
+
+	assert.Equal(t, []Change{
+		{Kind: KindRuleType, Name: "secret_scanning", Action: ActionCreate},
+		{Kind: KindProfile, Name: "security", Action: ActionUpdate},
+		{Kind: KindProfile, Name: "legacy", Action: ActionDelete},
+		{Kind: KindDataSource, Name: "osv", Action: ActionDelete},
+	}, diff(desired, current))
+
+	// Once the profile matches, the project is in sync
+	current.ruleTypes["secret_scanning"] = &minderv1.RuleType{Name: "secret_scanning"}
+	current.profiles["security"].Repository[0].Params = params("main")
+	delete(current.profiles, "legacy")
+	delete(current.dataSources, "osv")
+	assert.Empty(t, diff(desired, current))
+}
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *minderv1.Profile
+		have *minderv1.Profile
+		ok   bool
+	}{
+		{
+			name: "equal",
+			want: &minderv1.Profile{Name: "p", Alert: ptr.Ptr("on")},
+			have: &minderv1.Profile{Name: "p", Alert: ptr.Ptr("on")},
+			ok:   true,
+		},
+		{
+			name: "default only set in the project",
+			want: &minderv1.Profile{Name: "p"},
+			have: &minderv1.Profile{Name: "p", Alert: ptr.Ptr("on"), DisplayName: "p"},
+			ok:   true,
+		},
+		{
+			name: "changed field",
+			want: &minderv1.Profile{Name: "p", Remediate: ptr.Ptr("on")},
+			have: &minderv1.Profile{Name: "p", Remediate: ptr.Ptr("off")},
+		},
+		{
+			name: "removed rule",
+			want: &minderv1.Profile{Name: "p", Repository: []*minderv1.Profile_Rule{{Type: "a"}}},
+			have: &minderv1.Profile{Name: "p", Repository: []*minderv1.Profile_Rule{{Type: "a"}, {Type: "b"}}},
+		},
+		{
+			name: "changed labels",
+			want: &minderv1.Profile{Name: "p", Labels: []string{"a"}},
+			have: &minderv1.Profile{Name: "p", Labels: []string{"b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.ok, contains(tt.want, tt.have))
+		})
+	}
+}
+
+func TestCurrentResources(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	projectID := uuid.New()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListRuleTypesByProject(gomock.Any(), projectID).Return(nil, nil)
+	store.EXPECT().ListProfilesByProjectIDAndLabel(gomock.Any(), db.ListProfilesByProjectIDAndLabelParams{
+		ProjectID:     projectID,
+		IncludeLabels: []string{"*"},
+	}).Return([]db.ListProfilesByProjectIDAndLabelRow{
+		{Profile: db.Profile{ID: uuid.New(), Name: "security", ProjectID: projectID, Labels: []string{"team"}}},
+		{Profile: db.Profile{ID: uuid.New(), Name: "stacklok/healthcheck", ProjectID: projectID}},
+	}, nil)
+
+	s := &syncService{
+		dataSources: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulListDataSources(
+			&minderv1.DataSource{Name: "osv"},
+			&minderv1.DataSource{Name: "stacklok/deps"},
+		))(ctrl),
+	}
+
+	res, err := s.currentResources(context.Background(), store, projectID)
+	require.NoError(t, err)
+
+	// Profiles are keyed by name, and resources of subscriptions are left out
+	assert.Len(t, res.profiles, 1)
+	assert.Equal(t, "security", res.profiles["security"].GetName())
+	assert.Len(t, res.dataSources, 1)
+	assert.Contains(t, res.dataSources, "osv")
+	assert.Empty(t, res.ruleTypes)
+}
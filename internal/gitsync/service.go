@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitsync keeps the rule types, profiles and data sources of a
+// project in sync with a git repository. The repository is periodically
+// cloned through a git provider of the project, and the project is
+// reconciled with its contents: missing resources are created, changed
+// ones updated, and the ones no longer in the repository deleted.
+package gitsync
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers"
+	"github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	profsvc "github.com/mindersec/minder/pkg/profiles"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+const (
+	// defaultInterval is the time between two syncs when none is configured
+	defaultInterval = time.Hour
+	// maxInterval is the longest time between two syncs
+	maxInterval = 30 * 24 * time.Hour
+	// defaultBranch is the branch synced from when none is configured
+	defaultBranch = "main"
+)
+
+var (
+	// ErrSyncSourceNotFound is returned when the project is not synced from a repository
+	ErrSyncSourceNotFound = util.UserVisibleError(codes.NotFound, "project is not synced from a repository")
+	// ErrSyncDisabled is returned when syncing projects is not enabled on the server
+	ErrSyncDisabled = util.UserVisibleError(codes.FailedPrecondition, "project sync is not enabled on this server")
+)
+
+// SyncService manages the repositories projects are synced from, and
+// runs the syncs
+type SyncService interface {
+	// SetSource sets the repository the project is synced from, and
+	// schedules a sync right away
+	SetSource(ctx context.Context, projectID uuid.UUID, source *minderv1.ProjectSyncSource) (*minderv1.ProjectSyncStatus, error)
+
+	// GetStatus returns the repository the project is synced from, and
+	// the outcome of the last sync
+	GetStatus(ctx context.Context, projectID uuid.UUID) (*minderv1.ProjectSyncStatus, error)
+
+	// DeleteSource stops syncing the project. The resources of the
+	// project are kept as they are.
+	DeleteSource(ctx context.Context, projectID uuid.UUID) error
+
+	// Run syncs the projects as they become due, until the context is
+	// cancelled
+	Run(ctx context.Context) error
+}
+
+type syncService struct {
+	store           db.Store
+	cfg             *serverconfig.ProjectSyncConfig
+	providerStore   providers.ProviderStore
+	providerManager manager.ProviderManager
+	profiles        profsvc.ProfileService
+	rules           ruletypes.RuleTypeService
+	dataSources     datasourceservice.DataSourcesService
+}
+
+// NewSyncService creates a new project sync service
+func NewSyncService(
+	store db.Store,
+	cfg *serverconfig.ProjectSyncConfig,
+	providerStore providers.ProviderStore,
+	providerManager manager.ProviderManager,
+	profiles profsvc.ProfileService,
+	rules ruletypes.RuleTypeService,
+	dataSources datasourceservice.DataSourcesService,
+) SyncService {
+	return &syncService{
+		store:           store,
+		cfg:             cfg,
+		providerStore:   providerStore,
+		providerManager: providerManager,
+		profiles:        profiles,
+		rules:           rules,
+		dataSources:     dataSources,
+	}
+}
+
+func (s *syncService) SetSource(
+	ctx context.Context, projectID uuid.UUID, source *minderv1.ProjectSyncSource,
+) (*minderv1.ProjectSyncStatus, error) {
+	if !s.cfg.Enabled {
+		return nil, ErrSyncDisabled
+	}
+
+	repoHost, repoName, err := parseRepository(source.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	syncPath, err := cleanPath(source.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	interval, err := s.parseInterval(source.GetInterval())
+	if err != nil {
+		return nil, err
+	}
+	branch := source.GetBranch()
+	if branch == "" {
+		branch = defaultBranch
+	}
+
+	provs, err := s.providerStore.GetByTraitInHierarchy(ctx, projectID, source.GetProvider(), db.ProviderTypeGit)
+	if err != nil {
+		pErr := providers.ErrProviderNotFoundBy{}
+		if errors.As(err, &pErr) {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "git provider not found")
+		}
+		return nil, fmt.Errorf("error getting git provider: %w", err)
+	}
+	if len(provs) != 1 {
+		return nil, util.UserVisibleError(codes.InvalidArgument,
+			"cannot infer git provider, there are %d git providers available", len(provs))
+	}
+
+	gitClient, err := s.gitProvider(ctx, provs[0].ID)
+	if err != nil {
+		return nil, err
+	}
+	cloneURL, err := resolveRepository(ctx, gitClient, repoHost, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := s.store.UpsertProjectSyncSource(ctx, db.UpsertProjectSyncSourceParams{
+		ProjectID:       projectID,
+		ProviderID:      provs[0].ID,
+		Repository:      cloneURL,
+		Branch:          branch,
+		Path:            syncPath,
+		IntervalSeconds: int32(interval.Seconds()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting project sync source: %w", err)
+	}
+
+	return toProto(&src, provs[0].Name)
+}
+
+func (s *syncService) GetStatus(ctx context.Context, projectID uuid.UUID) (*minderv1.ProjectSyncStatus, error) {
+	row, err := s.store.GetProjectSyncSource(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSyncSourceNotFound
+		}
+		return nil, fmt.Errorf("error getting project sync source: %w", err)
+	}
+
+	return toProto(&row.ProjectSyncSource, row.ProviderName)
+}
+
+func (s *syncService) DeleteSource(ctx context.Context, projectID uuid.UUID) error {
+	if _, err := s.store.DeleteProjectSyncSource(ctx, projectID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSyncSourceNotFound
+		}
+		return fmt.Errorf("error deleting project sync source: %w", err)
+	}
+	return nil
+}
+
+func (s *syncService) parseInterval(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultInterval, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, util.UserVisibleError(codes.InvalidArgument, "invalid interval %q: %v", value, err)
+	}
+	if interval < s.cfg.MinInterval || interval > maxInterval {
+		return 0, util.UserVisibleError(codes.InvalidArgument,
+			"interval must be between %s and %s", s.cfg.MinInterval, maxInterval)
+	}
+	return interval, nil
+}
+
+// parseRepository returns the host and the owner/name of the URL of a
+// repository
+func parseRepository(repository string) (string, string, error) {
+	u, err := url.Parse(repository)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return "", "", util.UserVisibleError(codes.InvalidArgument, "repository must be an https URL")
+	}
+	name := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if owner, _, ok := strings.Cut(name, "/"); !ok || owner == "" {
+		return "", "", util.UserVisibleError(codes.InvalidArgument, "repository must be the URL of a repository")
+	}
+	return u.Host, name, nil
+}
+
+// gitProvider instantiates the git provider a project is synced through
+func (s *syncService) gitProvider(ctx context.Context, providerID uuid.UUID) (provinfv1.Git, error) {
+	prov, err := s.providerManager.InstantiateFromID(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("error instantiating provider: %w", err)
+	}
+	gitClient, err := provinfv1.As[provinfv1.Git](prov)
+	if err != nil {
+		return nil, fmt.Errorf("provider is not a git provider: %w", err)
+	}
+	return gitClient, nil
+}
+
+// resolveRepository looks the repository up through the provider, and
+// returns the URL to clone it from. The provider clones with its own
+// credential, which may belong to a parent project, so only repositories
+// hosted by the provider are accepted.
+func resolveRepository(ctx context.Context, prov provinfv1.Git, host, name string) (string, error) {
+	// Providers look repositories up by name, or by upstream ID, which
+	// GitLab accepts as a project path as well
+	props, err := prov.FetchAllProperties(ctx, properties.NewProperties(map[string]any{
+		properties.PropertyName:       name,
+		properties.PropertyUpstreamID: name,
+	}), minderv1.Entity_ENTITY_REPOSITORIES, nil)
+	if errors.Is(err, provinfv1.ErrEntityNotFound) {
+		return "", util.UserVisibleError(codes.NotFound, "repository %s not found through the provider", name)
+	} else if err != nil {
+		return "", fmt.Errorf("error fetching repository: %w", err)
+	}
+	msg, err := prov.PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, props)
+	if err != nil {
+		return "", fmt.Errorf("error converting repository properties: %w", err)
+	}
+	repo, ok := msg.(*minderv1.Repository)
+	if !ok {
+		return "", fmt.Errorf("unexpected repository message %T", msg)
+	}
+
+	cloneURL, err := url.Parse(repo.GetCloneUrl())
+	if err != nil || cloneURL.Host == "" {
+		return "", fmt.Errorf("provider returned invalid clone URL %q", repo.GetCloneUrl())
+	}
+	if !strings.EqualFold(cloneURL.Host, host) {
+		return "", util.UserVisibleError(codes.InvalidArgument,
+			"repository must be hosted by the provider, at %s", cloneURL.Host)
+	}
+	return cloneURL.String(), nil
+}
+
+// cleanPath normalizes the directory of the repository holding the
+// resources, which must not point outside of the repository
+func cleanPath(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	cleaned := path.Clean(value)
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", util.UserVisibleError(codes.InvalidArgument, "path must be relative to the repository root")
+	}
+	if cleaned == "." {
+		return "", nil
+	}
+	return cleaned, nil
+}
+
+func toProto(src *db.ProjectSyncSource, providerName string) (*minderv1.ProjectSyncStatus, error) {
+	var changes []Change
+	if err := json.Unmarshal(src.Drift, &changes); err != nil {
+		return nil, fmt.Errorf("error parsing project sync drift: %w", err)
+	}
+
+	drift := make([]*minderv1.ProjectSyncDrift, 0, len(changes))
+	for _, c := range changes {
+		drift = append(drift, &minderv1.ProjectSyncDrift{
+			Kind:   c.Kind,
+			Name:   c.Name,
+			Action: c.Action,
+		})
+	}
+
+	status := &minderv1.ProjectSyncStatus{
+		Source: &minderv1.ProjectSyncSource{
+			Provider:   providerName,
+			Repository: src.Repository,
+			Branch:     src.Branch,
+			Path:       src.Path,
+			Interval:   (time.Duration(src.IntervalSeconds) * time.Second).String(),
+		},
+		Status:     string(src.Status),
+		Message:    src.Message,
+		Commit:     src.LastCommit,
+		NextSyncAt: timestamppb.New(src.NextSyncAt),
+		Drift:      drift,
+	}
+	if src.LastSyncedAt.Valid {
+		status.LastSyncAt = timestamppb.New(src.LastSyncedAt.Time)
+	}
+	return status, nil
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitsync
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/db"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	mockproviders "github.com/mindersec/minder/internal/providers/mock"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+func TestToProto(t *testing.T) {
+	t.Parallel()
+
+	drift, err := json.Marshal([]Change{{Kind: KindProfile, Name: "security", Action: ActionUpdate}})
+	require.NoError(t, err)
+	next := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	status, err := toProto(&db.ProjectSyncSource{
+		Repository:      "https://github.com/mindersec/policies",
+		Branch:          "main",
+		IntervalSeconds: 900,
+		Status:          db.ProjectSyncStatusSuccess,
+		LastCommit:      "abc123",
+		Drift:           drift,
+		NextSyncAt:      next,
+	}, "github-app")
+	require.NoError(t, err)
+
+	assert.Equal(t, "github-app", status.GetSource().GetProvider())
+	assert.Equal(t, "15m0s", status.GetSource().GetInterval())
+	assert.Equal(t, "success", status.GetStatus())
+	assert.Nil(t, status.GetLastSyncAt())
+	assert.Equal(t, next, status.GetNextSyncAt().AsTime())
+	assert.Equal(t, []*minderv1.ProjectSyncDrift{{Kind: "profile", Name: "security", Action: "update"}},
+		status.GetDrift())
+}
+
+func TestSetSourceValidation(t *testing.T) {
+	t.Parallel()
+
+	cfg := serverconfig.DefaultConfigForTest().ProjectSync
+	cfg.Enabled = true
+
+	tests := []struct {
+		name   string
+		source *minderv1.ProjectSyncSource
+		errMsg string
+	}{
+		{
+			name:   "repository must be https",
+			source: &minderv1.ProjectSyncSource{Repository: "git@github.com:mindersec/policies.git"},
+			errMsg: "repository must be an https URL",
+		},
+		{
+			name:   "repository must name a repository",
+			source: &minderv1.ProjectSyncSource{Repository: "https://github.com/mindersec"},
+			errMsg: "repository must be the URL of a repository",
+		},
+		{
+			name: "path must stay in the repository",
+			source: &minderv1.ProjectSyncSource{
+				Repository: "https://github.com/mindersec/policies",
+				Path:       "policies/../../etc",
+			},
+			errMsg: "path must be relative to the repository root",
+		},
+		{
+			name: "interval must not be too short",
+			source: &minderv1.ProjectSyncSource{
+				Repository: "https://github.com/mindersec/policies",
+				Interval:   "1m",
+			},
+			errMsg: "interval must be between",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &syncService{cfg: &cfg}
+			_, err := s.SetSource(context.Background(), uuid.New(), tt.source)
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		s := &syncService{cfg: &serverconfig.ProjectSyncConfig{}}
+		_, err := s.SetSource(context.Background(), uuid.New(), &minderv1.ProjectSyncSource{})
+		require.ErrorIs(t, err, ErrSyncDisabled)
+	})
+}
+
+func TestSetSourceRejectsOffHostRepository(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	projectID := uuid.New()
+	providerID := uuid.New()
+
+	cfg := serverconfig.DefaultConfigForTest().ProjectSync
+	cfg.Enabled = true
+
+	// the git provider may be the one of a parent project
+	provStore := mockproviders.NewMockProviderStore(ctrl)
+	provStore.EXPECT().
+		GetByTraitInHierarchy(gomock.Any(), projectID, "", db.ProviderTypeGit).
+		Return([]db.Provider{{ID: providerID, Name: "github-app"}}, nil)
+	gitProv := mockprov.NewMockGit(ctrl)
+	expectRepository(gitProv, "mindersec/policies", "https://github.com/mindersec/policies.git")
+	provMgr := mockmanager.NewMockProviderManager(ctrl)
+	provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).Return(gitProv, nil)
+
+	// nothing is stored
+	s := &syncService{cfg: &cfg, providerStore: provStore, providerManager: provMgr}
+	_, err := s.SetSource(context.Background(), projectID, &minderv1.ProjectSyncSource{
+		Repository: "https://attacker.example.com/mindersec/policies",
+	})
+	require.ErrorContains(t, err, "repository must be hosted by the provider, at github.com")
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-billy/v5/helper/iofs"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+)
+
+// syncTimeout bounds the time a single project sync may take
+const syncTimeout = 5 * time.Minute
+
+func (s *syncService) Run(ctx context.Context) error {
+	logger := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.syncDue(ctx); err != nil {
+				logger.Error().Err(err).Msg("error syncing projects")
+			}
+		}
+	}
+}
+
+// syncDue syncs the projects which are due. Each source is claimed before
+// it is synced, so that its next sync is scheduled even if this one fails.
+func (s *syncService) syncDue(ctx context.Context) error {
+	sources, err := s.store.ClaimDueProjectSyncSources(ctx, s.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("error claiming project sync sources: %w", err)
+	}
+
+	for i := range sources {
+		s.syncProject(ctx, &sources[i])
+	}
+	return nil
+}
+
+// syncProject syncs a project and records the outcome
+func (s *syncService) syncProject(ctx context.Context, src *db.ProjectSyncSource) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("project_id", src.ProjectID.String()).
+		Str("repository", src.Repository).
+		Logger()
+
+	syncCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+
+	params := db.UpdateProjectSyncStatusParams{
+		ProjectID: src.ProjectID,
+		Status:    db.ProjectSyncStatusSuccess,
+	}
+
+	commit, changes, err := s.sync(syncCtx, src)
+	if err != nil {
+		logger.Info().Err(err).Msg("project sync failed")
+		params.Status = db.ProjectSyncStatusFailure
+		params.Message = err.Error()
+		// keep the last synced commit, the project has not changed
+		params.LastCommit = src.LastCommit
+		changes = nil
+	} else {
+		logger.Debug().Str("commit", commit).Int("changes", len(changes)).Msg("project synced")
+		params.LastCommit = commit
+	}
+
+	if changes == nil {
+		changes = []Change{}
+	}
+	params.Drift, err = json.Marshal(changes)
+	if err != nil {
+		logger.Error().Err(err).Msg("error encoding project sync drift")
+		return
+	}
+
+	if err := s.store.UpdateProjectSyncStatus(ctx, params); err != nil {
+		logger.Error().Err(err).Msg("error recording project sync status")
+	}
+}
+
+// sync clones the repository of the source, and reconciles the project
+// with it in a single transaction. It returns the synced commit, and the
+// changes applied to the project.
+func (s *syncService) sync(ctx context.Context, src *db.ProjectSyncSource) (string, []Change, error) {
+	bundle, commit, err := s.clone(ctx, src)
+	if err != nil {
+		return "", nil, err
+	}
+
+	desired, err := readBundle(bundle)
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading repository: %w", err)
+	}
+
+	changes, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) ([]Change, error) {
+		return s.reconcile(ctx, qtx, src.ProjectID, desired)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return commit, changes, nil
+}
+
+func (s *syncService) reconcile(
+	ctx context.Context, qtx db.ExtendQuerier, projectID uuid.UUID, desired *resources,
+) ([]Change, error) {
+	current, err := s.currentResources(ctx, qtx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diff(desired, current)
+	if err := s.apply(ctx, qtx, projectID, desired, current, changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// clone clones the repository of the source through its git provider, and
+// returns a reader for the resources in it, together with the commit
+func (s *syncService) clone(ctx context.Context, src *db.ProjectSyncSource) (reader.BundleReader, string, error) {
+	gitClient, err := s.gitProvider(ctx, src.ProviderID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The repository is looked up again, since the provider may have
+	// changed since the source was set
+	repoHost, repoName, err := parseRepository(src.Repository)
+	if err != nil {
+		return nil, "", err
+	}
+	cloneURL, err := resolveRepository(ctx, gitClient, repoHost, repoName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	repo, err := gitClient.Clone(ctx, cloneURL, src.Branch)
+	if err != nil {
+		return nil, "", fmt.Errorf("error cloning repository: %w", err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting repository head: %w", err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting repository worktree: %w", err)
+	}
+
+	fsys := wt.Filesystem
+	if src.Path != "" {
+		fsys, err = fsys.Chroot(src.Path)
+		if err != nil {
+			return nil, "", fmt.Errorf("error opening %s in repository: %w", src.Path, err)
+		}
+	}
+
+	bundle := &mindpak.Bundle{Source: iofs.NewStatFS(fsys)}
+	if err := bundle.ReadSource(); err != nil {
+		return nil, "", fmt.Errorf("error reading repository: %w", err)
+	}
+
+	return reader.NewBundleReader(bundle), head.Hash().String(), nil
+}
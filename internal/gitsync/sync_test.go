@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitsync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+const (
+	testRuleType = `---
+version: v1
+type: rule-type
+name: secret_scanning
+def:
+  in_entity: repository
+  rule_schema: {}
+  ingest:
+    type: rest
+    rest:
+      endpoint: "/repos/{{.Entity.Owner}}/{{.Entity.Name}}"
+      parse: json
+  eval:
+    type: jq
+    jq:
+      - ingested:
+          def: ".security_and_analysis.secret_scanning.status"
+        constant: "enabled"
+`
+	testProfile = `---
+version: v1
+type: profile
+name: security
+repository:
+  - type: secret_scanning
+    def: {}
+`
+)
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	providerID := uuid.New()
+
+	repo, commit := fakeRepository(t, map[string]string{
+		"README.md": "not a resource",
+		"policies/rule_types/secret_scanning.yaml": testRuleType,
+		"policies/profiles/security.yaml":          testProfile,
+	})
+
+	gitProv := mockprov.NewMockGit(ctrl)
+	expectRepository(gitProv, "mindersec/policies", "https://github.com/mindersec/policies.git")
+	gitProv.EXPECT().Clone(gomock.Any(), "https://github.com/mindersec/policies.git", "main").Return(repo, nil)
+	provMgr := mockmanager.NewMockProviderManager(ctrl)
+	provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).Return(gitProv, nil)
+
+	s := &syncService{providerManager: provMgr}
+	bundle, gotCommit, err := s.clone(context.Background(), &db.ProjectSyncSource{
+		ProviderID: providerID,
+		Repository: "https://github.com/mindersec/policies",
+		Branch:     "main",
+		Path:       "policies",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, commit, gotCommit)
+
+	res, err := readBundle(bundle)
+	require.NoError(t, err)
+	require.Contains(t, res.ruleTypes, "secret_scanning")
+	require.Contains(t, res.profiles, "security")
+	assert.Empty(t, res.dataSources)
+	assert.Equal(t, "secret_scanning", res.profiles["security"].GetRepository()[0].GetType())
+}
+
+func TestCloneRejectsOffHostRepository(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	providerID := uuid.New()
+
+	// the credential of the provider must not be sent to other hosts
+	gitProv := mockprov.NewMockGit(ctrl)
+	expectRepository(gitProv, "mindersec/policies", "https://github.com/mindersec/policies.git")
+	provMgr := mockmanager.NewMockProviderManager(ctrl)
+	provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).Return(gitProv, nil)
+
+	s := &syncService{providerManager: provMgr}
+	_, _, err := s.clone(context.Background(), &db.ProjectSyncSource{
+		ProviderID: providerID,
+		Repository: "https://attacker.example.com/mindersec/policies",
+		Branch:     "main",
+	})
+	require.ErrorContains(t, err, "repository must be hosted by the provider, at github.com")
+}
+
+func TestSyncProjectRecordsFailure(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	projectID := uuid.New()
+
+	provMgr := mockmanager.NewMockProviderManager(ctrl)
+	provMgr.EXPECT().InstantiateFromID(gomock.Any(), gomock.Any()).Return(nil, errors.New("provider is gone"))
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().UpdateProjectSyncStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpdateProjectSyncStatusParams) error {
+			assert.Equal(t, projectID, arg.ProjectID)
+			assert.Equal(t, db.ProjectSyncStatusFailure, arg.Status)
+			assert.Contains(t, arg.Message, "provider is gone")
+			// the last synced commit is kept
+			assert.Equal(t, "abc123", arg.LastCommit)
+			assert.JSONEq(t, "[]", string(arg.Drift))
+			return nil
+		})
+
+	s := &syncService{store: store, providerManager: provMgr}
+	s.syncProject(context.Background(), &db.ProjectSyncSource{
+		ProjectID:  projectID,
+		Repository: "https://github.com/mindersec/policies",
+		LastCommit: "abc123",
+	})
+}
+
+// expectRepository makes the provider look the repository up by name
+func expectRepository(gitProv *mockprov.MockGit, name, cloneURL string) {
+	gitProv.EXPECT().
+		FetchAllProperties(gomock.Any(), gomock.Any(), minderv1.Entity_ENTITY_REPOSITORIES, nil).
+		DoAndReturn(func(
+			_ context.Context, getByProps *properties.Properties, _ minderv1.Entity, _ *properties.Properties,
+		) (*properties.Properties, error) {
+			if getByProps.GetProperty(properties.PropertyName).GetString() != name {
+				return nil, provinfv1.ErrEntityNotFound
+			}
+			return getByProps, nil
+		})
+	gitProv.EXPECT().
+		PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, gomock.Any()).
+		Return(&minderv1.Repository{CloneUrl: cloneURL}, nil).
+		AnyTimes()
+}
+
+// fakeRepository returns a repository with a single commit holding the files
+func fakeRepository(t *testing.T, files map[string]string) (*git.Repository, string) {
+	t.Helper()
+
+	fs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), fs)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	for name, content := range files {
+		f, err := fs.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		_, err = wt.Add(name)
+		require.NoError(t, err)
+	}
+
+	hash, err := wt.Commit("policies", &git.CommitOptions{
+		Author: &object.Signature{Name: "minder", Email: "minder@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return repo, hash.String()
+}
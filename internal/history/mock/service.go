@@ -58,6 +58,18 @@ func (mr *MockEvaluationHistoryServiceMockRecorder) ListEvaluationHistory(ctx, q
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationHistory", reflect.TypeOf((*MockEvaluationHistoryService)(nil).ListEvaluationHistory), ctx, qtx, cursor, size, filter, includeOutputs)
 }
 
+// NotifyEvaluationStored mocks base method.
+func (m *MockEvaluationHistoryService) NotifyEvaluationStored(projectID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyEvaluationStored", projectID)
+}
+
+// NotifyEvaluationStored indicates an expected call of NotifyEvaluationStored.
+func (mr *MockEvaluationHistoryServiceMockRecorder) NotifyEvaluationStored(projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyEvaluationStored", reflect.TypeOf((*MockEvaluationHistoryService)(nil).NotifyEvaluationStored), projectID)
+}
+
 // StoreEvaluationStatus mocks base method.
 func (m *MockEvaluationHistoryService) StoreEvaluationStatus(ctx context.Context, qtx db.Querier, ruleID, profileID uuid.UUID, entityType db.Entities, entityID uuid.UUID, evalError error, marshaledCheckpoint []byte, output any) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -72,3 +84,17 @@ func (mr *MockEvaluationHistoryServiceMockRecorder) StoreEvaluationStatus(ctx, q
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreEvaluationStatus", reflect.TypeOf((*MockEvaluationHistoryService)(nil).StoreEvaluationStatus), ctx, qtx, ruleID, profileID, entityType, entityID, evalError, marshaledCheckpoint, output)
 }
+
+// WatchEvaluations mocks base method.
+func (m *MockEvaluationHistoryService) WatchEvaluations(ctx context.Context, projectID uuid.UUID) <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEvaluations", ctx, projectID)
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// WatchEvaluations indicates an expected call of WatchEvaluations.
+func (mr *MockEvaluationHistoryServiceMockRecorder) WatchEvaluations(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvaluations", reflect.TypeOf((*MockEvaluationHistoryService)(nil).WatchEvaluations), ctx, projectID)
+}
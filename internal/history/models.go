@@ -41,7 +41,7 @@ var (
 	allowedEntityTypes        = []string{"repository", "build_environment", "artifact", "pull_request"}
 	allowedEvaluationStatuses = []actions.EvalStatus{
 		actions.EvalStatusSuccess, actions.EvalStatusFailure, actions.EvalStatusError,
-		actions.EvalStatusSkipped, actions.EvalStatusPending}
+		actions.EvalStatusSkipped, actions.EvalStatusPending, actions.EvalStatusWaived}
 	allowedRemediationStatuses = []actions.RemediationStatus{
 		actions.RemediationStatusSuccess, actions.RemediationStatusFailure, actions.RemediationStatusError,
 		actions.RemediationStatusSkipped, actions.RemediationStatusNotAvailable, actions.RemediationStatusPending}
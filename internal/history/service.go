@@ -50,6 +50,17 @@ type EvaluationHistoryService interface {
 		filter ListEvaluationFilter,
 		includeOutputs bool,
 	) (*ListEvaluationHistoryResult, error)
+	// WatchEvaluations returns a channel which receives a value whenever
+	// new evaluations are stored for entities of the given project. The
+	// channel stops receiving values once ctx is done.
+	//
+	// Only evaluations stored by this instance are notified, so
+	// watchers should also poll ListEvaluationHistory periodically.
+	WatchEvaluations(ctx context.Context, projectID uuid.UUID) <-chan struct{}
+	// NotifyEvaluationStored wakes up the watchers of the given project.
+	// It must be called once the transaction used to store the evaluation
+	// has been committed, otherwise watchers may not see it.
+	NotifyEvaluationStored(projectID uuid.UUID)
 }
 
 type options func(*evaluationHistoryService)
@@ -64,6 +75,7 @@ func withPropertiesServiceBuilder(builder func(qtx db.ExtendQuerier) propertiess
 func NewEvaluationHistoryService(providerManager manager.ProviderManager, opts ...options) EvaluationHistoryService {
 	ehs := &evaluationHistoryService{
 		providerManager: providerManager,
+		watchers:        newEvaluationWatchers(),
 		propServiceBuilder: func(qtx db.ExtendQuerier) propertiessvc.PropertiesService {
 			return propertiessvc.NewPropertiesService(qtx)
 		},
@@ -78,6 +90,7 @@ func NewEvaluationHistoryService(providerManager manager.ProviderManager, opts .
 type evaluationHistoryService struct {
 	providerManager    manager.ProviderManager
 	propServiceBuilder func(qtx db.ExtendQuerier) propertiessvc.PropertiesService
+	watchers           *evaluationWatchers
 }
 
 func (e *evaluationHistoryService) StoreEvaluationStatus(
@@ -145,6 +158,14 @@ func (e *evaluationHistoryService) StoreEvaluationStatus(
 	return evaluationID, nil
 }
 
+func (e *evaluationHistoryService) WatchEvaluations(ctx context.Context, projectID uuid.UUID) <-chan struct{} {
+	return e.watchers.watch(ctx, projectID)
+}
+
+func (e *evaluationHistoryService) NotifyEvaluationStored(projectID uuid.UUID) {
+	e.watchers.notify(projectID)
+}
+
 func (*evaluationHistoryService) createNewStatus(
 	ctx context.Context,
 	qtx db.Querier,
@@ -602,6 +623,8 @@ func mapEvalStatusTypes(
 		return db.EvalStatusTypesSkipped, nil
 	case "pending":
 		return db.EvalStatusTypesPending, nil
+	case "waived":
+		return db.EvalStatusTypesWaived, nil
 	default:
 		return db.EvalStatusTypes("invalid"),
 			fmt.Errorf("invalid evaluation status: %s", value)
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// evaluationWatchers keeps track of the callers waiting for new
+// evaluations to be stored, grouped by project.
+//
+// Watchers are only woken up, they are expected to fetch the new
+// evaluations from the database themselves. This keeps notifications
+// cheap and lets watchers apply the same filters as
+// ListEvaluationHistory.
+type evaluationWatchers struct {
+	mu       sync.Mutex
+	watchers map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+func newEvaluationWatchers() *evaluationWatchers {
+	return &evaluationWatchers{
+		watchers: make(map[uuid.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+// watch registers a new watcher for the given project. The returned
+// channel is deregistered once ctx is done, it is never closed.
+func (ew *evaluationWatchers) watch(ctx context.Context, projectID uuid.UUID) <-chan struct{} {
+	// The channel is buffered so that notifications arriving while the
+	// watcher is busy are coalesced rather than lost.
+	ch := make(chan struct{}, 1)
+
+	ew.mu.Lock()
+	defer ew.mu.Unlock()
+
+	if _, ok := ew.watchers[projectID]; !ok {
+		ew.watchers[projectID] = make(map[chan struct{}]struct{})
+	}
+	ew.watchers[projectID][ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		ew.mu.Lock()
+		defer ew.mu.Unlock()
+
+		delete(ew.watchers[projectID], ch)
+		if len(ew.watchers[projectID]) == 0 {
+			delete(ew.watchers, projectID)
+		}
+	}()
+
+	return ch
+}
+
+// notify wakes up all the watchers of the given project without
+// blocking.
+func (ew *evaluationWatchers) notify(projectID uuid.UUID) {
+	ew.mu.Lock()
+	defer ew.mu.Unlock()
+
+	for ch := range ew.watchers[projectID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluationWatchers(t *testing.T) {
+	t.Parallel()
+
+	ew := newEvaluationWatchers()
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ew.watch(ctx, projectID)
+
+	// Evaluations of other projects don't wake the watcher
+	ew.notify(otherProjectID)
+	require.Len(t, ch, 0)
+
+	// Notifications are coalesced while the watcher is busy
+	ew.notify(projectID)
+	ew.notify(projectID)
+	require.Len(t, ch, 1)
+	<-ch
+
+	cancel()
+	require.Eventually(t, func() bool {
+		ew.mu.Lock()
+		defer ew.mu.Unlock()
+		return len(ew.watchers) == 0
+	}, time.Second, time.Millisecond)
+}
@@ -93,3 +93,54 @@ func WithFailedForEachDataSource(mock BundleMock) {
 		ForEachDataSource(gomock.Any()).
 		Return(errDefault)
 }
+
+func WithForEachProfile(profiles ...*v1.Profile) func(BundleMock) {
+	return func(mock BundleMock) {
+		type argType = func(*v1.Profile) error
+		var argument argType
+		mock.EXPECT().
+			ForEachProfile(gomock.AssignableToTypeOf(argument)).
+			DoAndReturn(func(fn argType) error {
+				for _, profile := range profiles {
+					if err := fn(profile); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+	}
+}
+
+func WithForEachRuleType(ruleTypes ...*v1.RuleType) func(BundleMock) {
+	return func(mock BundleMock) {
+		type argType = func(*v1.RuleType) error
+		var argument argType
+		mock.EXPECT().
+			ForEachRuleType(gomock.AssignableToTypeOf(argument)).
+			DoAndReturn(func(fn argType) error {
+				for _, ruleType := range ruleTypes {
+					if err := fn(ruleType); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+	}
+}
+
+func WithForEachDataSource(dataSources ...*v1.DataSource) func(BundleMock) {
+	return func(mock BundleMock) {
+		type argType = func(*v1.DataSource) error
+		var argument argType
+		mock.EXPECT().
+			ForEachDataSource(gomock.AssignableToTypeOf(argument)).
+			DoAndReturn(func(fn argType) error {
+				for _, dataSource := range dataSources {
+					if err := fn(dataSource); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+	}
+}
@@ -70,3 +70,87 @@ func (mr *MockBundleSourceMockRecorder) ListBundles() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBundles", reflect.TypeOf((*MockBundleSource)(nil).ListBundles))
 }
+
+// MockVersionedBundleSource is a mock of VersionedBundleSource interface.
+type MockVersionedBundleSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionedBundleSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockVersionedBundleSourceMockRecorder is the mock recorder for MockVersionedBundleSource.
+type MockVersionedBundleSourceMockRecorder struct {
+	mock *MockVersionedBundleSource
+}
+
+// NewMockVersionedBundleSource creates a new mock instance.
+func NewMockVersionedBundleSource(ctrl *gomock.Controller) *MockVersionedBundleSource {
+	mock := &MockVersionedBundleSource{ctrl: ctrl}
+	mock.recorder = &MockVersionedBundleSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionedBundleSource) EXPECT() *MockVersionedBundleSourceMockRecorder {
+	return m.recorder
+}
+
+// GetBundle mocks base method.
+func (m *MockVersionedBundleSource) GetBundle(id mindpak.BundleID) (reader.BundleReader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBundle", id)
+	ret0, _ := ret[0].(reader.BundleReader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBundle indicates an expected call of GetBundle.
+func (mr *MockVersionedBundleSourceMockRecorder) GetBundle(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBundle", reflect.TypeOf((*MockVersionedBundleSource)(nil).GetBundle), id)
+}
+
+// GetBundleVersion mocks base method.
+func (m *MockVersionedBundleSource) GetBundleVersion(id mindpak.BundleID, version string) (reader.BundleReader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBundleVersion", id, version)
+	ret0, _ := ret[0].(reader.BundleReader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBundleVersion indicates an expected call of GetBundleVersion.
+func (mr *MockVersionedBundleSourceMockRecorder) GetBundleVersion(id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBundleVersion", reflect.TypeOf((*MockVersionedBundleSource)(nil).GetBundleVersion), id, version)
+}
+
+// ListBundles mocks base method.
+func (m *MockVersionedBundleSource) ListBundles() ([]mindpak.BundleID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBundles")
+	ret0, _ := ret[0].([]mindpak.BundleID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBundles indicates an expected call of ListBundles.
+func (mr *MockVersionedBundleSourceMockRecorder) ListBundles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBundles", reflect.TypeOf((*MockVersionedBundleSource)(nil).ListBundles))
+}
+
+// ListVersions mocks base method.
+func (m *MockVersionedBundleSource) ListVersions(id mindpak.BundleID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockVersionedBundleSourceMockRecorder) ListVersions(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockVersionedBundleSource)(nil).ListVersions), id)
+}
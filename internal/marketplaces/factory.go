@@ -7,11 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/marketplaces/registries"
 	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
 	"github.com/mindersec/minder/pkg/config/server"
-	"github.com/mindersec/minder/pkg/mindpak"
 	src "github.com/mindersec/minder/pkg/mindpak/sources"
 	"github.com/mindersec/minder/pkg/profiles"
 	"github.com/mindersec/minder/pkg/ruletypes"
@@ -20,8 +21,10 @@ import (
 // NewMarketplaceFromServiceConfig takes the Minder service config and
 // instantiates the object graph needed for the Marketplace. If the marketplace
 // functionality is disabled in the config or missing, this returns a no-op
-// implementation of Marketplace. Otherwise, it loads the bundle specified in
-// the service config and builds a single-source Marketplace for it.
+// implementation of Marketplace. Otherwise, it builds a Marketplace for the
+// sources specified in the service config. Remote sources are only contacted
+// when their bundles are needed, so that they don't prevent the server from
+// starting.
 func NewMarketplaceFromServiceConfig(
 	config server.MarketplaceConfig,
 	profile profiles.ProfileService,
@@ -41,18 +44,10 @@ func NewMarketplaceFromServiceConfig(
 
 	newSources := make([]src.BundleSource, len(cfgSources))
 	for i, cfgSource := range cfgSources {
-		// This is just used for validation
-		// TODO: support other sources
-		if t, err := cfgSource.GetType(); err != nil || t != server.TgzSource {
-			return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
-		}
-
-		tarPath := filepath.Clean(cfgSource.Location)
-		source, err := src.NewSourceFromTarGZ(tarPath)
+		source, err := newSourceFromConfig(&cfgSource)
 		if err != nil {
-			return nil, fmt.Errorf("unable to load tar from path %s: %w", tarPath, err)
+			return nil, err
 		}
-
 		newSources[i] = source
 	}
 
@@ -64,21 +59,73 @@ func NewMarketplaceFromServiceConfig(
 	return marketplace, nil
 }
 
-// NewMarketplace creates an instance of Marketplace with a single source
-func NewMarketplace(sources []src.BundleSource, subscriptions sub.SubscriptionService) (Marketplace, error) {
-	sourceMapping := make(map[mindpak.BundleID]src.BundleSource)
-	for _, source := range sources {
-		bundles, err := source.ListBundles()
+func newSourceFromConfig(cfgSource *server.BundleSourceConfig) (src.BundleSource, error) {
+	t, err := cfgSource.GetType()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
+	}
+
+	opts := []registries.Option{registries.WithPins(cfgSource.Pins)}
+	if cfgSource.Verification != nil {
+		// an empty issuer or identity would trust any signer
+		if cfgSource.Verification.Issuer == "" || cfgSource.Verification.Identity == "" {
+			return nil, fmt.Errorf("verification of source %s requires both an issuer and an identity",
+				cfgSource.Location)
+		}
+		opts = append(opts, registries.WithVerification(cfgSource.Verification))
+	}
+	if cfgSource.AllowUnverified {
+		opts = append(opts, registries.WithoutVerification())
+	}
+
+	// remote sources must be verified, unless explicitly allowed not to be
+	if t != server.TgzSource {
+		if cfgSource.Verification == nil && !cfgSource.AllowUnverified {
+			return nil, fmt.Errorf("source %s requires verification, or allow_unverified to be set",
+				cfgSource.Location)
+		}
+		if cfgSource.Verification != nil && cfgSource.AllowUnverified {
+			return nil, fmt.Errorf("source %s sets both verification and allow_unverified",
+				cfgSource.Location)
+		}
+	}
+
+	switch t {
+	case server.TgzSource:
+		// tarballs on disk are trusted as they are
+		if len(cfgSource.Pins) > 0 || cfgSource.Verification != nil || cfgSource.AllowUnverified {
+			return nil, fmt.Errorf("pins and verification are not supported for %s sources", t)
+		}
+		tarPath := filepath.Clean(cfgSource.Location)
+		source, err := src.NewSourceFromTarGZ(tarPath)
 		if err != nil {
-			return nil, fmt.Errorf("error while listing bundles: %w", err)
+			return nil, fmt.Errorf("unable to load tar from path %s: %w", tarPath, err)
+		}
+		return source, nil
+	case server.OCISource:
+		source, err := registries.NewOCISource(cfgSource.Location, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load bundle from repository %s: %w", cfgSource.Location, err)
 		}
-		for _, id := range bundles {
-			sourceMapping[id] = source
+		return source, nil
+	case server.HTTPSSource:
+		source, err := registries.NewHTTPSSource(cfgSource.Location, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load bundles from index %s: %w", cfgSource.Location, err)
 		}
+		return source, nil
+	default:
+		return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
 	}
+}
+
+// NewMarketplace creates an instance of Marketplace from its sources. The
+// bundles of the sources are only listed when first needed.
+func NewMarketplace(sources []src.BundleSource, subscriptions sub.SubscriptionService) (Marketplace, error) {
 	return &marketplace{
-		sources:       sourceMapping,
+		sources:       sources,
 		subscriptions: subscriptions,
+		now:           time.Now,
 	}, nil
 }
 
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package marketplaces_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/marketplaces"
+	"github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestNewMarketplaceFromServiceConfig_Verification(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		Name         string
+		Verification *server.BundleVerificationConfig
+	}{
+		{
+			Name:         "no identity",
+			Verification: &server.BundleVerificationConfig{Issuer: "https://token.actions.githubusercontent.com"},
+		},
+		{
+			Name:         "no issuer",
+			Verification: &server.BundleVerificationConfig{Identity: "https://github.com/acme/bundles"},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := marketplaces.NewMarketplaceFromServiceConfig(server.MarketplaceConfig{
+				Enabled: true,
+				Sources: []server.BundleSourceConfig{{
+					Type:         string(server.OCISource),
+					Location:     "ghcr.io/acme/bundles",
+					Verification: scenario.Verification,
+				}},
+			}, nil, nil, nil)
+			require.ErrorContains(t, err, "requires both an issuer and an identity")
+		})
+	}
+}
+
+func TestNewMarketplaceFromServiceConfig_RemoteSourcesVerified(t *testing.T) {
+	t.Parallel()
+
+	verification := &server.BundleVerificationConfig{
+		Issuer:   "https://token.actions.githubusercontent.com",
+		Identity: "https://github.com/acme/bundles/.github/workflows/release.yml@refs/heads/main",
+	}
+
+	scenarios := []struct {
+		Name          string
+		Source        server.BundleSourceConfig
+		ExpectedError string
+	}{
+		{
+			Name: "unverified oci source",
+			Source: server.BundleSourceConfig{
+				Type:     string(server.OCISource),
+				Location: "ghcr.io/acme/bundles",
+			},
+			ExpectedError: "source ghcr.io/acme/bundles requires verification, or allow_unverified to be set",
+		},
+		{
+			Name: "unverified https source",
+			Source: server.BundleSourceConfig{
+				Type:     string(server.HTTPSSource),
+				Location: "https://bundles.acme.example/index.json",
+			},
+			ExpectedError: "requires verification, or allow_unverified to be set",
+		},
+		{
+			Name: "verification and opt-out",
+			Source: server.BundleSourceConfig{
+				Type:            string(server.OCISource),
+				Location:        "ghcr.io/acme/bundles",
+				Verification:    verification,
+				AllowUnverified: true,
+			},
+			ExpectedError: "sets both verification and allow_unverified",
+		},
+		{
+			Name: "opt-out on a tarball",
+			Source: server.BundleSourceConfig{
+				Type:            string(server.TgzSource),
+				Location:        "../../pkg/mindpak/sources/testdata/bundle.tar.gz",
+				AllowUnverified: true,
+			},
+			ExpectedError: "not supported for tgz sources",
+		},
+		{
+			Name: "verified oci source",
+			Source: server.BundleSourceConfig{
+				Type:         string(server.OCISource),
+				Location:     "ghcr.io/acme/bundles",
+				Verification: verification,
+			},
+		},
+		{
+			Name: "explicitly unverified https source",
+			Source: server.BundleSourceConfig{
+				Type:            string(server.HTTPSSource),
+				Location:        "https://bundles.acme.example/index.json",
+				AllowUnverified: true,
+			},
+		},
+		{
+			Name: "tarball",
+			Source: server.BundleSourceConfig{
+				Type:     string(server.TgzSource),
+				Location: "../../pkg/mindpak/sources/testdata/bundle.tar.gz",
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := marketplaces.NewMarketplaceFromServiceConfig(server.MarketplaceConfig{
+				Enabled: true,
+				Sources: []server.BundleSourceConfig{scenario.Source},
+			}, nil, nil, nil)
+			if scenario.ExpectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, scenario.ExpectedError)
+		})
+	}
+}
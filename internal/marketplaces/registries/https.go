@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+const (
+	// maxIndexSize is the largest index we are willing to download
+	maxIndexSize int64 = 1024 * 1024
+)
+
+// Index is the document listing the bundles of an HTTPS source
+type Index struct {
+	Bundles []IndexEntry `json:"bundles"`
+}
+
+// IndexEntry is a version of a bundle listed in an index
+type IndexEntry struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	// URL is the location of the bundle tarball, relative to the index
+	URL string `json:"url"`
+	// Digest is the digest of the bundle tarball, e.g. sha256:4f3c...
+	Digest string `json:"digest"`
+	// Signature is the location of the sigstore bundle signing the tarball,
+	// relative to the index
+	Signature string `json:"signature,omitempty"`
+}
+
+// httpsSource is a bundle source for the bundles listed in an index served
+// over HTTPS. The index is fetched again whenever the source is used, so that
+// newly published bundles and versions are picked up.
+type httpsSource struct {
+	indexURL *url.URL
+	opts     *options
+	cache    bundleCache
+}
+
+// NewHTTPSSource creates a bundle source for the bundles listed in the index
+// served at indexURL. The index isn't fetched until the bundles are needed.
+func NewHTTPSSource(indexURL string, opts ...Option) (sources.VersionedBundleSource, error) {
+	o := newOptions(opts)
+	if err := o.checkVerification(indexURL); err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(indexURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index URL %s: %w", indexURL, err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("index URL %s must use https", indexURL)
+	}
+
+	if o.verification != nil && o.verifier == nil {
+		o.newVerifier = func() (signatureVerifier, error) {
+			verifier, err := sigstore.New(o.verification.SigstoreURL)
+			if err != nil {
+				return nil, err
+			}
+			return verifier, nil
+		}
+	}
+
+	return &httpsSource{
+		indexURL: u,
+		opts:     o,
+	}, nil
+}
+
+func (s *httpsSource) GetBundle(id mindpak.BundleID) (reader.BundleReader, error) {
+	versions, err := s.ListVersions(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("%w: %s has no versions", sources.ErrBundleVersionNotFound, id)
+	}
+	return s.GetBundleVersion(id, versions[0])
+}
+
+func (s *httpsSource) ListBundles() ([]mindpak.BundleID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	index, err := s.fetchIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundles []mindpak.BundleID
+	for _, entry := range index.Bundles {
+		id := mindpak.ID(entry.Namespace, entry.Name)
+		if !slices.Contains(bundles, id) {
+			bundles = append(bundles, id)
+		}
+	}
+	return bundles, nil
+}
+
+func (s *httpsSource) ListVersions(id mindpak.BundleID) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	index, err := s.fetchIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := index.entries(id)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: %s", sources.ErrBundleNotFound, id)
+	}
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		versions = append(versions, entry.Version)
+	}
+	sources.SortVersions(versions)
+	return versions, nil
+}
+
+func (s *httpsSource) GetBundleVersion(id mindpak.BundleID, version string) (reader.BundleReader, error) {
+	key := fmt.Sprintf("%s@%s", id, version)
+	if bundle, ok := s.cache.get(key); ok {
+		return bundle, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	index, err := s.fetchIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := index.entries(id)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: %s", sources.ErrBundleNotFound, id)
+	}
+	idx := slices.IndexFunc(entries, func(entry *IndexEntry) bool {
+		return entry.Version == version
+	})
+	if idx == -1 {
+		return nil, fmt.Errorf("%w: %s", sources.ErrBundleVersionNotFound, key)
+	}
+
+	bundle, err := s.fetch(ctx, id, entries[idx])
+	if err != nil {
+		return nil, fmt.Errorf("unable to load %s: %w", key, err)
+	}
+
+	s.cache.put(key, bundle)
+	return bundle, nil
+}
+
+// entries returns the versions of a bundle listed in the index
+func (i *Index) entries(id mindpak.BundleID) []*IndexEntry {
+	var entries []*IndexEntry
+	for j := range i.Bundles {
+		if mindpak.ID(i.Bundles[j].Namespace, i.Bundles[j].Name) == id {
+			entries = append(entries, &i.Bundles[j])
+		}
+	}
+	return entries
+}
+
+// fetch downloads a version of a bundle, and checks it before loading it
+func (s *httpsSource) fetch(ctx context.Context, id mindpak.BundleID, entry *IndexEntry) (reader.BundleReader, error) {
+	content, err := s.download(ctx, entry.URL, maxBundleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+	if digest != entry.Digest {
+		return nil, fmt.Errorf("%w: index lists %s, got %s", ErrDigestMismatch, entry.Digest, digest)
+	}
+	if err := s.opts.checkPin(id, entry.Version, digest); err != nil {
+		return nil, err
+	}
+
+	if s.opts.verification != nil {
+		if entry.Signature == "" {
+			return nil, fmt.Errorf("%w: index lists no signature", ErrUnverifiedBundle)
+		}
+		verifier, err := s.opts.getVerifier()
+		if err != nil {
+			return nil, err
+		}
+		sigBundle, err := s.download(ctx, entry.Signature, maxBundleSize)
+		if err != nil {
+			return nil, err
+		}
+		results, err := verifier.VerifyBlob(ctx, sigBundle, content)
+		if err != nil {
+			return nil, fmt.Errorf("error verifying signature: %w", err)
+		}
+		if err := s.opts.checkSignature(results); err != nil {
+			return nil, err
+		}
+	}
+
+	bundle, err := mindpak.NewBundleFromTarGZReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load bundle: %w", err)
+	}
+	if err := bundle.Verify(); err != nil {
+		return nil, fmt.Errorf("bundle failed verification: %w", err)
+	}
+	bundleReader := reader.NewBundleReader(bundle)
+	if err := checkBundle(bundleReader, &id, entry.Version); err != nil {
+		return nil, err
+	}
+	return bundleReader, nil
+}
+
+func (s *httpsSource) fetchIndex(ctx context.Context) (*Index, error) {
+	content, err := s.download(ctx, s.indexURL.String(), maxIndexSize)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &Index{}
+	if err := json.Unmarshal(content, index); err != nil {
+		return nil, fmt.Errorf("error parsing index %s: %w", s.indexURL, err)
+	}
+	return index, nil
+}
+
+// download fetches a location relative to the index, which must also be
+// served over HTTPS
+func (s *httpsSource) download(ctx context.Context, location string, limit int64) ([]byte, error) {
+	ref, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location %s: %w", location, err)
+	}
+	u := s.indexURL.ResolveReference(ref)
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("location %s must use https", u)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := s.opts.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading %s: unexpected status %s", u, resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s: %w", u, err)
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("%s is larger than %d bytes", u, limit)
+	}
+	return content, nil
+}
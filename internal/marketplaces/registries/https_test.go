@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+// newTestIndexServer serves an index listing the given versions of the
+// acme/baseline bundle, along with their tarballs and signatures. The digest
+// of the tampered version doesn't match the one in the index.
+func newTestIndexServer(t *testing.T, versions []string, tampered string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	index := Index{}
+	for _, version := range versions {
+		content := newTestBundle(t, "acme", "baseline", version)
+		entry := IndexEntry{
+			Namespace: "acme",
+			Name:      "baseline",
+			Version:   version,
+			URL:       fmt.Sprintf("baseline-%s.tar.gz", version),
+			Digest:    fmt.Sprintf("sha256:%x", sha256.Sum256(content)),
+			Signature: fmt.Sprintf("baseline-%s.sigstore.json", version),
+		}
+		index.Bundles = append(index.Bundles, entry)
+
+		if version == tampered {
+			content = newTestBundle(t, "acme", "baseline", version+"-evil")
+		}
+		mux.HandleFunc("GET /bundles/"+entry.URL, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(content)
+		})
+		mux.HandleFunc("GET /bundles/"+entry.Signature, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("{}"))
+		})
+	}
+	mux.HandleFunc("GET /bundles/index.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(index))
+	})
+
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTTPSSource(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestIndexServer(t, []string{"0.9.0", "1.1.0", "1.0.0"}, "")
+	source, err := NewHTTPSSource(srv.URL+"/bundles/index.json",
+		WithHTTPClient(srv.Client()), WithoutVerification())
+	require.NoError(t, err)
+
+	id := mindpak.ID("acme", "baseline")
+	bundles, err := source.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []mindpak.BundleID{id}, bundles)
+
+	versions, err := source.ListVersions(id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.1.0", "1.0.0", "0.9.0"}, versions)
+
+	latest, err := source.GetBundle(id)
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", latest.GetMetadata().Version)
+
+	bundle, err := source.GetBundleVersion(id, "0.9.0")
+	require.NoError(t, err)
+	require.Equal(t, "0.9.0", bundle.GetMetadata().Version)
+
+	_, err = source.GetBundleVersion(id, "2.0.0")
+	require.ErrorIs(t, err, sources.ErrBundleVersionNotFound)
+
+	_, err = source.GetBundle(mindpak.ID("acme", "other"))
+	require.ErrorIs(t, err, sources.ErrBundleNotFound)
+}
+
+func TestHTTPSSource_Refresh(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	index := Index{Bundles: []IndexEntry{{Namespace: "acme", Name: "baseline", Version: "1.0.0"}}}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		require.NoError(t, json.NewEncoder(w).Encode(index))
+	}))
+	t.Cleanup(srv.Close)
+
+	source, err := NewHTTPSSource(srv.URL+"/index.json", WithHTTPClient(srv.Client()), WithoutVerification())
+	require.NoError(t, err)
+
+	bundles, err := source.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []mindpak.BundleID{mindpak.ID("acme", "baseline")}, bundles)
+
+	// bundles published later are listed without restarting
+	mu.Lock()
+	index.Bundles = append(index.Bundles, IndexEntry{Namespace: "acme", Name: "extra", Version: "0.1.0"})
+	mu.Unlock()
+
+	bundles, err = source.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []mindpak.BundleID{mindpak.ID("acme", "baseline"), mindpak.ID("acme", "extra")}, bundles)
+
+	versions, err := source.ListVersions(mindpak.ID("acme", "extra"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"0.1.0"}, versions)
+}
+
+func TestHTTPSSource_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	srv.Close()
+
+	// the index is not fetched when the source is created
+	source, err := NewHTTPSSource(srv.URL+"/index.json", WithHTTPClient(srv.Client()), WithoutVerification())
+	require.NoError(t, err)
+
+	_, err = source.ListBundles()
+	require.ErrorContains(t, err, "error downloading")
+}
+
+func TestHTTPSSource_Checks(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestIndexServer(t, []string{"1.0.0", "1.1.0"}, "1.1.0")
+	id := mindpak.ID("acme", "baseline")
+
+	scenarios := []struct {
+		Name          string
+		Version       string
+		Options       []Option
+		ExpectedError error
+	}{
+		{
+			Name:    "digest matches the index",
+			Version: "1.0.0",
+			Options: []Option{WithoutVerification()},
+		},
+		{
+			Name:          "digest does not match the index",
+			Version:       "1.1.0",
+			Options:       []Option{WithoutVerification()},
+			ExpectedError: ErrDigestMismatch,
+		},
+		{
+			Name:          "pinned digest does not match",
+			Version:       "1.0.0",
+			Options:       []Option{WithoutVerification(), WithPins(map[string]string{"acme/baseline@1.0.0": "sha256:0000"})},
+			ExpectedError: ErrDigestMismatch,
+		},
+		{
+			Name:    "signed by the expected identity",
+			Version: "1.0.0",
+			Options: []Option{
+				WithVerification(testVerification),
+				withVerifier(&fakeVerifier{identity: testIdentity}),
+			},
+		},
+		{
+			Name:    "signed by another identity",
+			Version: "1.0.0",
+			Options: []Option{
+				WithVerification(testVerification),
+				withVerifier(&fakeVerifier{identity: "https://github.com/evil/bundles"}),
+			},
+			ExpectedError: ErrUnverifiedBundle,
+		},
+		{
+			Name:    "verified without an identity",
+			Version: "1.0.0",
+			Options: []Option{
+				WithVerification(&server.BundleVerificationConfig{Issuer: testIssuer}),
+				withVerifier(&fakeVerifier{identity: "https://github.com/evil/bundles"}),
+			},
+			ExpectedError: ErrUnverifiedBundle,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+			opts := append([]Option{WithHTTPClient(srv.Client())}, scenario.Options...)
+			source, err := NewHTTPSSource(srv.URL+"/bundles/index.json", opts...)
+			require.NoError(t, err)
+
+			bundle, err := source.GetBundleVersion(id, scenario.Version)
+			if scenario.ExpectedError != nil {
+				require.ErrorIs(t, err, scenario.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, scenario.Version, bundle.GetMetadata().Version)
+		})
+	}
+}
+
+func TestNewHTTPSSource_RequiresHTTPS(t *testing.T) {
+	t.Parallel()
+	_, err := NewHTTPSSource("http://bundles.example.com/index.json", WithoutVerification())
+	require.ErrorContains(t, err, "must use https")
+}
@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+// ociSource is a bundle source for the versions of a bundle pushed to an OCI
+// repository, e.g. with `mindev bundle push`. Each tag of the repository is a
+// version of the bundle, and signatures are looked up as pushed by
+// `cosign sign`.
+type ociSource struct {
+	repo  name.Repository
+	auth  authn.Authenticator
+	opts  *options
+	cache bundleCache
+
+	mu sync.Mutex
+	// id is the bundle the repository holds, once known
+	id *mindpak.BundleID
+}
+
+// NewOCISource creates a bundle source for the OCI repository holding the
+// versions of a bundle. The repository isn't contacted until the bundle is
+// needed, when the latest version is pulled to find out which bundle the
+// repository holds.
+func NewOCISource(repository string, opts ...Option) (sources.VersionedBundleSource, error) {
+	o := newOptions(opts)
+	if err := o.checkVerification(repository); err != nil {
+		return nil, err
+	}
+
+	repo, err := name.NewRepository(repository)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %s: %w", repository, err)
+	}
+
+	auth, err := o.keychain.Resolve(repo)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving credentials for %s: %w", repository, err)
+	}
+
+	if o.verification != nil && o.verifier == nil {
+		o.newVerifier = func() (signatureVerifier, error) {
+			verifier, err := sigstore.New(o.verification.SigstoreURL,
+				container.WithRegistry(repo.RegistryStr()),
+				container.WithAuthenticator(auth))
+			if err != nil {
+				return nil, err
+			}
+			return verifier, nil
+		}
+	}
+
+	return &ociSource{
+		repo: repo,
+		auth: auth,
+		opts: o,
+	}, nil
+}
+
+func (s *ociSource) GetBundle(id mindpak.BundleID) (reader.BundleReader, error) {
+	versions, err := s.ListVersions(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("%w: %s has no versions", sources.ErrBundleVersionNotFound, id)
+	}
+	return s.GetBundleVersion(id, versions[0])
+}
+
+func (s *ociSource) ListBundles() ([]mindpak.BundleID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	id, err := s.bundleID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []mindpak.BundleID{id}, nil
+}
+
+func (s *ociSource) ListVersions(id mindpak.BundleID) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if err := s.checkBundleID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.listVersions(ctx)
+}
+
+func (s *ociSource) GetBundleVersion(id mindpak.BundleID, version string) (reader.BundleReader, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if err := s.checkBundleID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.fetch(ctx, &id, version)
+}
+
+// bundleID returns the bundle the repository holds, pulling its latest
+// version the first time
+func (s *ociSource) bundleID(ctx context.Context) (mindpak.BundleID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.id != nil {
+		return *s.id, nil
+	}
+
+	versions, err := s.listVersions(ctx)
+	if err != nil {
+		return mindpak.BundleID{}, err
+	}
+	if len(versions) == 0 {
+		return mindpak.BundleID{}, fmt.Errorf("no versions of the bundle found in %s", s.repo)
+	}
+	latest, err := s.fetch(ctx, nil, versions[0])
+	if err != nil {
+		return mindpak.BundleID{}, fmt.Errorf("unable to load version %s from %s: %w", versions[0], s.repo, err)
+	}
+
+	id := mindpak.ID(latest.GetMetadata().Namespace, latest.GetMetadata().Name)
+	s.id = &id
+	return id, nil
+}
+
+func (s *ociSource) checkBundleID(ctx context.Context, id mindpak.BundleID) error {
+	repoID, err := s.bundleID(ctx)
+	if err != nil {
+		return err
+	}
+	if id != repoID {
+		return fmt.Errorf("%w: %s", sources.ErrBundleNotFound, id)
+	}
+	return nil
+}
+
+func (s *ociSource) listVersions(ctx context.Context) ([]string, error) {
+	tags, err := remote.List(s.repo, s.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags of %s: %w", s.repo, err)
+	}
+
+	versions := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		// skip the signatures and attestations pushed by cosign
+		if strings.HasPrefix(tag, "sha256-") {
+			continue
+		}
+		versions = append(versions, tag)
+	}
+	sources.SortVersions(versions)
+	return versions, nil
+}
+
+// fetch pulls a version of the bundle, and checks it before loading it. The
+// expected bundle ID is only unknown when first pulling from the repository.
+func (s *ociSource) fetch(ctx context.Context, id *mindpak.BundleID, version string) (reader.BundleReader, error) {
+	if id != nil {
+		if bundle, ok := s.cache.get(version); ok {
+			return bundle, nil
+		}
+	}
+
+	desc, err := remote.Get(s.repo.Tag(version), s.remoteOptions(ctx)...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", sources.ErrBundleVersionNotFound, version)
+		}
+		return nil, fmt.Errorf("error getting manifest of %s: %w", version, err)
+	}
+	digest := desc.Digest.String()
+
+	if s.opts.verification != nil {
+		if err := s.verify(ctx, digest); err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := s.pullBundleLayer(desc)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	bundle, err := mindpak.NewBundleFromTarGZReader(io.LimitReader(content, maxBundleSize))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load bundle: %w", err)
+	}
+	if err := bundle.Verify(); err != nil {
+		return nil, fmt.Errorf("bundle failed verification: %w", err)
+	}
+	bundleReader := reader.NewBundleReader(bundle)
+	if err := checkBundle(bundleReader, id, version); err != nil {
+		return nil, err
+	}
+
+	metadata := bundleReader.GetMetadata()
+	if err := s.opts.checkPin(mindpak.ID(metadata.Namespace, metadata.Name), version, digest); err != nil {
+		return nil, err
+	}
+
+	s.cache.put(version, bundleReader)
+	return bundleReader, nil
+}
+
+// pullBundleLayer returns the content of the layer holding the bundle tarball
+func (*ociSource) pullBundleLayer(desc *remote.Descriptor) (io.ReadCloser, error) {
+	img, err := desc.Image()
+	if err != nil {
+		return nil, fmt.Errorf("error reading image: %w", err)
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("error reading image layers: %w", err)
+	}
+	for _, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			return nil, fmt.Errorf("error reading layer media type: %w", err)
+		}
+		if string(mediaType) != mindpak.OCILayerMediaType {
+			continue
+		}
+		// the content is checked against the layer digest as it is read
+		return layer.Compressed()
+	}
+	return nil, fmt.Errorf("image has no layer of type %s", mindpak.OCILayerMediaType)
+}
+
+func (s *ociSource) verify(ctx context.Context, digest string) error {
+	// the verifier expects the repository to be split as owner/artifact
+	owner, artifact, ok := strings.Cut(s.repo.RepositoryStr(), "/")
+	if !ok {
+		return fmt.Errorf("%w: repository %s has no owner", ErrUnverifiedBundle, s.repo)
+	}
+	verifier, err := s.opts.getVerifier()
+	if err != nil {
+		return err
+	}
+	results, err := verifier.VerifyContainer(ctx, owner, artifact, digest)
+	if err != nil {
+		return fmt.Errorf("error verifying signature: %w", err)
+	}
+	return s.opts.checkSignature(results)
+}
+
+// PushOCI pushes a bundle tarball, as built by `mindev bundle build`, to an
+// OCI repository, tagged with the version of the bundle. It returns the
+// reference of the pushed manifest by digest, which is what gets signed.
+func PushOCI(ctx context.Context, repository string, content []byte, opts ...Option) (string, error) {
+	o := newOptions(opts)
+
+	bundle, err := mindpak.NewBundleFromTarGZReader(bytes.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("unable to load bundle: %w", err)
+	}
+	if bundle.Manifest.Metadata == nil || bundle.Manifest.Metadata.Version == "" {
+		return "", errors.New("bundle has no version")
+	}
+
+	repo, err := name.NewRepository(repository)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository %s: %w", repository, err)
+	}
+	tag := repo.Tag(bundle.Manifest.Metadata.Version)
+
+	layer := static.NewLayer(content, types.MediaType(mindpak.OCILayerMediaType))
+	img, err := mutate.Append(
+		mutate.ConfigMediaType(mutate.MediaType(empty.Image, types.OCIManifestSchema1), mindpak.OCIArtifactType),
+		mutate.Addendum{Layer: layer},
+	)
+	if err != nil {
+		return "", fmt.Errorf("error building image: %w", err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return "", fmt.Errorf("error computing image digest: %w", err)
+	}
+
+	if err := remote.Write(tag, img, remote.WithAuthFromKeychain(o.keychain), remote.WithContext(ctx)); err != nil {
+		return "", fmt.Errorf("error pushing %s: %w", tag, err)
+	}
+	return repo.Digest(digest.String()).String(), nil
+}
+
+func (s *ociSource) remoteOptions(ctx context.Context) []remote.Option {
+	return []remote.Option{
+		remote.WithAuth(s.auth),
+		remote.WithContext(ctx),
+	}
+}
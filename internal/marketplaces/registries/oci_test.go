@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+// newTestOCIRepository pushes the given versions of the acme/baseline bundle
+// to an in-memory registry, and returns the repository and the digests of
+// the versions
+func newTestOCIRepository(t *testing.T, versions ...string) (string, map[string]string) {
+	t.Helper()
+
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+
+	repository := strings.TrimPrefix(srv.URL, "http://") + "/acme/mindpaks/baseline"
+	digests := make(map[string]string, len(versions))
+	for _, version := range versions {
+		ref, err := PushOCI(context.Background(), repository,
+			newTestBundle(t, "acme", "baseline", version), WithKeychain(authn.NewMultiKeychain()))
+		require.NoError(t, err)
+		_, digest, _ := strings.Cut(ref, "@")
+		digests[version] = digest
+	}
+	return repository, digests
+}
+
+func TestOCISource(t *testing.T) {
+	t.Parallel()
+
+	repository, _ := newTestOCIRepository(t, "1.0.0", "1.2.0", "1.10.0")
+	source, err := NewOCISource(repository, WithKeychain(authn.NewMultiKeychain()), WithoutVerification())
+	require.NoError(t, err)
+
+	id := mindpak.ID("acme", "baseline")
+	bundles, err := source.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []mindpak.BundleID{id}, bundles)
+
+	versions, err := source.ListVersions(id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.10.0", "1.2.0", "1.0.0"}, versions)
+
+	latest, err := source.GetBundle(id)
+	require.NoError(t, err)
+	require.Equal(t, "1.10.0", latest.GetMetadata().Version)
+
+	bundle, err := source.GetBundleVersion(id, "1.2.0")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.0", bundle.GetMetadata().Version)
+
+	_, err = source.GetBundleVersion(id, "2.0.0")
+	require.ErrorIs(t, err, sources.ErrBundleVersionNotFound)
+
+	_, err = source.ListVersions(mindpak.ID("acme", "other"))
+	require.ErrorIs(t, err, sources.ErrBundleNotFound)
+}
+
+func TestOCISource_Checks(t *testing.T) {
+	t.Parallel()
+
+	repository, digests := newTestOCIRepository(t, "1.0.0")
+	id := mindpak.ID("acme", "baseline")
+
+	scenarios := []struct {
+		Name          string
+		Options       []Option
+		ExpectedError error
+	}{
+		{
+			Name:    "pinned digest matches",
+			Options: []Option{WithoutVerification(), WithPins(map[string]string{"acme/baseline@1.0.0": digests["1.0.0"]})},
+		},
+		{
+			Name:          "pinned digest does not match",
+			Options:       []Option{WithoutVerification(), WithPins(map[string]string{"acme/baseline@1.0.0": "sha256:0000"})},
+			ExpectedError: ErrDigestMismatch,
+		},
+		{
+			Name: "signed by the expected identity",
+			Options: []Option{
+				WithVerification(testVerification),
+				withVerifier(&fakeVerifier{identity: testIdentity}),
+			},
+		},
+		{
+			Name: "signed by another identity",
+			Options: []Option{
+				WithVerification(testVerification),
+				withVerifier(&fakeVerifier{identity: "https://github.com/evil/bundles"}),
+			},
+			ExpectedError: ErrUnverifiedBundle,
+		},
+		{
+			Name: "verified without an identity",
+			Options: []Option{
+				WithVerification(&server.BundleVerificationConfig{Issuer: testIssuer}),
+				withVerifier(&fakeVerifier{identity: "https://github.com/evil/bundles"}),
+			},
+			ExpectedError: ErrUnverifiedBundle,
+		},
+		{
+			Name: "not signed",
+			Options: []Option{
+				WithVerification(testVerification),
+				withVerifier(&fakeVerifier{}),
+			},
+			ExpectedError: ErrUnverifiedBundle,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+			opts := append([]Option{WithKeychain(authn.NewMultiKeychain())}, scenario.Options...)
+			source, err := NewOCISource(repository, opts...)
+			require.NoError(t, err)
+
+			// the repository is only contacted once the bundle is needed
+			bundle, err := source.GetBundleVersion(id, "1.0.0")
+			if scenario.ExpectedError != nil {
+				require.ErrorIs(t, err, scenario.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "1.0.0", bundle.GetMetadata().Version)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package registries contains bundle sources which pull the versions of
+// bundles from remote registries, such as an OCI registry or an index served
+// over HTTPS. The bundles may be pinned to a digest, and their signatures
+// are checked using sigstore.
+package registries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	"github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+)
+
+const (
+	// maxBundleSize is the largest bundle tarball we are willing to download
+	maxBundleSize int64 = 10 * 1024 * 1024
+	// requestTimeout bounds each call to a source, which may have to list
+	// versions, download a bundle and verify its signature
+	requestTimeout = 30 * time.Second
+)
+
+var (
+	// ErrDigestMismatch is returned when a bundle doesn't have the digest it
+	// is pinned to
+	ErrDigestMismatch = errors.New("bundle digest does not match")
+	// ErrUnverifiedBundle is returned when a bundle isn't signed by the
+	// expected signer
+	ErrUnverifiedBundle = errors.New("bundle signature could not be verified")
+	// ErrVerificationRequired is returned when a source is created with
+	// neither WithVerification nor WithoutVerification
+	ErrVerificationRequired = errors.New("bundle verification is required")
+)
+
+// signatureVerifier checks the sigstore signatures of bundles. It is
+// implemented by the sigstore verifier.
+type signatureVerifier interface {
+	VerifyContainer(ctx context.Context, owner, artifact, checksumref string) ([]verifyif.Result, error)
+	VerifyBlob(ctx context.Context, sigBundle []byte, blob []byte) ([]verifyif.Result, error)
+}
+
+// Option configures a registry source
+type Option func(*options)
+
+type options struct {
+	pins         map[string]string
+	verification *server.BundleVerificationConfig
+	unverified   bool
+	httpClient   *http.Client
+	keychain     authn.Keychain
+
+	verifierMu sync.Mutex
+	verifier   signatureVerifier
+	// newVerifier creates the verifier when the first signature is checked,
+	// since it fetches the trusted root of the sigstore instance
+	newVerifier func() (signatureVerifier, error)
+}
+
+// WithPins pins bundle versions, in the form namespace/name@version, to the
+// digest their content must have
+func WithPins(pins map[string]string) Option {
+	return func(o *options) {
+		o.pins = pins
+	}
+}
+
+// WithVerification requires the bundles to be signed by the given signer
+func WithVerification(cfg *server.BundleVerificationConfig) Option {
+	return func(o *options) {
+		o.verification = cfg
+	}
+}
+
+// WithoutVerification accepts bundles without checking their signatures.
+// Sources require either this option or WithVerification.
+func WithoutVerification() Option {
+	return func(o *options) {
+		o.unverified = true
+	}
+}
+
+// WithHTTPClient sets the client used to download from HTTPS sources
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithKeychain sets the credentials used to pull from OCI registries. By
+// default, the credentials of the docker configuration are used.
+func WithKeychain(keychain authn.Keychain) Option {
+	return func(o *options) {
+		o.keychain = keychain
+	}
+}
+
+// withVerifier sets the verifier of the signatures, instead of creating a
+// sigstore verifier from the verification config
+func withVerifier(verifier signatureVerifier) Option {
+	return func(o *options) {
+		o.verifier = verifier
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		httpClient: http.DefaultClient,
+		keychain:   authn.DefaultKeychain,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// checkVerification makes sure that the bundles of the source are either
+// verified, or explicitly allowed not to be
+func (o *options) checkVerification(location string) error {
+	if o.verification == nil && !o.unverified {
+		return fmt.Errorf("%w: %s", ErrVerificationRequired, location)
+	}
+	if o.verification != nil && o.unverified {
+		return fmt.Errorf("source %s can't be both verified and unverified", location)
+	}
+	return nil
+}
+
+// getVerifier returns the verifier of the signatures, creating it if needed
+func (o *options) getVerifier() (signatureVerifier, error) {
+	o.verifierMu.Lock()
+	defer o.verifierMu.Unlock()
+
+	if o.verifier == nil {
+		if o.newVerifier == nil {
+			return nil, errors.New("no signature verifier configured")
+		}
+		verifier, err := o.newVerifier()
+		if err != nil {
+			return nil, fmt.Errorf("error creating sigstore verifier: %w", err)
+		}
+		o.verifier = verifier
+	}
+	return o.verifier, nil
+}
+
+// checkPin checks the digest of a bundle version against its pin, if any
+func (o *options) checkPin(id mindpak.BundleID, version, digest string) error {
+	key := fmt.Sprintf("%s@%s", id, version)
+	if pin, ok := o.pins[key]; ok && pin != digest {
+		return fmt.Errorf("%w: %s is pinned to %s, got %s", ErrDigestMismatch, key, pin, digest)
+	}
+	return nil
+}
+
+// checkSignature checks that at least one of the verified signatures was
+// made by the expected signer. Both the issuer and the identity must match,
+// so a signature is never accepted without them.
+func (o *options) checkSignature(results []verifyif.Result) error {
+	if o.verification.Issuer == "" || o.verification.Identity == "" {
+		return fmt.Errorf("%w: no issuer or identity to verify the signature against", ErrUnverifiedBundle)
+	}
+	for _, res := range results {
+		if !res.IsVerified || res.Signature == nil || res.Signature.Certificate == nil {
+			continue
+		}
+		cert := res.Signature.Certificate
+		if cert.Issuer != o.verification.Issuer || cert.SubjectAlternativeName != o.verification.Identity {
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: no signature by %s from %s",
+		ErrUnverifiedBundle, o.verification.Identity, o.verification.Issuer)
+}
+
+// checkBundle checks that the bundle downloaded for a version is the one
+// expected, so that a bundle can't be passed off as another one.
+func checkBundle(bundle reader.BundleReader, id *mindpak.BundleID, version string) error {
+	metadata := bundle.GetMetadata()
+	if id != nil && (metadata.Namespace != id.Namespace || metadata.Name != id.Name) {
+		return fmt.Errorf("expected bundle %s, got %s/%s", id, metadata.Namespace, metadata.Name)
+	}
+	if metadata.Version != version {
+		return fmt.Errorf("expected version %s of bundle, got %s", version, metadata.Version)
+	}
+	return nil
+}
+
+// bundleCache holds the versions of bundles already downloaded. Versions are
+// expected not to change once published, and are pinned to their digest to
+// enforce it.
+type bundleCache struct {
+	mu      sync.Mutex
+	bundles map[string]reader.BundleReader
+}
+
+func (c *bundleCache) get(key string) (reader.BundleReader, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bundle, ok := c.bundles[key]
+	return bundle, ok
+}
+
+func (c *bundleCache) put(key string, bundle reader.BundleReader) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.bundles == nil {
+		c.bundles = make(map[string]reader.BundleReader)
+	}
+	c.bundles[key] = bundle
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	"github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+const (
+	testIssuer   = "https://token.actions.githubusercontent.com"
+	testIdentity = "https://github.com/acme/bundles/.github/workflows/release.yml@refs/heads/main"
+)
+
+var testVerification = &server.BundleVerificationConfig{
+	Issuer:   testIssuer,
+	Identity: testIdentity,
+}
+
+// newTestBundle returns the tarball of a bundle with a single rule type
+func newTestBundle(t *testing.T, namespace, name, version string) []byte {
+	t.Helper()
+
+	manifest, err := json.Marshal(&mindpak.Manifest{
+		Metadata: &mindpak.Metadata{Namespace: namespace, Name: name, Version: version},
+		Files:    &mindpak.Files{},
+	})
+	require.NoError(t, err)
+	files := map[string][]byte{
+		mindpak.ManifestFileName:              manifest,
+		mindpak.PathRuleTypes + "/check.yaml": []byte("name: check\n"),
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for path, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     path,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// fakeVerifier reports the signatures as made by the configured identity
+type fakeVerifier struct {
+	identity string
+}
+
+func (f *fakeVerifier) VerifyContainer(_ context.Context, _, _, _ string) ([]verifyif.Result, error) {
+	return f.results(), nil
+}
+
+func (f *fakeVerifier) VerifyBlob(_ context.Context, _ []byte, _ []byte) ([]verifyif.Result, error) {
+	return f.results(), nil
+}
+
+func (f *fakeVerifier) results() []verifyif.Result {
+	if f.identity == "" {
+		return []verifyif.Result{{IsSigned: false, IsVerified: false}}
+	}
+	return []verifyif.Result{{
+		IsSigned:   true,
+		IsVerified: true,
+		VerificationResult: verify.VerificationResult{
+			Signature: &verify.SignatureVerificationResult{
+				Certificate: &certificate.Summary{
+					SubjectAlternativeName: f.identity,
+					Extensions:             certificate.Extensions{Issuer: testIssuer},
+				},
+			},
+		},
+	}}
+}
+
+func TestNewSources_RequireVerification(t *testing.T) {
+	t.Parallel()
+
+	constructors := map[string]func(opts ...Option) error{
+		"oci": func(opts ...Option) error {
+			_, err := NewOCISource("ghcr.io/acme/bundles", opts...)
+			return err
+		},
+		"https": func(opts ...Option) error {
+			_, err := NewHTTPSSource("https://bundles.acme.example/index.json", opts...)
+			return err
+		},
+	}
+
+	for name, newSource := range constructors {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, newSource(), ErrVerificationRequired)
+			require.ErrorContains(t,
+				newSource(WithVerification(testVerification), WithoutVerification()),
+				"can't be both verified and unverified")
+			require.NoError(t, newSource(WithVerification(testVerification)))
+			require.NoError(t, newSource(WithoutVerification()))
+		})
+	}
+}
@@ -7,9 +7,16 @@ package marketplaces
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/mindersec/minder/internal/db"
 	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
@@ -38,15 +45,138 @@ type Marketplace interface {
 		profileName string,
 		qtx db.Querier,
 	) error
+	// ListBundles lists the bundles available in the marketplace, along
+	// with their versions.
+	ListBundles() ([]BundleVersions, error)
+	// SubscribeVersion subscribes the project to the specified version of
+	// the bundle, upgrading or downgrading an existing subscription. If the
+	// version is empty, the latest version of the bundle is used.
+	SubscribeVersion(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		version string,
+		qtx db.ExtendQuerier,
+	) error
+	// PreviewVersion lists the changes subscribing the project to the
+	// specified version of the bundle would make, without making them. If
+	// the version is empty, the latest version of the bundle is used.
+	PreviewVersion(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		version string,
+		qtx db.ExtendQuerier,
+	) (*sub.Preview, error)
+	// Rollback subscribes the project to the version of the bundle it was
+	// subscribed to before its last upgrade, or downgrade. Rolling back
+	// twice restores the version rolled back from. Rollbacks with breaking
+	// changes, such as dropping a property an upgrade added to a rule
+	// schema, are refused with ErrBreakingChange.
+	Rollback(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		qtx db.ExtendQuerier,
+	) error
+}
+
+// BundleVersions lists the versions of a bundle, latest first
+type BundleVersions struct {
+	ID       mindpak.BundleID
+	Versions []string
 }
 
+var (
+	// ErrUnknownBundle is returned when the bundle is not in the marketplace
+	ErrUnknownBundle = errors.New("unknown bundle")
+	// ErrUnknownBundleVersion is returned when the version of the bundle is
+	// not in the marketplace
+	ErrUnknownBundleVersion = errors.New("unknown bundle version")
+	// ErrNoPreviousVersion is returned when a subscription has no version
+	// to roll back to
+	ErrNoPreviousVersion = sub.ErrNoPreviousVersion
+	// ErrBreakingChange is returned when rolling back would make breaking
+	// changes to the rule types of the project
+	ErrBreakingChange = sub.ErrBreakingChange
+)
+
+// unknownBundleRelistInterval is the minimum time between two listings of
+// the sources triggered by lookups of unknown bundles, so that those can't
+// make every lookup wait for the registries.
+const unknownBundleRelistInterval = time.Minute
+
 // trivial implementation of Marketplace with a single source
 type marketplace struct {
 	// ASSUMPTION: all sources are known at application startup
 	// This will need more complex logic if external sources can be added
 	// dynamically by customers.
-	sources       map[mindpak.BundleID]sources.BundleSource
+	sources       []sources.BundleSource
 	subscriptions sub.SubscriptionService
+
+	// listings makes concurrent refreshes share a single listing of the
+	// sources.
+	listings singleflight.Group
+	// now returns the current time, and is replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// bundleSources maps the bundles to the source they were found in. It
+	// is built lazily, since remote sources may not be reachable at startup,
+	// and refreshed when a bundle is not found, since remote sources may
+	// publish new bundles.
+	bundleSources map[mindpak.BundleID]sources.BundleSource
+	// listedAt is the time bundleSources was built at.
+	listedAt time.Time
+}
+
+// refreshBundleSources lists the bundles of every source. Sources which
+// cannot be listed are skipped, so that they don't make the bundles of the
+// other sources unavailable. The sources are listed without holding the
+// lock, so that lookups of known bundles don't wait for them.
+func (s *marketplace) refreshBundleSources() map[mindpak.BundleID]sources.BundleSource {
+	listed, _, _ := s.listings.Do("", func() (any, error) {
+		bundleSources := make(map[mindpak.BundleID]sources.BundleSource)
+		for _, source := range s.sources {
+			bundles, err := source.ListBundles()
+			if err != nil {
+				log.Warn().Err(err).Msg("error while listing bundles, skipping source")
+				continue
+			}
+			for _, id := range bundles {
+				bundleSources[id] = source
+			}
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.bundleSources = bundleSources
+		s.listedAt = s.now()
+		return bundleSources, nil
+	})
+	return listed.(map[mindpak.BundleID]sources.BundleSource)
+}
+
+// getSource returns the source of a bundle. Unknown bundles are looked up
+// again, unless the sources were listed less than
+// unknownBundleRelistInterval ago.
+func (s *marketplace) getSource(bundleID mindpak.BundleID) (sources.BundleSource, error) {
+	s.mu.Lock()
+	source, ok := s.bundleSources[bundleID]
+	recent := s.bundleSources != nil && s.now().Sub(s.listedAt) < unknownBundleRelistInterval
+	s.mu.Unlock()
+	if ok {
+		return source, nil
+	}
+	if recent {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBundle, bundleID)
+	}
+
+	source, ok = s.refreshBundleSources()[bundleID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBundle, bundleID)
+	}
+	return source, nil
 }
 
 func (s *marketplace) Subscribe(
@@ -84,10 +214,139 @@ func (s *marketplace) AddProfile(
 	return nil
 }
 
+func (s *marketplace) ListBundles() ([]BundleVersions, error) {
+	bundleSources := s.refreshBundleSources()
+	result := make([]BundleVersions, 0, len(bundleSources))
+	for id, source := range bundleSources {
+		var versions []string
+		if versioned, ok := source.(sources.VersionedBundleSource); ok {
+			var err error
+			versions, err = versioned.ListVersions(id)
+			if err != nil {
+				return nil, fmt.Errorf("error while listing versions of %s: %w", id, err)
+			}
+		} else {
+			bundle, err := source.GetBundle(id)
+			if err != nil {
+				return nil, fmt.Errorf("error while retrieving bundle: %w", err)
+			}
+			versions = []string{bundle.GetMetadata().Version}
+		}
+		result = append(result, BundleVersions{ID: id, Versions: versions})
+	}
+	slices.SortFunc(result, func(a, b BundleVersions) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+	return result, nil
+}
+
+func (s *marketplace) SubscribeVersion(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	version string,
+	qtx db.ExtendQuerier,
+) error {
+	bundle, err := s.getBundleVersion(bundleID, version)
+	if err != nil {
+		return err
+	}
+	if err = s.subscriptions.Update(ctx, projectID, bundle, qtx); err != nil {
+		return fmt.Errorf("error while updating subscription: %w", err)
+	}
+	return nil
+}
+
+func (s *marketplace) PreviewVersion(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	version string,
+	qtx db.ExtendQuerier,
+) (*sub.Preview, error) {
+	bundle, err := s.getBundleVersion(bundleID, version)
+	if err != nil {
+		return nil, err
+	}
+	preview, err := s.subscriptions.Preview(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return nil, fmt.Errorf("error while previewing subscription: %w", err)
+	}
+	return preview, nil
+}
+
+func (s *marketplace) Rollback(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	qtx db.ExtendQuerier,
+) error {
+	if _, err := s.getSource(bundleID); err != nil {
+		return err
+	}
+	version, err := s.subscriptions.PreviousVersion(ctx, projectID, bundleID, qtx)
+	if err != nil {
+		return err
+	}
+	bundle, err := s.getBundleVersion(bundleID, version)
+	if err != nil {
+		return err
+	}
+
+	// Upgrades commonly extend the schemas of rule types, which rule type
+	// updates can't take back. Check up front, so that the reasons can be
+	// reported rather than the first rule type update failing.
+	preview, err := s.subscriptions.Preview(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return fmt.Errorf("error while previewing subscription: %w", err)
+	}
+	if err = preview.BreakingError(); err != nil {
+		return err
+	}
+
+	if err = s.subscriptions.Update(ctx, projectID, bundle, qtx); err != nil {
+		return fmt.Errorf("error while updating subscription: %w", err)
+	}
+	return nil
+}
+
+// getBundleVersion returns the version of a bundle, or its latest version if
+// the version is empty. Sources which only hold a single version of a bundle
+// are supported as well.
+func (s *marketplace) getBundleVersion(bundleID mindpak.BundleID, version string) (reader.BundleReader, error) {
+	if version == "" {
+		return s.getBundle(bundleID)
+	}
+
+	source, err := s.getSource(bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if versioned, ok := source.(sources.VersionedBundleSource); ok {
+		bundle, err := versioned.GetBundleVersion(bundleID, version)
+		if errors.Is(err, sources.ErrBundleVersionNotFound) {
+			return nil, fmt.Errorf("%w: %s@%s", ErrUnknownBundleVersion, bundleID, version)
+		} else if err != nil {
+			return nil, fmt.Errorf("error while retrieving bundle: %w", err)
+		}
+		return bundle, nil
+	}
+
+	bundle, err := source.GetBundle(bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("error while retrieving bundle: %w", err)
+	}
+	if bundle.GetMetadata().Version != version {
+		return nil, fmt.Errorf("%w: %s@%s", ErrUnknownBundleVersion, bundleID, version)
+	}
+	return bundle, nil
+}
+
 func (s *marketplace) getBundle(bundleID mindpak.BundleID) (reader.BundleReader, error) {
-	source, ok := s.sources[bundleID]
-	if !ok {
-		return nil, fmt.Errorf("unknown bundle: %s", bundleID)
+	source, err := s.getSource(bundleID)
+	if err != nil {
+		return nil, err
 	}
 	bundle, err := source.GetBundle(bundleID)
 	if err != nil {
@@ -113,3 +372,39 @@ func (*noopMarketplace) AddProfile(
 ) error {
 	return nil
 }
+
+func (*noopMarketplace) ListBundles() ([]BundleVersions, error) {
+	return nil, nil
+}
+
+// SubscribeVersion fails, since no bundle is available to subscribe to
+func (*noopMarketplace) SubscribeVersion(
+	_ context.Context,
+	_ uuid.UUID,
+	bundleID mindpak.BundleID,
+	_ string,
+	_ db.ExtendQuerier,
+) error {
+	return fmt.Errorf("%w: %s", ErrUnknownBundle, bundleID)
+}
+
+// PreviewVersion fails, since no bundle is available to subscribe to
+func (*noopMarketplace) PreviewVersion(
+	_ context.Context,
+	_ uuid.UUID,
+	bundleID mindpak.BundleID,
+	_ string,
+	_ db.ExtendQuerier,
+) (*sub.Preview, error) {
+	return nil, fmt.Errorf("%w: %s", ErrUnknownBundle, bundleID)
+}
+
+// Rollback fails, since no bundle is available to subscribe to
+func (*noopMarketplace) Rollback(
+	_ context.Context,
+	_ uuid.UUID,
+	bundleID mindpak.BundleID,
+	_ db.ExtendQuerier,
+) error {
+	return fmt.Errorf("%w: %s", ErrUnknownBundle, bundleID)
+}
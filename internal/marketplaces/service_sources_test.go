@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package marketplaces
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockbundle "github.com/mindersec/minder/internal/marketplaces/bundles/mock"
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+var (
+	knownBundle   = mindpak.ID("stacklok", "healthcheck")
+	unknownBundle = mindpak.ID("stacklok", "unknown")
+)
+
+func TestMarketplace_UnknownBundleRelist(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	source := mockbundle.NewMockBundleSource(ctrl)
+	source.EXPECT().ListBundles().Return([]mindpak.BundleID{knownBundle}, nil).Times(2)
+
+	now := time.Now()
+	m := &marketplace{
+		sources: []sources.BundleSource{source},
+		now:     func() time.Time { return now },
+	}
+
+	// the first lookup lists the sources
+	_, err := m.getSource(unknownBundle)
+	require.ErrorIs(t, err, ErrUnknownBundle)
+
+	// lookups right after a listing don't list the sources again
+	_, err = m.getSource(unknownBundle)
+	require.ErrorIs(t, err, ErrUnknownBundle)
+	found, err := m.getSource(knownBundle)
+	require.NoError(t, err)
+	require.Equal(t, source, found)
+
+	// once the interval has passed, unknown bundles are looked up again
+	now = now.Add(unknownBundleRelistInterval)
+	_, err = m.getSource(unknownBundle)
+	require.ErrorIs(t, err, ErrUnknownBundle)
+}
+
+func TestMarketplace_ListingDoesNotBlockLookups(t *testing.T) {
+	t.Parallel()
+
+	listing := make(chan struct{})
+	release := make(chan struct{})
+
+	ctrl := gomock.NewController(t)
+	source := mockbundle.NewMockBundleSource(ctrl)
+	gomock.InOrder(
+		source.EXPECT().ListBundles().Return([]mindpak.BundleID{knownBundle}, nil),
+		source.EXPECT().ListBundles().DoAndReturn(func() ([]mindpak.BundleID, error) {
+			close(listing)
+			<-release
+			return []mindpak.BundleID{knownBundle}, nil
+		}),
+	)
+
+	m := &marketplace{
+		sources: []sources.BundleSource{source},
+		now:     time.Now,
+	}
+	m.refreshBundleSources()
+
+	refreshed := make(chan struct{})
+	go func() {
+		defer close(refreshed)
+		m.refreshBundleSources()
+	}()
+	<-listing
+
+	// known bundles are found while the sources are being listed
+	found := make(chan error)
+	go func() {
+		_, err := m.getSource(knownBundle)
+		found <- err
+	}()
+	select {
+	case err := <-found:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("lookup waited for the sources to be listed")
+	}
+
+	close(release)
+	<-refreshed
+}
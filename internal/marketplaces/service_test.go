@@ -5,6 +5,7 @@ package marketplaces_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -73,6 +74,136 @@ func TestMarketplace_AddProfile(t *testing.T) {
 	})
 }
 
+func TestMarketplace_SubscribeVersion(t *testing.T) {
+	t.Parallel()
+	testHarness(t, subscribeVersion, []testScenario{
+		{
+			Name:          "SubscribeVersion returns error when bundle does not exist in source",
+			SourceSetup:   bsf.NewBundleSourceMock(bsf.WithFailedGetBundle),
+			ExpectedError: "error while retrieving bundle",
+		},
+		{
+			Name:              "SubscribeVersion returns error when subscription cannot be updated",
+			SourceSetup:       bsf.NewBundleSourceMock(bsf.WithSuccessfulGetBundle(bundleReader)),
+			SubscriptionSetup: ssf.NewSubscriptionServiceMock(ssf.WithFailedUpdate),
+			ExpectedError:     "error while updating subscription",
+		},
+		{
+			Name:              "SubscribeVersion subscribes the project to the latest version of the bundle",
+			SourceSetup:       bsf.NewBundleSourceMock(bsf.WithSuccessfulGetBundle(bundleReader)),
+			SubscriptionSetup: ssf.NewSubscriptionServiceMock(ssf.WithSuccessfulUpdate),
+		},
+	})
+}
+
+func TestMarketplace_PreviewVersion(t *testing.T) {
+	t.Parallel()
+	testHarness(t, previewVersion, []testScenario{
+		{
+			Name:          "PreviewVersion returns error when bundle does not exist in source",
+			SourceSetup:   bsf.NewBundleSourceMock(bsf.WithFailedGetBundle),
+			ExpectedError: "error while retrieving bundle",
+		},
+		{
+			Name:              "PreviewVersion returns error when subscription cannot be previewed",
+			SourceSetup:       bsf.NewBundleSourceMock(bsf.WithSuccessfulGetBundle(bundleReader)),
+			SubscriptionSetup: ssf.NewSubscriptionServiceMock(ssf.WithFailedPreview),
+			ExpectedError:     "error while previewing subscription",
+		},
+		{
+			Name:              "PreviewVersion previews the latest version of the bundle",
+			SourceSetup:       bsf.NewBundleSourceMock(bsf.WithSuccessfulGetBundle(bundleReader)),
+			SubscriptionSetup: ssf.NewSubscriptionServiceMock(ssf.WithSuccessfulPreview),
+		},
+	})
+}
+
+func TestMarketplace_Rollback(t *testing.T) {
+	t.Parallel()
+	testHarness(t, rollback, []testScenario{
+		{
+			Name:              "Rollback returns error when there is no previous version",
+			SourceSetup:       bsf.NewBundleSourceMock(),
+			SubscriptionSetup: ssf.NewSubscriptionServiceMock(ssf.WithNoPreviousVersion),
+			ExpectedError:     "no previous version of the bundle",
+		},
+	})
+}
+
+func TestMarketplace_Versions(t *testing.T) {
+	t.Parallel()
+
+	source, err := sources.NewSourceFromTarGZ("../../pkg/mindpak/sources/testdata/bundle.tar.gz")
+	require.NoError(t, err)
+	marketplace, err := marketplaces.NewMarketplace([]sources.BundleSource{source}, nil)
+	require.NoError(t, err)
+
+	bundles, err := marketplace.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []marketplaces.BundleVersions{{
+		ID:       mindpak.ID("stacklok", "t2"),
+		Versions: []string{"v0.0.1"},
+	}}, bundles)
+
+	ctx := context.Background()
+	err = marketplace.SubscribeVersion(ctx, projectID, mindpak.ID("stacklok", "t2"), "v0.0.2", nil)
+	require.ErrorIs(t, err, marketplaces.ErrUnknownBundleVersion)
+
+	err = marketplace.SubscribeVersion(ctx, projectID, mindpak.ID("stacklok", "t3"), "", nil)
+	require.ErrorIs(t, err, marketplaces.ErrUnknownBundle)
+
+	// Rolling back subscribes to the previous version, which must still be
+	// available
+	ctrl := gomock.NewController(t)
+	subSvc := ssf.NewSubscriptionServiceMock(
+		ssf.WithPreviousVersion("v0.0.1"), ssf.WithSuccessfulPreview, ssf.WithSuccessfulUpdate)(ctrl)
+	marketplace, err = marketplaces.NewMarketplace([]sources.BundleSource{source}, subSvc)
+	require.NoError(t, err)
+	require.NoError(t, marketplace.Rollback(ctx, projectID, mindpak.ID("stacklok", "t2"), nil))
+
+	// Rollbacks with breaking changes are refused, without updating the
+	// subscription
+	subSvc = ssf.NewSubscriptionServiceMock(ssf.WithPreviousVersion("v0.0.1"), ssf.WithBreakingPreview)(ctrl)
+	marketplace, err = marketplaces.NewMarketplace([]sources.BundleSource{source}, subSvc)
+	require.NoError(t, err)
+	err = marketplace.Rollback(ctx, projectID, mindpak.ID("stacklok", "t2"), nil)
+	require.ErrorIs(t, err, marketplaces.ErrBreakingChange)
+	require.ErrorContains(t, err, "rule_type stacklok/secret_scanning: rule schema update is invalid")
+
+	subSvc = ssf.NewSubscriptionServiceMock(ssf.WithPreviousVersion("v0.0.0"))(ctrl)
+	marketplace, err = marketplaces.NewMarketplace([]sources.BundleSource{source}, subSvc)
+	require.NoError(t, err)
+	err = marketplace.Rollback(ctx, projectID, mindpak.ID("stacklok", "t2"), nil)
+	require.ErrorIs(t, err, marketplaces.ErrUnknownBundleVersion)
+}
+
+func TestMarketplace_UnreachableSource(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	unreachable := mockbundle.NewMockBundleSource(ctrl)
+	unreachable.EXPECT().ListBundles().Return(nil, errors.New("connection refused"))
+
+	source, err := sources.NewSourceFromTarGZ("../../pkg/mindpak/sources/testdata/bundle.tar.gz")
+	require.NoError(t, err)
+
+	// sources are not listed when the marketplace is created
+	marketplace, err := marketplaces.NewMarketplace([]sources.BundleSource{unreachable, source}, nil)
+	require.NoError(t, err)
+
+	// the bundles of the other sources are still available
+	bundles, err := marketplace.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []marketplaces.BundleVersions{{
+		ID:       mindpak.ID("stacklok", "t2"),
+		Versions: []string{"v0.0.1"},
+	}}, bundles)
+
+	// unknown bundles are not looked up again right after a listing
+	err = marketplace.SubscribeVersion(context.Background(), projectID, mindpak.ID("stacklok", "t3"), "", nil)
+	require.ErrorIs(t, err, marketplaces.ErrUnknownBundle)
+}
+
 func testHarness(t *testing.T, method testMethod, scenarios []testScenario) {
 	t.Helper()
 	for _, scenario := range scenarios {
@@ -104,6 +235,12 @@ func testHarness(t *testing.T, method testMethod, scenarios []testScenario) {
 				err = marketplace.Subscribe(ctx, projectID, bundleID, store)
 			case createProfile:
 				err = marketplace.AddProfile(ctx, projectID, bundleID, profileName, store)
+			case subscribeVersion:
+				err = marketplace.SubscribeVersion(ctx, projectID, bundleID, "", store)
+			case previewVersion:
+				_, err = marketplace.PreviewVersion(ctx, projectID, bundleID, "", store)
+			case rollback:
+				err = marketplace.Rollback(ctx, projectID, bundleID, store)
 			default:
 				t.Fatalf("unknown method %d", method)
 			}
@@ -133,4 +270,7 @@ type testMethod int
 const (
 	subscribe testMethod = iota
 	createProfile
+	subscribeVersion
+	previewVersion
+	rollback
 )
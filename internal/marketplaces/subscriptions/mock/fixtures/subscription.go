@@ -9,7 +9,9 @@ package fixtures
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/mindersec/minder/internal/marketplaces/subscriptions"
 	mocksubscription "github.com/mindersec/minder/internal/marketplaces/subscriptions/mock"
 	"go.uber.org/mock/gomock"
 )
@@ -56,3 +58,52 @@ func WithFailedCreateProfile(mock SubscriptionMock) {
 		CreateProfile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errDefault)
 }
+
+func WithSuccessfulUpdate(mock SubscriptionMock) {
+	mock.EXPECT().
+		Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+}
+
+func WithFailedUpdate(mock SubscriptionMock) {
+	mock.EXPECT().
+		Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errDefault)
+}
+
+func WithSuccessfulPreview(mock SubscriptionMock) {
+	mock.EXPECT().
+		Preview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&subscriptions.Preview{}, nil)
+}
+
+func WithBreakingPreview(mock SubscriptionMock) {
+	mock.EXPECT().
+		Preview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&subscriptions.Preview{Changes: []subscriptions.Change{{
+			Kind:     subscriptions.KindRuleType,
+			Name:     "stacklok/secret_scanning",
+			Action:   subscriptions.ActionUpdate,
+			Breaking: "rule schema update is invalid",
+		}}}, nil)
+}
+
+func WithFailedPreview(mock SubscriptionMock) {
+	mock.EXPECT().
+		Preview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errDefault)
+}
+
+func WithPreviousVersion(version string) func(SubscriptionMock) {
+	return func(mock SubscriptionMock) {
+		mock.EXPECT().
+			PreviousVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(version, nil)
+	}
+}
+
+func WithNoPreviousVersion(mock SubscriptionMock) {
+	mock.EXPECT().
+		PreviousVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", fmt.Errorf("%w: subscription was never upgraded", subscriptions.ErrNoPreviousVersion))
+}
@@ -15,6 +15,8 @@ import (
 
 	uuid "github.com/google/uuid"
 	db "github.com/mindersec/minder/internal/db"
+	subscriptions "github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	mindpak "github.com/mindersec/minder/pkg/mindpak"
 	reader "github.com/mindersec/minder/pkg/mindpak/reader"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -57,6 +59,36 @@ func (mr *MockSubscriptionServiceMockRecorder) CreateProfile(ctx, projectID, bun
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfile", reflect.TypeOf((*MockSubscriptionService)(nil).CreateProfile), ctx, projectID, bundle, profileName, qtx)
 }
 
+// Preview mocks base method.
+func (m *MockSubscriptionService) Preview(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) (*subscriptions.Preview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", ctx, projectID, bundle, qtx)
+	ret0, _ := ret[0].(*subscriptions.Preview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockSubscriptionServiceMockRecorder) Preview(ctx, projectID, bundle, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockSubscriptionService)(nil).Preview), ctx, projectID, bundle, qtx)
+}
+
+// PreviousVersion mocks base method.
+func (m *MockSubscriptionService) PreviousVersion(ctx context.Context, projectID uuid.UUID, bundleID mindpak.BundleID, qtx db.Querier) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviousVersion", ctx, projectID, bundleID, qtx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviousVersion indicates an expected call of PreviousVersion.
+func (mr *MockSubscriptionServiceMockRecorder) PreviousVersion(ctx, projectID, bundleID, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviousVersion", reflect.TypeOf((*MockSubscriptionService)(nil).PreviousVersion), ctx, projectID, bundleID, qtx)
+}
+
 // Subscribe mocks base method.
 func (m *MockSubscriptionService) Subscribe(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) error {
 	m.ctrl.T.Helper()
@@ -70,3 +102,17 @@ func (mr *MockSubscriptionServiceMockRecorder) Subscribe(ctx, projectID, bundle,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptionService)(nil).Subscribe), ctx, projectID, bundle, qtx)
 }
+
+// Update mocks base method.
+func (m *MockSubscriptionService) Update(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, projectID, bundle, qtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSubscriptionServiceMockRecorder) Update(ctx, projectID, bundle, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSubscriptionService)(nil).Update), ctx, projectID, bundle, qtx)
+}
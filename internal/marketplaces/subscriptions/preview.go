@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package subscriptions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util/protodrift"
+	"github.com/mindersec/minder/internal/util/schemaupdate"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+	profsvc "github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+const (
+	// KindDataSource is the kind of data source changes
+	KindDataSource = "data_source"
+	// KindRuleType is the kind of rule type changes
+	KindRuleType = "rule_type"
+	// KindProfile is the kind of profile changes
+	KindProfile = "profile"
+
+	// ActionAdd is a resource of the bundle added to the project
+	ActionAdd = "add"
+	// ActionUpdate is a resource of the project updated to match the bundle
+	ActionUpdate = "update"
+	// ActionUnmanaged is a resource of the project which is no longer part
+	// of the bundle. It is left in the project, but no longer updated.
+	ActionUnmanaged = "unmanaged"
+)
+
+// Change is a difference between a version of a bundle and the resources
+// the project installed from the bundle
+type Change struct {
+	Kind   string
+	Name   string
+	Action string
+	// Breaking describes why the update of a rule type may break the
+	// profiles using it. Subscribing to the version fails if it is set.
+	Breaking string
+}
+
+// Preview lists the changes subscribing a project to a version of a bundle
+// makes to the project
+type Preview struct {
+	// FromVersion is the version the project is subscribed to, if any
+	FromVersion string
+	// ToVersion is the version of the bundle previewed
+	ToVersion string
+	Changes   []Change
+}
+
+// BreakingError returns an error wrapping ErrBreakingChange which lists the
+// breaking changes of the preview, or nil if there are none
+func (p *Preview) BreakingError() error {
+	var reasons []string
+	for _, change := range p.Changes {
+		if change.Breaking != "" {
+			reasons = append(reasons, fmt.Sprintf("%s %s: %s", change.Kind, change.Name, change.Breaking))
+		}
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrBreakingChange, strings.Join(reasons, "; "))
+}
+
+// installed are the resources the project installed from a bundle, by name
+type installed struct {
+	dataSources map[string]*minderv1.DataSource
+	ruleTypes   map[string]*minderv1.RuleType
+	profiles    map[string]*minderv1.Profile
+}
+
+func (s *subscriptionService) Preview(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) (*Preview, error) {
+	metadata := bundle.GetMetadata()
+	preview := &Preview{ToVersion: metadata.Version}
+
+	current := &installed{}
+	subscription, err := qtx.GetSubscriptionByProjectBundle(ctx, db.GetSubscriptionByProjectBundleParams{
+		Namespace: metadata.Namespace,
+		Name:      metadata.Name,
+		ProjectID: projectID,
+	})
+	if err == nil {
+		preview.FromVersion = subscription.CurrentVersion
+		current, err = s.installedResources(ctx, qtx, projectID, subscription.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error while querying subscriptions: %w", err)
+	}
+
+	err = bundle.ForEachDataSource(func(ds *minderv1.DataSource) error {
+		if change, ok := diffResource(KindDataSource, ds, current.dataSources); ok {
+			preview.Changes = append(preview.Changes, change)
+		}
+		delete(current.dataSources, ds.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while reading data sources of bundle: %w", err)
+	}
+
+	err = bundle.ForEachRuleType(func(rt *minderv1.RuleType) error {
+		change, ok := diffResource(KindRuleType, rt, current.ruleTypes)
+		if ok && change.Action == ActionUpdate {
+			change.Breaking = breakingRuleTypeChange(current.ruleTypes[rt.GetName()], rt)
+		}
+		if ok {
+			preview.Changes = append(preview.Changes, change)
+		}
+		delete(current.ruleTypes, rt.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while reading rule types of bundle: %w", err)
+	}
+
+	// Profiles are only created in the project on request, so only the
+	// profiles already installed are compared
+	if len(current.profiles) > 0 {
+		err = bundle.ForEachProfile(func(p *minderv1.Profile) error {
+			if _, ok := current.profiles[p.GetName()]; !ok {
+				return nil
+			}
+			if change, ok := diffResource(KindProfile, p, current.profiles); ok {
+				preview.Changes = append(preview.Changes, change)
+			}
+			delete(current.profiles, p.GetName())
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while reading profiles of bundle: %w", err)
+		}
+	}
+
+	// Whatever is left is no longer part of the bundle
+	preview.Changes = append(preview.Changes, removals(KindDataSource, current.dataSources)...)
+	preview.Changes = append(preview.Changes, removals(KindRuleType, current.ruleTypes)...)
+	preview.Changes = append(preview.Changes, removals(KindProfile, current.profiles)...)
+
+	return preview, nil
+}
+
+// installedResources reads the resources of the project which belong to the
+// subscription
+func (s *subscriptionService) installedResources(
+	ctx context.Context,
+	qtx db.ExtendQuerier,
+	projectID uuid.UUID,
+	subscriptionID uuid.UUID,
+) (*installed, error) {
+	res := &installed{
+		dataSources: map[string]*minderv1.DataSource{},
+		ruleTypes:   map[string]*minderv1.RuleType{},
+	}
+
+	dss, err := qtx.ListDataSources(ctx, []uuid.UUID{projectID})
+	if err != nil {
+		return nil, fmt.Errorf("error while listing data sources: %w", err)
+	}
+	for _, ds := range dss {
+		if ds.SubscriptionID.UUID != subscriptionID {
+			continue
+		}
+		pb, err := s.dataSources.GetByName(ctx, ds.Name, projectID,
+			datasourceservice.ReadBuilder().WithTransaction(qtx))
+		if err != nil {
+			return nil, fmt.Errorf("error while retrieving data source %s: %w", ds.Name, err)
+		}
+		res.dataSources[ds.Name] = pb
+	}
+
+	rts, err := qtx.ListRuleTypesByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing rule types: %w", err)
+	}
+	for i := range rts {
+		if rts[i].SubscriptionID.UUID != subscriptionID {
+			continue
+		}
+		pb, err := ruletypes.RuleTypePBFromDB(&rts[i])
+		if err != nil {
+			return nil, fmt.Errorf("error while converting rule type %s: %w", rts[i].Name, err)
+		}
+		res.ruleTypes[rts[i].Name] = pb
+	}
+
+	res.profiles, err = subscriptionProfiles(ctx, qtx, projectID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// subscriptionProfiles reads the profiles of the project created from the
+// bundle of the subscription
+func subscriptionProfiles(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	subscriptionID uuid.UUID,
+) (map[string]*minderv1.Profile, error) {
+	rows, err := qtx.ListProfilesByProjectIDAndLabel(ctx, db.ListProfilesByProjectIDAndLabelParams{
+		ProjectID:     projectID,
+		IncludeLabels: []string{"*"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while listing profiles: %w", err)
+	}
+	var owned []db.ListProfilesByProjectIDAndLabelRow
+	for _, row := range rows {
+		if row.Profile.SubscriptionID.UUID == subscriptionID {
+			owned = append(owned, row)
+		}
+	}
+	// The merged profiles are keyed by ID
+	byName := map[string]*minderv1.Profile{}
+	for _, p := range profsvc.MergeDatabaseListIntoProfiles(owned) {
+		byName[p.GetName()] = p
+	}
+	return byName, nil
+}
+
+type namedResource interface {
+	proto.Message
+	GetName() string
+}
+
+// diffResource compares a resource of the bundle with the resource of the
+// same name installed in the project
+func diffResource[T namedResource](kind string, want T, current map[string]T) (Change, bool) {
+	have, ok := current[want.GetName()]
+	switch {
+	case !ok:
+		return Change{Kind: kind, Name: want.GetName(), Action: ActionAdd}, true
+	case !matches(want, have):
+		return Change{Kind: kind, Name: want.GetName(), Action: ActionUpdate}, true
+	}
+	return Change{}, false
+}
+
+// matches reports whether the resource installed in the project matches the
+// resource of the bundle. IDs and contexts come from the project, and the
+// version and type only identify the kind of resource in the bundle.
+func matches(want, have proto.Message) bool {
+	return protodrift.Contains(want, have, "id", "context", "version", "type")
+}
+
+func removals[T any](kind string, byName map[string]T) []Change {
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	changes := make([]Change, 0, len(names))
+	for _, name := range names {
+		changes = append(changes, Change{Kind: kind, Name: name, Action: ActionUnmanaged})
+	}
+	return changes
+}
+
+// breakingRuleTypeChange validates the update of the schemas of a rule type
+// the same way updates of rule types are validated, returning why the
+// update is invalid
+func breakingRuleTypeChange(before, after *minderv1.RuleType) string {
+	if err := schemaupdate.ValidateSchemaUpdate(
+		before.GetDef().GetRuleSchema(), after.GetDef().GetRuleSchema()); err != nil {
+		return fmt.Sprintf("rule schema update is invalid: %s", err)
+	}
+	if err := schemaupdate.ValidateSchemaUpdate(
+		before.GetDef().GetParamSchema(), after.GetDef().GetParamSchema()); err != nil {
+		return fmt.Sprintf("parameter schema update is invalid: %s", err)
+	}
+	return ""
+}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
@@ -38,6 +39,33 @@ type SubscriptionService interface {
 		bundle reader.BundleReader,
 		qtx db.ExtendQuerier,
 	) error
+	// Update subscribes the project to the version of the bundle. It creates
+	// the subscription if there is none, or else upserts the data sources and
+	// rule types of the bundle in the project, updates the profiles created
+	// from the bundle, and records the new version along with the previous
+	// one. It is a no-op if the project is already subscribed to that version.
+	Update(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundle reader.BundleReader,
+		qtx db.ExtendQuerier,
+	) error
+	// Preview lists the changes Update would make to the project, without
+	// making them.
+	Preview(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundle reader.BundleReader,
+		qtx db.ExtendQuerier,
+	) (*Preview, error)
+	// PreviousVersion returns the version of the bundle the project was
+	// subscribed to before its last upgrade, or downgrade.
+	PreviousVersion(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		qtx db.Querier,
+	) (string, error)
 	// CreateProfile creates the specified profile from the bundle in the project.
 	CreateProfile(
 		ctx context.Context,
@@ -48,6 +76,14 @@ type SubscriptionService interface {
 	) error
 }
 
+// ErrNoPreviousVersion is returned when a subscription has no version to
+// roll back to
+var ErrNoPreviousVersion = errors.New("no previous version of the bundle")
+
+// ErrBreakingChange is returned when a version of a bundle has rule type
+// updates which may break the profiles of the project
+var ErrBreakingChange = errors.New("bundle version has breaking changes")
+
 type subscriptionService struct {
 	profiles    profsvc.ProfileService
 	rules       ruletypes.RuleTypeService
@@ -88,6 +124,91 @@ func (s *subscriptionService) Subscribe(
 		return fmt.Errorf("error while querying subscriptions: %w", err)
 	}
 
+	return s.createSubscription(ctx, projectID, bundle, metadata, qtx)
+}
+
+func (s *subscriptionService) Update(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) error {
+	metadata := bundle.GetMetadata()
+	subscription, err := qtx.GetSubscriptionByProjectBundle(ctx, db.GetSubscriptionByProjectBundleParams{
+		Namespace: metadata.Namespace,
+		Name:      metadata.Name,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return s.createSubscription(ctx, projectID, bundle, metadata, qtx)
+	} else if err != nil {
+		return fmt.Errorf("error while querying subscriptions: %w", err)
+	}
+
+	// project already subscribed to this version of the bundle, skip
+	if subscription.CurrentVersion == metadata.Version {
+		return nil
+	}
+
+	// Resources which are no longer part of the bundle are left in the project
+	err = s.upsertBundleDataSources(ctx, qtx, projectID, bundle, subscription.ID)
+	if err != nil {
+		return fmt.Errorf("error while updating data sources in project: %w", err)
+	}
+
+	err = s.upsertBundleRules(ctx, qtx, projectID, bundle, subscription.ID)
+	if err != nil {
+		return fmt.Errorf("error while updating rules in project: %w", err)
+	}
+
+	err = s.updateBundleProfiles(ctx, qtx, projectID, bundle, subscription.ID)
+	if err != nil {
+		return fmt.Errorf("error while updating profiles in project: %w", err)
+	}
+
+	err = qtx.SetSubscriptionVersion(ctx, db.SetSubscriptionVersionParams{
+		ID:             subscription.ID,
+		CurrentVersion: metadata.Version,
+	})
+	if err != nil {
+		return fmt.Errorf("error while updating subscription version: %w", err)
+	}
+
+	return nil
+}
+
+func (*subscriptionService) PreviousVersion(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	qtx db.Querier,
+) (string, error) {
+	subscription, err := qtx.GetSubscriptionByProjectBundle(ctx, db.GetSubscriptionByProjectBundleParams{
+		Namespace: bundleID.Namespace,
+		Name:      bundleID.Name,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: project is not subscribed to bundle %s", ErrNoPreviousVersion, bundleID)
+	} else if err != nil {
+		return "", fmt.Errorf("error while querying subscriptions: %w", err)
+	}
+
+	if !subscription.PreviousVersion.Valid {
+		return "", fmt.Errorf("%w: subscription to bundle %s was never upgraded", ErrNoPreviousVersion, bundleID)
+	}
+	return subscription.PreviousVersion.String, nil
+}
+
+// createSubscription creates the subscription of the project to the bundle,
+// and populates the project with the contents of the bundle
+func (s *subscriptionService) createSubscription(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	metadata *mindpak.Metadata,
+	qtx db.ExtendQuerier,
+) error {
 	// if creating new subscription, ensure bundle exists
 	bundleID, err := ensureBundleExists(ctx, qtx, metadata.Namespace, metadata.Name)
 	if err != nil {
@@ -207,6 +328,36 @@ func (s *subscriptionService) upsertBundleRules(
 	})
 }
 
+// updateBundleProfiles updates the profiles created from the bundle which
+// differ from the profiles of the bundle. Profiles of the bundle which were
+// never created in the project are left alone.
+func (s *subscriptionService) updateBundleProfiles(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	subscriptionID uuid.UUID,
+) error {
+	current, err := subscriptionProfiles(ctx, qtx, projectID, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if len(current) == 0 {
+		return nil
+	}
+
+	return bundle.ForEachProfile(func(profile *minderv1.Profile) error {
+		have, ok := current[profile.GetName()]
+		if !ok || matches(profile, have) {
+			return nil
+		}
+		// UpdateProfile populates the rule names of the profile, which
+		// must not change the profile held by the bundle
+		_, err := s.profiles.UpdateProfile(ctx, projectID, subscriptionID, proto.Clone(profile).(*minderv1.Profile), qtx)
+		return err
+	})
+}
+
 func (s *subscriptionService) upsertBundleDataSources(
 	ctx context.Context,
 	qtx db.ExtendQuerier,
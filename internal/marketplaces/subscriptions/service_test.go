@@ -12,6 +12,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	dsf "github.com/mindersec/minder/internal/datasources/service/mock/fixtures"
@@ -19,6 +22,9 @@ import (
 	dbf "github.com/mindersec/minder/internal/db/fixtures"
 	brf "github.com/mindersec/minder/internal/marketplaces/bundles/mock/fixtures"
 	"github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak"
 	"github.com/mindersec/minder/pkg/mindpak/reader"
 	"github.com/mindersec/minder/pkg/profiles"
 	psf "github.com/mindersec/minder/pkg/profiles/mock/fixtures"
@@ -115,6 +121,386 @@ func TestSubscriptionService_Subscribe(t *testing.T) {
 	}
 }
 
+func TestSubscriptionService_Update(t *testing.T) {
+	t.Parallel()
+	scenarios := []struct {
+		Name            string
+		DBSetup         dbf.DBMockBuilder
+		BundleSetup     brf.BundleMockBuilder
+		RuleTypeSetup   rsf.RuleTypeSvcMockBuilder
+		DataSourceSetup dsf.DataSourcesSvcMockBuilder
+		ProfileSetup    psf.ProfileSvcMockBuilder
+		ExpectedError   string
+	}{
+		{
+			Name:        "Update is a no-op when the project is subscribed to the version",
+			BundleSetup: brf.NewBundleReaderMock(brf.WithMetadata),
+			DBSetup:     dbf.NewDBMock(withCurrentVersionFindSubscription),
+		},
+		{
+			Name:          "Update returns error when it cannot query for existing subscriptions",
+			BundleSetup:   brf.NewBundleReaderMock(brf.WithMetadata),
+			DBSetup:       dbf.NewDBMock(withFailedFindSubscription),
+			ExpectedError: "error while querying subscriptions",
+		},
+		{
+			Name:            "Update creates subscription when there is none",
+			DBSetup:         dbf.NewDBMock(withNotFoundFindSubscription, withSuccessfulCreateSubscription, withBundleUpsert),
+			BundleSetup:     brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+		},
+		{
+			Name:            "Update returns error if rules cannot be upserted into database",
+			DBSetup:         dbf.NewDBMock(withSuccessfulFindSubscription),
+			BundleSetup:     brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithFailedUpsertRuleType),
+			ExpectedError:   "error while updating rules in project",
+		},
+		{
+			Name:          "Update returns error if data sources cannot be read from bundle",
+			DBSetup:       dbf.NewDBMock(withSuccessfulFindSubscription),
+			BundleSetup:   brf.NewBundleReaderMock(brf.WithMetadata, brf.WithFailedForEachDataSource),
+			ExpectedError: "error while updating data sources in project",
+		},
+		{
+			Name:            "Update returns error if profiles cannot be listed",
+			DBSetup:         dbf.NewDBMock(withSuccessfulFindSubscription, withFailedListProfiles),
+			BundleSetup:     brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+			ExpectedError:   "error while updating profiles in project",
+		},
+		{
+			Name:    "Update returns error if profiles cannot be updated",
+			DBSetup: dbf.NewDBMock(withSuccessfulFindSubscription, withSubscriptionProfile),
+			BundleSetup: brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource,
+				brf.WithForEachProfile(&minderv1.Profile{Name: profileName, Remediate: ptr.Ptr("on")})),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+			ProfileSetup:    psf.NewProfileServiceMock(psf.WithFailedUpdateSubscriptionProfile),
+			ExpectedError:   "error while updating profiles in project",
+		},
+		{
+			Name:            "Update returns error if the version cannot be recorded",
+			DBSetup:         dbf.NewDBMock(withSuccessfulFindSubscription, withNoSubscriptionProfiles, withFailedSetSubscriptionVersion),
+			BundleSetup:     brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+			ExpectedError:   "error while updating subscription version",
+		},
+		{
+			Name:            "Update moves subscription to the version",
+			DBSetup:         dbf.NewDBMock(withSuccessfulFindSubscription, withNoSubscriptionProfiles, withSuccessfulSetSubscriptionVersion),
+			BundleSetup:     brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+		},
+		{
+			Name:    "Update leaves profiles matching the bundle alone",
+			DBSetup: dbf.NewDBMock(withSuccessfulFindSubscription, withSubscriptionProfile, withSuccessfulSetSubscriptionVersion),
+			BundleSetup: brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource,
+				brf.WithForEachProfile(&minderv1.Profile{Name: profileName, Remediate: ptr.Ptr("off")})),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+		},
+		{
+			Name:    "Update updates profiles created from the bundle",
+			DBSetup: dbf.NewDBMock(withSuccessfulFindSubscription, withSubscriptionProfile, withSuccessfulSetSubscriptionVersion),
+			BundleSetup: brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType, brf.WithSuccessfulForEachDataSource,
+				brf.WithForEachProfile(
+					&minderv1.Profile{Name: profileName, Remediate: ptr.Ptr("on")},
+					&minderv1.Profile{Name: "not_created"},
+				)),
+			RuleTypeSetup:   rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource),
+			ProfileSetup:    psf.NewProfileServiceMock(psf.WithSuccessfulUpdateSubscriptionProfile),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			bundle := scenario.BundleSetup(ctrl)
+			querier := getQuerier(ctrl, scenario.DBSetup)
+
+			svc := createService(ctrl, scenario.ProfileSetup, scenario.RuleTypeSetup, scenario.DataSourceSetup)
+			err := svc.Update(ctx, projectID, bundle, querier)
+			if scenario.ExpectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, scenario.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestSubscriptionService_UpdateKeepsBundleProfiles(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	profile := &minderv1.Profile{
+		Name:       profileName,
+		Remediate:  ptr.Ptr("on"),
+		Repository: []*minderv1.Profile_Rule{{Type: "stacklok/secret_scanning"}},
+	}
+	want := proto.Clone(profile)
+
+	bundle := brf.NewBundleReaderMock(brf.WithMetadata, brf.WithSuccessfulForEachRuleType,
+		brf.WithSuccessfulForEachDataSource, brf.WithForEachProfile(profile))(ctrl)
+	querier := getQuerier(ctrl,
+		dbf.NewDBMock(withSuccessfulFindSubscription, withSubscriptionProfile, withSuccessfulSetSubscriptionVersion))
+
+	// the profile service fills in the names of the rules, like
+	// UpdateProfile does
+	profileSetup := func(ctrl *gomock.Controller) psf.ProfileSvcMock {
+		mock := psf.NewProfileServiceMock()(ctrl)
+		mock.EXPECT().
+			UpdateProfile(gomock.Any(), projectID, subscriptionID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, p *minderv1.Profile, _ db.Querier) (*minderv1.Profile, error) {
+				p.GetRepository()[0].Name = "stacklok/secret_scanning"
+				return p, nil
+			})
+		return mock
+	}
+
+	svc := createService(ctrl, profileSetup,
+		rsf.NewRuleTypeServiceMock(rsf.WithSuccessfulUpsertRuleType),
+		dsf.NewDataSourcesServiceMock(dsf.WithSuccessfulUpsertDataSource))
+	require.NoError(t, svc.Update(context.Background(), projectID, bundle, querier))
+
+	// the profile of the bundle is left untouched
+	require.True(t, proto.Equal(want, profile))
+}
+
+func TestSubscriptionService_Preview(t *testing.T) {
+	t.Parallel()
+
+	schema := func(typ string) *structpb.Struct {
+		s, err := structpb.NewStruct(map[string]any{"type": typ})
+		require.NoError(t, err)
+		return s
+	}
+	ruleType := func(name string, paramType string) *minderv1.RuleType {
+		return &minderv1.RuleType{
+			Name: name,
+			Def:  &minderv1.RuleType_Definition{InEntity: "repository", ParamSchema: schema(paramType)},
+		}
+	}
+	ruleTypeRow := func(rt *minderv1.RuleType) db.RuleType {
+		def, err := protojson.Marshal(rt.GetDef())
+		require.NoError(t, err)
+		return db.RuleType{
+			ID:             uuid.New(),
+			Name:           rt.GetName(),
+			ProjectID:      projectID,
+			Definition:     def,
+			SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: true},
+		}
+	}
+	ruleSchemaRuleType := func(properties ...string) *minderv1.RuleType {
+		props := map[string]any{}
+		for _, prop := range properties {
+			props[prop] = map[string]any{"type": "string"}
+		}
+		s, err := structpb.NewStruct(map[string]any{"type": "object", "properties": props})
+		require.NoError(t, err)
+		return &minderv1.RuleType{
+			Name: "stacklok/secret_scanning",
+			Def:  &minderv1.RuleType_Definition{InEntity: "repository", RuleSchema: s},
+		}
+	}
+	osv := &minderv1.DataSource{Name: "stacklok/osv", Version: "v1", Type: "data-source"}
+
+	scenarios := []struct {
+		Name            string
+		DBSetup         dbf.DBMockBuilder
+		BundleSetup     brf.BundleMockBuilder
+		DataSourceSetup dsf.DataSourcesSvcMockBuilder
+		ExpectedFrom    string
+		ExpectedChanges []subscriptions.Change
+		ExpectedError   string
+	}{
+		{
+			Name:          "Preview returns error when it cannot query for existing subscriptions",
+			BundleSetup:   brf.NewBundleReaderMock(brf.WithMetadata),
+			DBSetup:       dbf.NewDBMock(withFailedFindSubscription),
+			ExpectedError: "error while querying subscriptions",
+		},
+		{
+			Name:    "Preview adds every resource when the project is not subscribed",
+			DBSetup: dbf.NewDBMock(withNotFoundFindSubscription),
+			BundleSetup: brf.NewBundleReaderMock(brf.WithMetadata,
+				brf.WithForEachDataSource(osv),
+				brf.WithForEachRuleType(ruleType("stacklok/branch_protection", "object"))),
+			ExpectedChanges: []subscriptions.Change{
+				{Kind: subscriptions.KindDataSource, Name: "stacklok/osv", Action: subscriptions.ActionAdd},
+				{Kind: subscriptions.KindRuleType, Name: "stacklok/branch_protection", Action: subscriptions.ActionAdd},
+			},
+		},
+		{
+			Name: "Preview diffs the bundle against the installed resources",
+			DBSetup: dbf.NewDBMock(
+				withPreviousVersionFindSubscription("0.9.0"),
+				func(mock dbf.DBMock) {
+					mock.EXPECT().
+						ListDataSources(gomock.Any(), []uuid.UUID{projectID}).
+						Return([]db.DataSource{
+							{Name: "stacklok/osv", SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: true}},
+							{Name: "stacklok/legacy", SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: true}},
+							{Name: "mine"},
+						}, nil)
+					mock.EXPECT().
+						ListRuleTypesByProject(gomock.Any(), projectID).
+						Return([]db.RuleType{
+							ruleTypeRow(ruleType("stacklok/branch_protection", "object")),
+							ruleTypeRow(ruleType("stacklok/secret_scanning", "object")),
+						}, nil)
+				},
+				withSubscriptionProfile,
+			),
+			BundleSetup: brf.NewBundleReaderMock(brf.WithMetadata,
+				brf.WithForEachDataSource(osv, &minderv1.DataSource{Name: "stacklok/deps"}),
+				brf.WithForEachRuleType(
+					ruleType("stacklok/branch_protection", "array"),
+					ruleType("stacklok/secret_scanning", "object"),
+				),
+				brf.WithForEachProfile(
+					&minderv1.Profile{Name: profileName, Remediate: ptr.Ptr("on")},
+					&minderv1.Profile{Name: "not_created"},
+				)),
+			DataSourceSetup: dsf.NewDataSourcesServiceMock(
+				dsf.WithSuccessfulGetByName(projectID, &minderv1.DataSource{Name: "stacklok/osv"}),
+				dsf.WithSuccessfulGetByName(projectID, &minderv1.DataSource{Name: "stacklok/legacy"}),
+			),
+			ExpectedFrom: "0.9.0",
+			ExpectedChanges: []subscriptions.Change{
+				{Kind: subscriptions.KindDataSource, Name: "stacklok/deps", Action: subscriptions.ActionAdd},
+				{
+					Kind:     subscriptions.KindRuleType,
+					Name:     "stacklok/branch_protection",
+					Action:   subscriptions.ActionUpdate,
+					Breaking: "parameter schema update is invalid: cannot change type of rule schema",
+				},
+				{Kind: subscriptions.KindProfile, Name: profileName, Action: subscriptions.ActionUpdate},
+				{Kind: subscriptions.KindDataSource, Name: "stacklok/legacy", Action: subscriptions.ActionUnmanaged},
+			},
+		},
+		{
+			Name: "Preview flags rolling back an upgrade which added a rule schema property",
+			DBSetup: dbf.NewDBMock(
+				withPreviousVersionFindSubscription("0.9.0"),
+				func(mock dbf.DBMock) {
+					mock.EXPECT().
+						ListDataSources(gomock.Any(), []uuid.UUID{projectID}).
+						Return(nil, nil)
+					mock.EXPECT().
+						ListRuleTypesByProject(gomock.Any(), projectID).
+						Return([]db.RuleType{ruleTypeRow(ruleSchemaRuleType("branch", "severity"))}, nil)
+				},
+				withNoSubscriptionProfiles,
+			),
+			BundleSetup: brf.NewBundleReaderMock(brf.WithMetadata,
+				brf.WithForEachDataSource(),
+				brf.WithForEachRuleType(ruleSchemaRuleType("branch"))),
+			ExpectedFrom: "0.9.0",
+			ExpectedChanges: []subscriptions.Change{
+				{
+					Kind:     subscriptions.KindRuleType,
+					Name:     "stacklok/secret_scanning",
+					Action:   subscriptions.ActionUpdate,
+					Breaking: "rule schema update is invalid: failed to validate properties: cannot remove properties from rule schema",
+				},
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			bundle := scenario.BundleSetup(ctrl)
+			querier := getQuerier(ctrl, scenario.DBSetup)
+
+			svc := createService(ctrl, nil, nil, scenario.DataSourceSetup)
+			preview, err := svc.Preview(ctx, projectID, bundle, querier)
+			if scenario.ExpectedError != "" {
+				require.ErrorContains(t, err, scenario.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, scenario.ExpectedFrom, preview.FromVersion)
+			require.Equal(t, brf.BundleVersion, preview.ToVersion)
+			require.Equal(t, scenario.ExpectedChanges, preview.Changes)
+		})
+	}
+}
+
+func TestSubscriptionService_PreviousVersion(t *testing.T) {
+	t.Parallel()
+	scenarios := []struct {
+		Name            string
+		DBSetup         dbf.DBMockBuilder
+		ExpectedVersion string
+		ExpectedError   error
+	}{
+		{
+			Name:          "PreviousVersion returns error when project is not subscribed to bundle",
+			DBSetup:       dbf.NewDBMock(withNotFoundFindSubscription),
+			ExpectedError: subscriptions.ErrNoPreviousVersion,
+		},
+		{
+			Name:          "PreviousVersion returns error when the subscription was never upgraded",
+			DBSetup:       dbf.NewDBMock(withSuccessfulFindSubscription),
+			ExpectedError: subscriptions.ErrNoPreviousVersion,
+		},
+		{
+			Name:          "PreviousVersion returns error when it cannot query for existing subscriptions",
+			DBSetup:       dbf.NewDBMock(withFailedFindSubscription),
+			ExpectedError: errDefault,
+		},
+		{
+			Name:            "PreviousVersion returns the previous version",
+			DBSetup:         dbf.NewDBMock(withPreviousVersionFindSubscription("0.9.0")),
+			ExpectedVersion: "0.9.0",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			querier := getQuerier(ctrl, scenario.DBSetup)
+
+			svc := createService(ctrl, nil, nil, nil)
+			version, err := svc.PreviousVersion(ctx, projectID,
+				mindpak.ID(brf.BundleNamespace, brf.BundleName), querier)
+			if scenario.ExpectedError != nil {
+				require.ErrorIs(t, err, scenario.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, scenario.ExpectedVersion, version)
+		})
+	}
+}
+
 func TestSubscriptionService_CreateProfile(t *testing.T) {
 	t.Parallel()
 	scenarios := []struct {
@@ -208,6 +594,68 @@ func withSuccessfulFindSubscription(mock dbf.DBMock) {
 		Return(db.Subscription{ID: subscriptionID}, nil)
 }
 
+func withCurrentVersionFindSubscription(mock dbf.DBMock) {
+	mock.EXPECT().
+		GetSubscriptionByProjectBundle(gomock.Any(), gomock.Any()).
+		Return(db.Subscription{ID: subscriptionID, CurrentVersion: brf.BundleVersion}, nil)
+}
+
+func withPreviousVersionFindSubscription(version string) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		mock.EXPECT().
+			GetSubscriptionByProjectBundle(gomock.Any(), gomock.Any()).
+			Return(db.Subscription{
+				ID:              subscriptionID,
+				CurrentVersion:  version,
+				PreviousVersion: sql.NullString{String: version, Valid: true},
+			}, nil)
+	}
+}
+
+func withNoSubscriptionProfiles(mock dbf.DBMock) {
+	mock.EXPECT().
+		ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+}
+
+func withSubscriptionProfile(mock dbf.DBMock) {
+	mock.EXPECT().
+		ListProfilesByProjectIDAndLabel(gomock.Any(), db.ListProfilesByProjectIDAndLabelParams{
+			ProjectID:     projectID,
+			IncludeLabels: []string{"*"},
+		}).
+		Return([]db.ListProfilesByProjectIDAndLabelRow{
+			{Profile: db.Profile{
+				ID:             uuid.New(),
+				Name:           profileName,
+				ProjectID:      projectID,
+				SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: true},
+			}},
+			{Profile: db.Profile{ID: uuid.New(), Name: "mine", ProjectID: projectID}},
+		}, nil)
+}
+
+func withFailedListProfiles(mock dbf.DBMock) {
+	mock.EXPECT().
+		ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).
+		Return(nil, errDefault)
+}
+
+func withSuccessfulSetSubscriptionVersion(mock dbf.DBMock) {
+	mock.EXPECT().
+		SetSubscriptionVersion(gomock.Any(), db.SetSubscriptionVersionParams{
+			ID:             subscriptionID,
+			CurrentVersion: brf.BundleVersion,
+		}).
+		Return(nil)
+}
+
+func withFailedSetSubscriptionVersion(mock dbf.DBMock) {
+	mock.EXPECT().
+		SetSubscriptionVersion(gomock.Any(), gomock.Any()).
+		Return(errDefault)
+}
+
 func withSuccessfulCreateSubscription(mock dbf.DBMock) {
 	mock.EXPECT().
 		CreateSubscription(gomock.Any(), gomock.Any()).
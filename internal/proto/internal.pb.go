@@ -35,6 +35,10 @@ const (
 	DepEcosystem_DEP_ECOSYSTEM_NPM         DepEcosystem = 1
 	DepEcosystem_DEP_ECOSYSTEM_GO          DepEcosystem = 2
 	DepEcosystem_DEP_ECOSYSTEM_PYPI        DepEcosystem = 3
+	DepEcosystem_DEP_ECOSYSTEM_MAVEN       DepEcosystem = 4
+	DepEcosystem_DEP_ECOSYSTEM_CARGO       DepEcosystem = 5
+	DepEcosystem_DEP_ECOSYSTEM_RUBYGEMS    DepEcosystem = 6
+	DepEcosystem_DEP_ECOSYSTEM_NUGET       DepEcosystem = 7
 )
 
 // Enum value maps for DepEcosystem.
@@ -44,12 +48,20 @@ var (
 		1: "DEP_ECOSYSTEM_NPM",
 		2: "DEP_ECOSYSTEM_GO",
 		3: "DEP_ECOSYSTEM_PYPI",
+		4: "DEP_ECOSYSTEM_MAVEN",
+		5: "DEP_ECOSYSTEM_CARGO",
+		6: "DEP_ECOSYSTEM_RUBYGEMS",
+		7: "DEP_ECOSYSTEM_NUGET",
 	}
 	DepEcosystem_value = map[string]int32{
 		"DEP_ECOSYSTEM_UNSPECIFIED": 0,
 		"DEP_ECOSYSTEM_NPM":         1,
 		"DEP_ECOSYSTEM_GO":          2,
 		"DEP_ECOSYSTEM_PYPI":        3,
+		"DEP_ECOSYSTEM_MAVEN":       4,
+		"DEP_ECOSYSTEM_CARGO":       5,
+		"DEP_ECOSYSTEM_RUBYGEMS":    6,
+		"DEP_ECOSYSTEM_NUGET":       7,
 	}
 )
 
@@ -655,6 +667,179 @@ func (x *SelectorPullRequest) GetProperties() *structpb.Struct {
 	return nil
 }
 
+type SelectorPipelineRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the full name of the pipeline run, e.g. mindersec/minder/actions/runs/123
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the provider of the pipeline run
+	Provider *SelectorProvider `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// the event that triggered the run, e.g. push or pull_request
+	Trigger string `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// the login of the user that triggered the run
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// is_from_fork is true if the run was triggered by a change coming from a fork,
+	// nil if "don't know" or rather not applicable to this provider
+	IsFromFork *bool `protobuf:"varint,5,opt,name=is_from_fork,json=isFromFork,proto3,oneof" json:"is_from_fork,omitempty"`
+	// provider-specific properties
+	Properties    *structpb.Struct `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectorPipelineRun) Reset() {
+	*x = SelectorPipelineRun{}
+	mi := &file_internal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectorPipelineRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorPipelineRun) ProtoMessage() {}
+
+func (x *SelectorPipelineRun) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorPipelineRun.ProtoReflect.Descriptor instead.
+func (*SelectorPipelineRun) Descriptor() ([]byte, []int) {
+	return file_internal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SelectorPipelineRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelectorPipelineRun) GetProvider() *SelectorProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *SelectorPipelineRun) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *SelectorPipelineRun) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SelectorPipelineRun) GetIsFromFork() bool {
+	if x != nil && x.IsFromFork != nil {
+		return *x.IsFromFork
+	}
+	return false
+}
+
+func (x *SelectorPipelineRun) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type SelectorTaskRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the full name of the task run, e.g. mindersec/minder/actions/jobs/456
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the provider of the task run
+	Provider *SelectorProvider `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// the labels of the runner the task runs, or is waiting to run, on
+	RunnerLabels []string `protobuf:"bytes,3,rep,name=runner_labels,json=runnerLabels,proto3" json:"runner_labels,omitempty"`
+	// is_self_hosted is true if the task runs on a self-hosted runner, nil if
+	// "don't know" or rather not applicable to this provider
+	IsSelfHosted *bool `protobuf:"varint,4,opt,name=is_self_hosted,json=isSelfHosted,proto3,oneof" json:"is_self_hosted,omitempty"`
+	// provider-specific properties
+	Properties    *structpb.Struct `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectorTaskRun) Reset() {
+	*x = SelectorTaskRun{}
+	mi := &file_internal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectorTaskRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorTaskRun) ProtoMessage() {}
+
+func (x *SelectorTaskRun) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorTaskRun.ProtoReflect.Descriptor instead.
+func (*SelectorTaskRun) Descriptor() ([]byte, []int) {
+	return file_internal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SelectorTaskRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelectorTaskRun) GetProvider() *SelectorProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *SelectorTaskRun) GetRunnerLabels() []string {
+	if x != nil {
+		return x.RunnerLabels
+	}
+	return nil
+}
+
+func (x *SelectorTaskRun) GetIsSelfHosted() bool {
+	if x != nil && x.IsSelfHosted != nil {
+		return *x.IsSelfHosted
+	}
+	return false
+}
+
+func (x *SelectorTaskRun) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
 type SelectorGeneric struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -665,7 +850,7 @@ type SelectorGeneric struct {
 
 func (x *SelectorGeneric) Reset() {
 	*x = SelectorGeneric{}
-	mi := &file_internal_proto_msgTypes[8]
+	mi := &file_internal_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -677,7 +862,7 @@ func (x *SelectorGeneric) String() string {
 func (*SelectorGeneric) ProtoMessage() {}
 
 func (x *SelectorGeneric) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[8]
+	mi := &file_internal_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +875,7 @@ func (x *SelectorGeneric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelectorGeneric.ProtoReflect.Descriptor instead.
 func (*SelectorGeneric) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{8}
+	return file_internal_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SelectorGeneric) GetName() string {
@@ -709,7 +894,7 @@ func (x *SelectorGeneric) GetProperties() *structpb.Struct {
 
 type SelectorEntity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// one of repository, pull_request, artifact (see oneof entity)
+	// one of repository, pull_request, artifact, pipeline_run, task_run (see oneof entity)
 	EntityType v1.Entity `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
 	// the name of the entity, same as the name in the entity message
 	Name     string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -720,6 +905,8 @@ type SelectorEntity struct {
 	//	*SelectorEntity_Artifact
 	//	*SelectorEntity_PullRequest
 	//	*SelectorEntity_Generic
+	//	*SelectorEntity_PipelineRun
+	//	*SelectorEntity_TaskRun
 	Entity        isSelectorEntity_Entity `protobuf_oneof:"entity"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -727,7 +914,7 @@ type SelectorEntity struct {
 
 func (x *SelectorEntity) Reset() {
 	*x = SelectorEntity{}
-	mi := &file_internal_proto_msgTypes[9]
+	mi := &file_internal_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -739,7 +926,7 @@ func (x *SelectorEntity) String() string {
 func (*SelectorEntity) ProtoMessage() {}
 
 func (x *SelectorEntity) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[9]
+	mi := &file_internal_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,7 +939,7 @@ func (x *SelectorEntity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelectorEntity.ProtoReflect.Descriptor instead.
 func (*SelectorEntity) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{9}
+	return file_internal_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SelectorEntity) GetEntityType() v1.Entity {
@@ -819,6 +1006,24 @@ func (x *SelectorEntity) GetGeneric() *SelectorGeneric {
 	return nil
 }
 
+func (x *SelectorEntity) GetPipelineRun() *SelectorPipelineRun {
+	if x != nil {
+		if x, ok := x.Entity.(*SelectorEntity_PipelineRun); ok {
+			return x.PipelineRun
+		}
+	}
+	return nil
+}
+
+func (x *SelectorEntity) GetTaskRun() *SelectorTaskRun {
+	if x != nil {
+		if x, ok := x.Entity.(*SelectorEntity_TaskRun); ok {
+			return x.TaskRun
+		}
+	}
+	return nil
+}
+
 type isSelectorEntity_Entity interface {
 	isSelectorEntity_Entity()
 }
@@ -839,6 +1044,14 @@ type SelectorEntity_Generic struct {
 	Generic *SelectorGeneric `protobuf:"bytes,7,opt,name=generic,proto3,oneof"`
 }
 
+type SelectorEntity_PipelineRun struct {
+	PipelineRun *SelectorPipelineRun `protobuf:"bytes,8,opt,name=pipeline_run,json=pipelineRun,proto3,oneof"`
+}
+
+type SelectorEntity_TaskRun struct {
+	TaskRun *SelectorTaskRun `protobuf:"bytes,9,opt,name=task_run,json=taskRun,proto3,oneof"`
+}
+
 func (*SelectorEntity_Repository) isSelectorEntity_Entity() {}
 
 func (*SelectorEntity_Artifact) isSelectorEntity_Entity() {}
@@ -847,6 +1060,10 @@ func (*SelectorEntity_PullRequest) isSelectorEntity_Entity() {}
 
 func (*SelectorEntity_Generic) isSelectorEntity_Entity() {}
 
+func (*SelectorEntity_PipelineRun) isSelectorEntity_Entity() {}
+
+func (*SelectorEntity_TaskRun) isSelectorEntity_Entity() {}
+
 type PrDependencies_ContextualDependency struct {
 	state         protoimpl.MessageState                         `protogen:"open.v1"`
 	Dep           *Dependency                                    `protobuf:"bytes,1,opt,name=dep,proto3" json:"dep,omitempty"`
@@ -857,7 +1074,7 @@ type PrDependencies_ContextualDependency struct {
 
 func (x *PrDependencies_ContextualDependency) Reset() {
 	*x = PrDependencies_ContextualDependency{}
-	mi := &file_internal_proto_msgTypes[10]
+	mi := &file_internal_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -869,7 +1086,7 @@ func (x *PrDependencies_ContextualDependency) String() string {
 func (*PrDependencies_ContextualDependency) ProtoMessage() {}
 
 func (x *PrDependencies_ContextualDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[10]
+	mi := &file_internal_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1126,7 @@ type PrDependencies_ContextualDependency_FilePatch struct {
 
 func (x *PrDependencies_ContextualDependency_FilePatch) Reset() {
 	*x = PrDependencies_ContextualDependency_FilePatch{}
-	mi := &file_internal_proto_msgTypes[11]
+	mi := &file_internal_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1138,7 @@ func (x *PrDependencies_ContextualDependency_FilePatch) String() string {
 func (*PrDependencies_ContextualDependency_FilePatch) ProtoMessage() {}
 
 func (x *PrDependencies_ContextualDependency_FilePatch) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[11]
+	mi := &file_internal_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1179,7 @@ type PrContents_File struct {
 
 func (x *PrContents_File) Reset() {
 	*x = PrContents_File{}
-	mi := &file_internal_proto_msgTypes[12]
+	mi := &file_internal_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -974,7 +1191,7 @@ func (x *PrContents_File) String() string {
 func (*PrContents_File) ProtoMessage() {}
 
 func (x *PrContents_File) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[12]
+	mi := &file_internal_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1240,7 @@ type PrContents_File_Line struct {
 
 func (x *PrContents_File_Line) Reset() {
 	*x = PrContents_File_Line{}
-	mi := &file_internal_proto_msgTypes[13]
+	mi := &file_internal_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1035,7 +1252,7 @@ func (x *PrContents_File_Line) String() string {
 func (*PrContents_File_Line) ProtoMessage() {}
 
 func (x *PrContents_File_Line) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[13]
+	mi := &file_internal_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,12 +1361,32 @@ const file_internal_proto_rawDesc = "" +
 	"\bprovider\x18\x03 \x01(\v2\x1a.internal.SelectorProviderR\bprovider\x127\n" +
 	"\n" +
 	"properties\x18\x02 \x01(\v2\x17.google.protobuf.StructR\n" +
-	"properties\"^\n" +
+	"properties\"\x82\x02\n" +
+	"\x13SelectorPipelineRun\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\bprovider\x18\x02 \x01(\v2\x1a.internal.SelectorProviderR\bprovider\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12%\n" +
+	"\fis_from_fork\x18\x05 \x01(\bH\x00R\n" +
+	"isFromFork\x88\x01\x01\x127\n" +
+	"\n" +
+	"properties\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"propertiesB\x0f\n" +
+	"\r_is_from_fork\"\xf9\x01\n" +
+	"\x0fSelectorTaskRun\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\bprovider\x18\x02 \x01(\v2\x1a.internal.SelectorProviderR\bprovider\x12#\n" +
+	"\rrunner_labels\x18\x03 \x03(\tR\frunnerLabels\x12)\n" +
+	"\x0eis_self_hosted\x18\x04 \x01(\bH\x00R\fisSelfHosted\x88\x01\x01\x127\n" +
+	"\n" +
+	"properties\x18\x05 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"propertiesB\x11\n" +
+	"\x0f_is_self_hosted\"^\n" +
 	"\x0fSelectorGeneric\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
 	"\n" +
 	"properties\x18\x03 \x01(\v2\x17.google.protobuf.StructR\n" +
-	"properties\"\x8f\x03\n" +
+	"properties\"\x8b\x04\n" +
 	"\x0eSelectorEntity\x122\n" +
 	"\ventity_type\x18\x01 \x01(\x0e2\x11.minder.v1.EntityR\n" +
 	"entityType\x12\x12\n" +
@@ -1160,13 +1397,19 @@ const file_internal_proto_rawDesc = "" +
 	"repository\x128\n" +
 	"\bartifact\x18\x05 \x01(\v2\x1a.internal.SelectorArtifactH\x00R\bartifact\x12B\n" +
 	"\fpull_request\x18\x06 \x01(\v2\x1d.internal.SelectorPullRequestH\x00R\vpullRequest\x125\n" +
-	"\ageneric\x18\a \x01(\v2\x19.internal.SelectorGenericH\x00R\agenericB\b\n" +
-	"\x06entity*r\n" +
+	"\ageneric\x18\a \x01(\v2\x19.internal.SelectorGenericH\x00R\ageneric\x12B\n" +
+	"\fpipeline_run\x18\b \x01(\v2\x1d.internal.SelectorPipelineRunH\x00R\vpipelineRun\x126\n" +
+	"\btask_run\x18\t \x01(\v2\x19.internal.SelectorTaskRunH\x00R\ataskRunB\b\n" +
+	"\x06entity*\xd9\x01\n" +
 	"\fDepEcosystem\x12\x1d\n" +
 	"\x19DEP_ECOSYSTEM_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11DEP_ECOSYSTEM_NPM\x10\x01\x12\x14\n" +
 	"\x10DEP_ECOSYSTEM_GO\x10\x02\x12\x16\n" +
-	"\x12DEP_ECOSYSTEM_PYPI\x10\x03B,Z*github.com/mindersec/minder/internal/protob\x06proto3"
+	"\x12DEP_ECOSYSTEM_PYPI\x10\x03\x12\x17\n" +
+	"\x13DEP_ECOSYSTEM_MAVEN\x10\x04\x12\x17\n" +
+	"\x13DEP_ECOSYSTEM_CARGO\x10\x05\x12\x1a\n" +
+	"\x16DEP_ECOSYSTEM_RUBYGEMS\x10\x06\x12\x17\n" +
+	"\x13DEP_ECOSYSTEM_NUGET\x10\aB,Z*github.com/mindersec/minder/internal/protob\x06proto3"
 
 var (
 	file_internal_proto_rawDescOnce sync.Once
@@ -1181,7 +1424,7 @@ func file_internal_proto_rawDescGZIP() []byte {
 }
 
 var file_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_internal_proto_goTypes = []any{
 	(DepEcosystem)(0),                                     // 0: internal.DepEcosystem
 	(*Dependency)(nil),                                    // 1: internal.Dependency
@@ -1192,45 +1435,53 @@ var file_internal_proto_goTypes = []any{
 	(*SelectorRepository)(nil),                            // 6: internal.SelectorRepository
 	(*SelectorArtifact)(nil),                              // 7: internal.SelectorArtifact
 	(*SelectorPullRequest)(nil),                           // 8: internal.SelectorPullRequest
-	(*SelectorGeneric)(nil),                               // 9: internal.SelectorGeneric
-	(*SelectorEntity)(nil),                                // 10: internal.SelectorEntity
-	(*PrDependencies_ContextualDependency)(nil),           // 11: internal.PrDependencies.ContextualDependency
-	(*PrDependencies_ContextualDependency_FilePatch)(nil), // 12: internal.PrDependencies.ContextualDependency.FilePatch
-	(*PrContents_File)(nil),                               // 13: internal.PrContents.File
-	(*PrContents_File_Line)(nil),                          // 14: internal.PrContents.File.Line
-	(*v1.Context)(nil),                                    // 15: minder.v1.Context
-	(*structpb.Struct)(nil),                               // 16: google.protobuf.Struct
-	(v1.Entity)(0),                                        // 17: minder.v1.Entity
+	(*SelectorPipelineRun)(nil),                           // 9: internal.SelectorPipelineRun
+	(*SelectorTaskRun)(nil),                               // 10: internal.SelectorTaskRun
+	(*SelectorGeneric)(nil),                               // 11: internal.SelectorGeneric
+	(*SelectorEntity)(nil),                                // 12: internal.SelectorEntity
+	(*PrDependencies_ContextualDependency)(nil),           // 13: internal.PrDependencies.ContextualDependency
+	(*PrDependencies_ContextualDependency_FilePatch)(nil), // 14: internal.PrDependencies.ContextualDependency.FilePatch
+	(*PrContents_File)(nil),                               // 15: internal.PrContents.File
+	(*PrContents_File_Line)(nil),                          // 16: internal.PrContents.File.Line
+	(*v1.Context)(nil),                                    // 17: minder.v1.Context
+	(*structpb.Struct)(nil),                               // 18: google.protobuf.Struct
+	(v1.Entity)(0),                                        // 19: minder.v1.Entity
 }
 var file_internal_proto_depIdxs = []int32{
 	0,  // 0: internal.Dependency.ecosystem:type_name -> internal.DepEcosystem
-	15, // 1: internal.PullRequest.context:type_name -> minder.v1.Context
-	16, // 2: internal.PullRequest.properties:type_name -> google.protobuf.Struct
+	17, // 1: internal.PullRequest.context:type_name -> minder.v1.Context
+	18, // 2: internal.PullRequest.properties:type_name -> google.protobuf.Struct
 	2,  // 3: internal.PrDependencies.pr:type_name -> internal.PullRequest
-	11, // 4: internal.PrDependencies.deps:type_name -> internal.PrDependencies.ContextualDependency
+	13, // 4: internal.PrDependencies.deps:type_name -> internal.PrDependencies.ContextualDependency
 	2,  // 5: internal.PrContents.pr:type_name -> internal.PullRequest
-	13, // 6: internal.PrContents.files:type_name -> internal.PrContents.File
+	15, // 6: internal.PrContents.files:type_name -> internal.PrContents.File
 	5,  // 7: internal.SelectorRepository.provider:type_name -> internal.SelectorProvider
-	16, // 8: internal.SelectorRepository.properties:type_name -> google.protobuf.Struct
+	18, // 8: internal.SelectorRepository.properties:type_name -> google.protobuf.Struct
 	5,  // 9: internal.SelectorArtifact.provider:type_name -> internal.SelectorProvider
-	16, // 10: internal.SelectorArtifact.properties:type_name -> google.protobuf.Struct
+	18, // 10: internal.SelectorArtifact.properties:type_name -> google.protobuf.Struct
 	5,  // 11: internal.SelectorPullRequest.provider:type_name -> internal.SelectorProvider
-	16, // 12: internal.SelectorPullRequest.properties:type_name -> google.protobuf.Struct
-	16, // 13: internal.SelectorGeneric.properties:type_name -> google.protobuf.Struct
-	17, // 14: internal.SelectorEntity.entity_type:type_name -> minder.v1.Entity
-	5,  // 15: internal.SelectorEntity.provider:type_name -> internal.SelectorProvider
-	6,  // 16: internal.SelectorEntity.repository:type_name -> internal.SelectorRepository
-	7,  // 17: internal.SelectorEntity.artifact:type_name -> internal.SelectorArtifact
-	8,  // 18: internal.SelectorEntity.pull_request:type_name -> internal.SelectorPullRequest
-	9,  // 19: internal.SelectorEntity.generic:type_name -> internal.SelectorGeneric
-	1,  // 20: internal.PrDependencies.ContextualDependency.dep:type_name -> internal.Dependency
-	12, // 21: internal.PrDependencies.ContextualDependency.file:type_name -> internal.PrDependencies.ContextualDependency.FilePatch
-	14, // 22: internal.PrContents.File.patch_lines:type_name -> internal.PrContents.File.Line
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	18, // 12: internal.SelectorPullRequest.properties:type_name -> google.protobuf.Struct
+	5,  // 13: internal.SelectorPipelineRun.provider:type_name -> internal.SelectorProvider
+	18, // 14: internal.SelectorPipelineRun.properties:type_name -> google.protobuf.Struct
+	5,  // 15: internal.SelectorTaskRun.provider:type_name -> internal.SelectorProvider
+	18, // 16: internal.SelectorTaskRun.properties:type_name -> google.protobuf.Struct
+	18, // 17: internal.SelectorGeneric.properties:type_name -> google.protobuf.Struct
+	19, // 18: internal.SelectorEntity.entity_type:type_name -> minder.v1.Entity
+	5,  // 19: internal.SelectorEntity.provider:type_name -> internal.SelectorProvider
+	6,  // 20: internal.SelectorEntity.repository:type_name -> internal.SelectorRepository
+	7,  // 21: internal.SelectorEntity.artifact:type_name -> internal.SelectorArtifact
+	8,  // 22: internal.SelectorEntity.pull_request:type_name -> internal.SelectorPullRequest
+	11, // 23: internal.SelectorEntity.generic:type_name -> internal.SelectorGeneric
+	9,  // 24: internal.SelectorEntity.pipeline_run:type_name -> internal.SelectorPipelineRun
+	10, // 25: internal.SelectorEntity.task_run:type_name -> internal.SelectorTaskRun
+	1,  // 26: internal.PrDependencies.ContextualDependency.dep:type_name -> internal.Dependency
+	14, // 27: internal.PrDependencies.ContextualDependency.file:type_name -> internal.PrDependencies.ContextualDependency.FilePatch
+	16, // 28: internal.PrContents.File.patch_lines:type_name -> internal.PrContents.File.Line
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_init() }
@@ -1239,11 +1490,15 @@ func file_internal_proto_init() {
 		return
 	}
 	file_internal_proto_msgTypes[5].OneofWrappers = []any{}
-	file_internal_proto_msgTypes[9].OneofWrappers = []any{
+	file_internal_proto_msgTypes[8].OneofWrappers = []any{}
+	file_internal_proto_msgTypes[9].OneofWrappers = []any{}
+	file_internal_proto_msgTypes[11].OneofWrappers = []any{
 		(*SelectorEntity_Repository)(nil),
 		(*SelectorEntity_Artifact)(nil),
 		(*SelectorEntity_PullRequest)(nil),
 		(*SelectorEntity_Generic)(nil),
+		(*SelectorEntity_PipelineRun)(nil),
+		(*SelectorEntity_TaskRun)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1251,7 +1506,7 @@ func file_internal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_rawDesc), len(file_internal_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
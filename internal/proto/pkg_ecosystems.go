@@ -13,6 +13,14 @@ func (ecosystem DepEcosystem) AsString() string {
 		return "Go"
 	case DepEcosystem_DEP_ECOSYSTEM_PYPI:
 		return "PyPI"
+	case DepEcosystem_DEP_ECOSYSTEM_MAVEN:
+		return "Maven"
+	case DepEcosystem_DEP_ECOSYSTEM_CARGO:
+		return "crates.io"
+	case DepEcosystem_DEP_ECOSYSTEM_RUBYGEMS:
+		return "RubyGems"
+	case DepEcosystem_DEP_ECOSYSTEM_NUGET:
+		return "NuGet"
 	case DepEcosystem_DEP_ECOSYSTEM_UNSPECIFIED:
 		// this shouldn't happen
 		return ""
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"net/url"
+)
+
+// webhookEvents are the events we subscribe to when registering a
+// repository. The event names differ between Cloud and Server.
+var (
+	cloudWebhookEvents = []string{
+		"repo:push",
+		"pullrequest:created",
+		"pullrequest:updated",
+		"pullrequest:fulfilled",
+		"pullrequest:rejected",
+	}
+	serverWebhookEvents = []string{
+		"repo:refs_changed",
+		"pr:opened",
+		"pr:from_ref_updated",
+		"pr:merged",
+		"pr:declined",
+		"pr:deleted",
+	}
+)
+
+// repository is the deployment-agnostic view of a Bitbucket repository
+type repository struct {
+	upstreamID    string
+	owner         string
+	slug          string
+	name          string
+	defaultBranch string
+	cloneURL      string
+	isPrivate     bool
+	isFork        bool
+	isArchived    bool
+}
+
+// pullRequest is the deployment-agnostic view of a Bitbucket pull request
+type pullRequest struct {
+	number       int64
+	commitSHA    string
+	sourceBranch string
+	targetBranch string
+	// sourceOwner and sourceSlug point to the repository the changes come
+	// from, which is different from the target repository for forks.
+	sourceOwner string
+	sourceSlug  string
+	url         string
+	author      string
+}
+
+// webhook is the deployment-agnostic view of a Bitbucket webhook
+type webhook struct {
+	id  string
+	url string
+}
+
+// bitbucketAPI abstracts the differences between the Bitbucket Cloud (2.0)
+// and Bitbucket Server (1.0) REST APIs. The owner is the workspace slug for
+// Cloud and the project key for Server.
+type bitbucketAPI interface {
+	listRepositories(ctx context.Context) ([]*repository, error)
+	getRepository(ctx context.Context, owner, slug string) (*repository, error)
+	getPullRequest(ctx context.Context, owner, slug string, number int64) (*pullRequest, error)
+	listWebhooks(ctx context.Context, owner, slug string) ([]*webhook, error)
+	createWebhook(ctx context.Context, owner, slug, hookURL, secret string) (*webhook, error)
+	deleteWebhook(ctx context.Context, owner, slug, hookID string) error
+}
+
+// stripUserInfo removes the user information Bitbucket adds to clone URLs,
+// e.g. https://user@bitbucket.org/workspace/repo.git
+func stripUserInfo(cloneURL string) string {
+	u, err := url.Parse(cloneURL)
+	if err != nil {
+		return cloneURL
+	}
+	u.User = nil
+	return u.String()
+}
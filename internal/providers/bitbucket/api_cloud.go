@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// cloudAPI implements bitbucketAPI on top of the Bitbucket Cloud 2.0 API
+// https://developer.atlassian.com/cloud/bitbucket/rest/
+type cloudAPI struct {
+	cli genericRESTClient
+	// workspace optionally restricts the repositories that are listed
+	workspace string
+}
+
+var _ bitbucketAPI = (*cloudAPI)(nil)
+
+type cloudPage[T any] struct {
+	Values []T    `json:"values"`
+	Next   string `json:"next"`
+}
+
+type cloudLink struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+type cloudRepository struct {
+	UUID      string `json:"uuid"`
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	FullName  string `json:"full_name"`
+	IsPrivate bool   `json:"is_private"`
+	Parent    *struct {
+		FullName string `json:"full_name"`
+	} `json:"parent"`
+	MainBranch *struct {
+		Name string `json:"name"`
+	} `json:"mainbranch"`
+	Workspace struct {
+		Slug string `json:"slug"`
+	} `json:"workspace"`
+	Links struct {
+		Clone []cloudLink `json:"clone"`
+	} `json:"links"`
+}
+
+type cloudPullRequest struct {
+	ID     int64 `json:"id"`
+	Author struct {
+		UUID string `json:"uuid"`
+	} `json:"author"`
+	Source      cloudPullRequestRef `json:"source"`
+	Destination cloudPullRequestRef `json:"destination"`
+	Links       struct {
+		HTML cloudLink `json:"html"`
+	} `json:"links"`
+}
+
+type cloudPullRequestRef struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	Commit struct {
+		Hash string `json:"hash"`
+	} `json:"commit"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+type cloudWebhook struct {
+	UUID        string   `json:"uuid,omitempty"`
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	Active      bool     `json:"active"`
+	Secret      string   `json:"secret,omitempty"`
+	Events      []string `json:"events,omitempty"`
+}
+
+func (a *cloudAPI) listRepositories(ctx context.Context) ([]*repository, error) {
+	// Only list repositories the user can administer, as we need
+	// to be able to register webhooks on them.
+	path := "repositories?role=admin"
+	if a.workspace != "" {
+		path = fmt.Sprintf("repositories/%s?role=admin", url.PathEscape(a.workspace))
+	}
+
+	repos, err := cloudGetAll[cloudRepository](ctx, a.cli, path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*repository, 0, len(repos))
+	for _, r := range repos {
+		out = append(out, r.toRepository())
+	}
+	return out, nil
+}
+
+func (a *cloudAPI) getRepository(ctx context.Context, owner, slug string) (*repository, error) {
+	repo := &cloudRepository{}
+	if err := bbRESTGet(ctx, a.cli, cloudRepoPath(owner, slug), repo); err != nil {
+		return nil, err
+	}
+	return repo.toRepository(), nil
+}
+
+func (a *cloudAPI) getPullRequest(ctx context.Context, owner, slug string, number int64) (*pullRequest, error) {
+	pr := &cloudPullRequest{}
+	path, err := url.JoinPath(cloudRepoPath(owner, slug), "pullrequests", strconv.FormatInt(number, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for pull request: %w", err)
+	}
+	if err := bbRESTGet(ctx, a.cli, path, pr); err != nil {
+		return nil, err
+	}
+
+	srcOwner, srcSlug := owner, slug
+	if fn := pr.Source.Repository.FullName; fn != "" {
+		if o, s, ok := strings.Cut(fn, "/"); ok {
+			srcOwner, srcSlug = o, s
+		}
+	}
+
+	return &pullRequest{
+		number:       pr.ID,
+		commitSHA:    pr.Source.Commit.Hash,
+		sourceBranch: pr.Source.Branch.Name,
+		targetBranch: pr.Destination.Branch.Name,
+		sourceOwner:  srcOwner,
+		sourceSlug:   srcSlug,
+		url:          pr.Links.HTML.Href,
+		author:       pr.Author.UUID,
+	}, nil
+}
+
+func (a *cloudAPI) listWebhooks(ctx context.Context, owner, slug string) ([]*webhook, error) {
+	path, err := url.JoinPath(cloudRepoPath(owner, slug), "hooks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for hooks: %w", err)
+	}
+
+	hooks, err := cloudGetAll[cloudWebhook](ctx, a.cli, path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*webhook, 0, len(hooks))
+	for _, h := range hooks {
+		out = append(out, &webhook{id: h.UUID, url: h.URL})
+	}
+	return out, nil
+}
+
+func (a *cloudAPI) createWebhook(ctx context.Context, owner, slug, hookURL, secret string) (*webhook, error) {
+	path, err := url.JoinPath(cloudRepoPath(owner, slug), "hooks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for hooks: %w", err)
+	}
+
+	hook := &cloudWebhook{}
+	if err := bbRESTDo(ctx, a.cli, http.MethodPost, path, &cloudWebhook{
+		URL:         hookURL,
+		Description: "Minder webhook",
+		Active:      true,
+		Secret:      secret,
+		Events:      cloudWebhookEvents,
+	}, hook, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return &webhook{id: hook.UUID, url: hook.URL}, nil
+}
+
+func (a *cloudAPI) deleteWebhook(ctx context.Context, owner, slug, hookID string) error {
+	path, err := url.JoinPath(cloudRepoPath(owner, slug), "hooks", hookID)
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for hook: %w", err)
+	}
+
+	return bbRESTDo(ctx, a.cli, http.MethodDelete, path, nil, nil, http.StatusNoContent)
+}
+
+func (r *cloudRepository) toRepository() *repository {
+	var defaultBranch string
+	if r.MainBranch != nil {
+		defaultBranch = r.MainBranch.Name
+	}
+
+	var cloneURL string
+	for _, l := range r.Links.Clone {
+		if l.Name == "https" {
+			cloneURL = stripUserInfo(l.Href)
+		}
+	}
+
+	owner := r.Workspace.Slug
+	if owner == "" {
+		owner, _, _ = strings.Cut(r.FullName, "/")
+	}
+
+	return &repository{
+		upstreamID:    r.UUID,
+		owner:         owner,
+		slug:          r.Slug,
+		name:          r.Name,
+		defaultBranch: defaultBranch,
+		cloneURL:      cloneURL,
+		isPrivate:     r.IsPrivate,
+		isFork:        r.Parent != nil,
+		// Bitbucket Cloud has no notion of archived repositories
+		isArchived: false,
+	}
+}
+
+func cloudRepoPath(owner, slug string) string {
+	return "repositories/" + url.PathEscape(owner) + "/" + url.PathEscape(slug)
+}
+
+// cloudGetAll follows the `next` links of a paginated Bitbucket Cloud
+// response until all values have been fetched.
+func cloudGetAll[T any](ctx context.Context, cli genericRESTClient, path string) ([]T, error) {
+	var out []T
+	for path != "" {
+		page := &cloudPage[T]{}
+		if err := bbRESTGet(ctx, cli, path, page); err != nil {
+			return nil, err
+		}
+		out = append(out, page.Values...)
+
+		next, err := nextCloudPagePath(path, page.Next)
+		if err != nil {
+			return nil, err
+		}
+		path = next
+	}
+	return out, nil
+}
+
+// nextCloudPagePath turns the absolute `next` URL returned by Bitbucket
+// Cloud into a path relative to the configured endpoint, by re-using the
+// query of the next URL on the current path.
+func nextCloudPagePath(current, next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse next page URL: %w", err)
+	}
+
+	currentURL, err := url.Parse(current)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse current page URL: %w", err)
+	}
+
+	currentURL.RawQuery = nextURL.RawQuery
+	return currentURL.String(), nil
+}
@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// serverAPI implements bitbucketAPI on top of the Bitbucket Server and
+// Data Center REST 1.0 API
+// https://developer.atlassian.com/server/bitbucket/rest/
+type serverAPI struct {
+	cli genericRESTClient
+	// projectKey optionally restricts the repositories that are listed
+	projectKey string
+}
+
+var _ bitbucketAPI = (*serverAPI)(nil)
+
+type serverPage[T any] struct {
+	Values        []T  `json:"values"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
+
+type serverLink struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+type serverRepository struct {
+	ID       int64  `json:"id"`
+	Slug     string `json:"slug"`
+	Name     string `json:"name"`
+	Public   bool   `json:"public"`
+	Archived bool   `json:"archived"`
+	Origin   *struct {
+		ID int64 `json:"id"`
+	} `json:"origin"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+	Links struct {
+		Clone []serverLink `json:"clone"`
+	} `json:"links"`
+}
+
+type serverPullRequest struct {
+	ID     int64 `json:"id"`
+	Author struct {
+		User struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+	} `json:"author"`
+	FromRef serverPullRequestRef `json:"fromRef"`
+	ToRef   serverPullRequestRef `json:"toRef"`
+	Links   struct {
+		Self []serverLink `json:"self"`
+	} `json:"links"`
+}
+
+type serverPullRequestRef struct {
+	DisplayID    string           `json:"displayId"`
+	LatestCommit string           `json:"latestCommit"`
+	Repository   serverRepository `json:"repository"`
+}
+
+type serverWebhook struct {
+	ID            int64             `json:"id,omitempty"`
+	Name          string            `json:"name,omitempty"`
+	URL           string            `json:"url"`
+	Active        bool              `json:"active"`
+	Events        []string          `json:"events,omitempty"`
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+type serverDefaultBranch struct {
+	DisplayID string `json:"displayId"`
+}
+
+func (a *serverAPI) listRepositories(ctx context.Context) ([]*repository, error) {
+	// Only list repositories the user can administer, as we need
+	// to be able to register webhooks on them.
+	path := "repos?permission=REPO_ADMIN"
+	if a.projectKey != "" {
+		path = fmt.Sprintf("projects/%s/repos", url.PathEscape(a.projectKey))
+	}
+
+	repos, err := serverGetAll[serverRepository](ctx, a.cli, path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*repository, 0, len(repos))
+	for _, r := range repos {
+		out = append(out, r.toRepository(""))
+	}
+	return out, nil
+}
+
+func (a *serverAPI) getRepository(ctx context.Context, owner, slug string) (*repository, error) {
+	repo := &serverRepository{}
+	if err := bbRESTGet(ctx, a.cli, serverRepoPath(owner, slug), repo); err != nil {
+		return nil, err
+	}
+
+	// The default branch is not part of the repository response
+	branch := &serverDefaultBranch{}
+	path, err := url.JoinPath(serverRepoPath(owner, slug), "default-branch")
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for default branch: %w", err)
+	}
+	// An empty repository has no default branch, which is not an error
+	if err := bbRESTGet(ctx, a.cli, path, branch); err != nil && !errors.Is(err, provifv1.ErrEntityNotFound) {
+		return nil, fmt.Errorf("failed to get default branch: %w", err)
+	}
+
+	return repo.toRepository(branch.DisplayID), nil
+}
+
+func (a *serverAPI) getPullRequest(ctx context.Context, owner, slug string, number int64) (*pullRequest, error) {
+	pr := &serverPullRequest{}
+	path, err := url.JoinPath(serverRepoPath(owner, slug), "pull-requests", strconv.FormatInt(number, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for pull request: %w", err)
+	}
+	if err := bbRESTGet(ctx, a.cli, path, pr); err != nil {
+		return nil, err
+	}
+
+	var prURL string
+	if len(pr.Links.Self) > 0 {
+		prURL = pr.Links.Self[0].Href
+	}
+
+	srcOwner, srcSlug := owner, slug
+	if src := pr.FromRef.Repository; src.Slug != "" && src.Project.Key != "" {
+		srcOwner, srcSlug = src.Project.Key, src.Slug
+	}
+
+	return &pullRequest{
+		number:       pr.ID,
+		commitSHA:    pr.FromRef.LatestCommit,
+		sourceBranch: pr.FromRef.DisplayID,
+		targetBranch: pr.ToRef.DisplayID,
+		sourceOwner:  srcOwner,
+		sourceSlug:   srcSlug,
+		url:          prURL,
+		author:       strconv.FormatInt(pr.Author.User.ID, 10),
+	}, nil
+}
+
+func (a *serverAPI) listWebhooks(ctx context.Context, owner, slug string) ([]*webhook, error) {
+	path, err := url.JoinPath(serverRepoPath(owner, slug), "webhooks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhooks: %w", err)
+	}
+
+	hooks, err := serverGetAll[serverWebhook](ctx, a.cli, path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*webhook, 0, len(hooks))
+	for _, h := range hooks {
+		out = append(out, &webhook{id: strconv.FormatInt(h.ID, 10), url: h.URL})
+	}
+	return out, nil
+}
+
+func (a *serverAPI) createWebhook(ctx context.Context, owner, slug, hookURL, secret string) (*webhook, error) {
+	path, err := url.JoinPath(serverRepoPath(owner, slug), "webhooks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhooks: %w", err)
+	}
+
+	hook := &serverWebhook{}
+	if err := bbRESTDo(ctx, a.cli, http.MethodPost, path, &serverWebhook{
+		Name:   "Minder webhook",
+		URL:    hookURL,
+		Active: true,
+		Events: serverWebhookEvents,
+		Configuration: map[string]string{
+			"secret": secret,
+		},
+	}, hook, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return &webhook{id: strconv.FormatInt(hook.ID, 10), url: hook.URL}, nil
+}
+
+func (a *serverAPI) deleteWebhook(ctx context.Context, owner, slug, hookID string) error {
+	path, err := url.JoinPath(serverRepoPath(owner, slug), "webhooks", hookID)
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for webhook: %w", err)
+	}
+
+	return bbRESTDo(ctx, a.cli, http.MethodDelete, path, nil, nil, http.StatusNoContent)
+}
+
+func (r *serverRepository) toRepository(defaultBranch string) *repository {
+	var cloneURL string
+	for _, l := range r.Links.Clone {
+		if l.Name == "http" {
+			cloneURL = stripUserInfo(l.Href)
+		}
+	}
+
+	return &repository{
+		upstreamID:    strconv.FormatInt(r.ID, 10),
+		owner:         r.Project.Key,
+		slug:          r.Slug,
+		name:          r.Name,
+		defaultBranch: defaultBranch,
+		cloneURL:      cloneURL,
+		isPrivate:     !r.Public,
+		isFork:        r.Origin != nil,
+		isArchived:    r.Archived,
+	}
+}
+
+func serverRepoPath(owner, slug string) string {
+	return "projects/" + url.PathEscape(owner) + "/repos/" + url.PathEscape(slug)
+}
+
+// serverGetAll follows the `nextPageStart` markers of a paginated
+// Bitbucket Server response until all values have been fetched.
+func serverGetAll[T any](ctx context.Context, cli genericRESTClient, path string) ([]T, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	var out []T
+	for {
+		page := &serverPage[T]{}
+		if err := bbRESTGet(ctx, cli, u.String(), page); err != nil {
+			return nil, err
+		}
+		out = append(out, page.Values...)
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			return out, nil
+		}
+
+		q := u.Query()
+		q.Set("start", strconv.Itoa(page.NextPageStart))
+		u.RawQuery = q.Encode()
+	}
+}
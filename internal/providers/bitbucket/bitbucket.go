@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bitbucket provides the Bitbucket Cloud and Bitbucket Server provider implementation
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Class is the string that represents the Bitbucket provider class
+const Class = "bitbucket"
+
+const (
+	// DeploymentCloud is the deployment type for Bitbucket Cloud (bitbucket.org)
+	DeploymentCloud = "cloud"
+	// DeploymentServer is the deployment type for Bitbucket Server and Data Center
+	DeploymentServer = "server"
+
+	// defaultCloudEndpoint is the API endpoint for Bitbucket Cloud
+	defaultCloudEndpoint = "https://api.bitbucket.org/2.0/"
+)
+
+// Implements is the list of provider types that the Bitbucket provider implements
+var Implements = []db.ProviderType{
+	db.ProviderTypeGit,
+	db.ProviderTypeRest,
+	db.ProviderTypeRepoLister,
+}
+
+// AuthorizationFlows is the list of authorization flows that the Bitbucket provider supports
+var AuthorizationFlows = []db.AuthorizationFlow{
+	db.AuthorizationFlowUserInput,
+	db.AuthorizationFlowOauth2AuthorizationCodeFlow,
+}
+
+// Ensure that the Bitbucket provider implements the right interfaces
+var _ provifv1.Git = (*bitbucketClient)(nil)
+var _ provifv1.REST = (*bitbucketClient)(nil)
+var _ provifv1.RepoLister = (*bitbucketClient)(nil)
+
+type bitbucketClient struct {
+	cred       provifv1.BitbucketCredential
+	cli        *http.Client
+	bbcfg      *minderv1.BitbucketProviderConfig
+	webhookURL string
+	gitConfig  config.GitConfig
+
+	// api hides the differences between the Bitbucket Cloud
+	// and Bitbucket Server REST APIs.
+	api bitbucketAPI
+
+	// secret for the webhook. This is stored in the
+	// structure to allow efficient fetching.
+	currentWebhookSecret string
+}
+
+// New creates a new Bitbucket provider
+// Note that the webhook URL should already contain the provider class in the path
+func New(
+	cred provifv1.BitbucketCredential,
+	cfg *minderv1.BitbucketProviderConfig,
+	webhookURL string,
+	currentWebhookSecret string,
+) (*bitbucketClient, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid bitbucket config: %w", err)
+	}
+
+	if cfg.Deployment == "" {
+		cfg.Deployment = DeploymentCloud
+	}
+
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = defaultCloudEndpoint
+	}
+
+	if webhookURL == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+
+	// TODO: We need a context here.
+	cli := oauth2.NewClient(context.Background(), cred.GetAsOAuth2TokenSource())
+
+	c := &bitbucketClient{
+		cred:                 cred,
+		cli:                  cli,
+		bbcfg:                cfg,
+		webhookURL:           webhookURL,
+		currentWebhookSecret: currentWebhookSecret,
+		// TODO: Add git config
+	}
+
+	if cfg.Deployment == DeploymentServer {
+		c.api = &serverAPI{cli: c, projectKey: cfg.Workspace}
+	} else {
+		c.api = &cloudAPI{cli: c, workspace: cfg.Workspace}
+	}
+
+	return c, nil
+}
+
+type bbConfigWrapper struct {
+	Bitbucket *minderv1.BitbucketProviderConfig `json:"bitbucket" yaml:"bitbucket" mapstructure:"bitbucket" validate:"required"`
+}
+
+// ParseV1Config parses the raw configuration into a BitbucketProviderConfig
+func ParseV1Config(rawCfg json.RawMessage) (*minderv1.BitbucketProviderConfig, error) {
+	var cfg bbConfigWrapper
+	if err := json.Unmarshal(rawCfg, &cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.Bitbucket == nil {
+		// Return a default but working config
+		return &minderv1.BitbucketProviderConfig{}, nil
+	}
+
+	return cfg.Bitbucket, nil
+}
+
+// MarshalV1Config marshals and validates the given config
+// so it can safely be stored in the database
+func MarshalV1Config(rawCfg json.RawMessage) (json.RawMessage, error) {
+	var w bbConfigWrapper
+	if err := json.Unmarshal(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	if err := w.Bitbucket.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating bitbucket config: %w", err)
+	}
+
+	return json.Marshal(w)
+}
+
+// CanImplement returns true if the provider can implement the given trait
+func (*bitbucketClient) CanImplement(trait minderv1.ProviderType) bool {
+	return trait == minderv1.ProviderType_PROVIDER_TYPE_GIT ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REST ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REPO_LISTER
+}
+
+func (c *bitbucketClient) GetCredential() provifv1.BitbucketCredential {
+	return c.cred
+}
+
+// SupportsEntity implements the Provider interface
+func (*bitbucketClient) SupportsEntity(entType minderv1.Entity) bool {
+	return entType == minderv1.Entity_ENTITY_REPOSITORIES ||
+		entType == minderv1.Entity_ENTITY_PULL_REQUESTS
+}
+
+// CreationOptions implements the Provider interface
+func (c *bitbucketClient) CreationOptions(entType minderv1.Entity) *provifv1.EntityCreationOptions {
+	if !c.SupportsEntity(entType) {
+		return nil
+	}
+
+	// Repositories need webhook registration and trigger policy evaluation
+	if entType == minderv1.Entity_ENTITY_REPOSITORIES {
+		return &provifv1.EntityCreationOptions{
+			RegisterWithProvider:       true,
+			PublishReconciliationEvent: true,
+		}
+	}
+
+	// Pull requests are originated by repositories and don't need registration
+	return &provifv1.EntityCreationOptions{
+		RegisterWithProvider:       false,
+		PublishReconciliationEvent: false,
+	}
+}
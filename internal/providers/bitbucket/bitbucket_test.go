@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	testWebhookURL = "https://minder.example.com/api/v1/webhook/bitbucket"
+	testToken      = "test-token"
+)
+
+// newTestClient creates a bitbucket client talking to the given handler,
+// which stands in for the Bitbucket Cloud or Server API.
+func newTestClient(t *testing.T, deployment string, handler http.Handler) *bitbucketClient {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every request must be authenticated with the token
+		assert.Equal(t, "Bearer "+testToken, r.Header.Get("Authorization"))
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := New(
+		credentials.NewBitbucketTokenCredential(testToken),
+		&minderv1.BitbucketProviderConfig{
+			Endpoint:   srv.URL,
+			Deployment: deployment,
+		},
+		testWebhookURL,
+		"test-secret",
+	)
+	require.NoError(t, err)
+
+	return cli
+}
+
+// writeJSON is a helper for the fake Bitbucket handlers
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	assert.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		cfg          *minderv1.BitbucketProviderConfig
+		webhookURL   string
+		wantEndpoint string
+		wantServer   bool
+		wantErr      string
+	}{
+		{
+			name:         "defaults to bitbucket cloud",
+			cfg:          &minderv1.BitbucketProviderConfig{},
+			webhookURL:   testWebhookURL,
+			wantEndpoint: defaultCloudEndpoint,
+		},
+		{
+			name: "bitbucket server",
+			cfg: &minderv1.BitbucketProviderConfig{
+				Endpoint:   "https://bitbucket.example.com/rest/api/1.0",
+				Deployment: DeploymentServer,
+			},
+			webhookURL:   testWebhookURL,
+			wantEndpoint: "https://bitbucket.example.com/rest/api/1.0",
+			wantServer:   true,
+		},
+		{
+			name: "bitbucket server requires an endpoint",
+			cfg: &minderv1.BitbucketProviderConfig{
+				Deployment: DeploymentServer,
+			},
+			webhookURL: testWebhookURL,
+			wantErr:    "endpoint is required",
+		},
+		{
+			name: "unknown deployment",
+			cfg: &minderv1.BitbucketProviderConfig{
+				Deployment: "datacenter",
+			},
+			webhookURL: testWebhookURL,
+			wantErr:    "unknown deployment",
+		},
+		{
+			name:       "missing webhook URL",
+			cfg:        &minderv1.BitbucketProviderConfig{},
+			webhookURL: "",
+			wantErr:    "webhook URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := New(credentials.NewBitbucketTokenCredential(testToken), tt.cfg, tt.webhookURL, "secret")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEndpoint, cli.GetBaseURL())
+			_, isServer := cli.api.(*serverAPI)
+			assert.Equal(t, tt.wantServer, isServer)
+		})
+	}
+}
+
+func TestParseAndMarshalV1Config(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseV1Config(json.RawMessage(`{}`))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.GetEndpoint())
+
+	cfg, err = ParseV1Config(json.RawMessage(`{"bitbucket": {"deployment": "server", "endpoint": "https://bb.example.com"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, DeploymentServer, cfg.GetDeployment())
+	assert.Equal(t, "https://bb.example.com", cfg.GetEndpoint())
+
+	out, err := MarshalV1Config(json.RawMessage(`{"bitbucket": {"workspace": "minder"}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"bitbucket": {"workspace": "minder"}}`, string(out))
+
+	_, err = MarshalV1Config(json.RawMessage(`{"bitbucket": {"deployment": "server"}}`))
+	assert.ErrorContains(t, err, "endpoint is required")
+}
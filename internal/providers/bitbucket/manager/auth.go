@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/bitbucket"
+
+	"github.com/mindersec/minder/internal/db"
+	m "github.com/mindersec/minder/internal/providers/manager"
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// NewOAuthConfig implements the providerClassOAuthManager interface
+func (p *providerClassManager) NewOAuthConfig(_ db.ProviderClass, cli bool) (*oauth2.Config, error) {
+	oauthClientConfig := &p.bbpcfg.OAuthClientConfig
+
+	endpoint, err := getOAuthEndpoint(p.bbpcfg.ServerURL)
+	if err != nil {
+		return nil, err
+	}
+	oauthConfig := getOauthConfig(oauthClientConfig.RedirectURI, cli, p.bbpcfg.Scopes, endpoint)
+
+	clientId, err := oauthClientConfig.GetClientID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client ID: %w", err)
+	}
+
+	clientSecret, err := oauthClientConfig.GetClientSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client secret: %w", err)
+	}
+
+	// this is currently only used for testing
+	if oauthClientConfig.Endpoint != nil && oauthClientConfig.Endpoint.TokenURL != "" {
+		oauthConfig.Endpoint = oauth2.Endpoint{
+			TokenURL: oauthClientConfig.Endpoint.TokenURL,
+		}
+	}
+
+	oauthConfig.ClientID = clientId
+	oauthConfig.ClientSecret = clientSecret
+	return oauthConfig, nil
+}
+
+// ValidateCredentials implements the providerClassOAuthManager interface
+func (*providerClassManager) ValidateCredentials(
+	_ context.Context, cred provv1.Credential, _ *m.CredentialVerifyParams,
+) error {
+	switch c := cred.(type) {
+	case provv1.OAuth2TokenCredential:
+		_, err := c.GetAsOAuth2TokenSource().Token()
+		if err != nil {
+			return fmt.Errorf("cannot get token from credential: %w", err)
+		}
+	case string:
+		// Bitbucket access tokens have no well-known prefix
+		if c == "" {
+			return errors.New("token is empty")
+		}
+	default:
+		return fmt.Errorf("invalid credential type: %T", cred)
+	}
+
+	return nil
+}
+
+// getOAuthEndpoint returns the OAuth endpoint of Bitbucket Cloud, or the
+// endpoint of the Bitbucket Server instance at serverURL if it is set.
+func getOAuthEndpoint(serverURL string) (oauth2.Endpoint, error) {
+	if serverURL == "" {
+		return bitbucket.Endpoint, nil
+	}
+
+	authURL, err := url.JoinPath(serverURL, "rest/oauth2/latest/authorize")
+	if err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("invalid bitbucket server URL: %w", err)
+	}
+
+	tokenURL, err := url.JoinPath(serverURL, "rest/oauth2/latest/token")
+	if err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("invalid bitbucket server URL: %w", err)
+	}
+
+	return oauth2.Endpoint{
+		AuthURL:  authURL,
+		TokenURL: tokenURL,
+	}, nil
+}
+
+func getOauthConfig(redirectUrlBase string, cli bool, scopes []string, endpoint oauth2.Endpoint) *oauth2.Config {
+	var redirectUrl string
+
+	if cli {
+		redirectUrl = fmt.Sprintf("%s/cli", redirectUrlBase)
+	} else {
+		redirectUrl = fmt.Sprintf("%s/web", redirectUrlBase)
+	}
+
+	return &oauth2.Config{
+		RedirectURL: redirectUrl,
+		Scopes:      scopes,
+		Endpoint:    endpoint,
+	}
+}
@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manager contains the BitbucketProviderClassManager
+package manager
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/providers/bitbucket"
+	"github.com/mindersec/minder/internal/providers/bitbucket/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func Test_tokenNeedsRefresh(t *testing.T) {
+	t.Parallel()
+
+	baseTime := time.Now()
+
+	tests := []struct {
+		name  string
+		token oauth2.Token
+		want  bool
+	}{
+		{
+			name:  "token is expired",
+			token: accessTokenWithExpiration(baseTime.Add(-1 * time.Minute)),
+			want:  true,
+		},
+		{
+			name:  "token is not expired and does not need refresh",
+			token: accessTokenWithExpiration(baseTime.Add(15 * time.Minute)),
+			want:  false,
+		},
+		{
+			name:  "token is not expired but needs refresh",
+			token: accessTokenWithExpiration(baseTime.Add(5 * time.Minute)),
+			want:  true,
+		},
+		{
+			name: "token is not valid",
+			token: oauth2.Token{
+				AccessToken:  "",
+				RefreshToken: "refresh",
+				Expiry:       baseTime.Add(15 * time.Minute),
+			},
+			want: true,
+		},
+		{
+			name: "stored an access token without refresh token",
+			token: oauth2.Token{
+				AccessToken: "notarealtoken",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			needsRefresh := tokenNeedsRefresh(tt.token)
+			assert.Equal(t, tt.want, needsRefresh)
+		})
+	}
+}
+
+func accessTokenWithExpiration(exp time.Time) oauth2.Token {
+	return oauth2.Token{
+		AccessToken:  "ozz-likes-beer",
+		RefreshToken: "ozz-likes-more-beer",
+		Expiry:       exp,
+	}
+}
+
+const (
+	testSecret   = "current-secret"
+	testHookUUID = "3b4b5a2e-7e9b-4a3f-9c2d-1d2e3f4a5b6c"
+)
+
+const cloudPushPayload = `{
+	"repository": {"uuid": "{1234}", "full_name": "mindersec/minder"}
+}`
+
+const cloudPullRequestPayload = `{
+	"pullrequest": {"id": 7},
+	"repository": {"uuid": "{1234}", "full_name": "mindersec/minder"}
+}`
+
+const serverRefsChangedPayload = `{
+	"repository": {"id": 42, "slug": "minder", "project": {"key": "SEC"}}
+}`
+
+const serverPullRequestPayload = `{
+	"pullRequest": {
+		"id": 3,
+		"toRef": {"repository": {"id": 42, "slug": "minder", "project": {"key": "SEC"}}}
+	}
+}`
+
+func TestWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		event      string
+		payload    string
+		secret     string
+		noSig      bool
+		wantStatus int
+		wantTopic  string
+		wantEntity minderv1.Entity
+		wantProps  map[string]any
+	}{
+		{
+			name:       "cloud push refreshes the repository",
+			event:      cloudEventRepoPush,
+			payload:    cloudPushPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+			wantProps: map[string]any{
+				properties.PropertyUpstreamID: "{1234}",
+				bitbucket.RepoPropertyOwner:   "mindersec",
+				bitbucket.RepoPropertySlug:    "minder",
+			},
+		},
+		{
+			name:       "previous secrets are accepted",
+			event:      cloudEventRepoPush,
+			payload:    cloudPushPayload,
+			secret:     "previous-secret",
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+		},
+		{
+			name:       "cloud pull request created",
+			event:      cloudEventPullRequestCreated,
+			payload:    cloudPullRequestPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueOriginatingEntityAdd,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			wantProps: map[string]any{
+				properties.PropertyUpstreamID: "{1234}/7",
+				bitbucket.RepoPropertyOwner:   "mindersec",
+				bitbucket.RepoPropertySlug:    "minder",
+			},
+		},
+		{
+			name:       "cloud pull request updated",
+			event:      cloudEventPullRequestUpdated,
+			payload:    cloudPullRequestPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:       "cloud pull request merged",
+			event:      cloudEventPullRequestFulfilled,
+			payload:    cloudPullRequestPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueOriginatingEntityDelete,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:       "server refs changed",
+			event:      serverEventRefsChanged,
+			payload:    serverRefsChangedPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+			wantProps: map[string]any{
+				properties.PropertyUpstreamID: "42",
+				bitbucket.RepoPropertyOwner:   "SEC",
+				bitbucket.RepoPropertySlug:    "minder",
+			},
+		},
+		{
+			name:       "server pull request opened",
+			event:      serverEventPullRequestOpened,
+			payload:    serverPullRequestPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueOriginatingEntityAdd,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			wantProps: map[string]any{
+				properties.PropertyUpstreamID: "42/3",
+			},
+		},
+		{
+			name:       "server pull request declined",
+			event:      serverEventPullRequestDeclined,
+			payload:    serverPullRequestPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueOriginatingEntityDelete,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:       "server ping is accepted unsigned",
+			event:      serverEventPing,
+			noSig:      true,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unhandled events are ignored",
+			event:      "repo:fork",
+			payload:    cloudPushPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing signature",
+			event:      cloudEventRepoPush,
+			payload:    cloudPushPayload,
+			noSig:      true,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid signature",
+			event:      cloudEventRepoPush,
+			payload:    cloudPushPayload,
+			secret:     "wrong-secret",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing event key",
+			payload:    cloudPushPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid payload",
+			event:      cloudEventRepoPush,
+			payload:    `{"repository": {}}`,
+			secret:     testSecret,
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pub := &stubs.StubEventer{}
+			m := &providerClassManager{
+				parentContext:          context.Background(),
+				pub:                    pub,
+				currentWebhookSecret:   testSecret,
+				previousWebhookSecrets: []string{"previous-secret"},
+			}
+
+			req := httptest.NewRequest(http.MethodPost,
+				"/api/v1/webhook/bitbucket/"+testHookUUID, bytes.NewBufferString(tt.payload))
+			if tt.event != "" {
+				req.Header.Set(eventKeyHeader, tt.event)
+			}
+			if !tt.noSig {
+				sec, err := webhooksecret.New(tt.secret, testHookUUID)
+				require.NoError(t, err)
+				req.Header.Set(signatureHeader, webhooksecret.Sign(sec, []byte(tt.payload)))
+			}
+
+			rec := httptest.NewRecorder()
+			m.GetWebhookHandler().ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantTopic == "" {
+				assert.Empty(t, pub.Sent)
+				return
+			}
+
+			require.Len(t, pub.Sent, 1)
+			assert.Equal(t, []string{tt.wantTopic}, pub.Topics)
+
+			msg, err := entmsg.ToEntityRefreshAndDo(pub.Sent[0])
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEntity, msg.Entity.Type)
+			assert.Equal(t, bitbucket.Class, msg.Hint.ProviderClassHint)
+			for k, v := range tt.wantProps {
+				assert.Equal(t, v, msg.Entity.GetByProps[k], "property %s", k)
+			}
+			if tt.wantEntity == minderv1.Entity_ENTITY_PULL_REQUESTS {
+				assert.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, msg.Originator.Type)
+				props := properties.NewProperties(msg.Entity.GetByProps)
+				assert.NotZero(t, props.GetProperty(bitbucket.PullRequestNumber).GetInt64())
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/bitbucket/webhooksecret"
+)
+
+const (
+	// MaxBytesLimit is the maximum number of bytes to read from the response body
+	// We limit to 1MB to prevent abuse
+	MaxBytesLimit int64 = 1 << 20
+
+	// eventKeyHeader is the header Bitbucket uses to convey the event type
+	eventKeyHeader = "X-Event-Key"
+	// signatureHeader is the header Bitbucket uses to sign the payload
+	signatureHeader = "X-Hub-Signature"
+)
+
+// Event types sent by Bitbucket Cloud
+const (
+	cloudEventRepoPush             = "repo:push"
+	cloudEventPullRequestCreated   = "pullrequest:created"
+	cloudEventPullRequestUpdated   = "pullrequest:updated"
+	cloudEventPullRequestFulfilled = "pullrequest:fulfilled"
+	cloudEventPullRequestRejected  = "pullrequest:rejected"
+)
+
+// Event types sent by Bitbucket Server
+const (
+	serverEventRefsChanged          = "repo:refs_changed"
+	serverEventPullRequestOpened    = "pr:opened"
+	serverEventPullRequestRefUpdate = "pr:from_ref_updated"
+	serverEventPullRequestMerged    = "pr:merged"
+	serverEventPullRequestDeclined  = "pr:declined"
+	serverEventPullRequestDeleted   = "pr:deleted"
+	serverEventPing                 = "diagnostics:ping"
+)
+
+// GetWebhookHandler implements the ProviderManager interface
+// Note that this is where the whole webhook handler is defined and
+// will live.
+func (m *providerClassManager) GetWebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := zerolog.Ctx(m.parentContext).With().
+			Str("webhook", "bitbucket").
+			Str("method", r.Method).
+			Str("path", r.URL.Path).
+			Str("remote", r.RemoteAddr).
+			Str("user-agent", r.UserAgent()).
+			Str("content-type", r.Header.Get("Content-Type")).
+			Logger()
+
+		eventType := r.Header.Get(eventKeyHeader)
+		if eventType == "" {
+			l.Error().Msg("missing X-Event-Key header")
+			http.Error(w, "missing X-Event-Key header", http.StatusBadRequest)
+			return
+		}
+
+		l = l.With().Str("event", eventType).Logger()
+
+		// Bitbucket Server sends an unsigned ping when testing the connection
+		if eventType == serverEventPing {
+			l.Debug().Msg("received ping event")
+			return
+		}
+
+		// The signature covers the whole payload, so we need to read
+		// it before validating the request.
+		payload, err := io.ReadAll(wrapSafe(r.Body))
+		if err != nil {
+			l.Error().Err(err).Msg("error reading webhook payload")
+			http.Error(w, "error reading webhook payload", http.StatusBadRequest)
+			return
+		}
+
+		// Validate the webhook signature
+		if err := m.validateRequest(r, payload); err != nil {
+			l.Error().Err(err).Msg("invalid webhook request")
+			http.Error(w, "invalid webhook request", http.StatusUnauthorized)
+			return
+		}
+
+		disp := m.getWebhookEventDispatcher(eventType)
+
+		if err := disp(l, eventType, payload); err != nil {
+			l.Error().Err(err).Msg("error handling webhook event")
+			http.Error(w, "error handling webhook event", http.StatusInternalServerError)
+			return
+		}
+
+		l.Debug().Msg("processed webhook event successfully")
+	})
+}
+
+// getWebhookEventDispatcher returns the appropriate webhook event dispatcher for the given event type
+// It returns a function that is meant to do the actual handling of the event.
+func (m *providerClassManager) getWebhookEventDispatcher(
+	eventType string,
+) func(l zerolog.Logger, eventType string, payload []byte) error {
+	switch eventType {
+	case cloudEventRepoPush:
+		return m.handleCloudRepoPush
+	case serverEventRefsChanged:
+		return m.handleServerRefsChanged
+	case cloudEventPullRequestCreated, cloudEventPullRequestUpdated,
+		cloudEventPullRequestFulfilled, cloudEventPullRequestRejected:
+		return m.handleCloudPullRequest
+	case serverEventPullRequestOpened, serverEventPullRequestRefUpdate,
+		serverEventPullRequestMerged, serverEventPullRequestDeclined, serverEventPullRequestDeleted:
+		return m.handleServerPullRequest
+	default:
+		return m.handleNoop
+	}
+}
+
+// handleNoop is a no-op handler for unhandled webhook events
+func (*providerClassManager) handleNoop(l zerolog.Logger, _ string, _ []byte) error {
+	l.Debug().Msg("unhandled webhook event")
+	return nil
+}
+
+func (m *providerClassManager) validateRequest(r *http.Request, payload []byte) error {
+	signature := r.Header.Get(signatureHeader)
+	if signature == "" {
+		return errors.New("missing X-Hub-Signature header")
+	}
+
+	if err := m.validateSignature(signature, payload, r); err != nil {
+		return fmt.Errorf("invalid X-Hub-Signature header: %w", err)
+	}
+
+	return nil
+}
+
+// validateSignature validates the signature of the incoming Bitbucket webhook.
+// The secret of each webhook is derived from the configured secret and the
+// last element of the path of the webhook URL (which is unique per entity).
+func (m *providerClassManager) validateSignature(signature string, payload []byte, req *http.Request) error {
+	// Extract the unique ID from the URL path
+	path := req.URL.Path
+	uniq := path[strings.LastIndex(path, "/")+1:]
+
+	// uniq must be a valid UUID
+	_, err := uuid.Parse(uniq)
+	if err != nil {
+		return errors.New("invalid unique ID")
+	}
+
+	if valid := webhooksecret.Verify(m.currentWebhookSecret, uniq, payload, signature); valid {
+		// If the signature is valid, we can return
+		return nil
+	}
+
+	// Check the previous secrets
+	for _, prev := range m.previousWebhookSecrets {
+		if valid := webhooksecret.Verify(prev, uniq, payload, signature); valid {
+			return nil
+		}
+	}
+
+	return errors.New("invalid webhook signature")
+}
+
+// wrapSafe wraps the io.Reader in a LimitReader to prevent abuse
+func wrapSafe(r io.Reader) io.Reader {
+	return io.LimitReader(r, MaxBytesLimit)
+}
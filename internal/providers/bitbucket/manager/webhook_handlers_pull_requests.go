@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/bitbucket"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func (m *providerClassManager) handleCloudPullRequest(l zerolog.Logger, eventType string, payload []byte) error {
+	l.Debug().Msg("handling pull request event")
+
+	prEvent := struct {
+		PullRequest struct {
+			ID int64 `json:"id"`
+		} `json:"pullrequest"`
+		Repository cloudRepository `json:"repository"`
+	}{}
+	if err := json.Unmarshal(payload, &prEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding pull request event")
+		return fmt.Errorf("error decoding pull request event: %w", err)
+	}
+
+	if prEvent.PullRequest.ID == 0 {
+		return fmt.Errorf("pull request event missing ID")
+	}
+
+	repoProps, err := prEvent.Repository.identifyingProperties()
+	if err != nil {
+		return fmt.Errorf("invalid pull request event: %w", err)
+	}
+
+	var topic string
+	switch eventType {
+	case cloudEventPullRequestCreated:
+		topic = constants.TopicQueueOriginatingEntityAdd
+	case cloudEventPullRequestFulfilled, cloudEventPullRequestRejected:
+		topic = constants.TopicQueueOriginatingEntityDelete
+	default:
+		topic = constants.TopicQueueRefreshEntityAndEvaluate
+	}
+
+	return m.publishPullRequestMessage(prEvent.PullRequest.ID, repoProps, topic)
+}
+
+func (m *providerClassManager) handleServerPullRequest(l zerolog.Logger, eventType string, payload []byte) error {
+	l.Debug().Msg("handling pull request event")
+
+	prEvent := struct {
+		PullRequest struct {
+			ID    int64 `json:"id"`
+			ToRef struct {
+				Repository serverRepository `json:"repository"`
+			} `json:"toRef"`
+		} `json:"pullRequest"`
+	}{}
+	if err := json.Unmarshal(payload, &prEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding pull request event")
+		return fmt.Errorf("error decoding pull request event: %w", err)
+	}
+
+	if prEvent.PullRequest.ID == 0 {
+		return fmt.Errorf("pull request event missing ID")
+	}
+
+	repoProps, err := prEvent.PullRequest.ToRef.Repository.identifyingProperties()
+	if err != nil {
+		return fmt.Errorf("invalid pull request event: %w", err)
+	}
+
+	var topic string
+	switch eventType {
+	case serverEventPullRequestOpened:
+		topic = constants.TopicQueueOriginatingEntityAdd
+	case serverEventPullRequestMerged, serverEventPullRequestDeclined, serverEventPullRequestDeleted:
+		topic = constants.TopicQueueOriginatingEntityDelete
+	default:
+		topic = constants.TopicQueueRefreshEntityAndEvaluate
+	}
+
+	return m.publishPullRequestMessage(prEvent.PullRequest.ID, repoProps, topic)
+}
+
+func (m *providerClassManager) publishPullRequestMessage(
+	number int64, repoIdentifyingProps *properties.Properties, queueTopic string) error {
+	repoUpstreamID := repoIdentifyingProps.GetProperty(properties.PropertyUpstreamID).GetString()
+
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: bitbucket.FormatPullRequestUpstreamID(repoUpstreamID, number),
+		bitbucket.PullRequestNumber:   number,
+		bitbucket.RepoPropertyOwner:   repoIdentifyingProps.GetProperty(bitbucket.RepoPropertyOwner).GetString(),
+		bitbucket.RepoPropertySlug:    repoIdentifyingProps.GetProperty(bitbucket.RepoPropertySlug).GetString(),
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_PULL_REQUESTS, identifyingProps)
+	outm.WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, repoIdentifyingProps)
+	outm.WithProviderClassHint(bitbucket.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	if err := m.pub.Publish(queueTopic, msg); err != nil {
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/bitbucket"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// cloudRepository is the repository as sent in Bitbucket Cloud webhook payloads
+type cloudRepository struct {
+	UUID     string `json:"uuid"`
+	FullName string `json:"full_name"`
+}
+
+// serverRepository is the repository as sent in Bitbucket Server webhook payloads
+type serverRepository struct {
+	ID      int64  `json:"id"`
+	Slug    string `json:"slug"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+}
+
+// identifyingProperties returns the properties used to look up the
+// repository. We include the owner and slug because, unlike the upstream
+// ID, they can be used to address the repository in the Bitbucket API.
+func (r *cloudRepository) identifyingProperties() (*properties.Properties, error) {
+	owner, slug, ok := strings.Cut(r.FullName, "/")
+	if r.UUID == "" || !ok {
+		return nil, fmt.Errorf("repository is missing uuid or full name")
+	}
+
+	return repoIdentifyingProperties(r.UUID, owner, slug), nil
+}
+
+func (r *serverRepository) identifyingProperties() (*properties.Properties, error) {
+	if r.ID == 0 || r.Slug == "" || r.Project.Key == "" {
+		return nil, fmt.Errorf("repository is missing id, slug or project key")
+	}
+
+	return repoIdentifyingProperties(strconv.FormatInt(r.ID, 10), r.Project.Key, r.Slug), nil
+}
+
+func repoIdentifyingProperties(upstreamID, owner, slug string) *properties.Properties {
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: upstreamID,
+		bitbucket.RepoPropertyOwner:   owner,
+		bitbucket.RepoPropertySlug:    slug,
+	})
+}
+
+func (m *providerClassManager) handleCloudRepoPush(l zerolog.Logger, _ string, payload []byte) error {
+	l.Debug().Msg("handling push event")
+
+	pushEvent := struct {
+		Repository cloudRepository `json:"repository"`
+	}{}
+	if err := json.Unmarshal(payload, &pushEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding push event")
+		return fmt.Errorf("error decoding push event: %w", err)
+	}
+
+	props, err := pushEvent.Repository.identifyingProperties()
+	if err != nil {
+		l.Error().Err(err).Msg("invalid push event")
+		return fmt.Errorf("invalid push event: %w", err)
+	}
+
+	return m.publishRefreshAndEvalForRepository(l, props)
+}
+
+func (m *providerClassManager) handleServerRefsChanged(l zerolog.Logger, _ string, payload []byte) error {
+	l.Debug().Msg("handling refs changed event")
+
+	refsEvent := struct {
+		Repository serverRepository `json:"repository"`
+	}{}
+	if err := json.Unmarshal(payload, &refsEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding refs changed event")
+		return fmt.Errorf("error decoding refs changed event: %w", err)
+	}
+
+	props, err := refsEvent.Repository.identifyingProperties()
+	if err != nil {
+		l.Error().Err(err).Msg("invalid refs changed event")
+		return fmt.Errorf("invalid refs changed event: %w", err)
+	}
+
+	return m.publishRefreshAndEvalForRepository(l, props)
+}
+
+func (m *providerClassManager) publishRefreshAndEvalForRepository(
+	l zerolog.Logger, identifyingProps *properties.Properties) error {
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, identifyingProps)
+	outm.WithProviderClassHint(bitbucket.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		l.Error().Err(err).Msg("error converting message to protobuf")
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	l.Debug().Str("msg_id", msgID).Msg("publishing refresh and eval message")
+	if err := m.pub.Publish(constants.TopicQueueRefreshEntityAndEvaluate, msg); err != nil {
+		l.Error().Err(err).Msg("error publishing refresh and eval message")
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// Repository Properties
+const (
+	// RepoPropertyOwner represents the bitbucket workspace (Cloud) or project key (Server)
+	RepoPropertyOwner = "bitbucket/owner"
+	// RepoPropertySlug represents the bitbucket repository slug
+	RepoPropertySlug = "bitbucket/repo_slug"
+	// RepoPropertyName represents the bitbucket repository display name
+	RepoPropertyName = "bitbucket/repo_name"
+	// RepoPropertyDefaultBranch represents the bitbucket default branch
+	RepoPropertyDefaultBranch = "bitbucket/default_branch"
+	// RepoPropertyCloneURL represents the bitbucket repo clone URL
+	RepoPropertyCloneURL = "bitbucket/clone_url"
+	// RepoPropertyHookID represents the bitbucket repo hook ID
+	RepoPropertyHookID = "bitbucket/hook_id"
+	// RepoPropertyHookURL represents the bitbucket repo hook URL
+	RepoPropertyHookURL = "bitbucket/hook_url"
+)
+
+// Pull Request Properties
+const (
+	// PullRequestNumber represents the bitbucket pull request number
+	PullRequestNumber = "bitbucket/pull_request_number"
+	// PullRequestAuthor represents the bitbucket author. This is the
+	// account UUID for Bitbucket Cloud and the numeric user ID for
+	// Bitbucket Server.
+	PullRequestAuthor = "bitbucket/author"
+)
+
+// FetchAllProperties implements the provider interface
+func (c *bitbucketClient) FetchAllProperties(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, cachedProps *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+
+	// The cached properties may carry data we can't fetch from the API,
+	// like the webhook we registered, so we use them as a base.
+	lookupProps := cachedProps.Merge(getByProps)
+
+	//nolint:exhaustive // We only support two entity types for now.
+	switch entType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return c.getPropertiesForRepo(ctx, lookupProps)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return c.getPropertiesForPullRequest(ctx, lookupProps)
+	default:
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+}
+
+// FetchProperty implements the provider interface
+// TODO: Implement this
+func (*bitbucketClient) FetchProperty(
+	_ context.Context, _ *properties.Properties, _ minderv1.Entity, _ string) (*properties.Property, error) {
+	return nil, nil
+}
+
+// GetEntityName implements the provider interface
+func (c *bitbucketClient) GetEntityName(entityType minderv1.Entity, props *properties.Properties) (string, error) {
+	if props == nil {
+		return "", errors.New("properties are nil")
+	}
+
+	if !c.SupportsEntity(entityType) {
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+
+	//nolint:exhaustive // We only support two entity types for now.
+	switch entityType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return getRepoNameFromProperties(props)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return getPullRequestNameFromProperties(props)
+	default:
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+}
+
+// PropertiesToProtoMessage implements the ProtoMessageConverter interface
+func (c *bitbucketClient) PropertiesToProtoMessage(
+	entType minderv1.Entity, props *properties.Properties,
+) (protoreflect.ProtoMessage, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s is not supported by the bitbucket provider", entType)
+	}
+
+	//nolint:exhaustive // We only support two entity types for now.
+	switch entType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return repoV1FromProperties(props)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return pullRequestV1FromProperties(props)
+	default:
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+}
+
+func getStringProp(props *properties.Properties, key string) (string, error) {
+	value, err := props.GetProperty(key).AsString()
+	if err != nil {
+		return "", fmt.Errorf("property %s not found or not a string", key)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,449 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func cloudRepoBody(uuid, workspace, slug string, fork bool) map[string]any {
+	body := map[string]any{
+		"uuid":       uuid,
+		"slug":       slug,
+		"name":       "Minder",
+		"full_name":  workspace + "/" + slug,
+		"is_private": true,
+		"mainbranch": map[string]any{"name": "main"},
+		"workspace":  map[string]any{"slug": workspace},
+		"links": map[string]any{
+			"clone": []map[string]any{
+				{"name": "https", "href": "https://user@bitbucket.org/" + workspace + "/" + slug + ".git"},
+				{"name": "ssh", "href": "git@bitbucket.org:" + workspace + "/" + slug + ".git"},
+			},
+		},
+	}
+	if fork {
+		body["parent"] = map[string]any{"full_name": "mindersec/minder"}
+	}
+	return body
+}
+
+func serverRepoBody() map[string]any {
+	return map[string]any{
+		"id":       42,
+		"slug":     "minder",
+		"name":     "Minder",
+		"public":   false,
+		"archived": true,
+		"project":  map[string]any{"key": "SEC"},
+		"links": map[string]any{
+			"clone": []map[string]any{
+				{"name": "http", "href": "https://admin@bb.example.com/scm/sec/minder.git"},
+				{"name": "ssh", "href": "ssh://git@bb.example.com:7999/sec/minder.git"},
+			},
+		},
+	}
+}
+
+// cloudHandler is a stand-in for the Bitbucket Cloud API
+func cloudHandler(t *testing.T) http.Handler {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repositories/mindersec/minder", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, cloudRepoBody("{1234}", "mindersec", "minder", false))
+	})
+	mux.HandleFunc("GET /repositories/contributor/minder", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, cloudRepoBody("{5678}", "contributor", "minder", true))
+	})
+	mux.HandleFunc("GET /repositories/mindersec/minder/pullrequests/7", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"id":     7,
+			"author": map[string]any{"uuid": "{author}"},
+			"source": map[string]any{
+				"branch":     map[string]any{"name": "feature"},
+				"commit":     map[string]any{"hash": "abc123"},
+				"repository": map[string]any{"full_name": "contributor/minder"},
+			},
+			"destination": map[string]any{
+				"branch":     map[string]any{"name": "release"},
+				"commit":     map[string]any{"hash": "def456"},
+				"repository": map[string]any{"full_name": "mindersec/minder"},
+			},
+			"links": map[string]any{
+				"html": map[string]any{"href": "https://bitbucket.org/mindersec/minder/pull-requests/7"},
+			},
+		})
+	})
+	mux.HandleFunc("GET /repositories/mindersec", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "admin", r.URL.Query().Get("role"))
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, http.StatusOK, map[string]any{
+				"values": []any{cloudRepoBody("{2}", "mindersec", "second", false)},
+			})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"values": []any{cloudRepoBody("{1234}", "mindersec", "minder", false)},
+			"next":   "https://api.bitbucket.org/2.0/repositories/mindersec?role=admin&page=2",
+		})
+	})
+	return mux
+}
+
+// serverHandler is a stand-in for the Bitbucket Server API
+func serverHandler(t *testing.T) http.Handler {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/SEC/repos/minder", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, serverRepoBody())
+	})
+	mux.HandleFunc("GET /projects/SEC/repos/minder/default-branch", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, map[string]any{"displayId": "main"})
+	})
+	mux.HandleFunc("GET /projects/SEC/repos/minder/pull-requests/3", func(w http.ResponseWriter, _ *http.Request) {
+		ref := func(branch, commit string) map[string]any {
+			return map[string]any{
+				"displayId":    branch,
+				"latestCommit": commit,
+				"repository":   serverRepoBody(),
+			}
+		}
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"id":      3,
+			"author":  map[string]any{"user": map[string]any{"id": 101}},
+			"fromRef": ref("feature", "deadbeef"),
+			"toRef":   ref("main", "cafebabe"),
+			"links": map[string]any{
+				"self": []map[string]any{
+					{"href": "https://bb.example.com/projects/SEC/repos/minder/pull-requests/3"},
+				},
+			},
+		})
+	})
+	mux.HandleFunc("GET /repos", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "REPO_ADMIN", r.URL.Query().Get("permission"))
+		if r.URL.Query().Get("start") == "25" {
+			second := serverRepoBody()
+			second["id"] = 43
+			second["slug"] = "second"
+			writeJSON(t, w, http.StatusOK, map[string]any{
+				"values":     []any{second},
+				"isLastPage": true,
+			})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"values":        []any{serverRepoBody()},
+			"isLastPage":    false,
+			"nextPageStart": 25,
+		})
+	})
+	return mux
+}
+
+func TestFetchAllPropertiesRepository(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		deployment string
+		handler    func(t *testing.T) http.Handler
+		getByProps map[string]any
+		cached     map[string]any
+		want       map[string]any
+		wantErr    string
+	}{
+		{
+			name:       "cloud repository by name",
+			deployment: DeploymentCloud,
+			handler:    cloudHandler,
+			getByProps: map[string]any{
+				properties.PropertyName: "mindersec/minder",
+			},
+			want: map[string]any{
+				properties.PropertyUpstreamID:     "{1234}",
+				properties.PropertyName:           "mindersec/minder",
+				properties.RepoPropertyIsPrivate:  true,
+				properties.RepoPropertyIsArchived: false,
+				properties.RepoPropertyIsFork:     false,
+				RepoPropertyOwner:                 "mindersec",
+				RepoPropertySlug:                  "minder",
+				RepoPropertyName:                  "Minder",
+				RepoPropertyDefaultBranch:         "main",
+				RepoPropertyCloneURL:              "https://bitbucket.org/mindersec/minder.git",
+			},
+		},
+		{
+			name:       "cached webhook properties are preserved",
+			deployment: DeploymentCloud,
+			handler:    cloudHandler,
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "{1234}",
+			},
+			cached: map[string]any{
+				RepoPropertyOwner:  "mindersec",
+				RepoPropertySlug:   "minder",
+				RepoPropertyHookID: "{hook}",
+			},
+			want: map[string]any{
+				properties.PropertyUpstreamID: "{1234}",
+				RepoPropertyHookID:            "{hook}",
+			},
+		},
+		{
+			name:       "upstream ID mismatch",
+			deployment: DeploymentCloud,
+			handler:    cloudHandler,
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "{other}",
+				properties.PropertyName:       "mindersec/minder",
+			},
+			wantErr: "repository ID mismatch",
+		},
+		{
+			name:       "invalid name",
+			deployment: DeploymentCloud,
+			handler:    cloudHandler,
+			getByProps: map[string]any{
+				properties.PropertyName: "minder",
+			},
+			wantErr: "expected owner/slug",
+		},
+		{
+			name:       "server repository by owner and slug",
+			deployment: DeploymentServer,
+			handler:    serverHandler,
+			getByProps: map[string]any{
+				RepoPropertyOwner: "SEC",
+				RepoPropertySlug:  "minder",
+			},
+			want: map[string]any{
+				properties.PropertyUpstreamID:     "42",
+				properties.PropertyName:           "SEC/minder",
+				properties.RepoPropertyIsPrivate:  true,
+				properties.RepoPropertyIsArchived: true,
+				properties.RepoPropertyIsFork:     false,
+				RepoPropertyDefaultBranch:         "main",
+				RepoPropertyCloneURL:              "https://bb.example.com/scm/sec/minder.git",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := newTestClient(t, tt.deployment, tt.handler(t))
+
+			var cached *properties.Properties
+			if tt.cached != nil {
+				cached = properties.NewProperties(tt.cached)
+			}
+
+			got, err := cli.FetchAllProperties(context.Background(),
+				properties.NewProperties(tt.getByProps), minderv1.Entity_ENTITY_REPOSITORIES, cached)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			for k, v := range tt.want {
+				assert.Equal(t, v, got.GetProperty(k).RawValue(), "property %s", k)
+			}
+		})
+	}
+}
+
+func TestFetchAllPropertiesNotFound(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, DeploymentCloud, cloudHandler(t))
+
+	_, err := cli.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyName: "mindersec/unknown",
+	}), minderv1.Entity_ENTITY_REPOSITORIES, nil)
+	assert.ErrorIs(t, err, provifv1.ErrEntityNotFound)
+}
+
+func TestFetchAllPropertiesPullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		deployment string
+		handler    func(t *testing.T) http.Handler
+		getByProps map[string]any
+		want       *pbinternal.PullRequest
+		wantName   string
+	}{
+		{
+			name:       "cloud pull request from a fork",
+			deployment: DeploymentCloud,
+			handler:    cloudHandler,
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "{1234}/7",
+				PullRequestNumber:             int64(7),
+				RepoPropertyOwner:             "mindersec",
+				RepoPropertySlug:              "minder",
+			},
+			want: &pbinternal.PullRequest{
+				Number:         7,
+				RepoOwner:      "mindersec",
+				RepoName:       "minder",
+				CommitSha:      "abc123",
+				Url:            "https://bitbucket.org/mindersec/minder/pull-requests/7",
+				BaseCloneUrl:   "https://bitbucket.org/mindersec/minder.git",
+				TargetCloneUrl: "https://bitbucket.org/contributor/minder.git",
+				BaseRef:        "release",
+				TargetRef:      "feature",
+			},
+			wantName: "mindersec/minder/7",
+		},
+		{
+			name:       "server pull request",
+			deployment: DeploymentServer,
+			handler:    serverHandler,
+			getByProps: map[string]any{
+				PullRequestNumber: int64(3),
+				RepoPropertyOwner: "SEC",
+				RepoPropertySlug:  "minder",
+			},
+			want: &pbinternal.PullRequest{
+				Number:         3,
+				RepoOwner:      "SEC",
+				RepoName:       "minder",
+				CommitSha:      "deadbeef",
+				AuthorId:       101,
+				Url:            "https://bb.example.com/projects/SEC/repos/minder/pull-requests/3",
+				BaseCloneUrl:   "https://bb.example.com/scm/sec/minder.git",
+				TargetCloneUrl: "https://bb.example.com/scm/sec/minder.git",
+				BaseRef:        "main",
+				TargetRef:      "feature",
+			},
+			wantName: "SEC/minder/3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := newTestClient(t, tt.deployment, tt.handler(t))
+
+			props, err := cli.FetchAllProperties(context.Background(),
+				properties.NewProperties(tt.getByProps), minderv1.Entity_ENTITY_PULL_REQUESTS, nil)
+			require.NoError(t, err)
+
+			name, err := cli.GetEntityName(minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, name)
+
+			msg, err := cli.PropertiesToProtoMessage(minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+			require.NoError(t, err)
+
+			pr, ok := msg.(*pbinternal.PullRequest)
+			require.True(t, ok)
+			assert.Equal(t, tt.want.Number, pr.Number)
+			assert.Equal(t, tt.want.RepoOwner, pr.RepoOwner)
+			assert.Equal(t, tt.want.RepoName, pr.RepoName)
+			assert.Equal(t, tt.want.CommitSha, pr.CommitSha)
+			assert.Equal(t, tt.want.AuthorId, pr.AuthorId)
+			assert.Equal(t, tt.want.Url, pr.Url)
+			assert.Equal(t, tt.want.BaseCloneUrl, pr.BaseCloneUrl)
+			assert.Equal(t, tt.want.TargetCloneUrl, pr.TargetCloneUrl)
+			assert.Equal(t, tt.want.BaseRef, pr.BaseRef)
+			assert.Equal(t, tt.want.TargetRef, pr.TargetRef)
+		})
+	}
+}
+
+func TestPropertiesToProtoMessageRepository(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, DeploymentServer, serverHandler(t))
+
+	props, err := cli.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyName: "SEC/minder",
+	}), minderv1.Entity_ENTITY_REPOSITORIES, nil)
+	require.NoError(t, err)
+
+	name, err := cli.GetEntityName(minderv1.Entity_ENTITY_REPOSITORIES, props)
+	require.NoError(t, err)
+	assert.Equal(t, "SEC/minder", name)
+
+	msg, err := cli.PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, props)
+	require.NoError(t, err)
+
+	repo, ok := msg.(*minderv1.Repository)
+	require.True(t, ok)
+	assert.Equal(t, "SEC", repo.GetOwner())
+	assert.Equal(t, "minder", repo.GetName())
+	assert.Equal(t, int64(42), repo.GetRepoId())
+	assert.Equal(t, "main", repo.GetDefaultBranch())
+	assert.Equal(t, "https://bb.example.com/scm/sec/minder.git", repo.GetCloneUrl())
+	assert.True(t, repo.GetIsPrivate())
+
+	_, err = cli.PropertiesToProtoMessage(minderv1.Entity_ENTITY_ARTIFACTS, props)
+	assert.Error(t, err)
+}
+
+func TestListAllRepositories(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		deployment string
+		workspace  string
+		handler    func(t *testing.T) http.Handler
+		want       []string
+	}{
+		{
+			name:       "cloud follows next links",
+			deployment: DeploymentCloud,
+			workspace:  "mindersec",
+			handler:    cloudHandler,
+			want:       []string{"mindersec/minder", "mindersec/second"},
+		},
+		{
+			name:       "server follows page starts",
+			deployment: DeploymentServer,
+			handler:    serverHandler,
+			want:       []string{"SEC/minder", "SEC/second"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := newTestClient(t, tt.deployment, tt.handler(t))
+			cli.bbcfg.Workspace = tt.workspace
+			if api, ok := cli.api.(*cloudAPI); ok {
+				api.workspace = tt.workspace
+			}
+
+			repos, err := cli.ListAllRepositories(context.Background())
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(repos))
+			for _, r := range repos {
+				got = append(got, r.GetOwner()+"/"+r.GetName())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// FormatPullRequestUpstreamID returns the upstream ID for a bitbucket pull request.
+// Pull request numbers are only unique within a repository, so the upstream ID
+// is scoped by the repository's upstream ID.
+func FormatPullRequestUpstreamID(repoUpstreamID string, number int64) string {
+	return fmt.Sprintf("%s/%d", repoUpstreamID, number)
+}
+
+func (c *bitbucketClient) getPropertiesForPullRequest(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	number, err := getByProps.GetProperty(PullRequestNumber).AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("pull request number not found or invalid: %w", err)
+	}
+
+	owner, slug, err := repoOwnerAndSlug(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := c.api.getPullRequest(ctx, owner, slug, number)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := c.api.getRepository(ctx, owner, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	// Validate - pull request upstream ID must match the one we requested
+	uid := FormatPullRequestUpstreamID(repo.upstreamID, pr.number)
+	if req := getByProps.GetProperty(properties.PropertyUpstreamID).GetString(); req != "" && req != uid {
+		return nil, fmt.Errorf("pull request ID mismatch: %s != %s", uid, req)
+	}
+
+	sourceRepo := repo
+	if pr.sourceOwner != owner || pr.sourceSlug != slug {
+		sourceRepo, err = c.api.getRepository(ctx, pr.sourceOwner, pr.sourceSlug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source repository: %w", err)
+		}
+	}
+
+	return pullRequestToProperties(pr, repo, sourceRepo), nil
+}
+
+func pullRequestToProperties(pr *pullRequest, repo *repository, sourceRepo *repository) *properties.Properties {
+	return properties.NewProperties(map[string]any{
+		// Unique upstream ID for the pull request
+		properties.PropertyUpstreamID:           FormatPullRequestUpstreamID(repo.upstreamID, pr.number),
+		properties.PropertyName:                 formatPullRequestName(repo.owner, repo.slug, pr.number),
+		properties.PullRequestCommitSHA:         pr.commitSHA,
+		properties.PullRequestBaseCloneURL:      repo.cloneURL,
+		properties.PullRequestBaseBranch:        pr.targetBranch,
+		properties.PullRequestBaseDefaultBranch: repo.defaultBranch,
+		properties.PullRequestTargetCloneURL:    sourceRepo.cloneURL,
+		properties.PullRequestTargetBranch:      pr.sourceBranch,
+		properties.PullRequestUpstreamURL:       pr.url,
+		RepoPropertyOwner:                       repo.owner,
+		RepoPropertySlug:                        repo.slug,
+		PullRequestNumber:                       pr.number,
+		PullRequestAuthor:                       pr.author,
+	})
+}
+
+func pullRequestV1FromProperties(prProps *properties.Properties) (*pbinternal.PullRequest, error) {
+	_, err := prProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream ID: %w", err)
+	}
+
+	number := prProps.GetProperty(PullRequestNumber).GetInt64()
+	if number == 0 {
+		return nil, fmt.Errorf("failed to get pull request number: %w", provifv1.ErrEntityNotFound)
+	}
+
+	owner, err := getStringProp(prProps, RepoPropertyOwner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	slug, err := getStringProp(prProps, RepoPropertySlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository slug: %w", err)
+	}
+
+	commitSha, err := getStringProp(prProps, properties.PullRequestCommitSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	prURL, err := getStringProp(prProps, properties.PullRequestUpstreamURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request URL: %w", err)
+	}
+
+	// Bitbucket Cloud identifies users by UUID, which can't be
+	// represented as a numeric author ID.
+	authorID, _ := strconv.ParseInt(prProps.GetProperty(PullRequestAuthor).GetString(), 10, 64)
+
+	pbPR := &pbinternal.PullRequest{
+		Number:         number,
+		RepoOwner:      owner,
+		RepoName:       slug,
+		CommitSha:      commitSha,
+		AuthorId:       authorID,
+		Url:            prURL,
+		BaseCloneUrl:   prProps.GetProperty(properties.PullRequestBaseCloneURL).GetString(),
+		TargetCloneUrl: prProps.GetProperty(properties.PullRequestTargetCloneURL).GetString(),
+		BaseRef:        prProps.GetProperty(properties.PullRequestBaseBranch).GetString(),
+		TargetRef:      prProps.GetProperty(properties.PullRequestTargetBranch).GetString(),
+		Properties:     prProps.ToProtoStruct(),
+	}
+
+	return pbPR, nil
+}
+
+func getPullRequestNameFromProperties(props *properties.Properties) (string, error) {
+	owner, err := getStringProp(props, RepoPropertyOwner)
+	if err != nil {
+		return "", err
+	}
+
+	slug, err := getStringProp(props, RepoPropertySlug)
+	if err != nil {
+		return "", err
+	}
+
+	number, err := props.GetProperty(PullRequestNumber).AsInt64()
+	if err != nil {
+		return "", fmt.Errorf("property %s not found or not a number", PullRequestNumber)
+	}
+
+	return formatPullRequestName(owner, slug, number), nil
+}
+
+func formatPullRequestName(owner, slug string, number int64) string {
+	return fmt.Sprintf("%s/%s/%d", owner, slug, number)
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/bitbucket/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// RegisterEntity implements the Provider interface
+func (c *bitbucketClient) RegisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, provifv1.ErrUnsupportedEntity
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		// We only explicitly register repositories
+		// Pull requests are handled via origination
+		return props, nil
+	}
+
+	owner, slug, err := repoOwnerAndSlug(props)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cleanUpStaleWebhooks(ctx, owner, slug); err != nil {
+		// This is a non-fatal error and may be transient. We log it and
+		// continue with the registration.
+		zerolog.Ctx(ctx).Error().
+			Str("repository", formatRepoName(owner, slug)).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to clean up stale webhooks")
+	}
+
+	whprops, err := c.createWebhook(ctx, owner, slug)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("repository", formatRepoName(owner, slug)).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to create webhook")
+		return nil, errors.New("failed to create webhook")
+	}
+
+	return props.Merge(whprops), nil
+}
+
+// DeregisterEntity implements the Provider interface
+func (c *bitbucketClient) DeregisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) error {
+	if !c.SupportsEntity(entType) {
+		return errors.New("unsupported entity type")
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		// Only repositories have webhooks
+		return nil
+	}
+
+	owner, slug, err := repoOwnerAndSlug(props)
+	if err != nil {
+		return err
+	}
+
+	hookID := props.GetProperty(RepoPropertyHookID).GetString()
+	if hookID == "" {
+		return errors.New("missing hook ID")
+	}
+
+	if err := c.api.deleteWebhook(ctx, owner, slug, hookID); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (c *bitbucketClient) createWebhook(ctx context.Context, owner, slug string) (*properties.Properties, error) {
+	hookUUID := uuid.New()
+	webhookUniqueURL, err := url.JoinPath(c.webhookURL, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhook: %w", err)
+	}
+
+	sec, err := webhooksecret.New(c.currentWebhookSecret, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook secret: %w", err)
+	}
+
+	hook, err := c.api.createWebhook(ctx, owner, slug, webhookUniqueURL, sec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	outProps := properties.NewProperties(map[string]any{
+		RepoPropertyHookID:  hook.id,
+		RepoPropertyHookURL: hook.url,
+	})
+
+	return outProps, nil
+}
+
+func (c *bitbucketClient) cleanUpStaleWebhooks(ctx context.Context, owner, slug string) error {
+	hooks, err := c.api.listWebhooks(ctx, owner, slug)
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	for _, hook := range hooks {
+		if strings.HasPrefix(hook.url, c.webhookURL) {
+			if err := c.api.deleteWebhook(ctx, owner, slug, hook.id); err != nil {
+				return fmt.Errorf("failed to delete webhook: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/bitbucket/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	testhelper "github.com/mindersec/minder/pkg/providers/v1/testing"
+)
+
+func TestRegistration(t *testing.T) {
+	t.Parallel()
+	// We don't need a full constructor here, so we're naughty
+	bbc := &bitbucketClient{}
+	testhelper.CheckRegistrationExcept(t, bbc, minderv1.Entity_ENTITY_REPOSITORIES)
+}
+
+func TestRegisterEntity(t *testing.T) {
+	t.Parallel()
+
+	repoProps := map[string]any{
+		properties.PropertyUpstreamID: "{1234}",
+		RepoPropertyOwner:             "mindersec",
+		RepoPropertySlug:              "minder",
+	}
+
+	tests := []struct {
+		name       string
+		deployment string
+		props      map[string]any
+		// hooksPath is the webhook collection path of the repository
+		hooksPath   string
+		existing    []map[string]any
+		created     map[string]any
+		createCode  int
+		wantDeleted []string
+		wantHookID  string
+		wantErr     string
+	}{
+		{
+			name:       "cloud registration cleans up stale webhooks",
+			deployment: DeploymentCloud,
+			props:      repoProps,
+			hooksPath:  "/repositories/mindersec/minder/hooks",
+			existing: []map[string]any{
+				{"uuid": "{stale}", "url": testWebhookURL + "/3b4b5a2e-7e9b-4a3f-9c2d-1d2e3f4a5b6c"},
+				{"uuid": "{other}", "url": "https://ci.example.com/hook"},
+			},
+			created:     map[string]any{"uuid": "{new}", "url": testWebhookURL + "/new"},
+			createCode:  http.StatusCreated,
+			wantDeleted: []string{"/repositories/mindersec/minder/hooks/{stale}"},
+			wantHookID:  "{new}",
+		},
+		{
+			name:       "server registration",
+			deployment: DeploymentServer,
+			props: map[string]any{
+				properties.PropertyUpstreamID: "42",
+				properties.PropertyName:       "SEC/minder",
+			},
+			hooksPath:  "/projects/SEC/repos/minder/webhooks",
+			created:    map[string]any{"id": 12, "url": testWebhookURL + "/new"},
+			createCode: http.StatusCreated,
+			wantHookID: "12",
+		},
+		{
+			name:       "failure creating webhook",
+			deployment: DeploymentCloud,
+			props:      repoProps,
+			hooksPath:  "/repositories/mindersec/minder/hooks",
+			createCode: http.StatusForbidden,
+			wantErr:    "failed to create webhook",
+		},
+		{
+			name:       "missing repository name",
+			deployment: DeploymentCloud,
+			props: map[string]any{
+				properties.PropertyUpstreamID: "{1234}",
+			},
+			wantErr: "owner/slug",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var deleted []string
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == tt.hooksPath:
+					writeJSON(t, w, http.StatusOK, map[string]any{
+						"values":     tt.existing,
+						"isLastPage": true,
+					})
+				case r.Method == http.MethodPost && r.URL.Path == tt.hooksPath:
+					hook := map[string]any{}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&hook))
+					hookURL, ok := hook["url"].(string)
+					assert.True(t, ok)
+					assert.True(t, strings.HasPrefix(hookURL, testWebhookURL+"/"))
+
+					// The secret must be derived from the unique hook URL
+					uniq := hookURL[strings.LastIndex(hookURL, "/")+1:]
+					want, err := webhooksecret.New("test-secret", uniq)
+					assert.NoError(t, err)
+					secret := hook["secret"]
+					if cfg, ok := hook["configuration"].(map[string]any); ok {
+						secret = cfg["secret"]
+					}
+					assert.Equal(t, want, secret)
+
+					if tt.createCode != http.StatusCreated {
+						w.WriteHeader(tt.createCode)
+						return
+					}
+					writeJSON(t, w, tt.createCode, tt.created)
+				case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, tt.hooksPath+"/"):
+					mu.Lock()
+					deleted = append(deleted, r.URL.Path)
+					mu.Unlock()
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			cli := newTestClient(t, tt.deployment, handler)
+
+			got, err := cli.RegisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES,
+				properties.NewProperties(tt.props))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHookID, got.GetProperty(RepoPropertyHookID).GetString())
+			assert.NotEmpty(t, got.GetProperty(RepoPropertyHookURL).GetString())
+			assert.Equal(t, tt.props[properties.PropertyUpstreamID], got.GetProperty(properties.PropertyUpstreamID).GetString())
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestRegisterEntityPullRequest(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, DeploymentCloud, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	props := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: "{1234}/7",
+	})
+
+	// Pull requests are not registered upstream
+	got, err := cli.RegisterEntity(context.Background(), minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+	require.NoError(t, err)
+	assert.Equal(t, props, got)
+
+	err = cli.DeregisterEntity(context.Background(), minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+	assert.NoError(t, err)
+}
+
+func TestDeregisterEntity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		props    map[string]any
+		status   int
+		wantPath string
+		wantErr  string
+	}{
+		{
+			name: "deletes the webhook",
+			props: map[string]any{
+				RepoPropertyOwner:  "mindersec",
+				RepoPropertySlug:   "minder",
+				RepoPropertyHookID: "{hook}",
+			},
+			status:   http.StatusNoContent,
+			wantPath: "/repositories/mindersec/minder/hooks/{hook}",
+		},
+		{
+			name: "missing hook ID",
+			props: map[string]any{
+				RepoPropertyOwner: "mindersec",
+				RepoPropertySlug:  "minder",
+			},
+			wantErr: "missing hook ID",
+		},
+		{
+			name: "webhook deletion fails",
+			props: map[string]any{
+				RepoPropertyOwner:  "mindersec",
+				RepoPropertySlug:   "minder",
+				RepoPropertyHookID: "{hook}",
+			},
+			status:   http.StatusInternalServerError,
+			wantPath: "/repositories/mindersec/minder/hooks/{hook}",
+			wantErr:  "failed to delete webhook",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := newTestClient(t, DeploymentCloud, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+				w.WriteHeader(tt.status)
+			}))
+
+			err := cli.DeregisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES,
+				properties.NewProperties(tt.props))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func (c *bitbucketClient) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
+	bbRepos, err := c.api.listRepositories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	repos := make([]*minderv1.Repository, 0, len(bbRepos))
+	for _, r := range bbRepos {
+		outRep, err := repoV1FromProperties(repositoryToProperties(r))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert properties to repository: %w", err)
+		}
+
+		repos = append(repos, outRep)
+	}
+
+	zerolog.Ctx(ctx).Debug().Int("num_repos", len(repos)).Msg("found repositories in bitbucket provider")
+
+	return repos, nil
+}
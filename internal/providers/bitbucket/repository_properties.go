@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func (c *bitbucketClient) getPropertiesForRepo(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	owner, slug, err := repoOwnerAndSlug(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := c.api.getRepository(ctx, owner, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate - if we were given an upstream ID, the repository
+	// must match it. This catches renamed or re-created repositories.
+	if uid := getByProps.GetProperty(properties.PropertyUpstreamID).GetString(); uid != "" && uid != repo.upstreamID {
+		return nil, fmt.Errorf("repository ID mismatch: %s != %s", repo.upstreamID, uid)
+	}
+
+	return getByProps.Merge(repositoryToProperties(repo)), nil
+}
+
+// repoOwnerAndSlug returns the owner and slug used to address a repository
+// in the Bitbucket API. Registration requests only carry the name, while
+// stored entities and webhook events carry the owner and slug explicitly.
+func repoOwnerAndSlug(props *properties.Properties) (string, string, error) {
+	owner := props.GetProperty(RepoPropertyOwner).GetString()
+	slug := props.GetProperty(RepoPropertySlug).GetString()
+	if owner != "" && slug != "" {
+		return owner, slug, nil
+	}
+
+	name := props.GetProperty(properties.PropertyName).GetString()
+	owner, slug, ok := strings.Cut(name, "/")
+	if !ok || owner == "" || slug == "" {
+		return "", "", fmt.Errorf("invalid repository name %q, expected owner/slug", name)
+	}
+
+	return owner, slug, nil
+}
+
+func repositoryToProperties(repo *repository) *properties.Properties {
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:     repo.upstreamID,
+		properties.PropertyName:           formatRepoName(repo.owner, repo.slug),
+		properties.RepoPropertyIsPrivate:  repo.isPrivate,
+		properties.RepoPropertyIsArchived: repo.isArchived,
+		properties.RepoPropertyIsFork:     repo.isFork,
+		RepoPropertyOwner:                 repo.owner,
+		RepoPropertySlug:                  repo.slug,
+		RepoPropertyName:                  repo.name,
+		RepoPropertyDefaultBranch:         repo.defaultBranch,
+		RepoPropertyCloneURL:              repo.cloneURL,
+	})
+}
+
+func repoV1FromProperties(repoProperties *properties.Properties) (*minderv1.Repository, error) {
+	upstreamID, err := repoProperties.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching upstream ID property: %w", err)
+	}
+
+	slug, err := repoProperties.GetProperty(RepoPropertySlug).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching slug property: %w", err)
+	}
+
+	owner, err := repoProperties.GetProperty(RepoPropertyOwner).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching owner property: %w", err)
+	}
+
+	isPrivate, err := repoProperties.GetProperty(properties.RepoPropertyIsPrivate).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_private property: %w", err)
+	}
+
+	isFork, err := repoProperties.GetProperty(properties.RepoPropertyIsFork).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_fork property: %w", err)
+	}
+
+	// Bitbucket Server uses numeric IDs, while Bitbucket Cloud uses
+	// UUIDs which can't be represented in the repo_id field.
+	repoId, _ := strconv.ParseInt(upstreamID, 10, 64)
+
+	pbRepo := &minderv1.Repository{
+		Name:          slug,
+		Owner:         owner,
+		RepoId:        repoId,
+		CloneUrl:      repoProperties.GetProperty(RepoPropertyCloneURL).GetString(),
+		IsPrivate:     isPrivate,
+		IsFork:        isFork,
+		DefaultBranch: repoProperties.GetProperty(RepoPropertyDefaultBranch).GetString(),
+		Properties:    repoProperties.ToProtoStruct(),
+	}
+
+	return pbRepo, nil
+}
+
+func getRepoNameFromProperties(props *properties.Properties) (string, error) {
+	owner, err := getStringProp(props, RepoPropertyOwner)
+	if err != nil {
+		return "", err
+	}
+
+	slug, err := getStringProp(props, RepoPropertySlug)
+	if err != nil {
+		return "", err
+	}
+
+	return formatRepoName(owner, slug), nil
+}
+
+func formatRepoName(owner, slug string) string {
+	return owner + "/" + slug
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhooksecret provides a way to generate secrets for Bitbucket
+// webhooks and to verify the signatures of the payloads Bitbucket sends.
+package webhooksecret
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	sum "crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// signaturePrefix is the prefix of the X-Hub-Signature header value
+const signaturePrefix = "sha256="
+
+var (
+	// ErrEmptyBaseOrUniq is returned when the base or uniq strings are empty.
+	ErrEmptyBaseOrUniq = errors.New("base or uniq strings are empty")
+)
+
+// New creates a new secret for usage in the bitbucket webhook.
+// The secret is generated by combining the base and uniq strings
+// and then hashing the result.
+func New(base string, uniq string) (string, error) {
+	if base == "" || uniq == "" {
+		return "", ErrEmptyBaseOrUniq
+	}
+
+	hash := sum.New()
+	_, err := hash.Write([]byte(base + uniq))
+	if err != nil {
+		return "", fmt.Errorf("failed to write secret: %w", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Sign returns the value of the X-Hub-Signature header Bitbucket
+// sends for the given payload when the webhook uses the given secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	// hash.Hash never returns an error on Write
+	_, _ = mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks if the given signature is valid for the payload, using
+// the secret generated from the given base and uniq strings.
+func Verify(base string, uniq string, payload []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+
+	secret, err := New(base, uniq)
+	if err != nil {
+		// If we can't generate the secret, we can't verify it.
+		return false
+	}
+
+	return hmac.Equal([]byte(Sign(secret, payload)), []byte(signature))
+}
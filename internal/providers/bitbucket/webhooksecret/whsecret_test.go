@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooksecret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	secret, err := New("baseString", "uniqueString")
+	require.NoError(t, err)
+	assert.Len(t, secret, 128)
+
+	other, err := New("baseString", "otherString")
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other, "secrets should be unique per webhook")
+
+	_, err = New("", "uniqueString")
+	assert.ErrorIs(t, err, ErrEmptyBaseOrUniq)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"repository":{"full_name":"ws/repo"}}`)
+	secret, err := New("base", "uniq")
+	require.NoError(t, err)
+	signature := Sign(secret, payload)
+
+	tests := []struct {
+		name      string
+		base      string
+		uniq      string
+		payload   []byte
+		signature string
+		want      bool
+	}{
+		{
+			name:      "valid signature",
+			base:      "base",
+			uniq:      "uniq",
+			payload:   payload,
+			signature: signature,
+			want:      true,
+		},
+		{
+			name:      "wrong base",
+			base:      "other",
+			uniq:      "uniq",
+			payload:   payload,
+			signature: signature,
+			want:      false,
+		},
+		{
+			name:      "tampered payload",
+			base:      "base",
+			uniq:      "uniq",
+			payload:   []byte(`{"repository":{"full_name":"ws/other"}}`),
+			signature: signature,
+			want:      false,
+		},
+		{
+			name:      "missing prefix",
+			base:      "base",
+			uniq:      "uniq",
+			payload:   payload,
+			signature: signature[len("sha256="):],
+			want:      false,
+		},
+		{
+			name:      "empty uniq",
+			base:      "base",
+			uniq:      "",
+			payload:   payload,
+			signature: signature,
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Verify(tt.base, tt.uniq, tt.payload, tt.signature))
+		})
+	}
+}
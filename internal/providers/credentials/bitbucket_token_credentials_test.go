@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitbucketTokenCredentialSetAuthorizationHeader(t *testing.T) {
+	t.Parallel()
+
+	cred := NewBitbucketTokenCredential("test_token")
+	req := &http.Request{
+		Header: http.Header{},
+	}
+	cred.SetAuthorizationHeader(req)
+	require.Equal(t, "Bearer test_token", req.Header.Get("Authorization"))
+}
+
+func TestBitbucketTokenCredentialGitOptions(t *testing.T) {
+	t.Parallel()
+
+	cred := NewBitbucketTokenCredential("test_token")
+	expected := &githttp.BasicAuth{
+		Username: "x-token-auth",
+		Password: "test_token",
+	}
+
+	cloneOptions := &git.CloneOptions{}
+	cred.AddToCloneOptions(cloneOptions)
+	require.Equal(t, expected, cloneOptions.Auth)
+
+	// The owner is ignored, Bitbucket always expects the token user
+	pushOptions := &git.PushOptions{}
+	cred.AddToPushOptions(pushOptions, "test_user")
+	require.Equal(t, expected, pushOptions.Auth)
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"golang.org/x/oauth2"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// GiteaTokenCredential is a credential that uses a token
+type GiteaTokenCredential struct {
+	token string
+}
+
+// Ensure that the GiteaTokenCredential implements the GiteaCredential interface
+var _ provifv1.GiteaCredential = (*GiteaTokenCredential)(nil)
+
+// NewGiteaTokenCredential creates a new GiteaTokenCredential from the token
+func NewGiteaTokenCredential(token string) *GiteaTokenCredential {
+	return &GiteaTokenCredential{
+		token: token,
+	}
+}
+
+// SetAuthorizationHeader sets the authorization header on the request
+func (t *GiteaTokenCredential) SetAuthorizationHeader(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+t.token)
+}
+
+// AddToPushOptions adds the credential to the git push options
+func (t *GiteaTokenCredential) AddToPushOptions(options *git.PushOptions, owner string) {
+	options.Auth = &githttp.BasicAuth{
+		Username: owner,
+		Password: t.token,
+	}
+}
+
+// AddToCloneOptions adds the credential to the git clone options
+func (t *GiteaTokenCredential) AddToCloneOptions(options *git.CloneOptions) {
+	options.Auth = &githttp.BasicAuth{
+		// the username can be anything, but it can't be empty
+		Username: "minder-user",
+		Password: t.token,
+	}
+}
+
+// GetAsOAuth2TokenSource returns the token as an OAuth2 token source
+func (t *GiteaTokenCredential) GetAsOAuth2TokenSource() oauth2.TokenSource {
+	return oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: t.token},
+	)
+}
+
+// GetCredential implements the DirectCredential interface
+func (t *GiteaTokenCredential) GetCredential() string {
+	return t.token
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGiteaTokenCredentialSetAuthorizationHeader(t *testing.T) {
+	t.Parallel()
+
+	cred := NewGiteaTokenCredential("test_token")
+	req := &http.Request{
+		Header: http.Header{},
+	}
+	cred.SetAuthorizationHeader(req)
+	require.Equal(t, "Bearer test_token", req.Header.Get("Authorization"))
+}
+
+func TestGiteaTokenCredentialGitOptions(t *testing.T) {
+	t.Parallel()
+
+	cred := NewGiteaTokenCredential("test_token")
+
+	cloneOptions := &git.CloneOptions{}
+	cred.AddToCloneOptions(cloneOptions)
+	require.Equal(t, &githttp.BasicAuth{
+		Username: "minder-user",
+		Password: "test_token",
+	}, cloneOptions.Auth)
+
+	pushOptions := &git.PushOptions{}
+	cred.AddToPushOptions(pushOptions, "test_user")
+	require.Equal(t, &githttp.BasicAuth{
+		Username: "test_user",
+		Password: "test_token",
+	}, pushOptions.Auth)
+}
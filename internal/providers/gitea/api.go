@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// pageSize is the number of items requested per page. Gitea caps this
+// to the instance's MAX_RESPONSE_ITEMS, which defaults to 50.
+const pageSize = 50
+
+// webhookEvents are the events Minder subscribes to
+var webhookEvents = []string{"push", "pull_request"}
+
+// user is a Gitea user or organization
+type user struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+// repository is a Gitea repository, as returned by the API
+// and sent in webhook payloads
+type repository struct {
+	ID            int64  `json:"id"`
+	Owner         user   `json:"owner"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Private       bool   `json:"private"`
+	Fork          bool   `json:"fork"`
+	Archived      bool   `json:"archived"`
+	DefaultBranch string `json:"default_branch"`
+	CloneURL      string `json:"clone_url"`
+	HTMLURL       string `json:"html_url"`
+}
+
+// prBranch is the head or base of a Gitea pull request
+type prBranch struct {
+	Ref  string      `json:"ref"`
+	Sha  string      `json:"sha"`
+	Repo *repository `json:"repo"`
+}
+
+// pullRequest is a Gitea pull request
+type pullRequest struct {
+	ID      int64    `json:"id"`
+	Number  int64    `json:"number"`
+	User    user     `json:"user"`
+	Head    prBranch `json:"head"`
+	Base    prBranch `json:"base"`
+	HTMLURL string   `json:"html_url"`
+}
+
+// hook is a Gitea repository webhook
+type hook struct {
+	ID     int64             `json:"id,omitempty"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+func repoPath(owner, name string) (string, error) {
+	p, err := url.JoinPath("repos", owner, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to join URL path for repository: %w", err)
+	}
+	return p, nil
+}
+
+func (c *giteaClient) getRepository(ctx context.Context, owner, name string) (*repository, error) {
+	path, err := repoPath(owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &repository{}
+	if err := giteaRESTGet(ctx, c, path, repo); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (c *giteaClient) getRepositoryByID(ctx context.Context, id int64) (*repository, error) {
+	path, err := url.JoinPath("repositories", strconv.FormatInt(id, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for repository: %w", err)
+	}
+
+	repo := &repository{}
+	if err := giteaRESTGet(ctx, c, path, repo); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (c *giteaClient) listRepositories(ctx context.Context) ([]*repository, error) {
+	path := "user/repos"
+	if org := c.gtcfg.GetOrganization(); org != "" {
+		var err error
+		path, err = url.JoinPath("orgs", org, "repos")
+		if err != nil {
+			return nil, fmt.Errorf("failed to join URL path for repositories: %w", err)
+		}
+	}
+
+	return getAll[*repository](ctx, c, path)
+}
+
+func (c *giteaClient) getPullRequest(ctx context.Context, owner, name string, number int64) (*pullRequest, error) {
+	path, err := repoPath(owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pr := &pullRequest{}
+	if err := giteaRESTGet(ctx, c, path+"/pulls/"+strconv.FormatInt(number, 10), pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (c *giteaClient) listHooks(ctx context.Context, owner, name string) ([]*hook, error) {
+	path, err := repoPath(owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAll[*hook](ctx, c, path+"/hooks")
+}
+
+func (c *giteaClient) createHook(ctx context.Context, owner, name, hookURL, secret string) (*hook, error) {
+	path, err := repoPath(owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &hook{}
+	if err := giteaRESTDo(ctx, c, http.MethodPost, path+"/hooks", &hook{
+		// Forgejo accepts gitea hooks too, and sends the same headers
+		Type: "gitea",
+		Config: map[string]string{
+			"url":          hookURL,
+			"content_type": "json",
+			"secret":       secret,
+		},
+		Events: webhookEvents,
+		Active: true,
+	}, out, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giteaClient) deleteHook(ctx context.Context, owner, name string, hookID int64) error {
+	path, err := repoPath(owner, name)
+	if err != nil {
+		return err
+	}
+
+	return giteaRESTDo(ctx, c, http.MethodDelete,
+		path+"/hooks/"+strconv.FormatInt(hookID, 10), nil, nil, http.StatusNoContent)
+}
+
+// getAll fetches all pages of a list endpoint
+func getAll[T any](ctx context.Context, cli genericRESTClient, path string) ([]T, error) {
+	var out []T
+	for page := 1; ; page++ {
+		q := url.Values{}
+		q.Set("page", strconv.Itoa(page))
+		q.Set("limit", strconv.Itoa(pageSize))
+
+		var items []T
+		if err := giteaRESTGet(ctx, cli, path+"?"+q.Encode(), &items); err != nil {
+			return nil, err
+		}
+
+		out = append(out, items...)
+		if len(items) < pageSize {
+			return out, nil
+		}
+	}
+}
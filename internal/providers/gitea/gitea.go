@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitea provides the Gitea provider implementation.
+// Forgejo is a fork of Gitea with a compatible API, so it is
+// served by this provider as well.
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Class is the string that represents the Gitea provider class
+const Class = "gitea"
+
+// defaultEndpoint is the API endpoint of Codeberg, the largest public
+// Forgejo instance.
+const defaultEndpoint = "https://codeberg.org/api/v1/"
+
+// Implements is the list of provider types that the Gitea provider implements
+var Implements = []db.ProviderType{
+	db.ProviderTypeGit,
+	db.ProviderTypeRest,
+	db.ProviderTypeRepoLister,
+}
+
+// AuthorizationFlows is the list of authorization flows that the Gitea provider supports
+var AuthorizationFlows = []db.AuthorizationFlow{
+	db.AuthorizationFlowUserInput,
+	db.AuthorizationFlowOauth2AuthorizationCodeFlow,
+}
+
+// Ensure that the Gitea provider implements the right interfaces
+var _ provifv1.Git = (*giteaClient)(nil)
+var _ provifv1.REST = (*giteaClient)(nil)
+var _ provifv1.RepoLister = (*giteaClient)(nil)
+var _ provifv1.CommitStatusPublisher = (*giteaClient)(nil)
+
+type giteaClient struct {
+	cred       provifv1.GiteaCredential
+	cli        *http.Client
+	gtcfg      *minderv1.GiteaProviderConfig
+	webhookURL string
+	gitConfig  config.GitConfig
+
+	// secret for the webhook. This is stored in the
+	// structure to allow efficient fetching.
+	currentWebhookSecret string
+}
+
+// New creates a new Gitea provider
+// Note that the webhook URL should already contain the provider class in the path
+func New(
+	cred provifv1.GiteaCredential,
+	cfg *minderv1.GiteaProviderConfig,
+	webhookURL string,
+	currentWebhookSecret string,
+) (*giteaClient, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid gitea config: %w", err)
+	}
+
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = defaultEndpoint
+	}
+
+	if webhookURL == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+
+	// TODO: We need a context here.
+	cli := oauth2.NewClient(context.Background(), cred.GetAsOAuth2TokenSource())
+
+	return &giteaClient{
+		cred:                 cred,
+		cli:                  cli,
+		gtcfg:                cfg,
+		webhookURL:           webhookURL,
+		currentWebhookSecret: currentWebhookSecret,
+		// TODO: Add git config
+	}, nil
+}
+
+type giteaConfigWrapper struct {
+	Gitea *minderv1.GiteaProviderConfig `json:"gitea" yaml:"gitea" mapstructure:"gitea" validate:"required"`
+}
+
+// ParseV1Config parses the raw configuration into a GiteaProviderConfig
+func ParseV1Config(rawCfg json.RawMessage) (*minderv1.GiteaProviderConfig, error) {
+	var cfg giteaConfigWrapper
+	if err := json.Unmarshal(rawCfg, &cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.Gitea == nil {
+		// Return a default but working config
+		return &minderv1.GiteaProviderConfig{}, nil
+	}
+
+	return cfg.Gitea, nil
+}
+
+// MarshalV1Config marshals and validates the given config
+// so it can safely be stored in the database
+func MarshalV1Config(rawCfg json.RawMessage) (json.RawMessage, error) {
+	var w giteaConfigWrapper
+	if err := json.Unmarshal(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	if err := w.Gitea.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating gitea config: %w", err)
+	}
+
+	return json.Marshal(w)
+}
+
+// CanImplement returns true if the provider can implement the given trait
+func (*giteaClient) CanImplement(trait minderv1.ProviderType) bool {
+	return trait == minderv1.ProviderType_PROVIDER_TYPE_GIT ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REST ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REPO_LISTER
+}
+
+func (c *giteaClient) GetCredential() provifv1.GiteaCredential {
+	return c.cred
+}
+
+// SupportsEntity implements the Provider interface
+func (*giteaClient) SupportsEntity(entType minderv1.Entity) bool {
+	return entType == minderv1.Entity_ENTITY_REPOSITORIES ||
+		entType == minderv1.Entity_ENTITY_PULL_REQUESTS
+}
+
+// CreationOptions implements the Provider interface
+func (c *giteaClient) CreationOptions(entType minderv1.Entity) *provifv1.EntityCreationOptions {
+	if !c.SupportsEntity(entType) {
+		return nil
+	}
+
+	// Repositories need webhook registration and trigger policy evaluation
+	if entType == minderv1.Entity_ENTITY_REPOSITORIES {
+		return &provifv1.EntityCreationOptions{
+			RegisterWithProvider:       true,
+			PublishReconciliationEvent: true,
+		}
+	}
+
+	// Pull requests are originated by repositories and don't need registration
+	return &provifv1.EntityCreationOptions{
+		RegisterWithProvider:       false,
+		PublishReconciliationEvent: false,
+	}
+}
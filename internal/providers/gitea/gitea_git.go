@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5"
+
+	gitclient "github.com/mindersec/minder/internal/providers/git"
+)
+
+// Implements the Git interface
+func (c *giteaClient) Clone(ctx context.Context, cloneUrl string, branch string) (*git.Repository, error) {
+	g := gitclient.NewGit(c.GetCredential(), gitclient.WithConfig(c.gitConfig))
+	return g.Clone(ctx, cloneUrl, branch)
+}
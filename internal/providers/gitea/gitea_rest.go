@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Do implements the REST provider interface
+func (c *giteaClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+	return c.cli.Do(req)
+}
+
+// GetBaseURL implements the REST provider interface
+func (c *giteaClient) GetBaseURL() string {
+	return c.gtcfg.Endpoint
+}
+
+// NewRequest implements the REST provider interface
+func (c *giteaClient) NewRequest(method, requestPath string, body any) (*http.Request, error) {
+	u, err := getParsedURL(c.gtcfg.Endpoint, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		err := enc.Encode(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if method == http.MethodPatch || method == http.MethodPost || method == http.MethodPut {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	req.Header.Set("Accept", "application/json")
+	// TODO: Get User-Agent from constants
+	req.Header.Set("User-Agent", "Minder")
+
+	c.cred.SetAuthorizationHeader(req)
+
+	return req, nil
+}
+
+type genericRESTClient interface {
+	// Do sends an HTTP request and returns an HTTP response
+	Do(ctx context.Context, req *http.Request) (*http.Response, error)
+	NewRequest(method, requestUrl string, body any) (*http.Request, error)
+}
+
+// giteaRESTDo sends a request to the Gitea API and decodes the response
+// into out, unless out is nil. Any status code not listed in okCodes
+// is considered an error.
+func giteaRESTDo(
+	ctx context.Context, cli genericRESTClient, method, path string, body any, out any, okCodes ...int,
+) error {
+	// NewRequest already has the base URL configured, the path
+	// will get appended to it.
+	req, err := cli.NewRequest(method, path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := cli.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to %s resource '%s': %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if !slices.Contains(okCodes, resp.StatusCode) {
+		if resp.StatusCode == http.StatusNotFound {
+			return provifv1.ErrEntityNotFound
+		}
+		return fmt.Errorf("failed to %s resource '%s': %s", method, path, resp.Status)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// giteaRESTGet is a convenience wrapper around giteaRESTDo for GET requests
+func giteaRESTGet[T any](ctx context.Context, cli genericRESTClient, path string, out T) error {
+	return giteaRESTDo(ctx, cli, http.MethodGet, path, nil, out, http.StatusOK)
+}
+
+func getParsedURL(endpoint, path string) (*url.URL, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	// Explicitly parse path and query parameters. This is to ensure that
+	// the path is properly escaped and that the query parameters are
+	// properly encoded.
+	parsedPathAndQuery, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	u := base.JoinPath(parsedPathAndQuery.Path)
+
+	// These have already been escaped by the URL parser
+	u.RawQuery = parsedPathAndQuery.RawQuery
+	u.Fragment = parsedPathAndQuery.Fragment
+
+	return u, nil
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	testWebhookURL = "https://minder.example.com/api/v1/webhook/gitea"
+	testToken      = "test-token"
+)
+
+// newTestClient creates a gitea client talking to the given handler,
+// which stands in for the Gitea API.
+func newTestClient(t *testing.T, handler http.Handler) *giteaClient {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every request must be authenticated with the token
+		assert.Equal(t, "Bearer "+testToken, r.Header.Get("Authorization"))
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := New(
+		credentials.NewGiteaTokenCredential(testToken),
+		&minderv1.GiteaProviderConfig{
+			Endpoint: srv.URL,
+		},
+		testWebhookURL,
+		"test-secret",
+	)
+	require.NoError(t, err)
+
+	return cli
+}
+
+// writeJSON is a helper for the fake Gitea handlers
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	assert.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		cfg          *minderv1.GiteaProviderConfig
+		webhookURL   string
+		wantEndpoint string
+		wantErr      string
+	}{
+		{
+			name:         "defaults to codeberg",
+			cfg:          &minderv1.GiteaProviderConfig{},
+			webhookURL:   testWebhookURL,
+			wantEndpoint: defaultEndpoint,
+		},
+		{
+			name: "self-hosted instance",
+			cfg: &minderv1.GiteaProviderConfig{
+				Endpoint: "https://forgejo.example.com/api/v1",
+			},
+			webhookURL:   testWebhookURL,
+			wantEndpoint: "https://forgejo.example.com/api/v1",
+		},
+		{
+			name: "invalid endpoint",
+			cfg: &minderv1.GiteaProviderConfig{
+				Endpoint: "ftp://forgejo.example.com",
+			},
+			webhookURL: testWebhookURL,
+			wantErr:    "invalid gitea config",
+		},
+		{
+			name:       "missing webhook URL",
+			cfg:        &minderv1.GiteaProviderConfig{},
+			webhookURL: "",
+			wantErr:    "webhook URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := New(credentials.NewGiteaTokenCredential(testToken), tt.cfg, tt.webhookURL, "secret")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEndpoint, cli.GetBaseURL())
+		})
+	}
+}
+
+func TestParseAndMarshalV1Config(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseV1Config(json.RawMessage(`{}`))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.GetEndpoint())
+
+	cfg, err = ParseV1Config(json.RawMessage(`{"gitea": {"endpoint": "https://forgejo.example.com/api/v1"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://forgejo.example.com/api/v1", cfg.GetEndpoint())
+
+	out, err := MarshalV1Config(json.RawMessage(`{"gitea": {"organization": "mindersec"}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"gitea": {"organization": "mindersec"}}`, string(out))
+
+	_, err = MarshalV1Config(json.RawMessage(`{"gitea": {"endpoint": "not a url"}}`))
+	assert.Error(t, err)
+}
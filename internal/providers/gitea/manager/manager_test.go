@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manager contains the GiteaProviderClassManager
+package manager
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/providers/gitea"
+	"github.com/mindersec/minder/internal/providers/gitea/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func Test_tokenNeedsRefresh(t *testing.T) {
+	t.Parallel()
+
+	baseTime := time.Now()
+
+	tests := []struct {
+		name  string
+		token oauth2.Token
+		want  bool
+	}{
+		{
+			name:  "token is expired",
+			token: accessTokenWithExpiration(baseTime.Add(-1 * time.Minute)),
+			want:  true,
+		},
+		{
+			name:  "token is not expired and does not need refresh",
+			token: accessTokenWithExpiration(baseTime.Add(15 * time.Minute)),
+			want:  false,
+		},
+		{
+			name:  "token is not expired but needs refresh",
+			token: accessTokenWithExpiration(baseTime.Add(5 * time.Minute)),
+			want:  true,
+		},
+		{
+			name: "token is not valid",
+			token: oauth2.Token{
+				AccessToken:  "",
+				RefreshToken: "refresh",
+				Expiry:       baseTime.Add(15 * time.Minute),
+			},
+			want: true,
+		},
+		{
+			name: "stored an access token without refresh token",
+			token: oauth2.Token{
+				AccessToken: "notarealtoken",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			needsRefresh := tokenNeedsRefresh(tt.token)
+			assert.Equal(t, tt.want, needsRefresh)
+		})
+	}
+}
+
+func accessTokenWithExpiration(exp time.Time) oauth2.Token {
+	return oauth2.Token{
+		AccessToken:  "ozz-likes-beer",
+		RefreshToken: "ozz-likes-more-beer",
+		Expiry:       exp,
+	}
+}
+
+const (
+	testSecret   = "current-secret"
+	testHookUUID = "3b4b5a2e-7e9b-4a3f-9c2d-1d2e3f4a5b6c"
+)
+
+const pushPayload = `{
+	"ref": "refs/heads/main",
+	"repository": {"id": 42, "name": "minder", "owner": {"login": "mindersec"}}
+}`
+
+func pullRequestPayload(action string) string {
+	return `{
+	"action": "` + action + `",
+	"number": 7,
+	"pull_request": {"id": 700, "number": 7},
+	"repository": {"id": 42, "name": "minder", "owner": {"login": "mindersec"}}
+}`
+}
+
+func TestWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		event      string
+		giteaOnly  bool
+		payload    string
+		secret     string
+		noSig      bool
+		wantStatus int
+		wantTopic  string
+		wantEntity minderv1.Entity
+		wantProps  map[string]any
+	}{
+		{
+			name:       "push refreshes the repository",
+			event:      eventPush,
+			payload:    pushPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+			wantProps: map[string]any{
+				properties.PropertyUpstreamID: "42",
+				gitea.RepoPropertyOwner:       "mindersec",
+				gitea.RepoPropertyName:        "minder",
+			},
+		},
+		{
+			name:       "gitea headers are accepted",
+			event:      eventPush,
+			giteaOnly:  true,
+			payload:    pushPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+		},
+		{
+			name:       "previous secrets are accepted",
+			event:      eventPush,
+			payload:    pushPayload,
+			secret:     "previous-secret",
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_REPOSITORIES,
+		},
+		{
+			name:       "pull request opened",
+			event:      eventPullRequest,
+			payload:    pullRequestPayload("opened"),
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueOriginatingEntityAdd,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			wantProps: map[string]any{
+				properties.PropertyUpstreamID: "700",
+				gitea.RepoPropertyOwner:       "mindersec",
+				gitea.RepoPropertyName:        "minder",
+			},
+		},
+		{
+			name:       "pull request reopened",
+			event:      eventPullRequest,
+			payload:    pullRequestPayload("reopened"),
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueOriginatingEntityAdd,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:       "pull request synchronized",
+			event:      eventPullRequest,
+			payload:    pullRequestPayload("synchronized"),
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:       "pull request closed",
+			event:      eventPullRequest,
+			payload:    pullRequestPayload("closed"),
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+			wantTopic:  constants.TopicQueueOriginatingEntityDelete,
+			wantEntity: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:       "unhandled events are ignored",
+			event:      "issues",
+			payload:    pushPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing signature",
+			event:      eventPush,
+			payload:    pushPayload,
+			noSig:      true,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid signature",
+			event:      eventPush,
+			payload:    pushPayload,
+			secret:     "wrong-secret",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing event header",
+			payload:    pushPayload,
+			secret:     testSecret,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid payload",
+			event:      eventPush,
+			payload:    `{"repository": {}}`,
+			secret:     testSecret,
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pub := &stubs.StubEventer{}
+			m := &providerClassManager{
+				parentContext:          context.Background(),
+				pub:                    pub,
+				currentWebhookSecret:   testSecret,
+				previousWebhookSecrets: []string{"previous-secret"},
+			}
+
+			eventHeader, signatureHeader := forgejoEventHeader, forgejoSignatureHeader
+			if tt.giteaOnly {
+				eventHeader, signatureHeader = giteaEventHeader, giteaSignatureHeader
+			}
+
+			req := httptest.NewRequest(http.MethodPost,
+				"/api/v1/webhook/gitea/"+testHookUUID, bytes.NewBufferString(tt.payload))
+			if tt.event != "" {
+				req.Header.Set(eventHeader, tt.event)
+			}
+			if !tt.noSig {
+				sec, err := webhooksecret.New(tt.secret, testHookUUID)
+				require.NoError(t, err)
+				req.Header.Set(signatureHeader, webhooksecret.Sign(sec, []byte(tt.payload)))
+			}
+
+			rec := httptest.NewRecorder()
+			m.GetWebhookHandler().ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantTopic == "" {
+				assert.Empty(t, pub.Sent)
+				return
+			}
+
+			require.Len(t, pub.Sent, 1)
+			assert.Equal(t, []string{tt.wantTopic}, pub.Topics)
+
+			msg, err := entmsg.ToEntityRefreshAndDo(pub.Sent[0])
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEntity, msg.Entity.Type)
+			assert.Equal(t, gitea.Class, msg.Hint.ProviderClassHint)
+			for k, v := range tt.wantProps {
+				assert.Equal(t, v, msg.Entity.GetByProps[k], "property %s", k)
+			}
+			if tt.wantEntity == minderv1.Entity_ENTITY_PULL_REQUESTS {
+				assert.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, msg.Originator.Type)
+				props := properties.NewProperties(msg.Entity.GetByProps)
+				assert.Equal(t, int64(7), props.GetProperty(gitea.PullRequestNumber).GetInt64())
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/gitea/webhooksecret"
+)
+
+const (
+	// MaxBytesLimit is the maximum number of bytes to read from the response body
+	// We limit to 1MB to prevent abuse
+	MaxBytesLimit int64 = 1 << 20
+
+	// Forgejo sends both its own headers and the Gitea ones, while
+	// Gitea only sends the latter. We prefer the Forgejo headers.
+	forgejoEventHeader     = "X-Forgejo-Event"
+	giteaEventHeader       = "X-Gitea-Event"
+	forgejoSignatureHeader = "X-Forgejo-Signature"
+	giteaSignatureHeader   = "X-Gitea-Signature"
+)
+
+// Event types sent by Gitea and Forgejo
+const (
+	eventPush        = "push"
+	eventPullRequest = "pull_request"
+)
+
+// GetWebhookHandler implements the ProviderManager interface
+// Note that this is where the whole webhook handler is defined and
+// will live.
+func (m *providerClassManager) GetWebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := zerolog.Ctx(m.parentContext).With().
+			Str("webhook", "gitea").
+			Str("method", r.Method).
+			Str("path", r.URL.Path).
+			Str("remote", r.RemoteAddr).
+			Str("user-agent", r.UserAgent()).
+			Str("content-type", r.Header.Get("Content-Type")).
+			Logger()
+
+		eventType := getHeader(r, forgejoEventHeader, giteaEventHeader)
+		if eventType == "" {
+			l.Error().Msg("missing X-Gitea-Event header")
+			http.Error(w, "missing X-Gitea-Event header", http.StatusBadRequest)
+			return
+		}
+
+		l = l.With().Str("event", eventType).Logger()
+
+		// The signature covers the whole payload, so we need to read
+		// it before validating the request.
+		payload, err := io.ReadAll(wrapSafe(r.Body))
+		if err != nil {
+			l.Error().Err(err).Msg("error reading webhook payload")
+			http.Error(w, "error reading webhook payload", http.StatusBadRequest)
+			return
+		}
+
+		// Validate the webhook signature
+		if err := m.validateRequest(r, payload); err != nil {
+			l.Error().Err(err).Msg("invalid webhook request")
+			http.Error(w, "invalid webhook request", http.StatusUnauthorized)
+			return
+		}
+
+		disp := m.getWebhookEventDispatcher(eventType)
+
+		if err := disp(l, payload); err != nil {
+			l.Error().Err(err).Msg("error handling webhook event")
+			http.Error(w, "error handling webhook event", http.StatusInternalServerError)
+			return
+		}
+
+		l.Debug().Msg("processed webhook event successfully")
+	})
+}
+
+// getWebhookEventDispatcher returns the appropriate webhook event dispatcher for the given event type
+// It returns a function that is meant to do the actual handling of the event.
+func (m *providerClassManager) getWebhookEventDispatcher(
+	eventType string,
+) func(l zerolog.Logger, payload []byte) error {
+	switch eventType {
+	case eventPush:
+		return m.handleRepoPush
+	case eventPullRequest:
+		return m.handlePullRequest
+	default:
+		return m.handleNoop
+	}
+}
+
+// handleNoop is a no-op handler for unhandled webhook events
+func (*providerClassManager) handleNoop(l zerolog.Logger, _ []byte) error {
+	l.Debug().Msg("unhandled webhook event")
+	return nil
+}
+
+func (m *providerClassManager) validateRequest(r *http.Request, payload []byte) error {
+	signature := getHeader(r, forgejoSignatureHeader, giteaSignatureHeader)
+	if signature == "" {
+		return errors.New("missing X-Gitea-Signature header")
+	}
+
+	if err := m.validateSignature(signature, payload, r); err != nil {
+		return fmt.Errorf("invalid X-Gitea-Signature header: %w", err)
+	}
+
+	return nil
+}
+
+// validateSignature validates the signature of the incoming Gitea webhook.
+// The secret of each webhook is derived from the configured secret and the
+// last element of the path of the webhook URL (which is unique per entity).
+func (m *providerClassManager) validateSignature(signature string, payload []byte, req *http.Request) error {
+	// Extract the unique ID from the URL path
+	path := req.URL.Path
+	uniq := path[strings.LastIndex(path, "/")+1:]
+
+	// uniq must be a valid UUID
+	_, err := uuid.Parse(uniq)
+	if err != nil {
+		return errors.New("invalid unique ID")
+	}
+
+	if valid := webhooksecret.Verify(m.currentWebhookSecret, uniq, payload, signature); valid {
+		// If the signature is valid, we can return
+		return nil
+	}
+
+	// Check the previous secrets
+	for _, prev := range m.previousWebhookSecrets {
+		if valid := webhooksecret.Verify(prev, uniq, payload, signature); valid {
+			return nil
+		}
+	}
+
+	return errors.New("invalid webhook signature")
+}
+
+// getHeader returns the value of the first of the headers that is set
+func getHeader(r *http.Request, headers ...string) string {
+	for _, h := range headers {
+		if v := r.Header.Get(h); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// wrapSafe wraps the io.Reader in a LimitReader to prevent abuse
+func wrapSafe(r io.Reader) io.Reader {
+	return io.LimitReader(r, MaxBytesLimit)
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/gitea"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// Pull request actions sent by Gitea
+const (
+	pullRequestActionOpened   = "opened"
+	pullRequestActionReopened = "reopened"
+	pullRequestActionClosed   = "closed"
+)
+
+func (m *providerClassManager) handlePullRequest(l zerolog.Logger, payload []byte) error {
+	l.Debug().Msg("handling pull request event")
+
+	prEvent := struct {
+		Action      string `json:"action"`
+		PullRequest struct {
+			ID     int64 `json:"id"`
+			Number int64 `json:"number"`
+		} `json:"pull_request"`
+		Repository repository `json:"repository"`
+	}{}
+	if err := json.Unmarshal(payload, &prEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding pull request event")
+		return fmt.Errorf("error decoding pull request event: %w", err)
+	}
+
+	if prEvent.PullRequest.ID == 0 || prEvent.PullRequest.Number == 0 {
+		return fmt.Errorf("pull request event missing ID or number")
+	}
+
+	repoProps, err := prEvent.Repository.identifyingProperties()
+	if err != nil {
+		return fmt.Errorf("invalid pull request event: %w", err)
+	}
+
+	var topic string
+	switch prEvent.Action {
+	case pullRequestActionOpened, pullRequestActionReopened:
+		topic = constants.TopicQueueOriginatingEntityAdd
+	case pullRequestActionClosed:
+		topic = constants.TopicQueueOriginatingEntityDelete
+	default:
+		// synchronized, edited, labels, reviews etc.
+		topic = constants.TopicQueueRefreshEntityAndEvaluate
+	}
+
+	return m.publishPullRequestMessage(prEvent.PullRequest.ID, prEvent.PullRequest.Number, repoProps, topic)
+}
+
+func (m *providerClassManager) publishPullRequestMessage(
+	id, number int64, repoIdentifyingProps *properties.Properties, queueTopic string) error {
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: gitea.FormatPullRequestUpstreamID(id),
+		gitea.PullRequestNumber:       number,
+		gitea.RepoPropertyOwner:       repoIdentifyingProps.GetProperty(gitea.RepoPropertyOwner).GetString(),
+		gitea.RepoPropertyName:        repoIdentifyingProps.GetProperty(gitea.RepoPropertyName).GetString(),
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_PULL_REQUESTS, identifyingProps)
+	outm.WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, repoIdentifyingProps)
+	outm.WithProviderClassHint(gitea.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	if err := m.pub.Publish(queueTopic, msg); err != nil {
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/gitea"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// repository is the repository as sent in Gitea webhook payloads
+type repository struct {
+	ID    int64 `json:"id"`
+	Owner struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+	Name string `json:"name"`
+}
+
+// identifyingProperties returns the properties used to look up the
+// repository. We include the owner and name so that the provider
+// doesn't need an extra lookup by ID.
+func (r *repository) identifyingProperties() (*properties.Properties, error) {
+	if r.ID == 0 || r.Owner.Login == "" || r.Name == "" {
+		return nil, fmt.Errorf("repository is missing id, owner or name")
+	}
+
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: gitea.FormatRepositoryUpstreamID(r.ID),
+		gitea.RepoPropertyOwner:       r.Owner.Login,
+		gitea.RepoPropertyName:        r.Name,
+	}), nil
+}
+
+func (m *providerClassManager) handleRepoPush(l zerolog.Logger, payload []byte) error {
+	l.Debug().Msg("handling push event")
+
+	pushEvent := struct {
+		Repository repository `json:"repository"`
+	}{}
+	if err := json.Unmarshal(payload, &pushEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding push event")
+		return fmt.Errorf("error decoding push event: %w", err)
+	}
+
+	props, err := pushEvent.Repository.identifyingProperties()
+	if err != nil {
+		l.Error().Err(err).Msg("invalid push event")
+		return fmt.Errorf("invalid push event: %w", err)
+	}
+
+	return m.publishRefreshAndEvalForRepository(l, props)
+}
+
+func (m *providerClassManager) publishRefreshAndEvalForRepository(
+	l zerolog.Logger, identifyingProps *properties.Properties) error {
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, identifyingProps)
+	outm.WithProviderClassHint(gitea.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		l.Error().Err(err).Msg("error converting message to protobuf")
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	l.Debug().Str("msg_id", msgID).Msg("publishing refresh and eval message")
+	if err := m.pub.Publish(constants.TopicQueueRefreshEntityAndEvaluate, msg); err != nil {
+		l.Error().Err(err).Msg("error publishing refresh and eval message")
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
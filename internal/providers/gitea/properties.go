@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// Repository Properties
+const (
+	// RepoPropertyOwner represents the gitea repository owner (user or organization)
+	RepoPropertyOwner = "gitea/owner"
+	// RepoPropertyName represents the gitea repository name
+	RepoPropertyName = "gitea/repo_name"
+	// RepoPropertyDefaultBranch represents the gitea default branch
+	RepoPropertyDefaultBranch = "gitea/default_branch"
+	// RepoPropertyCloneURL represents the gitea repo clone URL
+	RepoPropertyCloneURL = "gitea/clone_url"
+	// RepoPropertyHookID represents the gitea repo hook ID
+	RepoPropertyHookID = "gitea/hook_id"
+	// RepoPropertyHookURL represents the gitea repo hook URL
+	RepoPropertyHookURL = "gitea/hook_url"
+)
+
+// Pull Request Properties
+const (
+	// PullRequestNumber represents the gitea pull request number
+	PullRequestNumber = "gitea/pull_request_number"
+	// PullRequestAuthor represents the gitea author
+	PullRequestAuthor = "gitea/author"
+)
+
+// FetchAllProperties implements the provider interface
+func (c *giteaClient) FetchAllProperties(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, cachedProps *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+
+	// The cached properties may carry data we can't fetch from the API,
+	// like the webhook we registered, so we use them as a base.
+	lookupProps := cachedProps.Merge(getByProps)
+
+	//nolint:exhaustive // We only support two entity types for now.
+	switch entType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return c.getPropertiesForRepo(ctx, lookupProps)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return c.getPropertiesForPullRequest(ctx, lookupProps)
+	default:
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+}
+
+// FetchProperty implements the provider interface
+// TODO: Implement this
+func (*giteaClient) FetchProperty(
+	_ context.Context, _ *properties.Properties, _ minderv1.Entity, _ string) (*properties.Property, error) {
+	return nil, nil
+}
+
+// GetEntityName implements the provider interface
+func (c *giteaClient) GetEntityName(entityType minderv1.Entity, props *properties.Properties) (string, error) {
+	if props == nil {
+		return "", errors.New("properties are nil")
+	}
+
+	if !c.SupportsEntity(entityType) {
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+
+	//nolint:exhaustive // We only support two entity types for now.
+	switch entityType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return getRepoNameFromProperties(props)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return getPullRequestNameFromProperties(props)
+	default:
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+}
+
+// PropertiesToProtoMessage implements the ProtoMessageConverter interface
+func (c *giteaClient) PropertiesToProtoMessage(
+	entType minderv1.Entity, props *properties.Properties,
+) (protoreflect.ProtoMessage, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s is not supported by the gitea provider", entType)
+	}
+
+	//nolint:exhaustive // We only support two entity types for now.
+	switch entType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return repoV1FromProperties(props)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return pullRequestV1FromProperties(props)
+	default:
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+}
+
+func getStringProp(props *properties.Properties, key string) (string, error) {
+	value, err := props.GetProperty(key).AsString()
+	if err != nil {
+		return "", fmt.Errorf("property %s not found or not a string", key)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func repoBody(id int64, owner, name string, fork bool) map[string]any {
+	return map[string]any{
+		"id":             id,
+		"owner":          map[string]any{"id": 1, "login": owner},
+		"name":           name,
+		"full_name":      owner + "/" + name,
+		"private":        true,
+		"fork":           fork,
+		"archived":       false,
+		"default_branch": "main",
+		"clone_url":      "https://forgejo.example.com/" + owner + "/" + name + ".git",
+		"html_url":       "https://forgejo.example.com/" + owner + "/" + name,
+	}
+}
+
+// giteaHandler is a stand-in for the Gitea API
+func giteaHandler(t *testing.T) http.Handler {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/mindersec/minder", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, repoBody(42, "mindersec", "minder", false))
+	})
+	mux.HandleFunc("GET /repositories/42", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, repoBody(42, "mindersec", "minder", false))
+	})
+	mux.HandleFunc("GET /repos/mindersec/minder/pulls/7", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"id":     700,
+			"number": 7,
+			"user":   map[string]any{"id": 101, "login": "contributor"},
+			"head": map[string]any{
+				"ref":  "feature",
+				"sha":  "abc123",
+				"repo": repoBody(43, "contributor", "minder", true),
+			},
+			"base": map[string]any{
+				"ref":  "release",
+				"sha":  "def456",
+				"repo": repoBody(42, "mindersec", "minder", false),
+			},
+			"html_url": "https://forgejo.example.com/mindersec/minder/pulls/7",
+		})
+	})
+	mux.HandleFunc("GET /orgs/mindersec/repos", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, strconv.Itoa(pageSize), r.URL.Query().Get("limit"))
+
+		var repos []any
+		switch r.URL.Query().Get("page") {
+		case "1":
+			for i := range pageSize {
+				repos = append(repos, repoBody(int64(i+1), "mindersec", fmt.Sprintf("repo-%d", i+1), false))
+			}
+		case "2":
+			repos = append(repos, repoBody(pageSize+1, "mindersec", "last", false))
+		}
+		writeJSON(t, w, http.StatusOK, repos)
+	})
+	return mux
+}
+
+func TestFetchAllPropertiesRepository(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		getByProps map[string]any
+		cached     map[string]any
+		want       map[string]any
+		wantErr    string
+	}{
+		{
+			name: "repository by name",
+			getByProps: map[string]any{
+				properties.PropertyName: "mindersec/minder",
+			},
+			want: map[string]any{
+				properties.PropertyUpstreamID:     "42",
+				properties.PropertyName:           "mindersec/minder",
+				properties.RepoPropertyIsPrivate:  true,
+				properties.RepoPropertyIsArchived: false,
+				properties.RepoPropertyIsFork:     false,
+				RepoPropertyOwner:                 "mindersec",
+				RepoPropertyName:                  "minder",
+				RepoPropertyDefaultBranch:         "main",
+				RepoPropertyCloneURL:              "https://forgejo.example.com/mindersec/minder.git",
+			},
+		},
+		{
+			name: "repository by upstream ID",
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "42",
+			},
+			want: map[string]any{
+				properties.PropertyName: "mindersec/minder",
+			},
+		},
+		{
+			name: "cached webhook properties are preserved",
+			getByProps: map[string]any{
+				properties.PropertyUpstreamID: "42",
+			},
+			cached: map[string]any{
+				RepoPropertyHookURL: testWebhookURL + "/hook",
+			},
+			want: map[string]any{
+				properties.PropertyUpstreamID: "42",
+				RepoPropertyHookURL:           testWebhookURL + "/hook",
+			},
+		},
+		{
+			name: "invalid name",
+			getByProps: map[string]any{
+				properties.PropertyName: "minder",
+			},
+			wantErr: "expected owner/name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := newTestClient(t, giteaHandler(t))
+
+			var cached *properties.Properties
+			if tt.cached != nil {
+				cached = properties.NewProperties(tt.cached)
+			}
+
+			got, err := cli.FetchAllProperties(context.Background(),
+				properties.NewProperties(tt.getByProps), minderv1.Entity_ENTITY_REPOSITORIES, cached)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			for k, v := range tt.want {
+				assert.Equal(t, v, got.GetProperty(k).RawValue(), "property %s", k)
+			}
+		})
+	}
+}
+
+func TestFetchAllPropertiesNotFound(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, giteaHandler(t))
+
+	_, err := cli.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyName: "mindersec/unknown",
+	}), minderv1.Entity_ENTITY_REPOSITORIES, nil)
+	assert.ErrorIs(t, err, provifv1.ErrEntityNotFound)
+}
+
+func TestFetchAllPropertiesPullRequest(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, giteaHandler(t))
+
+	props, err := cli.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: "700",
+		PullRequestNumber:             int64(7),
+		RepoPropertyOwner:             "mindersec",
+		RepoPropertyName:              "minder",
+	}), minderv1.Entity_ENTITY_PULL_REQUESTS, nil)
+	require.NoError(t, err)
+
+	name, err := cli.GetEntityName(minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+	require.NoError(t, err)
+	assert.Equal(t, "mindersec/minder/7", name)
+
+	msg, err := cli.PropertiesToProtoMessage(minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+	require.NoError(t, err)
+
+	pr, ok := msg.(*pbinternal.PullRequest)
+	require.True(t, ok)
+	assert.Equal(t, int64(7), pr.GetNumber())
+	assert.Equal(t, "mindersec", pr.GetRepoOwner())
+	assert.Equal(t, "minder", pr.GetRepoName())
+	assert.Equal(t, "abc123", pr.GetCommitSha())
+	assert.Equal(t, int64(101), pr.GetAuthorId())
+	assert.Equal(t, "https://forgejo.example.com/mindersec/minder/pulls/7", pr.GetUrl())
+	assert.Equal(t, "https://forgejo.example.com/mindersec/minder.git", pr.GetBaseCloneUrl())
+	assert.Equal(t, "https://forgejo.example.com/contributor/minder.git", pr.GetTargetCloneUrl())
+	assert.Equal(t, "release", pr.GetBaseRef())
+	assert.Equal(t, "feature", pr.GetTargetRef())
+
+	// The upstream ID must match the pull request we fetched
+	_, err = cli.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: "701",
+		PullRequestNumber:             int64(7),
+		RepoPropertyOwner:             "mindersec",
+		RepoPropertyName:              "minder",
+	}), minderv1.Entity_ENTITY_PULL_REQUESTS, nil)
+	assert.ErrorContains(t, err, "pull request ID mismatch")
+}
+
+func TestPropertiesToProtoMessageRepository(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, giteaHandler(t))
+
+	props, err := cli.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyName: "mindersec/minder",
+	}), minderv1.Entity_ENTITY_REPOSITORIES, nil)
+	require.NoError(t, err)
+
+	name, err := cli.GetEntityName(minderv1.Entity_ENTITY_REPOSITORIES, props)
+	require.NoError(t, err)
+	assert.Equal(t, "mindersec/minder", name)
+
+	msg, err := cli.PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, props)
+	require.NoError(t, err)
+
+	repo, ok := msg.(*minderv1.Repository)
+	require.True(t, ok)
+	assert.Equal(t, "mindersec", repo.GetOwner())
+	assert.Equal(t, "minder", repo.GetName())
+	assert.Equal(t, int64(42), repo.GetRepoId())
+	assert.Equal(t, "main", repo.GetDefaultBranch())
+	assert.Equal(t, "https://forgejo.example.com/mindersec/minder.git", repo.GetCloneUrl())
+	assert.True(t, repo.GetIsPrivate())
+
+	_, err = cli.PropertiesToProtoMessage(minderv1.Entity_ENTITY_ARTIFACTS, props)
+	assert.Error(t, err)
+}
+
+func TestListAllRepositories(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, giteaHandler(t))
+	cli.gtcfg.Organization = "mindersec"
+
+	repos, err := cli.ListAllRepositories(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, repos, pageSize+1)
+	assert.Equal(t, "repo-1", repos[0].GetName())
+	assert.Equal(t, "last", repos[pageSize].GetName())
+	assert.Equal(t, int64(pageSize+1), repos[pageSize].GetRepoId())
+}
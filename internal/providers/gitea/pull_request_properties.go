@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// FormatPullRequestUpstreamID returns the upstream ID for a gitea pull request
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatPullRequestUpstreamID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func (c *giteaClient) getPropertiesForPullRequest(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	number, err := getByProps.GetProperty(PullRequestNumber).AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("pull request number not found or invalid: %w", err)
+	}
+
+	owner, name, err := repoOwnerAndName(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := c.getPullRequest(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate - pull request upstream ID must match the one we requested
+	uid := FormatPullRequestUpstreamID(pr.ID)
+	if req := getByProps.GetProperty(properties.PropertyUpstreamID).GetString(); req != "" && req != uid {
+		return nil, fmt.Errorf("pull request ID mismatch: %s != %s", uid, req)
+	}
+
+	if pr.Base.Repo == nil {
+		return nil, fmt.Errorf("pull request %d has no base repository", pr.Number)
+	}
+
+	return pullRequestToProperties(pr), nil
+}
+
+func pullRequestToProperties(pr *pullRequest) *properties.Properties {
+	base := pr.Base.Repo
+
+	// The head repository is gone if the fork was deleted,
+	// in which case we can only fetch the PR from the base.
+	targetCloneURL := base.CloneURL
+	if pr.Head.Repo != nil {
+		targetCloneURL = pr.Head.Repo.CloneURL
+	}
+
+	return properties.NewProperties(map[string]any{
+		// Unique upstream ID for the pull request
+		properties.PropertyUpstreamID:           FormatPullRequestUpstreamID(pr.ID),
+		properties.PropertyName:                 formatPullRequestName(base.Owner.Login, base.Name, pr.Number),
+		properties.PullRequestCommitSHA:         pr.Head.Sha,
+		properties.PullRequestBaseCloneURL:      base.CloneURL,
+		properties.PullRequestBaseBranch:        pr.Base.Ref,
+		properties.PullRequestBaseDefaultBranch: base.DefaultBranch,
+		properties.PullRequestTargetCloneURL:    targetCloneURL,
+		properties.PullRequestTargetBranch:      pr.Head.Ref,
+		properties.PullRequestUpstreamURL:       pr.HTMLURL,
+		RepoPropertyOwner:                       base.Owner.Login,
+		RepoPropertyName:                        base.Name,
+		PullRequestNumber:                       pr.Number,
+		PullRequestAuthor:                       pr.User.ID,
+	})
+}
+
+func pullRequestV1FromProperties(prProps *properties.Properties) (*pbinternal.PullRequest, error) {
+	_, err := prProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream ID: %w", err)
+	}
+
+	number := prProps.GetProperty(PullRequestNumber).GetInt64()
+	if number == 0 {
+		return nil, fmt.Errorf("failed to get pull request number: %w", provifv1.ErrEntityNotFound)
+	}
+
+	owner, err := getStringProp(prProps, RepoPropertyOwner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	name, err := getStringProp(prProps, RepoPropertyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository name: %w", err)
+	}
+
+	commitSha, err := getStringProp(prProps, properties.PullRequestCommitSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	prURL, err := getStringProp(prProps, properties.PullRequestUpstreamURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request URL: %w", err)
+	}
+
+	authorID, err := prProps.GetProperty(PullRequestAuthor).AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author ID: %w", err)
+	}
+
+	pbPR := &pbinternal.PullRequest{
+		Number:         number,
+		RepoOwner:      owner,
+		RepoName:       name,
+		CommitSha:      commitSha,
+		AuthorId:       authorID,
+		Url:            prURL,
+		BaseCloneUrl:   prProps.GetProperty(properties.PullRequestBaseCloneURL).GetString(),
+		TargetCloneUrl: prProps.GetProperty(properties.PullRequestTargetCloneURL).GetString(),
+		BaseRef:        prProps.GetProperty(properties.PullRequestBaseBranch).GetString(),
+		TargetRef:      prProps.GetProperty(properties.PullRequestTargetBranch).GetString(),
+		Properties:     prProps.ToProtoStruct(),
+	}
+
+	return pbPR, nil
+}
+
+func getPullRequestNameFromProperties(props *properties.Properties) (string, error) {
+	owner, err := getStringProp(props, RepoPropertyOwner)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := getStringProp(props, RepoPropertyName)
+	if err != nil {
+		return "", err
+	}
+
+	number, err := props.GetProperty(PullRequestNumber).AsInt64()
+	if err != nil {
+		return "", fmt.Errorf("property %s not found or not a number", PullRequestNumber)
+	}
+
+	return formatPullRequestName(owner, name, number), nil
+}
+
+func formatPullRequestName(owner, name string, number int64) string {
+	return fmt.Sprintf("%s/%s/%d", owner, name, number)
+}
@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+// The PR actions of the evaluators are written against the GitHub API.
+// Gitea's API is close enough that we can translate the calls, which
+// makes the review, comment and commit_status actions work on Gitea.
+var _ interfaces.GitHubIssuePRClient = (*giteaClient)(nil)
+var _ interfaces.SelfAwareness = (*giteaClient)(nil)
+
+// commitStatus is a Gitea commit status. Note that Gitea calls the
+// state "status" in responses, but "state" in requests.
+type commitStatus struct {
+	ID          int64     `json:"id"`
+	Status      string    `json:"status"`
+	TargetURL   string    `json:"target_url"`
+	Description string    `json:"description"`
+	Context     string    `json:"context"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type createStatusRequest struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context,omitempty"`
+}
+
+// review is a Gitea pull request review
+type review struct {
+	ID          int64     `json:"id"`
+	Body        string    `json:"body"`
+	User        user      `json:"user"`
+	State       string    `json:"state"`
+	CommitID    string    `json:"commit_id"`
+	HTMLURL     string    `json:"html_url"`
+	Dismissed   bool      `json:"dismissed"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type reviewComment struct {
+	Path        string `json:"path"`
+	Body        string `json:"body"`
+	NewPosition int64  `json:"new_position,omitempty"`
+}
+
+type createReviewRequest struct {
+	Body     string           `json:"body,omitempty"`
+	CommitID string           `json:"commit_id,omitempty"`
+	Event    string           `json:"event,omitempty"`
+	Comments []*reviewComment `json:"comments,omitempty"`
+}
+
+// issueComment is a Gitea issue or pull request comment
+type issueComment struct {
+	ID        int64     `json:"id"`
+	Body      string    `json:"body"`
+	User      user      `json:"user"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commentRequest struct {
+	Body string `json:"body"`
+}
+
+// SetCommitStatus implements the CommitStatusPublisher interface
+func (c *giteaClient) SetCommitStatus(
+	ctx context.Context, owner, repo, ref string, status *github.RepoStatus,
+) (*github.RepoStatus, error) {
+	path, err := repoPath(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &commitStatus{}
+	if err := giteaRESTDo(ctx, c, http.MethodPost, path+"/statuses/"+url.PathEscape(ref), &createStatusRequest{
+		State:       status.GetState(),
+		TargetURL:   status.GetTargetURL(),
+		Description: status.GetDescription(),
+		Context:     status.GetContext(),
+	}, out, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	return &github.RepoStatus{
+		ID:          github.Int64(out.ID),
+		URL:         github.String(out.URL),
+		State:       github.String(out.Status),
+		TargetURL:   github.String(out.TargetURL),
+		Description: github.String(out.Description),
+		Context:     github.String(out.Context),
+		CreatedAt:   &github.Timestamp{Time: out.CreatedAt},
+		UpdatedAt:   &github.Timestamp{Time: out.UpdatedAt},
+	}, nil
+}
+
+// ListReviews implements the GitHubIssuePRClient interface
+func (c *giteaClient) ListReviews(
+	ctx context.Context, owner, repo string, number int, _ *github.ListOptions,
+) ([]*github.PullRequestReview, error) {
+	path, err := pullRequestPath(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := getAll[*review](ctx, c, path+"/reviews")
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*github.PullRequestReview, 0, len(reviews))
+	for _, r := range reviews {
+		out = append(out, r.toGitHub())
+	}
+	return out, nil
+}
+
+// CreateReview implements the GitHubIssuePRClient interface
+func (c *giteaClient) CreateReview(
+	ctx context.Context, owner, repo string, number int, req *github.PullRequestReviewRequest,
+) (*github.PullRequestReview, error) {
+	path, err := pullRequestPath(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	greq := &createReviewRequest{
+		Body:     req.GetBody(),
+		CommitID: req.GetCommitID(),
+		Event:    reviewEventFromGitHub(req.GetEvent()),
+	}
+	for _, comment := range req.Comments {
+		greq.Comments = append(greq.Comments, &reviewComment{
+			Path: comment.GetPath(),
+			Body: comment.GetBody(),
+			// Gitea has no multi-line comments, so we anchor
+			// the comment to the last line of the range.
+			NewPosition: int64(comment.GetLine()),
+		})
+	}
+
+	out := &review{}
+	if err := giteaRESTDo(ctx, c, http.MethodPost, path+"/reviews", greq, out, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return out.toGitHub(), nil
+}
+
+// DismissReview implements the GitHubIssuePRClient interface
+func (c *giteaClient) DismissReview(
+	ctx context.Context, owner, repo string, number int, reviewID int64,
+	req *github.PullRequestReviewDismissalRequest,
+) (*github.PullRequestReview, error) {
+	path, err := pullRequestPath(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &review{}
+	if err := giteaRESTDo(ctx, c, http.MethodPost,
+		path+"/reviews/"+strconv.FormatInt(reviewID, 10)+"/dismissals",
+		map[string]string{"message": req.GetMessage()}, out, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return out.toGitHub(), nil
+}
+
+// ListIssueComments implements the GitHubIssuePRClient interface
+func (c *giteaClient) ListIssueComments(
+	ctx context.Context, owner, repo string, number int, _ *github.IssueListCommentsOptions,
+) ([]*github.IssueComment, error) {
+	path, err := issuePath(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gitea returns the comments in ascending order of creation
+	// and doesn't paginate this endpoint.
+	var comments []*issueComment
+	if err := giteaRESTGet(ctx, c, path+"/comments", &comments); err != nil {
+		return nil, err
+	}
+
+	out := make([]*github.IssueComment, 0, len(comments))
+	for _, comment := range comments {
+		out = append(out, comment.toGitHub())
+	}
+	return out, nil
+}
+
+// CreateIssueComment implements the GitHubIssuePRClient interface
+func (c *giteaClient) CreateIssueComment(
+	ctx context.Context, owner, repo string, number int, comment string,
+) (*github.IssueComment, error) {
+	path, err := issuePath(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &issueComment{}
+	if err := giteaRESTDo(ctx, c, http.MethodPost, path+"/comments",
+		&commentRequest{Body: comment}, out, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return out.toGitHub(), nil
+}
+
+// UpdateIssueComment implements the GitHubIssuePRClient interface
+func (c *giteaClient) UpdateIssueComment(ctx context.Context, owner, repo string, id int64, comment string) error {
+	path, err := repoPath(owner, repo)
+	if err != nil {
+		return err
+	}
+
+	return giteaRESTDo(ctx, c, http.MethodPatch, path+"/issues/comments/"+strconv.FormatInt(id, 10),
+		&commentRequest{Body: comment}, nil, http.StatusOK)
+}
+
+// GetUserId implements the SelfAwareness interface
+func (c *giteaClient) GetUserId(ctx context.Context) (int64, error) {
+	u := &user{}
+	if err := giteaRESTGet(ctx, c, "user", u); err != nil {
+		return 0, fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+	return u.ID, nil
+}
+
+func pullRequestPath(owner, repo string, number int) (string, error) {
+	path, err := repoPath(owner, repo)
+	if err != nil {
+		return "", err
+	}
+	return path + "/pulls/" + strconv.Itoa(number), nil
+}
+
+// issuePath returns the issue path for a pull request. Gitea, like
+// GitHub, handles pull request comments through the issues API.
+func issuePath(owner, repo string, number int) (string, error) {
+	path, err := repoPath(owner, repo)
+	if err != nil {
+		return "", err
+	}
+	return path + "/issues/" + strconv.Itoa(number), nil
+}
+
+// reviewEventFromGitHub maps the GitHub review events to Gitea's
+func reviewEventFromGitHub(event string) string {
+	// REQUEST_CHANGES and COMMENT are the same in both, and
+	// an empty event leaves the review pending in both.
+	if event == "APPROVE" {
+		return "APPROVED"
+	}
+	return event
+}
+
+// reviewStateToGitHub maps the Gitea review states to GitHub's
+func reviewStateToGitHub(state string, dismissed bool) string {
+	if dismissed {
+		return "DISMISSED"
+	}
+
+	switch state {
+	case "REQUEST_CHANGES":
+		return "CHANGES_REQUESTED"
+	case "COMMENT":
+		return "COMMENTED"
+	default:
+		return state
+	}
+}
+
+func (r *review) toGitHub() *github.PullRequestReview {
+	return &github.PullRequestReview{
+		ID:          github.Int64(r.ID),
+		Body:        github.String(r.Body),
+		User:        &github.User{ID: github.Int64(r.User.ID), Login: github.String(r.User.Login)},
+		State:       github.String(reviewStateToGitHub(r.State, r.Dismissed)),
+		CommitID:    github.String(r.CommitID),
+		HTMLURL:     github.String(r.HTMLURL),
+		SubmittedAt: &github.Timestamp{Time: r.SubmittedAt},
+	}
+}
+
+func (ic *issueComment) toGitHub() *github.IssueComment {
+	return &github.IssueComment{
+		ID:        github.Int64(ic.ID),
+		Body:      github.String(ic.Body),
+		User:      &github.User{ID: github.Int64(ic.User.ID), Login: github.String(ic.User.Login)},
+		HTMLURL:   github.String(ic.HTMLURL),
+		CreatedAt: &github.Timestamp{Time: ic.CreatedAt},
+		UpdatedAt: &github.Timestamp{Time: ic.UpdatedAt},
+	}
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCommitStatus(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repos/mindersec/minder/statuses/abc123", r.URL.Path)
+
+		req := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]any{
+			"state":       "failure",
+			"description": "Minder found vulnerabilities",
+			"context":     "minder.stacklok.dev/pr-vulncheck",
+		}, req)
+
+		writeJSON(t, w, http.StatusCreated, map[string]any{
+			"id":          9,
+			"status":      "failure",
+			"description": "Minder found vulnerabilities",
+			"context":     "minder.stacklok.dev/pr-vulncheck",
+		})
+	}))
+
+	got, err := cli.SetCommitStatus(context.Background(), "mindersec", "minder", "abc123", &github.RepoStatus{
+		State:       github.String("failure"),
+		Description: github.String("Minder found vulnerabilities"),
+		Context:     github.String("minder.stacklok.dev/pr-vulncheck"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), got.GetID())
+	assert.Equal(t, "failure", got.GetState())
+}
+
+func TestCreateReview(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repos/mindersec/minder/pulls/7/reviews", r.URL.Path)
+
+		req := &createReviewRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		assert.Equal(t, "REQUEST_CHANGES", req.Event)
+		assert.Equal(t, "abc123", req.CommitID)
+		require.Len(t, req.Comments, 1)
+		assert.Equal(t, &reviewComment{Path: "go.mod", Body: "vulnerable", NewPosition: 12}, req.Comments[0])
+
+		writeJSON(t, w, http.StatusOK, map[string]any{
+			"id":    5,
+			"state": "REQUEST_CHANGES",
+			"user":  map[string]any{"id": 1, "login": "minder"},
+		})
+	}))
+
+	got, err := cli.CreateReview(context.Background(), "mindersec", "minder", 7, &github.PullRequestReviewRequest{
+		CommitID: github.String("abc123"),
+		Event:    github.String("REQUEST_CHANGES"),
+		Comments: []*github.DraftReviewComment{
+			{Path: github.String("go.mod"), Body: github.String("vulnerable"), Line: github.Int(12)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), got.GetID())
+	assert.Equal(t, "CHANGES_REQUESTED", got.GetState())
+	assert.Equal(t, int64(1), got.GetUser().GetID())
+}
+
+func TestListReviews(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/mindersec/minder/pulls/7/reviews", r.URL.Path)
+		writeJSON(t, w, http.StatusOK, []map[string]any{
+			{"id": 1, "state": "APPROVED"},
+			{"id": 2, "state": "COMMENT"},
+			{"id": 3, "state": "REQUEST_CHANGES", "dismissed": true},
+		})
+	}))
+
+	got, err := cli.ListReviews(context.Background(), "mindersec", "minder", 7, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, "APPROVED", got[0].GetState())
+	assert.Equal(t, "COMMENTED", got[1].GetState())
+	assert.Equal(t, "DISMISSED", got[2].GetState())
+}
+
+func TestIssueComments(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/mindersec/minder/issues/7/comments", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, []map[string]any{
+			{"id": 1, "body": "first", "user": map[string]any{"id": 1}},
+		})
+	})
+	mux.HandleFunc("POST /repos/mindersec/minder/issues/7/comments", func(w http.ResponseWriter, r *http.Request) {
+		req := &commentRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		writeJSON(t, w, http.StatusCreated, map[string]any{"id": 2, "body": req.Body})
+	})
+	mux.HandleFunc("PATCH /repos/mindersec/minder/issues/comments/2", func(w http.ResponseWriter, r *http.Request) {
+		req := &commentRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		assert.Equal(t, "updated", req.Body)
+		writeJSON(t, w, http.StatusOK, map[string]any{"id": 2, "body": req.Body})
+	})
+	cli := newTestClient(t, mux)
+
+	comments, err := cli.ListIssueComments(context.Background(), "mindersec", "minder", 7, nil)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "first", comments[0].GetBody())
+
+	created, err := cli.CreateIssueComment(context.Background(), "mindersec", "minder", 7, "second")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), created.GetID())
+	assert.Equal(t, "second", created.GetBody())
+
+	err = cli.UpdateIssueComment(context.Background(), "mindersec", "minder", created.GetID(), "updated")
+	assert.NoError(t, err)
+}
+
+func TestGetUserId(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/user", r.URL.Path)
+		writeJSON(t, w, http.StatusOK, map[string]any{"id": 101, "login": "minder"})
+	}))
+
+	id, err := cli.GetUserId(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), id)
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/gitea/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// RegisterEntity implements the Provider interface
+func (c *giteaClient) RegisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, provifv1.ErrUnsupportedEntity
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		// We only explicitly register repositories
+		// Pull requests are handled via origination
+		return props, nil
+	}
+
+	owner, name, err := repoOwnerAndName(props)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cleanUpStaleWebhooks(ctx, owner, name); err != nil {
+		// This is a non-fatal error and may be transient. We log it and
+		// continue with the registration.
+		zerolog.Ctx(ctx).Error().
+			Str("repository", formatRepoName(owner, name)).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to clean up stale webhooks")
+	}
+
+	whprops, err := c.createWebhook(ctx, owner, name)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("repository", formatRepoName(owner, name)).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to create webhook")
+		return nil, errors.New("failed to create webhook")
+	}
+
+	return props.Merge(whprops), nil
+}
+
+// DeregisterEntity implements the Provider interface
+func (c *giteaClient) DeregisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) error {
+	if !c.SupportsEntity(entType) {
+		return errors.New("unsupported entity type")
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		// Only repositories have webhooks
+		return nil
+	}
+
+	owner, name, err := repoOwnerAndName(props)
+	if err != nil {
+		return err
+	}
+
+	hookID, err := props.GetProperty(RepoPropertyHookID).AsInt64()
+	if err != nil {
+		return fmt.Errorf("missing hook ID: %w", err)
+	}
+
+	if err := c.deleteHook(ctx, owner, name, hookID); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (c *giteaClient) createWebhook(ctx context.Context, owner, name string) (*properties.Properties, error) {
+	hookUUID := uuid.New()
+	webhookUniqueURL, err := url.JoinPath(c.webhookURL, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhook: %w", err)
+	}
+
+	sec, err := webhooksecret.New(c.currentWebhookSecret, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook secret: %w", err)
+	}
+
+	h, err := c.createHook(ctx, owner, name, webhookUniqueURL, sec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	outProps := properties.NewProperties(map[string]any{
+		RepoPropertyHookID:  h.ID,
+		RepoPropertyHookURL: webhookUniqueURL,
+	})
+
+	return outProps, nil
+}
+
+func (c *giteaClient) cleanUpStaleWebhooks(ctx context.Context, owner, name string) error {
+	hooks, err := c.listHooks(ctx, owner, name)
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	for _, h := range hooks {
+		if strings.HasPrefix(h.Config["url"], c.webhookURL) {
+			if err := c.deleteHook(ctx, owner, name, h.ID); err != nil {
+				return fmt.Errorf("failed to delete webhook: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
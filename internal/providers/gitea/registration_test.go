@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/gitea/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	testhelper "github.com/mindersec/minder/pkg/providers/v1/testing"
+)
+
+func TestRegistration(t *testing.T) {
+	t.Parallel()
+	// We don't need a full constructor here, so we're naughty
+	gtc := &giteaClient{}
+	testhelper.CheckRegistrationExcept(t, gtc, minderv1.Entity_ENTITY_REPOSITORIES)
+}
+
+func TestRegisterEntity(t *testing.T) {
+	t.Parallel()
+
+	const hooksPath = "/repos/mindersec/minder/hooks"
+
+	repoProps := map[string]any{
+		properties.PropertyUpstreamID: "42",
+		RepoPropertyOwner:             "mindersec",
+		RepoPropertyName:              "minder",
+	}
+
+	tests := []struct {
+		name        string
+		props       map[string]any
+		existing    []map[string]any
+		createCode  int
+		wantDeleted []string
+		wantErr     string
+	}{
+		{
+			name:  "registration cleans up stale webhooks",
+			props: repoProps,
+			existing: []map[string]any{
+				{"id": 3, "config": map[string]any{"url": testWebhookURL + "/3b4b5a2e-7e9b-4a3f-9c2d-1d2e3f4a5b6c"}},
+				{"id": 4, "config": map[string]any{"url": "https://ci.example.com/hook"}},
+			},
+			createCode:  http.StatusCreated,
+			wantDeleted: []string{hooksPath + "/3"},
+		},
+		{
+			name: "registration by full name",
+			props: map[string]any{
+				properties.PropertyUpstreamID: "42",
+				properties.PropertyName:       "mindersec/minder",
+			},
+			createCode: http.StatusCreated,
+		},
+		{
+			name:       "failure creating webhook",
+			props:      repoProps,
+			createCode: http.StatusForbidden,
+			wantErr:    "failed to create webhook",
+		},
+		{
+			name: "missing repository name",
+			props: map[string]any{
+				properties.PropertyUpstreamID: "42",
+			},
+			wantErr: "owner/name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var deleted []string
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == hooksPath:
+					writeJSON(t, w, http.StatusOK, tt.existing)
+				case r.Method == http.MethodPost && r.URL.Path == hooksPath:
+					h := &hook{}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(h))
+					assert.Equal(t, "gitea", h.Type)
+					assert.Equal(t, "json", h.Config["content_type"])
+					assert.ElementsMatch(t, []string{"push", "pull_request"}, h.Events)
+					assert.True(t, h.Active)
+
+					hookURL := h.Config["url"]
+					assert.True(t, strings.HasPrefix(hookURL, testWebhookURL+"/"))
+
+					// The secret must be derived from the unique hook URL
+					uniq := hookURL[strings.LastIndex(hookURL, "/")+1:]
+					want, err := webhooksecret.New("test-secret", uniq)
+					assert.NoError(t, err)
+					assert.Equal(t, want, h.Config["secret"])
+
+					if tt.createCode != http.StatusCreated {
+						w.WriteHeader(tt.createCode)
+						return
+					}
+					h.ID = 12
+					writeJSON(t, w, tt.createCode, h)
+				case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, hooksPath+"/"):
+					mu.Lock()
+					deleted = append(deleted, r.URL.Path)
+					mu.Unlock()
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			cli := newTestClient(t, handler)
+
+			got, err := cli.RegisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES,
+				properties.NewProperties(tt.props))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, int64(12), got.GetProperty(RepoPropertyHookID).GetInt64())
+			assert.True(t, strings.HasPrefix(got.GetProperty(RepoPropertyHookURL).GetString(), testWebhookURL+"/"))
+			assert.Equal(t, "42", got.GetProperty(properties.PropertyUpstreamID).GetString())
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestRegisterEntityPullRequest(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	props := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: "700",
+	})
+
+	// Pull requests are not registered upstream
+	got, err := cli.RegisterEntity(context.Background(), minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+	require.NoError(t, err)
+	assert.Equal(t, props, got)
+
+	err = cli.DeregisterEntity(context.Background(), minderv1.Entity_ENTITY_PULL_REQUESTS, props)
+	assert.NoError(t, err)
+}
+
+func TestDeregisterEntity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		props    map[string]any
+		status   int
+		wantPath string
+		wantErr  string
+	}{
+		{
+			name: "deletes the webhook",
+			props: map[string]any{
+				RepoPropertyOwner:  "mindersec",
+				RepoPropertyName:   "minder",
+				RepoPropertyHookID: int64(12),
+			},
+			status:   http.StatusNoContent,
+			wantPath: "/repos/mindersec/minder/hooks/12",
+		},
+		{
+			name: "missing hook ID",
+			props: map[string]any{
+				RepoPropertyOwner: "mindersec",
+				RepoPropertyName:  "minder",
+			},
+			wantErr: "missing hook ID",
+		},
+		{
+			name: "webhook deletion fails",
+			props: map[string]any{
+				RepoPropertyOwner:  "mindersec",
+				RepoPropertyName:   "minder",
+				RepoPropertyHookID: int64(12),
+			},
+			status:   http.StatusInternalServerError,
+			wantPath: "/repos/mindersec/minder/hooks/12",
+			wantErr:  "failed to delete webhook",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+				w.WriteHeader(tt.status)
+			}))
+
+			err := cli.DeregisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES,
+				properties.NewProperties(tt.props))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// FormatRepositoryUpstreamID returns the upstream ID for a gitea repository
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatRepositoryUpstreamID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func (c *giteaClient) getPropertiesForRepo(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	repo, err := c.lookupRepository(ctx, getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate - if we were given an upstream ID, the repository
+	// must match it. This catches renamed or re-created repositories.
+	uid := FormatRepositoryUpstreamID(repo.ID)
+	if req := getByProps.GetProperty(properties.PropertyUpstreamID).GetString(); req != "" && req != uid {
+		return nil, fmt.Errorf("repository ID mismatch: %s != %s", uid, req)
+	}
+
+	return getByProps.Merge(repositoryToProperties(repo)), nil
+}
+
+// lookupRepository fetches the repository by upstream ID if we have one,
+// as it is stable across renames, and by name otherwise.
+func (c *giteaClient) lookupRepository(
+	ctx context.Context, getByProps *properties.Properties,
+) (*repository, error) {
+	if uid := getByProps.GetProperty(properties.PropertyUpstreamID).GetString(); uid != "" {
+		id, err := strconv.ParseInt(uid, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upstream ID %q: %w", uid, err)
+		}
+		return c.getRepositoryByID(ctx, id)
+	}
+
+	owner, name, err := repoOwnerAndName(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getRepository(ctx, owner, name)
+}
+
+// repoOwnerAndName returns the owner and name used to address a repository
+// in the Gitea API.
+func repoOwnerAndName(props *properties.Properties) (string, string, error) {
+	owner := props.GetProperty(RepoPropertyOwner).GetString()
+	name := props.GetProperty(RepoPropertyName).GetString()
+	if owner != "" && name != "" {
+		return owner, name, nil
+	}
+
+	fullName := props.GetProperty(properties.PropertyName).GetString()
+	owner, name, ok := strings.Cut(fullName, "/")
+	if !ok || owner == "" || name == "" {
+		return "", "", fmt.Errorf("invalid repository name %q, expected owner/name", fullName)
+	}
+
+	return owner, name, nil
+}
+
+func repositoryToProperties(repo *repository) *properties.Properties {
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:     FormatRepositoryUpstreamID(repo.ID),
+		properties.PropertyName:           formatRepoName(repo.Owner.Login, repo.Name),
+		properties.RepoPropertyIsPrivate:  repo.Private,
+		properties.RepoPropertyIsArchived: repo.Archived,
+		properties.RepoPropertyIsFork:     repo.Fork,
+		RepoPropertyOwner:                 repo.Owner.Login,
+		RepoPropertyName:                  repo.Name,
+		RepoPropertyDefaultBranch:         repo.DefaultBranch,
+		RepoPropertyCloneURL:              repo.CloneURL,
+	})
+}
+
+func repoV1FromProperties(repoProperties *properties.Properties) (*minderv1.Repository, error) {
+	upstreamID, err := repoProperties.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching upstream ID property: %w", err)
+	}
+
+	// convert the upstream ID to an int64
+	repoId, err := strconv.ParseInt(upstreamID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error converting upstream ID to int64: %w", err)
+	}
+
+	name, err := repoProperties.GetProperty(RepoPropertyName).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching name property: %w", err)
+	}
+
+	owner, err := repoProperties.GetProperty(RepoPropertyOwner).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching owner property: %w", err)
+	}
+
+	isPrivate, err := repoProperties.GetProperty(properties.RepoPropertyIsPrivate).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_private property: %w", err)
+	}
+
+	isFork, err := repoProperties.GetProperty(properties.RepoPropertyIsFork).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_fork property: %w", err)
+	}
+
+	pbRepo := &minderv1.Repository{
+		Name:          name,
+		Owner:         owner,
+		RepoId:        repoId,
+		CloneUrl:      repoProperties.GetProperty(RepoPropertyCloneURL).GetString(),
+		IsPrivate:     isPrivate,
+		IsFork:        isFork,
+		DefaultBranch: repoProperties.GetProperty(RepoPropertyDefaultBranch).GetString(),
+		Properties:    repoProperties.ToProtoStruct(),
+	}
+
+	return pbRepo, nil
+}
+
+func getRepoNameFromProperties(props *properties.Properties) (string, error) {
+	owner, err := getStringProp(props, RepoPropertyOwner)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := getStringProp(props, RepoPropertyName)
+	if err != nil {
+		return "", err
+	}
+
+	return formatRepoName(owner, name), nil
+}
+
+func formatRepoName(owner, name string) string {
+	return owner + "/" + name
+}
@@ -85,6 +85,9 @@ var _ provifv1.CommitStatusPublisher = (*GitHub)(nil)
 // Ensure that the GitHub client implements the ReviewPublisher interface
 var _ provifv1.ReviewPublisher = (*GitHub)(nil)
 
+// Ensure that the GitHub client implements the IssuePublisher interface
+var _ provifv1.IssuePublisher = (*GitHub)(nil)
+
 // ClientService is an interface for GitHub operations
 // It is used to mock GitHub operations in tests, but in order to generate
 // mocks, the interface must be exported
@@ -771,6 +774,60 @@ func (c *GitHub) UpdateIssueComment(ctx context.Context, owner, repo string, num
 	return err
 }
 
+// CreateIssue opens an issue in a repository
+func (c *GitHub) CreateIssue(
+	ctx context.Context, owner, repo string, req *github.IssueRequest,
+) (*github.Issue, error) {
+	var issue *github.Issue
+
+	op := func() (any, error) {
+		var err error
+
+		issue, _, err = c.client.Issues.Create(ctx, owner, repo, req)
+
+		if isRateLimitError(err) {
+			waitWrr := c.waitForRateLimitReset(ctx, err)
+			if waitWrr == nil {
+				return nil, err
+			}
+			return nil, backoffv4.Permanent(err)
+		}
+
+		return nil, backoffv4.Permanent(err)
+	}
+	_, retryErr := performWithRetry(ctx, op)
+	return issue, retryErr
+}
+
+// GetIssue gets an issue in a repository
+func (c *GitHub) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	issue, resp, err := c.client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, issueError(resp, err)
+	}
+	return issue, nil
+}
+
+// EditIssue updates an issue in a repository
+func (c *GitHub) EditIssue(
+	ctx context.Context, owner, repo string, number int, req *github.IssueRequest,
+) (*github.Issue, error) {
+	issue, resp, err := c.client.Issues.Edit(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, issueError(resp, err)
+	}
+	return issue, nil
+}
+
+// issueError wraps the error with ErrNotFound when the issue is gone,
+// which happens both when it doesn't exist and when it was deleted.
+func issueError(resp *github.Response, err error) error {
+	if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone) {
+		return fmt.Errorf("%w: %w", engerrors.ErrNotFound, err)
+	}
+	return err
+}
+
 // Clone clones a GitHub repository
 func (c *GitHub) Clone(ctx context.Context, cloneUrl string, branch string) (*git.Repository, error) {
 	delegator := gitclient.NewGit(c.delegate.GetCredential(), gitclient.WithConfig(c.gitConfig))
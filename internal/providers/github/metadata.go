@@ -35,6 +35,8 @@ func (c *GitHub) ProviderClassInfo() *minderv1.ProviderClassInfo {
 		minderv1.Entity_ENTITY_PULL_REQUESTS,
 		minderv1.Entity_ENTITY_ARTIFACTS,
 		minderv1.Entity_ENTITY_RELEASE,
+		minderv1.Entity_ENTITY_PIPELINE_RUN,
+		minderv1.Entity_ENTITY_TASK_RUN,
 	}
 	//nolint:exhaustive
 	switch c.providerClass {
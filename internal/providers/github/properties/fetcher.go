@@ -54,6 +54,10 @@ func (ghEntityFetcher) EntityPropertyFetcher(entType minderv1.Entity) GhProperty
 		return NewArtifactFetcher()
 	case minderv1.Entity_ENTITY_RELEASE:
 		return NewReleaseFetcher()
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return NewWorkflowRunFetcher()
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return NewWorkflowJobFetcher()
 	}
 
 	return nil
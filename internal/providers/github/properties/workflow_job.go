@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	go_github "github.com/google/go-github/v63/github"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Workflow job properties
+const (
+	// WorkflowJobPropertyName represents the name of the job
+	WorkflowJobPropertyName = "github/job_name"
+	// WorkflowJobPropertyRunnerName represents the name of the runner the job runs on
+	WorkflowJobPropertyRunnerName = "github/runner_name"
+	// WorkflowJobPropertyRunnerGroupName represents the name of the runner group the job runs on
+	WorkflowJobPropertyRunnerGroupName = "github/runner_group_name"
+	// WorkflowJobPropertyURL represents the URL of the job
+	WorkflowJobPropertyURL = "github/job_url"
+)
+
+// selfHostedLabel is the label GitHub adds to all self-hosted runners
+const selfHostedLabel = "self-hosted"
+
+// WorkflowJobFetcher is a property fetcher for github workflow jobs
+type WorkflowJobFetcher struct {
+	propertyFetcherBase
+}
+
+// NewWorkflowJobFetcher creates a new WorkflowJobFetcher
+func NewWorkflowJobFetcher() *WorkflowJobFetcher {
+	return &WorkflowJobFetcher{
+		propertyFetcherBase: propertyFetcherBase{
+			propertyOrigins: []propertyOrigin{
+				{
+					keys: []string{
+						// general entity
+						properties.PropertyName,
+						properties.PropertyUpstreamID,
+						// general task run
+						properties.TaskRunPropertyPipelineRunID,
+						properties.TaskRunPropertyRunnerLabels,
+						properties.TaskRunPropertyIsSelfHosted,
+						// general pipeline run
+						properties.PipelineRunPropertyWorkflowPath,
+						properties.PipelineRunPropertyTrigger,
+						properties.PipelineRunPropertyActor,
+						properties.PipelineRunPropertyBranch,
+						properties.PipelineRunPropertyCommitSHA,
+						properties.PipelineRunPropertyIsFromFork,
+						properties.PipelineRunPropertyStatus,
+						properties.PipelineRunPropertyConclusion,
+						properties.PipelineRunPropertyPermissions,
+						// github-specific
+						WorkflowJobPropertyName,
+						WorkflowJobPropertyRunnerName,
+						WorkflowJobPropertyRunnerGroupName,
+						WorkflowJobPropertyURL,
+						WorkflowRunPropertyRepoOwner,
+						WorkflowRunPropertyRepoName,
+						WorkflowRunPropertyWorkflowID,
+						WorkflowRunPropertyWorkflowName,
+						WorkflowRunPropertyRunNumber,
+						WorkflowRunPropertyRunAttempt,
+						WorkflowRunPropertyHeadRepo,
+						WorkflowRunPropertyURL,
+					},
+					wrapper: getWorkflowJobWrapper,
+				},
+			},
+			operationalProperties: []string{},
+		},
+	}
+}
+
+// GetName returns the name of the workflow job
+func (*WorkflowJobFetcher) GetName(props *properties.Properties) (string, error) {
+	owner, repo, err := workflowRepoFromProps(props)
+	if err != nil {
+		return "", err
+	}
+
+	jobID, err := props.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return "", fmt.Errorf("failed to get upstream ID: %w", err)
+	}
+
+	return getWorkflowJobName(owner, repo, jobID), nil
+}
+
+func getWorkflowJobName(owner, repo, jobID string) string {
+	return fmt.Sprintf("%s/%s/actions/jobs/%s", owner, repo, jobID)
+}
+
+func getWorkflowJobWrapper(
+	ctx context.Context, ghCli *go_github.Client, _ bool, getByProps *properties.Properties,
+) (map[string]any, error) {
+	jobID, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	owner, repo, err := workflowRepoFromProps(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	job, result, err := ghCli.Actions.GetWorkflowJobByID(ctx, owner, repo, jobID)
+	if err != nil {
+		if result != nil && result.StatusCode == http.StatusNotFound {
+			return nil, v1.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch workflow job: %w", err)
+	}
+
+	// the trigger, the origin and the definition of the job are only
+	// available from the run
+	run, _, err := ghCli.Actions.GetWorkflowRunByID(ctx, owner, repo, job.GetRunID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow run of job: %w", err)
+	}
+
+	workflow, err := getWorkflowDefinition(ctx, ghCli, owner, repo, run.GetPath(), run.GetHeadSHA())
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]any, 0, len(job.Labels))
+	isSelfHosted := false
+	for _, label := range job.Labels {
+		labels = append(labels, label)
+		if strings.EqualFold(label, selfHostedLabel) {
+			isSelfHosted = true
+		}
+	}
+
+	props := workflowRunProperties(owner, repo, run)
+	props[properties.PropertyUpstreamID] = properties.NumericalValueToUpstreamID(job.GetID())
+	props[properties.PropertyName] = getWorkflowJobName(owner, repo, props[properties.PropertyUpstreamID].(string))
+	props[properties.TaskRunPropertyPipelineRunID] = properties.NumericalValueToUpstreamID(job.GetRunID())
+	props[properties.TaskRunPropertyRunnerLabels] = labels
+	props[properties.TaskRunPropertyIsSelfHosted] = isSelfHosted
+	props[properties.PipelineRunPropertyStatus] = job.GetStatus()
+	props[properties.PipelineRunPropertyConclusion] = job.GetConclusion()
+	props[WorkflowJobPropertyName] = job.GetName()
+	props[WorkflowJobPropertyRunnerName] = job.GetRunnerName()
+	props[WorkflowJobPropertyRunnerGroupName] = job.GetRunnerGroupName()
+	props[WorkflowJobPropertyURL] = job.GetHTMLURL()
+	if perms := workflow.permissions(job.GetName()); perms != nil {
+		props[properties.PipelineRunPropertyPermissions] = perms
+	}
+
+	return props, nil
+}
+
+// EntityInstanceV1FromWorkflowJobProperties creates a new EntityInstance from the given properties
+func EntityInstanceV1FromWorkflowJobProperties(props *properties.Properties) (*minderv1.EntityInstance, error) {
+	name, err := NewWorkflowJobFetcher().GetName(props)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.EntityInstance{
+		Type:       minderv1.Entity_ENTITY_TASK_RUN,
+		Name:       name,
+		Properties: props.ToProtoStruct(),
+	}, nil
+}
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	go_github "github.com/google/go-github/v63/github"
+	"gopkg.in/yaml.v3"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Workflow run properties
+const (
+	// WorkflowRunPropertyRepoOwner represents the github owner of the repository
+	WorkflowRunPropertyRepoOwner = "github/repo_owner"
+	// WorkflowRunPropertyRepoName represents the github name of the repository
+	WorkflowRunPropertyRepoName = "github/repo_name"
+	// WorkflowRunPropertyWorkflowID represents the ID of the workflow that was run
+	WorkflowRunPropertyWorkflowID = "github/workflow_id"
+	// WorkflowRunPropertyWorkflowName represents the name of the workflow that was run
+	WorkflowRunPropertyWorkflowName = "github/workflow_name"
+	// WorkflowRunPropertyRunNumber represents the number of the run within the workflow
+	WorkflowRunPropertyRunNumber = "github/run_number"
+	// WorkflowRunPropertyRunAttempt represents the attempt number of the run
+	WorkflowRunPropertyRunAttempt = "github/run_attempt"
+	// WorkflowRunPropertyHeadRepo represents the full name of the repository the change comes from
+	WorkflowRunPropertyHeadRepo = "github/head_repo"
+	// WorkflowRunPropertyURL represents the URL of the run
+	WorkflowRunPropertyURL = "github/run_url"
+)
+
+// workflowPermissionsKey is the key of the permissions granted to the
+// GITHUB_TOKEN, both at the workflow and at the job level
+const workflowPermissionsKey = "permissions"
+
+// WorkflowRunFetcher is a property fetcher for github workflow runs
+type WorkflowRunFetcher struct {
+	propertyFetcherBase
+}
+
+// NewWorkflowRunFetcher creates a new WorkflowRunFetcher
+func NewWorkflowRunFetcher() *WorkflowRunFetcher {
+	return &WorkflowRunFetcher{
+		propertyFetcherBase: propertyFetcherBase{
+			propertyOrigins: []propertyOrigin{
+				{
+					keys: []string{
+						// general entity
+						properties.PropertyName,
+						properties.PropertyUpstreamID,
+						// general pipeline run
+						properties.PipelineRunPropertyWorkflowPath,
+						properties.PipelineRunPropertyTrigger,
+						properties.PipelineRunPropertyActor,
+						properties.PipelineRunPropertyBranch,
+						properties.PipelineRunPropertyCommitSHA,
+						properties.PipelineRunPropertyIsFromFork,
+						properties.PipelineRunPropertyStatus,
+						properties.PipelineRunPropertyConclusion,
+						properties.PipelineRunPropertyPermissions,
+						// github-specific
+						WorkflowRunPropertyRepoOwner,
+						WorkflowRunPropertyRepoName,
+						WorkflowRunPropertyWorkflowID,
+						WorkflowRunPropertyWorkflowName,
+						WorkflowRunPropertyRunNumber,
+						WorkflowRunPropertyRunAttempt,
+						WorkflowRunPropertyHeadRepo,
+						WorkflowRunPropertyURL,
+					},
+					wrapper: getWorkflowRunWrapper,
+				},
+			},
+			operationalProperties: []string{},
+		},
+	}
+}
+
+// GetName returns the name of the workflow run
+func (*WorkflowRunFetcher) GetName(props *properties.Properties) (string, error) {
+	owner, repo, err := workflowRepoFromProps(props)
+	if err != nil {
+		return "", err
+	}
+
+	runID, err := props.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return "", fmt.Errorf("failed to get upstream ID: %w", err)
+	}
+
+	return getWorkflowRunName(owner, repo, runID), nil
+}
+
+func getWorkflowRunName(owner, repo, runID string) string {
+	return fmt.Sprintf("%s/%s/actions/runs/%s", owner, repo, runID)
+}
+
+func workflowRepoFromProps(props *properties.Properties) (string, string, error) {
+	owner, err := props.GetProperty(WorkflowRunPropertyRepoOwner).AsString()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get repo owner: %w", err)
+	}
+
+	repo, err := props.GetProperty(WorkflowRunPropertyRepoName).AsString()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get repo name: %w", err)
+	}
+
+	return owner, repo, nil
+}
+
+func getWorkflowRunWrapper(
+	ctx context.Context, ghCli *go_github.Client, _ bool, getByProps *properties.Properties,
+) (map[string]any, error) {
+	runID, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsInt64()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	owner, repo, err := workflowRepoFromProps(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	run, result, err := ghCli.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+	if err != nil {
+		if result != nil && result.StatusCode == http.StatusNotFound {
+			return nil, v1.ErrEntityNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch workflow run: %w", err)
+	}
+
+	workflow, err := getWorkflowDefinition(ctx, ghCli, owner, repo, run.GetPath(), run.GetHeadSHA())
+	if err != nil {
+		return nil, err
+	}
+
+	props := workflowRunProperties(owner, repo, run)
+	props[properties.PropertyUpstreamID] = properties.NumericalValueToUpstreamID(run.GetID())
+	props[properties.PropertyName] = getWorkflowRunName(owner, repo, props[properties.PropertyUpstreamID].(string))
+	props[properties.PipelineRunPropertyStatus] = run.GetStatus()
+	props[properties.PipelineRunPropertyConclusion] = run.GetConclusion()
+	if perms := workflow.permissions(""); perms != nil {
+		props[properties.PipelineRunPropertyPermissions] = perms
+	}
+
+	return props, nil
+}
+
+// workflowRunProperties returns the properties of a workflow run which are
+// shared by the runs and the jobs which are part of them
+func workflowRunProperties(owner, repo string, run *go_github.WorkflowRun) map[string]any {
+	// the head repository is not set for runs which are not related to a
+	// repository, e.g. dynamic runs
+	headRepo := run.GetHeadRepository().GetFullName()
+	isFromFork := headRepo != "" && !strings.EqualFold(headRepo, fmt.Sprintf("%s/%s", owner, repo))
+
+	actor := run.GetTriggeringActor().GetLogin()
+	if actor == "" {
+		actor = run.GetActor().GetLogin()
+	}
+
+	return map[string]any{
+		properties.PipelineRunPropertyWorkflowPath: run.GetPath(),
+		properties.PipelineRunPropertyTrigger:      run.GetEvent(),
+		properties.PipelineRunPropertyActor:        actor,
+		properties.PipelineRunPropertyBranch:       run.GetHeadBranch(),
+		properties.PipelineRunPropertyCommitSHA:    run.GetHeadSHA(),
+		properties.PipelineRunPropertyIsFromFork:   isFromFork,
+		WorkflowRunPropertyRepoOwner:               owner,
+		WorkflowRunPropertyRepoName:                repo,
+		WorkflowRunPropertyWorkflowID:              run.GetWorkflowID(),
+		WorkflowRunPropertyWorkflowName:            run.GetName(),
+		WorkflowRunPropertyRunNumber:               int64(run.GetRunNumber()),
+		WorkflowRunPropertyRunAttempt:              int64(run.GetRunAttempt()),
+		WorkflowRunPropertyHeadRepo:                headRepo,
+		WorkflowRunPropertyURL:                     run.GetHTMLURL(),
+	}
+}
+
+// workflowDefinition is the part of a workflow file which is reflected in
+// the properties of the runs
+type workflowDefinition struct {
+	Permissions any                      `yaml:"permissions"`
+	Jobs        map[string]jobDefinition `yaml:"jobs"`
+}
+
+type jobDefinition struct {
+	Name        string `yaml:"name"`
+	Permissions any    `yaml:"permissions"`
+}
+
+// permissions returns the permissions of the given job, or of the workflow if
+// the job is empty or doesn't set them. The permissions are either a string,
+// e.g. read-all, or a map of scopes to access levels, and nil if they are not
+// set at all, in which case the defaults of the repository apply.
+func (w *workflowDefinition) permissions(jobName string) any {
+	if w == nil {
+		return nil
+	}
+
+	if jobName != "" {
+		for key, job := range w.Jobs {
+			if job.Permissions != nil && (matchesJobName(jobName, key) || matchesJobName(jobName, job.Name)) {
+				return job.Permissions
+			}
+		}
+	}
+
+	return w.Permissions
+}
+
+// matchesJobName returns whether the name of a job run is the given name from
+// its definition. Runs of matrix jobs are named after the job followed by the
+// matrix values, e.g. "build (ubuntu-latest, 1.22)".
+func matchesJobName(runName, name string) bool {
+	if name == "" {
+		return false
+	}
+	return runName == name || strings.HasPrefix(runName, name+" (")
+}
+
+// getWorkflowDefinition fetches and parses the workflow file at the commit
+// that was run. It returns nil if the file can't be found or parsed, e.g. for
+// dynamic workflows which are not defined in the repository.
+func getWorkflowDefinition(
+	ctx context.Context, ghCli *go_github.Client, owner, repo, path, ref string,
+) (*workflowDefinition, error) {
+	if path == "" || ref == "" {
+		return nil, nil
+	}
+
+	file, _, result, err := ghCli.Repositories.GetContents(ctx, owner, repo, path,
+		&go_github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		if result != nil && result.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch workflow file: %w", err)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, nil
+	}
+
+	var def workflowDefinition
+	if err := yaml.Unmarshal([]byte(content), &def); err != nil {
+		return nil, nil
+	}
+
+	def.Permissions = normalizePermissions(def.Permissions)
+	for key, job := range def.Jobs {
+		job.Permissions = normalizePermissions(job.Permissions)
+		def.Jobs[key] = job
+	}
+
+	return &def, nil
+}
+
+// normalizePermissions converts the permissions parsed from YAML to values
+// which can be stored as properties
+func normalizePermissions(perms any) any {
+	switch p := perms.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(p))
+		for scope, level := range p {
+			out[scope] = fmt.Sprint(level)
+		}
+		return out
+	case nil:
+		return nil
+	default:
+		return fmt.Sprint(p)
+	}
+}
+
+// EntityInstanceV1FromWorkflowRunProperties creates a new EntityInstance from the given properties
+func EntityInstanceV1FromWorkflowRunProperties(props *properties.Properties) (*minderv1.EntityInstance, error) {
+	name, err := NewWorkflowRunFetcher().GetName(props)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.EntityInstance{
+		Type:       minderv1.Entity_ENTITY_PIPELINE_RUN,
+		Name:       name,
+		Properties: props.ToProtoStruct(),
+	}, nil
+}
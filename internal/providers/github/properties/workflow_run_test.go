@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	go_github "github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const testWorkflow = `
+on: pull_request_target
+permissions: read-all
+jobs:
+  build:
+    runs-on: [self-hosted, linux]
+    steps:
+      - run: make
+  release:
+    name: Publish release
+    permissions:
+      contents: write
+      id-token: write
+    runs-on: ubuntu-latest
+    steps:
+      - run: make release
+`
+
+func newTestWorkflowClient(t *testing.T) *go_github.Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+	mux.HandleFunc("GET /repos/testorg/testrepo/actions/runs/123", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"id":              123,
+			"name":            "CI",
+			"path":            ".github/workflows/ci.yml",
+			"event":           "pull_request_target",
+			"head_branch":     "feature",
+			"head_sha":        "abc123",
+			"run_number":      7,
+			"run_attempt":     1,
+			"status":          "completed",
+			"conclusion":      "success",
+			"workflow_id":     42,
+			"html_url":        "https://github.com/testorg/testrepo/actions/runs/123",
+			"actor":           map[string]any{"login": "octocat"},
+			"head_repository": map[string]any{"full_name": "someone/testrepo"},
+		})
+	})
+	mux.HandleFunc("GET /repos/testorg/testrepo/actions/jobs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		switch r.PathValue("id") {
+		case "456":
+			writeJSON(w, map[string]any{
+				"id":     456,
+				"run_id": 123,
+				"name":   "build",
+				"status": "queued",
+				"labels": []string{"self-hosted", "linux"},
+			})
+		case "789":
+			writeJSON(w, map[string]any{
+				"id":                789,
+				"run_id":            123,
+				"name":              "Publish release (1.22)",
+				"status":            "completed",
+				"conclusion":        "failure",
+				"labels":            []string{"ubuntu-latest"},
+				"runner_group_name": "GitHub Actions",
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	mux.HandleFunc("GET /repos/testorg/testrepo/contents/.github/workflows/ci.yml", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "abc123", r.URL.Query().Get("ref"))
+		writeJSON(w, map[string]any{
+			"type":     "file",
+			"encoding": "base64",
+			"content":  base64.StdEncoding.EncodeToString([]byte(testWorkflow)),
+		})
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client := go_github.NewClient(srv.Client())
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+	return client
+}
+
+func TestGetWorkflowRunWrapper(t *testing.T) {
+	t.Parallel()
+
+	client := newTestWorkflowClient(t)
+	props, err := getWorkflowRunWrapper(context.Background(), client, false, properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: "123",
+		WorkflowRunPropertyRepoOwner:  "testorg",
+		WorkflowRunPropertyRepoName:   "testrepo",
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, "testorg/testrepo/actions/runs/123", props[properties.PropertyName])
+	assert.Equal(t, "123", props[properties.PropertyUpstreamID])
+	assert.Equal(t, ".github/workflows/ci.yml", props[properties.PipelineRunPropertyWorkflowPath])
+	assert.Equal(t, "pull_request_target", props[properties.PipelineRunPropertyTrigger])
+	assert.Equal(t, "octocat", props[properties.PipelineRunPropertyActor])
+	assert.Equal(t, true, props[properties.PipelineRunPropertyIsFromFork])
+	assert.Equal(t, "completed", props[properties.PipelineRunPropertyStatus])
+	assert.Equal(t, "success", props[properties.PipelineRunPropertyConclusion])
+	assert.Equal(t, "read-all", props[properties.PipelineRunPropertyPermissions])
+	assert.Equal(t, "someone/testrepo", props[WorkflowRunPropertyHeadRepo])
+	assert.Equal(t, int64(7), props[WorkflowRunPropertyRunNumber])
+
+	// the properties must be storable
+	_, err = properties.NewProperties(props).ToProtoStruct().MarshalJSON()
+	require.NoError(t, err)
+}
+
+func TestGetWorkflowJobWrapper(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		jobID        string
+		wantErr      error
+		wantLabels   []any
+		selfHosted   bool
+		wantPerms    any
+		wantStatus   string
+		wantConclude string
+	}{
+		{
+			name:       "self-hosted job using the workflow permissions",
+			jobID:      "456",
+			wantLabels: []any{"self-hosted", "linux"},
+			selfHosted: true,
+			wantPerms:  "read-all",
+			wantStatus: "queued",
+		},
+		{
+			name:         "matrix job with its own permissions",
+			jobID:        "789",
+			wantLabels:   []any{"ubuntu-latest"},
+			wantPerms:    map[string]any{"contents": "write", "id-token": "write"},
+			wantStatus:   "completed",
+			wantConclude: "failure",
+		},
+		{
+			name:    "job not found",
+			jobID:   "404",
+			wantErr: v1.ErrEntityNotFound,
+		},
+	}
+
+	client := newTestWorkflowClient(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			props, err := getWorkflowJobWrapper(context.Background(), client, false, properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID: tt.jobID,
+				WorkflowRunPropertyRepoOwner:  "testorg",
+				WorkflowRunPropertyRepoName:   "testrepo",
+			}))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "testorg/testrepo/actions/jobs/"+tt.jobID, props[properties.PropertyName])
+			assert.Equal(t, "123", props[properties.TaskRunPropertyPipelineRunID])
+			assert.Equal(t, tt.wantLabels, props[properties.TaskRunPropertyRunnerLabels])
+			assert.Equal(t, tt.selfHosted, props[properties.TaskRunPropertyIsSelfHosted])
+			assert.Equal(t, tt.wantPerms, props[properties.PipelineRunPropertyPermissions])
+			assert.Equal(t, tt.wantStatus, props[properties.PipelineRunPropertyStatus])
+			assert.Equal(t, tt.wantConclude, props[properties.PipelineRunPropertyConclusion])
+			// the pipeline run properties come from the run
+			assert.Equal(t, "pull_request_target", props[properties.PipelineRunPropertyTrigger])
+			assert.Equal(t, true, props[properties.PipelineRunPropertyIsFromFork])
+		})
+	}
+}
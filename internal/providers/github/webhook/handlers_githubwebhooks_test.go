@@ -2636,6 +2636,201 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 			statusCode: http.StatusInternalServerError,
 			queued:     nil,
 		},
+		{
+			name: "workflow_run requested",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#workflow_run
+			event: "workflow_run",
+			payload: &github.WorkflowRunEvent{
+				Action: github.String("requested"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				WorkflowRun: &github.WorkflowRun{
+					ID:         github.Int64(123),
+					RunAttempt: github.Int(1),
+				},
+			},
+			topic:      constants.TopicQueueOriginatingEntityAdd,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, "12345", received.Metadata["id"])
+				require.Equal(t, event, received.Metadata["type"])
+				require.Equal(t, "https://api.github.com/", received.Metadata["source"])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
+		{
+			name: "workflow_run re-run requested",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#workflow_run
+			event: "workflow_run",
+			payload: &github.WorkflowRunEvent{
+				Action: github.String("requested"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				WorkflowRun: &github.WorkflowRun{
+					ID:         github.Int64(123),
+					RunAttempt: github.Int(2),
+				},
+			},
+			topic:      constants.TopicQueueRefreshEntityAndEvaluate,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, "12345", received.Metadata["id"])
+				require.Equal(t, event, received.Metadata["type"])
+				require.Equal(t, "https://api.github.com/", received.Metadata["source"])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
+		{
+			name: "workflow_run completed",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#workflow_run
+			event: "workflow_run",
+			payload: &github.WorkflowRunEvent{
+				Action: github.String("completed"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				WorkflowRun: &github.WorkflowRun{
+					ID:         github.Int64(123),
+					RunAttempt: github.Int(1),
+				},
+			},
+			topic:      constants.TopicQueueRefreshEntityAndEvaluate,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, "12345", received.Metadata["id"])
+				require.Equal(t, event, received.Metadata["type"])
+				require.Equal(t, "https://api.github.com/", received.Metadata["source"])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
+		{
+			name: "workflow_run no details",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#workflow_run
+			event: "workflow_run",
+			payload: &github.WorkflowRunEvent{
+				Action: github.String("requested"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+			},
+			topic:      constants.TopicQueueOriginatingEntityAdd,
+			statusCode: http.StatusInternalServerError,
+			queued:     nil,
+		},
+		{
+			name: "workflow_job queued",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#workflow_job
+			event: "workflow_job",
+			payload: &github.WorkflowJobEvent{
+				Action: github.String("queued"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				WorkflowJob: &github.WorkflowJob{
+					ID:         github.Int64(456),
+					RunID:      github.Int64(123),
+					RunAttempt: github.Int64(1),
+				},
+			},
+			topic:      constants.TopicQueueOriginatingEntityAdd,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, "12345", received.Metadata["id"])
+				require.Equal(t, event, received.Metadata["type"])
+				require.Equal(t, "https://api.github.com/", received.Metadata["source"])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
+		{
+			name: "workflow_job completed",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#workflow_job
+			event: "workflow_job",
+			payload: &github.WorkflowJobEvent{
+				Action: github.String("completed"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				WorkflowJob: &github.WorkflowJob{
+					ID:         github.Int64(456),
+					RunID:      github.Int64(123),
+					RunAttempt: github.Int64(1),
+				},
+			},
+			topic:      constants.TopicQueueRefreshEntityAndEvaluate,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, "12345", received.Metadata["id"])
+				require.Equal(t, event, received.Metadata["type"])
+				require.Equal(t, "https://api.github.com/", received.Metadata["source"])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
+		{
+			name: "workflow_job not handled",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#workflow_job
+			event: "workflow_job",
+			payload: &github.WorkflowJobEvent{
+				Action: github.String("random"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				WorkflowJob: &github.WorkflowJob{
+					ID:         github.Int64(456),
+					RunID:      github.Int64(123),
+					RunAttempt: github.Int64(1),
+				},
+			},
+			statusCode: http.StatusOK,
+			queued:     nil,
+		},
 
 		// garbage
 		{
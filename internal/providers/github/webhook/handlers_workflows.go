@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+const (
+	webhookActionEventRequested  = "requested"
+	webhookActionEventQueued     = "queued"
+	webhookActionEventWaiting    = "waiting"
+	webhookActionEventInProgress = "in_progress"
+	webhookActionEventCompleted  = "completed"
+)
+
+// workflowRunEvent is sent when a GitHub Actions workflow run is
+// requested, starts or completes
+type workflowRunEvent struct {
+	Action      *string      `json:"action,omitempty"`
+	WorkflowRun *workflowRun `json:"workflow_run,omitempty"`
+	Repo        *repo        `json:"repository,omitempty"`
+}
+
+func (e *workflowRunEvent) GetAction() string {
+	if e.Action != nil {
+		return *e.Action
+	}
+	return ""
+}
+
+func (e *workflowRunEvent) GetWorkflowRun() *workflowRun {
+	return e.WorkflowRun
+}
+
+func (e *workflowRunEvent) GetRepo() *repo {
+	return e.Repo
+}
+
+type workflowRun struct {
+	ID         *int64 `json:"id,omitempty"`
+	RunAttempt *int64 `json:"run_attempt,omitempty"`
+}
+
+func (r *workflowRun) GetID() int64 {
+	if r.ID != nil {
+		return *r.ID
+	}
+	return 0
+}
+
+func (r *workflowRun) GetRunAttempt() int64 {
+	if r.RunAttempt != nil {
+		return *r.RunAttempt
+	}
+	return 0
+}
+
+// workflowJobEvent is sent when a job of a GitHub Actions workflow run
+// is queued, starts or completes
+type workflowJobEvent struct {
+	Action      *string      `json:"action,omitempty"`
+	WorkflowJob *workflowJob `json:"workflow_job,omitempty"`
+	Repo        *repo        `json:"repository,omitempty"`
+}
+
+func (e *workflowJobEvent) GetAction() string {
+	if e.Action != nil {
+		return *e.Action
+	}
+	return ""
+}
+
+func (e *workflowJobEvent) GetWorkflowJob() *workflowJob {
+	return e.WorkflowJob
+}
+
+func (e *workflowJobEvent) GetRepo() *repo {
+	return e.Repo
+}
+
+type workflowJob struct {
+	ID         *int64 `json:"id,omitempty"`
+	RunID      *int64 `json:"run_id,omitempty"`
+	RunAttempt *int64 `json:"run_attempt,omitempty"`
+}
+
+func (j *workflowJob) GetID() int64 {
+	if j.ID != nil {
+		return *j.ID
+	}
+	return 0
+}
+
+func (j *workflowJob) GetRunID() int64 {
+	if j.RunID != nil {
+		return *j.RunID
+	}
+	return 0
+}
+
+func (j *workflowJob) GetRunAttempt() int64 {
+	if j.RunAttempt != nil {
+		return *j.RunAttempt
+	}
+	return 0
+}
+
+func processWorkflowRunEvent(
+	ctx context.Context,
+	payload []byte,
+) (*processingResult, error) {
+	var event *workflowRunEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow run event: %w", err)
+	}
+
+	if event.GetAction() == "" {
+		return nil, errors.New("workflow run event action not found")
+	}
+	if event.GetWorkflowRun() == nil {
+		return nil, errors.New("workflow run event workflow run not found")
+	}
+	if event.GetWorkflowRun().GetID() == 0 {
+		return nil, errors.New("workflow run event workflow run ID not found")
+	}
+	if event.GetRepo() == nil {
+		return nil, errors.New("workflow run event repository not found")
+	}
+
+	var topic string
+	switch event.GetAction() {
+	case webhookActionEventRequested:
+		topic = workflowEventTopic(event.GetWorkflowRun().GetRunAttempt())
+	case webhookActionEventInProgress, webhookActionEventCompleted:
+		topic = constants.TopicQueueRefreshEntityAndEvaluate
+	default:
+		return nil, errNotHandled
+	}
+
+	runProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:       properties.NumericalValueToUpstreamID(event.GetWorkflowRun().GetID()),
+		ghprop.WorkflowRunPropertyRepoOwner: event.GetRepo().GetOwner(),
+		ghprop.WorkflowRunPropertyRepoName:  event.GetRepo().GetName(),
+	})
+
+	zerolog.Ctx(ctx).Info().
+		Int64("workflow-run-id", event.GetWorkflowRun().GetID()).
+		Str("action", event.GetAction()).
+		Msgf("evaluating workflow run => %s", topic)
+
+	return sendWorkflowEvent(topic, pb.Entity_ENTITY_PIPELINE_RUN, runProps, event.GetRepo()), nil
+}
+
+func processWorkflowJobEvent(
+	ctx context.Context,
+	payload []byte,
+) (*processingResult, error) {
+	var event *workflowJobEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow job event: %w", err)
+	}
+
+	if event.GetAction() == "" {
+		return nil, errors.New("workflow job event action not found")
+	}
+	if event.GetWorkflowJob() == nil {
+		return nil, errors.New("workflow job event workflow job not found")
+	}
+	if event.GetWorkflowJob().GetID() == 0 {
+		return nil, errors.New("workflow job event workflow job ID not found")
+	}
+	if event.GetRepo() == nil {
+		return nil, errors.New("workflow job event repository not found")
+	}
+
+	var topic string
+	switch event.GetAction() {
+	case webhookActionEventQueued:
+		topic = workflowEventTopic(event.GetWorkflowJob().GetRunAttempt())
+	case webhookActionEventWaiting, webhookActionEventInProgress, webhookActionEventCompleted:
+		topic = constants.TopicQueueRefreshEntityAndEvaluate
+	default:
+		return nil, errNotHandled
+	}
+
+	jobProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:       properties.NumericalValueToUpstreamID(event.GetWorkflowJob().GetID()),
+		ghprop.WorkflowRunPropertyRepoOwner: event.GetRepo().GetOwner(),
+		ghprop.WorkflowRunPropertyRepoName:  event.GetRepo().GetName(),
+	})
+
+	zerolog.Ctx(ctx).Info().
+		Int64("workflow-job-id", event.GetWorkflowJob().GetID()).
+		Int64("workflow-run-id", event.GetWorkflowJob().GetRunID()).
+		Str("action", event.GetAction()).
+		Msgf("evaluating workflow job => %s", topic)
+
+	return sendWorkflowEvent(topic, pb.Entity_ENTITY_TASK_RUN, jobProps, event.GetRepo()), nil
+}
+
+// workflowEventTopic returns the topic for the event sent when a run, or a
+// job, is first created. Re-running a workflow reuses the IDs of the run and
+// of its jobs, so later attempts refresh the existing entities instead.
+// Completed runs are kept so that their evaluation results stay visible, and
+// deleted later on by the `runs purge` server command.
+func workflowEventTopic(runAttempt int64) string {
+	if runAttempt > 1 {
+		return constants.TopicQueueRefreshEntityAndEvaluate
+	}
+	return constants.TopicQueueOriginatingEntityAdd
+}
+
+func sendWorkflowEvent(
+	topic string,
+	entType pb.Entity,
+	lookByProps *properties.Properties,
+	ghRepo *repo,
+) *processingResult {
+	originatorProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: properties.NumericalValueToUpstreamID(ghRepo.GetID()),
+	})
+
+	return &processingResult{
+		topic: topic,
+		wrapper: entityMessage.NewEntityRefreshAndDoMessage().
+			WithEntity(entType, lookByProps).
+			WithProviderImplementsHint(string(db.ProviderTypeGithub)).
+			WithOriginator(pb.Entity_ENTITY_REPOSITORIES, originatorProps),
+	}
+}
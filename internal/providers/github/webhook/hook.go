@@ -59,8 +59,8 @@ var _ toMessage = (*entMsg.HandleEntityAndDoMessage)(nil)
 type processingResult struct {
 	// destination topic
 	topic string
-	// wrapper object for repository, pull-request, and artifact
-	// (package) events.
+	// wrapper object for repository, pull-request, artifact
+	// (package), release and workflow events.
 	wrapper toMessage
 }
 
@@ -163,6 +163,12 @@ func HandleWebhookEvent(
 		case "release":
 			wes.Accepted = true
 			res, processingErr = processReleaseEvent(ctx, rawWBPayload)
+		case "workflow_run":
+			wes.Accepted = true
+			res, processingErr = processWorkflowRunEvent(ctx, rawWBPayload)
+		case "workflow_job":
+			wes.Accepted = true
+			res, processingErr = processWorkflowJobEvent(ctx, rawWBPayload)
 		case "ping":
 			// For ping events, we do not set wes.Accepted
 			// to true because they're not relevant
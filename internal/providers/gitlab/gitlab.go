@@ -132,7 +132,9 @@ func (c *gitlabClient) GetCredential() provifv1.GitLabCredential {
 func (*gitlabClient) SupportsEntity(entType minderv1.Entity) bool {
 	return entType == minderv1.Entity_ENTITY_REPOSITORIES ||
 		entType == minderv1.Entity_ENTITY_PULL_REQUESTS ||
-		entType == minderv1.Entity_ENTITY_RELEASE
+		entType == minderv1.Entity_ENTITY_RELEASE ||
+		entType == minderv1.Entity_ENTITY_PIPELINE_RUN ||
+		entType == minderv1.Entity_ENTITY_TASK_RUN
 }
 
 // CreationOptions implements the Provider interface
@@ -149,7 +151,7 @@ func (c *gitlabClient) CreationOptions(entType minderv1.Entity) *provifv1.Entity
 		}
 	}
 
-	// Other entities (PRs, releases) don't need registration or events
+	// Other entities (PRs, releases, pipelines and jobs) don't need registration or events
 	return &provifv1.EntityCreationOptions{
 		RegisterWithProvider:       false,
 		PublishReconciliationEvent: false,
@@ -119,7 +119,9 @@ func getParsedURL(endpoint, path string) (*url.URL, error) {
 		return nil, fmt.Errorf("failed to parse path: %w", err)
 	}
 
-	u := base.JoinPath(parsedPathAndQuery.Path)
+	// Join the escaped path, so that escaped characters such as the
+	// slash in a URL-encoded project path survive.
+	u := base.JoinPath(parsedPathAndQuery.EscapedPath())
 
 	// These have already been escaped by the URL parser
 	u.RawQuery = parsedPathAndQuery.RawQuery
@@ -127,3 +129,38 @@ func getParsedURL(endpoint, path string) (*url.URL, error) {
 
 	return u, nil
 }
+
+// glRESTDo sends a request with the given body and decodes the response
+// into out, if out is not nil. Any status other than the expected one is
+// considered an error.
+func glRESTDo(
+	ctx context.Context, cli genericRESTClient, method, path string, body, out any, expectedStatus int,
+) error {
+	req, err := cli.NewRequest(method, path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := cli.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to '%s': %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		if resp.StatusCode == http.StatusNotFound {
+			return provifv1.ErrEntityNotFound
+		}
+		return fmt.Errorf("failed to send request to '%s': %s", path, resp.Status)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v63/github"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// The issue alert is written against the GitHub API. GitLab's issues
+// are close enough that we translate the calls here.
+var _ provifv1.IssuePublisher = (*gitlabClient)(nil)
+
+const issueStateClosed = "closed"
+
+// issueRequest is the body of the GitLab create and edit issue calls
+type issueRequest struct {
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Labels      *string `json:"labels,omitempty"`
+	StateEvent  string  `json:"state_event,omitempty"`
+}
+
+type noteRequest struct {
+	Body string `json:"body"`
+}
+
+// CreateIssue implements the IssuePublisher interface
+func (c *gitlabClient) CreateIssue(
+	ctx context.Context, owner, repo string, req *github.IssueRequest,
+) (*github.Issue, error) {
+	path, err := issuesPath(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &gitlab.Issue{}
+	if err := glRESTDo(ctx, c, http.MethodPost, path, issueRequestFromGitHub(req), out, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return issueToGitHub(out), nil
+}
+
+// GetIssue implements the IssuePublisher interface
+func (c *gitlabClient) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	path, err := issuesPath(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &gitlab.Issue{}
+	if err := glRESTGet(ctx, c, path+"/"+strconv.Itoa(number), out); err != nil {
+		return nil, issueError(err)
+	}
+	return issueToGitHub(out), nil
+}
+
+// EditIssue implements the IssuePublisher interface
+func (c *gitlabClient) EditIssue(
+	ctx context.Context, owner, repo string, number int, req *github.IssueRequest,
+) (*github.Issue, error) {
+	path, err := issuesPath(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &gitlab.Issue{}
+	if err := glRESTDo(ctx, c, http.MethodPut, path+"/"+strconv.Itoa(number),
+		issueRequestFromGitHub(req), out, http.StatusOK); err != nil {
+		return nil, issueError(err)
+	}
+	return issueToGitHub(out), nil
+}
+
+// CreateIssueComment implements the IssuePublisher interface. GitLab
+// calls issue comments notes.
+func (c *gitlabClient) CreateIssueComment(
+	ctx context.Context, owner, repo string, number int, comment string,
+) (*github.IssueComment, error) {
+	path, err := issuesPath(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &gitlab.Note{}
+	if err := glRESTDo(ctx, c, http.MethodPost, path+"/"+strconv.Itoa(number)+"/notes",
+		&noteRequest{Body: comment}, out, http.StatusCreated); err != nil {
+		return nil, issueError(err)
+	}
+	return &github.IssueComment{
+		ID:   github.Int64(int64(out.ID)),
+		Body: github.String(out.Body),
+	}, nil
+}
+
+// issuesPath returns the issues path of a project. GitLab accepts the
+// URL-encoded full path of the project in place of its ID.
+func issuesPath(owner, repo string) (string, error) {
+	p, err := url.JoinPath("projects", url.PathEscape(owner+"/"+repo), "issues")
+	if err != nil {
+		return "", fmt.Errorf("failed to join URL path for issues: %w", err)
+	}
+	return p, nil
+}
+
+// issueError translates a missing issue into the engine's not found error
+func issueError(err error) error {
+	if errors.Is(err, provifv1.ErrEntityNotFound) {
+		return fmt.Errorf("%w: %w", engerrors.ErrNotFound, err)
+	}
+	return err
+}
+
+func issueRequestFromGitHub(req *github.IssueRequest) *issueRequest {
+	out := &issueRequest{
+		Title:       req.Title,
+		Description: req.Body,
+	}
+	if req.Labels != nil {
+		out.Labels = github.String(strings.Join(*req.Labels, ","))
+	}
+	switch req.GetState() {
+	case "closed":
+		out.StateEvent = "close"
+	case "open":
+		out.StateEvent = "reopen"
+	}
+	return out
+}
+
+func issueToGitHub(issue *gitlab.Issue) *github.Issue {
+	state := "open"
+	if issue.State == issueStateClosed {
+		state = "closed"
+	}
+	return &github.Issue{
+		ID:      github.Int64(int64(issue.ID)),
+		Number:  github.Int(issue.IID),
+		Title:   github.String(issue.Title),
+		Body:    github.String(issue.Description),
+		State:   github.String(state),
+		HTMLURL: github.String(issue.WebURL),
+		Labels:  labelsToGitHub(issue.Labels),
+	}
+}
+
+func labelsToGitHub(labels gitlab.Labels) []*github.Label {
+	out := make([]*github.Label, 0, len(labels))
+	for _, l := range labels {
+		out = append(out, &github.Label{Name: github.String(l)})
+	}
+	return out
+}
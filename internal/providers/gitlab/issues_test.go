@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	engerrors "github.com/mindersec/minder/pkg/engine/errors"
+)
+
+func newIssuesTestClient(t *testing.T, handler http.HandlerFunc) *gitlabClient {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	return &gitlabClient{
+		cli: ts.Client(),
+		glcfg: &minderv1.GitLabProviderConfig{
+			Endpoint: ts.URL,
+		},
+		cred: credentials.NewGitLabTokenCredential("token"),
+	}
+}
+
+func TestGitLabCreateIssue(t *testing.T) {
+	t.Parallel()
+
+	cli := newIssuesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/projects/group%2Fproject/issues", r.URL.EscapedPath())
+
+		body := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "title", body["title"])
+		assert.Equal(t, "body", body["description"])
+		assert.Equal(t, "a,b", body["labels"])
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1000, "iid": 7, "state": "opened", "web_url": "https://gitlab.com/group/project/-/issues/7"}`))
+	})
+
+	issue, err := cli.CreateIssue(context.Background(), "group", "project", &github.IssueRequest{
+		Title:  github.String("title"),
+		Body:   github.String("body"),
+		Labels: &[]string{"a", "b"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 7, issue.GetNumber())
+	assert.Equal(t, "open", issue.GetState())
+	assert.Equal(t, "https://gitlab.com/group/project/-/issues/7", issue.GetHTMLURL())
+}
+
+func TestGitLabEditIssue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		state     string
+		wantEvent string
+	}{
+		{name: "close", state: "closed", wantEvent: "close"},
+		{name: "reopen", state: "open", wantEvent: "reopen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := newIssuesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/projects/group%2Fproject/issues/7", r.URL.EscapedPath())
+
+				body := map[string]any{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.wantEvent, body["state_event"])
+
+				_, _ = w.Write([]byte(`{"id": 1000, "iid": 7, "state": "closed"}`))
+			})
+
+			_, err := cli.EditIssue(context.Background(), "group", "project", 7, &github.IssueRequest{
+				State: github.String(tt.state),
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGitLabIssueNotFound(t *testing.T) {
+	t.Parallel()
+
+	cli := newIssuesTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := cli.GetIssue(context.Background(), "group", "project", 7)
+	require.ErrorIs(t, err, engerrors.ErrNotFound)
+
+	_, err = cli.CreateIssueComment(context.Background(), "group", "project", 7, "comment")
+	require.ErrorIs(t, err, engerrors.ErrNotFound)
+}
@@ -83,6 +83,10 @@ func (m *providerClassManager) getWebhookEventDispatcher(
 		return m.handleMergeRequest
 	case gitlablib.EventTypeRelease:
 		return m.handleRelease
+	case gitlablib.EventTypePipeline:
+		return m.handlePipeline
+	case gitlablib.EventTypeJob:
+		return m.handleJob
 	default:
 		return m.handleNoop
 	}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	gitlablib "gitlab.com/gitlab-org/api/client-go"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/gitlab"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func (m *providerClassManager) handlePipeline(l zerolog.Logger, r *http.Request) error {
+	l.Debug().Msg("handling pipeline event")
+
+	pipelineEvent := gitlablib.PipelineEvent{}
+	if err := decodeJSONSafe(r.Body, &pipelineEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding pipeline event")
+		return fmt.Errorf("error decoding pipeline event: %w", err)
+	}
+
+	pipelineID := pipelineEvent.ObjectAttributes.ID
+	if pipelineID == 0 {
+		return fmt.Errorf("pipeline event missing ID")
+	}
+
+	rawProjectID := pipelineEvent.Project.ID
+	if rawProjectID == 0 {
+		return fmt.Errorf("pipeline event missing project ID")
+	}
+
+	// A pipeline which already ran some of its jobs is being retried,
+	// and thus already known.
+	started := false
+	for _, build := range pipelineEvent.Builds {
+		if build.StartedAt != "" {
+			started = true
+			break
+		}
+	}
+
+	return m.publishCIMessage(minderv1.Entity_ENTITY_PIPELINE_RUN,
+		gitlab.FormatPipelineRunUpstreamID(pipelineID), rawProjectID,
+		ciEventTopic(pipelineEvent.ObjectAttributes.Status, started))
+}
+
+func (m *providerClassManager) handleJob(l zerolog.Logger, r *http.Request) error {
+	l.Debug().Msg("handling job event")
+
+	jobEvent := gitlablib.JobEvent{}
+	if err := decodeJSONSafe(r.Body, &jobEvent); err != nil {
+		l.Error().Err(err).Msg("error decoding job event")
+		return fmt.Errorf("error decoding job event: %w", err)
+	}
+
+	jobID := jobEvent.BuildID
+	if jobID == 0 {
+		return fmt.Errorf("job event missing ID")
+	}
+
+	rawProjectID := jobEvent.ProjectID
+	if rawProjectID == 0 {
+		return fmt.Errorf("job event missing project ID")
+	}
+
+	return m.publishCIMessage(minderv1.Entity_ENTITY_TASK_RUN,
+		gitlab.FormatTaskRunUpstreamID(jobID), rawProjectID,
+		ciEventTopic(jobEvent.BuildStatus, jobEvent.BuildStartedAt != ""))
+}
+
+// ciEventTopic returns the topic for a pipeline or job event. GitLab doesn't
+// tell apart the events of new pipelines and jobs from later status changes,
+// so those which didn't start yet are created, and the rest are refreshed.
+// Retried jobs get a new ID, so they are created as well. Should GitLab send
+// both a created and a pending event before the start, creating the entity
+// a second time fails, and is logged, without any other effect. Completed
+// pipelines and jobs are kept so that their evaluation results stay visible,
+// and deleted later on by the `runs purge` server command.
+func ciEventTopic(status string, started bool) string {
+	switch status {
+	case "created", "pending":
+		if !started {
+			return constants.TopicQueueOriginatingEntityAdd
+		}
+	}
+	return constants.TopicQueueRefreshEntityAndEvaluate
+}
+
+func (m *providerClassManager) publishCIMessage(
+	entType minderv1.Entity, upstreamID string, rawProjectID int, queueTopic string) error {
+	projectID := gitlab.FormatRepositoryUpstreamID(rawProjectID)
+
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:       upstreamID,
+		gitlab.PipelineRunPropertyProjectID: projectID,
+	})
+
+	repoIdentifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: projectID,
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(entType, identifyingProps)
+	outm.WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, repoIdentifyingProps)
+	outm.WithProviderClassHint(gitlab.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	if err := m.pub.Publish(queueTopic, msg); err != nil {
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
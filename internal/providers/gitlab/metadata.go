@@ -27,6 +27,8 @@ func (c *gitlabClient) ProviderClassInfo() *minderv1.ProviderClassInfo {
 			minderv1.Entity_ENTITY_REPOSITORIES,
 			minderv1.Entity_ENTITY_PULL_REQUESTS,
 			minderv1.Entity_ENTITY_RELEASE,
+			minderv1.Entity_ENTITY_PIPELINE_RUN,
+			minderv1.Entity_ENTITY_TASK_RUN,
 		},
 		DocumentationUrl: providerDocsURL,
 	}
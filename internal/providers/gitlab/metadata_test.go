@@ -32,6 +32,8 @@ func TestClassInfo(t *testing.T) {
 		minderv1.Entity_ENTITY_REPOSITORIES,
 		minderv1.Entity_ENTITY_PULL_REQUESTS,
 		minderv1.Entity_ENTITY_RELEASE,
+		minderv1.Entity_ENTITY_PIPELINE_RUN,
+		minderv1.Entity_ENTITY_TASK_RUN,
 	}, info.SupportedEntities)
 
 	assert.ElementsMatch(t, []minderv1.ProviderType{
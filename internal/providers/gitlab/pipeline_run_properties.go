@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// defaultCIConfigPath is the path of the pipeline definition when the
+// project doesn't configure a custom one
+const defaultCIConfigPath = ".gitlab-ci.yml"
+
+// mergeRequestRefRegex matches the refs that merge request pipelines run for,
+// e.g. refs/merge-requests/12/head
+var mergeRequestRefRegex = regexp.MustCompile(`^refs/merge-requests/(\d+)/`)
+
+// FormatPipelineRunUpstreamID returns the upstream ID for a gitlab pipeline
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatPipelineRunUpstreamID(id int) string {
+	return fmt.Sprintf("%d", id)
+}
+
+func (c *gitlabClient) getPropertiesForPipelineRun(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	uid, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	pid, err := getByProps.GetProperty(PipelineRunPropertyProjectID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("project ID not found or invalid: %w", err)
+	}
+
+	pipeline, proj, err := c.getGitLabPipeline(ctx, pid, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	runProps, err := c.gitlabPipelineToProperties(ctx, pipeline, proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert pipeline to properties: %w", err)
+	}
+
+	ns, err := getGitlabProjectNamespace(proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	runProps[properties.PropertyUpstreamID] = FormatPipelineRunUpstreamID(pipeline.ID)
+	runProps[properties.PropertyName] = formatPipelineRunName(ns, proj.Name, FormatPipelineRunUpstreamID(pipeline.ID))
+	runProps[properties.PipelineRunPropertyStatus], runProps[properties.PipelineRunPropertyConclusion] =
+		normalizeCIStatus(pipeline.Status)
+
+	return properties.NewProperties(runProps), nil
+}
+
+// getGitLabPipeline returns a pipeline along with the project it runs in
+func (c *gitlabClient) getGitLabPipeline(
+	ctx context.Context, pid, pipelineID string,
+) (*gitlab.Pipeline, *gitlab.Project, error) {
+	pipelinePath, err := url.JoinPath("projects", pid, "pipelines", pipelineID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to join URL path for pipeline using upstream ID: %w", err)
+	}
+
+	pipeline := &gitlab.Pipeline{}
+	if err := glRESTGet(ctx, c, pipelinePath, pipeline); err != nil {
+		if errors.Is(err, provifv1.ErrEntityNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("failed to get pipeline: %w", err)
+	}
+
+	// Validate - pipeline upstream ID must match the one we requested
+	if res := FormatPipelineRunUpstreamID(pipeline.ID); res != pipelineID {
+		return nil, nil, fmt.Errorf("pipeline ID mismatch: %s != %s", res, pipelineID)
+	}
+
+	proj, err := c.getGitLabProject(ctx, pid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	return pipeline, proj, nil
+}
+
+// gitlabPipelineToProperties returns the properties of a pipeline which are
+// shared by the pipelines and the jobs which are part of them
+func (c *gitlabClient) gitlabPipelineToProperties(
+	ctx context.Context, pipeline *gitlab.Pipeline, proj *gitlab.Project,
+) (map[string]any, error) {
+	ns, err := getGitlabProjectNamespace(proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	ciConfigPath := proj.CIConfigPath
+	if ciConfigPath == "" {
+		ciConfigPath = defaultCIConfigPath
+	}
+
+	var actor string
+	if pipeline.User != nil {
+		actor = pipeline.User.Username
+	}
+
+	branch := pipeline.Ref
+	isFromFork := false
+	// Merge request pipelines run for a ref of the merge request rather than
+	// for a branch, so look at the merge request to find out where the change
+	// comes from.
+	if match := mergeRequestRefRegex.FindStringSubmatch(pipeline.Ref); match != nil {
+		mr, err := c.getGitLabMergeRequest(ctx, FormatRepositoryUpstreamID(proj.ID), match[1])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get merge request: %w", err)
+		}
+		branch = mr.SourceBranch
+		isFromFork = mr.SourceProjectID != 0 && mr.SourceProjectID != proj.ID
+	}
+
+	return map[string]any{
+		properties.PipelineRunPropertyWorkflowPath: ciConfigPath,
+		properties.PipelineRunPropertyTrigger:      string(pipeline.Source),
+		properties.PipelineRunPropertyActor:        actor,
+		properties.PipelineRunPropertyBranch:       branch,
+		properties.PipelineRunPropertyCommitSHA:    pipeline.SHA,
+		properties.PipelineRunPropertyIsFromFork:   isFromFork,
+		RepoPropertyNamespace:                      ns,
+		RepoPropertyProjectName:                    proj.Name,
+		PipelineRunPropertyProjectID:               FormatRepositoryUpstreamID(proj.ID),
+		PipelineRunPropertyIID:                     int64(pipeline.IID),
+		PipelineRunPropertyRef:                     pipeline.Ref,
+		PipelineRunPropertyRawStatus:               pipeline.Status,
+		PipelineRunPropertyURL:                     pipeline.WebURL,
+	}, nil
+}
+
+func (c *gitlabClient) getGitLabMergeRequest(
+	ctx context.Context, pid, iid string,
+) (*gitlab.MergeRequest, error) {
+	mrPath, err := url.JoinPath("projects", pid, "merge_requests", iid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for merge request: %w", err)
+	}
+
+	mr := &gitlab.MergeRequest{}
+	if err := glRESTGet(ctx, c, mrPath, mr); err != nil {
+		return nil, err
+	}
+
+	return mr, nil
+}
+
+// normalizeCIStatus maps the status of a gitlab pipeline or job onto the
+// status and conclusion of pipeline and task runs, which follow the GitHub
+// Actions vocabulary so that profiles work the same across providers. Only
+// final statuses map to "completed", as the `runs purge` server command
+// deletes completed runs once they are old enough.
+func normalizeCIStatus(status string) (string, string) {
+	switch status {
+	case "created", "waiting_for_resource", "preparing", "pending", "scheduled":
+		return "queued", ""
+	case "manual":
+		return "waiting", ""
+	case "running", "canceling":
+		return "in_progress", ""
+	case "success":
+		return "completed", "success"
+	case "failed":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "skipped":
+		return "completed", "skipped"
+	default:
+		return status, ""
+	}
+}
+
+func pipelineRunEntityV1FromProperties(props *properties.Properties) (*minderv1.EntityInstance, error) {
+	// validation
+	if _, err := props.GetProperty(properties.PropertyUpstreamID).AsString(); err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	if _, err := props.GetProperty(PipelineRunPropertyProjectID).AsString(); err != nil {
+		return nil, fmt.Errorf("project ID not found or invalid: %w", err)
+	}
+
+	name, err := getPipelineRunNameFromProperties(props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline name: %w", err)
+	}
+
+	return &minderv1.EntityInstance{
+		Type:       minderv1.Entity_ENTITY_PIPELINE_RUN,
+		Name:       name,
+		Properties: props.ToProtoStruct(),
+	}, nil
+}
+
+func getPipelineRunNameFromProperties(props *properties.Properties) (string, error) {
+	ns, err := getStringProp(props, RepoPropertyNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	projName, err := getStringProp(props, RepoPropertyProjectName)
+	if err != nil {
+		return "", err
+	}
+
+	pipelineID, err := getStringProp(props, properties.PropertyUpstreamID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatPipelineRunName(ns, projName, pipelineID), nil
+}
+
+func formatPipelineRunName(ns, projName, pipelineID string) string {
+	return fmt.Sprintf("%s/%s/-/pipelines/%s", ns, projName, pipelineID)
+}
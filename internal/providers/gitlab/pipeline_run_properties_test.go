@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func newTestPipelineServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+	mux.HandleFunc("GET /projects/1", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"id":        1,
+			"name":      "project",
+			"namespace": map[string]any{"path": "group"},
+		})
+	})
+	mux.HandleFunc("GET /projects/1/pipelines/{id}", func(w http.ResponseWriter, r *http.Request) {
+		switch r.PathValue("id") {
+		case "100":
+			writeJSON(w, map[string]any{
+				"id":      100,
+				"iid":     7,
+				"status":  "success",
+				"source":  "push",
+				"ref":     "main",
+				"sha":     "abc123",
+				"user":    map[string]any{"username": "jdoe"},
+				"web_url": "https://gitlab.com/group/project/-/pipelines/100",
+			})
+		case "101":
+			writeJSON(w, map[string]any{
+				"id":     101,
+				"iid":    8,
+				"status": "running",
+				"source": "merge_request_event",
+				"ref":    "refs/merge-requests/3/head",
+				"sha":    "def456",
+				"user":   map[string]any{"username": "contributor"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	mux.HandleFunc("GET /projects/1/merge_requests/3", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"id":                300,
+			"iid":               3,
+			"source_branch":     "feature",
+			"source_project_id": 2,
+			"target_project_id": 1,
+		})
+	})
+	mux.HandleFunc("GET /projects/1/jobs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		switch r.PathValue("id") {
+		case "500":
+			writeJSON(w, map[string]any{
+				"id":       500,
+				"name":     "build",
+				"stage":    "test",
+				"status":   "failed",
+				"tag_list": []string{"docker", "linux"},
+				"pipeline": map[string]any{"id": 101},
+				"runner":   map[string]any{"id": 9, "description": "group runner", "is_shared": false},
+			})
+		case "501":
+			writeJSON(w, map[string]any{
+				"id":       501,
+				"name":     "deploy",
+				"stage":    "deploy",
+				"status":   "pending",
+				"pipeline": map[string]any{"id": 100},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFetchAllPropertiesPipelineRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		id      string
+		want    map[string]any
+		wantErr error
+	}{
+		{
+			name: "push pipeline",
+			id:   "100",
+			want: map[string]any{
+				properties.PropertyName:                    "group/project/-/pipelines/100",
+				properties.PipelineRunPropertyWorkflowPath: ".gitlab-ci.yml",
+				properties.PipelineRunPropertyTrigger:      "push",
+				properties.PipelineRunPropertyActor:        "jdoe",
+				properties.PipelineRunPropertyBranch:       "main",
+				properties.PipelineRunPropertyCommitSHA:    "abc123",
+				properties.PipelineRunPropertyIsFromFork:   false,
+				properties.PipelineRunPropertyStatus:       "completed",
+				properties.PipelineRunPropertyConclusion:   "success",
+				PipelineRunPropertyRawStatus:               "success",
+			},
+		},
+		{
+			name: "merge request pipeline from a fork",
+			id:   "101",
+			want: map[string]any{
+				properties.PipelineRunPropertyTrigger:    "merge_request_event",
+				properties.PipelineRunPropertyBranch:     "feature",
+				properties.PipelineRunPropertyIsFromFork: true,
+				properties.PipelineRunPropertyStatus:     "in_progress",
+				properties.PipelineRunPropertyConclusion: "",
+			},
+		},
+		{
+			name:    "pipeline not found",
+			id:      "404",
+			wantErr: provifv1.ErrEntityNotFound,
+		},
+	}
+
+	srv := newTestPipelineServer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestGitlabProvider(srv.URL)
+			got, err := c.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID: tt.id,
+				PipelineRunPropertyProjectID:  "1",
+			}), minderv1.Entity_ENTITY_PIPELINE_RUN, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for key, want := range tt.want {
+				assert.Equal(t, want, got.GetProperty(key).RawValue(), "property %s", key)
+			}
+
+			assert.NotZero(t, got.GetProperty(PipelineRunPropertyIID).GetInt64())
+
+			ent, err := c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_PIPELINE_RUN, got)
+			require.NoError(t, err)
+			assert.Equal(t, "group/project/-/pipelines/"+tt.id, ent.(*minderv1.EntityInstance).GetName())
+		})
+	}
+}
+
+func TestFetchAllPropertiesTaskRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		id      string
+		want    map[string]any
+		unset   []string
+		wantErr error
+	}{
+		{
+			name: "job on a group runner",
+			id:   "500",
+			want: map[string]any{
+				properties.PropertyName:                  "group/project/-/jobs/500",
+				properties.TaskRunPropertyPipelineRunID:  "101",
+				properties.TaskRunPropertyRunnerLabels:   []any{"docker", "linux"},
+				properties.TaskRunPropertyIsSelfHosted:   true,
+				properties.PipelineRunPropertyStatus:     "completed",
+				properties.PipelineRunPropertyConclusion: "failure",
+				// the pipeline run properties come from the pipeline
+				properties.PipelineRunPropertyIsFromFork: true,
+				TaskRunPropertyJobName:                   "build",
+				TaskRunPropertyStage:                     "test",
+				TaskRunPropertyRunnerDescription:         "group runner",
+			},
+		},
+		{
+			name: "job waiting for a runner",
+			id:   "501",
+			want: map[string]any{
+				properties.TaskRunPropertyPipelineRunID: "100",
+				properties.TaskRunPropertyRunnerLabels:  []any{},
+				properties.PipelineRunPropertyStatus:    "queued",
+				properties.PipelineRunPropertyTrigger:   "push",
+			},
+			unset: []string{properties.TaskRunPropertyIsSelfHosted, TaskRunPropertyRunnerIsShared},
+		},
+		{
+			name:    "job not found",
+			id:      "404",
+			wantErr: provifv1.ErrEntityNotFound,
+		},
+	}
+
+	srv := newTestPipelineServer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestGitlabProvider(srv.URL)
+			got, err := c.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID: tt.id,
+				PipelineRunPropertyProjectID:  "1",
+			}), minderv1.Entity_ENTITY_TASK_RUN, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for key, want := range tt.want {
+				assert.Equal(t, want, got.GetProperty(key).RawValue(), "property %s", key)
+			}
+			for _, key := range tt.unset {
+				assert.Nil(t, got.GetProperty(key), "property %s", key)
+			}
+
+			ent, err := c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_TASK_RUN, got)
+			require.NoError(t, err)
+			assert.Equal(t, minderv1.Entity_ENTITY_TASK_RUN, ent.(*minderv1.EntityInstance).GetType())
+		})
+	}
+}
+
+func TestNormalizeCIStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status         string
+		wantStatus     string
+		wantConclusion string
+	}{
+		{status: "created", wantStatus: "queued"},
+		{status: "pending", wantStatus: "queued"},
+		{status: "manual", wantStatus: "waiting"},
+		{status: "running", wantStatus: "in_progress"},
+		// still running until GitLab reports it canceled
+		{status: "canceling", wantStatus: "in_progress"},
+		{status: "success", wantStatus: "completed", wantConclusion: "success"},
+		{status: "failed", wantStatus: "completed", wantConclusion: "failure"},
+		{status: "canceled", wantStatus: "completed", wantConclusion: "cancelled"},
+		{status: "skipped", wantStatus: "completed", wantConclusion: "skipped"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			t.Parallel()
+
+			status, conclusion := normalizeCIStatus(tt.status)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantConclusion, conclusion)
+		})
+	}
+}
@@ -53,6 +53,34 @@ const (
 	ReleasePropertyBranch = "gitlab/branch"
 )
 
+// Pipeline Run Properties
+const (
+	// PipelineRunPropertyProjectID represents the gitlab project ID
+	PipelineRunPropertyProjectID = "gitlab/project_id"
+	// PipelineRunPropertyIID represents the gitlab pipeline number within the project
+	PipelineRunPropertyIID = "gitlab/pipeline_iid"
+	// PipelineRunPropertyRef represents the gitlab ref the pipeline runs for
+	PipelineRunPropertyRef = "gitlab/ref"
+	// PipelineRunPropertyRawStatus represents the gitlab status of the pipeline, or of the job
+	PipelineRunPropertyRawStatus = "gitlab/status"
+	// PipelineRunPropertyURL represents the gitlab pipeline URL
+	PipelineRunPropertyURL = "gitlab/pipeline_url"
+)
+
+// Task Run Properties
+const (
+	// TaskRunPropertyJobName represents the gitlab job name
+	TaskRunPropertyJobName = "gitlab/job_name"
+	// TaskRunPropertyStage represents the gitlab stage the job is part of
+	TaskRunPropertyStage = "gitlab/stage"
+	// TaskRunPropertyRunnerDescription represents the description of the gitlab runner
+	TaskRunPropertyRunnerDescription = "gitlab/runner_description"
+	// TaskRunPropertyRunnerIsShared represents whether the gitlab runner is a shared runner
+	TaskRunPropertyRunnerIsShared = "gitlab/runner_is_shared"
+	// TaskRunPropertyURL represents the gitlab job URL
+	TaskRunPropertyURL = "gitlab/job_url"
+)
+
 // FetchAllProperties implements the provider interface
 func (c *gitlabClient) FetchAllProperties(
 	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, _ *properties.Properties,
@@ -61,7 +89,7 @@ func (c *gitlabClient) FetchAllProperties(
 		return nil, fmt.Errorf("entity type %s not supported", entType)
 	}
 
-	//nolint:exhaustive // We only support two entity types for now.
+	//nolint:exhaustive // We only support a subset of the entity types.
 	switch entType {
 	case minderv1.Entity_ENTITY_REPOSITORIES:
 		return c.getPropertiesForRepo(ctx, getByProps)
@@ -69,6 +97,10 @@ func (c *gitlabClient) FetchAllProperties(
 		return c.getPropertiesForPullRequest(ctx, getByProps)
 	case minderv1.Entity_ENTITY_RELEASE:
 		return c.getPropertiesForRelease(ctx, getByProps)
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return c.getPropertiesForPipelineRun(ctx, getByProps)
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return c.getPropertiesForTaskRun(ctx, getByProps)
 	default:
 		return nil, fmt.Errorf("entity type %s not supported", entType)
 	}
@@ -91,7 +123,7 @@ func (c *gitlabClient) GetEntityName(entityType minderv1.Entity, props *properti
 		return "", fmt.Errorf("entity type %s not supported", entityType)
 	}
 
-	//nolint:exhaustive // We only support two entity types for now.
+	//nolint:exhaustive // We only support a subset of the entity types.
 	switch entityType {
 	case minderv1.Entity_ENTITY_REPOSITORIES:
 		return getRepoNameFromProperties(props)
@@ -99,6 +131,10 @@ func (c *gitlabClient) GetEntityName(entityType minderv1.Entity, props *properti
 		return getPullRequestNameFromProperties(props)
 	case minderv1.Entity_ENTITY_RELEASE:
 		return getReleaseNameFromProperties(props)
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return getPipelineRunNameFromProperties(props)
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return getTaskRunNameFromProperties(props)
 	default:
 		return "", fmt.Errorf("entity type %s not supported", entityType)
 	}
@@ -112,7 +148,7 @@ func (c *gitlabClient) PropertiesToProtoMessage(
 		return nil, fmt.Errorf("entity type %s is not supported by the gitlab provider", entType)
 	}
 
-	//nolint:exhaustive // We only support two entity types for now.
+	//nolint:exhaustive // We only support a subset of the entity types.
 	switch entType {
 	case minderv1.Entity_ENTITY_REPOSITORIES:
 		return repoV1FromProperties(props)
@@ -120,6 +156,10 @@ func (c *gitlabClient) PropertiesToProtoMessage(
 		return pullRequestV1FromProperties(props)
 	case minderv1.Entity_ENTITY_RELEASE:
 		return releaseEntityV1FromProperties(props)
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return pipelineRunEntityV1FromProperties(props)
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return taskRunEntityV1FromProperties(props)
 	default:
 		return nil, fmt.Errorf("entity type %s not supported", entType)
 	}
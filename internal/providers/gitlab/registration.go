@@ -33,7 +33,7 @@ func (c *gitlabClient) RegisterEntity(
 
 	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
 		// We only explicitly register repositories
-		// Pull requests are handled via origination
+		// Pull requests, releases, pipelines and jobs are handled via origination
 		return props, nil
 	}
 
@@ -114,6 +114,8 @@ func (c *gitlabClient) createWebhook(ctx context.Context, upstreamID string) (*p
 		TagPushEvents:         trve,
 		MergeRequestsEvents:   trve,
 		ReleasesEvents:        trve,
+		PipelineEvents:        trve,
+		JobEvents:             trve,
 		EnableSSLVerification: trve,
 	}
 
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// FormatTaskRunUpstreamID returns the upstream ID for a gitlab job
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatTaskRunUpstreamID(id int) string {
+	return fmt.Sprintf("%d", id)
+}
+
+func (c *gitlabClient) getPropertiesForTaskRun(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	uid, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	pid, err := getByProps.GetProperty(PipelineRunPropertyProjectID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("project ID not found or invalid: %w", err)
+	}
+
+	jobPath, err := url.JoinPath("projects", pid, "jobs", uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for job using upstream ID: %w", err)
+	}
+
+	job := &gitlab.Job{}
+	if err := glRESTGet(ctx, c, jobPath, job); err != nil {
+		if errors.Is(err, provifv1.ErrEntityNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	// Validate - job upstream ID must match the one we requested
+	if res := FormatTaskRunUpstreamID(job.ID); res != uid {
+		return nil, fmt.Errorf("job ID mismatch: %s != %s", res, uid)
+	}
+
+	pipeline, proj, err := c.getGitLabPipeline(ctx, pid, FormatPipelineRunUpstreamID(job.Pipeline.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline of job: %w", err)
+	}
+
+	// The pipeline related properties are those of the pipeline the job is
+	// part of, while the status is the job's own.
+	taskProps, err := c.gitlabPipelineToProperties(ctx, pipeline, proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert pipeline to properties: %w", err)
+	}
+
+	ns, err := getGitlabProjectNamespace(proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	labels := make([]any, 0, len(job.TagList))
+	for _, tag := range job.TagList {
+		labels = append(labels, tag)
+	}
+
+	taskProps[properties.PropertyUpstreamID] = FormatTaskRunUpstreamID(job.ID)
+	taskProps[properties.PropertyName] = formatTaskRunName(ns, proj.Name, FormatTaskRunUpstreamID(job.ID))
+	taskProps[properties.PipelineRunPropertyStatus], taskProps[properties.PipelineRunPropertyConclusion] =
+		normalizeCIStatus(job.Status)
+	taskProps[properties.TaskRunPropertyPipelineRunID] = FormatPipelineRunUpstreamID(pipeline.ID)
+	taskProps[properties.TaskRunPropertyRunnerLabels] = labels
+	taskProps[PipelineRunPropertyRawStatus] = job.Status
+	taskProps[TaskRunPropertyJobName] = job.Name
+	taskProps[TaskRunPropertyStage] = job.Stage
+	taskProps[TaskRunPropertyURL] = job.WebURL
+
+	// Which runner picks up the job is only known once it starts. Instance
+	// runners are managed by the GitLab administrators, any other runner is
+	// registered by the owners of the group or project.
+	if job.Runner.ID != 0 {
+		taskProps[properties.TaskRunPropertyIsSelfHosted] = !job.Runner.IsShared
+		taskProps[TaskRunPropertyRunnerDescription] = job.Runner.Description
+		taskProps[TaskRunPropertyRunnerIsShared] = job.Runner.IsShared
+	}
+
+	return properties.NewProperties(taskProps), nil
+}
+
+func taskRunEntityV1FromProperties(props *properties.Properties) (*minderv1.EntityInstance, error) {
+	// validation
+	if _, err := props.GetProperty(properties.PropertyUpstreamID).AsString(); err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	if _, err := props.GetProperty(PipelineRunPropertyProjectID).AsString(); err != nil {
+		return nil, fmt.Errorf("project ID not found or invalid: %w", err)
+	}
+
+	name, err := getTaskRunNameFromProperties(props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job name: %w", err)
+	}
+
+	return &minderv1.EntityInstance{
+		Type:       minderv1.Entity_ENTITY_TASK_RUN,
+		Name:       name,
+		Properties: props.ToProtoStruct(),
+	}, nil
+}
+
+func getTaskRunNameFromProperties(props *properties.Properties) (string, error) {
+	ns, err := getStringProp(props, RepoPropertyNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	projName, err := getStringProp(props, RepoPropertyProjectName)
+	if err != nil {
+		return "", err
+	}
+
+	jobID, err := getStringProp(props, properties.PropertyUpstreamID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatTaskRunName(ns, projName, jobID), nil
+}
+
+func formatTaskRunName(ns, projName, jobID string) string {
+	return fmt.Sprintf("%s/%s/-/jobs/%s", ns, projName, jobID)
+}
@@ -94,6 +94,62 @@ func pullRequestToSelectorEntity(
 	return selEnt
 }
 
+func pipelineRunToSelectorEntity(
+	entityWithProps *models.EntityWithProperties, selProv *internalpb.SelectorProvider,
+) *internalpb.SelectorEntity {
+	var isFromFork *bool
+	if propIsFromFork, err := entityWithProps.Properties.GetProperty(
+		properties.PipelineRunPropertyIsFromFork).AsBool(); err == nil {
+		isFromFork = proto.Bool(propIsFromFork)
+	}
+
+	selEnt := buildBaseSelectorEntity(entityWithProps, selProv)
+	selEnt.Entity = &internalpb.SelectorEntity_PipelineRun{
+		PipelineRun: &internalpb.SelectorPipelineRun{
+			Name:       entityWithProps.Entity.Name,
+			Trigger:    entityWithProps.Properties.GetProperty(properties.PipelineRunPropertyTrigger).GetString(),
+			Actor:      entityWithProps.Properties.GetProperty(properties.PipelineRunPropertyActor).GetString(),
+			IsFromFork: isFromFork,
+			Properties: entityWithProps.Properties.ToProtoStruct(),
+			Provider:   selProv,
+		},
+	}
+	return selEnt
+}
+
+func taskRunToSelectorEntity(
+	entityWithProps *models.EntityWithProperties, selProv *internalpb.SelectorProvider,
+) *internalpb.SelectorEntity {
+	var isSelfHosted *bool
+	if propIsSelfHosted, err := entityWithProps.Properties.GetProperty(
+		properties.TaskRunPropertyIsSelfHosted).AsBool(); err == nil {
+		isSelfHosted = proto.Bool(propIsSelfHosted)
+	}
+
+	var labels []string
+	if propLabels := entityWithProps.Properties.GetProperty(properties.TaskRunPropertyRunnerLabels); propLabels != nil {
+		if rawLabels, ok := propLabels.RawValue().([]any); ok {
+			for _, label := range rawLabels {
+				if strLabel, ok := label.(string); ok {
+					labels = append(labels, strLabel)
+				}
+			}
+		}
+	}
+
+	selEnt := buildBaseSelectorEntity(entityWithProps, selProv)
+	selEnt.Entity = &internalpb.SelectorEntity_TaskRun{
+		TaskRun: &internalpb.SelectorTaskRun{
+			Name:         entityWithProps.Entity.Name,
+			RunnerLabels: labels,
+			IsSelfHosted: isSelfHosted,
+			Properties:   entityWithProps.Properties.ToProtoStruct(),
+			Provider:     selProv,
+		},
+	}
+	return selEnt
+}
+
 // genericToSelectorEntity is a fallback converter used for any generic or newly added
 // entities (e.g. release, build). It extracts standard entity properties natively.
 func genericToSelectorEntity(
@@ -119,6 +175,10 @@ func newConverter(entType minderv1.Entity) toSelectorEntity {
 		return artifactToSelectorEntity
 	case minderv1.Entity_ENTITY_PULL_REQUESTS:
 		return pullRequestToSelectorEntity
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return pipelineRunToSelectorEntity
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return taskRunToSelectorEntity
 	default:
 		// gracefully handle generic or unknown entities so properties can still be queried in selectors
 		return genericToSelectorEntity
@@ -91,6 +91,29 @@ func checkSelEntPullRequest(t *testing.T, got, expected *internalpb.SelectorPull
 	checkProps(t, got.Properties, propMap)
 }
 
+func checkSelEntPipelineRun(t *testing.T, got, expected *internalpb.SelectorPipelineRun, propMap map[string]any, expProvider *db.Provider) {
+	t.Helper()
+
+	assert.Equal(t, got.Name, expected.Name)
+	assert.Equal(t, got.Trigger, expected.Trigger)
+	assert.Equal(t, got.Actor, expected.Actor)
+	assert.Equal(t, got.IsFromFork, expected.IsFromFork)
+	assert.Equal(t, got.GetProvider().GetName(), expProvider.Name)
+	assert.Equal(t, got.GetProvider().GetClass(), string(expProvider.Class))
+	checkProps(t, got.Properties, propMap)
+}
+
+func checkSelEntTaskRun(t *testing.T, got, expected *internalpb.SelectorTaskRun, propMap map[string]any, expProvider *db.Provider) {
+	t.Helper()
+
+	assert.Equal(t, got.Name, expected.Name)
+	assert.Equal(t, got.RunnerLabels, expected.RunnerLabels)
+	assert.Equal(t, got.IsSelfHosted, expected.IsSelfHosted)
+	assert.Equal(t, got.GetProvider().GetName(), expProvider.Name)
+	assert.Equal(t, got.GetProvider().GetClass(), string(expProvider.Class))
+	checkProps(t, got.Properties, propMap)
+}
+
 func checkSelEnt(t *testing.T, got, expected *internalpb.SelectorEntity, propMap map[string]any, expProvider *db.Provider) {
 	t.Helper()
 
@@ -102,6 +125,10 @@ func checkSelEnt(t *testing.T, got, expected *internalpb.SelectorEntity, propMap
 		checkSelEntArtifact(t, got.GetArtifact(), expected.GetArtifact(), propMap, expProvider)
 	case minderv1.Entity_ENTITY_PULL_REQUESTS:
 		checkSelEntPullRequest(t, got.GetPullRequest(), expected.GetPullRequest(), propMap, expProvider)
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		checkSelEntPipelineRun(t, got.GetPipelineRun(), expected.GetPipelineRun(), propMap, expProvider)
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		checkSelEntTaskRun(t, got.GetTaskRun(), expected.GetTaskRun(), propMap, expProvider)
 	}
 }
 
@@ -207,6 +234,65 @@ func TestEntityToSelectorEntity(t *testing.T) {
 			expDbProv: &gitlabProvider,
 			success:   true,
 		},
+		{
+			name:       "Pipeline Run",
+			entityType: minderv1.Entity_ENTITY_PIPELINE_RUN,
+			entityName: "testorg/testrepo/actions/runs/123",
+			entityProps: map[string]any{
+				properties.PropertyUpstreamID:            "123",
+				properties.PipelineRunPropertyTrigger:    "pull_request_target",
+				properties.PipelineRunPropertyActor:      "octocat",
+				properties.PipelineRunPropertyIsFromFork: true,
+				ghprops.WorkflowRunPropertyRepoOwner:     "testorg",
+				ghprops.WorkflowRunPropertyRepoName:      "testrepo",
+			},
+			expSelEnt: &internalpb.SelectorEntity{
+				EntityType: minderv1.Entity_ENTITY_PIPELINE_RUN,
+				Name:       "testorg/testrepo/actions/runs/123",
+				Entity: &internalpb.SelectorEntity_PipelineRun{
+					PipelineRun: &internalpb.SelectorPipelineRun{
+						Name:       "testorg/testrepo/actions/runs/123",
+						Trigger:    "pull_request_target",
+						Actor:      "octocat",
+						IsFromFork: &trueBool,
+					},
+				},
+			},
+			dbSetup: dbf.NewDBMock(
+				withGetProviderByID(githubProvider, nil),
+			),
+			expDbProv: &githubProvider,
+			success:   true,
+		},
+		{
+			name:       "Task Run",
+			entityType: minderv1.Entity_ENTITY_TASK_RUN,
+			entityName: "testorg/testrepo/actions/jobs/456",
+			entityProps: map[string]any{
+				properties.PropertyUpstreamID:           "456",
+				properties.TaskRunPropertyPipelineRunID: "123",
+				properties.TaskRunPropertyRunnerLabels:  []any{"self-hosted", "linux"},
+				properties.TaskRunPropertyIsSelfHosted:  true,
+				ghprops.WorkflowRunPropertyRepoOwner:    "testorg",
+				ghprops.WorkflowRunPropertyRepoName:     "testrepo",
+			},
+			expSelEnt: &internalpb.SelectorEntity{
+				EntityType: minderv1.Entity_ENTITY_TASK_RUN,
+				Name:       "testorg/testrepo/actions/jobs/456",
+				Entity: &internalpb.SelectorEntity_TaskRun{
+					TaskRun: &internalpb.SelectorTaskRun{
+						Name:         "testorg/testrepo/actions/jobs/456",
+						RunnerLabels: []string{"self-hosted", "linux"},
+						IsSelfHosted: &trueBool,
+					},
+				},
+			},
+			dbSetup: dbf.NewDBMock(
+				withGetProviderByID(githubProvider, nil),
+			),
+			expDbProv: &githubProvider,
+			success:   true,
+		},
 		{
 			name:       "Repository but no querier provided",
 			entityType: minderv1.Entity_ENTITY_REPOSITORIES,
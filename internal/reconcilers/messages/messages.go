@@ -24,14 +24,21 @@ type RepoReconcilerEvent struct {
 	Provider uuid.UUID `json:"provider"`
 	// EntityID is the entity id of the repository to be reconciled
 	EntityID uuid.UUID `json:"entity_id"`
+	// ProfileIDs restricts the evaluation to the given profiles. If
+	// empty, the entity is evaluated against all applicable profiles.
+	ProfileIDs []uuid.UUID `json:"profile_ids,omitempty"`
 }
 
-// NewRepoReconcilerMessage creates a new repos init event
-func NewRepoReconcilerMessage(providerID uuid.UUID, entityID uuid.UUID, projectID uuid.UUID) (*message.Message, error) {
+// NewRepoReconcilerMessage creates a new repos init event. If profile IDs
+// are given, only those profiles are evaluated.
+func NewRepoReconcilerMessage(
+	providerID uuid.UUID, entityID uuid.UUID, projectID uuid.UUID, profileIDs ...uuid.UUID,
+) (*message.Message, error) {
 	evt := &RepoReconcilerEvent{
-		Project:  projectID,
-		Provider: providerID,
-		EntityID: entityID,
+		Project:    projectID,
+		Provider:   providerID,
+		EntityID:   entityID,
+		ProfileIDs: profileIDs,
 	}
 
 	evtStr, err := json.Marshal(evt)
@@ -48,7 +48,8 @@ func (r *Reconciler) handleRepoReconcilerEvent(msg *message.Message) error {
 // nolint: gocyclo
 func (r *Reconciler) handleRepositoryReconcilerEvent(ctx context.Context, evt *messages.RepoReconcilerEvent) error {
 	entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
-		WithEntityID(evt.EntityID)
+		WithEntityID(evt.EntityID).
+		WithProfileIDs(evt.ProfileIDs)
 
 	m := message.NewMessage(uuid.New().String(), nil)
 	if err := entRefresh.ToMessage(m); err != nil {
@@ -20,14 +20,21 @@ type EntityReminderEvent struct {
 	ProviderID uuid.UUID `json:"provider"`
 	// EntityID is the entity id of the repository to be reconciled
 	EntityID uuid.UUID `json:"entity_id"`
+	// ProfileIDs are the profiles which are due for re-evaluation. If
+	// empty, the entity is evaluated against all applicable profiles.
+	ProfileIDs []uuid.UUID `json:"profile_ids,omitempty"`
 }
 
-// NewEntityReminderMessage creates a new repo reminder message
-func NewEntityReminderMessage(providerId uuid.UUID, entityID uuid.UUID, projectID uuid.UUID) (*message.Message, error) {
+// NewEntityReminderMessage creates a new entity reminder message. If profile
+// IDs are given, only those profiles are re-evaluated.
+func NewEntityReminderMessage(
+	providerId uuid.UUID, entityID uuid.UUID, projectID uuid.UUID, profileIDs ...uuid.UUID,
+) (*message.Message, error) {
 	evt := &EntityReminderEvent{
 		Project:    projectID,
 		ProviderID: providerId,
 		EntityID:   entityID,
+		ProfileIDs: profileIDs,
 	}
 
 	evtStr, err := json.Marshal(evt)
@@ -14,15 +14,28 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"github.com/sqlc-dev/pqtype"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/mindersec/minder/internal/db"
 	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
 	"github.com/mindersec/minder/internal/reminder/metrics"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	reminderconfig "github.com/mindersec/minder/pkg/config/reminder"
 	"github.com/mindersec/minder/pkg/eventer/constants"
 )
 
+// reminderEntityTypes are the types of entities which are re-evaluated in
+// the background
+var reminderEntityTypes = []db.Entities{
+	db.EntitiesRepository,
+	db.EntitiesArtifact,
+	db.EntitiesPullRequest,
+}
+
 // Interface is an interface over the reminder service
 type Interface interface {
 	// Start starts the reminder by sending reminders at regular intervals
@@ -39,7 +52,9 @@ type reminder struct {
 	stop     chan struct{}
 	stopOnce sync.Once
 
-	repositoryCursor uuid.UUID
+	// entityCursors holds, per entity type, the ID of the last entity
+	// of the previous batch
+	entityCursors map[db.Entities]uuid.UUID
 
 	ticker *time.Ticker
 
@@ -59,9 +74,12 @@ func NewReminder(ctx context.Context, store db.Store, config *reminderconfig.Con
 	}
 
 	// Set to a random UUID to start
-	r.repositoryCursor = uuid.New()
 	logger := zerolog.Ctx(ctx)
-	logger.Info().Msgf("initial repository cursor: %s", r.repositoryCursor)
+	r.entityCursors = make(map[db.Entities]uuid.UUID, len(reminderEntityTypes))
+	for _, entityType := range reminderEntityTypes {
+		r.entityCursors[entityType] = uuid.New()
+		logger.Info().Msgf("initial %s cursor: %s", entityType, r.entityCursors[entityType])
+	}
 
 	pub, err := r.getMessagePublisher(ctx)
 	if err != nil {
@@ -150,28 +168,39 @@ func (r *reminder) Stop() {
 }
 
 func (r *reminder) sendReminders(ctx context.Context) error {
+	var errs []error
+	for _, entityType := range reminderEntityTypes {
+		if err := r.sendEntityTypeReminders(ctx, entityType); err != nil {
+			errs = append(errs, fmt.Errorf("error sending %s reminders: %w", entityType, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *reminder) sendEntityTypeReminders(ctx context.Context, entityType db.Entities) error {
 	logger := zerolog.Ctx(ctx)
 
-	// Fetch a batch of repositories
-	repos, repoToLastUpdated, err := r.getRepositoryBatch(ctx)
+	// Fetch a batch of entities
+	dueEntities, err := r.getEntityBatch(ctx, entityType, time.Now())
 	if err != nil {
-		return fmt.Errorf("error fetching repository batch: %w", err)
+		return fmt.Errorf("error fetching %s batch: %w", entityType, err)
 	}
 
-	if len(repos) == 0 {
-		logger.Debug().Msg("no repositories to send reminders for")
+	if len(dueEntities) == 0 {
+		logger.Debug().Msgf("no %s entities to send reminders for", entityType)
 		return nil
 	}
 
-	logger.Info().Msgf("created repository batch of size: %d", len(repos))
+	logger.Info().Msgf("created %s batch of size: %d", entityType, len(dueEntities))
 
-	messages, err := createReminderMessages(ctx, repos)
+	messages, err := createReminderMessages(ctx, dueEntities)
 	if err != nil {
 		return fmt.Errorf("error creating reminder messages: %w", err)
 	}
 
 	if r.metrics != nil {
-		r.metrics.BatchSize.Record(ctx, int64(len(repos)))
+		r.metrics.BatchSize.Record(ctx, int64(len(dueEntities)),
+			metric.WithAttributes(attribute.String("entity_type", string(entityType))))
 	}
 
 	err = r.eventPublisher.Publish(constants.TopicQueueRepoReminder, messages...)
@@ -179,133 +208,194 @@ func (r *reminder) sendReminders(ctx context.Context) error {
 		return fmt.Errorf("error publishing messages: %w", err)
 	}
 
-	for _, repo := range repos {
-		if r.metrics != nil {
-			sendDelay := time.Since(repoToLastUpdated[repo.ID]) - r.cfg.RecurrenceConfig.MinElapsed
-			// TODO: Track whether this is a new vs existing reminder
-			// Previously used repo.ReminderLastSent field which is now removed
-			recorder := r.metrics.SendDelay
-			recorder.Record(ctx, sendDelay.Seconds())
+	if r.metrics != nil {
+		for _, ent := range dueEntities {
+			for _, prof := range ent.profiles {
+				sendDelay := time.Since(prof.lastEvaluated) - prof.interval
+				// TODO: Track whether this is a new vs existing reminder
+				r.metrics.SendDelay.Record(ctx, sendDelay.Seconds())
+			}
 		}
 	}
 
 	// Note: The legacy reminder_last_sent timestamp tracking has been removed.
-	// We rely solely on the MinElapsed check against evaluation history for throttling.
-	// This provides sufficient rate limiting while keeping the reminder service stateless
-	// and horizontally scalable.
+	// The last evaluation of each entity and profile pair, as recorded in the
+	// evaluation history, is what throttles reminders. This keeps the reminder
+	// service stateless and horizontally scalable.
 
 	return nil
 }
 
-func (r *reminder) getRepositoryBatch(ctx context.Context) ([]db.EntityInstance, map[uuid.UUID]time.Time, error) {
+// dueProfile is a profile which is due for re-evaluation against an entity
+type dueProfile struct {
+	id            uuid.UUID
+	lastEvaluated time.Time
+	interval      time.Duration
+}
+
+// dueEntity is an entity with the profiles which are due for re-evaluation
+type dueEntity struct {
+	entity   db.EntityInstance
+	profiles []dueProfile
+}
+
+func (r *reminder) getEntityBatch(ctx context.Context, entityType db.Entities, now time.Time) ([]dueEntity, error) {
 	logger := zerolog.Ctx(ctx)
 
-	logger.Debug().Msgf("fetching repositories after cursor: %s", r.repositoryCursor)
+	logger.Debug().Msgf("fetching %s entities after cursor: %s", entityType, r.entityCursors[entityType])
 
-	// Fetch repository entities after cursor
-	repos, err := r.store.ListEntitiesAfterID(ctx, db.ListEntitiesAfterIDParams{
-		EntityType: db.EntitiesRepository,
-		ID:         r.repositoryCursor,
+	// Fetch entities after cursor
+	ents, err := r.store.ListEntitiesAfterID(ctx, db.ListEntitiesAfterIDParams{
+		EntityType: entityType,
+		ID:         r.entityCursors[entityType],
 		Limit:      int64(r.cfg.RecurrenceConfig.BatchSize),
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	eligibleRepos, eligibleReposLastUpdated, err := r.getEligibleRepositories(ctx, repos)
+	dueEntities, err := r.getDueEntities(ctx, ents, now)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	logger.Debug().Msgf("%d/%d repositories are eligible for reminders", len(eligibleRepos), len(repos))
+	logger.Debug().Msgf("%d/%d %s entities are due for reminders", len(dueEntities), len(ents), entityType)
 
-	r.updateRepositoryCursor(ctx, repos)
+	r.updateEntityCursor(ctx, entityType, ents)
 
-	return eligibleRepos, eligibleReposLastUpdated, nil
+	return dueEntities, nil
 }
 
-func (r *reminder) getEligibleRepositories(ctx context.Context, repos []db.EntityInstance) (
-	[]db.EntityInstance, map[uuid.UUID]time.Time, error,
-) {
-	eligibleRepos := make([]db.EntityInstance, 0, len(repos))
+// getDueEntities returns the entities which have at least one profile due
+// for re-evaluation. A profile is due when its oldest evaluation for the
+// entity is older than the interval in its schedule, or than the minimum
+// elapsed time of the reminder if it has no schedule, and it is not in
+// one of its blackout windows.
+func (r *reminder) getDueEntities(ctx context.Context, ents []db.EntityInstance, now time.Time) ([]dueEntity, error) {
+	logger := zerolog.Ctx(ctx)
 
-	// We have a slice of entity instances, extract UUIDs for evaluation lookup
-	repoIds := make([]uuid.UUID, 0, len(repos))
-	for _, repo := range repos {
-		repoIds = append(repoIds, repo.ID)
+	entityIDs := make([]uuid.UUID, 0, len(ents))
+	for _, ent := range ents {
+		entityIDs = append(entityIDs, ent.ID)
 	}
 
-	// Use entity_instance_id based query instead of repository_id
-	oldestRuleEvals, err := r.store.ListOldestRuleEvaluationsByEntityID(ctx, repoIds)
+	oldestRuleEvals, err := r.store.ListOldestRuleEvaluationsByEntityAndProfile(ctx, entityIDs)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	idToLastUpdate := make(map[uuid.UUID]time.Time, len(oldestRuleEvals))
+
+	dueProfiles := make(map[uuid.UUID][]dueProfile, len(ents))
 	for _, ruleEval := range oldestRuleEvals {
-		idToLastUpdate[ruleEval.EntityInstanceID] = ruleEval.OldestLastUpdated
+		schedule, err := parseSchedule(ruleEval.ProfileSchedule)
+		if err != nil {
+			// Fall back to the reminder defaults rather than never
+			// re-evaluating the profile
+			logger.Error().Err(err).Str("profile_id", ruleEval.ProfileID.String()).
+				Msg("invalid profile schedule, using defaults")
+		}
+
+		if schedule.InBlackout(now) {
+			continue
+		}
+
+		interval, err := schedule.GetIntervalDuration()
+		if err != nil || interval == 0 {
+			interval = r.cfg.RecurrenceConfig.MinElapsed
+		}
+
+		if ruleEval.OldestLastUpdated.Before(now.Add(-interval)) {
+			dueProfiles[ruleEval.EntityInstanceID] = append(dueProfiles[ruleEval.EntityInstanceID], dueProfile{
+				id:            ruleEval.ProfileID,
+				lastEvaluated: ruleEval.OldestLastUpdated,
+				interval:      interval,
+			})
+		}
 	}
 
-	cutoff := time.Now().Add(-1 * r.cfg.RecurrenceConfig.MinElapsed)
-	for _, repo := range repos {
-		if t, ok := idToLastUpdate[repo.ID]; ok && t.Before(cutoff) {
-			eligibleRepos = append(eligibleRepos, repo)
+	dueEntities := make([]dueEntity, 0, len(dueProfiles))
+	for _, ent := range ents {
+		if profiles, ok := dueProfiles[ent.ID]; ok {
+			dueEntities = append(dueEntities, dueEntity{entity: ent, profiles: profiles})
 		}
 	}
 
-	return eligibleRepos, idToLastUpdate, nil
+	return dueEntities, nil
 }
 
-func (r *reminder) updateRepositoryCursor(ctx context.Context, repos []db.EntityInstance) {
+// parseSchedule decodes the stored schedule of a profile. A missing
+// schedule decodes to nil, which applies the reminder defaults.
+func parseSchedule(raw pqtype.NullRawMessage) (*minderv1.Profile_Schedule, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+
+	schedule := &minderv1.Profile_Schedule{}
+	if err := protojson.Unmarshal(raw.RawMessage, schedule); err != nil {
+		return nil, fmt.Errorf("error unmarshalling schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (r *reminder) updateEntityCursor(ctx context.Context, entityType db.Entities, ents []db.EntityInstance) {
 	logger := zerolog.Ctx(ctx)
 
-	if len(repos) == 0 {
-		r.repositoryCursor = uuid.Nil
+	if len(ents) == 0 {
+		r.entityCursors[entityType] = uuid.Nil
 	} else {
-		r.repositoryCursor = repos[len(repos)-1].ID
-		r.adjustCursorForEndOfList(ctx)
+		r.entityCursors[entityType] = ents[len(ents)-1].ID
+		r.adjustCursorForEndOfList(ctx, entityType)
 	}
 
-	logger.Debug().Msgf("updated repository cursor to: %s", r.repositoryCursor)
+	logger.Debug().Msgf("updated %s cursor to: %s", entityType, r.entityCursors[entityType])
 }
 
-func (r *reminder) adjustCursorForEndOfList(ctx context.Context) {
+func (r *reminder) adjustCursorForEndOfList(ctx context.Context, entityType db.Entities) {
 	logger := zerolog.Ctx(ctx)
+	cursor := r.entityCursors[entityType]
 
-	// Check if any repository entities exist after the cursor
+	// Check if any entities exist after the cursor
 	exists, err := r.store.EntityExistsAfterID(ctx, db.EntityExistsAfterIDParams{
-		EntityType: db.EntitiesRepository,
-		ID:         r.repositoryCursor,
+		EntityType: entityType,
+		ID:         cursor,
 	})
 	if err != nil {
-		logger.Error().Err(err).Msgf("unable to check if repository exists after cursor: %s"+
-			", resetting cursor to zero uuid", r.repositoryCursor)
-		r.repositoryCursor = uuid.Nil
+		logger.Error().Err(err).Msgf("unable to check if %s exists after cursor: %s"+
+			", resetting cursor to zero uuid", entityType, cursor)
+		r.entityCursors[entityType] = uuid.Nil
 		return
 	}
 
 	if !exists {
-		logger.Info().Msgf("cursor %s is at the end of the list, resetting cursor to zero uuid",
-			r.repositoryCursor)
-		r.repositoryCursor = uuid.Nil
+		logger.Info().Msgf("%s cursor %s is at the end of the list, resetting cursor to zero uuid",
+			entityType, cursor)
+		r.entityCursors[entityType] = uuid.Nil
 	}
 }
 
-func createReminderMessages(ctx context.Context, repos []db.EntityInstance) ([]*message.Message, error) {
+func createReminderMessages(ctx context.Context, dueEntities []dueEntity) ([]*message.Message, error) {
 	logger := zerolog.Ctx(ctx)
 
-	messages := make([]*message.Message, 0, len(repos))
-	for _, repo := range repos {
-		repoReconcileMessage, err := remindermessages.NewEntityReminderMessage(
-			repo.ProviderID, repo.ID, repo.ProjectID,
+	messages := make([]*message.Message, 0, len(dueEntities))
+	for _, due := range dueEntities {
+		profileIDs := make([]uuid.UUID, 0, len(due.profiles))
+		for _, prof := range due.profiles {
+			profileIDs = append(profileIDs, prof.id)
+		}
+
+		reminderMessage, err := remindermessages.NewEntityReminderMessage(
+			due.entity.ProviderID, due.entity.ID, due.entity.ProjectID, profileIDs...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error creating reminder message: %w", err)
 		}
 
 		logger.Debug().
-			Str("repo", repo.ID.String()).
+			Str("entity", due.entity.ID.String()).
+			Str("entity_type", string(due.entity.EntityType)).
+			Int("profiles", len(profileIDs)).
 			Msg("created reminder message")
 
-		messages = append(messages, repoReconcileMessage)
+		messages = append(messages, reminderMessage)
 	}
 
 	return messages, nil
@@ -11,15 +11,19 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
+	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	reminderconfig "github.com/mindersec/minder/pkg/config/reminder"
 )
 
-func Test_getRepositoryBatch(t *testing.T) {
+func Test_getEntityBatch(t *testing.T) {
 	t.Parallel()
 
 	type expectedOutput struct {
@@ -49,7 +53,7 @@ func Test_getRepositoryBatch(t *testing.T) {
 			},
 			setup: func(store *mockdb.MockStore, _ input) {
 				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(nil, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByEntityID(gomock.Any(), []uuid.UUID{}).Return(nil, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityAndProfile(gomock.Any(), []uuid.UUID{}).Return(nil, nil)
 			},
 		},
 		{
@@ -80,7 +84,7 @@ func Test_getRepositoryBatch(t *testing.T) {
 			},
 			setup: func(store *mockdb.MockStore, in input) {
 				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByEntityID(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityAndProfile(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
 				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
 			},
 		},
@@ -98,7 +102,7 @@ func Test_getRepositoryBatch(t *testing.T) {
 			},
 			setup: func(store *mockdb.MockStore, in input) {
 				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByEntityID(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityAndProfile(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
 				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 		},
@@ -116,7 +120,7 @@ func Test_getRepositoryBatch(t *testing.T) {
 			},
 			setup: func(store *mockdb.MockStore, in input) {
 				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
-				store.EXPECT().ListOldestRuleEvaluationsByEntityID(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityAndProfile(gomock.Any(), gomock.Any()).Return(getStandardOldestRuleEvals(t, in.repos), nil)
 				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(false, sql.ErrConnDone)
 			},
 		},
@@ -137,7 +141,7 @@ func Test_getRepositoryBatch(t *testing.T) {
 				store.EXPECT().ListEntitiesAfterID(gomock.Any(), gomock.Any()).Return(in.repos, nil)
 				oldestRuleEvals := getStandardOldestRuleEvals(t, in.repos)
 				oldestRuleEvals[2].OldestLastUpdated = time.Now().Add(-time.Second)
-				store.EXPECT().ListOldestRuleEvaluationsByEntityID(gomock.Any(), gomock.Any()).Return(oldestRuleEvals, nil)
+				store.EXPECT().ListOldestRuleEvaluationsByEntityAndProfile(gomock.Any(), gomock.Any()).Return(oldestRuleEvals, nil)
 				store.EXPECT().EntityExistsAfterID(gomock.Any(), gomock.Any()).Return(true, nil)
 			},
 		},
@@ -158,18 +162,170 @@ func Test_getRepositoryBatch(t *testing.T) {
 
 			r := createTestReminder(t, store, cfg)
 
-			got, _, err := r.getRepositoryBatch(context.Background())
+			got, err := r.getEntityBatch(context.Background(), db.EntitiesRepository, time.Now())
 			if test.err != "" {
 				require.ErrorContains(t, err, test.err)
 				return
 			}
 			require.NoError(t, err)
-			require.ElementsMatch(t, got, test.expectedOutput.repos)
-			require.Equal(t, test.expectedOutput.repoCursor, r.repositoryCursor)
+			gotRepos := make([]db.EntityInstance, 0, len(got))
+			for _, due := range got {
+				gotRepos = append(gotRepos, due.entity)
+			}
+			require.ElementsMatch(t, gotRepos, test.expectedOutput.repos)
+			require.Equal(t, test.expectedOutput.repoCursor, r.entityCursors[db.EntitiesRepository])
 		})
 	}
 }
 
+func Test_getDueEntities(t *testing.T) {
+	t.Parallel()
+
+	// 2026-01-02 is a Friday
+	now := time.Date(2026, time.January, 2, 12, 0, 0, 0, time.UTC)
+	entity := db.EntityInstance{ID: uuid.New(), EntityType: db.EntitiesArtifact}
+	hourly := uuid.New()
+	nightly := uuid.New()
+	unscheduled := uuid.New()
+
+	schedule := func(t *testing.T, s *minderv1.Profile_Schedule) pqtype.NullRawMessage {
+		t.Helper()
+		raw, err := protojson.Marshal(s)
+		require.NoError(t, err)
+		return pqtype.NullRawMessage{RawMessage: raw, Valid: true}
+	}
+
+	tests := []struct {
+		name     string
+		evals    func(t *testing.T) []db.ListOldestRuleEvaluationsByEntityAndProfileRow
+		expected []uuid.UUID
+	}{
+		{
+			name: "profiles are due according to their own interval",
+			evals: func(t *testing.T) []db.ListOldestRuleEvaluationsByEntityAndProfileRow {
+				t.Helper()
+				return []db.ListOldestRuleEvaluationsByEntityAndProfileRow{
+					{
+						EntityInstanceID:  entity.ID,
+						ProfileID:         hourly,
+						ProfileSchedule:   schedule(t, &minderv1.Profile_Schedule{Interval: "1h"}),
+						OldestLastUpdated: now.Add(-2 * time.Hour),
+					},
+					{
+						EntityInstanceID:  entity.ID,
+						ProfileID:         nightly,
+						ProfileSchedule:   schedule(t, &minderv1.Profile_Schedule{Interval: "24h"}),
+						OldestLastUpdated: now.Add(-2 * time.Hour),
+					},
+				}
+			},
+			expected: []uuid.UUID{hourly},
+		},
+		{
+			name: "profiles without schedule use the minimum elapsed time",
+			evals: func(_ *testing.T) []db.ListOldestRuleEvaluationsByEntityAndProfileRow {
+				return []db.ListOldestRuleEvaluationsByEntityAndProfileRow{
+					{
+						EntityInstanceID:  entity.ID,
+						ProfileID:         unscheduled,
+						OldestLastUpdated: now.Add(-31 * time.Minute),
+					},
+				}
+			},
+			expected: []uuid.UUID{unscheduled},
+		},
+		{
+			name: "profiles in a blackout window are not due",
+			evals: func(t *testing.T) []db.ListOldestRuleEvaluationsByEntityAndProfileRow {
+				t.Helper()
+				return []db.ListOldestRuleEvaluationsByEntityAndProfileRow{
+					{
+						EntityInstanceID: entity.ID,
+						ProfileID:        hourly,
+						ProfileSchedule: schedule(t, &minderv1.Profile_Schedule{
+							Interval: "1h",
+							BlackoutWindows: []*minderv1.Profile_BlackoutWindow{
+								{Start: "09:00", End: "17:00", Days: []string{"fri"}},
+							},
+						}),
+						OldestLastUpdated: now.Add(-2 * time.Hour),
+					},
+				}
+			},
+		},
+		{
+			name: "invalid schedules use the defaults",
+			evals: func(_ *testing.T) []db.ListOldestRuleEvaluationsByEntityAndProfileRow {
+				return []db.ListOldestRuleEvaluationsByEntityAndProfileRow{
+					{
+						EntityInstanceID:  entity.ID,
+						ProfileID:         unscheduled,
+						ProfileSchedule:   pqtype.NullRawMessage{RawMessage: []byte(`{"interval": 5}`), Valid: true},
+						OldestLastUpdated: now.Add(-time.Hour),
+					},
+				}
+			},
+			expected: []uuid.UUID{unscheduled},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().ListOldestRuleEvaluationsByEntityAndProfile(gomock.Any(), []uuid.UUID{entity.ID}).
+				Return(test.evals(t), nil)
+
+			r := createTestReminder(t, store, &reminderconfig.Config{
+				RecurrenceConfig: reminderconfig.RecurrenceConfig{MinElapsed: 30 * time.Minute},
+			})
+
+			got, err := r.getDueEntities(context.Background(), []db.EntityInstance{entity}, now)
+			require.NoError(t, err)
+
+			if len(test.expected) == 0 {
+				require.Empty(t, got)
+				return
+			}
+			require.Len(t, got, 1)
+			gotProfiles := make([]uuid.UUID, 0, len(got[0].profiles))
+			for _, prof := range got[0].profiles {
+				gotProfiles = append(gotProfiles, prof.id)
+			}
+			require.ElementsMatch(t, test.expected, gotProfiles)
+		})
+	}
+}
+
+func Test_createReminderMessages(t *testing.T) {
+	t.Parallel()
+
+	entity := db.EntityInstance{
+		ID:         uuid.New(),
+		EntityType: db.EntitiesPullRequest,
+		ProjectID:  uuid.New(),
+		ProviderID: uuid.New(),
+	}
+	profileIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	msgs, err := createReminderMessages(context.Background(), []dueEntity{{
+		entity:   entity,
+		profiles: []dueProfile{{id: profileIDs[0]}, {id: profileIDs[1]}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	evt, err := remindermessages.EntityReminderEventFromMessage(msgs[0])
+	require.NoError(t, err)
+	require.Equal(t, entity.ID, evt.EntityID)
+	require.Equal(t, entity.ProjectID, evt.Project)
+	require.Equal(t, entity.ProviderID, evt.ProviderID)
+	require.Equal(t, profileIDs, evt.ProfileIDs)
+}
+
 func generateUUIDFromNum(t *testing.T, num int) uuid.UUID {
 	t.Helper()
 
@@ -203,18 +359,20 @@ func createTestReminder(t *testing.T, store db.Store, config *reminderconfig.Con
 	t.Helper()
 
 	return &reminder{
-		store: store,
-		cfg:   config,
+		store:         store,
+		cfg:           config,
+		entityCursors: map[db.Entities]uuid.UUID{},
 	}
 }
 
-func getStandardOldestRuleEvals(t *testing.T, repos []db.EntityInstance) []db.ListOldestRuleEvaluationsByEntityIDRow {
+func getStandardOldestRuleEvals(t *testing.T, repos []db.EntityInstance) []db.ListOldestRuleEvaluationsByEntityAndProfileRow {
 	t.Helper()
 
-	oldestRuleEvals := make([]db.ListOldestRuleEvaluationsByEntityIDRow, 0, len(repos))
+	oldestRuleEvals := make([]db.ListOldestRuleEvaluationsByEntityAndProfileRow, 0, len(repos))
 	for _, repo := range repos {
-		oldestRuleEvals = append(oldestRuleEvals, db.ListOldestRuleEvaluationsByEntityIDRow{
+		oldestRuleEvals = append(oldestRuleEvals, db.ListOldestRuleEvaluationsByEntityAndProfileRow{
 			EntityInstanceID:  repo.ID,
+			ProfileID:         generateUUIDFromNum(t, 100),
 			OldestLastUpdated: time.Now().Add(-time.Hour),
 		})
 	}
@@ -39,7 +39,8 @@ func (rp *ReminderProcessor) reminderMessageHandler(msg *message.Message) error
 
 	log.Info().Msgf("Received reminder event: %v", evt)
 
-	repoReconcileMsg, err := reconcilermessages.NewRepoReconcilerMessage(evt.ProviderID, evt.EntityID, evt.Project)
+	repoReconcileMsg, err := reconcilermessages.NewRepoReconcilerMessage(
+		evt.ProviderID, evt.EntityID, evt.Project, evt.ProfileIDs...)
 	if err != nil {
 		return fmt.Errorf("error creating repo reconcile event: %w", err)
 	}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"time"
+)
+
+// CSVContentType is the MIME type of CSV reports
+const CSVContentType = "text/csv"
+
+var csvHeader = []string{
+	"profile",
+	"rule_name",
+	"rule_type",
+	"severity",
+	"entity_type",
+	"entity_name",
+	"entity_id",
+	"status",
+	"details",
+	"alert_status",
+	"alert_url",
+	"remediation_status",
+	"remediation_url",
+	"guidance",
+	"last_updated",
+}
+
+// ToCSV renders the report as CSV, with one row per finding.
+func ToCSV(r *Report) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	for _, f := range r.Findings {
+		err := w.Write(csvSafe(
+			f.Profile,
+			f.RuleName,
+			f.RuleType,
+			f.Severity,
+			f.EntityType,
+			f.EntityName,
+			f.EntityID,
+			f.Status,
+			f.Details,
+			f.AlertStatus,
+			f.AlertURL,
+			f.RemediationStatus,
+			f.RemediationURL,
+			f.Guidance,
+			f.LastUpdated.UTC().Format(time.RFC3339),
+		))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// csvSafe escapes the cells which spreadsheets would interpret as
+// formulas. Details may contain text coming from the evaluated entities,
+// which must not be executed when the report is opened.
+func csvSafe(cells ...string) []string {
+	for i, cell := range cells {
+		if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+			cells[i] = "'" + cell
+		}
+	}
+	return cells
+}
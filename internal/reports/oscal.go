@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// OSCALContentType is the MIME type of OSCAL reports
+	OSCALContentType = "application/oscal.ar+json"
+
+	oscalVersion = "1.1.2"
+	// oscalNamespace qualifies the properties which are not defined by OSCAL
+	oscalNamespace = minderURI + "/ns/oscal"
+)
+
+// oscalUUIDNamespace is used to derive stable UUIDs from evaluation IDs,
+// so that the same evaluation gets the same UUIDs across reports.
+var oscalUUIDNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte(oscalNamespace))
+
+type oscalDocument struct {
+	AssessmentResults oscalAssessmentResults `json:"assessment-results"`
+}
+
+type oscalAssessmentResults struct {
+	UUID     string        `json:"uuid"`
+	Metadata oscalMetadata `json:"metadata"`
+	ImportAP oscalImportAP `json:"import-ap"`
+	Results  []oscalResult `json:"results"`
+}
+
+type oscalMetadata struct {
+	Title        string `json:"title"`
+	LastModified string `json:"last-modified"`
+	Version      string `json:"version"`
+	OSCALVersion string `json:"oscal-version"`
+}
+
+type oscalImportAP struct {
+	Href string `json:"href"`
+}
+
+type oscalResult struct {
+	UUID             string                `json:"uuid"`
+	Title            string                `json:"title"`
+	Description      string                `json:"description"`
+	Start            string                `json:"start"`
+	ReviewedControls oscalReviewedControls `json:"reviewed-controls"`
+	Observations     []oscalObservation    `json:"observations,omitempty"`
+	Findings         []oscalFinding        `json:"findings,omitempty"`
+}
+
+type oscalReviewedControls struct {
+	ControlSelections []oscalControlSelection `json:"control-selections"`
+}
+
+type oscalControlSelection struct {
+	IncludeAll struct{} `json:"include-all"`
+}
+
+type oscalObservation struct {
+	UUID        string         `json:"uuid"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Props       []oscalProp    `json:"props,omitempty"`
+	Methods     []string       `json:"methods"`
+	Subjects    []oscalSubject `json:"subjects"`
+	Collected   string         `json:"collected"`
+	Remarks     string         `json:"remarks,omitempty"`
+}
+
+type oscalSubject struct {
+	SubjectUUID string `json:"subject-uuid"`
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+}
+
+type oscalProp struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	NS    string `json:"ns"`
+}
+
+type oscalFinding struct {
+	UUID                string                    `json:"uuid"`
+	Title               string                    `json:"title"`
+	Description         string                    `json:"description"`
+	Target              oscalFindingTarget        `json:"target"`
+	RelatedObservations []oscalRelatedObservation `json:"related-observations"`
+}
+
+type oscalFindingTarget struct {
+	Type     string             `json:"type"`
+	TargetID string             `json:"target-id"`
+	Status   oscalFindingStatus `json:"status"`
+}
+
+type oscalFindingStatus struct {
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type oscalRelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
+
+// ToOSCAL renders the report as an OSCAL assessment results document.
+// Every evaluation is recorded as an observation; the evaluations which
+// reached a verdict are also recorded as findings against the rule.
+//
+// The document does not refer to an actual assessment plan, since Minder
+// profiles are not modelled in OSCAL.
+func ToOSCAL(r *Report) ([]byte, error) {
+	generatedAt := r.GeneratedAt.UTC().Format(time.RFC3339)
+
+	result := oscalResult{
+		UUID:        uuid.NewString(),
+		Title:       "Minder rule evaluations",
+		Description: fmt.Sprintf("Latest rule evaluations of project %s", r.ProjectID),
+		Start:       generatedAt,
+		ReviewedControls: oscalReviewedControls{
+			ControlSelections: []oscalControlSelection{{}},
+		},
+	}
+
+	for _, f := range r.Findings {
+		obsUUID := uuid.NewSHA1(oscalUUIDNamespace, []byte("observation/"+f.EvaluationID)).String()
+
+		result.Observations = append(result.Observations, oscalObservation{
+			UUID:        obsUUID,
+			Title:       fmt.Sprintf("%s on %s", f.displayName(), f.EntityName),
+			Description: oscalDescription(&f),
+			Props: []oscalProp{
+				{Name: "profile", Value: f.Profile, NS: oscalNamespace},
+				{Name: "rule-name", Value: f.RuleName, NS: oscalNamespace},
+				{Name: "rule-type", Value: f.RuleType, NS: oscalNamespace},
+				{Name: "severity", Value: f.Severity, NS: oscalNamespace},
+				{Name: "status", Value: f.Status, NS: oscalNamespace},
+				{Name: "alert-status", Value: f.AlertStatus, NS: oscalNamespace},
+				{Name: "remediation-status", Value: f.RemediationStatus, NS: oscalNamespace},
+			},
+			Methods: []string{"TEST"},
+			Subjects: []oscalSubject{{
+				SubjectUUID: f.EntityID,
+				Type:        "resource",
+				Title:       fmt.Sprintf("%s %s", f.EntityType, f.EntityName),
+			}},
+			Collected: f.LastUpdated.UTC().Format(time.RFC3339),
+			Remarks:   f.Guidance,
+		})
+
+		state, reason, ok := oscalState(f.Status)
+		if !ok {
+			continue
+		}
+		result.Findings = append(result.Findings, oscalFinding{
+			UUID:        uuid.NewSHA1(oscalUUIDNamespace, []byte("finding/"+f.EvaluationID)).String(),
+			Title:       fmt.Sprintf("%s on %s", f.displayName(), f.EntityName),
+			Description: oscalDescription(&f),
+			Target: oscalFindingTarget{
+				Type:     "objective-id",
+				TargetID: f.RuleName,
+				Status:   oscalFindingStatus{State: state, Reason: reason},
+			},
+			RelatedObservations: []oscalRelatedObservation{{ObservationUUID: obsUUID}},
+		})
+	}
+
+	return json.MarshalIndent(&oscalDocument{
+		AssessmentResults: oscalAssessmentResults{
+			UUID: uuid.NewString(),
+			Metadata: oscalMetadata{
+				Title:        fmt.Sprintf("Minder compliance report for project %s", r.ProjectID),
+				LastModified: generatedAt,
+				Version:      generatedAt,
+				OSCALVersion: oscalVersion,
+			},
+			ImportAP: oscalImportAP{Href: "#"},
+			Results:  []oscalResult{result},
+		},
+	}, "", "  ")
+}
+
+// oscalState returns the finding state of an evaluation status, and false
+// for the statuses which didn't reach a verdict.
+func oscalState(status string) (state string, reason string, ok bool) {
+	switch status {
+	case statusSuccess:
+		return "satisfied", "pass", true
+	case statusFailure:
+		return "not-satisfied", "fail", true
+	case statusError:
+		return "not-satisfied", "other", true
+	default:
+		return "", "", false
+	}
+}
+
+func oscalDescription(f *Finding) string {
+	if f.Details != "" {
+		return f.Details
+	}
+	return fmt.Sprintf("The rule evaluation status is %s", f.Status)
+}
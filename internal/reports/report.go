@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reports renders compliance reports out of the latest rule
+// evaluations of a project.
+package reports
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+const (
+	// minderURI identifies Minder in the reports
+	minderURI = "https://mindersec.github.io"
+	// toolName is the name of the tool producing the reports
+	toolName = "Minder"
+)
+
+// Evaluation statuses, as stored in the database
+const (
+	statusSuccess = "success"
+	statusFailure = "failure"
+	statusError   = "error"
+	statusSkipped = "skipped"
+	statusWaived  = "waived"
+)
+
+// Report is a point-in-time snapshot of the latest rule evaluations
+// of a project.
+type Report struct {
+	ProjectID   string
+	GeneratedAt time.Time
+	Findings    []Finding
+}
+
+// Finding is the latest evaluation of a rule against an entity.
+type Finding struct {
+	EvaluationID string
+	Profile      string
+	// RuleName is the name of the rule in the profile
+	RuleName string
+	RuleType string
+	// RuleDisplayName is the human-readable name of the rule type
+	RuleDisplayName string
+	// Severity is one of unknown, info, low, medium, high or critical
+	Severity   string
+	EntityType string
+	EntityID   string
+	EntityName string
+	// Status is one of success, failure, error, skipped, waived or pending
+	Status            string
+	Details           string
+	Guidance          string
+	AlertStatus       string
+	AlertURL          string
+	RemediationStatus string
+	RemediationURL    string
+	LastUpdated       time.Time
+}
+
+// Sort sorts the findings by profile, entity and rule, so that
+// reports are stable.
+func (r *Report) Sort() {
+	slices.SortFunc(r.Findings, func(a, b Finding) int {
+		return cmp.Or(
+			cmp.Compare(a.Profile, b.Profile),
+			cmp.Compare(a.EntityType, b.EntityType),
+			cmp.Compare(a.EntityName, b.EntityName),
+			cmp.Compare(a.RuleName, b.RuleName),
+		)
+	})
+}
+
+func (f *Finding) displayName() string {
+	return cmp.Or(f.RuleDisplayName, f.RuleType)
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() *Report {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &Report{
+		ProjectID:   "4c0a9ab5-7b2a-4a8c-8f6a-5b6d1f2c3d4e",
+		GeneratedAt: ts,
+		Findings: []Finding{
+			{
+				EvaluationID:      "8e6a8a4e-1f6f-4b8e-9d7e-1a2b3c4d5e60",
+				Profile:           "security",
+				RuleName:          "branch_protection",
+				RuleType:          "branch_protection_enabled",
+				RuleDisplayName:   "Branch protection is enabled",
+				Severity:          "high",
+				EntityType:        "repository",
+				EntityID:          "0b1c2d3e-4f50-4617-8293-a4b5c6d7e8f9",
+				EntityName:        "mindersec/minder",
+				Status:            "success",
+				AlertStatus:       "off",
+				RemediationStatus: "skipped",
+				LastUpdated:       ts,
+			},
+			{
+				EvaluationID:      "8e6a8a4e-1f6f-4b8e-9d7e-1a2b3c4d5e61",
+				Profile:           "security",
+				RuleName:          "branch_protection",
+				RuleType:          "branch_protection_enabled",
+				RuleDisplayName:   "Branch protection is enabled",
+				Severity:          "high",
+				EntityType:        "repository",
+				EntityID:          "0b1c2d3e-4f50-4617-8293-a4b5c6d7e8fa",
+				EntityName:        "mindersec/other",
+				Status:            "failure",
+				Details:           "=HYPERLINK(\"https://evil.example\")",
+				Guidance:          "Enable branch protection",
+				AlertStatus:       "on",
+				AlertURL:          "https://github.com/mindersec/other/security/advisories/GHSA-1",
+				RemediationStatus: "failure",
+				LastUpdated:       ts,
+			},
+			{
+				EvaluationID:      "8e6a8a4e-1f6f-4b8e-9d7e-1a2b3c4d5e62",
+				Profile:           "security",
+				RuleName:          "artifact_signature",
+				RuleType:          "artifact_signature",
+				Severity:          "medium",
+				EntityType:        "artifact",
+				EntityID:          "0b1c2d3e-4f50-4617-8293-a4b5c6d7e8fb",
+				EntityName:        "mindersec/minder/server",
+				Status:            "skipped",
+				AlertStatus:       "skipped",
+				RemediationStatus: "skipped",
+				LastUpdated:       ts,
+			},
+		},
+	}
+}
+
+func TestToCSV(t *testing.T) {
+	t.Parallel()
+
+	out, err := ToCSV(testReport())
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, csvHeader, rows[0])
+	assert.Equal(t, "mindersec/other", rows[2][5])
+	assert.Equal(t, "failure", rows[2][7])
+	// Formulas are escaped
+	assert.Equal(t, "'=HYPERLINK(\"https://evil.example\")", rows[2][8])
+	assert.Equal(t, "2026-01-02T03:04:05Z", rows[2][14])
+}
+
+func TestToSARIF(t *testing.T) {
+	t.Parallel()
+
+	out, err := ToSARIF(testReport())
+	require.NoError(t, err)
+
+	log := &sarifLog{}
+	require.NoError(t, json.Unmarshal(out, log))
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+
+	run := log.Runs[0]
+	// Rule types are deduplicated
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	rule := run.Tool.Driver.Rules[0]
+	assert.Equal(t, "branch_protection_enabled", rule.ID)
+	assert.Equal(t, "error", rule.DefaultConfiguration.Level)
+	assert.Equal(t, "8.0", rule.Properties["security-severity"])
+	// Guidance comes from the failing evaluation
+	require.NotNil(t, rule.Help)
+	assert.Equal(t, "Enable branch protection", rule.Help.Text)
+
+	require.Len(t, run.Results, 3)
+	assert.Equal(t, "pass", run.Results[0].Kind)
+	assert.Equal(t, "fail", run.Results[1].Kind)
+	assert.Equal(t, "error", run.Results[1].Level)
+	assert.Equal(t, "mindersec/other", run.Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "notApplicable", run.Results[2].Kind)
+	assert.Equal(t, 1, run.Results[2].RuleIndex)
+	assert.NotEqual(t,
+		run.Results[0].PartialFingerprints[sarifFingerprint],
+		run.Results[1].PartialFingerprints[sarifFingerprint])
+	assert.Empty(t, run.Results[1].Suppressions)
+}
+
+func TestToSARIFWaived(t *testing.T) {
+	t.Parallel()
+
+	r := testReport()
+	r.Findings[1].Status = "waived"
+	r.Findings[1].Details = "waived until 2026-12-31: migration tracked in TICKET-1"
+
+	out, err := ToSARIF(r)
+	require.NoError(t, err)
+
+	log := &sarifLog{}
+	require.NoError(t, json.Unmarshal(out, log))
+
+	res := log.Runs[0].Results[1]
+	assert.Equal(t, "open", res.Kind)
+	require.Len(t, res.Suppressions, 1)
+	assert.Equal(t, "external", res.Suppressions[0].Kind)
+	assert.Equal(t, r.Findings[1].Details, res.Suppressions[0].Justification)
+}
+
+func TestToOSCAL(t *testing.T) {
+	t.Parallel()
+
+	out, err := ToOSCAL(testReport())
+	require.NoError(t, err)
+
+	doc := &oscalDocument{}
+	require.NoError(t, json.Unmarshal(out, doc))
+	assert.Equal(t, oscalVersion, doc.AssessmentResults.Metadata.OSCALVersion)
+	require.Len(t, doc.AssessmentResults.Results, 1)
+
+	result := doc.AssessmentResults.Results[0]
+	require.Len(t, result.Observations, 3)
+	// Skipped evaluations are not findings
+	require.Len(t, result.Findings, 2)
+	assert.Equal(t, "satisfied", result.Findings[0].Target.Status.State)
+	assert.Equal(t, "not-satisfied", result.Findings[1].Target.Status.State)
+	assert.Equal(t, result.Observations[1].UUID, result.Findings[1].RelatedObservations[0].ObservationUUID)
+
+	// Observation UUIDs are stable across reports
+	again, err := ToOSCAL(testReport())
+	require.NoError(t, err)
+	doc2 := &oscalDocument{}
+	require.NoError(t, json.Unmarshal(again, doc2))
+	assert.Equal(t, result.Observations[0].UUID, doc2.AssessmentResults.Results[0].Observations[0].UUID)
+}
+
+func TestReportSort(t *testing.T) {
+	t.Parallel()
+
+	r := testReport()
+	r.Sort()
+	assert.Equal(t, "artifact", r.Findings[0].EntityType)
+	assert.Equal(t, "mindersec/minder", r.Findings[1].EntityName)
+	assert.Equal(t, "mindersec/other", r.Findings[2].EntityName)
+}
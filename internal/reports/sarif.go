@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// SARIFContentType is the MIME type of SARIF reports
+	SARIFContentType = "application/sarif+json"
+
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	// sarifFingerprint is the key of the fingerprint used by code scanning
+	// tools to track a result across reports
+	sarifFingerprint = "minderFinding/v1"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string            `json:"id"`
+	Name                 string            `json:"name,omitempty"`
+	ShortDescription     sarifMessage      `json:"shortDescription"`
+	Help                 *sarifHelp        `json:"help,omitempty"`
+	DefaultConfiguration sarifRuleConfig   `json:"defaultConfiguration"`
+	Properties           map[string]string `json:"properties,omitempty"`
+}
+
+type sarifHelp struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown"`
+}
+
+type sarifRuleConfig struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string             `json:"ruleId"`
+	RuleIndex           int                `json:"ruleIndex"`
+	Kind                string             `json:"kind"`
+	Level               string             `json:"level"`
+	Message             sarifMessage       `json:"message"`
+	Locations           []sarifLocation    `json:"locations"`
+	PartialFingerprints map[string]string  `json:"partialFingerprints"`
+	Suppressions        []sarifSuppression `json:"suppressions,omitempty"`
+	Properties          map[string]string  `json:"properties,omitempty"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// ToSARIF renders the report as a SARIF 2.1.0 log. Rule types are
+// reported as SARIF rules, and every finding as a result. Passing
+// evaluations are kept, with the `pass` kind, so that the log is a
+// complete snapshot.
+func ToSARIF(r *Report) ([]byte, error) {
+	driver := sarifDriver{
+		Name:           toolName,
+		InformationURI: minderURI,
+		Rules:          []sarifRule{},
+	}
+	ruleIndex := map[string]int{}
+
+	results := make([]sarifResult, 0, len(r.Findings))
+	for _, f := range r.Findings {
+		idx, ok := ruleIndex[f.RuleType]
+		if !ok {
+			idx = len(driver.Rules)
+			ruleIndex[f.RuleType] = idx
+			driver.Rules = append(driver.Rules, sarifRuleFromFinding(&f))
+		} else if driver.Rules[idx].Help == nil && f.Guidance != "" {
+			// Guidance is only set on failing evaluations
+			driver.Rules[idx].Help = &sarifHelp{Text: f.Guidance, Markdown: f.Guidance}
+		}
+
+		kind, level := sarifKindAndLevel(&f)
+		var suppressions []sarifSuppression
+		if f.Status == statusWaived {
+			// Exemptions are managed in Minder, not in the source
+			suppressions = []sarifSuppression{{Kind: "external", Justification: f.Details}}
+		}
+		results = append(results, sarifResult{
+			RuleID:    f.RuleType,
+			RuleIndex: idx,
+			Kind:      kind,
+			Level:     level,
+			Message:   sarifMessage{Text: sarifMessageText(&f)},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: f.EntityName},
+				},
+				LogicalLocations: []sarifLogicalLocation{{
+					FullyQualifiedName: f.EntityName,
+					Kind:               f.EntityType,
+				}},
+			}},
+			PartialFingerprints: map[string]string{
+				sarifFingerprint: fingerprint(f.Profile, f.RuleName, f.EntityID),
+			},
+			Suppressions: suppressions,
+			Properties: map[string]string{
+				"profile":           f.Profile,
+				"ruleName":          f.RuleName,
+				"status":            f.Status,
+				"alertStatus":       f.AlertStatus,
+				"alertUrl":          f.AlertURL,
+				"remediationStatus": f.RemediationStatus,
+				"remediationUrl":    f.RemediationURL,
+			},
+		})
+	}
+
+	return json.MarshalIndent(&sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: driver},
+			Results: results,
+		}},
+	}, "", "  ")
+}
+
+func sarifRuleFromFinding(f *Finding) sarifRule {
+	rule := sarifRule{
+		ID:                   f.RuleType,
+		Name:                 f.displayName(),
+		ShortDescription:     sarifMessage{Text: f.displayName()},
+		DefaultConfiguration: sarifRuleConfig{Level: severityToSARIFLevel(f.Severity)},
+		Properties:           map[string]string{"severity": f.Severity},
+	}
+	// GitHub code scanning uses this property to rank security results
+	if score, ok := securitySeverityScores[f.Severity]; ok {
+		rule.Properties["security-severity"] = score
+	}
+	if f.Guidance != "" {
+		rule.Help = &sarifHelp{Text: f.Guidance, Markdown: f.Guidance}
+	}
+	return rule
+}
+
+// securitySeverityScores maps the rule type severities to the CVSS-like
+// scores expected by code scanning tools.
+var securitySeverityScores = map[string]string{
+	"critical": "9.5",
+	"high":     "8.0",
+	"medium":   "5.5",
+	"low":      "2.0",
+}
+
+func severityToSARIFLevel(severity string) string {
+	switch severity {
+	case "critical", "high":
+		return "error"
+	case "medium":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func sarifKindAndLevel(f *Finding) (string, string) {
+	switch f.Status {
+	case statusSuccess:
+		return "pass", "none"
+	case statusFailure:
+		return "fail", severityToSARIFLevel(f.Severity)
+	case statusError:
+		// The rule could not be evaluated, so a human has to check it
+		return "review", "warning"
+	case statusSkipped:
+		return "notApplicable", "none"
+	case statusWaived:
+		// The rule was not evaluated, the result is suppressed instead
+		return "open", "none"
+	default:
+		return "open", "none"
+	}
+}
+
+func sarifMessageText(f *Finding) string {
+	if f.Details != "" {
+		return f.Details
+	}
+	return fmt.Sprintf("%s: %s", f.displayName(), f.Status)
+}
+
+func fingerprint(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		// Separate the parts so that they can't be confused
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
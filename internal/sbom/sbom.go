@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sbom reads, builds and renders standards-compliant SBOM documents
+// (CycloneDX and SPDX) on top of the protobom data model.
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/protobom/protobom/pkg/formats"
+	"github.com/protobom/protobom/pkg/reader"
+	protobom "github.com/protobom/protobom/pkg/sbom"
+	"github.com/protobom/protobom/pkg/writer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Format is the family of SBOM document presented to rules
+type Format string
+
+const (
+	// FormatCycloneDX renders documents as CycloneDX 1.5 JSON
+	FormatCycloneDX Format = "cyclonedx"
+	// FormatSPDX renders documents as SPDX 2.3 JSON
+	FormatSPDX Format = "spdx"
+)
+
+// ErrUnsupportedFormat is returned when an unknown output format is requested
+var ErrUnsupportedFormat = errors.New("unsupported SBOM format")
+
+// ParseFormat validates a user-supplied format, defaulting to CycloneDX
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case "", FormatCycloneDX:
+		return FormatCycloneDX, nil
+	case FormatSPDX:
+		return FormatSPDX, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, s)
+	}
+}
+
+func (f Format) serialization() formats.Format {
+	if f == FormatSPDX {
+		return formats.SPDX23JSON
+	}
+	return formats.CDX15JSON
+}
+
+// Document is an SBOM in protobom form. Documents which were read from
+// an existing JSON serialization keep it, so that rendering them back in
+// the same format doesn't lose anything protobom doesn't model.
+type Document struct {
+	bom *protobom.Document
+
+	raw       []byte
+	rawFormat formats.Format
+}
+
+// Read parses a CycloneDX or SPDX document, detecting its format
+func Read(data []byte) (*Document, error) {
+	bom, err := reader.New().ParseStream(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SBOM: %w", err)
+	}
+
+	doc := &Document{bom: bom}
+	if src := bom.GetMetadata().GetSourceData(); src != nil {
+		doc.raw = data
+		doc.rawFormat = formats.Format(src.GetFormat())
+	}
+	return doc, nil
+}
+
+// FromNodeList builds a document describing a software component (for
+// example, a repository at a given commit) which depends on every node in
+// the list. This is the shape dependency extractors produce, which has
+// nodes but no root element or edges.
+func FromNodeList(name, version string, nodes *protobom.NodeList) *Document {
+	bom := protobom.NewDocument()
+	bom.Metadata.Name = name
+	bom.Metadata.Date = timestamppb.Now()
+	bom.Metadata.Tools = append(bom.Metadata.Tools, &protobom.Tool{Name: "minder"})
+
+	root := &protobom.Node{
+		Id:             protobom.NewNodeIdentifier(),
+		Type:           protobom.Node_PACKAGE,
+		Name:           name,
+		Version:        version,
+		PrimaryPurpose: []protobom.Purpose{protobom.Purpose_APPLICATION},
+	}
+	bom.NodeList.AddRootNode(root)
+
+	edge := &protobom.Edge{Type: protobom.Edge_dependsOn, From: root.Id}
+	for _, node := range nodes.GetNodes() {
+		bom.NodeList.AddNode(node)
+		edge.To = append(edge.To, node.GetId())
+	}
+	if len(edge.To) > 0 {
+		bom.NodeList.AddEdge(edge)
+	}
+
+	return &Document{bom: bom}
+}
+
+// Protobom returns the document in protobom form
+func (d *Document) Protobom() *protobom.Document {
+	return d.bom
+}
+
+// Render returns the JSON serialization of the document in the requested
+// format, decoded into generic values suitable for rule evaluation.
+func (d *Document) Render(format Format) (map[string]any, error) {
+	data := d.raw
+	if data == nil || d.rawFormat.Type() != string(format) || d.rawFormat.Encoding() != formats.JSON {
+		var buf bytes.Buffer
+		w := writer.New(writer.WithFormat(format.serialization()))
+		if err := w.WriteStream(d.bom, &buf); err != nil {
+			return nil, fmt.Errorf("error rendering SBOM as %s: %w", format, err)
+		}
+		data = buf.Bytes()
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("error decoding %s SBOM: %w", format, err)
+	}
+	return out, nil
+}
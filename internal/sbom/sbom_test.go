@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sbom
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	protobom "github.com/protobom/protobom/pkg/sbom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		in      string
+		want    Format
+		wantErr bool
+	}{
+		{in: "", want: FormatCycloneDX},
+		{in: "cyclonedx", want: FormatCycloneDX},
+		{in: "spdx", want: FormatSPDX},
+		{in: "swid", wantErr: true},
+	} {
+		got, err := ParseFormat(tc.in)
+		if tc.wantErr {
+			assert.ErrorIs(t, err, ErrUnsupportedFormat)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got)
+	}
+}
+
+func TestReadAndRender(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		file   string
+		format Format
+		check  func(t *testing.T, doc map[string]any)
+	}{{
+		name:   "cyclonedx as cyclonedx is passed through",
+		file:   "cyclonedx.json",
+		format: FormatCycloneDX,
+		check: func(t *testing.T, doc map[string]any) {
+			t.Helper()
+			assert.Equal(t, "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79", doc["serialNumber"])
+			component := doc["components"].([]any)[0].(map[string]any)
+			assert.Equal(t, "Google LLC", component["supplier"].(map[string]any)["name"])
+		},
+	}, {
+		name:   "cyclonedx as spdx",
+		file:   "cyclonedx.json",
+		format: FormatSPDX,
+		check: func(t *testing.T, doc map[string]any) {
+			t.Helper()
+			assert.Equal(t, "SPDX-2.3", doc["spdxVersion"])
+			assert.Contains(t, packageNames(doc["packages"]), "github.com/google/uuid")
+		},
+	}, {
+		name:   "spdx as cyclonedx",
+		file:   "spdx.json",
+		format: FormatCycloneDX,
+		check: func(t *testing.T, doc map[string]any) {
+			t.Helper()
+			assert.Equal(t, "CycloneDX", doc["bomFormat"])
+			assert.Contains(t, packageNames(doc["components"]), "github.com/google/uuid")
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join("testdata", tc.file))
+			require.NoError(t, err)
+
+			doc, err := Read(data)
+			require.NoError(t, err)
+
+			rendered, err := doc.Render(tc.format)
+			require.NoError(t, err)
+			tc.check(t, rendered)
+		})
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := Read([]byte(`{"hello": "world"}`))
+	require.Error(t, err)
+}
+
+func TestFromNodeList(t *testing.T) {
+	t.Parallel()
+
+	nodes := protobom.NewNodeList()
+	nodes.AddNode(&protobom.Node{
+		Id:      "dep-1",
+		Type:    protobom.Node_PACKAGE,
+		Name:    "requests",
+		Version: "2.32.3",
+		Identifiers: map[int32]string{
+			int32(protobom.SoftwareIdentifierType_PURL): "pkg:pypi/requests@2.32.3",
+		},
+	})
+
+	doc := FromNodeList("stacklok/demo", "abc123", nodes)
+
+	bom := doc.Protobom()
+	require.Len(t, bom.GetNodeList().GetRootElements(), 1)
+	require.Len(t, bom.GetNodeList().GetEdges(), 1)
+	assert.Equal(t, []string{"dep-1"}, bom.GetNodeList().GetEdges()[0].GetTo())
+
+	cdx, err := doc.Render(FormatCycloneDX)
+	require.NoError(t, err)
+	metadata := cdx["metadata"].(map[string]any)
+	assert.Equal(t, "stacklok/demo", metadata["component"].(map[string]any)["name"])
+	assert.Equal(t, []string{"requests"}, packageNames(cdx["components"]))
+
+	spdx, err := doc.Render(FormatSPDX)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"stacklok/demo", "requests"}, packageNames(spdx["packages"]))
+}
+
+func packageNames(list any) []string {
+	var names []string
+	items, _ := list.([]any)
+	for _, item := range items {
+		if pkg, ok := item.(map[string]any); ok {
+			names = append(names, pkg["name"].(string))
+		}
+	}
+	return names
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/open-feature/go-sdk/openfeature"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/mindersec/minder/internal/alertwebhooks"
 	"github.com/mindersec/minder/internal/auth"
 	"github.com/mindersec/minder/internal/auth/jwt"
 	"github.com/mindersec/minder/internal/authz"
@@ -30,13 +31,17 @@ import (
 	propService "github.com/mindersec/minder/internal/entities/properties/service"
 	entityService "github.com/mindersec/minder/internal/entities/service"
 	"github.com/mindersec/minder/internal/entities/service/validators"
+	"github.com/mindersec/minder/internal/exemptions"
+	"github.com/mindersec/minder/internal/gitsync"
 	"github.com/mindersec/minder/internal/history"
 	"github.com/mindersec/minder/internal/invites"
 	"github.com/mindersec/minder/internal/marketplaces"
 	"github.com/mindersec/minder/internal/metrics/meters"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers"
+	bitbucketmanager "github.com/mindersec/minder/internal/providers/bitbucket/manager"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
+	giteamanager "github.com/mindersec/minder/internal/providers/gitea/manager"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/github/installations"
@@ -101,7 +106,7 @@ func AllInOneServerService(
 	profileSvc := profiles.NewProfileService(evt, selChecker)
 	ruleSvc := ruletypes.NewRuleTypeService(featureFlagClient)
 	roleScv := roles.NewRoleService()
-	dataSourcesSvc := datasourcessvc.NewDataSourceService(store)
+	dataSourcesSvc := datasourcessvc.NewDataSourceService(store, cryptoEngine)
 	marketplace, err := marketplaces.NewMarketplaceFromServiceConfig(cfg.Marketplace, profileSvc, ruleSvc, dataSourcesSvc)
 	if err != nil {
 		return fmt.Errorf("failed to create marketplace: %w", err)
@@ -163,6 +168,38 @@ func AllInOneServerService(
 		provmans = append(provmans, gitlabProviderManager)
 	}
 
+	if flags.Bool(ctx, featureFlagClient, flags.BitbucketProvider) {
+		bitbucketProviderManager, err := bitbucketmanager.NewBitbucketProviderClassManager(
+			ctx,
+			cryptoEngine,
+			store,
+			evt,
+			cfg.Provider.Bitbucket,
+			cfg.WebhookConfig,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create bitbucket provider manager: %w", err)
+		}
+
+		provmans = append(provmans, bitbucketProviderManager)
+	}
+
+	if flags.Bool(ctx, featureFlagClient, flags.GiteaProvider) {
+		giteaProviderManager, err := giteamanager.NewGiteaProviderClassManager(
+			ctx,
+			cryptoEngine,
+			store,
+			evt,
+			cfg.Provider.Gitea,
+			cfg.WebhookConfig,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create gitea provider manager: %w", err)
+		}
+
+		provmans = append(provmans, giteaProviderManager)
+	}
+
 	providerManager, closer, err := manager.NewProviderManager(ctx, providerStore,
 		provmans...)
 	if err != nil {
@@ -190,10 +227,15 @@ func AllInOneServerService(
 	)
 
 	historySvc := history.NewEvaluationHistoryService(providerManager)
+	planner := engine.NewPlanner(store, providerManager, featureFlagClient, selChecker, propSvc, cryptoEngine)
 	repos := repositories.NewRepositoryService(store, propSvc, evt, providerManager, entityCreator)
 	projectDeleter := projects.NewProjectDeleter(authzClient, providerManager)
 	sessionsService := session.NewProviderSessionService(providerManager, providerStore, store)
 	entSvc := entityService.NewEntityService(store, propSvc, providerManager)
+	alertWebhooksSvc := alertwebhooks.NewAlertWebhookService(store, cryptoEngine)
+	exemptionSvc := exemptions.NewExemptionService(store)
+	projectSyncSvc := gitsync.NewSyncService(store, &cfg.ProjectSync, providerStore, providerManager,
+		profileSvc, ruleSvc, dataSourcesSvc)
 
 	s := controlplane.NewServer(
 		store,
@@ -210,8 +252,13 @@ func AllInOneServerService(
 		roleScv,
 		profileSvc,
 		historySvc,
+		planner,
 		ruleSvc,
 		dataSourcesSvc,
+		alertWebhooksSvc,
+		exemptionSvc,
+		projectSyncSvc,
+		marketplace,
 		ghProviders,
 		providerManager,
 		providerAuthManager,
@@ -260,6 +307,8 @@ func AllInOneServerService(
 		profileStore,
 		selEnv,
 		propSvc,
+		alertWebhooksSvc,
+		cryptoEngine,
 	)
 
 	handler := engine.NewExecutorEventHandler(
@@ -339,6 +388,12 @@ func AllInOneServerService(
 		return evt.Run(ctx)
 	})
 
+	if cfg.ProjectSync.Enabled {
+		errg.Go(func() error {
+			return projectSyncSvc.Run(ctx)
+		})
+	}
+
 	// Wait for event handlers to start running
 	<-evt.Running()
 
@@ -16,6 +16,7 @@ const (
 	errorStatus        = "error"
 	skippedStatus      = "skipped"
 	pendingStatus      = "pending"
+	waivedStatus       = "waived"
 	notAvailableStatus = "not_available"
 	onStatus           = "on"
 	offStatus          = "off"
@@ -64,6 +65,11 @@ var statuses = map[string]statusDisplay{
 		Text:     "Skipped",
 		Severity: 2,
 	},
+	"waived": {
+		Emoji:    "🎫",
+		Text:     "Waived",
+		Severity: 2,
+	},
 	"fail no fix": {
 		Emoji:    "⛔",
 		Text:     "Failed",
@@ -114,6 +120,8 @@ func GetStatusIcon(eval EvalStatus, emoji bool) layouts.ColoredColumn {
 		results = append(results, statuses["failed to evaluate"])
 	case skippedStatus:
 		results = append(results, statuses["skipped"])
+	case waivedStatus:
+		results = append(results, statuses["waived"])
 	case failureStatus:
 		switch eval.GetRemediationStatus() {
 		case successStatus:
@@ -98,6 +98,16 @@ func TestGetStatusIcon(t *testing.T) {
 			expectedColor:    layouts.ColorYellow,
 			expectedSeverity: 2,
 		},
+		{
+			name: "rule eval waived",
+			evalStatus: &testEvalStatus{
+				status: waivedStatus,
+			},
+			expectedEmoji:    "🎫",
+			expectedText:     "Waived",
+			expectedColor:    layouts.ColorYellow,
+			expectedSeverity: 2,
+		},
 		{
 			name: "successfully remediated",
 			evalStatus: &testEvalStatus{
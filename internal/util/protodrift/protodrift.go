@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package protodrift compares resources declared outside of Minder, such as
+// in a git repository or a bundle, with the resources stored in a project.
+package protodrift
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Contains reports whether every field set in the declared resource has the
+// same value in the stored resource. Fields only set in the stored resource,
+// such as defaults filled in by the server, are not drift. The top-level
+// fields named in ignore are not compared.
+func Contains(want, have proto.Message, ignore ...protoreflect.Name) bool {
+	if len(ignore) > 0 {
+		want = proto.Clone(want)
+		ClearFields(want, ignore...)
+	}
+	return containsFields(want.ProtoReflect(), have.ProtoReflect())
+}
+
+func containsFields(want, have protoreflect.Message) bool {
+	equal := true
+	want.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !have.Has(fd) {
+			equal = false
+			return false
+		}
+		switch {
+		case fd.IsMap():
+			equal = equalField(want, have, fd)
+		case fd.IsList() && fd.Message() != nil:
+			equal = containsList(v.List(), have.Get(fd).List())
+		case fd.Message() != nil:
+			equal = containsFields(v.Message(), have.Get(fd).Message())
+		default:
+			equal = equalField(want, have, fd)
+		}
+		return equal
+	})
+	return equal
+}
+
+func containsList(want, have protoreflect.List) bool {
+	if want.Len() != have.Len() {
+		return false
+	}
+	for i := 0; i < want.Len(); i++ {
+		if !containsFields(want.Get(i).Message(), have.Get(i).Message()) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalField compares a single field of two messages of the same type
+func equalField(want, have protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	a := want.Type().New()
+	a.Set(fd, want.Get(fd))
+	b := want.Type().New()
+	b.Set(fd, have.Get(fd))
+	return proto.Equal(a.Interface(), b.Interface())
+}
+
+// ClearFields clears the named top-level fields of the message, if it has
+// them.
+func ClearFields(m proto.Message, names ...protoreflect.Name) {
+	msg := m.ProtoReflect()
+	for _, name := range names {
+		if fd := msg.Descriptor().Fields().ByName(name); fd != nil {
+			msg.Clear(fd)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protodrift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestContains(t *testing.T) {
+	t.Parallel()
+
+	ruleType := func(description string, ingest string) *minderv1.RuleType {
+		return &minderv1.RuleType{
+			Name:        "branch_protection",
+			Description: description,
+			Def: &minderv1.RuleType_Definition{
+				InEntity: "repository",
+				Ingest:   &minderv1.RuleType_Definition_Ingest{Type: ingest},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		want   *minderv1.RuleType
+		have   *minderv1.RuleType
+		ignore []protoreflect.Name
+		ok     bool
+	}{
+		{
+			name: "equal",
+			want: ruleType("d", "git"),
+			have: ruleType("d", "git"),
+			ok:   true,
+		},
+		{
+			name: "fields only set in the project",
+			want: ruleType("d", "git"),
+			have: func() *minderv1.RuleType {
+				rt := ruleType("d", "git")
+				rt.Id = ptr.Ptr("00000000-0000-0000-0000-000000000001")
+				rt.Def.Ingest.Git = &minderv1.GitType{Branch: "main"}
+				return rt
+			}(),
+			ok: true,
+		},
+		{
+			name: "changed nested field",
+			want: ruleType("d", "git"),
+			have: ruleType("d", "rest"),
+		},
+		{
+			name: "field missing from the project",
+			want: ruleType("d", "git"),
+			have: ruleType("", "git"),
+		},
+		{
+			name:   "ignored field",
+			want:   &minderv1.RuleType{Name: "branch_protection", Version: "v1"},
+			have:   &minderv1.RuleType{Name: "branch_protection"},
+			ignore: []protoreflect.Name{"version"},
+			ok:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.ok, Contains(tt.want, tt.have, tt.ignore...))
+		})
+	}
+}
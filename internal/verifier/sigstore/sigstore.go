@@ -5,6 +5,7 @@
 package sigstore
 
 import (
+	"bytes"
 	"context"
 	"embed"
 	"fmt"
@@ -12,6 +13,8 @@ import (
 	"path"
 	"strings"
 
+	"github.com/rs/zerolog"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
 	"github.com/sigstore/sigstore-go/pkg/root"
 	"github.com/sigstore/sigstore-go/pkg/tuf"
 	"github.com/sigstore/sigstore-go/pkg/verify"
@@ -160,6 +163,35 @@ func (s *Sigstore) VerifyContainer(ctx context.Context, owner, artifact, checksu
 	return container.Verify(ctx, s.verifier, owner, artifact, checksumref, s.authOpts...)
 }
 
+// VerifyBlob verifies a blob, such as a file downloaded over HTTPS, using
+// sigstore. The signature is a sigstore bundle in the JSON format, as written
+// by `cosign sign-blob --new-bundle-format --bundle`. As for containers, the
+// identity of the signer is left to the caller to check.
+func (s *Sigstore) VerifyBlob(ctx context.Context, sigBundle []byte, blob []byte) ([]verifyif.Result, error) {
+	var b bundle.Bundle
+	if err := b.UnmarshalJSON(sigBundle); err != nil {
+		return nil, fmt.Errorf("error parsing sigstore bundle: %w", err)
+	}
+
+	// The bundle could be parsed, so the blob is signed
+	res := verifyif.Result{
+		IsSigned:   true,
+		IsVerified: false,
+	}
+	verificationResult, err := s.verifier.Verify(&b, verify.NewPolicy(
+		verify.WithArtifact(bytes.NewReader(blob)),
+		verify.WithoutIdentitiesUnsafe(),
+	))
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Msg("error verifying bundle")
+		return []verifyif.Result{res}, nil
+	}
+
+	res.IsVerified = true
+	res.VerificationResult = *verificationResult
+	return []verifyif.Result{res}, nil
+}
+
 // sanitizeInput sanitizes the input parameters
 func sanitizeInput(owner *string) {
 	// (jaosorior): The owner can't be upper-cased, normalize the owner.
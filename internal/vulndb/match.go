@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulndb
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"deps.dev/util/semver"
+	"github.com/ossf/osv-schema/bindings/go/osvschema"
+)
+
+// versionSystems maps OSV ecosystems to their version semantics
+var versionSystems = map[string]semver.System{
+	string(osvschema.EcosystemNPM):      semver.NPM,
+	string(osvschema.EcosystemGo):       semver.Go,
+	string(osvschema.EcosystemPyPI):     semver.PyPI,
+	string(osvschema.EcosystemMaven):    semver.Maven,
+	string(osvschema.EcosystemCratesIO): semver.Cargo,
+	string(osvschema.EcosystemRubyGems): semver.RubyGems,
+	string(osvschema.EcosystemNuGet):    semver.NuGet,
+}
+
+var pyNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// NormalizeName returns the canonical form of a package name in an ecosystem,
+// so that names can be compared the way the ecosystem's registry does.
+func NormalizeName(ecosystem, name string) string {
+	switch ecosystem {
+	case string(osvschema.EcosystemPyPI):
+		// https://packaging.python.org/en/latest/specifications/name-normalization/
+		return strings.ToLower(pyNameSeparators.ReplaceAllString(name, "-"))
+	case string(osvschema.EcosystemNuGet):
+		return strings.ToLower(name)
+	default:
+		return name
+	}
+}
+
+// Range is the range of versions a vulnerability was found in
+type Range struct {
+	Type       osvschema.RangeType
+	Introduced string
+	Fixed      string
+}
+
+// Affects reports whether a vulnerability affects a package version, and
+// the range of versions the package version belongs to. Versions are
+// compared with the semantics of the package's ecosystem; GIT ranges
+// can't be evaluated without the source repository and are ignored.
+//
+// If the package has no ecosystem, the affected entries of any ecosystem
+// with the package's name are considered.
+func Affects(vuln *osvschema.Vulnerability, pkg Package) (Range, bool) {
+	for _, affected := range vuln.Affected {
+		ecosystem := affected.Package.Ecosystem
+		if pkg.Ecosystem != "" && pkg.Ecosystem != ecosystem {
+			continue
+		}
+		if NormalizeName(ecosystem, affected.Package.Name) != NormalizeName(ecosystem, pkg.Name) {
+			continue
+		}
+
+		sys, ok := versionSystems[ecosystem]
+		if !ok {
+			continue
+		}
+
+		for _, r := range affected.Ranges {
+			if r.Type == osvschema.RangeGit {
+				continue
+			}
+			if rng, ok := inRange(sys, r, pkg.Version); ok {
+				return rng, true
+			}
+		}
+
+		if slices.ContainsFunc(affected.Versions, func(v string) bool {
+			return sys.Compare(normalizeVersion(sys, v), normalizeVersion(sys, pkg.Version)) == 0
+		}) {
+			return Range{}, true
+		}
+	}
+
+	return Range{}, false
+}
+
+type eventKind int
+
+const (
+	eventIntroduced eventKind = iota
+	eventFixed
+	eventLastAffected
+	eventLimit
+)
+
+type event struct {
+	kind eventKind
+	raw  string
+	// version is nil for the introduced "0" event, which precedes all versions
+	version *semver.Version
+}
+
+// inRange evaluates the events of a range as described in
+// https://ossf.github.io/osv-schema/#evaluation
+func inRange(sys semver.System, r osvschema.Range, version string) (Range, bool) {
+	current, err := sys.Parse(normalizeVersion(sys, version))
+	if err != nil {
+		return Range{}, false
+	}
+
+	events := make([]event, 0, len(r.Events))
+	for _, e := range r.Events {
+		var ev event
+		switch {
+		case e.Introduced != "":
+			ev = event{kind: eventIntroduced, raw: e.Introduced}
+		case e.Fixed != "":
+			ev = event{kind: eventFixed, raw: e.Fixed}
+		case e.LastAffected != "":
+			ev = event{kind: eventLastAffected, raw: e.LastAffected}
+		case e.Limit != "":
+			ev = event{kind: eventLimit, raw: e.Limit}
+		default:
+			continue
+		}
+		if ev.kind != eventIntroduced || ev.raw != "0" {
+			if ev.version, err = sys.Parse(normalizeVersion(sys, ev.raw)); err != nil {
+				// a range which can't be evaluated can't say anything
+				return Range{}, false
+			}
+		}
+		events = append(events, ev)
+	}
+
+	slices.SortStableFunc(events, func(a, b event) int {
+		switch {
+		case a.version == nil && b.version == nil:
+			return 0
+		case a.version == nil:
+			return -1
+		case b.version == nil:
+			return 1
+		default:
+			return a.version.Compare(b.version)
+		}
+	})
+
+	affected := false
+	var rng Range
+	for _, ev := range events {
+		switch ev.kind {
+		case eventIntroduced:
+			if ev.version == nil || current.Compare(ev.version) >= 0 {
+				affected = true
+				rng = Range{Type: r.Type, Introduced: ev.raw}
+			}
+		case eventFixed:
+			if current.Compare(ev.version) >= 0 {
+				affected = false
+			} else if affected && rng.Fixed == "" {
+				rng.Fixed = ev.raw
+			}
+		case eventLastAffected:
+			if current.Compare(ev.version) > 0 {
+				affected = false
+			}
+		case eventLimit:
+			if current.Compare(ev.version) >= 0 {
+				affected = false
+			}
+		}
+	}
+
+	return rng, affected
+}
+
+// normalizeVersion adds the "v" prefix Go versions have, but OSV records don't
+func normalizeVersion(sys semver.System, version string) string {
+	if sys == semver.Go && !strings.HasPrefix(version, "v") {
+		return "v" + version
+	}
+	return version
+}
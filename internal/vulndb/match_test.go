@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulndb
+
+import (
+	"testing"
+
+	"github.com/ossf/osv-schema/bindings/go/osvschema"
+	"github.com/stretchr/testify/assert"
+)
+
+func affected(ecosystem, name string, ranges ...osvschema.Range) osvschema.Affected {
+	return osvschema.Affected{
+		Package: osvschema.Package{Ecosystem: ecosystem, Name: name},
+		Ranges:  ranges,
+	}
+}
+
+func ecosystemRange(events ...osvschema.Event) osvschema.Range {
+	return osvschema.Range{Type: osvschema.RangeEcosystem, Events: events}
+}
+
+func semverRange(events ...osvschema.Event) osvschema.Range {
+	return osvschema.Range{Type: osvschema.RangeSemVer, Events: events}
+}
+
+func TestAffects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		affected    []osvschema.Affected
+		pkg         Package
+		expectOK    bool
+		expectRange Range
+	}{
+		{
+			name: "go version with prefix",
+			affected: []osvschema.Affected{
+				affected("Go", "golang.org/x/text", semverRange(
+					osvschema.Event{Introduced: "0"}, osvschema.Event{Fixed: "0.3.8"})),
+			},
+			pkg:         Package{Ecosystem: "Go", Name: "golang.org/x/text", Version: "v0.3.7"},
+			expectOK:    true,
+			expectRange: Range{Type: osvschema.RangeSemVer, Introduced: "0", Fixed: "0.3.8"},
+		},
+		{
+			name: "fixed version is not affected",
+			affected: []osvschema.Affected{
+				affected("Go", "golang.org/x/text", semverRange(
+					osvschema.Event{Introduced: "0"}, osvschema.Event{Fixed: "0.3.8"})),
+			},
+			pkg: Package{Ecosystem: "Go", Name: "golang.org/x/text", Version: "v0.3.8"},
+		},
+		{
+			name: "second of several ranges",
+			affected: []osvschema.Affected{
+				affected("npm", "lodash", semverRange(
+					osvschema.Event{Introduced: "0"}, osvschema.Event{Fixed: "3.10.2"},
+					osvschema.Event{Introduced: "4.0.0"}, osvschema.Event{Fixed: "4.17.21"})),
+			},
+			pkg:         Package{Ecosystem: "npm", Name: "lodash", Version: "4.17.20"},
+			expectOK:    true,
+			expectRange: Range{Type: osvschema.RangeSemVer, Introduced: "4.0.0", Fixed: "4.17.21"},
+		},
+		{
+			name: "between ranges",
+			affected: []osvschema.Affected{
+				affected("npm", "lodash", semverRange(
+					osvschema.Event{Introduced: "0"}, osvschema.Event{Fixed: "3.10.2"},
+					osvschema.Event{Introduced: "4.0.0"}, osvschema.Event{Fixed: "4.17.21"})),
+			},
+			pkg: Package{Ecosystem: "npm", Name: "lodash", Version: "3.10.2"},
+		},
+		{
+			name: "pypi pre-release and normalized name",
+			affected: []osvschema.Affected{
+				affected("PyPI", "Django", ecosystemRange(
+					osvschema.Event{Introduced: "4.2"}, osvschema.Event{Fixed: "4.2.1"})),
+			},
+			pkg:         Package{Ecosystem: "PyPI", Name: "django", Version: "4.2.1rc1"},
+			expectOK:    true,
+			expectRange: Range{Type: osvschema.RangeEcosystem, Introduced: "4.2", Fixed: "4.2.1"},
+		},
+		{
+			name: "maven qualifiers",
+			affected: []osvschema.Affected{
+				affected("Maven", "org.apache.logging.log4j:log4j-core", ecosystemRange(
+					osvschema.Event{Introduced: "2.0-beta9"}, osvschema.Event{Fixed: "2.15.0"})),
+			},
+			pkg:         Package{Ecosystem: "Maven", Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"},
+			expectOK:    true,
+			expectRange: Range{Type: osvschema.RangeEcosystem, Introduced: "2.0-beta9", Fixed: "2.15.0"},
+		},
+		{
+			name: "last affected",
+			affected: []osvschema.Affected{
+				affected("crates.io", "time", ecosystemRange(
+					osvschema.Event{Introduced: "0.1.0"}, osvschema.Event{LastAffected: "0.2.22"})),
+			},
+			pkg: Package{Ecosystem: "crates.io", Name: "time", Version: "0.2.23"},
+		},
+		{
+			name: "explicit versions",
+			affected: []osvschema.Affected{{
+				Package:  osvschema.Package{Ecosystem: "RubyGems", Name: "rack"},
+				Versions: []string{"2.2.3", "2.2.4"},
+			}},
+			pkg:      Package{Ecosystem: "RubyGems", Name: "rack", Version: "2.2.4"},
+			expectOK: true,
+		},
+		{
+			name: "other ecosystem",
+			affected: []osvschema.Affected{
+				affected("npm", "requests", semverRange(osvschema.Event{Introduced: "0"})),
+			},
+			pkg: Package{Ecosystem: "PyPI", Name: "requests", Version: "2.0.0"},
+		},
+		{
+			name: "git ranges are ignored",
+			affected: []osvschema.Affected{
+				affected("Go", "golang.org/x/text", osvschema.Range{
+					Type:   osvschema.RangeGit,
+					Events: []osvschema.Event{{Introduced: "0"}},
+				}),
+			},
+			pkg: Package{Ecosystem: "Go", Name: "golang.org/x/text", Version: "v0.3.7"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rng, ok := Affects(&osvschema.Vulnerability{ID: "TEST-1", Affected: tt.affected}, tt.pkg)
+			assert.Equal(t, tt.expectOK, ok)
+			if tt.expectOK {
+				assert.Equal(t, tt.expectRange, rng)
+			}
+		})
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "zope-interface", NormalizeName("PyPI", "Zope.Interface"))
+	assert.Equal(t, "newtonsoft.json", NormalizeName("NuGet", "Newtonsoft.Json"))
+	assert.Equal(t, "github.com/BurntSushi/toml", NormalizeName("Go", "github.com/BurntSushi/toml"))
+}
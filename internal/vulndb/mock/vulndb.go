@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./vulndb.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_vulndb -destination=./mock/vulndb.go -source=./vulndb.go
+//
+
+// Package mock_vulndb is a generated GoMock package.
+package mock_vulndb
+
+import (
+	context "context"
+	reflect "reflect"
+
+	vulndb "github.com/mindersec/minder/internal/vulndb"
+	osvschema "github.com/ossf/osv-schema/bindings/go/osvschema"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDatabase is a mock of Database interface.
+type MockDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseMockRecorder is the mock recorder for MockDatabase.
+type MockDatabaseMockRecorder struct {
+	mock *MockDatabase
+}
+
+// NewMockDatabase creates a new mock instance.
+func NewMockDatabase(ctrl *gomock.Controller) *MockDatabase {
+	mock := &MockDatabase{ctrl: ctrl}
+	mock.recorder = &MockDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
+	return m.recorder
+}
+
+// Ecosystems mocks base method.
+func (m *MockDatabase) Ecosystems(ctx context.Context) ([]vulndb.SyncState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ecosystems", ctx)
+	ret0, _ := ret[0].([]vulndb.SyncState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ecosystems indicates an expected call of Ecosystems.
+func (mr *MockDatabaseMockRecorder) Ecosystems(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ecosystems", reflect.TypeOf((*MockDatabase)(nil).Ecosystems), ctx)
+}
+
+// Query mocks base method.
+func (m *MockDatabase) Query(ctx context.Context, pkgs []vulndb.Package) ([][]*osvschema.Vulnerability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", ctx, pkgs)
+	ret0, _ := ret[0].([][]*osvschema.Vulnerability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockDatabaseMockRecorder) Query(ctx, pkgs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockDatabase)(nil).Query), ctx, pkgs)
+}
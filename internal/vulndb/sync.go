@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulndb
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ossf/osv-schema/bindings/go/osvschema"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+const (
+	// DefaultSource is where OSV publishes the exports of its database
+	DefaultSource = "https://osv-vulnerabilities.storage.googleapis.com"
+
+	// the export of each ecosystem is a zip file of OSV records
+	exportFileName = "all.zip"
+
+	// records are written in batches, each in its own transaction
+	syncBatchSize = 500
+)
+
+// DefaultEcosystems are the ecosystems the vulncheck evaluator looks up
+var DefaultEcosystems = []string{
+	string(osvschema.EcosystemNPM),
+	string(osvschema.EcosystemGo),
+	string(osvschema.EcosystemPyPI),
+	string(osvschema.EcosystemMaven),
+	string(osvschema.EcosystemCratesIO),
+	string(osvschema.EcosystemRubyGems),
+	string(osvschema.EcosystemNuGet),
+}
+
+// SyncResult summarizes the sync of an ecosystem
+type SyncResult struct {
+	Ecosystem string
+	// Total is the number of records in the export
+	Total int
+	// Updated is the number of records which were new or had changed
+	Updated int
+}
+
+// Syncer loads OSV exports into the minder database
+type Syncer struct {
+	store  db.Store
+	source string
+	client *http.Client
+}
+
+// NewSyncer creates a Syncer reading the exports from source, which is
+// either the base URL of the OSV bucket (or a mirror of it), or a local
+// directory with the same layout, i.e. `<source>/<ecosystem>/all.zip`.
+func NewSyncer(store db.Store, source string) *Syncer {
+	return &Syncer{
+		store:  store,
+		source: source,
+		client: &http.Client{},
+	}
+}
+
+// Sync loads the export of an ecosystem. Records which haven't been
+// modified since the last sync are left alone.
+func (s *Syncer) Sync(ctx context.Context, ecosystem string) (*SyncResult, error) {
+	archive, cleanup, err := s.openExport(ctx, ecosystem)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	result := &SyncResult{Ecosystem: ecosystem}
+	batch := make([]record, 0, syncBatchSize)
+	for _, file := range archive.File {
+		if !strings.HasSuffix(file.Name, ".json") {
+			continue
+		}
+
+		rec, err := readRecord(file)
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, rec)
+		result.Total++
+
+		if len(batch) == syncBatchSize {
+			updated, err := s.writeBatch(ctx, batch)
+			if err != nil {
+				return nil, err
+			}
+			result.Updated += updated
+			batch = batch[:0]
+		}
+	}
+
+	updated, err := s.writeBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	result.Updated += updated
+
+	count := min(result.Total, math.MaxInt32)
+	if err := s.store.UpsertOSVSyncState(ctx, db.UpsertOSVSyncStateParams{
+		Ecosystem:          ecosystem,
+		VulnerabilityCount: int32(count), //nolint:gosec // bounded above
+	}); err != nil {
+		return nil, fmt.Errorf("error recording sync of %s: %w", ecosystem, err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Str("ecosystem", ecosystem).
+		Int("total", result.Total).
+		Int("updated", result.Updated).
+		Msg("synced vulnerability database")
+
+	return result, nil
+}
+
+// record is a vulnerability together with its serialization as exported
+type record struct {
+	vuln *osvschema.Vulnerability
+	raw  []byte
+}
+
+func readRecord(file *zip.File) (record, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return record{}, fmt.Errorf("error opening %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	raw, err := io.ReadAll(rc)
+	if err != nil {
+		return record{}, fmt.Errorf("error reading %s: %w", file.Name, err)
+	}
+
+	var vuln osvschema.Vulnerability
+	if err := json.Unmarshal(raw, &vuln); err != nil {
+		return record{}, fmt.Errorf("error decoding %s: %w", file.Name, err)
+	}
+	if vuln.ID == "" {
+		return record{}, fmt.Errorf("record %s has no id", file.Name)
+	}
+
+	return record{vuln: &vuln, raw: raw}, nil
+}
+
+func (s *Syncer) writeBatch(ctx context.Context, batch []record) (int, error) {
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	updated := 0
+	err := s.store.WithTransactionErr(func(qtx db.ExtendQuerier) error {
+		updated = 0
+		for _, rec := range batch {
+			rows, err := qtx.UpsertOSVVulnerability(ctx, db.UpsertOSVVulnerabilityParams{
+				ID:       rec.vuln.ID,
+				Modified: rec.vuln.Modified,
+				Data:     rec.raw,
+			})
+			if err != nil {
+				return fmt.Errorf("error storing %s: %w", rec.vuln.ID, err)
+			}
+			if rows == 0 {
+				// already up to date
+				continue
+			}
+			updated++
+
+			if err := qtx.DeleteOSVAffectedPackages(ctx, rec.vuln.ID); err != nil {
+				return fmt.Errorf("error updating packages affected by %s: %w", rec.vuln.ID, err)
+			}
+			for _, affected := range rec.vuln.Affected {
+				if affected.Package.Name == "" {
+					continue
+				}
+				if err := qtx.InsertOSVAffectedPackage(ctx, db.InsertOSVAffectedPackageParams{
+					VulnerabilityID: rec.vuln.ID,
+					Ecosystem:       affected.Package.Ecosystem,
+					Name:            NormalizeName(affected.Package.Ecosystem, affected.Package.Name),
+				}); err != nil {
+					return fmt.Errorf("error updating packages affected by %s: %w", rec.vuln.ID, err)
+				}
+			}
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// openExport opens the export of an ecosystem. Remote exports are
+// downloaded to a temporary file first, since zip files can't be streamed.
+func (s *Syncer) openExport(ctx context.Context, ecosystem string) (*zip.Reader, func(), error) {
+	u, err := url.Parse(s.source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid vulnerability database source: %w", err)
+	}
+
+	var f *os.File
+	temporary := false
+	switch u.Scheme {
+	case "http", "https":
+		u.Path = path.Join(u.Path, url.PathEscape(ecosystem), exportFileName)
+		f, err = s.download(ctx, u.String())
+		temporary = true
+	case "", "file":
+		f, err = os.Open(filepath.Join(filepath.FromSlash(u.Path), ecosystem, exportFileName))
+	default:
+		err = fmt.Errorf("unsupported vulnerability database source: %s", s.source)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		_ = f.Close()
+		if temporary {
+			_ = os.Remove(f.Name())
+		}
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("error reading %s export: %w", ecosystem, err)
+	}
+	archive, err := zip.NewReader(f, info.Size())
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("error reading %s export: %w", ecosystem, err)
+	}
+	return archive, cleanup, nil
+}
+
+func (s *Syncer) download(ctx context.Context, exportURL string) (*os.File, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, exportURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "minder (https://github.com/mindersec/minder)")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s: %w", exportURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading %s: unexpected status code %d", exportURL, resp.StatusCode)
+	}
+
+	f, err := os.CreateTemp("", "osv-export-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary file: %w", err)
+	}
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return nil, fmt.Errorf("error downloading %s: %w", exportURL, err)
+	}
+	return f, nil
+}
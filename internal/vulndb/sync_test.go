@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulndb
+
+import (
+	"archive/zip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+)
+
+const (
+	changedRecord = `{
+  "id": "GO-2024-0001",
+  "modified": "2024-05-01T00:00:00Z",
+  "affected": [
+    {"package": {"ecosystem": "Go", "name": "example.com/a"}},
+    {"package": {"ecosystem": "Go", "name": "example.com/b"}}
+  ]
+}`
+	unchangedRecord = `{
+  "id": "GO-2024-0002",
+  "modified": "2024-01-01T00:00:00Z",
+  "affected": [{"package": {"ecosystem": "Go", "name": "example.com/c"}}]
+}`
+)
+
+func writeExport(t *testing.T, dir string, records map[string]string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "Go"), 0o750))
+	f, err := os.Create(filepath.Join(dir, "Go", exportFileName))
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, content := range records {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+}
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeExport(t, dir, map[string]string{
+		"GO-2024-0001.json": changedRecord,
+		"GO-2024-0002.json": unchangedRecord,
+		"README":            "not a record",
+	})
+
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{name: "local directory", source: dir},
+		{name: "file url", source: "file://" + filepath.ToSlash(dir)},
+		{name: "http", source: server.URL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockTx := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				WithTransactionErr(gomock.Any()).
+				DoAndReturn(func(fn func(db.ExtendQuerier) error) error {
+					return fn(mockTx)
+				})
+
+			mockTx.EXPECT().
+				UpsertOSVVulnerability(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.UpsertOSVVulnerabilityParams) (int64, error) {
+					switch arg.ID {
+					case "GO-2024-0001":
+						assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), arg.Modified)
+						assert.JSONEq(t, changedRecord, string(arg.Data))
+						return 1, nil
+					default:
+						return 0, nil
+					}
+				}).
+				Times(2)
+			mockTx.EXPECT().DeleteOSVAffectedPackages(ctx, "GO-2024-0001").Return(nil)
+			mockTx.EXPECT().InsertOSVAffectedPackage(ctx, db.InsertOSVAffectedPackageParams{
+				VulnerabilityID: "GO-2024-0001", Ecosystem: "Go", Name: "example.com/a",
+			}).Return(nil)
+			mockTx.EXPECT().InsertOSVAffectedPackage(ctx, db.InsertOSVAffectedPackageParams{
+				VulnerabilityID: "GO-2024-0001", Ecosystem: "Go", Name: "example.com/b",
+			}).Return(nil)
+
+			mockStore.EXPECT().UpsertOSVSyncState(ctx, db.UpsertOSVSyncStateParams{
+				Ecosystem:          "Go",
+				VulnerabilityCount: 2,
+			}).Return(nil)
+
+			result, err := NewSyncer(mockStore, tt.source).Sync(ctx, "Go")
+			require.NoError(t, err)
+			assert.Equal(t, &SyncResult{Ecosystem: "Go", Total: 2, Updated: 1}, result)
+		})
+	}
+}
+
+func TestSyncMissingExport(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	_, err := NewSyncer(mockStore, server.URL).Sync(context.Background(), "Go")
+	require.ErrorContains(t, err, "unexpected status code 404")
+
+	_, err = NewSyncer(mockStore, t.TempDir()).Sync(context.Background(), "Go")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vulndb maintains a local mirror of the OSV vulnerability database,
+// so that vulnerabilities can be looked up in bulk, and without network
+// access to osv.dev.
+package vulndb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ossf/osv-schema/bindings/go/osvschema"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+// Package is a version of a package to look up. The ecosystem uses the
+// names of the OSV schema, e.g. "PyPI" or "crates.io".
+type Package struct {
+	Ecosystem string
+	Name      string
+	Version   string
+}
+
+// SyncState describes the last sync of an ecosystem
+type SyncState struct {
+	Ecosystem          string
+	VulnerabilityCount int
+	SyncedAt           time.Time
+}
+
+// Database looks up vulnerabilities in the local mirror
+type Database interface {
+	// Ecosystems returns the ecosystems which have been mirrored
+	Ecosystems(ctx context.Context) ([]SyncState, error)
+	// Query returns the vulnerabilities affecting each of the package
+	// versions, in the order the packages were given.
+	Query(ctx context.Context, pkgs []Package) ([][]*osvschema.Vulnerability, error)
+}
+
+type store struct {
+	store db.Store
+}
+
+// NewDatabase returns a Database backed by the minder database
+func NewDatabase(dbStore db.Store) Database {
+	return &store{store: dbStore}
+}
+
+func (s *store) Ecosystems(ctx context.Context) ([]SyncState, error) {
+	rows, err := s.store.ListOSVSyncState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing mirrored ecosystems: %w", err)
+	}
+
+	states := make([]SyncState, 0, len(rows))
+	for _, row := range rows {
+		states = append(states, SyncState{
+			Ecosystem:          row.Ecosystem,
+			VulnerabilityCount: int(row.VulnerabilityCount),
+			SyncedAt:           row.SyncedAt,
+		})
+	}
+	return states, nil
+}
+
+func (s *store) Query(ctx context.Context, pkgs []Package) ([][]*osvschema.Vulnerability, error) {
+	// one lookup per ecosystem, for all the packages of that ecosystem
+	names := make(map[string][]string)
+	for _, pkg := range pkgs {
+		names[pkg.Ecosystem] = append(names[pkg.Ecosystem], NormalizeName(pkg.Ecosystem, pkg.Name))
+	}
+
+	type key struct{ ecosystem, name string }
+	candidates := make(map[key][]*osvschema.Vulnerability)
+	for ecosystem, ecoNames := range names {
+		rows, err := s.store.ListOSVVulnerabilitiesByPackages(ctx, db.ListOSVVulnerabilitiesByPackagesParams{
+			Ecosystem: ecosystem,
+			Names:     ecoNames,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error looking up %s vulnerabilities: %w", ecosystem, err)
+		}
+
+		for _, row := range rows {
+			var vuln osvschema.Vulnerability
+			if err := json.Unmarshal(row.Data, &vuln); err != nil {
+				return nil, fmt.Errorf("error decoding vulnerability: %w", err)
+			}
+			if !vuln.Withdrawn.IsZero() {
+				continue
+			}
+			k := key{ecosystem, row.Name}
+			candidates[k] = append(candidates[k], &vuln)
+		}
+	}
+
+	results := make([][]*osvschema.Vulnerability, len(pkgs))
+	for i, pkg := range pkgs {
+		for _, vuln := range candidates[key{pkg.Ecosystem, NormalizeName(pkg.Ecosystem, pkg.Name)}] {
+			if _, ok := Affects(vuln, pkg); ok {
+				results[i] = append(results[i], vuln)
+			}
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulndb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+)
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	mockStore.EXPECT().
+		ListOSVVulnerabilitiesByPackages(ctx, db.ListOSVVulnerabilitiesByPackagesParams{
+			Ecosystem: "PyPI",
+			Names:     []string{"zope-interface", "requests"},
+		}).
+		Return([]db.ListOSVVulnerabilitiesByPackagesRow{
+			{
+				Name: "zope-interface",
+				Data: []byte(`{"id": "PYSEC-1", "affected": [{"package": {"ecosystem": "PyPI", "name": "zope.interface"},
+"ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "5.0"}]}]}]}`),
+			},
+			{
+				Name: "requests",
+				Data: []byte(`{"id": "PYSEC-2", "affected": [{"package": {"ecosystem": "PyPI", "name": "requests"},
+"ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "2.0"}]}]}]}`),
+			},
+			{
+				Name: "requests",
+				Data: []byte(`{"id": "PYSEC-3", "withdrawn": "2024-01-01T00:00:00Z",
+"affected": [{"package": {"ecosystem": "PyPI", "name": "requests"}, "versions": ["2.31.0"]}]}`),
+			},
+		}, nil)
+
+	results, err := NewDatabase(mockStore).Query(ctx, []Package{
+		{Ecosystem: "PyPI", Name: "Zope.Interface", Version: "4.7.2"},
+		{Ecosystem: "PyPI", Name: "requests", Version: "2.31.0"},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	require.Len(t, results[0], 1)
+	assert.Equal(t, "PYSEC-1", results[0][0].ID)
+	// PYSEC-2 is fixed, and PYSEC-3 was withdrawn
+	assert.Empty(t, results[1])
+}
@@ -27,6 +27,10 @@ func (ds *DataSource) GetDriverType() string {
 		return v1datasources.DataSourceDriverRest
 	case *DataSource_Structured:
 		return v1datasources.DataSourceDriverStruct
+	case *DataSource_Graphql:
+		return v1datasources.DataSourceDriverGraphQL
+	case *DataSource_Sql:
+		return v1datasources.DataSourceDriverSQL
 	default:
 		return "unknown"
 	}
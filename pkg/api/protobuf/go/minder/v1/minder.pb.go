@@ -377,6 +377,62 @@ func (Entity) EnumDescriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{3}
 }
 
+// ComplianceReportFormat is the format of a compliance report
+type ComplianceReportFormat int32
+
+const (
+	ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_UNSPECIFIED ComplianceReportFormat = 0
+	// SARIF 2.1.0, suitable for uploading to code scanning tools
+	ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_SARIF ComplianceReportFormat = 1
+	// CSV, with one row per rule evaluation
+	ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_CSV ComplianceReportFormat = 2
+	// OSCAL assessment results, in JSON
+	ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_OSCAL ComplianceReportFormat = 3
+)
+
+// Enum value maps for ComplianceReportFormat.
+var (
+	ComplianceReportFormat_name = map[int32]string{
+		0: "COMPLIANCE_REPORT_FORMAT_UNSPECIFIED",
+		1: "COMPLIANCE_REPORT_FORMAT_SARIF",
+		2: "COMPLIANCE_REPORT_FORMAT_CSV",
+		3: "COMPLIANCE_REPORT_FORMAT_OSCAL",
+	}
+	ComplianceReportFormat_value = map[string]int32{
+		"COMPLIANCE_REPORT_FORMAT_UNSPECIFIED": 0,
+		"COMPLIANCE_REPORT_FORMAT_SARIF":       1,
+		"COMPLIANCE_REPORT_FORMAT_CSV":         2,
+		"COMPLIANCE_REPORT_FORMAT_OSCAL":       3,
+	}
+)
+
+func (x ComplianceReportFormat) Enum() *ComplianceReportFormat {
+	p := new(ComplianceReportFormat)
+	*p = x
+	return p
+}
+
+func (x ComplianceReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComplianceReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_minder_v1_minder_proto_enumTypes[4].Descriptor()
+}
+
+func (ComplianceReportFormat) Type() protoreflect.EnumType {
+	return &file_minder_v1_minder_proto_enumTypes[4]
+}
+
+func (x ComplianceReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComplianceReportFormat.Descriptor instead.
+func (ComplianceReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{4}
+}
+
 // RuleTypeReleasePhase defines the release phase of the rule type.
 type RuleTypeReleasePhase int32
 
@@ -417,11 +473,11 @@ func (x RuleTypeReleasePhase) String() string {
 }
 
 func (RuleTypeReleasePhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_minder_v1_minder_proto_enumTypes[4].Descriptor()
+	return file_minder_v1_minder_proto_enumTypes[5].Descriptor()
 }
 
 func (RuleTypeReleasePhase) Type() protoreflect.EnumType {
-	return &file_minder_v1_minder_proto_enumTypes[4]
+	return &file_minder_v1_minder_proto_enumTypes[5]
 }
 
 func (x RuleTypeReleasePhase) Number() protoreflect.EnumNumber {
@@ -430,7 +486,7 @@ func (x RuleTypeReleasePhase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuleTypeReleasePhase.Descriptor instead.
 func (RuleTypeReleasePhase) EnumDescriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{4}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{5}
 }
 
 // ProviderTrait is the type of the provider.
@@ -479,11 +535,11 @@ func (x ProviderType) String() string {
 }
 
 func (ProviderType) Descriptor() protoreflect.EnumDescriptor {
-	return file_minder_v1_minder_proto_enumTypes[5].Descriptor()
+	return file_minder_v1_minder_proto_enumTypes[6].Descriptor()
 }
 
 func (ProviderType) Type() protoreflect.EnumType {
-	return &file_minder_v1_minder_proto_enumTypes[5]
+	return &file_minder_v1_minder_proto_enumTypes[6]
 }
 
 func (x ProviderType) Number() protoreflect.EnumNumber {
@@ -492,7 +548,7 @@ func (x ProviderType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProviderType.Descriptor instead.
 func (ProviderType) EnumDescriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{5}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{6}
 }
 
 type ProviderClass int32
@@ -534,11 +590,11 @@ func (x ProviderClass) String() string {
 }
 
 func (ProviderClass) Descriptor() protoreflect.EnumDescriptor {
-	return file_minder_v1_minder_proto_enumTypes[6].Descriptor()
+	return file_minder_v1_minder_proto_enumTypes[7].Descriptor()
 }
 
 func (ProviderClass) Type() protoreflect.EnumType {
-	return &file_minder_v1_minder_proto_enumTypes[6]
+	return &file_minder_v1_minder_proto_enumTypes[7]
 }
 
 func (x ProviderClass) Number() protoreflect.EnumNumber {
@@ -547,7 +603,7 @@ func (x ProviderClass) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProviderClass.Descriptor instead.
 func (ProviderClass) EnumDescriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{6}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{7}
 }
 
 type AuthorizationFlow int32
@@ -589,11 +645,11 @@ func (x AuthorizationFlow) String() string {
 }
 
 func (AuthorizationFlow) Descriptor() protoreflect.EnumDescriptor {
-	return file_minder_v1_minder_proto_enumTypes[7].Descriptor()
+	return file_minder_v1_minder_proto_enumTypes[8].Descriptor()
 }
 
 func (AuthorizationFlow) Type() protoreflect.EnumType {
-	return &file_minder_v1_minder_proto_enumTypes[7]
+	return &file_minder_v1_minder_proto_enumTypes[8]
 }
 
 func (x AuthorizationFlow) Number() protoreflect.EnumNumber {
@@ -602,7 +658,7 @@ func (x AuthorizationFlow) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuthorizationFlow.Descriptor instead.
 func (AuthorizationFlow) EnumDescriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{7}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{8}
 }
 
 type CredentialsState int32
@@ -641,11 +697,11 @@ func (x CredentialsState) String() string {
 }
 
 func (CredentialsState) Descriptor() protoreflect.EnumDescriptor {
-	return file_minder_v1_minder_proto_enumTypes[8].Descriptor()
+	return file_minder_v1_minder_proto_enumTypes[9].Descriptor()
 }
 
 func (CredentialsState) Type() protoreflect.EnumType {
-	return &file_minder_v1_minder_proto_enumTypes[8]
+	return &file_minder_v1_minder_proto_enumTypes[9]
 }
 
 func (x CredentialsState) Number() protoreflect.EnumNumber {
@@ -654,7 +710,7 @@ func (x CredentialsState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CredentialsState.Descriptor instead.
 func (CredentialsState) EnumDescriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{8}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{9}
 }
 
 // Value enumerates the severity values.
@@ -715,11 +771,11 @@ func (x Severity_Value) String() string {
 }
 
 func (Severity_Value) Descriptor() protoreflect.EnumDescriptor {
-	return file_minder_v1_minder_proto_enumTypes[9].Descriptor()
+	return file_minder_v1_minder_proto_enumTypes[10].Descriptor()
 }
 
 func (Severity_Value) Type() protoreflect.EnumType {
-	return &file_minder_v1_minder_proto_enumTypes[9]
+	return &file_minder_v1_minder_proto_enumTypes[10]
 }
 
 func (x Severity_Value) Number() protoreflect.EnumNumber {
@@ -728,7 +784,7 @@ func (x Severity_Value) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity_Value.Descriptor instead.
 func (Severity_Value) EnumDescriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{127, 0}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{147, 0}
 }
 
 type RpcOptions struct {
@@ -4905,6 +4961,258 @@ func (x *UpdateProfileResponse) GetProfile() *Profile {
 	return nil
 }
 
+type PlanProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// profile is the profile to plan, as it would be created or updated
+	Profile       *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanProfileRequest) Reset() {
+	*x = PlanProfileRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProfileRequest) ProtoMessage() {}
+
+func (x *PlanProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProfileRequest.ProtoReflect.Descriptor instead.
+func (*PlanProfileRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PlanProfileRequest) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// ProfilePlanSelection is an entity which the profile would start or stop selecting
+type ProfilePlanSelection struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Entity *EntityTypedId         `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// selected_before is whether the profile currently in the project selects the entity
+	SelectedBefore bool `protobuf:"varint,2,opt,name=selected_before,json=selectedBefore,proto3" json:"selected_before,omitempty"`
+	// selected_after is whether the planned profile selects the entity
+	SelectedAfter bool `protobuf:"varint,3,opt,name=selected_after,json=selectedAfter,proto3" json:"selected_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfilePlanSelection) Reset() {
+	*x = ProfilePlanSelection{}
+	mi := &file_minder_v1_minder_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfilePlanSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfilePlanSelection) ProtoMessage() {}
+
+func (x *ProfilePlanSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfilePlanSelection.ProtoReflect.Descriptor instead.
+func (*ProfilePlanSelection) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ProfilePlanSelection) GetEntity() *EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *ProfilePlanSelection) GetSelectedBefore() bool {
+	if x != nil {
+		return x.SelectedBefore
+	}
+	return false
+}
+
+func (x *ProfilePlanSelection) GetSelectedAfter() bool {
+	if x != nil {
+		return x.SelectedAfter
+	}
+	return false
+}
+
+// ProfilePlanEvaluation is the projected change in the evaluation of a rule against an entity
+type ProfilePlanEvaluation struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Entity       *EntityTypedId         `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	RuleTypeName string                 `protobuf:"bytes,2,opt,name=rule_type_name,json=ruleTypeName,proto3" json:"rule_type_name,omitempty"`
+	RuleName     string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	// current_status is the status of the latest evaluation of the rule against the entity,
+	// empty if the rule was never evaluated against the entity
+	CurrentStatus string `protobuf:"bytes,4,opt,name=current_status,json=currentStatus,proto3" json:"current_status,omitempty"`
+	// planned_status is the status the planned profile would evaluate to,
+	// empty if the rule would no longer be evaluated against the entity
+	PlannedStatus string `protobuf:"bytes,5,opt,name=planned_status,json=plannedStatus,proto3" json:"planned_status,omitempty"`
+	// planned_details are the details of the planned evaluation
+	PlannedDetails string `protobuf:"bytes,6,opt,name=planned_details,json=plannedDetails,proto3" json:"planned_details,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProfilePlanEvaluation) Reset() {
+	*x = ProfilePlanEvaluation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfilePlanEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfilePlanEvaluation) ProtoMessage() {}
+
+func (x *ProfilePlanEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfilePlanEvaluation.ProtoReflect.Descriptor instead.
+func (*ProfilePlanEvaluation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ProfilePlanEvaluation) GetEntity() *EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *ProfilePlanEvaluation) GetRuleTypeName() string {
+	if x != nil {
+		return x.RuleTypeName
+	}
+	return ""
+}
+
+func (x *ProfilePlanEvaluation) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *ProfilePlanEvaluation) GetCurrentStatus() string {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return ""
+}
+
+func (x *ProfilePlanEvaluation) GetPlannedStatus() string {
+	if x != nil {
+		return x.PlannedStatus
+	}
+	return ""
+}
+
+func (x *ProfilePlanEvaluation) GetPlannedDetails() string {
+	if x != nil {
+		return x.PlannedDetails
+	}
+	return ""
+}
+
+type PlanProfileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// selections are the entities which the profile would start or stop selecting
+	Selections []*ProfilePlanSelection `protobuf:"bytes,1,rep,name=selections,proto3" json:"selections,omitempty"`
+	// evaluations are the evaluations whose status would change
+	Evaluations   []*ProfilePlanEvaluation `protobuf:"bytes,2,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanProfileResponse) Reset() {
+	*x = PlanProfileResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProfileResponse) ProtoMessage() {}
+
+func (x *PlanProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProfileResponse.ProtoReflect.Descriptor instead.
+func (*PlanProfileResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PlanProfileResponse) GetSelections() []*ProfilePlanSelection {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+func (x *PlanProfileResponse) GetEvaluations() []*ProfilePlanEvaluation {
+	if x != nil {
+		return x.Evaluations
+	}
+	return nil
+}
+
 type PatchProfileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The context in which the patch is applied. Provided explicitly
@@ -4925,7 +5233,7 @@ type PatchProfileRequest struct {
 
 func (x *PatchProfileRequest) Reset() {
 	*x = PatchProfileRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[76]
+	mi := &file_minder_v1_minder_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4937,7 +5245,7 @@ func (x *PatchProfileRequest) String() string {
 func (*PatchProfileRequest) ProtoMessage() {}
 
 func (x *PatchProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[76]
+	mi := &file_minder_v1_minder_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4950,7 +5258,7 @@ func (x *PatchProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchProfileRequest.ProtoReflect.Descriptor instead.
 func (*PatchProfileRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{76}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *PatchProfileRequest) GetContext() *Context {
@@ -4990,7 +5298,7 @@ type PatchProfileResponse struct {
 
 func (x *PatchProfileResponse) Reset() {
 	*x = PatchProfileResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[77]
+	mi := &file_minder_v1_minder_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5002,7 +5310,7 @@ func (x *PatchProfileResponse) String() string {
 func (*PatchProfileResponse) ProtoMessage() {}
 
 func (x *PatchProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[77]
+	mi := &file_minder_v1_minder_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5015,7 +5323,7 @@ func (x *PatchProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchProfileResponse.ProtoReflect.Descriptor instead.
 func (*PatchProfileResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{77}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *PatchProfileResponse) GetProfile() *Profile {
@@ -5037,7 +5345,7 @@ type DeleteProfileRequest struct {
 
 func (x *DeleteProfileRequest) Reset() {
 	*x = DeleteProfileRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[78]
+	mi := &file_minder_v1_minder_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5049,7 +5357,7 @@ func (x *DeleteProfileRequest) String() string {
 func (*DeleteProfileRequest) ProtoMessage() {}
 
 func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[78]
+	mi := &file_minder_v1_minder_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5062,7 +5370,7 @@ func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProfileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProfileRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{78}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DeleteProfileRequest) GetContext() *Context {
@@ -5087,7 +5395,7 @@ type DeleteProfileResponse struct {
 
 func (x *DeleteProfileResponse) Reset() {
 	*x = DeleteProfileResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[79]
+	mi := &file_minder_v1_minder_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5099,7 +5407,7 @@ func (x *DeleteProfileResponse) String() string {
 func (*DeleteProfileResponse) ProtoMessage() {}
 
 func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[79]
+	mi := &file_minder_v1_minder_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5112,7 +5420,7 @@ func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProfileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProfileResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{79}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{83}
 }
 
 // list profiles
@@ -5132,7 +5440,7 @@ type ListProfilesRequest struct {
 
 func (x *ListProfilesRequest) Reset() {
 	*x = ListProfilesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[80]
+	mi := &file_minder_v1_minder_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5144,7 +5452,7 @@ func (x *ListProfilesRequest) String() string {
 func (*ListProfilesRequest) ProtoMessage() {}
 
 func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[80]
+	mi := &file_minder_v1_minder_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5157,7 +5465,7 @@ func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProfilesRequest.ProtoReflect.Descriptor instead.
 func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{80}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ListProfilesRequest) GetContext() *Context {
@@ -5183,7 +5491,7 @@ type ListProfilesResponse struct {
 
 func (x *ListProfilesResponse) Reset() {
 	*x = ListProfilesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[81]
+	mi := &file_minder_v1_minder_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5195,7 +5503,7 @@ func (x *ListProfilesResponse) String() string {
 func (*ListProfilesResponse) ProtoMessage() {}
 
 func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[81]
+	mi := &file_minder_v1_minder_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5208,7 +5516,7 @@ func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProfilesResponse.ProtoReflect.Descriptor instead.
 func (*ListProfilesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{81}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *ListProfilesResponse) GetProfiles() []*Profile {
@@ -5231,7 +5539,7 @@ type GetProfileByIdRequest struct {
 
 func (x *GetProfileByIdRequest) Reset() {
 	*x = GetProfileByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[82]
+	mi := &file_minder_v1_minder_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5243,7 +5551,7 @@ func (x *GetProfileByIdRequest) String() string {
 func (*GetProfileByIdRequest) ProtoMessage() {}
 
 func (x *GetProfileByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[82]
+	mi := &file_minder_v1_minder_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5256,7 +5564,7 @@ func (x *GetProfileByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{82}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetProfileByIdRequest) GetContext() *Context {
@@ -5282,7 +5590,7 @@ type GetProfileByIdResponse struct {
 
 func (x *GetProfileByIdResponse) Reset() {
 	*x = GetProfileByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[83]
+	mi := &file_minder_v1_minder_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5294,7 +5602,7 @@ func (x *GetProfileByIdResponse) String() string {
 func (*GetProfileByIdResponse) ProtoMessage() {}
 
 func (x *GetProfileByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[83]
+	mi := &file_minder_v1_minder_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5307,7 +5615,7 @@ func (x *GetProfileByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{83}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetProfileByIdResponse) GetProfile() *Profile {
@@ -5330,7 +5638,7 @@ type GetProfileByNameRequest struct {
 
 func (x *GetProfileByNameRequest) Reset() {
 	*x = GetProfileByNameRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[84]
+	mi := &file_minder_v1_minder_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5342,7 +5650,7 @@ func (x *GetProfileByNameRequest) String() string {
 func (*GetProfileByNameRequest) ProtoMessage() {}
 
 func (x *GetProfileByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[84]
+	mi := &file_minder_v1_minder_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5355,7 +5663,7 @@ func (x *GetProfileByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileByNameRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{84}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *GetProfileByNameRequest) GetContext() *Context {
@@ -5381,7 +5689,7 @@ type GetProfileByNameResponse struct {
 
 func (x *GetProfileByNameResponse) Reset() {
 	*x = GetProfileByNameResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[85]
+	mi := &file_minder_v1_minder_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5393,7 +5701,7 @@ func (x *GetProfileByNameResponse) String() string {
 func (*GetProfileByNameResponse) ProtoMessage() {}
 
 func (x *GetProfileByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[85]
+	mi := &file_minder_v1_minder_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5406,7 +5714,7 @@ func (x *GetProfileByNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileByNameResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileByNameResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{85}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *GetProfileByNameResponse) GetProfile() *Profile {
@@ -5435,7 +5743,7 @@ type ProfileStatus struct {
 
 func (x *ProfileStatus) Reset() {
 	*x = ProfileStatus{}
-	mi := &file_minder_v1_minder_proto_msgTypes[86]
+	mi := &file_minder_v1_minder_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5447,7 +5755,7 @@ func (x *ProfileStatus) String() string {
 func (*ProfileStatus) ProtoMessage() {}
 
 func (x *ProfileStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[86]
+	mi := &file_minder_v1_minder_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5460,7 +5768,7 @@ func (x *ProfileStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileStatus.ProtoReflect.Descriptor instead.
 func (*ProfileStatus) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{86}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *ProfileStatus) GetProfileId() string {
@@ -5515,7 +5823,7 @@ type EvalResultAlert struct {
 
 func (x *EvalResultAlert) Reset() {
 	*x = EvalResultAlert{}
-	mi := &file_minder_v1_minder_proto_msgTypes[87]
+	mi := &file_minder_v1_minder_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5527,7 +5835,7 @@ func (x *EvalResultAlert) String() string {
 func (*EvalResultAlert) ProtoMessage() {}
 
 func (x *EvalResultAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[87]
+	mi := &file_minder_v1_minder_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5540,7 +5848,7 @@ func (x *EvalResultAlert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvalResultAlert.ProtoReflect.Descriptor instead.
 func (*EvalResultAlert) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{87}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *EvalResultAlert) GetStatus() string {
@@ -5627,7 +5935,7 @@ type RuleEvaluationStatus struct {
 
 func (x *RuleEvaluationStatus) Reset() {
 	*x = RuleEvaluationStatus{}
-	mi := &file_minder_v1_minder_proto_msgTypes[88]
+	mi := &file_minder_v1_minder_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5639,7 +5947,7 @@ func (x *RuleEvaluationStatus) String() string {
 func (*RuleEvaluationStatus) ProtoMessage() {}
 
 func (x *RuleEvaluationStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[88]
+	mi := &file_minder_v1_minder_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5652,7 +5960,7 @@ func (x *RuleEvaluationStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleEvaluationStatus.ProtoReflect.Descriptor instead.
 func (*RuleEvaluationStatus) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{88}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *RuleEvaluationStatus) GetProfileId() string {
@@ -5819,7 +6127,7 @@ type EntityTypedId struct {
 
 func (x *EntityTypedId) Reset() {
 	*x = EntityTypedId{}
-	mi := &file_minder_v1_minder_proto_msgTypes[89]
+	mi := &file_minder_v1_minder_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5831,7 +6139,7 @@ func (x *EntityTypedId) String() string {
 func (*EntityTypedId) ProtoMessage() {}
 
 func (x *EntityTypedId) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[89]
+	mi := &file_minder_v1_minder_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5844,7 +6152,7 @@ func (x *EntityTypedId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityTypedId.ProtoReflect.Descriptor instead.
 func (*EntityTypedId) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{89}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *EntityTypedId) GetType() Entity {
@@ -5896,7 +6204,7 @@ type GetProfileStatusByNameRequest struct {
 
 func (x *GetProfileStatusByNameRequest) Reset() {
 	*x = GetProfileStatusByNameRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[90]
+	mi := &file_minder_v1_minder_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5908,7 +6216,7 @@ func (x *GetProfileStatusByNameRequest) String() string {
 func (*GetProfileStatusByNameRequest) ProtoMessage() {}
 
 func (x *GetProfileStatusByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[90]
+	mi := &file_minder_v1_minder_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5921,7 +6229,7 @@ func (x *GetProfileStatusByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileStatusByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileStatusByNameRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{90}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *GetProfileStatusByNameRequest) GetContext() *Context {
@@ -5986,7 +6294,7 @@ type GetProfileStatusByNameResponse struct {
 
 func (x *GetProfileStatusByNameResponse) Reset() {
 	*x = GetProfileStatusByNameResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[91]
+	mi := &file_minder_v1_minder_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5998,7 +6306,7 @@ func (x *GetProfileStatusByNameResponse) String() string {
 func (*GetProfileStatusByNameResponse) ProtoMessage() {}
 
 func (x *GetProfileStatusByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[91]
+	mi := &file_minder_v1_minder_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6011,7 +6319,7 @@ func (x *GetProfileStatusByNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileStatusByNameResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileStatusByNameResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{91}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *GetProfileStatusByNameResponse) GetProfileStatus() *ProfileStatus {
@@ -6044,7 +6352,7 @@ type GetProfileStatusByIdRequest struct {
 
 func (x *GetProfileStatusByIdRequest) Reset() {
 	*x = GetProfileStatusByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[92]
+	mi := &file_minder_v1_minder_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6056,7 +6364,7 @@ func (x *GetProfileStatusByIdRequest) String() string {
 func (*GetProfileStatusByIdRequest) ProtoMessage() {}
 
 func (x *GetProfileStatusByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[92]
+	mi := &file_minder_v1_minder_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6069,7 +6377,7 @@ func (x *GetProfileStatusByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileStatusByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileStatusByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{92}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetProfileStatusByIdRequest) GetContext() *Context {
@@ -6126,7 +6434,7 @@ type GetProfileStatusByIdResponse struct {
 
 func (x *GetProfileStatusByIdResponse) Reset() {
 	*x = GetProfileStatusByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[93]
+	mi := &file_minder_v1_minder_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6138,7 +6446,7 @@ func (x *GetProfileStatusByIdResponse) String() string {
 func (*GetProfileStatusByIdResponse) ProtoMessage() {}
 
 func (x *GetProfileStatusByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[93]
+	mi := &file_minder_v1_minder_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6151,7 +6459,7 @@ func (x *GetProfileStatusByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileStatusByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileStatusByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{93}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *GetProfileStatusByIdResponse) GetProfileStatus() *ProfileStatus {
@@ -6178,7 +6486,7 @@ type GetProfileStatusByProjectRequest struct {
 
 func (x *GetProfileStatusByProjectRequest) Reset() {
 	*x = GetProfileStatusByProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[94]
+	mi := &file_minder_v1_minder_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6190,7 +6498,7 @@ func (x *GetProfileStatusByProjectRequest) String() string {
 func (*GetProfileStatusByProjectRequest) ProtoMessage() {}
 
 func (x *GetProfileStatusByProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[94]
+	mi := &file_minder_v1_minder_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6203,7 +6511,7 @@ func (x *GetProfileStatusByProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileStatusByProjectRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileStatusByProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{94}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *GetProfileStatusByProjectRequest) GetContext() *Context {
@@ -6223,7 +6531,7 @@ type GetProfileStatusByProjectResponse struct {
 
 func (x *GetProfileStatusByProjectResponse) Reset() {
 	*x = GetProfileStatusByProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[95]
+	mi := &file_minder_v1_minder_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6235,7 +6543,7 @@ func (x *GetProfileStatusByProjectResponse) String() string {
 func (*GetProfileStatusByProjectResponse) ProtoMessage() {}
 
 func (x *GetProfileStatusByProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[95]
+	mi := &file_minder_v1_minder_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6248,7 +6556,7 @@ func (x *GetProfileStatusByProjectResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetProfileStatusByProjectResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileStatusByProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{95}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *GetProfileStatusByProjectResponse) GetProfileStatus() []*ProfileStatus {
@@ -6258,28 +6566,56 @@ func (x *GetProfileStatusByProjectResponse) GetProfileStatus() []*ProfileStatus
 	return nil
 }
 
-type EntityAutoRegistrationConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       *bool                  `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+// RuleExemption allows a rule of a profile to fail for an entity until
+// it expires. While the exemption is active, the rule is not evaluated
+// for the entity, the evaluation is recorded as `waived`, and no alerts
+// or remediations are issued.
+type RuleExemption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the exemption. It is ignored on creation.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the context in which the exemption applies.
+	Context *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// profile is the name of the profile containing the rule.
+	Profile string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	// rule_name is the name of the rule in the profile. For rules without
+	// a name, this is the name of the rule type.
+	RuleName string `protobuf:"bytes,4,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	// entity is the entity the rule is waived for. On input, either the
+	// id or the name of the entity must be set.
+	Entity *EntityTypedId `protobuf:"bytes,5,opt,name=entity,proto3" json:"entity,omitempty"`
+	// justification explains why the rule may fail, e.g. a reference to
+	// the ticket tracking the fix.
+	Justification string `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
+	// approver is the person who approved the exemption.
+	Approver string `protobuf:"bytes,7,opt,name=approver,proto3" json:"approver,omitempty"`
+	// expires_at is the time at which the exemption stops applying.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// created_by is the user who created the exemption.
+	CreatedBy string `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// created_at is the time the exemption was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the time the exemption was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityAutoRegistrationConfig) Reset() {
-	*x = EntityAutoRegistrationConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[96]
+func (x *RuleExemption) Reset() {
+	*x = RuleExemption{}
+	mi := &file_minder_v1_minder_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityAutoRegistrationConfig) String() string {
+func (x *RuleExemption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityAutoRegistrationConfig) ProtoMessage() {}
+func (*RuleExemption) ProtoMessage() {}
 
-func (x *EntityAutoRegistrationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[96]
+func (x *RuleExemption) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6290,44 +6626,113 @@ func (x *EntityAutoRegistrationConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityAutoRegistrationConfig.ProtoReflect.Descriptor instead.
-func (*EntityAutoRegistrationConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use RuleExemption.ProtoReflect.Descriptor instead.
+func (*RuleExemption) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *EntityAutoRegistrationConfig) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
+func (x *RuleExemption) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-// AutoRegistration is the configuration for auto-registering entities.
-// When nothing is set, it means that auto-registration is disabled. There is no difference between disabled
-// and undefined so for the "let's not auto-register anything" case we'd just let the repeated string empty
-type AutoRegistration struct {
+func (x *RuleExemption) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RuleExemption) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *RuleExemption) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RuleExemption) GetEntity() *EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *RuleExemption) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *RuleExemption) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *RuleExemption) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *RuleExemption) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RuleExemption) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RuleExemption) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateRuleExemptionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// enabled is the list of entities that are enabled for auto-registration.
-	Entities      map[string]*EntityAutoRegistrationConfig `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// context is the context in which the exemption is created.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// exemption is the exemption to create.
+	Exemption     *RuleExemption `protobuf:"bytes,2,opt,name=exemption,proto3" json:"exemption,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AutoRegistration) Reset() {
-	*x = AutoRegistration{}
-	mi := &file_minder_v1_minder_proto_msgTypes[97]
+func (x *CreateRuleExemptionRequest) Reset() {
+	*x = CreateRuleExemptionRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutoRegistration) String() string {
+func (x *CreateRuleExemptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutoRegistration) ProtoMessage() {}
+func (*CreateRuleExemptionRequest) ProtoMessage() {}
 
-func (x *AutoRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[97]
+func (x *CreateRuleExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6338,42 +6743,48 @@ func (x *AutoRegistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutoRegistration.ProtoReflect.Descriptor instead.
-func (*AutoRegistration) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use CreateRuleExemptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateRuleExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *AutoRegistration) GetEntities() map[string]*EntityAutoRegistrationConfig {
+func (x *CreateRuleExemptionRequest) GetContext() *Context {
 	if x != nil {
-		return x.Entities
+		return x.Context
 	}
 	return nil
 }
 
-// ProviderConfig contains the generic configuration for a provider.
-type ProviderConfig struct {
+func (x *CreateRuleExemptionRequest) GetExemption() *RuleExemption {
+	if x != nil {
+		return x.Exemption
+	}
+	return nil
+}
+
+type CreateRuleExemptionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// auto_registration is the configuration for auto-registering entities.
-	AutoRegistration *AutoRegistration `protobuf:"bytes,1,opt,name=auto_registration,json=autoRegistration,proto3,oneof" json:"auto_registration,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// exemption is the exemption that was created.
+	Exemption     *RuleExemption `protobuf:"bytes,1,opt,name=exemption,proto3" json:"exemption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderConfig) Reset() {
-	*x = ProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[98]
+func (x *CreateRuleExemptionResponse) Reset() {
+	*x = CreateRuleExemptionResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderConfig) String() string {
+func (x *CreateRuleExemptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderConfig) ProtoMessage() {}
+func (*CreateRuleExemptionResponse) ProtoMessage() {}
 
-func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[98]
+func (x *CreateRuleExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6384,42 +6795,43 @@ func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderConfig.ProtoReflect.Descriptor instead.
-func (*ProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use CreateRuleExemptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateRuleExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ProviderConfig) GetAutoRegistration() *AutoRegistration {
+func (x *CreateRuleExemptionResponse) GetExemption() *RuleExemption {
 	if x != nil {
-		return x.AutoRegistration
+		return x.Exemption
 	}
 	return nil
 }
 
-// RESTProviderConfig contains the configuration for the REST provider.
-type RESTProviderConfig struct {
+type GetRuleExemptionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// base_url is the base URL for the REST provider.
-	BaseUrl       *string `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
+	// context is the context in which the exemption is retrieved.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the exemption to retrieve.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RESTProviderConfig) Reset() {
-	*x = RESTProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[99]
+func (x *GetRuleExemptionRequest) Reset() {
+	*x = GetRuleExemptionRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RESTProviderConfig) String() string {
+func (x *GetRuleExemptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RESTProviderConfig) ProtoMessage() {}
+func (*GetRuleExemptionRequest) ProtoMessage() {}
 
-func (x *RESTProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[99]
+func (x *GetRuleExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6430,48 +6842,48 @@ func (x *RESTProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RESTProviderConfig.ProtoReflect.Descriptor instead.
-func (*RESTProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use GetRuleExemptionRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *RESTProviderConfig) GetBaseUrl() string {
-	if x != nil && x.BaseUrl != nil {
-		return *x.BaseUrl
-	}
+func (x *GetRuleExemptionRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetRuleExemptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
 	return ""
 }
 
-// GitHubProviderConfig contains the configuration for the GitHub client
-//
-// Endpoint: is the GitHub API endpoint
-//
-// If using the public GitHub API, Endpoint can be left blank
-// disable revive linting for this struct as there is nothing wrong with the
-// naming convention
-type GitHubProviderConfig struct {
+type GetRuleExemptionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
-	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
+	// exemption is the exemption that was retrieved.
+	Exemption     *RuleExemption `protobuf:"bytes,1,opt,name=exemption,proto3" json:"exemption,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitHubProviderConfig) Reset() {
-	*x = GitHubProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[100]
+func (x *GetRuleExemptionResponse) Reset() {
+	*x = GetRuleExemptionResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitHubProviderConfig) String() string {
+func (x *GetRuleExemptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubProviderConfig) ProtoMessage() {}
+func (*GetRuleExemptionResponse) ProtoMessage() {}
 
-func (x *GitHubProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[100]
+func (x *GetRuleExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6482,42 +6894,45 @@ func (x *GitHubProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubProviderConfig.ProtoReflect.Descriptor instead.
-func (*GitHubProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use GetRuleExemptionResponse.ProtoReflect.Descriptor instead.
+func (*GetRuleExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GitHubProviderConfig) GetEndpoint() string {
-	if x != nil && x.Endpoint != nil {
-		return *x.Endpoint
+func (x *GetRuleExemptionResponse) GetExemption() *RuleExemption {
+	if x != nil {
+		return x.Exemption
 	}
-	return ""
+	return nil
 }
 
-// GitHubAppProviderConfig contains the configuration for the GitHub App provider
-type GitHubAppProviderConfig struct {
+type ListRuleExemptionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
-	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// context is the context in which the exemptions are listed.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// profile only lists the exemptions of the profile with this name, if set.
+	Profile string `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// include_expired also lists the exemptions which have expired.
+	IncludeExpired bool `protobuf:"varint,3,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GitHubAppProviderConfig) Reset() {
-	*x = GitHubAppProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[101]
+func (x *ListRuleExemptionsRequest) Reset() {
+	*x = ListRuleExemptionsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitHubAppProviderConfig) String() string {
+func (x *ListRuleExemptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubAppProviderConfig) ProtoMessage() {}
+func (*ListRuleExemptionsRequest) ProtoMessage() {}
 
-func (x *GitHubAppProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[101]
+func (x *ListRuleExemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6528,48 +6943,55 @@ func (x *GitHubAppProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubAppProviderConfig.ProtoReflect.Descriptor instead.
-func (*GitHubAppProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use ListRuleExemptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRuleExemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GitHubAppProviderConfig) GetEndpoint() string {
-	if x != nil && x.Endpoint != nil {
-		return *x.Endpoint
+func (x *ListRuleExemptionsRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListRuleExemptionsRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
 	}
 	return ""
 }
 
-// GitLabProviderConfig contains the configuration for the GitLab provider.
-//
-// Endpoint: is the GitLab API endpoint
-//
-// If using the public GitLab API, Endpoint can be left blank
-type GitLabProviderConfig struct {
+func (x *ListRuleExemptionsRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListRuleExemptionsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Endpoint is the GitLab API endpoint. If using the public GitLab API, Endpoint can be left blank.
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	// group is the GitLab group to use for the provider
-	Group         string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// exemptions are the exemptions of the project.
+	Exemptions    []*RuleExemption `protobuf:"bytes,1,rep,name=exemptions,proto3" json:"exemptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitLabProviderConfig) Reset() {
-	*x = GitLabProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[102]
+func (x *ListRuleExemptionsResponse) Reset() {
+	*x = ListRuleExemptionsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitLabProviderConfig) String() string {
+func (x *ListRuleExemptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitLabProviderConfig) ProtoMessage() {}
+func (*ListRuleExemptionsResponse) ProtoMessage() {}
 
-func (x *GitLabProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[102]
+func (x *ListRuleExemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6580,51 +7002,51 @@ func (x *GitLabProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitLabProviderConfig.ProtoReflect.Descriptor instead.
-func (*GitLabProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{102}
-}
-
-func (x *GitLabProviderConfig) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
+// Deprecated: Use ListRuleExemptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRuleExemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GitLabProviderConfig) GetGroup() string {
+func (x *ListRuleExemptionsResponse) GetExemptions() []*RuleExemption {
 	if x != nil {
-		return x.Group
+		return x.Exemptions
 	}
-	return ""
+	return nil
 }
 
-// DockerHubProviderConfig contains the configuration for the DockerHub provider.
-//
-// Namespace: is the namespace for the DockerHub provider.
-type DockerHubProviderConfig struct {
+// UpdateRuleExemptionRequest updates the justification, approver or expiry
+// of an exemption. Fields which are not set keep their current value.
+type UpdateRuleExemptionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// namespace is the namespace for the DockerHub provider.
-	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// context is the context in which the exemption is updated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the exemption to update.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// justification is the new justification of the exemption.
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	// approver is the new approver of the exemption.
+	Approver string `protobuf:"bytes,4,opt,name=approver,proto3" json:"approver,omitempty"`
+	// expires_at is the new expiry of the exemption.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DockerHubProviderConfig) Reset() {
-	*x = DockerHubProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[103]
+func (x *UpdateRuleExemptionRequest) Reset() {
+	*x = UpdateRuleExemptionRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DockerHubProviderConfig) String() string {
+func (x *UpdateRuleExemptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DockerHubProviderConfig) ProtoMessage() {}
+func (*UpdateRuleExemptionRequest) ProtoMessage() {}
 
-func (x *DockerHubProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[103]
+func (x *UpdateRuleExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6635,44 +7057,69 @@ func (x *DockerHubProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DockerHubProviderConfig.ProtoReflect.Descriptor instead.
-func (*DockerHubProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use UpdateRuleExemptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRuleExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *DockerHubProviderConfig) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
+func (x *UpdateRuleExemptionRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateRuleExemptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-// GHCRProviderConfig contains the configuration for the GHCR provider.
-//
-// Namespace: is the namespace for the GHCR provider.
-type GHCRProviderConfig struct {
+func (x *UpdateRuleExemptionRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *UpdateRuleExemptionRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *UpdateRuleExemptionRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type UpdateRuleExemptionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// namespace is the namespace for the GHCR provider.
-	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// exemption is the updated exemption.
+	Exemption     *RuleExemption `protobuf:"bytes,1,opt,name=exemption,proto3" json:"exemption,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GHCRProviderConfig) Reset() {
-	*x = GHCRProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[104]
+func (x *UpdateRuleExemptionResponse) Reset() {
+	*x = UpdateRuleExemptionResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GHCRProviderConfig) String() string {
+func (x *UpdateRuleExemptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GHCRProviderConfig) ProtoMessage() {}
+func (*UpdateRuleExemptionResponse) ProtoMessage() {}
 
-func (x *GHCRProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[104]
+func (x *UpdateRuleExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6683,51 +7130,43 @@ func (x *GHCRProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GHCRProviderConfig.ProtoReflect.Descriptor instead.
-func (*GHCRProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use UpdateRuleExemptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRuleExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GHCRProviderConfig) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
+func (x *UpdateRuleExemptionResponse) GetExemption() *RuleExemption {
+	if x != nil {
+		return x.Exemption
 	}
-	return ""
+	return nil
 }
 
-// Context defines the context in which a rule is evaluated.
-// this normally refers to a combination of the provider, organization and project.
-type Context struct {
+type DeleteRuleExemptionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name of the provider
-	// This is optional, but some existing clients may set the field unconditionally,
-	// so an empty string is also an allowed value.
-	Provider *string `protobuf:"bytes,1,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
-	// ID or name of the project.  If empty or unset, will select the user's default
-	// project if they only have one project.  Existing clients may unconditionally set
-	// this to the empty string rather than leaving this unset, so we allow "" as an
-	// alias for unset.
-	Project             *string `protobuf:"bytes,3,opt,name=project,proto3,oneof" json:"project,omitempty"`
-	RetiredOrganization *string `protobuf:"bytes,2,opt,name=retired_organization,json=retiredOrganization,proto3,oneof" json:"retired_organization,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// context is the context in which the exemption is deleted.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the exemption to delete.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Context) Reset() {
-	*x = Context{}
-	mi := &file_minder_v1_minder_proto_msgTypes[105]
+func (x *DeleteRuleExemptionRequest) Reset() {
+	*x = DeleteRuleExemptionRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Context) String() string {
+func (x *DeleteRuleExemptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Context) ProtoMessage() {}
+func (*DeleteRuleExemptionRequest) ProtoMessage() {}
 
-func (x *Context) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[105]
+func (x *DeleteRuleExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6738,59 +7177,46 @@ func (x *Context) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Context.ProtoReflect.Descriptor instead.
-func (*Context) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{105}
-}
-
-func (x *Context) GetProvider() string {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
-	}
-	return ""
+// Deprecated: Use DeleteRuleExemptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *Context) GetProject() string {
-	if x != nil && x.Project != nil {
-		return *x.Project
+func (x *DeleteRuleExemptionRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *Context) GetRetiredOrganization() string {
-	if x != nil && x.RetiredOrganization != nil {
-		return *x.RetiredOrganization
+func (x *DeleteRuleExemptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-// ContextV2 defines the context in which a rule is evaluated.
-type ContextV2 struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project ID or name.  If empty or unset, will select the user's
-	// default project if they only have one project.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// name of the provider. Set to empty string when not applicable.
-	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+type DeleteRuleExemptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContextV2) Reset() {
-	*x = ContextV2{}
-	mi := &file_minder_v1_minder_proto_msgTypes[106]
+func (x *DeleteRuleExemptionResponse) Reset() {
+	*x = DeleteRuleExemptionResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContextV2) String() string {
+func (x *DeleteRuleExemptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContextV2) ProtoMessage() {}
+func (*DeleteRuleExemptionResponse) ProtoMessage() {}
 
-func (x *ContextV2) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[106]
+func (x *DeleteRuleExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6801,49 +7227,33 @@ func (x *ContextV2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContextV2.ProtoReflect.Descriptor instead.
-func (*ContextV2) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use DeleteRuleExemptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRuleExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ContextV2) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-func (x *ContextV2) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-// ListRuleTypesRequest is the request to list rule types.
-type ListRuleTypesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule types are evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+type EntityAutoRegistrationConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       *bool                  `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRuleTypesRequest) Reset() {
-	*x = ListRuleTypesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[107]
+func (x *EntityAutoRegistrationConfig) Reset() {
+	*x = EntityAutoRegistrationConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRuleTypesRequest) String() string {
+func (x *EntityAutoRegistrationConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRuleTypesRequest) ProtoMessage() {}
+func (*EntityAutoRegistrationConfig) ProtoMessage() {}
 
-func (x *ListRuleTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[107]
+func (x *EntityAutoRegistrationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6854,42 +7264,44 @@ func (x *ListRuleTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRuleTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListRuleTypesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use EntityAutoRegistrationConfig.ProtoReflect.Descriptor instead.
+func (*EntityAutoRegistrationConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *ListRuleTypesRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
+func (x *EntityAutoRegistrationConfig) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
 	}
-	return nil
+	return false
 }
 
-// ListRuleTypesResponse is the response to list rule types.
-type ListRuleTypesResponse struct {
+// AutoRegistration is the configuration for auto-registering entities.
+// When nothing is set, it means that auto-registration is disabled. There is no difference between disabled
+// and undefined so for the "let's not auto-register anything" case we'd just let the repeated string empty
+type AutoRegistration struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_types is the list of rule types.
-	RuleTypes     []*RuleType `protobuf:"bytes,1,rep,name=rule_types,json=ruleTypes,proto3" json:"rule_types,omitempty"`
+	// enabled is the list of entities that are enabled for auto-registration.
+	Entities      map[string]*EntityAutoRegistrationConfig `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRuleTypesResponse) Reset() {
-	*x = ListRuleTypesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[108]
+func (x *AutoRegistration) Reset() {
+	*x = AutoRegistration{}
+	mi := &file_minder_v1_minder_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRuleTypesResponse) String() string {
+func (x *AutoRegistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRuleTypesResponse) ProtoMessage() {}
+func (*AutoRegistration) ProtoMessage() {}
 
-func (x *ListRuleTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[108]
+func (x *AutoRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6900,44 +7312,42 @@ func (x *ListRuleTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRuleTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListRuleTypesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use AutoRegistration.ProtoReflect.Descriptor instead.
+func (*AutoRegistration) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ListRuleTypesResponse) GetRuleTypes() []*RuleType {
+func (x *AutoRegistration) GetEntities() map[string]*EntityAutoRegistrationConfig {
 	if x != nil {
-		return x.RuleTypes
+		return x.Entities
 	}
 	return nil
 }
 
-// GetRuleTypeByNameRequest is the request to get a rule type by name.
-type GetRuleTypeByNameRequest struct {
+// ProviderConfig contains the generic configuration for a provider.
+type ProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the rule type.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// auto_registration is the configuration for auto-registering entities.
+	AutoRegistration *AutoRegistration `protobuf:"bytes,1,opt,name=auto_registration,json=autoRegistration,proto3,oneof" json:"auto_registration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetRuleTypeByNameRequest) Reset() {
-	*x = GetRuleTypeByNameRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[109]
+func (x *ProviderConfig) Reset() {
+	*x = ProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleTypeByNameRequest) String() string {
+func (x *ProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleTypeByNameRequest) ProtoMessage() {}
+func (*ProviderConfig) ProtoMessage() {}
 
-func (x *GetRuleTypeByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[109]
+func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6948,49 +7358,42 @@ func (x *GetRuleTypeByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleTypeByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByNameRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use ProviderConfig.ProtoReflect.Descriptor instead.
+func (*ProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetRuleTypeByNameRequest) GetContext() *Context {
+func (x *ProviderConfig) GetAutoRegistration() *AutoRegistration {
 	if x != nil {
-		return x.Context
+		return x.AutoRegistration
 	}
 	return nil
 }
 
-func (x *GetRuleTypeByNameRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-// GetRuleTypeByNameResponse is the response to get a rule type by name.
-type GetRuleTypeByNameResponse struct {
+// RESTProviderConfig contains the configuration for the REST provider.
+type RESTProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// base_url is the base URL for the REST provider.
+	BaseUrl       *string `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleTypeByNameResponse) Reset() {
-	*x = GetRuleTypeByNameResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[110]
+func (x *RESTProviderConfig) Reset() {
+	*x = RESTProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleTypeByNameResponse) String() string {
+func (x *RESTProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleTypeByNameResponse) ProtoMessage() {}
+func (*RESTProviderConfig) ProtoMessage() {}
 
-func (x *GetRuleTypeByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[110]
+func (x *RESTProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7001,44 +7404,48 @@ func (x *GetRuleTypeByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleTypeByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByNameResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use RESTProviderConfig.ProtoReflect.Descriptor instead.
+func (*RESTProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetRuleTypeByNameResponse) GetRuleType() *RuleType {
-	if x != nil {
-		return x.RuleType
+func (x *RESTProviderConfig) GetBaseUrl() string {
+	if x != nil && x.BaseUrl != nil {
+		return *x.BaseUrl
 	}
-	return nil
+	return ""
 }
 
-// GetRuleTypeByIdRequest is the request to get a rule type by id.
-type GetRuleTypeByIdRequest struct {
+// GitHubProviderConfig contains the configuration for the GitHub client
+//
+// Endpoint: is the GitHub API endpoint
+//
+// If using the public GitHub API, Endpoint can be left blank
+// disable revive linting for this struct as there is nothing wrong with the
+// naming convention
+type GitHubProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the rule type.
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
+	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleTypeByIdRequest) Reset() {
-	*x = GetRuleTypeByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[111]
+func (x *GitHubProviderConfig) Reset() {
+	*x = GitHubProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleTypeByIdRequest) String() string {
+func (x *GitHubProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleTypeByIdRequest) ProtoMessage() {}
+func (*GitHubProviderConfig) ProtoMessage() {}
 
-func (x *GetRuleTypeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[111]
+func (x *GitHubProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7049,49 +7456,42 @@ func (x *GetRuleTypeByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleTypeByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{111}
-}
-
-func (x *GetRuleTypeByIdRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use GitHubProviderConfig.ProtoReflect.Descriptor instead.
+func (*GitHubProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetRuleTypeByIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *GitHubProviderConfig) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
 	}
 	return ""
 }
 
-// GetRuleTypeByIdResponse is the response to get a rule type by id.
-type GetRuleTypeByIdResponse struct {
+// GitHubAppProviderConfig contains the configuration for the GitHub App provider
+type GitHubAppProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
+	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleTypeByIdResponse) Reset() {
-	*x = GetRuleTypeByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[112]
+func (x *GitHubAppProviderConfig) Reset() {
+	*x = GitHubAppProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleTypeByIdResponse) String() string {
+func (x *GitHubAppProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleTypeByIdResponse) ProtoMessage() {}
+func (*GitHubAppProviderConfig) ProtoMessage() {}
 
-func (x *GetRuleTypeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[112]
+func (x *GitHubAppProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7102,42 +7502,48 @@ func (x *GetRuleTypeByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleTypeByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use GitHubAppProviderConfig.ProtoReflect.Descriptor instead.
+func (*GitHubAppProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetRuleTypeByIdResponse) GetRuleType() *RuleType {
-	if x != nil {
-		return x.RuleType
+func (x *GitHubAppProviderConfig) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-// CreateRuleTypeRequest is the request to create a rule type.
-type CreateRuleTypeRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type to be created.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
+// GitLabProviderConfig contains the configuration for the GitLab provider.
+//
+// Endpoint: is the GitLab API endpoint
+//
+// If using the public GitLab API, Endpoint can be left blank
+type GitLabProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Endpoint is the GitLab API endpoint. If using the public GitLab API, Endpoint can be left blank.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// group is the GitLab group to use for the provider
+	Group         string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRuleTypeRequest) Reset() {
-	*x = CreateRuleTypeRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[113]
+func (x *GitLabProviderConfig) Reset() {
+	*x = GitLabProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRuleTypeRequest) String() string {
+func (x *GitLabProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRuleTypeRequest) ProtoMessage() {}
+func (*GitLabProviderConfig) ProtoMessage() {}
 
-func (x *CreateRuleTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[113]
+func (x *GitLabProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7148,42 +7554,61 @@ func (x *CreateRuleTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRuleTypeRequest.ProtoReflect.Descriptor instead.
-func (*CreateRuleTypeRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use GitLabProviderConfig.ProtoReflect.Descriptor instead.
+func (*GitLabProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *CreateRuleTypeRequest) GetRuleType() *RuleType {
+func (x *GitLabProviderConfig) GetEndpoint() string {
 	if x != nil {
-		return x.RuleType
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-// CreateRuleTypeResponse is the response to create a rule type.
-type CreateRuleTypeResponse struct {
+func (x *GitLabProviderConfig) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// BitbucketProviderConfig contains the configuration for the Bitbucket provider.
+//
+// Endpoint: is the Bitbucket API endpoint
+//
+// If using Bitbucket Cloud, Endpoint can be left blank
+type BitbucketProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type that was created.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// Endpoint is the Bitbucket API endpoint. If using Bitbucket Cloud, Endpoint can be left blank.
+	// For Bitbucket Server / Data Center this is the base URL of the REST API,
+	// e.g. https://bitbucket.example.com/rest/api/1.0
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// deployment is the type of Bitbucket deployment, either "cloud" or "server".
+	// Defaults to "cloud".
+	Deployment string `protobuf:"bytes,2,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	// workspace is the Bitbucket Cloud workspace or Bitbucket Server project key
+	// used to scope the repositories listed by the provider.
+	Workspace     string `protobuf:"bytes,3,opt,name=workspace,proto3" json:"workspace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRuleTypeResponse) Reset() {
-	*x = CreateRuleTypeResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[114]
+func (x *BitbucketProviderConfig) Reset() {
+	*x = BitbucketProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRuleTypeResponse) String() string {
+func (x *BitbucketProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRuleTypeResponse) ProtoMessage() {}
+func (*BitbucketProviderConfig) ProtoMessage() {}
 
-func (x *CreateRuleTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[114]
+func (x *BitbucketProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7194,42 +7619,65 @@ func (x *CreateRuleTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRuleTypeResponse.ProtoReflect.Descriptor instead.
-func (*CreateRuleTypeResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use BitbucketProviderConfig.ProtoReflect.Descriptor instead.
+func (*BitbucketProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *CreateRuleTypeResponse) GetRuleType() *RuleType {
+func (x *BitbucketProviderConfig) GetEndpoint() string {
 	if x != nil {
-		return x.RuleType
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-// UpdateRuleTypeRequest is the request to update a rule type.
-type UpdateRuleTypeRequest struct {
+func (x *BitbucketProviderConfig) GetDeployment() string {
+	if x != nil {
+		return x.Deployment
+	}
+	return ""
+}
+
+func (x *BitbucketProviderConfig) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+// GiteaProviderConfig contains the configuration for the Gitea provider.
+// Forgejo is API-compatible with Gitea and uses the same configuration.
+//
+// Endpoint: is the Gitea API endpoint
+//
+// If using Codeberg, Endpoint can be left blank
+type GiteaProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type to be updated.
-	RuleType      *RuleType `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// Endpoint is the Gitea API endpoint, e.g. https://git.example.com/api/v1
+	// If using Codeberg, Endpoint can be left blank.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// organization is the Gitea organization used to scope the
+	// repositories listed by the provider.
+	Organization  string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRuleTypeRequest) Reset() {
-	*x = UpdateRuleTypeRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[115]
+func (x *GiteaProviderConfig) Reset() {
+	*x = GiteaProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRuleTypeRequest) String() string {
+func (x *GiteaProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRuleTypeRequest) ProtoMessage() {}
+func (*GiteaProviderConfig) ProtoMessage() {}
 
-func (x *UpdateRuleTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[115]
+func (x *GiteaProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7240,42 +7688,51 @@ func (x *UpdateRuleTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRuleTypeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRuleTypeRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use GiteaProviderConfig.ProtoReflect.Descriptor instead.
+func (*GiteaProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *UpdateRuleTypeRequest) GetRuleType() *RuleType {
+func (x *GiteaProviderConfig) GetEndpoint() string {
 	if x != nil {
-		return x.RuleType
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-// UpdateRuleTypeResponse is the response to update a rule type.
-type UpdateRuleTypeResponse struct {
+func (x *GiteaProviderConfig) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+// DockerHubProviderConfig contains the configuration for the DockerHub provider.
+//
+// Namespace: is the namespace for the DockerHub provider.
+type DockerHubProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type that was updated.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// namespace is the namespace for the DockerHub provider.
+	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRuleTypeResponse) Reset() {
-	*x = UpdateRuleTypeResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[116]
+func (x *DockerHubProviderConfig) Reset() {
+	*x = DockerHubProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRuleTypeResponse) String() string {
+func (x *DockerHubProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRuleTypeResponse) ProtoMessage() {}
+func (*DockerHubProviderConfig) ProtoMessage() {}
 
-func (x *UpdateRuleTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[116]
+func (x *DockerHubProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7286,44 +7743,44 @@ func (x *UpdateRuleTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRuleTypeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRuleTypeResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use DockerHubProviderConfig.ProtoReflect.Descriptor instead.
+func (*DockerHubProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *UpdateRuleTypeResponse) GetRuleType() *RuleType {
-	if x != nil {
-		return x.RuleType
+func (x *DockerHubProviderConfig) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
-	return nil
+	return ""
 }
 
-// DeleteRuleTypeRequest is the request to delete a rule type.
-type DeleteRuleTypeRequest struct {
+// GHCRProviderConfig contains the configuration for the GHCR provider.
+//
+// Namespace: is the namespace for the GHCR provider.
+type GHCRProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the name or id of the rule type to be deleted.
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// namespace is the namespace for the GHCR provider.
+	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRuleTypeRequest) Reset() {
-	*x = DeleteRuleTypeRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[117]
+func (x *GHCRProviderConfig) Reset() {
+	*x = GHCRProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRuleTypeRequest) String() string {
+func (x *GHCRProviderConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRuleTypeRequest) ProtoMessage() {}
+func (*GHCRProviderConfig) ProtoMessage() {}
 
-func (x *DeleteRuleTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[117]
+func (x *GHCRProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7334,47 +7791,51 @@ func (x *DeleteRuleTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRuleTypeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRuleTypeRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{117}
-}
-
-func (x *DeleteRuleTypeRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use GHCRProviderConfig.ProtoReflect.Descriptor instead.
+func (*GHCRProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *DeleteRuleTypeRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *GHCRProviderConfig) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
-// DeleteRuleTypeResponse is the response to delete a rule type.
-type DeleteRuleTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Context defines the context in which a rule is evaluated.
+// this normally refers to a combination of the provider, organization and project.
+type Context struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the provider
+	// This is optional, but some existing clients may set the field unconditionally,
+	// so an empty string is also an allowed value.
+	Provider *string `protobuf:"bytes,1,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	// ID or name of the project.  If empty or unset, will select the user's default
+	// project if they only have one project.  Existing clients may unconditionally set
+	// this to the empty string rather than leaving this unset, so we allow "" as an
+	// alias for unset.
+	Project             *string `protobuf:"bytes,3,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	RetiredOrganization *string `protobuf:"bytes,2,opt,name=retired_organization,json=retiredOrganization,proto3,oneof" json:"retired_organization,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *DeleteRuleTypeResponse) Reset() {
-	*x = DeleteRuleTypeResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[118]
+func (x *Context) Reset() {
+	*x = Context{}
+	mi := &file_minder_v1_minder_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRuleTypeResponse) String() string {
+func (x *Context) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRuleTypeResponse) ProtoMessage() {}
+func (*Context) ProtoMessage() {}
 
-func (x *DeleteRuleTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[118]
+func (x *Context) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7385,54 +7846,59 @@ func (x *DeleteRuleTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRuleTypeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRuleTypeResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use Context.ProtoReflect.Descriptor instead.
+func (*Context) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{122}
 }
 
-type ListEvaluationResultsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the evaluation results are evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// profile is the profile name or ID to retrieve results for.
-	// If empty, return evaluation results for profiles selected by
-	// an empty label_filter.
-	//
-	// Types that are valid to be assigned to ProfileSelector:
-	//
-	//	*ListEvaluationResultsRequest_Profile
-	//	*ListEvaluationResultsRequest_LabelFilter
-	ProfileSelector isListEvaluationResultsRequest_ProfileSelector `protobuf_oneof:"profile_selector"`
-	// If set, only return evaluation results for the named entities.
-	// If empty, return evaluation results for all entities
-	Entity []*EntityTypedId `protobuf:"bytes,4,rep,name=entity,proto3" json:"entity,omitempty"`
-	// If set, only return evaluation results for the named rules.
-	// If empty, return evaluation results for all rules
-	RuleName []string `protobuf:"bytes,5,rep,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
-	// If true, include structured rule output for the matched evaluations.
-	// Not all ruletypes may generate structured outputs.
-	// Because the evaluation output may be large, it is only returned
-	// when explicitly requested.
-	IncludeOutputs bool `protobuf:"varint,6,opt,name=include_outputs,json=includeOutputs,proto3" json:"include_outputs,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Context) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
 }
 
-func (x *ListEvaluationResultsRequest) Reset() {
-	*x = ListEvaluationResultsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[119]
+func (x *Context) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+func (x *Context) GetRetiredOrganization() string {
+	if x != nil && x.RetiredOrganization != nil {
+		return *x.RetiredOrganization
+	}
+	return ""
+}
+
+// ContextV2 defines the context in which a rule is evaluated.
+type ContextV2 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project ID or name.  If empty or unset, will select the user's
+	// default project if they only have one project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// name of the provider. Set to empty string when not applicable.
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContextV2) Reset() {
+	*x = ContextV2{}
+	mi := &file_minder_v1_minder_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsRequest) String() string {
+func (x *ContextV2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsRequest) ProtoMessage() {}
+func (*ContextV2) ProtoMessage() {}
 
-func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[119]
+func (x *ContextV2) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7443,109 +7909,95 @@ func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsRequest.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{119}
-}
-
-func (x *ListEvaluationResultsRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use ContextV2.ProtoReflect.Descriptor instead.
+func (*ContextV2) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *ListEvaluationResultsRequest) GetProfileSelector() isListEvaluationResultsRequest_ProfileSelector {
+func (x *ContextV2) GetProjectId() string {
 	if x != nil {
-		return x.ProfileSelector
+		return x.ProjectId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEvaluationResultsRequest) GetProfile() string {
+func (x *ContextV2) GetProvider() string {
 	if x != nil {
-		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_Profile); ok {
-			return x.Profile
-		}
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *ListEvaluationResultsRequest) GetLabelFilter() string {
-	if x != nil {
-		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_LabelFilter); ok {
-			return x.LabelFilter
-		}
-	}
-	return ""
+// ListRuleTypesRequest is the request to list rule types.
+type ListRuleTypesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule types are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsRequest) GetEntity() []*EntityTypedId {
-	if x != nil {
-		return x.Entity
-	}
-	return nil
+func (x *ListRuleTypesRequest) Reset() {
+	*x = ListRuleTypesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsRequest) GetRuleName() []string {
-	if x != nil {
-		return x.RuleName
-	}
-	return nil
+func (x *ListRuleTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListEvaluationResultsRequest) GetIncludeOutputs() bool {
+func (*ListRuleTypesRequest) ProtoMessage() {}
+
+func (x *ListRuleTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[124]
 	if x != nil {
-		return x.IncludeOutputs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
-}
-
-type isListEvaluationResultsRequest_ProfileSelector interface {
-	isListEvaluationResultsRequest_ProfileSelector()
+	return mi.MessageOf(x)
 }
 
-type ListEvaluationResultsRequest_Profile struct {
-	// ID can contain either a profile name or an ID.
-	Profile string `protobuf:"bytes,2,opt,name=profile,proto3,oneof"`
+// Deprecated: Use ListRuleTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListRuleTypesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{124}
 }
 
-type ListEvaluationResultsRequest_LabelFilter struct {
-	// Filter profiles to only those matching the specified labels.
-	//
-	// The default is to return all user-created profiles; the string "*" can
-	// be used to select all profiles, including system profiles.  This syntax
-	// may be expanded in the future.
-	LabelFilter string `protobuf:"bytes,3,opt,name=label_filter,json=labelFilter,proto3,oneof"`
+func (x *ListRuleTypesRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
 }
 
-func (*ListEvaluationResultsRequest_Profile) isListEvaluationResultsRequest_ProfileSelector() {}
-
-func (*ListEvaluationResultsRequest_LabelFilter) isListEvaluationResultsRequest_ProfileSelector() {}
-
-type ListEvaluationResultsResponse struct {
+// ListRuleTypesResponse is the response to list rule types.
+type ListRuleTypesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Each entity selected by the list request will have _single_ entry in entities which contains results of all evaluations for each profile.
-	Entities      []*ListEvaluationResultsResponse_EntityEvaluationResults `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	// rule_types is the list of rule types.
+	RuleTypes     []*RuleType `protobuf:"bytes,1,rep,name=rule_types,json=ruleTypes,proto3" json:"rule_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsResponse) Reset() {
-	*x = ListEvaluationResultsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[120]
+func (x *ListRuleTypesResponse) Reset() {
+	*x = ListRuleTypesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsResponse) String() string {
+func (x *ListRuleTypesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsResponse) ProtoMessage() {}
+func (*ListRuleTypesResponse) ProtoMessage() {}
 
-func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[120]
+func (x *ListRuleTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7556,62 +8008,44 @@ func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsResponse.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use ListRuleTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListRuleTypesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *ListEvaluationResultsResponse) GetEntities() []*ListEvaluationResultsResponse_EntityEvaluationResults {
+func (x *ListRuleTypesResponse) GetRuleTypes() []*RuleType {
 	if x != nil {
-		return x.Entities
+		return x.RuleTypes
 	}
 	return nil
 }
 
-// RestType defines the rest data evaluation.
-// This is used to fetch data from a REST endpoint.
-type RestType struct {
+// GetRuleTypeByNameRequest is the request to get a rule type by name.
+type GetRuleTypeByNameRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// endpoint is the endpoint to fetch data from.
-	// This can be a URL or path on the API.
-	// This is a required field and must be set.
-	// This is also evaluated via a template which allows
-	// us dynamically fill in the values.
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	// method is the method to use to fetch data.
-	// Go templates may be used to vary the method using the same parameters
-	// as the endpoint.
-	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	// headers are the headers to be sent to the endpoint.
-	Headers []string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
-	// body is the body to be sent to the endpoint, which must be valid JSON
-	// Go templates may be used to vary the method using the same parameters
-	// as the endpoint.
-	Body *string `protobuf:"bytes,4,opt,name=body,proto3,oneof" json:"body,omitempty"`
-	// parse is the parsing mechanism to be used to parse the data.
-	Parse string `protobuf:"bytes,5,opt,name=parse,proto3" json:"parse,omitempty"`
-	// fallback provides a body that the ingester would return in case
-	// the REST call returns a non-200 status code.
-	Fallback      []*RestType_Fallback `protobuf:"bytes,6,rep,name=fallback,proto3" json:"fallback,omitempty"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the rule type.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestType) Reset() {
-	*x = RestType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[121]
+func (x *GetRuleTypeByNameRequest) Reset() {
+	*x = GetRuleTypeByNameRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestType) String() string {
+func (x *GetRuleTypeByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestType) ProtoMessage() {}
+func (*GetRuleTypeByNameRequest) ProtoMessage() {}
 
-func (x *RestType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[121]
+func (x *GetRuleTypeByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7622,76 +8056,97 @@ func (x *RestType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestType.ProtoReflect.Descriptor instead.
-func (*RestType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use GetRuleTypeByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByNameRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *RestType) GetEndpoint() string {
+func (x *GetRuleTypeByNameRequest) GetContext() *Context {
 	if x != nil {
-		return x.Endpoint
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *RestType) GetMethod() string {
+func (x *GetRuleTypeByNameRequest) GetName() string {
 	if x != nil {
-		return x.Method
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RestType) GetHeaders() []string {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
-}
-
-func (x *RestType) GetBody() string {
-	if x != nil && x.Body != nil {
-		return *x.Body
-	}
-	return ""
+// GetRuleTypeByNameResponse is the response to get a rule type by name.
+type GetRuleTypeByNameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestType) GetParse() string {
+func (x *GetRuleTypeByNameResponse) Reset() {
+	*x = GetRuleTypeByNameResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleTypeByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleTypeByNameResponse) ProtoMessage() {}
+
+func (x *GetRuleTypeByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[127]
 	if x != nil {
-		return x.Parse
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RestType) GetFallback() []*RestType_Fallback {
+// Deprecated: Use GetRuleTypeByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByNameResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetRuleTypeByNameResponse) GetRuleType() *RuleType {
 	if x != nil {
-		return x.Fallback
+		return x.RuleType
 	}
 	return nil
 }
 
-// BuiltinType defines the builtin data evaluation.
-type BuiltinType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+// GetRuleTypeByIdRequest is the request to get a rule type by id.
+type GetRuleTypeByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the id of the rule type.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuiltinType) Reset() {
-	*x = BuiltinType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[122]
+func (x *GetRuleTypeByIdRequest) Reset() {
+	*x = GetRuleTypeByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuiltinType) String() string {
+func (x *GetRuleTypeByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuiltinType) ProtoMessage() {}
+func (*GetRuleTypeByIdRequest) ProtoMessage() {}
 
-func (x *BuiltinType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[122]
+func (x *GetRuleTypeByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7702,40 +8157,49 @@ func (x *BuiltinType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuiltinType.ProtoReflect.Descriptor instead.
-func (*BuiltinType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use GetRuleTypeByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *BuiltinType) GetMethod() string {
+func (x *GetRuleTypeByIdRequest) GetContext() *Context {
 	if x != nil {
-		return x.Method
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetRuleTypeByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-// ArtifactType defines the artifact data evaluation.
-type ArtifactType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// GetRuleTypeByIdResponse is the response to get a rule type by id.
+type GetRuleTypeByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArtifactType) Reset() {
-	*x = ArtifactType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[123]
+func (x *GetRuleTypeByIdResponse) Reset() {
+	*x = GetRuleTypeByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArtifactType) String() string {
+func (x *GetRuleTypeByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArtifactType) ProtoMessage() {}
+func (*GetRuleTypeByIdResponse) ProtoMessage() {}
 
-func (x *ArtifactType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[123]
+func (x *GetRuleTypeByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7746,37 +8210,42 @@ func (x *ArtifactType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArtifactType.ProtoReflect.Descriptor instead.
-func (*ArtifactType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use GetRuleTypeByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{129}
 }
 
-// GitType defines the git data ingester.
-type GitType struct {
+func (x *GetRuleTypeByIdResponse) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
+
+// CreateRuleTypeRequest is the request to create a rule type.
+type CreateRuleTypeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// clone_url is the url of the git repository.
-	CloneUrl string `protobuf:"bytes,1,opt,name=clone_url,json=cloneUrl,proto3" json:"clone_url,omitempty"`
-	// branch is the branch of the git repository.
-	Branch        string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// rule_type is the rule type to be created.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitType) Reset() {
-	*x = GitType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[124]
+func (x *CreateRuleTypeRequest) Reset() {
+	*x = CreateRuleTypeRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitType) String() string {
+func (x *CreateRuleTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitType) ProtoMessage() {}
+func (*CreateRuleTypeRequest) ProtoMessage() {}
 
-func (x *GitType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[124]
+func (x *CreateRuleTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7787,54 +8256,42 @@ func (x *GitType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitType.ProtoReflect.Descriptor instead.
-func (*GitType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{124}
-}
-
-func (x *GitType) GetCloneUrl() string {
-	if x != nil {
-		return x.CloneUrl
-	}
-	return ""
+// Deprecated: Use CreateRuleTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRuleTypeRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *GitType) GetBranch() string {
+func (x *CreateRuleTypeRequest) GetRuleType() *RuleType {
 	if x != nil {
-		return x.Branch
+		return x.RuleType
 	}
-	return ""
+	return nil
 }
 
-// DiffType defines the diff data ingester.
-type DiffType struct {
+// CreateRuleTypeResponse is the response to create a rule type.
+type CreateRuleTypeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ecosystems is the list of ecosystems to be used
-	// for the "dep" diff type.
-	Ecosystems []*DiffType_Ecosystem `protobuf:"bytes,1,rep,name=ecosystems,proto3" json:"ecosystems,omitempty"`
-	// type is the type of diff ingestor to use.
-	// The default is "dep" which will leverage
-	// the ecosystems array.
-	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// rule_type is the rule type that was created.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiffType) Reset() {
-	*x = DiffType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[125]
+func (x *CreateRuleTypeResponse) Reset() {
+	*x = CreateRuleTypeResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiffType) String() string {
+func (x *CreateRuleTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffType) ProtoMessage() {}
+func (*CreateRuleTypeResponse) ProtoMessage() {}
 
-func (x *DiffType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[125]
+func (x *CreateRuleTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7845,53 +8302,42 @@ func (x *DiffType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffType.ProtoReflect.Descriptor instead.
-func (*DiffType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use CreateRuleTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateRuleTypeResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *DiffType) GetEcosystems() []*DiffType_Ecosystem {
+func (x *CreateRuleTypeResponse) GetRuleType() *RuleType {
 	if x != nil {
-		return x.Ecosystems
+		return x.RuleType
 	}
 	return nil
 }
 
-func (x *DiffType) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-// DepsType defines the "deps" ingester which can extract depndencies in protobom
-// format for rule evaluation.
-type DepsType struct {
+// UpdateRuleTypeRequest is the request to update a rule type.
+type UpdateRuleTypeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to EntityType:
-	//
-	//	*DepsType_Repo
-	//	*DepsType_Pr
-	EntityType    isDepsType_EntityType `protobuf_oneof:"entity_type"`
+	// rule_type is the rule type to be updated.
+	RuleType      *RuleType `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepsType) Reset() {
-	*x = DepsType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[126]
+func (x *UpdateRuleTypeRequest) Reset() {
+	*x = UpdateRuleTypeRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepsType) String() string {
+func (x *UpdateRuleTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepsType) ProtoMessage() {}
+func (*UpdateRuleTypeRequest) ProtoMessage() {}
 
-func (x *DepsType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[126]
+func (x *UpdateRuleTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7902,76 +8348,90 @@ func (x *DepsType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepsType.ProtoReflect.Descriptor instead.
-func (*DepsType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126}
-}
-
-func (x *DepsType) GetEntityType() isDepsType_EntityType {
-	if x != nil {
-		return x.EntityType
-	}
-	return nil
+// Deprecated: Use UpdateRuleTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRuleTypeRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *DepsType) GetRepo() *DepsType_RepoConfigs {
+func (x *UpdateRuleTypeRequest) GetRuleType() *RuleType {
 	if x != nil {
-		if x, ok := x.EntityType.(*DepsType_Repo); ok {
-			return x.Repo
-		}
+		return x.RuleType
 	}
 	return nil
 }
 
-func (x *DepsType) GetPr() *DepsType_PullRequestConfigs {
-	if x != nil {
-		if x, ok := x.EntityType.(*DepsType_Pr); ok {
-			return x.Pr
-		}
-	}
-	return nil
+// UpdateRuleTypeResponse is the response to update a rule type.
+type UpdateRuleTypeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type that was updated.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type isDepsType_EntityType interface {
-	isDepsType_EntityType()
+func (x *UpdateRuleTypeResponse) Reset() {
+	*x = UpdateRuleTypeResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type DepsType_Repo struct {
-	Repo *DepsType_RepoConfigs `protobuf:"bytes,1,opt,name=repo,proto3,oneof"`
+func (x *UpdateRuleTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type DepsType_Pr struct {
-	Pr *DepsType_PullRequestConfigs `protobuf:"bytes,2,opt,name=pr,proto3,oneof"`
+func (*UpdateRuleTypeResponse) ProtoMessage() {}
+
+func (x *UpdateRuleTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*DepsType_Repo) isDepsType_EntityType() {}
+// Deprecated: Use UpdateRuleTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRuleTypeResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{133}
+}
 
-func (*DepsType_Pr) isDepsType_EntityType() {}
+func (x *UpdateRuleTypeResponse) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
 
-// Severity defines the severity of the rule.
-type Severity struct {
+// DeleteRuleTypeRequest is the request to delete a rule type.
+type DeleteRuleTypeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// value is the severity value.
-	Value         Severity_Value `protobuf:"varint,1,opt,name=value,proto3,enum=minder.v1.Severity_Value" json:"value,omitempty"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the name or id of the rule type to be deleted.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Severity) Reset() {
-	*x = Severity{}
-	mi := &file_minder_v1_minder_proto_msgTypes[127]
+func (x *DeleteRuleTypeRequest) Reset() {
+	*x = DeleteRuleTypeRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Severity) String() string {
+func (x *DeleteRuleTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Severity) ProtoMessage() {}
+func (*DeleteRuleTypeRequest) ProtoMessage() {}
 
-func (x *Severity) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[127]
+func (x *DeleteRuleTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7982,68 +8442,47 @@ func (x *Severity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Severity.ProtoReflect.Descriptor instead.
-func (*Severity) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use DeleteRuleTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleTypeRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *Severity) GetValue() Severity_Value {
+func (x *DeleteRuleTypeRequest) GetContext() *Context {
 	if x != nil {
-		return x.Value
+		return x.Context
 	}
-	return Severity_VALUE_UNSPECIFIED
+	return nil
 }
 
-// RuleType defines rules that may or may not be user defined.
-// The version is assumed from the folder's version.
-type RuleType struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// version is the version of the rule type API.
-	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
-	// type is the type of the rule.
-	Type string `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
-	// id is the id of the rule type.
-	// This is mostly optional and is set by the server.
-	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	// name is the name of the rule type.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// display_name is the display name of the rule type.
-	DisplayName string `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// short_failure_message is the message to display when the evaluation fails.
-	ShortFailureMessage string `protobuf:"bytes,10,opt,name=short_failure_message,json=shortFailureMessage,proto3" json:"short_failure_message,omitempty"`
-	// context is the context in which the rule is evaluated.
-	Context *Context `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
-	// def is the definition of the rule type.
-	Def *RuleType_Definition `protobuf:"bytes,4,opt,name=def,proto3" json:"def,omitempty"`
-	// description is the description of the rule type.
-	// This is expected to be a valid markdown formatted string.
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	// guidance are instructions we give the user in case a rule fails.
-	// This is expected to be a valid markdown formatted string.
-	Guidance string `protobuf:"bytes,6,opt,name=guidance,proto3" json:"guidance,omitempty"`
-	// severity is the severity of the rule type.
-	Severity *Severity `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
-	// release_phase is the release phase of the rule type, i.e. alpha, beta, ga, deprecated.
-	ReleasePhase  RuleTypeReleasePhase `protobuf:"varint,9,opt,name=release_phase,json=releasePhase,proto3,enum=minder.v1.RuleTypeReleasePhase" json:"release_phase,omitempty"`
+func (x *DeleteRuleTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteRuleTypeResponse is the response to delete a rule type.
+type DeleteRuleTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType) Reset() {
-	*x = RuleType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[128]
+func (x *DeleteRuleTypeResponse) Reset() {
+	*x = DeleteRuleTypeResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType) String() string {
+func (x *DeleteRuleTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType) ProtoMessage() {}
+func (*DeleteRuleTypeResponse) ProtoMessage() {}
 
-func (x *RuleType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[128]
+func (x *DeleteRuleTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8054,160 +8493,167 @@ func (x *RuleType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType.ProtoReflect.Descriptor instead.
-func (*RuleType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use DeleteRuleTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRuleTypeResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *RuleType) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+type ListEvaluationResultsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the evaluation results are evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// profile is the profile name or ID to retrieve results for.
+	// If empty, return evaluation results for profiles selected by
+	// an empty label_filter.
+	//
+	// Types that are valid to be assigned to ProfileSelector:
+	//
+	//	*ListEvaluationResultsRequest_Profile
+	//	*ListEvaluationResultsRequest_LabelFilter
+	ProfileSelector isListEvaluationResultsRequest_ProfileSelector `protobuf_oneof:"profile_selector"`
+	// If set, only return evaluation results for the named entities.
+	// If empty, return evaluation results for all entities
+	Entity []*EntityTypedId `protobuf:"bytes,4,rep,name=entity,proto3" json:"entity,omitempty"`
+	// If set, only return evaluation results for the named rules.
+	// If empty, return evaluation results for all rules
+	RuleName []string `protobuf:"bytes,5,rep,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	// If true, include structured rule output for the matched evaluations.
+	// Not all ruletypes may generate structured outputs.
+	// Because the evaluation output may be large, it is only returned
+	// when explicitly requested.
+	IncludeOutputs bool `protobuf:"varint,6,opt,name=include_outputs,json=includeOutputs,proto3" json:"include_outputs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RuleType) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+func (x *ListEvaluationResultsRequest) Reset() {
+	*x = ListEvaluationResultsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return ""
+func (x *ListEvaluationResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleType) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
+func (*ListEvaluationResultsRequest) ProtoMessage() {}
 
-func (x *RuleType) GetDisplayName() string {
+func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[136]
 	if x != nil {
-		return x.DisplayName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RuleType) GetShortFailureMessage() string {
-	if x != nil {
-		return x.ShortFailureMessage
-	}
-	return ""
+// Deprecated: Use ListEvaluationResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *RuleType) GetContext() *Context {
+func (x *ListEvaluationResultsRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *RuleType) GetDef() *RuleType_Definition {
+func (x *ListEvaluationResultsRequest) GetProfileSelector() isListEvaluationResultsRequest_ProfileSelector {
 	if x != nil {
-		return x.Def
+		return x.ProfileSelector
 	}
 	return nil
 }
 
-func (x *RuleType) GetDescription() string {
+func (x *ListEvaluationResultsRequest) GetProfile() string {
 	if x != nil {
-		return x.Description
+		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_Profile); ok {
+			return x.Profile
+		}
 	}
 	return ""
 }
 
-func (x *RuleType) GetGuidance() string {
+func (x *ListEvaluationResultsRequest) GetLabelFilter() string {
 	if x != nil {
-		return x.Guidance
+		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_LabelFilter); ok {
+			return x.LabelFilter
+		}
 	}
 	return ""
 }
 
-func (x *RuleType) GetSeverity() *Severity {
+func (x *ListEvaluationResultsRequest) GetEntity() []*EntityTypedId {
 	if x != nil {
-		return x.Severity
+		return x.Entity
 	}
 	return nil
 }
 
-func (x *RuleType) GetReleasePhase() RuleTypeReleasePhase {
+func (x *ListEvaluationResultsRequest) GetRuleName() []string {
 	if x != nil {
-		return x.ReleasePhase
+		return x.RuleName
 	}
-	return RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_UNSPECIFIED
+	return nil
 }
 
-// Profile defines a profile that is user defined.
-// All fields are optional because we want to allow partial updates.
-type Profile struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the profile is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the profile.
-	// This is optional and is set by the system.
-	Id *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	// name is the name of the profile instance.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// labels are a set of system-provided attributes which can be used to
-	// filter profiles and status results.  Labels cannot be set by the user,
-	// but are returned in ListProfiles.
-	//
-	// Labels use DNS label constraints, with a possible namespace prefix
-	// separated by a colon (:).  They are intended to allow filtering, but
-	// not to store arbitrary metadata.
-	// DNS labels are 1-63 character alphanumeric strings with internal hyphens.
-	// An RE2-style validation regex would be:
-	//
-	// DNS_STR = "[a-zA-Z0-9](?[-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?"
-	// ($DNS_STR:)?$DNS_STR
-	Labels []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`
-	// These are the entities that one could set in the profile.
-	Repository       []*Profile_Rule     `protobuf:"bytes,4,rep,name=repository,proto3" json:"repository,omitempty"`
-	BuildEnvironment []*Profile_Rule     `protobuf:"bytes,5,rep,name=build_environment,json=buildEnvironment,proto3" json:"build_environment,omitempty"`
-	Artifact         []*Profile_Rule     `protobuf:"bytes,6,rep,name=artifact,proto3" json:"artifact,omitempty"`
-	PullRequest      []*Profile_Rule     `protobuf:"bytes,7,rep,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
-	Release          []*Profile_Rule     `protobuf:"bytes,15,rep,name=release,proto3" json:"release,omitempty"`
-	PipelineRun      []*Profile_Rule     `protobuf:"bytes,16,rep,name=pipeline_run,json=pipelineRun,proto3" json:"pipeline_run,omitempty"`
-	TaskRun          []*Profile_Rule     `protobuf:"bytes,17,rep,name=task_run,json=taskRun,proto3" json:"task_run,omitempty"`
-	Build            []*Profile_Rule     `protobuf:"bytes,18,rep,name=build,proto3" json:"build,omitempty"`
-	Selection        []*Profile_Selector `protobuf:"bytes,14,rep,name=selection,proto3" json:"selection,omitempty"`
-	// whether and how to remediate (on,off,dry_run)
-	// this is optional and defaults to "off"
-	Remediate *string `protobuf:"bytes,8,opt,name=remediate,proto3,oneof" json:"remediate,omitempty"`
-	// whether and how to alert (on,off,dry_run)
-	// this is optional and defaults to "on"
-	Alert *string `protobuf:"bytes,9,opt,name=alert,proto3,oneof" json:"alert,omitempty"`
-	// type is a placeholder for the object type. It should always be set to "profile".
-	Type string `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
-	// version is the version of the profile type. In this case, it is "v1"
-	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
-	// display_name is the display name of the profile.
-	DisplayName   string `protobuf:"bytes,13,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+func (x *ListEvaluationResultsRequest) GetIncludeOutputs() bool {
+	if x != nil {
+		return x.IncludeOutputs
+	}
+	return false
+}
+
+type isListEvaluationResultsRequest_ProfileSelector interface {
+	isListEvaluationResultsRequest_ProfileSelector()
+}
+
+type ListEvaluationResultsRequest_Profile struct {
+	// ID can contain either a profile name or an ID.
+	Profile string `protobuf:"bytes,2,opt,name=profile,proto3,oneof"`
+}
+
+type ListEvaluationResultsRequest_LabelFilter struct {
+	// Filter profiles to only those matching the specified labels.
+	//
+	// The default is to return all user-created profiles; the string "*" can
+	// be used to select all profiles, including system profiles.  This syntax
+	// may be expanded in the future.
+	LabelFilter string `protobuf:"bytes,3,opt,name=label_filter,json=labelFilter,proto3,oneof"`
+}
+
+func (*ListEvaluationResultsRequest_Profile) isListEvaluationResultsRequest_ProfileSelector() {}
+
+func (*ListEvaluationResultsRequest_LabelFilter) isListEvaluationResultsRequest_ProfileSelector() {}
+
+type ListEvaluationResultsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Each entity selected by the list request will have _single_ entry in entities which contains results of all evaluations for each profile.
+	Entities      []*ListEvaluationResultsResponse_EntityEvaluationResults `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Profile) Reset() {
-	*x = Profile{}
-	mi := &file_minder_v1_minder_proto_msgTypes[129]
+func (x *ListEvaluationResultsResponse) Reset() {
+	*x = ListEvaluationResultsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) String() string {
+func (x *ListEvaluationResultsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile) ProtoMessage() {}
+func (*ListEvaluationResultsResponse) ProtoMessage() {}
 
-func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[129]
+func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8218,158 +8664,152 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
-func (*Profile) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use ListEvaluationResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *Profile) GetContext() *Context {
+func (x *ListEvaluationResultsResponse) GetEntities() []*ListEvaluationResultsResponse_EntityEvaluationResults {
 	if x != nil {
-		return x.Context
+		return x.Entities
 	}
 	return nil
 }
 
-func (x *Profile) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return ""
-}
-
-func (x *Profile) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GenerateComplianceReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the project to report on.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// format is the format of the report.
+	Format ComplianceReportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=minder.v1.ComplianceReportFormat" json:"format,omitempty"`
+	// profile_selector selects the profiles to report on. The default is
+	// to report on all user-created profiles.
+	//
+	// Types that are valid to be assigned to ProfileSelector:
+	//
+	//	*GenerateComplianceReportRequest_Profile
+	//	*GenerateComplianceReportRequest_LabelFilter
+	ProfileSelector isGenerateComplianceReportRequest_ProfileSelector `protobuf_oneof:"profile_selector"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Profile) GetLabels() []string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+func (x *GenerateComplianceReportRequest) Reset() {
+	*x = GenerateComplianceReportRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) GetRepository() []*Profile_Rule {
-	if x != nil {
-		return x.Repository
-	}
-	return nil
+func (x *GenerateComplianceReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Profile) GetBuildEnvironment() []*Profile_Rule {
-	if x != nil {
-		return x.BuildEnvironment
-	}
-	return nil
-}
+func (*GenerateComplianceReportRequest) ProtoMessage() {}
 
-func (x *Profile) GetArtifact() []*Profile_Rule {
+func (x *GenerateComplianceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[138]
 	if x != nil {
-		return x.Artifact
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Profile) GetPullRequest() []*Profile_Rule {
-	if x != nil {
-		return x.PullRequest
-	}
-	return nil
+// Deprecated: Use GenerateComplianceReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateComplianceReportRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *Profile) GetRelease() []*Profile_Rule {
+func (x *GenerateComplianceReportRequest) GetContext() *Context {
 	if x != nil {
-		return x.Release
+		return x.Context
 	}
 	return nil
 }
 
-func (x *Profile) GetPipelineRun() []*Profile_Rule {
+func (x *GenerateComplianceReportRequest) GetFormat() ComplianceReportFormat {
 	if x != nil {
-		return x.PipelineRun
+		return x.Format
 	}
-	return nil
+	return ComplianceReportFormat_COMPLIANCE_REPORT_FORMAT_UNSPECIFIED
 }
 
-func (x *Profile) GetTaskRun() []*Profile_Rule {
+func (x *GenerateComplianceReportRequest) GetProfileSelector() isGenerateComplianceReportRequest_ProfileSelector {
 	if x != nil {
-		return x.TaskRun
+		return x.ProfileSelector
 	}
 	return nil
 }
 
-func (x *Profile) GetBuild() []*Profile_Rule {
+func (x *GenerateComplianceReportRequest) GetProfile() string {
 	if x != nil {
-		return x.Build
+		if x, ok := x.ProfileSelector.(*GenerateComplianceReportRequest_Profile); ok {
+			return x.Profile
+		}
 	}
-	return nil
+	return ""
 }
 
-func (x *Profile) GetSelection() []*Profile_Selector {
+func (x *GenerateComplianceReportRequest) GetLabelFilter() string {
 	if x != nil {
-		return x.Selection
-	}
-	return nil
-}
-
-func (x *Profile) GetRemediate() string {
-	if x != nil && x.Remediate != nil {
-		return *x.Remediate
+		if x, ok := x.ProfileSelector.(*GenerateComplianceReportRequest_LabelFilter); ok {
+			return x.LabelFilter
+		}
 	}
 	return ""
 }
 
-func (x *Profile) GetAlert() string {
-	if x != nil && x.Alert != nil {
-		return *x.Alert
-	}
-	return ""
+type isGenerateComplianceReportRequest_ProfileSelector interface {
+	isGenerateComplianceReportRequest_ProfileSelector()
 }
 
-func (x *Profile) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+type GenerateComplianceReportRequest_Profile struct {
+	// profile is the ID of a profile to report on.
+	Profile string `protobuf:"bytes,3,opt,name=profile,proto3,oneof"`
 }
 
-func (x *Profile) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+type GenerateComplianceReportRequest_LabelFilter struct {
+	// Report only on profiles matching the specified labels, with the
+	// same syntax as ListEvaluationResultsRequest.
+	LabelFilter string `protobuf:"bytes,4,opt,name=label_filter,json=labelFilter,proto3,oneof"`
 }
 
-func (x *Profile) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+func (*GenerateComplianceReportRequest_Profile) isGenerateComplianceReportRequest_ProfileSelector() {}
+
+func (*GenerateComplianceReportRequest_LabelFilter) isGenerateComplianceReportRequest_ProfileSelector() {
 }
 
-type ListProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GenerateComplianceReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// content is the report, in the requested format.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// content_type is the MIME type of the report.
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// generated_at is the time the snapshot was taken.
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsRequest) Reset() {
-	*x = ListProjectsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[130]
+func (x *GenerateComplianceReportResponse) Reset() {
+	*x = GenerateComplianceReportResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsRequest) String() string {
+func (x *GenerateComplianceReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsRequest) ProtoMessage() {}
+func (*GenerateComplianceReportResponse) ProtoMessage() {}
 
-func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[130]
+func (x *GenerateComplianceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8380,80 +8820,76 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{130}
-}
-
-type ListProjectsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListProjectsResponse) Reset() {
-	*x = ListProjectsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[131]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListProjectsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GenerateComplianceReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateComplianceReportResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{139}
 }
 
-func (*ListProjectsResponse) ProtoMessage() {}
-
-func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[131]
+func (x *GenerateComplianceReportResponse) GetContent() []byte {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Content
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{131}
+func (x *GenerateComplianceReportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (x *ListProjectsResponse) GetProjects() []*Project {
+func (x *GenerateComplianceReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Projects
+		return x.GeneratedAt
 	}
 	return nil
 }
 
-type CreateProjectRequest struct {
+// RestType defines the rest data evaluation.
+// This is used to fetch data from a REST endpoint.
+type RestType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is created.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the project to create.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[132]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateProjectRequest) String() string {
+	// endpoint is the endpoint to fetch data from.
+	// This can be a URL or path on the API.
+	// This is a required field and must be set.
+	// This is also evaluated via a template which allows
+	// us dynamically fill in the values.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// method is the method to use to fetch data.
+	// Go templates may be used to vary the method using the same parameters
+	// as the endpoint.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// headers are the headers to be sent to the endpoint.
+	Headers []string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	// body is the body to be sent to the endpoint, which must be valid JSON
+	// Go templates may be used to vary the method using the same parameters
+	// as the endpoint.
+	Body *string `protobuf:"bytes,4,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// parse is the parsing mechanism to be used to parse the data.
+	Parse string `protobuf:"bytes,5,opt,name=parse,proto3" json:"parse,omitempty"`
+	// fallback provides a body that the ingester would return in case
+	// the REST call returns a non-200 status code.
+	Fallback      []*RestType_Fallback `protobuf:"bytes,6,rep,name=fallback,proto3" json:"fallback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestType) Reset() {
+	*x = RestType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*RestType) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[132]
+func (x *RestType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8464,93 +8900,76 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use RestType.ProtoReflect.Descriptor instead.
+func (*RestType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *CreateProjectRequest) GetContext() *Context {
+func (x *RestType) GetEndpoint() string {
 	if x != nil {
-		return x.Context
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateProjectRequest) GetName() string {
+func (x *RestType) GetMethod() string {
 	if x != nil {
-		return x.Name
+		return x.Method
 	}
 	return ""
 }
 
-type CreateProjectResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project that was created.
-	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[133]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RestType) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
 }
 
-func (x *CreateProjectResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RestType) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
-
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[133]
+func (x *RestType) GetParse() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Parse
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{133}
+	return ""
 }
 
-func (x *CreateProjectResponse) GetProject() *Project {
+func (x *RestType) GetFallback() []*RestType_Fallback {
 	if x != nil {
-		return x.Project
+		return x.Fallback
 	}
 	return nil
 }
 
-type DeleteProjectRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is deleted.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+// BuiltinType defines the builtin data evaluation.
+type BuiltinType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectRequest) Reset() {
-	*x = DeleteProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[134]
+func (x *BuiltinType) Reset() {
+	*x = BuiltinType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectRequest) String() string {
+func (x *BuiltinType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectRequest) ProtoMessage() {}
+func (*BuiltinType) ProtoMessage() {}
 
-func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[134]
+func (x *BuiltinType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8561,41 +8980,40 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use BuiltinType.ProtoReflect.Descriptor instead.
+func (*BuiltinType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *DeleteProjectRequest) GetContext() *Context {
+func (x *BuiltinType) GetMethod() string {
 	if x != nil {
-		return x.Context
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-type DeleteProjectResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// project_id is the id of the project that was deleted.
-	ProjectId     string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+// ArtifactType defines the artifact data evaluation.
+type ArtifactType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectResponse) Reset() {
-	*x = DeleteProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[135]
+func (x *ArtifactType) Reset() {
+	*x = ArtifactType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectResponse) String() string {
+func (x *ArtifactType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectResponse) ProtoMessage() {}
+func (*ArtifactType) ProtoMessage() {}
 
-func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[135]
+func (x *ArtifactType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8606,47 +9024,37 @@ func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{135}
-}
-
-func (x *DeleteProjectResponse) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use ArtifactType.ProtoReflect.Descriptor instead.
+func (*ArtifactType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{142}
 }
 
-type UpdateProjectRequest struct {
+// GitType defines the git data ingester.
+type GitType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is updated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// display_name is the display name of the project to update.
-	// This is optional.
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// description is the description of the project to update.
-	// This is optional.
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// clone_url is the url of the git repository.
+	CloneUrl string `protobuf:"bytes,1,opt,name=clone_url,json=cloneUrl,proto3" json:"clone_url,omitempty"`
+	// branch is the branch of the git repository.
+	Branch        string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectRequest) Reset() {
-	*x = UpdateProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[136]
+func (x *GitType) Reset() {
+	*x = GitType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectRequest) String() string {
+func (x *GitType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectRequest) ProtoMessage() {}
+func (*GitType) ProtoMessage() {}
 
-func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[136]
+func (x *GitType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8657,55 +9065,54 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{136}
-}
-
-func (x *UpdateProjectRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use GitType.ProtoReflect.Descriptor instead.
+func (*GitType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *UpdateProjectRequest) GetDisplayName() string {
+func (x *GitType) GetCloneUrl() string {
 	if x != nil {
-		return x.DisplayName
+		return x.CloneUrl
 	}
 	return ""
 }
 
-func (x *UpdateProjectRequest) GetDescription() string {
+func (x *GitType) GetBranch() string {
 	if x != nil {
-		return x.Description
+		return x.Branch
 	}
 	return ""
 }
 
-type UpdateProjectResponse struct {
+// DiffType defines the diff data ingester.
+type DiffType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project that was updated.
-	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// ecosystems is the list of ecosystems to be used
+	// for the "dep" diff type.
+	Ecosystems []*DiffType_Ecosystem `protobuf:"bytes,1,rep,name=ecosystems,proto3" json:"ecosystems,omitempty"`
+	// type is the type of diff ingestor to use.
+	// The default is "dep" which will leverage
+	// the ecosystems array.
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectResponse) Reset() {
-	*x = UpdateProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[137]
+func (x *DiffType) Reset() {
+	*x = DiffType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectResponse) String() string {
+func (x *DiffType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectResponse) ProtoMessage() {}
+func (*DiffType) ProtoMessage() {}
 
-func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[137]
+func (x *DiffType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8716,43 +9123,53 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use DiffType.ProtoReflect.Descriptor instead.
+func (*DiffType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *UpdateProjectResponse) GetProject() *Project {
+func (x *DiffType) GetEcosystems() []*DiffType_Ecosystem {
 	if x != nil {
-		return x.Project
+		return x.Ecosystems
 	}
 	return nil
 }
 
-type ProjectPatch struct {
+func (x *DiffType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// DepsType defines the "deps" ingester which can extract depndencies in protobom
+// format for rule evaluation.
+type DepsType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// display_name is the display name of the project to update.
-	DisplayName *string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
-	// description is the description of the project to update.
-	Description   *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Types that are valid to be assigned to EntityType:
+	//
+	//	*DepsType_Repo
+	//	*DepsType_Pr
+	EntityType    isDepsType_EntityType `protobuf_oneof:"entity_type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectPatch) Reset() {
-	*x = ProjectPatch{}
-	mi := &file_minder_v1_minder_proto_msgTypes[138]
+func (x *DepsType) Reset() {
+	*x = DepsType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectPatch) String() string {
+func (x *DepsType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectPatch) ProtoMessage() {}
+func (*DepsType) ProtoMessage() {}
 
-func (x *ProjectPatch) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[138]
+func (x *DepsType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8763,53 +9180,89 @@ func (x *ProjectPatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectPatch.ProtoReflect.Descriptor instead.
-func (*ProjectPatch) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use DepsType.ProtoReflect.Descriptor instead.
+func (*DepsType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *ProjectPatch) GetDisplayName() string {
-	if x != nil && x.DisplayName != nil {
-		return *x.DisplayName
+func (x *DepsType) GetEntityType() isDepsType_EntityType {
+	if x != nil {
+		return x.EntityType
 	}
-	return ""
+	return nil
 }
 
-func (x *ProjectPatch) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *DepsType) GetRepo() *DepsType_RepoConfigs {
+	if x != nil {
+		if x, ok := x.EntityType.(*DepsType_Repo); ok {
+			return x.Repo
+		}
 	}
-	return ""
+	return nil
 }
 
-type PatchProjectRequest struct {
+func (x *DepsType) GetPr() *DepsType_PullRequestConfigs {
+	if x != nil {
+		if x, ok := x.EntityType.(*DepsType_Pr); ok {
+			return x.Pr
+		}
+	}
+	return nil
+}
+
+type isDepsType_EntityType interface {
+	isDepsType_EntityType()
+}
+
+type DepsType_Repo struct {
+	Repo *DepsType_RepoConfigs `protobuf:"bytes,1,opt,name=repo,proto3,oneof"`
+}
+
+type DepsType_Pr struct {
+	Pr *DepsType_PullRequestConfigs `protobuf:"bytes,2,opt,name=pr,proto3,oneof"`
+}
+
+func (*DepsType_Repo) isDepsType_EntityType() {}
+
+func (*DepsType_Pr) isDepsType_EntityType() {}
+
+// SBOMType defines the "sbom" ingester which presents a standards-compliant
+// SBOM document (CycloneDX or SPDX) for rule evaluation.
+type SBOMType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is updated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// patch is the patch to apply to the project
-	Patch *ProjectPatch `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
-	// needed to enable PATCH, see https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/patch_feature/
-	// is not exposed to the API user
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// source is where the SBOM comes from. "scan" (the default) generates it by
+	// scanning a clone of the repository for dependencies, "repository" reads a
+	// document checked into the repository and "attestation" reads a verified
+	// SBOM attestation of a container artifact.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// format is the format of the document presented to the rule, either
+	// "cyclonedx" (the default) or "spdx". Documents are converted if needed.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// path is the path of the SBOM document within the repository when the
+	// source is "repository". If unset, a few well-known file names are tried.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// branch is the branch of the git repository for the "scan" and
+	// "repository" sources. Defaults to the repository's default branch.
+	Branch        string `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProjectRequest) Reset() {
-	*x = PatchProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[139]
+func (x *SBOMType) Reset() {
+	*x = SBOMType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProjectRequest) String() string {
+func (x *SBOMType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProjectRequest) ProtoMessage() {}
+func (*SBOMType) ProtoMessage() {}
 
-func (x *PatchProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[139]
+func (x *SBOMType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8820,55 +9273,63 @@ func (x *PatchProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProjectRequest.ProtoReflect.Descriptor instead.
-func (*PatchProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use SBOMType.ProtoReflect.Descriptor instead.
+func (*SBOMType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *PatchProjectRequest) GetContext() *Context {
+func (x *SBOMType) GetSource() string {
 	if x != nil {
-		return x.Context
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *PatchProjectRequest) GetPatch() *ProjectPatch {
+func (x *SBOMType) GetFormat() string {
 	if x != nil {
-		return x.Patch
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-func (x *PatchProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *SBOMType) GetPath() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-type PatchProjectResponse struct {
+func (x *SBOMType) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+// Severity defines the severity of the rule.
+type Severity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project that was updated.
-	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// value is the severity value.
+	Value         Severity_Value `protobuf:"varint,1,opt,name=value,proto3,enum=minder.v1.Severity_Value" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProjectResponse) Reset() {
-	*x = PatchProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[140]
+func (x *Severity) Reset() {
+	*x = Severity{}
+	mi := &file_minder_v1_minder_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProjectResponse) String() string {
+func (x *Severity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProjectResponse) ProtoMessage() {}
+func (*Severity) ProtoMessage() {}
 
-func (x *PatchProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[140]
+func (x *Severity) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8879,43 +9340,68 @@ func (x *PatchProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProjectResponse.ProtoReflect.Descriptor instead.
-func (*PatchProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use Severity.ProtoReflect.Descriptor instead.
+func (*Severity) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *PatchProjectResponse) GetProject() *Project {
+func (x *Severity) GetValue() Severity_Value {
 	if x != nil {
-		return x.Project
+		return x.Value
 	}
-	return nil
+	return Severity_VALUE_UNSPECIFIED
 }
 
-type ListChildProjectsRequest struct {
+// RuleType defines rules that may or may not be user defined.
+// The version is assumed from the folder's version.
+type RuleType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the child projects are listed.
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// recursive is true if child projects should be listed recursively.
-	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	// version is the version of the rule type API.
+	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
+	// type is the type of the rule.
+	Type string `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	// id is the id of the rule type.
+	// This is mostly optional and is set by the server.
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// name is the name of the rule type.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// display_name is the display name of the rule type.
+	DisplayName string `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// short_failure_message is the message to display when the evaluation fails.
+	ShortFailureMessage string `protobuf:"bytes,10,opt,name=short_failure_message,json=shortFailureMessage,proto3" json:"short_failure_message,omitempty"`
+	// context is the context in which the rule is evaluated.
+	Context *Context `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// def is the definition of the rule type.
+	Def *RuleType_Definition `protobuf:"bytes,4,opt,name=def,proto3" json:"def,omitempty"`
+	// description is the description of the rule type.
+	// This is expected to be a valid markdown formatted string.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// guidance are instructions we give the user in case a rule fails.
+	// This is expected to be a valid markdown formatted string.
+	Guidance string `protobuf:"bytes,6,opt,name=guidance,proto3" json:"guidance,omitempty"`
+	// severity is the severity of the rule type.
+	Severity *Severity `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
+	// release_phase is the release phase of the rule type, i.e. alpha, beta, ga, deprecated.
+	ReleasePhase  RuleTypeReleasePhase `protobuf:"varint,9,opt,name=release_phase,json=releasePhase,proto3,enum=minder.v1.RuleTypeReleasePhase" json:"release_phase,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildProjectsRequest) Reset() {
-	*x = ListChildProjectsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[141]
+func (x *RuleType) Reset() {
+	*x = RuleType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildProjectsRequest) String() string {
+func (x *RuleType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildProjectsRequest) ProtoMessage() {}
+func (*RuleType) ProtoMessage() {}
 
-func (x *ListChildProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[141]
+func (x *RuleType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8926,47 +9412,3297 @@ func (x *ListChildProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListChildProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use RuleType.ProtoReflect.Descriptor instead.
+func (*RuleType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *ListChildProjectsRequest) GetContext() *ContextV2 {
+func (x *RuleType) GetVersion() string {
 	if x != nil {
-		return x.Context
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *ListChildProjectsRequest) GetRecursive() bool {
+func (x *RuleType) GetType() string {
 	if x != nil {
-		return x.Recursive
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-type ListChildProjectsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RuleType) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
 }
 
-func (x *ListChildProjectsResponse) Reset() {
-	*x = ListChildProjectsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[142]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RuleType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ListChildProjectsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleType) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
 }
 
-func (*ListChildProjectsResponse) ProtoMessage() {}
-
-func (x *ListChildProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[142]
+func (x *RuleType) GetShortFailureMessage() string {
+	if x != nil {
+		return x.ShortFailureMessage
+	}
+	return ""
+}
+
+func (x *RuleType) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RuleType) GetDef() *RuleType_Definition {
+	if x != nil {
+		return x.Def
+	}
+	return nil
+}
+
+func (x *RuleType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RuleType) GetGuidance() string {
+	if x != nil {
+		return x.Guidance
+	}
+	return ""
+}
+
+func (x *RuleType) GetSeverity() *Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return nil
+}
+
+func (x *RuleType) GetReleasePhase() RuleTypeReleasePhase {
+	if x != nil {
+		return x.ReleasePhase
+	}
+	return RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_UNSPECIFIED
+}
+
+// Profile defines a profile that is user defined.
+// All fields are optional because we want to allow partial updates.
+type Profile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the profile is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the id of the profile.
+	// This is optional and is set by the system.
+	Id *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// name is the name of the profile instance.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// labels are a set of system-provided attributes which can be used to
+	// filter profiles and status results.  Labels cannot be set by the user,
+	// but are returned in ListProfiles.
+	//
+	// Labels use DNS label constraints, with a possible namespace prefix
+	// separated by a colon (:).  They are intended to allow filtering, but
+	// not to store arbitrary metadata.
+	// DNS labels are 1-63 character alphanumeric strings with internal hyphens.
+	// An RE2-style validation regex would be:
+	//
+	// DNS_STR = "[a-zA-Z0-9](?[-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?"
+	// ($DNS_STR:)?$DNS_STR
+	Labels []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`
+	// These are the entities that one could set in the profile.
+	Repository       []*Profile_Rule     `protobuf:"bytes,4,rep,name=repository,proto3" json:"repository,omitempty"`
+	BuildEnvironment []*Profile_Rule     `protobuf:"bytes,5,rep,name=build_environment,json=buildEnvironment,proto3" json:"build_environment,omitempty"`
+	Artifact         []*Profile_Rule     `protobuf:"bytes,6,rep,name=artifact,proto3" json:"artifact,omitempty"`
+	PullRequest      []*Profile_Rule     `protobuf:"bytes,7,rep,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	Release          []*Profile_Rule     `protobuf:"bytes,15,rep,name=release,proto3" json:"release,omitempty"`
+	PipelineRun      []*Profile_Rule     `protobuf:"bytes,16,rep,name=pipeline_run,json=pipelineRun,proto3" json:"pipeline_run,omitempty"`
+	TaskRun          []*Profile_Rule     `protobuf:"bytes,17,rep,name=task_run,json=taskRun,proto3" json:"task_run,omitempty"`
+	Build            []*Profile_Rule     `protobuf:"bytes,18,rep,name=build,proto3" json:"build,omitempty"`
+	Selection        []*Profile_Selector `protobuf:"bytes,14,rep,name=selection,proto3" json:"selection,omitempty"`
+	// whether and how to remediate (on,off,dry_run)
+	// this is optional and defaults to "off"
+	Remediate *string `protobuf:"bytes,8,opt,name=remediate,proto3,oneof" json:"remediate,omitempty"`
+	// whether and how to alert (on,off,dry_run)
+	// this is optional and defaults to "on"
+	Alert *string `protobuf:"bytes,9,opt,name=alert,proto3,oneof" json:"alert,omitempty"`
+	// type is a placeholder for the object type. It should always be set to "profile".
+	Type string `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	// version is the version of the profile type. In this case, it is "v1"
+	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
+	// display_name is the display name of the profile.
+	DisplayName string `protobuf:"bytes,13,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// schedule is the background re-evaluation schedule of the profile.
+	// This is optional.
+	Schedule      *Profile_Schedule `protobuf:"bytes,19,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_minder_v1_minder_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *Profile) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *Profile) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Profile) GetRepository() []*Profile_Rule {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *Profile) GetBuildEnvironment() []*Profile_Rule {
+	if x != nil {
+		return x.BuildEnvironment
+	}
+	return nil
+}
+
+func (x *Profile) GetArtifact() []*Profile_Rule {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *Profile) GetPullRequest() []*Profile_Rule {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *Profile) GetRelease() []*Profile_Rule {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *Profile) GetPipelineRun() []*Profile_Rule {
+	if x != nil {
+		return x.PipelineRun
+	}
+	return nil
+}
+
+func (x *Profile) GetTaskRun() []*Profile_Rule {
+	if x != nil {
+		return x.TaskRun
+	}
+	return nil
+}
+
+func (x *Profile) GetBuild() []*Profile_Rule {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *Profile) GetSelection() []*Profile_Selector {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *Profile) GetRemediate() string {
+	if x != nil && x.Remediate != nil {
+		return *x.Remediate
+	}
+	return ""
+}
+
+func (x *Profile) GetAlert() string {
+	if x != nil && x.Alert != nil {
+		return *x.Alert
+	}
+	return ""
+}
+
+func (x *Profile) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Profile) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetSchedule() *Profile_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{150}
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type CreateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the project is created.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the project to create.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *CreateProjectRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project that was created.
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the project is deleted.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *DeleteProjectRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DeleteProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project_id is the id of the project that was deleted.
+	ProjectId     string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DeleteProjectResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type UpdateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the project is updated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// display_name is the display name of the project to update.
+	// This is optional.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// description is the description of the project to update.
+	// This is optional.
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *UpdateProjectRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project that was updated.
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectResponse) Reset() {
+	*x = UpdateProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectResponse) ProtoMessage() {}
+
+func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *UpdateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ProjectPatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// display_name is the display name of the project to update.
+	DisplayName *string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	// description is the description of the project to update.
+	Description   *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectPatch) Reset() {
+	*x = ProjectPatch{}
+	mi := &file_minder_v1_minder_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectPatch) ProtoMessage() {}
+
+func (x *ProjectPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectPatch.ProtoReflect.Descriptor instead.
+func (*ProjectPatch) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ProjectPatch) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *ProjectPatch) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type PatchProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the project is updated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// patch is the patch to apply to the project
+	Patch *ProjectPatch `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	// needed to enable PATCH, see https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/patch_feature/
+	// is not exposed to the API user
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchProjectRequest) Reset() {
+	*x = PatchProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchProjectRequest) ProtoMessage() {}
+
+func (x *PatchProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchProjectRequest.ProtoReflect.Descriptor instead.
+func (*PatchProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *PatchProjectRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *PatchProjectRequest) GetPatch() *ProjectPatch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *PatchProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type PatchProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project that was updated.
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchProjectResponse) Reset() {
+	*x = PatchProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchProjectResponse) ProtoMessage() {}
+
+func (x *PatchProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchProjectResponse.ProtoReflect.Descriptor instead.
+func (*PatchProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *PatchProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ListChildProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the child projects are listed.
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// recursive is true if child projects should be listed recursively.
+	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildProjectsRequest) Reset() {
+	*x = ListChildProjectsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildProjectsRequest) ProtoMessage() {}
+
+func (x *ListChildProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListChildProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListChildProjectsRequest) GetContext() *ContextV2 {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListChildProjectsRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type ListChildProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildProjectsResponse) Reset() {
+	*x = ListChildProjectsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildProjectsResponse) ProtoMessage() {}
+
+func (x *ListChildProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListChildProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListChildProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// AlertWebhook is a destination for alerts of type webhook. The URL
+// and signing secret are stored encrypted and never returned.
+type AlertWebhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the alert webhook.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name used to refer to the webhook in rule types.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// context is the context in which the alert webhook is defined.
+	Context *Context `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// has_signing_secret is true if payloads sent to the webhook are signed.
+	HasSigningSecret bool `protobuf:"varint,4,opt,name=has_signing_secret,json=hasSigningSecret,proto3" json:"has_signing_secret,omitempty"`
+	// created_at is the time the alert webhook was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertWebhook) Reset() {
+	*x = AlertWebhook{}
+	mi := &file_minder_v1_minder_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertWebhook) ProtoMessage() {}
+
+func (x *AlertWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertWebhook.ProtoReflect.Descriptor instead.
+func (*AlertWebhook) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *AlertWebhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertWebhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertWebhook) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *AlertWebhook) GetHasSigningSecret() bool {
+	if x != nil {
+		return x.HasSigningSecret
+	}
+	return false
+}
+
+func (x *AlertWebhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAlertWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the alert webhook is created.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the alert webhook. It must be unique in the project.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// url is the URL alerts are posted to, e.g. a Slack or Teams
+	// incoming webhook URL.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// signing_secret is an optional secret used to sign the payloads.
+	// The signature is sent in the X-Minder-Signature header as
+	// sha256=<hex encoded HMAC>.
+	SigningSecret string `protobuf:"bytes,4,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertWebhookRequest) Reset() {
+	*x = CreateAlertWebhookRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertWebhookRequest) ProtoMessage() {}
+
+func (x *CreateAlertWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *CreateAlertWebhookRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateAlertWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAlertWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateAlertWebhookRequest) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+type CreateAlertWebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// alert_webhook is the alert webhook that was created.
+	AlertWebhook  *AlertWebhook `protobuf:"bytes,1,opt,name=alert_webhook,json=alertWebhook,proto3" json:"alert_webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertWebhookResponse) Reset() {
+	*x = CreateAlertWebhookResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertWebhookResponse) ProtoMessage() {}
+
+func (x *CreateAlertWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *CreateAlertWebhookResponse) GetAlertWebhook() *AlertWebhook {
+	if x != nil {
+		return x.AlertWebhook
+	}
+	return nil
+}
+
+type ListAlertWebhooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the alert webhooks are listed.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertWebhooksRequest) Reset() {
+	*x = ListAlertWebhooksRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertWebhooksRequest) ProtoMessage() {}
+
+func (x *ListAlertWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *ListAlertWebhooksRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ListAlertWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// alert_webhooks are the alert webhooks of the project.
+	AlertWebhooks []*AlertWebhook `protobuf:"bytes,1,rep,name=alert_webhooks,json=alertWebhooks,proto3" json:"alert_webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertWebhooksResponse) Reset() {
+	*x = ListAlertWebhooksResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertWebhooksResponse) ProtoMessage() {}
+
+func (x *ListAlertWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ListAlertWebhooksResponse) GetAlertWebhooks() []*AlertWebhook {
+	if x != nil {
+		return x.AlertWebhooks
+	}
+	return nil
+}
+
+type DeleteAlertWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the alert webhook is deleted.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the alert webhook to delete.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertWebhookRequest) Reset() {
+	*x = DeleteAlertWebhookRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteAlertWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *DeleteAlertWebhookRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *DeleteAlertWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAlertWebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the alert webhook that was deleted.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertWebhookResponse) Reset() {
+	*x = DeleteAlertWebhookResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteAlertWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *DeleteAlertWebhookResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ProjectSyncSource is a git repository the rule types, profiles and data
+// sources of a project are kept in sync with. The resources are laid out
+// as in a bundle: in rule_types, profiles and data_sources directories.
+type ProjectSyncSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is the name of the git provider used to clone the repository.
+	// If empty, the only git provider of the project is used.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// repository is the https URL of the repository. The repository is
+	// looked up through the git provider, and must be hosted by it.
+	Repository string `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	// branch is the branch synced from. If empty, the default branch is used.
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// path is the directory of the repository holding the resources. If
+	// empty, the root of the repository is used.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// interval is the time between two syncs, as a duration such as 15m.
+	// If empty, the project is synced every hour.
+	Interval      string `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectSyncSource) Reset() {
+	*x = ProjectSyncSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectSyncSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectSyncSource) ProtoMessage() {}
+
+func (x *ProjectSyncSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectSyncSource.ProtoReflect.Descriptor instead.
+func (*ProjectSyncSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *ProjectSyncSource) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProjectSyncSource) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ProjectSyncSource) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ProjectSyncSource) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProjectSyncSource) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+// ProjectSyncDrift is a difference found between the repository and the
+// project, and the change the sync applied to reconcile it.
+type ProjectSyncDrift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is the kind of the resource, one of rule_type, profile or data_source.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name is the name of the resource.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// action is the change applied, one of create, update or delete.
+	Action        string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectSyncDrift) Reset() {
+	*x = ProjectSyncDrift{}
+	mi := &file_minder_v1_minder_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectSyncDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectSyncDrift) ProtoMessage() {}
+
+func (x *ProjectSyncDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectSyncDrift.ProtoReflect.Descriptor instead.
+func (*ProjectSyncDrift) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ProjectSyncDrift) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ProjectSyncDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectSyncDrift) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// ProjectSyncStatus is the outcome of the last sync of a project.
+type ProjectSyncStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// source is the repository the project is synced from.
+	Source *ProjectSyncSource `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// status is one of pending, success or failure.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// message describes why the last sync failed.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// commit is the commit of the repository the project was last synced to.
+	Commit string `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
+	// last_sync_at is the time of the last sync.
+	LastSyncAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_sync_at,json=lastSyncAt,proto3" json:"last_sync_at,omitempty"`
+	// next_sync_at is the time of the next sync.
+	NextSyncAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_sync_at,json=nextSyncAt,proto3" json:"next_sync_at,omitempty"`
+	// drift lists the changes the last sync applied to the project.
+	Drift         []*ProjectSyncDrift `protobuf:"bytes,7,rep,name=drift,proto3" json:"drift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectSyncStatus) Reset() {
+	*x = ProjectSyncStatus{}
+	mi := &file_minder_v1_minder_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectSyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectSyncStatus) ProtoMessage() {}
+
+func (x *ProjectSyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectSyncStatus.ProtoReflect.Descriptor instead.
+func (*ProjectSyncStatus) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *ProjectSyncStatus) GetSource() *ProjectSyncSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ProjectSyncStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProjectSyncStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProjectSyncStatus) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ProjectSyncStatus) GetLastSyncAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncAt
+	}
+	return nil
+}
+
+func (x *ProjectSyncStatus) GetNextSyncAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextSyncAt
+	}
+	return nil
+}
+
+func (x *ProjectSyncStatus) GetDrift() []*ProjectSyncDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+type SetProjectSyncSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the project to sync.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// source is the repository to sync the project from.
+	Source        *ProjectSyncSource `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProjectSyncSourceRequest) Reset() {
+	*x = SetProjectSyncSourceRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProjectSyncSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectSyncSourceRequest) ProtoMessage() {}
+
+func (x *SetProjectSyncSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectSyncSourceRequest.ProtoReflect.Descriptor instead.
+func (*SetProjectSyncSourceRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *SetProjectSyncSourceRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SetProjectSyncSourceRequest) GetSource() *ProjectSyncSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type SetProjectSyncSourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is the status of the project sync, pending until it runs.
+	Status        *ProjectSyncStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProjectSyncSourceResponse) Reset() {
+	*x = SetProjectSyncSourceResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProjectSyncSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectSyncSourceResponse) ProtoMessage() {}
+
+func (x *SetProjectSyncSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectSyncSourceResponse.ProtoReflect.Descriptor instead.
+func (*SetProjectSyncSourceResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *SetProjectSyncSourceResponse) GetStatus() *ProjectSyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetProjectSyncStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the synced project.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectSyncStatusRequest) Reset() {
+	*x = GetProjectSyncStatusRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectSyncStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectSyncStatusRequest) ProtoMessage() {}
+
+func (x *GetProjectSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *GetProjectSyncStatusRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type GetProjectSyncStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is the status of the project sync.
+	Status        *ProjectSyncStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectSyncStatusResponse) Reset() {
+	*x = GetProjectSyncStatusResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectSyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectSyncStatusResponse) ProtoMessage() {}
+
+func (x *GetProjectSyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectSyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *GetProjectSyncStatusResponse) GetStatus() *ProjectSyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteProjectSyncSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the synced project.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectSyncSourceRequest) Reset() {
+	*x = DeleteProjectSyncSourceRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectSyncSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectSyncSourceRequest) ProtoMessage() {}
+
+func (x *DeleteProjectSyncSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectSyncSourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectSyncSourceRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *DeleteProjectSyncSourceRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DeleteProjectSyncSourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectSyncSourceResponse) Reset() {
+	*x = DeleteProjectSyncSourceResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectSyncSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectSyncSourceResponse) ProtoMessage() {}
+
+func (x *DeleteProjectSyncSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectSyncSourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectSyncSourceResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{178}
+}
+
+// MarketplaceBundle is a bundle of rule types, profiles and data sources
+// available in the marketplace, and the subscription of the project to it.
+type MarketplaceBundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// namespace is the namespace of the bundle.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the bundle.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// versions lists the versions of the bundle available, latest first.
+	Versions []string `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+	// subscribed_version is the version of the bundle the project is
+	// subscribed to. It is empty if the project is not subscribed.
+	SubscribedVersion string `protobuf:"bytes,4,opt,name=subscribed_version,json=subscribedVersion,proto3" json:"subscribed_version,omitempty"`
+	// previous_version is the version of the bundle the project was
+	// subscribed to before its last upgrade, or downgrade, which a rollback
+	// restores. It is empty if the subscription was never upgraded.
+	PreviousVersion string `protobuf:"bytes,5,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarketplaceBundle) Reset() {
+	*x = MarketplaceBundle{}
+	mi := &file_minder_v1_minder_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketplaceBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketplaceBundle) ProtoMessage() {}
+
+func (x *MarketplaceBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketplaceBundle.ProtoReflect.Descriptor instead.
+func (*MarketplaceBundle) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *MarketplaceBundle) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *MarketplaceBundle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarketplaceBundle) GetVersions() []string {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *MarketplaceBundle) GetSubscribedVersion() string {
+	if x != nil {
+		return x.SubscribedVersion
+	}
+	return ""
+}
+
+func (x *MarketplaceBundle) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+type ListBundlesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the project the subscriptions are listed for.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBundlesRequest) Reset() {
+	*x = ListBundlesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBundlesRequest) ProtoMessage() {}
+
+func (x *ListBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBundlesRequest.ProtoReflect.Descriptor instead.
+func (*ListBundlesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ListBundlesRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ListBundlesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bundles lists the bundles available in the marketplace, along with
+	// the bundles the project is subscribed to.
+	Bundles       []*MarketplaceBundle `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBundlesResponse) Reset() {
+	*x = ListBundlesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBundlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBundlesResponse) ProtoMessage() {}
+
+func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBundlesResponse.ProtoReflect.Descriptor instead.
+func (*ListBundlesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *ListBundlesResponse) GetBundles() []*MarketplaceBundle {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+type SubscribeBundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the project to subscribe.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// namespace is the namespace of the bundle.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the bundle.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the version of the bundle to subscribe to. If the project
+	// is already subscribed to the bundle, its rule types and data sources
+	// are upgraded, or downgraded, to this version. If empty, the latest
+	// version is used.
+	Version       string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBundleRequest) Reset() {
+	*x = SubscribeBundleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBundleRequest) ProtoMessage() {}
+
+func (x *SubscribeBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBundleRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBundleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SubscribeBundleRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SubscribeBundleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SubscribeBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscribeBundleRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type SubscribeBundleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bundle is the bundle the project is subscribed to.
+	Bundle        *MarketplaceBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBundleResponse) Reset() {
+	*x = SubscribeBundleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBundleResponse) ProtoMessage() {}
+
+func (x *SubscribeBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBundleResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeBundleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *SubscribeBundleResponse) GetBundle() *MarketplaceBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+// BundleResourceChange is a change subscribing to a version of a bundle
+// makes to the resources the project installed from the bundle.
+type BundleResourceChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is the kind of the resource, one of rule_type, profile or data_source.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name is the name of the resource.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// action is one of add, update or unmanaged. Unmanaged resources are
+	// no longer part of the bundle; they are left in the project, but no
+	// longer updated.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// breaking describes why the update of a rule type may break the
+	// profiles using it, such as an incompatible change of its parameter
+	// schema. Subscribing to the version fails if any change is breaking.
+	Breaking      string `protobuf:"bytes,4,opt,name=breaking,proto3" json:"breaking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleResourceChange) Reset() {
+	*x = BundleResourceChange{}
+	mi := &file_minder_v1_minder_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleResourceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleResourceChange) ProtoMessage() {}
+
+func (x *BundleResourceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleResourceChange.ProtoReflect.Descriptor instead.
+func (*BundleResourceChange) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *BundleResourceChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BundleResourceChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundleResourceChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BundleResourceChange) GetBreaking() string {
+	if x != nil {
+		return x.Breaking
+	}
+	return ""
+}
+
+type PreviewSubscriptionUpgradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the subscribed project.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// namespace is the namespace of the bundle.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the bundle.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the version of the bundle to preview. If empty, the latest
+	// version is used.
+	Version       string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewSubscriptionUpgradeRequest) Reset() {
+	*x = PreviewSubscriptionUpgradeRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSubscriptionUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSubscriptionUpgradeRequest) ProtoMessage() {}
+
+func (x *PreviewSubscriptionUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSubscriptionUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*PreviewSubscriptionUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *PreviewSubscriptionUpgradeRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *PreviewSubscriptionUpgradeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PreviewSubscriptionUpgradeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreviewSubscriptionUpgradeRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type PreviewSubscriptionUpgradeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// from_version is the version of the bundle the project is subscribed
+	// to. It is empty if the project is not subscribed.
+	FromVersion string `protobuf:"bytes,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// to_version is the version of the bundle previewed.
+	ToVersion string `protobuf:"bytes,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// changes lists the changes subscribing to the version would make.
+	Changes       []*BundleResourceChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewSubscriptionUpgradeResponse) Reset() {
+	*x = PreviewSubscriptionUpgradeResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSubscriptionUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSubscriptionUpgradeResponse) ProtoMessage() {}
+
+func (x *PreviewSubscriptionUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSubscriptionUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*PreviewSubscriptionUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *PreviewSubscriptionUpgradeResponse) GetFromVersion() string {
+	if x != nil {
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *PreviewSubscriptionUpgradeResponse) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+func (x *PreviewSubscriptionUpgradeResponse) GetChanges() []*BundleResourceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RollbackBundleSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context of the subscribed project.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// namespace is the namespace of the bundle.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the bundle.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackBundleSubscriptionRequest) Reset() {
+	*x = RollbackBundleSubscriptionRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackBundleSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackBundleSubscriptionRequest) ProtoMessage() {}
+
+func (x *RollbackBundleSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackBundleSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackBundleSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *RollbackBundleSubscriptionRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RollbackBundleSubscriptionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RollbackBundleSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RollbackBundleSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bundle is the bundle the project is subscribed to.
+	Bundle        *MarketplaceBundle `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackBundleSubscriptionResponse) Reset() {
+	*x = RollbackBundleSubscriptionResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackBundleSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackBundleSubscriptionResponse) ProtoMessage() {}
+
+func (x *RollbackBundleSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackBundleSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*RollbackBundleSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *RollbackBundleSubscriptionResponse) GetBundle() *MarketplaceBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type CreateEntityReconciliationTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity is the entity to be reconciled.
+	Entity *EntityTypedId `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// context is the context in which the entity reconciliation task is created.
+	Context       *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEntityReconciliationTaskRequest) Reset() {
+	*x = CreateEntityReconciliationTaskRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEntityReconciliationTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntityReconciliationTaskRequest) ProtoMessage() {}
+
+func (x *CreateEntityReconciliationTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntityReconciliationTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntityReconciliationTaskRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *CreateEntityReconciliationTaskRequest) GetEntity() *EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *CreateEntityReconciliationTaskRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type CreateEntityReconciliationTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEntityReconciliationTaskResponse) Reset() {
+	*x = CreateEntityReconciliationTaskResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEntityReconciliationTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntityReconciliationTaskResponse) ProtoMessage() {}
+
+func (x *CreateEntityReconciliationTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntityReconciliationTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateEntityReconciliationTaskResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{190}
+}
+
+type ListRolesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the roles are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ListRolesRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type ListRoleAssignmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the role assignments are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleAssignmentsRequest) Reset() {
+	*x = ListRoleAssignmentsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *ListRoleAssignmentsRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ListRoleAssignmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role_assignments contains permission grants which have been accepted
+	// by a user.
+	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
+	// invitations contains outstanding role invitations which have not yet
+	// been accepted by a user.
+	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleAssignmentsResponse) Reset() {
+	*x = ListRoleAssignmentsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *ListRoleAssignmentsResponse) GetRoleAssignments() []*RoleAssignment {
+	if x != nil {
+		return x.RoleAssignments
+	}
+	return nil
+}
+
+func (x *ListRoleAssignmentsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type AssignRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the role assignment is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// role_assignment is the role assignment to be created.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *AssignRoleRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *AssignRoleRequest) GetRoleAssignment() *RoleAssignment {
+	if x != nil {
+		return x.RoleAssignment
+	}
+	return nil
+}
+
+type AssignRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role_assignment is the role assignment that was created.
+	// This is optional.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	// invitation contains the details of the invitation for the
+	// assigned user to join the project if the user is not already
+	// a member. This is optional.
+	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *AssignRoleResponse) GetRoleAssignment() *RoleAssignment {
+	if x != nil {
+		return x.RoleAssignment
+	}
+	return nil
+}
+
+func (x *AssignRoleResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the role assignment is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// subject is the account to change permissions for.
+	// The account must already have permissions on the project
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// All subject roles are _replaced_ with the following role assignments.  Must be non-empty,
+	// use RemoveRole to remove permissions entirely from the project.
+	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// email is the email address of the subject used for updating invitations
+	Email         string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *UpdateRoleRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type UpdateRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role_assignments are the role assignments that were updated.
+	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
+	// invitations contains the details of the invitations that were updated.
+	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *UpdateRoleResponse) GetRoleAssignments() []*RoleAssignment {
+	if x != nil {
+		return x.RoleAssignments
+	}
+	return nil
+}
+
+func (x *UpdateRoleResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RemoveRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the role assignment is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// role_assignment is the role assignment to be removed.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveRoleRequest) Reset() {
+	*x = RemoveRoleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleRequest) ProtoMessage() {}
+
+func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRoleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *RemoveRoleRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RemoveRoleRequest) GetRoleAssignment() *RoleAssignment {
+	if x != nil {
+		return x.RoleAssignment
+	}
+	return nil
+}
+
+type RemoveRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role_assignment is the role assignment that was removed.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	// invitation contains the details of the invitation that was removed.
+	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleResponse) Reset() {
+	*x = RemoveRoleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleResponse) ProtoMessage() {}
+
+func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRoleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *RemoveRoleResponse) GetRoleAssignment() *RoleAssignment {
+	if x != nil {
+		return x.RoleAssignment
+	}
+	return nil
+}
+
+func (x *RemoveRoleResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type Role struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the role.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// display name of the role
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// description is the description of the role.
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_minder_v1_minder_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type RoleAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role is the role that is assigned.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// subject is the subject to which the role is assigned.
+	// Can be either a UUID or a providername/subject string.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// display_name is the display name of the subject.
+	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// project is the project in which the role is assigned.
+	Project *string `protobuf:"bytes,4,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	// email is the email address of the subject used for invitations.
+	Email string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	// first_name is the first name of the subject.
+	FirstName string `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	// last_name is the last name of the subject.
+	LastName      string `protobuf:"bytes,8,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleAssignment) Reset() {
+	*x = RoleAssignment{}
+	mi := &file_minder_v1_minder_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAssignment) ProtoMessage() {}
+
+func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
+func (*RoleAssignment) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *RoleAssignment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8977,43 +12713,247 @@ func (x *ListChildProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListChildProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *ListChildProjectsResponse) GetProjects() []*Project {
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[204]
 	if x != nil {
-		return x.Projects
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
 	}
 	return nil
 }
 
-type CreateEntityReconciliationTaskRequest struct {
+type ResolveInvitationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity to be reconciled.
-	Entity *EntityTypedId `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	// context is the context in which the entity reconciliation task is created.
-	Context       *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// code is the code of the invitation to resolve.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// accept is true if the invitation is accepted, false if it is rejected.
+	Accept        bool `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveInvitationRequest) Reset() {
+	*x = ResolveInvitationRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveInvitationRequest) ProtoMessage() {}
+
+func (x *ResolveInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResolveInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *ResolveInvitationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResolveInvitationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type ResolveInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role is the role that would be assigned if the user
+	// accepts the invitation.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// email is the email address of the invited user.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// project is the project to which the user is invited.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// is_accepted is the status of the invitation.
+	IsAccepted bool `protobuf:"varint,4,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted,omitempty"`
+	// project_display is the display name of the project to which the user
+	// is invited.
+	ProjectDisplay string `protobuf:"bytes,5,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolveInvitationResponse) Reset() {
+	*x = ResolveInvitationResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveInvitationResponse) ProtoMessage() {}
+
+func (x *ResolveInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ResolveInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *ResolveInvitationResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ResolveInvitationResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ResolveInvitationResponse) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ResolveInvitationResponse) GetIsAccepted() bool {
+	if x != nil {
+		return x.IsAccepted
+	}
+	return false
+}
+
+func (x *ResolveInvitationResponse) GetProjectDisplay() string {
+	if x != nil {
+		return x.ProjectDisplay
+	}
+	return ""
+}
+
+// Invitation is an invitation to join a project. This is only used in responses.
+type Invitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role is the role that would be assigned if the user
+	// accepts the invitation.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// email is the email address of the invited user.  This is
+	// presented as a convenience for display purposes, and does
+	// not affect who can accept the invitation using the code.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// project is the project to which the user is invited.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// code is a unique identifier for the invitation, which can
+	// be used by the recipient to accept or reject the invitation.
+	// The code is only transmitted in response to AssignRole or
+	// ListInvitations RPCs, and not transmitted in
+	// ListRoleAssignments or other calls.
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	// created_at is the time at which the invitation was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at is the time at which the invitation expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// expired is true if the invitation has expired.
+	Expired bool `protobuf:"varint,9,opt,name=expired,proto3" json:"expired,omitempty"`
+	// sponsor is the account (ID) of the user who created the invitation.
+	Sponsor string `protobuf:"bytes,7,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// sponsor_display is the display name of the user who created the
+	// invitation.
+	SponsorDisplay string `protobuf:"bytes,8,opt,name=sponsor_display,json=sponsorDisplay,proto3" json:"sponsor_display,omitempty"`
+	// project_display is the display name of the project to which the user
+	// is invited.
+	ProjectDisplay string `protobuf:"bytes,10,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
+	// inviteURL is the URL that can be used to accept the invitation.
+	InviteUrl string `protobuf:"bytes,11,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"`
+	// emailSkipped is true if the email was not sent to the invitee.
+	EmailSkipped  bool `protobuf:"varint,12,opt,name=email_skipped,json=emailSkipped,proto3" json:"email_skipped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEntityReconciliationTaskRequest) Reset() {
-	*x = CreateEntityReconciliationTaskRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[143]
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEntityReconciliationTaskRequest) String() string {
+func (x *Invitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntityReconciliationTaskRequest) ProtoMessage() {}
+func (*Invitation) ProtoMessage() {}
 
-func (x *CreateEntityReconciliationTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[143]
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9024,84 +12964,120 @@ func (x *CreateEntityReconciliationTaskRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntityReconciliationTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateEntityReconciliationTaskRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *CreateEntityReconciliationTaskRequest) GetEntity() *EntityTypedId {
+func (x *Invitation) GetRole() string {
 	if x != nil {
-		return x.Entity
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Invitation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *CreateEntityReconciliationTaskRequest) GetContext() *Context {
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Context
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type CreateEntityReconciliationTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Invitation) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
 }
 
-func (x *CreateEntityReconciliationTaskResponse) Reset() {
-	*x = CreateEntityReconciliationTaskResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[144]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Invitation) GetSponsor() string {
+	if x != nil {
+		return x.Sponsor
+	}
+	return ""
 }
 
-func (x *CreateEntityReconciliationTaskResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Invitation) GetSponsorDisplay() string {
+	if x != nil {
+		return x.SponsorDisplay
+	}
+	return ""
 }
 
-func (*CreateEntityReconciliationTaskResponse) ProtoMessage() {}
+func (x *Invitation) GetProjectDisplay() string {
+	if x != nil {
+		return x.ProjectDisplay
+	}
+	return ""
+}
 
-func (x *CreateEntityReconciliationTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[144]
+func (x *Invitation) GetInviteUrl() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InviteUrl
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateEntityReconciliationTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateEntityReconciliationTaskResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{144}
+func (x *Invitation) GetEmailSkipped() bool {
+	if x != nil {
+		return x.EmailSkipped
+	}
+	return false
 }
 
-type ListRolesRequest struct {
+type GetProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the roles are evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// context is the context in which the provider is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the provider to get.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolesRequest) Reset() {
-	*x = ListRolesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[145]
+func (x *GetProviderRequest) Reset() {
+	*x = GetProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesRequest) String() string {
+func (x *GetProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesRequest) ProtoMessage() {}
+func (*GetProviderRequest) ProtoMessage() {}
 
-func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[145]
+func (x *GetProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9112,40 +13088,48 @@ func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListRolesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use GetProviderRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *ListRolesRequest) GetContext() *Context {
+func (x *GetProviderRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-type ListRolesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+func (x *GetProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetProviderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is the provider that was retrieved.
+	Provider      *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolesResponse) Reset() {
-	*x = ListRolesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[146]
+func (x *GetProviderResponse) Reset() {
+	*x = GetProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesResponse) String() string {
+func (x *GetProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesResponse) ProtoMessage() {}
+func (*GetProviderResponse) ProtoMessage() {}
 
-func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[146]
+func (x *GetProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9156,41 +13140,46 @@ func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListRolesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use GetProviderResponse.ProtoReflect.Descriptor instead.
+func (*GetProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *ListRolesResponse) GetRoles() []*Role {
+func (x *GetProviderResponse) GetProvider() *Provider {
 	if x != nil {
-		return x.Roles
+		return x.Provider
 	}
 	return nil
 }
 
-type ListRoleAssignmentsRequest struct {
+type ListProvidersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignments are evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// context is the context in which the providers are evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// limit is the maximum number of providers to return.
+	// 0 uses a server-defined default.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the cursor to use for the page of results, empty if at the beginning
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoleAssignmentsRequest) Reset() {
-	*x = ListRoleAssignmentsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[147]
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoleAssignmentsRequest) String() string {
+func (x *ListProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleAssignmentsRequest) ProtoMessage() {}
+func (*ListProvidersRequest) ProtoMessage() {}
 
-func (x *ListRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[147]
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9201,45 +13190,56 @@ func (x *ListRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleAssignmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListRoleAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *ListRoleAssignmentsRequest) GetContext() *Context {
+func (x *ListProvidersRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-type ListRoleAssignmentsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignments contains permission grants which have been accepted
-	// by a user.
-	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
-	// invitations contains outstanding role invitations which have not yet
-	// been accepted by a user.
-	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+func (x *ListProvidersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListProvidersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListProvidersResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Providers []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	// cursor is the cursor to use for the next page of results, empty if at the end
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoleAssignmentsResponse) Reset() {
-	*x = ListRoleAssignmentsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[148]
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoleAssignmentsResponse) String() string {
+func (x *ListProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleAssignmentsResponse) ProtoMessage() {}
+func (*ListProvidersResponse) ProtoMessage() {}
 
-func (x *ListRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[148]
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9250,50 +13250,50 @@ func (x *ListRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleAssignmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListRoleAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148}
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *ListRoleAssignmentsResponse) GetRoleAssignments() []*RoleAssignment {
+func (x *ListProvidersResponse) GetProviders() []*Provider {
 	if x != nil {
-		return x.RoleAssignments
+		return x.Providers
 	}
 	return nil
 }
 
-func (x *ListRoleAssignmentsResponse) GetInvitations() []*Invitation {
+func (x *ListProvidersResponse) GetCursor() string {
 	if x != nil {
-		return x.Invitations
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-type AssignRoleRequest struct {
+type CreateProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignment is evaluated.
+	// context is the context in which the provider is created.
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// role_assignment is the role assignment to be created.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// provider is the provider to be created.
+	Provider      *Provider `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignRoleRequest) Reset() {
-	*x = AssignRoleRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[149]
+func (x *CreateProviderRequest) Reset() {
+	*x = CreateProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignRoleRequest) String() string {
+func (x *CreateProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRoleRequest) ProtoMessage() {}
+func (*CreateProviderRequest) ProtoMessage() {}
 
-func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[149]
+func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9304,53 +13304,51 @@ func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
-func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use CreateProviderRequest.ProtoReflect.Descriptor instead.
+func (*CreateProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *AssignRoleRequest) GetContext() *Context {
+func (x *CreateProviderRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *AssignRoleRequest) GetRoleAssignment() *RoleAssignment {
+func (x *CreateProviderRequest) GetProvider() *Provider {
 	if x != nil {
-		return x.RoleAssignment
+		return x.Provider
 	}
 	return nil
 }
 
-type AssignRoleResponse struct {
+type CreateProviderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignment is the role assignment that was created.
-	// This is optional.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	// invitation contains the details of the invitation for the
-	// assigned user to join the project if the user is not already
-	// a member. This is optional.
-	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	// provider is the provider that was created.
+	Provider *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// authorization provides additional authorization information needed
+	// to complete the initialization of the provider.
+	Authorization *AuthorizationParams `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignRoleResponse) Reset() {
-	*x = AssignRoleResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[150]
+func (x *CreateProviderResponse) Reset() {
+	*x = CreateProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignRoleResponse) String() string {
+func (x *CreateProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRoleResponse) ProtoMessage() {}
+func (*CreateProviderResponse) ProtoMessage() {}
 
-func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[150]
+func (x *CreateProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9361,56 +13359,49 @@ func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
-func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{150}
+// Deprecated: Use CreateProviderResponse.ProtoReflect.Descriptor instead.
+func (*CreateProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *AssignRoleResponse) GetRoleAssignment() *RoleAssignment {
+func (x *CreateProviderResponse) GetProvider() *Provider {
 	if x != nil {
-		return x.RoleAssignment
+		return x.Provider
 	}
 	return nil
 }
 
-func (x *AssignRoleResponse) GetInvitation() *Invitation {
+func (x *CreateProviderResponse) GetAuthorization() *AuthorizationParams {
 	if x != nil {
-		return x.Invitation
+		return x.Authorization
 	}
 	return nil
 }
 
-type UpdateRoleRequest struct {
+type DeleteProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignment is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// subject is the account to change permissions for.
-	// The account must already have permissions on the project
-	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	// All subject roles are _replaced_ with the following role assignments.  Must be non-empty,
-	// use RemoveRole to remove permissions entirely from the project.
-	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
-	// email is the email address of the subject used for updating invitations
-	Email         string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	// context is the context in which the provider is deleted.  Both
+	// project and provider are required in this context.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoleRequest) Reset() {
-	*x = UpdateRoleRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[151]
+func (x *DeleteProviderRequest) Reset() {
+	*x = DeleteProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleRequest) String() string {
+func (x *DeleteProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleRequest) ProtoMessage() {}
+func (*DeleteProviderRequest) ProtoMessage() {}
 
-func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[151]
+func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9421,64 +13412,41 @@ func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *UpdateRoleRequest) GetContext() *Context {
+func (x *DeleteProviderRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *UpdateRoleRequest) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
-}
-
-func (x *UpdateRoleRequest) GetRoles() []string {
-	if x != nil {
-		return x.Roles
-	}
-	return nil
-}
-
-func (x *UpdateRoleRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-type UpdateRoleResponse struct {
+type DeleteProviderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignments are the role assignments that were updated.
-	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
-	// invitations contains the details of the invitations that were updated.
-	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	// name is the name of the provider that was deleted
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoleResponse) Reset() {
-	*x = UpdateRoleResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[152]
+func (x *DeleteProviderResponse) Reset() {
+	*x = DeleteProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleResponse) String() string {
+func (x *DeleteProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleResponse) ProtoMessage() {}
+func (*DeleteProviderResponse) ProtoMessage() {}
 
-func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[152]
+func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9489,50 +13457,43 @@ func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{152}
-}
-
-func (x *UpdateRoleResponse) GetRoleAssignments() []*RoleAssignment {
-	if x != nil {
-		return x.RoleAssignments
-	}
-	return nil
+// Deprecated: Use DeleteProviderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *UpdateRoleResponse) GetInvitations() []*Invitation {
+func (x *DeleteProviderResponse) GetName() string {
 	if x != nil {
-		return x.Invitations
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type RemoveRoleRequest struct {
+type DeleteProviderByIDRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignment is evaluated.
+	// context is the context in which the provider is deleted. Only the project is required in this context.
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// role_assignment is the role assignment to be removed.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// id is the id of the provider to delete
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRoleRequest) Reset() {
-	*x = RemoveRoleRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[153]
+func (x *DeleteProviderByIDRequest) Reset() {
+	*x = DeleteProviderByIDRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRoleRequest) String() string {
+func (x *DeleteProviderByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRoleRequest) ProtoMessage() {}
+func (*DeleteProviderByIDRequest) ProtoMessage() {}
 
-func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[153]
+func (x *DeleteProviderByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9543,50 +13504,48 @@ func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRoleRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRoleRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{153}
+// Deprecated: Use DeleteProviderByIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderByIDRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *RemoveRoleRequest) GetContext() *Context {
+func (x *DeleteProviderByIDRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *RemoveRoleRequest) GetRoleAssignment() *RoleAssignment {
+func (x *DeleteProviderByIDRequest) GetId() string {
 	if x != nil {
-		return x.RoleAssignment
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type RemoveRoleResponse struct {
+type DeleteProviderByIDResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignment is the role assignment that was removed.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	// invitation contains the details of the invitation that was removed.
-	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	// id is the id of the provider that was deleted
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRoleResponse) Reset() {
-	*x = RemoveRoleResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[154]
+func (x *DeleteProviderByIDResponse) Reset() {
+	*x = DeleteProviderByIDResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRoleResponse) String() string {
+func (x *DeleteProviderByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRoleResponse) ProtoMessage() {}
+func (*DeleteProviderByIDResponse) ProtoMessage() {}
 
-func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[154]
+func (x *DeleteProviderByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9597,52 +13556,41 @@ func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRoleResponse.ProtoReflect.Descriptor instead.
-func (*RemoveRoleResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{154}
-}
-
-func (x *RemoveRoleResponse) GetRoleAssignment() *RoleAssignment {
-	if x != nil {
-		return x.RoleAssignment
-	}
-	return nil
+// Deprecated: Use DeleteProviderByIDResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderByIDResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *RemoveRoleResponse) GetInvitation() *Invitation {
+func (x *DeleteProviderByIDResponse) GetId() string {
 	if x != nil {
-		return x.Invitation
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type Role struct {
+type ListProviderClassesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the role.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// display name of the role
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// description is the description of the role.
-	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// context is the context in which the provider classes are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Role) Reset() {
-	*x = Role{}
-	mi := &file_minder_v1_minder_proto_msgTypes[155]
+func (x *ListProviderClassesRequest) Reset() {
+	*x = ListProviderClassesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Role) String() string {
+func (x *ListProviderClassesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Role) ProtoMessage() {}
+func (*ListProviderClassesRequest) ProtoMessage() {}
 
-func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[155]
+func (x *ListProviderClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9653,68 +13601,53 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Role.ProtoReflect.Descriptor instead.
-func (*Role) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{155}
-}
-
-func (x *Role) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Role) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+// Deprecated: Use ListProviderClassesRequest.ProtoReflect.Descriptor instead.
+func (*ListProviderClassesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *Role) GetDescription() string {
+func (x *ListProviderClassesRequest) GetContext() *Context {
 	if x != nil {
-		return x.Description
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-type RoleAssignment struct {
+type ProviderClassInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role is the role that is assigned.
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	// subject is the subject to which the role is assigned.
-	// Can be either a UUID or a providername/subject string.
-	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	// display_name is the display name of the subject.
-	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// project is the project in which the role is assigned.
-	Project *string `protobuf:"bytes,4,opt,name=project,proto3,oneof" json:"project,omitempty"`
-	// email is the email address of the subject used for invitations.
-	Email string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	// first_name is the first name of the subject.
-	FirstName string `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	// last_name is the last name of the subject.
-	LastName      string `protobuf:"bytes,8,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// class is the provider class identifier.
+	Class string `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	// display_name is a human-friendly provider class name.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// description is a short plaintext summary of the provider class.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// supported_provider_types is the list of provider traits/interfaces supported by this class.
+	SupportedProviderTypes []ProviderType `protobuf:"varint,4,rep,packed,name=supported_provider_types,json=supportedProviderTypes,proto3,enum=minder.v1.ProviderType" json:"supported_provider_types,omitempty"`
+	// supported_auth_flows is the list of supported authorization flows.
+	SupportedAuthFlows []AuthorizationFlow `protobuf:"varint,5,rep,packed,name=supported_auth_flows,json=supportedAuthFlows,proto3,enum=minder.v1.AuthorizationFlow" json:"supported_auth_flows,omitempty"`
+	// supported_entities is the list of entity types supported by this provider class.
+	SupportedEntities []Entity `protobuf:"varint,6,rep,packed,name=supported_entities,json=supportedEntities,proto3,enum=minder.v1.Entity" json:"supported_entities,omitempty"`
+	// documentation_url points to provider-specific or generic documentation.
+	DocumentationUrl string `protobuf:"bytes,7,opt,name=documentation_url,json=documentationUrl,proto3" json:"documentation_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *RoleAssignment) Reset() {
-	*x = RoleAssignment{}
-	mi := &file_minder_v1_minder_proto_msgTypes[156]
+func (x *ProviderClassInfo) Reset() {
+	*x = ProviderClassInfo{}
+	mi := &file_minder_v1_minder_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleAssignment) String() string {
+func (x *ProviderClassInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleAssignment) ProtoMessage() {}
+func (*ProviderClassInfo) ProtoMessage() {}
 
-func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[156]
+func (x *ProviderClassInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9725,81 +13658,88 @@ func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
-func (*RoleAssignment) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{156}
+// Deprecated: Use ProviderClassInfo.ProtoReflect.Descriptor instead.
+func (*ProviderClassInfo) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *RoleAssignment) GetRole() string {
+func (x *ProviderClassInfo) GetClass() string {
 	if x != nil {
-		return x.Role
+		return x.Class
 	}
 	return ""
 }
 
-func (x *RoleAssignment) GetSubject() string {
+func (x *ProviderClassInfo) GetDisplayName() string {
 	if x != nil {
-		return x.Subject
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *RoleAssignment) GetDisplayName() string {
+func (x *ProviderClassInfo) GetDescription() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Description
 	}
 	return ""
 }
 
-func (x *RoleAssignment) GetProject() string {
-	if x != nil && x.Project != nil {
-		return *x.Project
+func (x *ProviderClassInfo) GetSupportedProviderTypes() []ProviderType {
+	if x != nil {
+		return x.SupportedProviderTypes
 	}
-	return ""
+	return nil
 }
 
-func (x *RoleAssignment) GetEmail() string {
+func (x *ProviderClassInfo) GetSupportedAuthFlows() []AuthorizationFlow {
 	if x != nil {
-		return x.Email
+		return x.SupportedAuthFlows
 	}
-	return ""
+	return nil
 }
 
-func (x *RoleAssignment) GetFirstName() string {
+func (x *ProviderClassInfo) GetSupportedEntities() []Entity {
 	if x != nil {
-		return x.FirstName
+		return x.SupportedEntities
 	}
-	return ""
+	return nil
 }
 
-func (x *RoleAssignment) GetLastName() string {
+func (x *ProviderClassInfo) GetDocumentationUrl() string {
 	if x != nil {
-		return x.LastName
+		return x.DocumentationUrl
 	}
 	return ""
 }
 
-type ListInvitationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListProviderClassesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider_classes is the legacy list of provider class names.
+	// Deprecated: use provider_class_infos for rich metadata.
+	//
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	ProviderClasses []string `protobuf:"bytes,1,rep,name=provider_classes,json=providerClasses,proto3" json:"provider_classes,omitempty"`
+	// provider_class_infos is the rich metadata for each provider class.
+	ProviderClassInfos []*ProviderClassInfo `protobuf:"bytes,2,rep,name=provider_class_infos,json=providerClassInfos,proto3" json:"provider_class_infos,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListInvitationsRequest) Reset() {
-	*x = ListInvitationsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[157]
+func (x *ListProviderClassesResponse) Reset() {
+	*x = ListProviderClassesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInvitationsRequest) String() string {
+func (x *ListProviderClassesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvitationsRequest) ProtoMessage() {}
+func (*ListProviderClassesResponse) ProtoMessage() {}
 
-func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[157]
+func (x *ListProviderClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9810,33 +13750,52 @@ func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
-func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{157}
+// Deprecated: Use ListProviderClassesResponse.ProtoReflect.Descriptor instead.
+func (*ListProviderClassesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{220}
 }
 
-type ListInvitationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *ListProviderClassesResponse) GetProviderClasses() []string {
+	if x != nil {
+		return x.ProviderClasses
+	}
+	return nil
+}
+
+func (x *ListProviderClassesResponse) GetProviderClassInfos() []*ProviderClassInfo {
+	if x != nil {
+		return x.ProviderClassInfos
+	}
+	return nil
+}
+
+type PatchProviderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the provider is updated.
+	// The provider name is required in this context.
+	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Patch         *Provider              `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInvitationsResponse) Reset() {
-	*x = ListInvitationsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[158]
+func (x *PatchProviderRequest) Reset() {
+	*x = PatchProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInvitationsResponse) String() string {
+func (x *PatchProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvitationsResponse) ProtoMessage() {}
+func (*PatchProviderRequest) ProtoMessage() {}
 
-func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[158]
+func (x *PatchProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9847,43 +13806,54 @@ func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
-func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{158}
+// Deprecated: Use PatchProviderRequest.ProtoReflect.Descriptor instead.
+func (*PatchProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+func (x *PatchProviderRequest) GetContext() *Context {
 	if x != nil {
-		return x.Invitations
+		return x.Context
 	}
 	return nil
 }
 
-type ResolveInvitationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// code is the code of the invitation to resolve.
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	// accept is true if the invitation is accepted, false if it is rejected.
-	Accept        bool `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+func (x *PatchProviderRequest) GetPatch() *Provider {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *PatchProviderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type PatchProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveInvitationRequest) Reset() {
-	*x = ResolveInvitationRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[159]
+func (x *PatchProviderResponse) Reset() {
+	*x = PatchProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveInvitationRequest) String() string {
+func (x *PatchProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveInvitationRequest) ProtoMessage() {}
+func (*PatchProviderResponse) ProtoMessage() {}
 
-func (x *ResolveInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[159]
+func (x *PatchProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9894,58 +13864,41 @@ func (x *ResolveInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveInvitationRequest.ProtoReflect.Descriptor instead.
-func (*ResolveInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{159}
-}
-
-func (x *ResolveInvitationRequest) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+// Deprecated: Use PatchProviderResponse.ProtoReflect.Descriptor instead.
+func (*PatchProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *ResolveInvitationRequest) GetAccept() bool {
+func (x *PatchProviderResponse) GetProvider() *Provider {
 	if x != nil {
-		return x.Accept
+		return x.Provider
 	}
-	return false
+	return nil
 }
 
-type ResolveInvitationResponse struct {
+type AuthorizationParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role is the role that would be assigned if the user
-	// accepts the invitation.
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	// email is the email address of the invited user.
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	// project is the project to which the user is invited.
-	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
-	// is_accepted is the status of the invitation.
-	IsAccepted bool `protobuf:"varint,4,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted,omitempty"`
-	// project_display is the display name of the project to which the user
-	// is invited.
-	ProjectDisplay string `protobuf:"bytes,5,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// authorization_url is an external URL to use to authorize the provider.
+	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ResolveInvitationResponse) Reset() {
-	*x = ResolveInvitationResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[160]
+func (x *AuthorizationParams) Reset() {
+	*x = AuthorizationParams{}
+	mi := &file_minder_v1_minder_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveInvitationResponse) String() string {
+func (x *AuthorizationParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveInvitationResponse) ProtoMessage() {}
+func (*AuthorizationParams) ProtoMessage() {}
 
-func (x *ResolveInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[160]
+func (x *AuthorizationParams) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9956,101 +13909,43 @@ func (x *ResolveInvitationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveInvitationResponse.ProtoReflect.Descriptor instead.
-func (*ResolveInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{160}
-}
-
-func (x *ResolveInvitationResponse) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
-}
-
-func (x *ResolveInvitationResponse) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *ResolveInvitationResponse) GetProject() string {
-	if x != nil {
-		return x.Project
-	}
-	return ""
-}
-
-func (x *ResolveInvitationResponse) GetIsAccepted() bool {
-	if x != nil {
-		return x.IsAccepted
-	}
-	return false
+// Deprecated: Use AuthorizationParams.ProtoReflect.Descriptor instead.
+func (*AuthorizationParams) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *ResolveInvitationResponse) GetProjectDisplay() string {
+func (x *AuthorizationParams) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.ProjectDisplay
+		return x.AuthorizationUrl
 	}
 	return ""
 }
 
-// Invitation is an invitation to join a project. This is only used in responses.
-type Invitation struct {
+type ProviderParameter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role is the role that would be assigned if the user
-	// accepts the invitation.
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	// email is the email address of the invited user.  This is
-	// presented as a convenience for display purposes, and does
-	// not affect who can accept the invitation using the code.
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	// project is the project to which the user is invited.
-	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
-	// code is a unique identifier for the invitation, which can
-	// be used by the recipient to accept or reject the invitation.
-	// The code is only transmitted in response to AssignRole or
-	// ListInvitations RPCs, and not transmitted in
-	// ListRoleAssignments or other calls.
-	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	// created_at is the time at which the invitation was created.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// expires_at is the time at which the invitation expires.
-	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	// expired is true if the invitation has expired.
-	Expired bool `protobuf:"varint,9,opt,name=expired,proto3" json:"expired,omitempty"`
-	// sponsor is the account (ID) of the user who created the invitation.
-	Sponsor string `protobuf:"bytes,7,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
-	// sponsor_display is the display name of the user who created the
-	// invitation.
-	SponsorDisplay string `protobuf:"bytes,8,opt,name=sponsor_display,json=sponsorDisplay,proto3" json:"sponsor_display,omitempty"`
-	// project_display is the display name of the project to which the user
-	// is invited.
-	ProjectDisplay string `protobuf:"bytes,10,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
-	// inviteURL is the URL that can be used to accept the invitation.
-	InviteUrl string `protobuf:"bytes,11,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"`
-	// emailSkipped is true if the email was not sent to the invitee.
-	EmailSkipped  bool `protobuf:"varint,12,opt,name=email_skipped,json=emailSkipped,proto3" json:"email_skipped,omitempty"`
+	// Types that are valid to be assigned to Parameters:
+	//
+	//	*ProviderParameter_GithubApp
+	Parameters    isProviderParameter_Parameters `protobuf_oneof:"parameters"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Invitation) Reset() {
-	*x = Invitation{}
-	mi := &file_minder_v1_minder_proto_msgTypes[161]
+func (x *ProviderParameter) Reset() {
+	*x = ProviderParameter{}
+	mi := &file_minder_v1_minder_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Invitation) String() string {
+func (x *ProviderParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Invitation) ProtoMessage() {}
+func (*ProviderParameter) ProtoMessage() {}
 
-func (x *Invitation) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[161]
+func (x *ProviderParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10061,120 +13956,152 @@ func (x *Invitation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
-func (*Invitation) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{161}
+// Deprecated: Use ProviderParameter.ProtoReflect.Descriptor instead.
+func (*ProviderParameter) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *Invitation) GetRole() string {
+func (x *ProviderParameter) GetParameters() isProviderParameter_Parameters {
 	if x != nil {
-		return x.Role
+		return x.Parameters
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetEmail() string {
+func (x *ProviderParameter) GetGithubApp() *GitHubAppParams {
 	if x != nil {
-		return x.Email
+		if x, ok := x.Parameters.(*ProviderParameter_GithubApp); ok {
+			return x.GithubApp
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetProject() string {
-	if x != nil {
-		return x.Project
-	}
-	return ""
+type isProviderParameter_Parameters interface {
+	isProviderParameter_Parameters()
 }
 
-func (x *Invitation) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+type ProviderParameter_GithubApp struct {
+	GithubApp *GitHubAppParams `protobuf:"bytes,1,opt,name=github_app,json=githubApp,proto3,oneof"`
 }
 
-func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (*ProviderParameter_GithubApp) isProviderParameter_Parameters() {}
+
+// GitHubAppParams is the parameters for a GitHub App provider.
+type GitHubAppParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The GitHub installation ID for the app.  On create, this is the only
+	// parameter used; the organization parameters are ignored.
+	InstallationId int64 `protobuf:"varint,1,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	// The GitHub organization slug where the app is installed.  This is an
+	// output-only parameter, and is validated on input if set (i.e. the value
+	// must be either empty or match the org of the installation_id).
+	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// The GitHub organization ID where the app is installed.  This is an
+	// output-only parameter, and is validated on input if set (i.e. the value
+	// must be either empty or match the org of the installation_id).
+	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
+func (x *GitHubAppParams) Reset() {
+	*x = GitHubAppParams{}
+	mi := &file_minder_v1_minder_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Invitation) GetExpired() bool {
-	if x != nil {
-		return x.Expired
-	}
-	return false
+func (x *GitHubAppParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Invitation) GetSponsor() string {
+func (*GitHubAppParams) ProtoMessage() {}
+
+func (x *GitHubAppParams) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[225]
 	if x != nil {
-		return x.Sponsor
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Invitation) GetSponsorDisplay() string {
-	if x != nil {
-		return x.SponsorDisplay
-	}
-	return ""
+// Deprecated: Use GitHubAppParams.ProtoReflect.Descriptor instead.
+func (*GitHubAppParams) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *Invitation) GetProjectDisplay() string {
+func (x *GitHubAppParams) GetInstallationId() int64 {
 	if x != nil {
-		return x.ProjectDisplay
+		return x.InstallationId
 	}
-	return ""
+	return 0
 }
 
-func (x *Invitation) GetInviteUrl() string {
+func (x *GitHubAppParams) GetOrganization() string {
 	if x != nil {
-		return x.InviteUrl
+		return x.Organization
 	}
 	return ""
 }
 
-func (x *Invitation) GetEmailSkipped() bool {
+func (x *GitHubAppParams) GetOrganizationId() int64 {
 	if x != nil {
-		return x.EmailSkipped
+		return x.OrganizationId
 	}
-	return false
+	return 0
 }
 
-type GetProviderRequest struct {
+// Provider represents a provider that is used to interact with external systems.
+// All fields are optional because we want to allow partial updates.
+type Provider struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the provider to get.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// name is the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// class is the name of the provider implementation, eg. 'github' or 'gh-app'.
+	Class string `protobuf:"bytes,7,opt,name=class,proto3" json:"class,omitempty"`
+	// project is the project where the provider is.  This is ignored on input
+	// in favor of the context field in CreateProviderRequest.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// version is the version of the provider.
+	// if unset, "v1" is assumed.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// implements is the list of interfaces that the provider implements.
+	Implements []ProviderType `protobuf:"varint,4,rep,packed,name=implements,proto3,enum=minder.v1.ProviderType" json:"implements,omitempty"`
+	// config is the configuration of the provider.
+	Config *structpb.Struct `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// auth_flows is the list of authorization flows that the provider supports.
+	AuthFlows []AuthorizationFlow `protobuf:"varint,6,rep,packed,name=auth_flows,json=authFlows,proto3,enum=minder.v1.AuthorizationFlow" json:"auth_flows,omitempty"`
+	// parameters is the list of parameters that the provider requires.
+	Parameters *ProviderParameter `protobuf:"bytes,8,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// credentials_state is the state of the credentials for the provider.
+	// This is an output-only field. It may be: "set", "unset", "not_applicable".
+	CredentialsState string `protobuf:"bytes,9,opt,name=credentials_state,json=credentialsState,proto3" json:"credentials_state,omitempty"`
+	// id is the unique identifier of the provider.
+	Id            string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProviderRequest) Reset() {
-	*x = GetProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[162]
+func (x *Provider) Reset() {
+	*x = Provider{}
+	mi := &file_minder_v1_minder_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProviderRequest) String() string {
+func (x *Provider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderRequest) ProtoMessage() {}
+func (*Provider) ProtoMessage() {}
 
-func (x *GetProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[162]
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10185,98 +14112,110 @@ func (x *GetProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderRequest.ProtoReflect.Descriptor instead.
-func (*GetProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{162}
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *GetProviderRequest) GetContext() *Context {
+func (x *Provider) GetName() string {
 	if x != nil {
-		return x.Context
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetProviderRequest) GetName() string {
+func (x *Provider) GetClass() string {
 	if x != nil {
-		return x.Name
+		return x.Class
 	}
 	return ""
 }
 
-type GetProviderResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// provider is the provider that was retrieved.
-	Provider      *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Provider) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
 }
 
-func (x *GetProviderResponse) Reset() {
-	*x = GetProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[163]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Provider) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
 }
 
-func (x *GetProviderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Provider) GetImplements() []ProviderType {
+	if x != nil {
+		return x.Implements
+	}
+	return nil
 }
 
-func (*GetProviderResponse) ProtoMessage() {}
-
-func (x *GetProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[163]
+func (x *Provider) GetConfig() *structpb.Struct {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Config
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetProviderResponse.ProtoReflect.Descriptor instead.
-func (*GetProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{163}
+func (x *Provider) GetAuthFlows() []AuthorizationFlow {
+	if x != nil {
+		return x.AuthFlows
+	}
+	return nil
 }
 
-func (x *GetProviderResponse) GetProvider() *Provider {
+func (x *Provider) GetParameters() *ProviderParameter {
 	if x != nil {
-		return x.Provider
+		return x.Parameters
 	}
 	return nil
 }
 
-type ListProvidersRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the providers are evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// limit is the maximum number of providers to return.
-	// 0 uses a server-defined default.
-	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// cursor is the cursor to use for the page of results, empty if at the beginning
-	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Provider) GetCredentialsState() string {
+	if x != nil {
+		return x.CredentialsState
+	}
+	return ""
 }
 
-func (x *ListProvidersRequest) Reset() {
-	*x = ListProvidersRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[164]
+func (x *Provider) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetEvaluationHistoryRequest represents a request for the GetEvaluationHistory endpoint
+type GetEvaluationHistoryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Context *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// If true, include structured rule output for the matched evaluations.
+	// Not all ruletypes may generate structured outputs.
+	// Because the evaluation output may be large, it is only returned
+	// when explicitly requested.
+	IncludeOutputs bool `protobuf:"varint,3,opt,name=include_outputs,json=includeOutputs,proto3" json:"include_outputs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEvaluationHistoryRequest) Reset() {
+	*x = GetEvaluationHistoryRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProvidersRequest) String() string {
+func (x *GetEvaluationHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProvidersRequest) ProtoMessage() {}
+func (*GetEvaluationHistoryRequest) ProtoMessage() {}
 
-func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[164]
+func (x *GetEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10287,56 +14226,88 @@ func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
-func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{164}
+// Deprecated: Use GetEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *ListProvidersRequest) GetContext() *Context {
+func (x *GetEvaluationHistoryRequest) GetId() string {
 	if x != nil {
-		return x.Context
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProvidersRequest) GetLimit() int32 {
+func (x *GetEvaluationHistoryRequest) GetContext() *Context {
 	if x != nil {
-		return x.Limit
+		return x.Context
 	}
-	return 0
+	return nil
 }
 
-func (x *ListProvidersRequest) GetCursor() string {
+func (x *GetEvaluationHistoryRequest) GetIncludeOutputs() bool {
 	if x != nil {
-		return x.Cursor
+		return x.IncludeOutputs
 	}
-	return ""
+	return false
 }
 
-type ListProvidersResponse struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Providers []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
-	// cursor is the cursor to use for the next page of results, empty if at the end
-	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ListEvaluationHistoryRequest represents a request message for the
+// ListEvaluationHistory RPC.
+//
+// Most of its fields are used for filtering, except for `cursor`
+// which is used for pagination.
+type ListEvaluationHistoryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// List of entity types to retrieve.
+	EntityType []string `protobuf:"bytes,2,rep,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// List of entity names to retrieve.
+	EntityName []string `protobuf:"bytes,3,rep,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
+	// List of profile names to retrieve.
+	ProfileName []string `protobuf:"bytes,4,rep,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	// List of evaluation statuses to retrieve.
+	Status []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	// List of remediation statuses to retrieve.
+	Remediation []string `protobuf:"bytes,6,rep,name=remediation,proto3" json:"remediation,omitempty"`
+	// List of alert statuses to retrieve.
+	Alert []string `protobuf:"bytes,7,rep,name=alert,proto3" json:"alert,omitempty"`
+	// Timestamp representing the start time of the selection window.
+	From *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"`
+	// Timestamp representing the end time of the selection window.
+	To *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
+	// Filter evaluation history to only those matching the specified labels.
+	//
+	// The default is to return all user-created profiles; the string "*" can
+	// be used to select all profiles, including system profiles.  This syntax
+	// may be expanded in the future.
+	LabelFilter []string `protobuf:"bytes,11,rep,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
+	// Cursor object to select the "page" of data to retrieve. This is optional.
+	Cursor *Cursor `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// If true, include structured rule output for the matched evaluations.
+	// Not all ruletypes may generate structured outputs.
+	// Because the evaluation output may be large, it is only returned
+	// when explicitly requested.
+	IncludeOutputs bool `protobuf:"varint,12,opt,name=include_outputs,json=includeOutputs,proto3" json:"include_outputs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListProvidersResponse) Reset() {
-	*x = ListProvidersResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[165]
+func (x *ListEvaluationHistoryRequest) Reset() {
+	*x = ListEvaluationHistoryRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProvidersResponse) String() string {
+func (x *ListEvaluationHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProvidersResponse) ProtoMessage() {}
+func (*ListEvaluationHistoryRequest) ProtoMessage() {}
 
-func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[165]
+func (x *ListEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10347,158 +14318,120 @@ func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
-func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use ListEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *ListProvidersResponse) GetProviders() []*Provider {
+func (x *ListEvaluationHistoryRequest) GetContext() *Context {
 	if x != nil {
-		return x.Providers
+		return x.Context
 	}
 	return nil
 }
 
-func (x *ListProvidersResponse) GetCursor() string {
+func (x *ListEvaluationHistoryRequest) GetEntityType() []string {
 	if x != nil {
-		return x.Cursor
+		return x.EntityType
 	}
-	return ""
-}
-
-type CreateProviderRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is created.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// provider is the provider to be created.
-	Provider      *Provider `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateProviderRequest) Reset() {
-	*x = CreateProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[166]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateProviderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*CreateProviderRequest) ProtoMessage() {}
-
-func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[166]
+func (x *ListEvaluationHistoryRequest) GetEntityName() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EntityName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateProviderRequest.ProtoReflect.Descriptor instead.
-func (*CreateProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{166}
+	return nil
 }
 
-func (x *CreateProviderRequest) GetContext() *Context {
+func (x *ListEvaluationHistoryRequest) GetProfileName() []string {
 	if x != nil {
-		return x.Context
+		return x.ProfileName
 	}
 	return nil
 }
 
-func (x *CreateProviderRequest) GetProvider() *Provider {
+func (x *ListEvaluationHistoryRequest) GetStatus() []string {
 	if x != nil {
-		return x.Provider
+		return x.Status
 	}
 	return nil
 }
 
-type CreateProviderResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// provider is the provider that was created.
-	Provider *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	// authorization provides additional authorization information needed
-	// to complete the initialization of the provider.
-	Authorization *AuthorizationParams `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListEvaluationHistoryRequest) GetRemediation() []string {
+	if x != nil {
+		return x.Remediation
+	}
+	return nil
 }
 
-func (x *CreateProviderResponse) Reset() {
-	*x = CreateProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[167]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListEvaluationHistoryRequest) GetAlert() []string {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
 }
 
-func (x *CreateProviderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListEvaluationHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
 }
 
-func (*CreateProviderResponse) ProtoMessage() {}
-
-func (x *CreateProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[167]
+func (x *ListEvaluationHistoryRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.To
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateProviderResponse.ProtoReflect.Descriptor instead.
-func (*CreateProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{167}
+func (x *ListEvaluationHistoryRequest) GetLabelFilter() []string {
+	if x != nil {
+		return x.LabelFilter
+	}
+	return nil
 }
 
-func (x *CreateProviderResponse) GetProvider() *Provider {
+func (x *ListEvaluationHistoryRequest) GetCursor() *Cursor {
 	if x != nil {
-		return x.Provider
+		return x.Cursor
 	}
 	return nil
 }
 
-func (x *CreateProviderResponse) GetAuthorization() *AuthorizationParams {
+func (x *ListEvaluationHistoryRequest) GetIncludeOutputs() bool {
 	if x != nil {
-		return x.Authorization
+		return x.IncludeOutputs
 	}
-	return nil
+	return false
 }
 
-type DeleteProviderRequest struct {
+// GetEvaluationHistoryResponse represents a response message for the
+// GetEvaluationHistory RPC.
+type GetEvaluationHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is deleted.  Both
-	// project and provider are required in this context.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// The requested record
+	Evaluation    *EvaluationHistory `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProviderRequest) Reset() {
-	*x = DeleteProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[168]
+func (x *GetEvaluationHistoryResponse) Reset() {
+	*x = GetEvaluationHistoryResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProviderRequest) String() string {
+func (x *GetEvaluationHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProviderRequest) ProtoMessage() {}
+func (*GetEvaluationHistoryResponse) ProtoMessage() {}
 
-func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[168]
+func (x *GetEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10509,41 +14442,49 @@ func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{168}
+// Deprecated: Use GetEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *DeleteProviderRequest) GetContext() *Context {
+func (x *GetEvaluationHistoryResponse) GetEvaluation() *EvaluationHistory {
 	if x != nil {
-		return x.Context
+		return x.Evaluation
 	}
 	return nil
 }
 
-type DeleteProviderResponse struct {
+// ListEvaluationHistoryResponse represents a response message for the
+// ListEvaluationHistory RPC.
+//
+// It ships a collection of records retrieved and pointers to get to
+// the next and/or previous pages of data.
+type ListEvaluationHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the provider that was deleted
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// List of records retrieved.
+	Data []*EvaluationHistory `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Metadata of the current page and pointers to next and/or
+	// previous pages.
+	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProviderResponse) Reset() {
-	*x = DeleteProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[169]
+func (x *ListEvaluationHistoryResponse) Reset() {
+	*x = ListEvaluationHistoryResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProviderResponse) String() string {
+func (x *ListEvaluationHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProviderResponse) ProtoMessage() {}
+func (*ListEvaluationHistoryResponse) ProtoMessage() {}
 
-func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[169]
+func (x *ListEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10554,43 +14495,64 @@ func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProviderResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{169}
+// Deprecated: Use ListEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *DeleteProviderResponse) GetName() string {
+func (x *ListEvaluationHistoryResponse) GetData() []*EvaluationHistory {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type DeleteProviderByIDRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is deleted. Only the project is required in this context.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the provider to delete
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ListEvaluationHistoryResponse) GetPage() *CursorPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// WatchEvaluationResultsRequest represents a request message for the
+// WatchEvaluationResults RPC.
+//
+// The filters have the same syntax as the ones of
+// ListEvaluationHistoryRequest.
+type WatchEvaluationResultsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// List of profile names to watch.
+	ProfileName []string `protobuf:"bytes,2,rep,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	// List of entity types to watch.
+	EntityType []string `protobuf:"bytes,3,rep,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// Watch only the evaluations of profiles matching the specified labels.
+	LabelFilter []string `protobuf:"bytes,4,rep,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
+	// List of evaluation statuses to watch.
+	Status []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	// cursor resumes the stream right after the evaluation it was
+	// returned with. When empty, only evaluations stored after the
+	// call is made are streamed.
+	Cursor        string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProviderByIDRequest) Reset() {
-	*x = DeleteProviderByIDRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[170]
+func (x *WatchEvaluationResultsRequest) Reset() {
+	*x = WatchEvaluationResultsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProviderByIDRequest) String() string {
+func (x *WatchEvaluationResultsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProviderByIDRequest) ProtoMessage() {}
+func (*WatchEvaluationResultsRequest) ProtoMessage() {}
 
-func (x *DeleteProviderByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[170]
+func (x *WatchEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10601,93 +14563,80 @@ func (x *DeleteProviderByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProviderByIDRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProviderByIDRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170}
+// Deprecated: Use WatchEvaluationResultsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEvaluationResultsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *DeleteProviderByIDRequest) GetContext() *Context {
+func (x *WatchEvaluationResultsRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *DeleteProviderByIDRequest) GetId() string {
+func (x *WatchEvaluationResultsRequest) GetProfileName() []string {
 	if x != nil {
-		return x.Id
+		return x.ProfileName
 	}
-	return ""
-}
-
-type DeleteProviderByIDResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the id of the provider that was deleted
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteProviderByIDResponse) Reset() {
-	*x = DeleteProviderByIDResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[171]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *DeleteProviderByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WatchEvaluationResultsRequest) GetEntityType() []string {
+	if x != nil {
+		return x.EntityType
+	}
+	return nil
 }
 
-func (*DeleteProviderByIDResponse) ProtoMessage() {}
-
-func (x *DeleteProviderByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[171]
+func (x *WatchEvaluationResultsRequest) GetLabelFilter() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LabelFilter
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteProviderByIDResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProviderByIDResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{171}
+func (x *WatchEvaluationResultsRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
 }
 
-func (x *DeleteProviderByIDResponse) GetId() string {
+func (x *WatchEvaluationResultsRequest) GetCursor() string {
 	if x != nil {
-		return x.Id
+		return x.Cursor
 	}
 	return ""
 }
 
-type ListProviderClassesRequest struct {
+// WatchEvaluationResultsResponse represents a message streamed by the
+// WatchEvaluationResults RPC.
+type WatchEvaluationResultsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider classes are evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// The evaluation which was stored.
+	Evaluation *EvaluationHistory `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	// cursor can be used to resume the stream after this evaluation.
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProviderClassesRequest) Reset() {
-	*x = ListProviderClassesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[172]
+func (x *WatchEvaluationResultsResponse) Reset() {
+	*x = WatchEvaluationResultsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProviderClassesRequest) String() string {
+func (x *WatchEvaluationResultsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProviderClassesRequest) ProtoMessage() {}
+func (*WatchEvaluationResultsResponse) ProtoMessage() {}
 
-func (x *ListProviderClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[172]
+func (x *WatchEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10698,53 +14647,64 @@ func (x *ListProviderClassesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProviderClassesRequest.ProtoReflect.Descriptor instead.
-func (*ListProviderClassesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{172}
+// Deprecated: Use WatchEvaluationResultsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEvaluationResultsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *ListProviderClassesRequest) GetContext() *Context {
+func (x *WatchEvaluationResultsResponse) GetEvaluation() *EvaluationHistory {
 	if x != nil {
-		return x.Context
+		return x.Evaluation
 	}
 	return nil
 }
 
-type ProviderClassInfo struct {
+func (x *WatchEvaluationResultsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// EvaluationHistory represents the history of an entity evaluation.
+// This is only used in responses.
+type EvaluationHistory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// class is the provider class identifier.
-	Class string `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
-	// display_name is a human-friendly provider class name.
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// description is a short plaintext summary of the provider class.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// supported_provider_types is the list of provider traits/interfaces supported by this class.
-	SupportedProviderTypes []ProviderType `protobuf:"varint,4,rep,packed,name=supported_provider_types,json=supportedProviderTypes,proto3,enum=minder.v1.ProviderType" json:"supported_provider_types,omitempty"`
-	// supported_auth_flows is the list of supported authorization flows.
-	SupportedAuthFlows []AuthorizationFlow `protobuf:"varint,5,rep,packed,name=supported_auth_flows,json=supportedAuthFlows,proto3,enum=minder.v1.AuthorizationFlow" json:"supported_auth_flows,omitempty"`
-	// supported_entities is the list of entity types supported by this provider class.
-	SupportedEntities []Entity `protobuf:"varint,6,rep,packed,name=supported_entities,json=supportedEntities,proto3,enum=minder.v1.Entity" json:"supported_entities,omitempty"`
-	// documentation_url points to provider-specific or generic documentation.
-	DocumentationUrl string `protobuf:"bytes,7,opt,name=documentation_url,json=documentationUrl,proto3" json:"documentation_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// entity contains details of the entity which was evaluated.
+	Entity *EvaluationHistoryEntity `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// rule contains details of the rule which the entity was evaluated against.
+	Rule *EvaluationHistoryRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	// status contains the evaluation status.
+	Status *EvaluationHistoryStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// alert contains details of the alerts for this evaluation.
+	// This is optional.
+	Alert *EvaluationHistoryAlert `protobuf:"bytes,4,opt,name=alert,proto3" json:"alert,omitempty"`
+	// remediation contains details of the remediation for this evaluation.
+	// This is optional.
+	Remediation *EvaluationHistoryRemediation `protobuf:"bytes,5,opt,name=remediation,proto3" json:"remediation,omitempty"`
+	// created_at is the timestamp of creation of this evaluation
+	EvaluatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	// id is the unique identifier of the evaluation.
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderClassInfo) Reset() {
-	*x = ProviderClassInfo{}
-	mi := &file_minder_v1_minder_proto_msgTypes[173]
+func (x *EvaluationHistory) Reset() {
+	*x = EvaluationHistory{}
+	mi := &file_minder_v1_minder_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderClassInfo) String() string {
+func (x *EvaluationHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderClassInfo) ProtoMessage() {}
+func (*EvaluationHistory) ProtoMessage() {}
 
-func (x *ProviderClassInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[173]
+func (x *EvaluationHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10755,144 +14715,87 @@ func (x *ProviderClassInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderClassInfo.ProtoReflect.Descriptor instead.
-func (*ProviderClassInfo) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{173}
-}
-
-func (x *ProviderClassInfo) GetClass() string {
-	if x != nil {
-		return x.Class
-	}
-	return ""
-}
-
-func (x *ProviderClassInfo) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *ProviderClassInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use EvaluationHistory.ProtoReflect.Descriptor instead.
+func (*EvaluationHistory) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *ProviderClassInfo) GetSupportedProviderTypes() []ProviderType {
+func (x *EvaluationHistory) GetEntity() *EvaluationHistoryEntity {
 	if x != nil {
-		return x.SupportedProviderTypes
+		return x.Entity
 	}
 	return nil
 }
 
-func (x *ProviderClassInfo) GetSupportedAuthFlows() []AuthorizationFlow {
+func (x *EvaluationHistory) GetRule() *EvaluationHistoryRule {
 	if x != nil {
-		return x.SupportedAuthFlows
+		return x.Rule
 	}
 	return nil
 }
 
-func (x *ProviderClassInfo) GetSupportedEntities() []Entity {
+func (x *EvaluationHistory) GetStatus() *EvaluationHistoryStatus {
 	if x != nil {
-		return x.SupportedEntities
+		return x.Status
 	}
 	return nil
 }
 
-func (x *ProviderClassInfo) GetDocumentationUrl() string {
+func (x *EvaluationHistory) GetAlert() *EvaluationHistoryAlert {
 	if x != nil {
-		return x.DocumentationUrl
+		return x.Alert
 	}
-	return ""
-}
-
-type ListProviderClassesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// provider_classes is the legacy list of provider class names.
-	// Deprecated: use provider_class_infos for rich metadata.
-	//
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	ProviderClasses []string `protobuf:"bytes,1,rep,name=provider_classes,json=providerClasses,proto3" json:"provider_classes,omitempty"`
-	// provider_class_infos is the rich metadata for each provider class.
-	ProviderClassInfos []*ProviderClassInfo `protobuf:"bytes,2,rep,name=provider_class_infos,json=providerClassInfos,proto3" json:"provider_class_infos,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *ListProviderClassesResponse) Reset() {
-	*x = ListProviderClassesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[174]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListProviderClassesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*ListProviderClassesResponse) ProtoMessage() {}
-
-func (x *ListProviderClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[174]
+func (x *EvaluationHistory) GetRemediation() *EvaluationHistoryRemediation {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Remediation
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProviderClassesResponse.ProtoReflect.Descriptor instead.
-func (*ListProviderClassesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{174}
+	return nil
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *ListProviderClassesResponse) GetProviderClasses() []string {
+func (x *EvaluationHistory) GetEvaluatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProviderClasses
+		return x.EvaluatedAt
 	}
 	return nil
 }
 
-func (x *ListProviderClassesResponse) GetProviderClassInfos() []*ProviderClassInfo {
+func (x *EvaluationHistory) GetId() string {
 	if x != nil {
-		return x.ProviderClassInfos
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type PatchProviderRequest struct {
+type EvaluationHistoryEntity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is updated.
-	// The provider name is required in this context.
-	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Patch         *Provider              `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// id is the unique identifier of the entity.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the entity type.
+	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
+	// name is the entity name.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProviderRequest) Reset() {
-	*x = PatchProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[175]
+func (x *EvaluationHistoryEntity) Reset() {
+	*x = EvaluationHistoryEntity{}
+	mi := &file_minder_v1_minder_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProviderRequest) String() string {
+func (x *EvaluationHistoryEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProviderRequest) ProtoMessage() {}
+func (*EvaluationHistoryEntity) ProtoMessage() {}
 
-func (x *PatchProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[175]
+func (x *EvaluationHistoryEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10903,54 +14806,61 @@ func (x *PatchProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProviderRequest.ProtoReflect.Descriptor instead.
-func (*PatchProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{175}
+// Deprecated: Use EvaluationHistoryEntity.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryEntity) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *PatchProviderRequest) GetContext() *Context {
+func (x *EvaluationHistoryEntity) GetId() string {
 	if x != nil {
-		return x.Context
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *PatchProviderRequest) GetPatch() *Provider {
+func (x *EvaluationHistoryEntity) GetType() Entity {
 	if x != nil {
-		return x.Patch
+		return x.Type
 	}
-	return nil
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-func (x *PatchProviderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *EvaluationHistoryEntity) GetName() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type PatchProviderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+type EvaluationHistoryRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the rule instance.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the name of the rule type.
+	RuleType string `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// profile is the name of the profile which contains the rule.
+	Profile string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	// severity is the severity of the rule type.
+	Severity      *Severity `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProviderResponse) Reset() {
-	*x = PatchProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[176]
+func (x *EvaluationHistoryRule) Reset() {
+	*x = EvaluationHistoryRule{}
+	mi := &file_minder_v1_minder_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProviderResponse) String() string {
+func (x *EvaluationHistoryRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProviderResponse) ProtoMessage() {}
+func (*EvaluationHistoryRule) ProtoMessage() {}
 
-func (x *PatchProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[176]
+func (x *EvaluationHistoryRule) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10961,41 +14871,71 @@ func (x *PatchProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProviderResponse.ProtoReflect.Descriptor instead.
-func (*PatchProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{176}
+// Deprecated: Use EvaluationHistoryRule.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryRule) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *PatchProviderResponse) GetProvider() *Provider {
+func (x *EvaluationHistoryRule) GetName() string {
 	if x != nil {
-		return x.Provider
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EvaluationHistoryRule) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *EvaluationHistoryRule) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *EvaluationHistoryRule) GetSeverity() *Severity {
+	if x != nil {
+		return x.Severity
 	}
 	return nil
 }
 
-type AuthorizationParams struct {
+type EvaluationHistoryStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// authorization_url is an external URL to use to authorize the provider.
-	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// status is one of (success, error, failure, skipped)
+	// not using enums to mirror the behaviour of the existing API contracts.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// details contains optional details about the evaluation.
+	// the structure and contents are rule type specific, and are subject to change.
+	Details string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	// output optionally contains the structured rule evaluation output.
+	// Because output may be multiple KB, it is only returned
+	// if include_outputs is set. Historical evaluations may
+	// discard structured output sooner than status results.
+	Output        *structpb.Value `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationParams) Reset() {
-	*x = AuthorizationParams{}
-	mi := &file_minder_v1_minder_proto_msgTypes[177]
+func (x *EvaluationHistoryStatus) Reset() {
+	*x = EvaluationHistoryStatus{}
+	mi := &file_minder_v1_minder_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationParams) String() string {
+func (x *EvaluationHistoryStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationParams) ProtoMessage() {}
+func (*EvaluationHistoryStatus) ProtoMessage() {}
 
-func (x *AuthorizationParams) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[177]
+func (x *EvaluationHistoryStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11006,43 +14946,59 @@ func (x *AuthorizationParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationParams.ProtoReflect.Descriptor instead.
-func (*AuthorizationParams) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{177}
+// Deprecated: Use EvaluationHistoryStatus.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryStatus) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *AuthorizationParams) GetAuthorizationUrl() string {
+func (x *EvaluationHistoryStatus) GetStatus() string {
 	if x != nil {
-		return x.AuthorizationUrl
+		return x.Status
 	}
 	return ""
 }
 
-type ProviderParameter struct {
+func (x *EvaluationHistoryStatus) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *EvaluationHistoryStatus) GetOutput() *structpb.Value {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type EvaluationHistoryRemediation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Parameters:
-	//
-	//	*ProviderParameter_GithubApp
-	Parameters    isProviderParameter_Parameters `protobuf_oneof:"parameters"`
+	// status is one of (success, error, failure, skipped, not available)
+	// not using enums to mirror the behaviour of the existing API contracts.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// details contains optional details about the remediation.
+	// the structure and contents are remediation specific, and are subject to change.
+	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderParameter) Reset() {
-	*x = ProviderParameter{}
-	mi := &file_minder_v1_minder_proto_msgTypes[178]
+func (x *EvaluationHistoryRemediation) Reset() {
+	*x = EvaluationHistoryRemediation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderParameter) String() string {
+func (x *EvaluationHistoryRemediation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderParameter) ProtoMessage() {}
+func (*EvaluationHistoryRemediation) ProtoMessage() {}
 
-func (x *ProviderParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[178]
+func (x *EvaluationHistoryRemediation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11053,70 +15009,52 @@ func (x *ProviderParameter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderParameter.ProtoReflect.Descriptor instead.
-func (*ProviderParameter) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{178}
+// Deprecated: Use EvaluationHistoryRemediation.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryRemediation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *ProviderParameter) GetParameters() isProviderParameter_Parameters {
+func (x *EvaluationHistoryRemediation) GetStatus() string {
 	if x != nil {
-		return x.Parameters
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *ProviderParameter) GetGithubApp() *GitHubAppParams {
+func (x *EvaluationHistoryRemediation) GetDetails() string {
 	if x != nil {
-		if x, ok := x.Parameters.(*ProviderParameter_GithubApp); ok {
-			return x.GithubApp
-		}
+		return x.Details
 	}
-	return nil
-}
-
-type isProviderParameter_Parameters interface {
-	isProviderParameter_Parameters()
-}
-
-type ProviderParameter_GithubApp struct {
-	GithubApp *GitHubAppParams `protobuf:"bytes,1,opt,name=github_app,json=githubApp,proto3,oneof"`
+	return ""
 }
 
-func (*ProviderParameter_GithubApp) isProviderParameter_Parameters() {}
-
-// GitHubAppParams is the parameters for a GitHub App provider.
-type GitHubAppParams struct {
+type EvaluationHistoryAlert struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The GitHub installation ID for the app.  On create, this is the only
-	// parameter used; the organization parameters are ignored.
-	InstallationId int64 `protobuf:"varint,1,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
-	// The GitHub organization slug where the app is installed.  This is an
-	// output-only parameter, and is validated on input if set (i.e. the value
-	// must be either empty or match the org of the installation_id).
-	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
-	// The GitHub organization ID where the app is installed.  This is an
-	// output-only parameter, and is validated on input if set (i.e. the value
-	// must be either empty or match the org of the installation_id).
-	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// status is one of (on, off, error, skipped, not available)
+	// not using enums to mirror the behaviour of the existing API contracts.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// details contains optional details about the alert.
+	// the structure and contents are alert specific, and are subject to change.
+	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitHubAppParams) Reset() {
-	*x = GitHubAppParams{}
-	mi := &file_minder_v1_minder_proto_msgTypes[179]
+func (x *EvaluationHistoryAlert) Reset() {
+	*x = EvaluationHistoryAlert{}
+	mi := &file_minder_v1_minder_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitHubAppParams) String() string {
+func (x *EvaluationHistoryAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubAppParams) ProtoMessage() {}
+func (*EvaluationHistoryAlert) ProtoMessage() {}
 
-func (x *GitHubAppParams) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[179]
+func (x *EvaluationHistoryAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11127,78 +15065,59 @@ func (x *GitHubAppParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubAppParams.ProtoReflect.Descriptor instead.
-func (*GitHubAppParams) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{179}
-}
-
-func (x *GitHubAppParams) GetInstallationId() int64 {
-	if x != nil {
-		return x.InstallationId
-	}
-	return 0
+// Deprecated: Use EvaluationHistoryAlert.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryAlert) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *GitHubAppParams) GetOrganization() string {
+func (x *EvaluationHistoryAlert) GetStatus() string {
 	if x != nil {
-		return x.Organization
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GitHubAppParams) GetOrganizationId() int64 {
+func (x *EvaluationHistoryAlert) GetDetails() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Details
 	}
-	return 0
+	return ""
 }
 
-// Provider represents a provider that is used to interact with external systems.
-// All fields are optional because we want to allow partial updates.
-type Provider struct {
+// used for parsing resources in ruletypes
+type EntityInstance struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the provider.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// class is the name of the provider implementation, eg. 'github' or 'gh-app'.
-	Class string `protobuf:"bytes,7,opt,name=class,proto3" json:"class,omitempty"`
-	// project is the project where the provider is.  This is ignored on input
-	// in favor of the context field in CreateProviderRequest.
-	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
-	// version is the version of the provider.
-	// if unset, "v1" is assumed.
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	// implements is the list of interfaces that the provider implements.
-	Implements []ProviderType `protobuf:"varint,4,rep,packed,name=implements,proto3,enum=minder.v1.ProviderType" json:"implements,omitempty"`
-	// config is the configuration of the provider.
-	Config *structpb.Struct `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
-	// auth_flows is the list of authorization flows that the provider supports.
-	AuthFlows []AuthorizationFlow `protobuf:"varint,6,rep,packed,name=auth_flows,json=authFlows,proto3,enum=minder.v1.AuthorizationFlow" json:"auth_flows,omitempty"`
-	// parameters is the list of parameters that the provider requires.
-	Parameters *ProviderParameter `protobuf:"bytes,8,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	// credentials_state is the state of the credentials for the provider.
-	// This is an output-only field. It may be: "set", "unset", "not_applicable".
-	CredentialsState string `protobuf:"bytes,9,opt,name=credentials_state,json=credentialsState,proto3" json:"credentials_state,omitempty"`
-	// id is the unique identifier of the provider.
-	Id            string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	// id is the unique identifier of the entity.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the context in which the entity is evaluated.
+	Context *ContextV2 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the entity.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the type of the entity.
+	// DISCUSSION: If we're aiming for a BYO entity type, we should probably
+	// have this be a string, and have the user provide the type.
+	Type Entity `protobuf:"varint,4,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
+	// properties is a map of properties of the entity.
+	Properties    *structpb.Struct `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Provider) Reset() {
-	*x = Provider{}
-	mi := &file_minder_v1_minder_proto_msgTypes[180]
+func (x *EntityInstance) Reset() {
+	*x = EntityInstance{}
+	mi := &file_minder_v1_minder_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Provider) String() string {
+func (x *EntityInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*EntityInstance) ProtoMessage() {}
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[180]
+func (x *EntityInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11209,110 +15128,136 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{180}
+// Deprecated: Use EntityInstance.ProtoReflect.Descriptor instead.
+func (*EntityInstance) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *Provider) GetName() string {
+func (x *EntityInstance) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Provider) GetClass() string {
+func (x *EntityInstance) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Class
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *Provider) GetProject() string {
+func (x *EntityInstance) GetName() string {
 	if x != nil {
-		return x.Project
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Provider) GetVersion() string {
+func (x *EntityInstance) GetType() Entity {
 	if x != nil {
-		return x.Version
+		return x.Type
 	}
-	return ""
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-func (x *Provider) GetImplements() []ProviderType {
+func (x *EntityInstance) GetProperties() *structpb.Struct {
 	if x != nil {
-		return x.Implements
+		return x.Properties
 	}
 	return nil
 }
 
-func (x *Provider) GetConfig() *structpb.Struct {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+// ListEntitiesRequest is the request message for the ListEntities method
+type ListEntitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the entities are listed
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// entity_type is the type of entity to list
+	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+	// cursor is the pagination cursor
+	Cursor        *Cursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Provider) GetAuthFlows() []AuthorizationFlow {
+func (x *ListEntitiesRequest) Reset() {
+	*x = ListEntitiesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntitiesRequest) ProtoMessage() {}
+
+func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[240]
 	if x != nil {
-		return x.AuthFlows
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Provider) GetParameters() *ProviderParameter {
+// Deprecated: Use ListEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *ListEntitiesRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Parameters
+		return x.Context
 	}
 	return nil
 }
 
-func (x *Provider) GetCredentialsState() string {
+func (x *ListEntitiesRequest) GetEntityType() Entity {
 	if x != nil {
-		return x.CredentialsState
+		return x.EntityType
 	}
-	return ""
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-func (x *Provider) GetId() string {
+func (x *ListEntitiesRequest) GetCursor() *Cursor {
 	if x != nil {
-		return x.Id
+		return x.Cursor
 	}
-	return ""
+	return nil
 }
 
-// GetEvaluationHistoryRequest represents a request for the GetEvaluationHistory endpoint
-type GetEvaluationHistoryRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Context *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
-	// If true, include structured rule output for the matched evaluations.
-	// Not all ruletypes may generate structured outputs.
-	// Because the evaluation output may be large, it is only returned
-	// when explicitly requested.
-	IncludeOutputs bool `protobuf:"varint,3,opt,name=include_outputs,json=includeOutputs,proto3" json:"include_outputs,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ListEntitiesResponse is the response message for the ListEntities method
+type ListEntitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results is the list of entities
+	Results []*EntityInstance `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// page is the pagination information
+	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEvaluationHistoryRequest) Reset() {
-	*x = GetEvaluationHistoryRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[181]
+func (x *ListEntitiesResponse) Reset() {
+	*x = ListEntitiesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvaluationHistoryRequest) String() string {
+func (x *ListEntitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvaluationHistoryRequest) ProtoMessage() {}
+func (*ListEntitiesResponse) ProtoMessage() {}
 
-func (x *GetEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[181]
+func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11323,88 +15268,51 @@ func (x *GetEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{181}
-}
-
-func (x *GetEvaluationHistoryRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *GetEvaluationHistoryRequest) GetContext() *Context {
+func (x *ListEntitiesResponse) GetResults() []*EntityInstance {
 	if x != nil {
-		return x.Context
+		return x.Results
 	}
 	return nil
 }
 
-func (x *GetEvaluationHistoryRequest) GetIncludeOutputs() bool {
+func (x *ListEntitiesResponse) GetPage() *CursorPage {
 	if x != nil {
-		return x.IncludeOutputs
+		return x.Page
 	}
-	return false
+	return nil
 }
 
-// ListEvaluationHistoryRequest represents a request message for the
-// ListEvaluationHistory RPC.
-//
-// Most of its fields are used for filtering, except for `cursor`
-// which is used for pagination.
-type ListEvaluationHistoryRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// List of entity types to retrieve.
-	EntityType []string `protobuf:"bytes,2,rep,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	// List of entity names to retrieve.
-	EntityName []string `protobuf:"bytes,3,rep,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
-	// List of profile names to retrieve.
-	ProfileName []string `protobuf:"bytes,4,rep,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
-	// List of evaluation statuses to retrieve.
-	Status []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
-	// List of remediation statuses to retrieve.
-	Remediation []string `protobuf:"bytes,6,rep,name=remediation,proto3" json:"remediation,omitempty"`
-	// List of alert statuses to retrieve.
-	Alert []string `protobuf:"bytes,7,rep,name=alert,proto3" json:"alert,omitempty"`
-	// Timestamp representing the start time of the selection window.
-	From *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"`
-	// Timestamp representing the end time of the selection window.
-	To *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
-	// Filter evaluation history to only those matching the specified labels.
-	//
-	// The default is to return all user-created profiles; the string "*" can
-	// be used to select all profiles, including system profiles.  This syntax
-	// may be expanded in the future.
-	LabelFilter []string `protobuf:"bytes,11,rep,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
-	// Cursor object to select the "page" of data to retrieve. This is optional.
-	Cursor *Cursor `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	// If true, include structured rule output for the matched evaluations.
-	// Not all ruletypes may generate structured outputs.
-	// Because the evaluation output may be large, it is only returned
-	// when explicitly requested.
-	IncludeOutputs bool `protobuf:"varint,12,opt,name=include_outputs,json=includeOutputs,proto3" json:"include_outputs,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GetEntityByIdRequest is the request message for the GetEntityById method
+type GetEntityByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the entity is evaluated
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the entity to get
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationHistoryRequest) Reset() {
-	*x = ListEvaluationHistoryRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[182]
+func (x *GetEntityByIdRequest) Reset() {
+	*x = GetEntityByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationHistoryRequest) String() string {
+func (x *GetEntityByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationHistoryRequest) ProtoMessage() {}
+func (*GetEntityByIdRequest) ProtoMessage() {}
 
-func (x *ListEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[182]
+func (x *GetEntityByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11415,120 +15323,159 @@ func (x *ListEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ListEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{182}
+// Deprecated: Use GetEntityByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{242}
 }
 
-func (x *ListEvaluationHistoryRequest) GetContext() *Context {
+func (x *GetEntityByIdRequest) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *ListEvaluationHistoryRequest) GetEntityType() []string {
+func (x *GetEntityByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetEntityByIdResponse is the response message for the GetEntityById method
+type GetEntityByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity is the entity that was retrieved
+	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntityByIdResponse) Reset() {
+	*x = GetEntityByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntityByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityByIdResponse) ProtoMessage() {}
+
+func (x *GetEntityByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[243]
 	if x != nil {
-		return x.EntityType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListEvaluationHistoryRequest) GetEntityName() []string {
-	if x != nil {
-		return x.EntityName
-	}
-	return nil
+// Deprecated: Use GetEntityByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{243}
 }
 
-func (x *ListEvaluationHistoryRequest) GetProfileName() []string {
+func (x *GetEntityByIdResponse) GetEntity() *EntityInstance {
 	if x != nil {
-		return x.ProfileName
+		return x.Entity
 	}
 	return nil
 }
 
-func (x *ListEvaluationHistoryRequest) GetStatus() []string {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+// GetEntityByNameRequest is the request message for the GetEntityByName method
+type GetEntityByNameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the entity is evaluated
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the entity to get
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// entity_type is the type of entity to get
+	EntityType    Entity `protobuf:"varint,3,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationHistoryRequest) GetRemediation() []string {
-	if x != nil {
-		return x.Remediation
-	}
-	return nil
+func (x *GetEntityByNameRequest) Reset() {
+	*x = GetEntityByNameRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationHistoryRequest) GetAlert() []string {
-	if x != nil {
-		return x.Alert
-	}
-	return nil
+func (x *GetEntityByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListEvaluationHistoryRequest) GetFrom() *timestamppb.Timestamp {
+func (*GetEntityByNameRequest) ProtoMessage() {}
+
+func (x *GetEntityByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[244]
 	if x != nil {
-		return x.From
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListEvaluationHistoryRequest) GetTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.To
-	}
-	return nil
+// Deprecated: Use GetEntityByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityByNameRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *ListEvaluationHistoryRequest) GetLabelFilter() []string {
+func (x *GetEntityByNameRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.LabelFilter
+		return x.Context
 	}
 	return nil
 }
 
-func (x *ListEvaluationHistoryRequest) GetCursor() *Cursor {
+func (x *GetEntityByNameRequest) GetName() string {
 	if x != nil {
-		return x.Cursor
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEvaluationHistoryRequest) GetIncludeOutputs() bool {
+func (x *GetEntityByNameRequest) GetEntityType() Entity {
 	if x != nil {
-		return x.IncludeOutputs
+		return x.EntityType
 	}
-	return false
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-// GetEvaluationHistoryResponse represents a response message for the
-// GetEvaluationHistory RPC.
-type GetEvaluationHistoryResponse struct {
+// GetEntityByNameResponse is the response message for the GetEntityByName method
+type GetEntityByNameResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The requested record
-	Evaluation    *EvaluationHistory `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	// entity is the entity that was retrieved
+	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEvaluationHistoryResponse) Reset() {
-	*x = GetEvaluationHistoryResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[183]
+func (x *GetEntityByNameResponse) Reset() {
+	*x = GetEntityByNameResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvaluationHistoryResponse) String() string {
+func (x *GetEntityByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvaluationHistoryResponse) ProtoMessage() {}
+func (*GetEntityByNameResponse) ProtoMessage() {}
 
-func (x *GetEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[183]
+func (x *GetEntityByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11539,49 +15486,44 @@ func (x *GetEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{183}
+// Deprecated: Use GetEntityByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityByNameResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *GetEvaluationHistoryResponse) GetEvaluation() *EvaluationHistory {
+func (x *GetEntityByNameResponse) GetEntity() *EntityInstance {
 	if x != nil {
-		return x.Evaluation
+		return x.Entity
 	}
 	return nil
 }
 
-// ListEvaluationHistoryResponse represents a response message for the
-// ListEvaluationHistory RPC.
-//
-// It ships a collection of records retrieved and pointers to get to
-// the next and/or previous pages of data.
-type ListEvaluationHistoryResponse struct {
+// DeleteEntityByIdRequest is the request message for the DeleteEntityById method
+type DeleteEntityByIdRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of records retrieved.
-	Data []*EvaluationHistory `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	// Metadata of the current page and pointers to next and/or
-	// previous pages.
-	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// context is the context in which the entity is evaluated
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the entity to delete
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationHistoryResponse) Reset() {
-	*x = ListEvaluationHistoryResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[184]
+func (x *DeleteEntityByIdRequest) Reset() {
+	*x = DeleteEntityByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationHistoryResponse) String() string {
+func (x *DeleteEntityByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationHistoryResponse) ProtoMessage() {}
+func (*DeleteEntityByIdRequest) ProtoMessage() {}
 
-func (x *ListEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[184]
+func (x *DeleteEntityByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11592,64 +15534,49 @@ func (x *ListEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
-func (*ListEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{184}
+// Deprecated: Use DeleteEntityByIdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntityByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{246}
 }
 
-func (x *ListEvaluationHistoryResponse) GetData() []*EvaluationHistory {
+func (x *DeleteEntityByIdRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Data
+		return x.Context
 	}
 	return nil
 }
 
-func (x *ListEvaluationHistoryResponse) GetPage() *CursorPage {
+func (x *DeleteEntityByIdRequest) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// EvaluationHistory represents the history of an entity evaluation.
-// This is only used in responses.
-type EvaluationHistory struct {
+// DeleteEntityByIdResponse is the response message for the DeleteEntityById method
+type DeleteEntityByIdResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity contains details of the entity which was evaluated.
-	Entity *EvaluationHistoryEntity `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	// rule contains details of the rule which the entity was evaluated against.
-	Rule *EvaluationHistoryRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	// status contains the evaluation status.
-	Status *EvaluationHistoryStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	// alert contains details of the alerts for this evaluation.
-	// This is optional.
-	Alert *EvaluationHistoryAlert `protobuf:"bytes,4,opt,name=alert,proto3" json:"alert,omitempty"`
-	// remediation contains details of the remediation for this evaluation.
-	// This is optional.
-	Remediation *EvaluationHistoryRemediation `protobuf:"bytes,5,opt,name=remediation,proto3" json:"remediation,omitempty"`
-	// created_at is the timestamp of creation of this evaluation
-	EvaluatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
-	// id is the unique identifier of the evaluation.
-	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	// id is the ID of the entity that was deleted
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistory) Reset() {
-	*x = EvaluationHistory{}
-	mi := &file_minder_v1_minder_proto_msgTypes[185]
+func (x *DeleteEntityByIdResponse) Reset() {
+	*x = DeleteEntityByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistory) String() string {
+func (x *DeleteEntityByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistory) ProtoMessage() {}
+func (*DeleteEntityByIdResponse) ProtoMessage() {}
 
-func (x *EvaluationHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[185]
+func (x *DeleteEntityByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11660,87 +15587,109 @@ func (x *EvaluationHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistory.ProtoReflect.Descriptor instead.
-func (*EvaluationHistory) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{185}
+// Deprecated: Use DeleteEntityByIdResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntityByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *EvaluationHistory) GetEntity() *EvaluationHistoryEntity {
+func (x *DeleteEntityByIdResponse) GetId() string {
 	if x != nil {
-		return x.Entity
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *EvaluationHistory) GetRule() *EvaluationHistoryRule {
-	if x != nil {
-		return x.Rule
-	}
-	return nil
+// RegisterEntityRequest is the request message for the RegisterEntity method
+type RegisterEntityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the entity is created
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// entity_type is the type of entity to create
+	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+	// identifying_properties uniquely identifies the entity in the provider.
+	// For example, for a GitHub repository use github/repo_owner and github/repo_name,
+	// or use upstream_id to identify by provider's internal ID.
+	// Each key maps to a value that can be a string, number, boolean, or nested structure.
+	IdentifyingProperties map[string]*structpb.Value `protobuf:"bytes,3,rep,name=identifying_properties,json=identifyingProperties,proto3" json:"identifying_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *EvaluationHistory) GetStatus() *EvaluationHistoryStatus {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+func (x *RegisterEntityRequest) Reset() {
+	*x = RegisterEntityRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistory) GetAlert() *EvaluationHistoryAlert {
+func (x *RegisterEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterEntityRequest) ProtoMessage() {}
+
+func (x *RegisterEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[248]
 	if x != nil {
-		return x.Alert
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *EvaluationHistory) GetRemediation() *EvaluationHistoryRemediation {
+// Deprecated: Use RegisterEntityRequest.ProtoReflect.Descriptor instead.
+func (*RegisterEntityRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *RegisterEntityRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Remediation
+		return x.Context
 	}
 	return nil
 }
 
-func (x *EvaluationHistory) GetEvaluatedAt() *timestamppb.Timestamp {
+func (x *RegisterEntityRequest) GetEntityType() Entity {
 	if x != nil {
-		return x.EvaluatedAt
+		return x.EntityType
 	}
-	return nil
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-func (x *EvaluationHistory) GetId() string {
+func (x *RegisterEntityRequest) GetIdentifyingProperties() map[string]*structpb.Value {
 	if x != nil {
-		return x.Id
+		return x.IdentifyingProperties
 	}
-	return ""
+	return nil
 }
 
-type EvaluationHistoryEntity struct {
+// RegisterEntityResponse is the response message for the RegisterEntity method
+type RegisterEntityResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the entity.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// type is the entity type.
-	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
-	// name is the entity name.
-	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// entity is the entity that was created
+	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryEntity) Reset() {
-	*x = EvaluationHistoryEntity{}
-	mi := &file_minder_v1_minder_proto_msgTypes[186]
+func (x *RegisterEntityResponse) Reset() {
+	*x = RegisterEntityResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryEntity) String() string {
+func (x *RegisterEntityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryEntity) ProtoMessage() {}
+func (*RegisterEntityResponse) ProtoMessage() {}
 
-func (x *EvaluationHistoryEntity) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[186]
+func (x *RegisterEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11751,61 +15700,53 @@ func (x *EvaluationHistoryEntity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryEntity.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryEntity) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{186}
-}
-
-func (x *EvaluationHistoryEntity) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *EvaluationHistoryEntity) GetType() Entity {
-	if x != nil {
-		return x.Type
-	}
-	return Entity_ENTITY_UNSPECIFIED
+// Deprecated: Use RegisterEntityResponse.ProtoReflect.Descriptor instead.
+func (*RegisterEntityResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *EvaluationHistoryEntity) GetName() string {
+func (x *RegisterEntityResponse) GetEntity() *EntityInstance {
 	if x != nil {
-		return x.Name
+		return x.Entity
 	}
-	return ""
+	return nil
 }
 
-type EvaluationHistoryRule struct {
+// UpstreamEntityRef providers enough information for the
+// provider to identify the entity in the upstream system.
+type UpstreamEntityRef struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the rule instance.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// type is the name of the rule type.
-	RuleType string `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	// profile is the name of the profile which contains the rule.
-	Profile string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
-	// severity is the severity of the rule type.
-	Severity      *Severity `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	// context is the context in which the entity is evaluated.
+	// Note that the context is included here since users of
+	// this message may return upstream references from
+	// multiple providers
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// type is the type of the entity.
+	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
+	// properties is a map of properties of the entity.
+	// This will be used to identify the entity in the upstream system
+	// and will be a subset of the properties of the entity that will
+	// be stored in Minder.
+	Properties    *structpb.Struct `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryRule) Reset() {
-	*x = EvaluationHistoryRule{}
-	mi := &file_minder_v1_minder_proto_msgTypes[187]
+func (x *UpstreamEntityRef) Reset() {
+	*x = UpstreamEntityRef{}
+	mi := &file_minder_v1_minder_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryRule) String() string {
+func (x *UpstreamEntityRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryRule) ProtoMessage() {}
+func (*UpstreamEntityRef) ProtoMessage() {}
 
-func (x *EvaluationHistoryRule) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[187]
+func (x *UpstreamEntityRef) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11816,71 +15757,79 @@ func (x *EvaluationHistoryRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryRule.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryRule) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{187}
-}
-
-func (x *EvaluationHistoryRule) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use UpstreamEntityRef.ProtoReflect.Descriptor instead.
+func (*UpstreamEntityRef) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{250}
 }
 
-func (x *EvaluationHistoryRule) GetRuleType() string {
+func (x *UpstreamEntityRef) GetContext() *ContextV2 {
 	if x != nil {
-		return x.RuleType
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *EvaluationHistoryRule) GetProfile() string {
+func (x *UpstreamEntityRef) GetType() Entity {
 	if x != nil {
-		return x.Profile
+		return x.Type
 	}
-	return ""
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-func (x *EvaluationHistoryRule) GetSeverity() *Severity {
+func (x *UpstreamEntityRef) GetProperties() *structpb.Struct {
 	if x != nil {
-		return x.Severity
+		return x.Properties
 	}
 	return nil
 }
 
-type EvaluationHistoryStatus struct {
+// DataSource is a Data source instance. Data sources represent
+// external integrations that enrich the data in Minder, but do not
+// have explicit lifecycle objects (entities).  Integrations which
+// create entities are called Providers.
+type DataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// status is one of (success, error, failure, skipped)
-	// not using enums to mirror the behaviour of the existing API contracts.
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// details contains optional details about the evaluation.
-	// the structure and contents are rule type specific, and are subject to change.
-	Details string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	// output optionally contains the structured rule evaluation output.
-	// Because output may be multiple KB, it is only returned
-	// if include_outputs is set. Historical evaluations may
-	// discard structured output sooner than status results.
-	Output        *structpb.Value `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// version is the version of the data source API.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// type is the data source type
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// context is the context in which the data source is evaluated.
+	// Note that in this case we only need the project in the
+	// context, since data sources are not provider-specific.
+	Context *ContextV2 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the data source.
+	// Note that this is unique within a project hierarchy.
+	// Names must be lowercase and can only contain letters, numbers,
+	// hyphens, and underscores.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// id is the unique identifier of the data source.
+	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Driver:
+	//
+	//	*DataSource_Structured
+	//	*DataSource_Rest
+	//	*DataSource_Graphql
+	//	*DataSource_Sql
+	Driver        isDataSource_Driver `protobuf_oneof:"driver"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryStatus) Reset() {
-	*x = EvaluationHistoryStatus{}
-	mi := &file_minder_v1_minder_proto_msgTypes[188]
+func (x *DataSource) Reset() {
+	*x = DataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryStatus) String() string {
+func (x *DataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryStatus) ProtoMessage() {}
+func (*DataSource) ProtoMessage() {}
 
-func (x *EvaluationHistoryStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[188]
+func (x *DataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11891,115 +15840,145 @@ func (x *EvaluationHistoryStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryStatus.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryStatus) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{188}
+// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{251}
 }
 
-func (x *EvaluationHistoryStatus) GetStatus() string {
+func (x *DataSource) GetVersion() string {
 	if x != nil {
-		return x.Status
+		return x.Version
 	}
 	return ""
 }
 
-func (x *EvaluationHistoryStatus) GetDetails() string {
+func (x *DataSource) GetType() string {
 	if x != nil {
-		return x.Details
+		return x.Type
 	}
 	return ""
 }
 
-func (x *EvaluationHistoryStatus) GetOutput() *structpb.Value {
+func (x *DataSource) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Output
+		return x.Context
 	}
 	return nil
 }
 
-type EvaluationHistoryRemediation struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// status is one of (success, error, failure, skipped, not available)
-	// not using enums to mirror the behaviour of the existing API contracts.
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// details contains optional details about the remediation.
-	// the structure and contents are remediation specific, and are subject to change.
-	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DataSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *EvaluationHistoryRemediation) Reset() {
-	*x = EvaluationHistoryRemediation{}
-	mi := &file_minder_v1_minder_proto_msgTypes[189]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DataSource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *EvaluationHistoryRemediation) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DataSource) GetDriver() isDataSource_Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
 }
 
-func (*EvaluationHistoryRemediation) ProtoMessage() {}
+func (x *DataSource) GetStructured() *StructDataSource {
+	if x != nil {
+		if x, ok := x.Driver.(*DataSource_Structured); ok {
+			return x.Structured
+		}
+	}
+	return nil
+}
 
-func (x *EvaluationHistoryRemediation) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[189]
+func (x *DataSource) GetRest() *RestDataSource {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Driver.(*DataSource_Rest); ok {
+			return x.Rest
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
+}
+
+func (x *DataSource) GetGraphql() *GraphQLDataSource {
+	if x != nil {
+		if x, ok := x.Driver.(*DataSource_Graphql); ok {
+			return x.Graphql
+		}
+	}
+	return nil
+}
+
+func (x *DataSource) GetSql() *SQLDataSource {
+	if x != nil {
+		if x, ok := x.Driver.(*DataSource_Sql); ok {
+			return x.Sql
+		}
+	}
+	return nil
 }
 
-// Deprecated: Use EvaluationHistoryRemediation.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryRemediation) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{189}
+type isDataSource_Driver interface {
+	isDataSource_Driver()
 }
 
-func (x *EvaluationHistoryRemediation) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type DataSource_Structured struct {
+	// structured is the structired data - data source.
+	Structured *StructDataSource `protobuf:"bytes,8,opt,name=structured,proto3,oneof"`
 }
 
-func (x *EvaluationHistoryRemediation) GetDetails() string {
-	if x != nil {
-		return x.Details
-	}
-	return ""
+type DataSource_Rest struct {
+	// rest is the REST data source driver.
+	Rest *RestDataSource `protobuf:"bytes,6,opt,name=rest,proto3,oneof"`
 }
 
-type EvaluationHistoryAlert struct {
+type DataSource_Graphql struct {
+	// graphql is the GraphQL data source driver.
+	Graphql *GraphQLDataSource `protobuf:"bytes,9,opt,name=graphql,proto3,oneof"`
+}
+
+type DataSource_Sql struct {
+	// sql is the SQL data source driver.
+	Sql *SQLDataSource `protobuf:"bytes,10,opt,name=sql,proto3,oneof"`
+}
+
+func (*DataSource_Structured) isDataSource_Driver() {}
+
+func (*DataSource_Rest) isDataSource_Driver() {}
+
+func (*DataSource_Graphql) isDataSource_Driver() {}
+
+func (*DataSource_Sql) isDataSource_Driver() {}
+
+// StructDataSource is the structured data source driver.
+type StructDataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// status is one of (on, off, error, skipped, not available)
-	// not using enums to mirror the behaviour of the existing API contracts.
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// details contains optional details about the alert.
-	// the structure and contents are alert specific, and are subject to change.
-	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	// defs is the list of definitions for the structured data API.
+	Def           map[string]*StructDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryAlert) Reset() {
-	*x = EvaluationHistoryAlert{}
-	mi := &file_minder_v1_minder_proto_msgTypes[190]
+func (x *StructDataSource) Reset() {
+	*x = StructDataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryAlert) String() string {
+func (x *StructDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryAlert) ProtoMessage() {}
+func (*StructDataSource) ProtoMessage() {}
 
-func (x *EvaluationHistoryAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[190]
+func (x *StructDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12010,59 +15989,49 @@ func (x *EvaluationHistoryAlert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryAlert.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryAlert) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{190}
-}
-
-func (x *EvaluationHistoryAlert) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use StructDataSource.ProtoReflect.Descriptor instead.
+func (*StructDataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{252}
 }
 
-func (x *EvaluationHistoryAlert) GetDetails() string {
+func (x *StructDataSource) GetDef() map[string]*StructDataSource_Def {
 	if x != nil {
-		return x.Details
+		return x.Def
 	}
-	return ""
+	return nil
 }
 
-// used for parsing resources in ruletypes
-type EntityInstance struct {
+// RestDataSource is the REST data source driver.
+type RestDataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the entity.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// context is the context in which the entity is evaluated.
-	Context *ContextV2 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the entity.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// type is the type of the entity.
-	// DISCUSSION: If we're aiming for a BYO entity type, we should probably
-	// have this be a string, and have the user provide the type.
-	Type Entity `protobuf:"varint,4,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
-	// properties is a map of properties of the entity.
-	Properties    *structpb.Struct `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
+	// defs is the list of definitions for the REST API.
+	Def map[string]*RestDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// provider_auth enables provider authentication for this data source.
+	// When enabled, the data source will use the provider's authentication
+	// credentials to make requests.
+	ProviderAuth bool `protobuf:"varint,2,opt,name=provider_auth,json=providerAuth,proto3" json:"provider_auth,omitempty"`
+	// rate_limit limits the rate of the requests made by all the functions
+	// of this data source. Cached responses don't count towards it.
+	RateLimit     *RestDataSource_RateLimit `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityInstance) Reset() {
-	*x = EntityInstance{}
-	mi := &file_minder_v1_minder_proto_msgTypes[191]
+func (x *RestDataSource) Reset() {
+	*x = RestDataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityInstance) String() string {
+func (x *RestDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityInstance) ProtoMessage() {}
+func (*RestDataSource) ProtoMessage() {}
 
-func (x *EntityInstance) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[191]
+func (x *RestDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12073,74 +16042,60 @@ func (x *EntityInstance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityInstance.ProtoReflect.Descriptor instead.
-func (*EntityInstance) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{191}
-}
-
-func (x *EntityInstance) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use RestDataSource.ProtoReflect.Descriptor instead.
+func (*RestDataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{253}
 }
 
-func (x *EntityInstance) GetContext() *ContextV2 {
+func (x *RestDataSource) GetDef() map[string]*RestDataSource_Def {
 	if x != nil {
-		return x.Context
+		return x.Def
 	}
 	return nil
 }
 
-func (x *EntityInstance) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *EntityInstance) GetType() Entity {
+func (x *RestDataSource) GetProviderAuth() bool {
 	if x != nil {
-		return x.Type
+		return x.ProviderAuth
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return false
 }
 
-func (x *EntityInstance) GetProperties() *structpb.Struct {
+func (x *RestDataSource) GetRateLimit() *RestDataSource_RateLimit {
 	if x != nil {
-		return x.Properties
+		return x.RateLimit
 	}
 	return nil
 }
 
-// ListEntitiesRequest is the request message for the ListEntities method
-type ListEntitiesRequest struct {
+// GraphQLDataSource is the GraphQL data source driver.
+type GraphQLDataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entities are listed
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// entity_type is the type of entity to list
-	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
-	// cursor is the pagination cursor
-	Cursor        *Cursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// defs is the list of definitions for the GraphQL API.
+	Def map[string]*GraphQLDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// provider_auth enables provider authentication for this data source.
+	// When enabled, the data source will use the provider's authentication
+	// credentials to make requests.
+	ProviderAuth  bool `protobuf:"varint,2,opt,name=provider_auth,json=providerAuth,proto3" json:"provider_auth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEntitiesRequest) Reset() {
-	*x = ListEntitiesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[192]
+func (x *GraphQLDataSource) Reset() {
+	*x = GraphQLDataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEntitiesRequest) String() string {
+func (x *GraphQLDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntitiesRequest) ProtoMessage() {}
+func (*GraphQLDataSource) ProtoMessage() {}
 
-func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[192]
+func (x *GraphQLDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12151,58 +16106,55 @@ func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntitiesRequest.ProtoReflect.Descriptor instead.
-func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{192}
+// Deprecated: Use GraphQLDataSource.ProtoReflect.Descriptor instead.
+func (*GraphQLDataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{254}
 }
 
-func (x *ListEntitiesRequest) GetContext() *ContextV2 {
+func (x *GraphQLDataSource) GetDef() map[string]*GraphQLDataSource_Def {
 	if x != nil {
-		return x.Context
+		return x.Def
 	}
 	return nil
 }
 
-func (x *ListEntitiesRequest) GetEntityType() Entity {
-	if x != nil {
-		return x.EntityType
-	}
-	return Entity_ENTITY_UNSPECIFIED
-}
-
-func (x *ListEntitiesRequest) GetCursor() *Cursor {
+func (x *GraphQLDataSource) GetProviderAuth() bool {
 	if x != nil {
-		return x.Cursor
+		return x.ProviderAuth
 	}
-	return nil
+	return false
 }
 
-// ListEntitiesResponse is the response message for the ListEntities method
-type ListEntitiesResponse struct {
+// SQLDataSource is the SQL data source driver. It runs read-only queries
+// against a PostgreSQL database.
+type SQLDataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// results is the list of entities
-	Results []*EntityInstance `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	// page is the pagination information
-	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// defs is the list of definitions for the SQL data source.
+	Def map[string]*SQLDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// connection_string is the PostgreSQL connection string, as a URL or
+	// as key/value pairs. It is stored encrypted and is never returned;
+	// when updating a data source, it may be left unset to keep the
+	// current one.
+	ConnectionString string `protobuf:"bytes,2,opt,name=connection_string,json=connectionString,proto3" json:"connection_string,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListEntitiesResponse) Reset() {
-	*x = ListEntitiesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[193]
+func (x *SQLDataSource) Reset() {
+	*x = SQLDataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEntitiesResponse) String() string {
+func (x *SQLDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntitiesResponse) ProtoMessage() {}
+func (*SQLDataSource) ProtoMessage() {}
 
-func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[193]
+func (x *SQLDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12213,51 +16165,55 @@ func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntitiesResponse.ProtoReflect.Descriptor instead.
-func (*ListEntitiesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{193}
+// Deprecated: Use SQLDataSource.ProtoReflect.Descriptor instead.
+func (*SQLDataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *ListEntitiesResponse) GetResults() []*EntityInstance {
+func (x *SQLDataSource) GetDef() map[string]*SQLDataSource_Def {
 	if x != nil {
-		return x.Results
+		return x.Def
 	}
 	return nil
 }
 
-func (x *ListEntitiesResponse) GetPage() *CursorPage {
+func (x *SQLDataSource) GetConnectionString() string {
 	if x != nil {
-		return x.Page
+		return x.ConnectionString
 	}
-	return nil
+	return ""
 }
 
-// GetEntityByIdRequest is the request message for the GetEntityById method
-type GetEntityByIdRequest struct {
+// DataSourceReference is a reference to a data source.
+// Note that for a resource to refer to a data source the data source must
+// be available in the same project hierarchy.
+type DataSourceReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the ID of the entity to get
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the data source within the project hierarchy.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// alias is the alias used to refer to the data source in the rule
+	// definition.
+	// If left unset, it will default to the name of the data source.
+	Alias         string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityByIdRequest) Reset() {
-	*x = GetEntityByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[194]
+func (x *DataSourceReference) Reset() {
+	*x = DataSourceReference{}
+	mi := &file_minder_v1_minder_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByIdRequest) String() string {
+func (x *DataSourceReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByIdRequest) ProtoMessage() {}
+func (*DataSourceReference) ProtoMessage() {}
 
-func (x *GetEntityByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[194]
+func (x *DataSourceReference) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12268,49 +16224,48 @@ func (x *GetEntityByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetEntityByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{194}
+// Deprecated: Use DataSourceReference.ProtoReflect.Descriptor instead.
+func (*DataSourceReference) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{256}
 }
 
-func (x *GetEntityByIdRequest) GetContext() *ContextV2 {
+func (x *DataSourceReference) GetName() string {
 	if x != nil {
-		return x.Context
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetEntityByIdRequest) GetId() string {
+func (x *DataSourceReference) GetAlias() string {
 	if x != nil {
-		return x.Id
+		return x.Alias
 	}
 	return ""
 }
 
-// GetEntityByIdResponse is the response message for the GetEntityById method
-type GetEntityByIdResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity that was retrieved
-	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+type RegisterRepoResult_Status struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *GetEntityByIdResponse) Reset() {
-	*x = GetEntityByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[195]
+
+func (x *RegisterRepoResult_Status) Reset() {
+	*x = RegisterRepoResult_Status{}
+	mi := &file_minder_v1_minder_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByIdResponse) String() string {
+func (x *RegisterRepoResult_Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByIdResponse) ProtoMessage() {}
+func (*RegisterRepoResult_Status) ProtoMessage() {}
 
-func (x *GetEntityByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[195]
+func (x *RegisterRepoResult_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12321,46 +16276,51 @@ func (x *GetEntityByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetEntityByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{195}
+// Deprecated: Use RegisterRepoResult_Status.ProtoReflect.Descriptor instead.
+func (*RegisterRepoResult_Status) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{32, 0}
 }
 
-func (x *GetEntityByIdResponse) GetEntity() *EntityInstance {
+func (x *RegisterRepoResult_Status) GetSuccess() bool {
 	if x != nil {
-		return x.Entity
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// GetEntityByNameRequest is the request message for the GetEntityByName method
-type GetEntityByNameRequest struct {
+func (x *RegisterRepoResult_Status) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type ListEvaluationResultsResponse_EntityProfileEvaluationResults struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the entity to get
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// entity_type is the type of entity to get
-	EntityType    Entity `protobuf:"varint,3,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+	// profile_status is the status of the profile - id, name, status, last_updated
+	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
+	// Note that some fields like profile_id and entity might be empty
+	// Eventually we might replace this type with another one that fits the API better
+	Results       []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityByNameRequest) Reset() {
-	*x = GetEntityByNameRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[196]
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) Reset() {
+	*x = ListEvaluationResultsResponse_EntityProfileEvaluationResults{}
+	mi := &file_minder_v1_minder_proto_msgTypes[260]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByNameRequest) String() string {
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByNameRequest) ProtoMessage() {}
+func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoMessage() {}
 
-func (x *GetEntityByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[196]
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[260]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12371,56 +16331,48 @@ func (x *GetEntityByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetEntityByNameRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{196}
+// Deprecated: Use ListEvaluationResultsResponse_EntityProfileEvaluationResults.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{137, 0}
 }
 
-func (x *GetEntityByNameRequest) GetContext() *ContextV2 {
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetProfileStatus() *ProfileStatus {
 	if x != nil {
-		return x.Context
+		return x.ProfileStatus
 	}
 	return nil
 }
 
-func (x *GetEntityByNameRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetEntityByNameRequest) GetEntityType() Entity {
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetResults() []*RuleEvaluationStatus {
 	if x != nil {
-		return x.EntityType
+		return x.Results
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return nil
 }
 
-// GetEntityByNameResponse is the response message for the GetEntityByName method
-type GetEntityByNameResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity that was retrieved
-	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+type ListEvaluationResultsResponse_EntityEvaluationResults struct {
+	state         protoimpl.MessageState                                          `protogen:"open.v1"`
+	Entity        *EntityTypedId                                                  `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Profiles      []*ListEvaluationResultsResponse_EntityProfileEvaluationResults `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityByNameResponse) Reset() {
-	*x = GetEntityByNameResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[197]
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) Reset() {
+	*x = ListEvaluationResultsResponse_EntityEvaluationResults{}
+	mi := &file_minder_v1_minder_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByNameResponse) String() string {
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByNameResponse) ProtoMessage() {}
+func (*ListEvaluationResultsResponse_EntityEvaluationResults) ProtoMessage() {}
 
-func (x *GetEntityByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[197]
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12431,44 +16383,49 @@ func (x *GetEntityByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetEntityByNameResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{197}
+// Deprecated: Use ListEvaluationResultsResponse_EntityEvaluationResults.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsResponse_EntityEvaluationResults) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{137, 1}
 }
 
-func (x *GetEntityByNameResponse) GetEntity() *EntityInstance {
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetEntity() *EntityTypedId {
 	if x != nil {
 		return x.Entity
 	}
 	return nil
 }
 
-// DeleteEntityByIdRequest is the request message for the DeleteEntityById method
-type DeleteEntityByIdRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the ID of the entity to delete
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetProfiles() []*ListEvaluationResultsResponse_EntityProfileEvaluationResults {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type RestType_Fallback struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HttpCode int32                  `protobuf:"varint,1,opt,name=http_code,json=httpCode,proto3" json:"http_code,omitempty"`
+	// This is expected to be a valid JSON string.
+	Body          string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntityByIdRequest) Reset() {
-	*x = DeleteEntityByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[198]
+func (x *RestType_Fallback) Reset() {
+	*x = RestType_Fallback{}
+	mi := &file_minder_v1_minder_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntityByIdRequest) String() string {
+func (x *RestType_Fallback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntityByIdRequest) ProtoMessage() {}
+func (*RestType_Fallback) ProtoMessage() {}
 
-func (x *DeleteEntityByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[198]
+func (x *RestType_Fallback) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12479,49 +16436,51 @@ func (x *DeleteEntityByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntityByIdRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEntityByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{198}
+// Deprecated: Use RestType_Fallback.ProtoReflect.Descriptor instead.
+func (*RestType_Fallback) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{140, 0}
 }
 
-func (x *DeleteEntityByIdRequest) GetContext() *ContextV2 {
+func (x *RestType_Fallback) GetHttpCode() int32 {
 	if x != nil {
-		return x.Context
+		return x.HttpCode
 	}
-	return nil
+	return 0
 }
 
-func (x *DeleteEntityByIdRequest) GetId() string {
+func (x *RestType_Fallback) GetBody() string {
 	if x != nil {
-		return x.Id
+		return x.Body
 	}
 	return ""
 }
 
-// DeleteEntityByIdResponse is the response message for the DeleteEntityById method
-type DeleteEntityByIdResponse struct {
+type DiffType_Ecosystem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the ID of the entity that was deleted
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the ecosystem.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// depfile is the file that contains the dependencies for this ecosystem.
+	// The file name may be a glob, such as "*.csproj".
+	Depfile       string `protobuf:"bytes,2,opt,name=depfile,proto3" json:"depfile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntityByIdResponse) Reset() {
-	*x = DeleteEntityByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[199]
+func (x *DiffType_Ecosystem) Reset() {
+	*x = DiffType_Ecosystem{}
+	mi := &file_minder_v1_minder_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntityByIdResponse) String() string {
+func (x *DiffType_Ecosystem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntityByIdResponse) ProtoMessage() {}
+func (*DiffType_Ecosystem) ProtoMessage() {}
 
-func (x *DeleteEntityByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[199]
+func (x *DiffType_Ecosystem) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12532,49 +16491,49 @@ func (x *DeleteEntityByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntityByIdResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEntityByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{199}
+// Deprecated: Use DiffType_Ecosystem.ProtoReflect.Descriptor instead.
+func (*DiffType_Ecosystem) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{144, 0}
 }
 
-func (x *DeleteEntityByIdResponse) GetId() string {
+func (x *DiffType_Ecosystem) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-// RegisterEntityRequest is the request message for the RegisterEntity method
-type RegisterEntityRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is created
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// entity_type is the type of entity to create
-	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
-	// identifying_properties uniquely identifies the entity in the provider.
-	// For example, for a GitHub repository use github/repo_owner and github/repo_name,
-	// or use upstream_id to identify by provider's internal ID.
-	// Each key maps to a value that can be a string, number, boolean, or nested structure.
-	IdentifyingProperties map[string]*structpb.Value `protobuf:"bytes,3,rep,name=identifying_properties,json=identifyingProperties,proto3" json:"identifying_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *DiffType_Ecosystem) GetDepfile() string {
+	if x != nil {
+		return x.Depfile
+	}
+	return ""
 }
 
-func (x *RegisterEntityRequest) Reset() {
-	*x = RegisterEntityRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[200]
+// branch is the branch of the git repository, when applied to repository entities.
+// Has no meaning or effect on other entity types.
+type DepsType_RepoConfigs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branch        string                 `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepsType_RepoConfigs) Reset() {
+	*x = DepsType_RepoConfigs{}
+	mi := &file_minder_v1_minder_proto_msgTypes[264]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterEntityRequest) String() string {
+func (x *DepsType_RepoConfigs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterEntityRequest) ProtoMessage() {}
+func (*DepsType_RepoConfigs) ProtoMessage() {}
 
-func (x *RegisterEntityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[200]
+func (x *DepsType_RepoConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[264]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12585,56 +16544,41 @@ func (x *RegisterEntityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterEntityRequest.ProtoReflect.Descriptor instead.
-func (*RegisterEntityRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{200}
-}
-
-func (x *RegisterEntityRequest) GetContext() *ContextV2 {
-	if x != nil {
-		return x.Context
-	}
-	return nil
-}
-
-func (x *RegisterEntityRequest) GetEntityType() Entity {
-	if x != nil {
-		return x.EntityType
-	}
-	return Entity_ENTITY_UNSPECIFIED
+// Deprecated: Use DepsType_RepoConfigs.ProtoReflect.Descriptor instead.
+func (*DepsType_RepoConfigs) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{145, 0}
 }
 
-func (x *RegisterEntityRequest) GetIdentifyingProperties() map[string]*structpb.Value {
+func (x *DepsType_RepoConfigs) GetBranch() string {
 	if x != nil {
-		return x.IdentifyingProperties
+		return x.Branch
 	}
-	return nil
+	return ""
 }
 
-// RegisterEntityResponse is the response message for the RegisterEntity method
-type RegisterEntityResponse struct {
+type DepsType_PullRequestConfigs struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity that was created
-	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// filter is the filter to apply to the PRs.  The default value is "NEW_AND_UPDATED".
+	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *RegisterEntityResponse) Reset() {
-	*x = RegisterEntityResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[201]
+
+func (x *DepsType_PullRequestConfigs) Reset() {
+	*x = DepsType_PullRequestConfigs{}
+	mi := &file_minder_v1_minder_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterEntityResponse) String() string {
+func (x *DepsType_PullRequestConfigs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterEntityResponse) ProtoMessage() {}
+func (*DepsType_PullRequestConfigs) ProtoMessage() {}
 
-func (x *RegisterEntityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[201]
+func (x *DepsType_PullRequestConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12645,53 +16589,52 @@ func (x *RegisterEntityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterEntityResponse.ProtoReflect.Descriptor instead.
-func (*RegisterEntityResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{201}
+// Deprecated: Use DepsType_PullRequestConfigs.ProtoReflect.Descriptor instead.
+func (*DepsType_PullRequestConfigs) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{145, 1}
 }
 
-func (x *RegisterEntityResponse) GetEntity() *EntityInstance {
+func (x *DepsType_PullRequestConfigs) GetFilter() string {
 	if x != nil {
-		return x.Entity
+		return x.Filter
 	}
-	return nil
+	return ""
 }
 
-// UpstreamEntityRef providers enough information for the
-// provider to identify the entity in the upstream system.
-type UpstreamEntityRef struct {
+// Definition defines the rule type. It encompases the schema and the data evaluation.
+type RuleType_Definition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated.
-	// Note that the context is included here since users of
-	// this message may return upstream references from
-	// multiple providers
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// type is the type of the entity.
-	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
-	// properties is a map of properties of the entity.
-	// This will be used to identify the entity in the upstream system
-	// and will be a subset of the properties of the entity that will
-	// be stored in Minder.
-	Properties    *structpb.Struct `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`
+	// in_entity is the entity in which the rule is evaluated.
+	// This can be repository, build_environment or artifact.
+	InEntity string `protobuf:"bytes,1,opt,name=in_entity,json=inEntity,proto3" json:"in_entity,omitempty"`
+	// rule_schema is the schema of the rule. This is expressed in JSON Schema.
+	RuleSchema *structpb.Struct `protobuf:"bytes,2,opt,name=rule_schema,json=ruleSchema,proto3" json:"rule_schema,omitempty"`
+	// param_schema is the schema of the parameters that are passed to the rule.
+	// This is expressed in JSON Schema.
+	ParamSchema   *structpb.Struct               `protobuf:"bytes,3,opt,name=param_schema,json=paramSchema,proto3,oneof" json:"param_schema,omitempty"`
+	Ingest        *RuleType_Definition_Ingest    `protobuf:"bytes,4,opt,name=ingest,proto3" json:"ingest,omitempty"`
+	Eval          *RuleType_Definition_Eval      `protobuf:"bytes,5,opt,name=eval,proto3" json:"eval,omitempty"`
+	Remediate     *RuleType_Definition_Remediate `protobuf:"bytes,6,opt,name=remediate,proto3" json:"remediate,omitempty"`
+	Alert         *RuleType_Definition_Alert     `protobuf:"bytes,7,opt,name=alert,proto3" json:"alert,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpstreamEntityRef) Reset() {
-	*x = UpstreamEntityRef{}
-	mi := &file_minder_v1_minder_proto_msgTypes[202]
+func (x *RuleType_Definition) Reset() {
+	*x = RuleType_Definition{}
+	mi := &file_minder_v1_minder_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpstreamEntityRef) String() string {
+func (x *RuleType_Definition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpstreamEntityRef) ProtoMessage() {}
+func (*RuleType_Definition) ProtoMessage() {}
 
-func (x *UpstreamEntityRef) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[202]
+func (x *RuleType_Definition) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12702,77 +16645,105 @@ func (x *UpstreamEntityRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpstreamEntityRef.ProtoReflect.Descriptor instead.
-func (*UpstreamEntityRef) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{202}
+// Deprecated: Use RuleType_Definition.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0}
 }
 
-func (x *UpstreamEntityRef) GetContext() *ContextV2 {
+func (x *RuleType_Definition) GetInEntity() string {
 	if x != nil {
-		return x.Context
+		return x.InEntity
+	}
+	return ""
+}
+
+func (x *RuleType_Definition) GetRuleSchema() *structpb.Struct {
+	if x != nil {
+		return x.RuleSchema
 	}
 	return nil
 }
 
-func (x *UpstreamEntityRef) GetType() Entity {
+func (x *RuleType_Definition) GetParamSchema() *structpb.Struct {
 	if x != nil {
-		return x.Type
+		return x.ParamSchema
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return nil
 }
 
-func (x *UpstreamEntityRef) GetProperties() *structpb.Struct {
+func (x *RuleType_Definition) GetIngest() *RuleType_Definition_Ingest {
 	if x != nil {
-		return x.Properties
+		return x.Ingest
 	}
 	return nil
 }
 
-// DataSource is a Data source instance. Data sources represent
-// external integrations that enrich the data in Minder, but do not
-// have explicit lifecycle objects (entities).  Integrations which
-// create entities are called Providers.
-type DataSource struct {
+func (x *RuleType_Definition) GetEval() *RuleType_Definition_Eval {
+	if x != nil {
+		return x.Eval
+	}
+	return nil
+}
+
+func (x *RuleType_Definition) GetRemediate() *RuleType_Definition_Remediate {
+	if x != nil {
+		return x.Remediate
+	}
+	return nil
+}
+
+func (x *RuleType_Definition) GetAlert() *RuleType_Definition_Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+// Ingest defines how the data is ingested.
+type RuleType_Definition_Ingest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// version is the version of the data source API.
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// type is the data source type
-	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	// context is the context in which the data source is evaluated.
-	// Note that in this case we only need the project in the
-	// context, since data sources are not provider-specific.
-	Context *ContextV2 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the data source.
-	// Note that this is unique within a project hierarchy.
-	// Names must be lowercase and can only contain letters, numbers,
-	// hyphens, and underscores.
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// id is the unique identifier of the data source.
-	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are valid to be assigned to Driver:
-	//
-	//	*DataSource_Structured
-	//	*DataSource_Rest
-	Driver        isDataSource_Driver `protobuf_oneof:"driver"`
+	// type is the type of the data ingestion.
+	// we currently support rest, artifact and builtin.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// rest is the rest data ingestion.
+	// this is only used if the type is rest.
+	Rest *RestType `protobuf:"bytes,3,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
+	// builtin is the builtin data ingestion.
+	Builtin *BuiltinType `protobuf:"bytes,4,opt,name=builtin,proto3,oneof" json:"builtin,omitempty"`
+	// artifact is the artifact data ingestion.
+	// artifact currently only applies to artifacts.
+	Artifact *ArtifactType `protobuf:"bytes,5,opt,name=artifact,proto3,oneof" json:"artifact,omitempty"`
+	// git is the git data ingestion.
+	// git currently only applies to repositories.
+	Git *GitType `protobuf:"bytes,6,opt,name=git,proto3,oneof" json:"git,omitempty"`
+	// diff is the diff data ingestion.
+	// diff currently only applies to pull_requests.
+	Diff *DiffType `protobuf:"bytes,7,opt,name=diff,proto3,oneof" json:"diff,omitempty"`
+	// deps is the deps data ingestion.
+	// deps currently only applies to repositories.
+	Deps *DepsType `protobuf:"bytes,8,opt,name=deps,proto3,oneof" json:"deps,omitempty"`
+	// sbom is the sbom data ingestion.
+	// sbom applies to repositories and, with the attestation source, artifacts.
+	Sbom          *SBOMType `protobuf:"bytes,9,opt,name=sbom,proto3,oneof" json:"sbom,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataSource) Reset() {
-	*x = DataSource{}
-	mi := &file_minder_v1_minder_proto_msgTypes[203]
+func (x *RuleType_Definition_Ingest) Reset() {
+	*x = RuleType_Definition_Ingest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[267]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataSource) String() string {
+func (x *RuleType_Definition_Ingest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataSource) ProtoMessage() {}
+func (*RuleType_Definition_Ingest) ProtoMessage() {}
 
-func (x *DataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[203]
+func (x *RuleType_Definition_Ingest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[267]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12783,113 +16754,115 @@ func (x *DataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
-func (*DataSource) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{203}
+// Deprecated: Use RuleType_Definition_Ingest.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Ingest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 0}
 }
 
-func (x *DataSource) GetVersion() string {
+func (x *RuleType_Definition_Ingest) GetType() string {
 	if x != nil {
-		return x.Version
+		return x.Type
 	}
 	return ""
 }
 
-func (x *DataSource) GetType() string {
+func (x *RuleType_Definition_Ingest) GetRest() *RestType {
 	if x != nil {
-		return x.Type
+		return x.Rest
 	}
-	return ""
+	return nil
 }
 
-func (x *DataSource) GetContext() *ContextV2 {
+func (x *RuleType_Definition_Ingest) GetBuiltin() *BuiltinType {
 	if x != nil {
-		return x.Context
+		return x.Builtin
 	}
 	return nil
 }
 
-func (x *DataSource) GetName() string {
+func (x *RuleType_Definition_Ingest) GetArtifact() *ArtifactType {
 	if x != nil {
-		return x.Name
+		return x.Artifact
 	}
-	return ""
+	return nil
 }
 
-func (x *DataSource) GetId() string {
+func (x *RuleType_Definition_Ingest) GetGit() *GitType {
 	if x != nil {
-		return x.Id
+		return x.Git
 	}
-	return ""
+	return nil
 }
 
-func (x *DataSource) GetDriver() isDataSource_Driver {
+func (x *RuleType_Definition_Ingest) GetDiff() *DiffType {
 	if x != nil {
-		return x.Driver
+		return x.Diff
 	}
 	return nil
 }
 
-func (x *DataSource) GetStructured() *StructDataSource {
+func (x *RuleType_Definition_Ingest) GetDeps() *DepsType {
 	if x != nil {
-		if x, ok := x.Driver.(*DataSource_Structured); ok {
-			return x.Structured
-		}
+		return x.Deps
 	}
 	return nil
 }
 
-func (x *DataSource) GetRest() *RestDataSource {
+func (x *RuleType_Definition_Ingest) GetSbom() *SBOMType {
 	if x != nil {
-		if x, ok := x.Driver.(*DataSource_Rest); ok {
-			return x.Rest
-		}
+		return x.Sbom
 	}
 	return nil
 }
 
-type isDataSource_Driver interface {
-	isDataSource_Driver()
-}
-
-type DataSource_Structured struct {
-	// structured is the structired data - data source.
-	Structured *StructDataSource `protobuf:"bytes,8,opt,name=structured,proto3,oneof"`
-}
-
-type DataSource_Rest struct {
-	// rest is the REST data source driver.
-	Rest *RestDataSource `protobuf:"bytes,6,opt,name=rest,proto3,oneof"`
-}
-
-func (*DataSource_Structured) isDataSource_Driver() {}
-
-func (*DataSource_Rest) isDataSource_Driver() {}
-
-// StructDataSource is the structured data source driver.
-type StructDataSource struct {
+// Eval defines the data evaluation definition.
+// This pertains to the way we traverse data from the upstream
+// endpoint and how we compare it to the rule.
+type RuleType_Definition_Eval struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// defs is the list of definitions for the structured data API.
-	Def           map[string]*StructDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// type is the type of the data evaluation.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// jq is only used if the `jq` type is selected.
+	// It defines the comparisons that are made between
+	// the ingested data and the profile rule.
+	Jq []*RuleType_Definition_Eval_JQComparison `protobuf:"bytes,2,rep,name=jq,proto3" json:"jq,omitempty"`
+	// rego is only used if the `rego` type is selected.
+	Rego *RuleType_Definition_Eval_Rego `protobuf:"bytes,3,opt,name=rego,proto3,oneof" json:"rego,omitempty"`
+	// vulncheck is only used if the `vulncheck` type is selected.
+	Vulncheck *RuleType_Definition_Eval_Vulncheck `protobuf:"bytes,4,opt,name=vulncheck,proto3,oneof" json:"vulncheck,omitempty"`
+	// The trusty type is no longer used, but is still here for backwards
+	// compatibility with existing stored rules
+	Trusty *RuleType_Definition_Eval_Trusty `protobuf:"bytes,5,opt,name=trusty,proto3,oneof" json:"trusty,omitempty"`
+	// homoglyphs is only used if the `homoglyphs` type is selected.
+	Homoglyphs *RuleType_Definition_Eval_Homoglyphs `protobuf:"bytes,6,opt,name=homoglyphs,proto3,oneof" json:"homoglyphs,omitempty"`
+	// cel is only used if the `cel` type is selected.
+	Cel *RuleType_Definition_Eval_CEL `protobuf:"bytes,8,opt,name=cel,proto3,oneof" json:"cel,omitempty"`
+	// Data sources that the rule refers to. These are used to
+	// instantiate the relevant data sources for the rule and keep
+	// track of them as dependencies.
+	//
+	// Note that the data source must exist in the project hierarchy
+	// in order to be used in the rule.
+	DataSources   []*DataSourceReference `protobuf:"bytes,7,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StructDataSource) Reset() {
-	*x = StructDataSource{}
-	mi := &file_minder_v1_minder_proto_msgTypes[204]
+func (x *RuleType_Definition_Eval) Reset() {
+	*x = RuleType_Definition_Eval{}
+	mi := &file_minder_v1_minder_proto_msgTypes[268]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StructDataSource) String() string {
+func (x *RuleType_Definition_Eval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StructDataSource) ProtoMessage() {}
+func (*RuleType_Definition_Eval) ProtoMessage() {}
 
-func (x *StructDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[204]
+func (x *RuleType_Definition_Eval) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[268]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12900,105 +16873,97 @@ func (x *StructDataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StructDataSource.ProtoReflect.Descriptor instead.
-func (*StructDataSource) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{204}
+// Deprecated: Use RuleType_Definition_Eval.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1}
 }
 
-func (x *StructDataSource) GetDef() map[string]*StructDataSource_Def {
+func (x *RuleType_Definition_Eval) GetType() string {
 	if x != nil {
-		return x.Def
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-// RestDataSource is the REST data source driver.
-type RestDataSource struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// defs is the list of definitions for the REST API.
-	Def map[string]*RestDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// provider_auth enables provider authentication for this data source.
-	// When enabled, the data source will use the provider's authentication
-	// credentials to make requests.
-	ProviderAuth  bool `protobuf:"varint,2,opt,name=provider_auth,json=providerAuth,proto3" json:"provider_auth,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RuleType_Definition_Eval) GetJq() []*RuleType_Definition_Eval_JQComparison {
+	if x != nil {
+		return x.Jq
+	}
+	return nil
 }
 
-func (x *RestDataSource) Reset() {
-	*x = RestDataSource{}
-	mi := &file_minder_v1_minder_proto_msgTypes[205]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RuleType_Definition_Eval) GetRego() *RuleType_Definition_Eval_Rego {
+	if x != nil {
+		return x.Rego
+	}
+	return nil
 }
 
-func (x *RestDataSource) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleType_Definition_Eval) GetVulncheck() *RuleType_Definition_Eval_Vulncheck {
+	if x != nil {
+		return x.Vulncheck
+	}
+	return nil
 }
 
-func (*RestDataSource) ProtoMessage() {}
-
-func (x *RestDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[205]
+func (x *RuleType_Definition_Eval) GetTrusty() *RuleType_Definition_Eval_Trusty {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Trusty
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RestDataSource.ProtoReflect.Descriptor instead.
-func (*RestDataSource) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{205}
+func (x *RuleType_Definition_Eval) GetHomoglyphs() *RuleType_Definition_Eval_Homoglyphs {
+	if x != nil {
+		return x.Homoglyphs
+	}
+	return nil
 }
 
-func (x *RestDataSource) GetDef() map[string]*RestDataSource_Def {
+func (x *RuleType_Definition_Eval) GetCel() *RuleType_Definition_Eval_CEL {
 	if x != nil {
-		return x.Def
+		return x.Cel
 	}
 	return nil
 }
 
-func (x *RestDataSource) GetProviderAuth() bool {
+func (x *RuleType_Definition_Eval) GetDataSources() []*DataSourceReference {
 	if x != nil {
-		return x.ProviderAuth
+		return x.DataSources
 	}
-	return false
+	return nil
 }
 
-// DataSourceReference is a reference to a data source.
-// Note that for a resource to refer to a data source the data source must
-// be available in the same project hierarchy.
-type DataSourceReference struct {
+type RuleType_Definition_Remediate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the data source within the project hierarchy.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// alias is the alias used to refer to the data source in the rule
-	// definition.
-	// If left unset, it will default to the name of the data source.
-	Alias         string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// type is the type of the remediation.
+	// * 'rest' can be used with any entity type.
+	// * 'gh_branch_protection' and 'pull_request' can only be used with the 'repository' entity type.
+	// * 'pull_request_comment' can only be used with the 'pull_request' entity type.
+	Type               string                                                `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Rest               *RestType                                             `protobuf:"bytes,2,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
+	GhBranchProtection *RuleType_Definition_Remediate_GhBranchProtectionType `protobuf:"bytes,3,opt,name=gh_branch_protection,json=ghBranchProtection,proto3,oneof" json:"gh_branch_protection,omitempty"`
+	PullRequest        *RuleType_Definition_Remediate_PullRequestRemediation `protobuf:"bytes,4,opt,name=pull_request,json=pullRequest,proto3,oneof" json:"pull_request,omitempty"`
+	PullRequestComment *RuleType_Definition_Alert_AlertTypePRComment         `protobuf:"bytes,5,opt,name=pull_request_comment,json=pullRequestComment,proto3,oneof" json:"pull_request_comment,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *DataSourceReference) Reset() {
-	*x = DataSourceReference{}
-	mi := &file_minder_v1_minder_proto_msgTypes[206]
+func (x *RuleType_Definition_Remediate) Reset() {
+	*x = RuleType_Definition_Remediate{}
+	mi := &file_minder_v1_minder_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataSourceReference) String() string {
+func (x *RuleType_Definition_Remediate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataSourceReference) ProtoMessage() {}
+func (*RuleType_Definition_Remediate) ProtoMessage() {}
 
-func (x *DataSourceReference) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[206]
+func (x *RuleType_Definition_Remediate) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13009,103 +16974,77 @@ func (x *DataSourceReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataSourceReference.ProtoReflect.Descriptor instead.
-func (*DataSourceReference) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{206}
+// Deprecated: Use RuleType_Definition_Remediate.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 2}
 }
 
-func (x *DataSourceReference) GetName() string {
+func (x *RuleType_Definition_Remediate) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *DataSourceReference) GetAlias() string {
+func (x *RuleType_Definition_Remediate) GetRest() *RestType {
 	if x != nil {
-		return x.Alias
+		return x.Rest
 	}
-	return ""
-}
-
-type RegisterRepoResult_Status struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RegisterRepoResult_Status) Reset() {
-	*x = RegisterRepoResult_Status{}
-	mi := &file_minder_v1_minder_proto_msgTypes[207]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RegisterRepoResult_Status) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*RegisterRepoResult_Status) ProtoMessage() {}
-
-func (x *RegisterRepoResult_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[207]
+func (x *RuleType_Definition_Remediate) GetGhBranchProtection() *RuleType_Definition_Remediate_GhBranchProtectionType {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GhBranchProtection
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RegisterRepoResult_Status.ProtoReflect.Descriptor instead.
-func (*RegisterRepoResult_Status) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{32, 0}
+	return nil
 }
 
-func (x *RegisterRepoResult_Status) GetSuccess() bool {
+func (x *RuleType_Definition_Remediate) GetPullRequest() *RuleType_Definition_Remediate_PullRequestRemediation {
 	if x != nil {
-		return x.Success
+		return x.PullRequest
 	}
-	return false
+	return nil
 }
 
-func (x *RegisterRepoResult_Status) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *RuleType_Definition_Remediate) GetPullRequestComment() *RuleType_Definition_Alert_AlertTypePRComment {
+	if x != nil {
+		return x.PullRequestComment
 	}
-	return ""
+	return nil
 }
 
-type ListEvaluationResultsResponse_EntityProfileEvaluationResults struct {
+type RuleType_Definition_Alert struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// profile_status is the status of the profile - id, name, status, last_updated
-	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
-	// Note that some fields like profile_id and entity might be empty
-	// Eventually we might replace this type with another one that fits the API better
-	Results       []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// type is the type of the alert.
+	// * 'security_advisory' can only be used with the 'repository' entity type.
+	// * 'pull_request_comment' can only be used with the 'pull_request' entity type.
+	// * 'webhook' can be used with any entity type.
+	// * 'issue' can only be used with the 'repository' entity type.
+	Type               string                                        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SecurityAdvisory   *RuleType_Definition_Alert_AlertTypeSA        `protobuf:"bytes,2,opt,name=security_advisory,json=securityAdvisory,proto3,oneof" json:"security_advisory,omitempty"`
+	PullRequestComment *RuleType_Definition_Alert_AlertTypePRComment `protobuf:"bytes,3,opt,name=pull_request_comment,json=pullRequestComment,proto3,oneof" json:"pull_request_comment,omitempty"`
+	Webhook            *RuleType_Definition_Alert_AlertTypeWebhook   `protobuf:"bytes,4,opt,name=webhook,proto3,oneof" json:"webhook,omitempty"`
+	Issue              *RuleType_Definition_Alert_AlertTypeIssue     `protobuf:"bytes,5,opt,name=issue,proto3,oneof" json:"issue,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) Reset() {
-	*x = ListEvaluationResultsResponse_EntityProfileEvaluationResults{}
-	mi := &file_minder_v1_minder_proto_msgTypes[210]
+func (x *RuleType_Definition_Alert) Reset() {
+	*x = RuleType_Definition_Alert{}
+	mi := &file_minder_v1_minder_proto_msgTypes[270]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) String() string {
+func (x *RuleType_Definition_Alert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoMessage() {}
+func (*RuleType_Definition_Alert) ProtoMessage() {}
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[210]
+func (x *RuleType_Definition_Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[270]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13116,101 +17055,75 @@ func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoRefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsResponse_EntityProfileEvaluationResults.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{120, 0}
-}
-
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetProfileStatus() *ProfileStatus {
-	if x != nil {
-		return x.ProfileStatus
-	}
-	return nil
-}
-
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetResults() []*RuleEvaluationStatus {
-	if x != nil {
-		return x.Results
-	}
-	return nil
-}
-
-type ListEvaluationResultsResponse_EntityEvaluationResults struct {
-	state         protoimpl.MessageState                                          `protogen:"open.v1"`
-	Entity        *EntityTypedId                                                  `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	Profiles      []*ListEvaluationResultsResponse_EntityProfileEvaluationResults `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) Reset() {
-	*x = ListEvaluationResultsResponse_EntityEvaluationResults{}
-	mi := &file_minder_v1_minder_proto_msgTypes[211]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use RuleType_Definition_Alert.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 3}
 }
 
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleType_Definition_Alert) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (*ListEvaluationResultsResponse_EntityEvaluationResults) ProtoMessage() {}
-
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[211]
+func (x *RuleType_Definition_Alert) GetSecurityAdvisory() *RuleType_Definition_Alert_AlertTypeSA {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SecurityAdvisory
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListEvaluationResultsResponse_EntityEvaluationResults.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsResponse_EntityEvaluationResults) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{120, 1}
+func (x *RuleType_Definition_Alert) GetPullRequestComment() *RuleType_Definition_Alert_AlertTypePRComment {
+	if x != nil {
+		return x.PullRequestComment
+	}
+	return nil
 }
 
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetEntity() *EntityTypedId {
+func (x *RuleType_Definition_Alert) GetWebhook() *RuleType_Definition_Alert_AlertTypeWebhook {
 	if x != nil {
-		return x.Entity
+		return x.Webhook
 	}
 	return nil
 }
 
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetProfiles() []*ListEvaluationResultsResponse_EntityProfileEvaluationResults {
+func (x *RuleType_Definition_Alert) GetIssue() *RuleType_Definition_Alert_AlertTypeIssue {
 	if x != nil {
-		return x.Profiles
+		return x.Issue
 	}
 	return nil
 }
 
-type RestType_Fallback struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	HttpCode int32                  `protobuf:"varint,1,opt,name=http_code,json=httpCode,proto3" json:"http_code,omitempty"`
-	// This is expected to be a valid JSON string.
-	Body          string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+type RuleType_Definition_Eval_JQComparison struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ingested points to the data retrieved in the `ingest` section
+	Ingested *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,1,opt,name=ingested,proto3" json:"ingested,omitempty"`
+	// Profile points to the profile itself.
+	// This is mutually exclusive with the `constant` field.
+	Profile *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Constant points to a constant value.
+	// This is mutually exclusive with the `profile` field.
+	Constant      *structpb.Value `protobuf:"bytes,3,opt,name=constant,proto3" json:"constant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestType_Fallback) Reset() {
-	*x = RestType_Fallback{}
-	mi := &file_minder_v1_minder_proto_msgTypes[212]
+func (x *RuleType_Definition_Eval_JQComparison) Reset() {
+	*x = RuleType_Definition_Eval_JQComparison{}
+	mi := &file_minder_v1_minder_proto_msgTypes[271]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestType_Fallback) String() string {
+func (x *RuleType_Definition_Eval_JQComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestType_Fallback) ProtoMessage() {}
+func (*RuleType_Definition_Eval_JQComparison) ProtoMessage() {}
 
-func (x *RestType_Fallback) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[212]
+func (x *RuleType_Definition_Eval_JQComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[271]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13221,50 +17134,71 @@ func (x *RestType_Fallback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestType_Fallback.ProtoReflect.Descriptor instead.
-func (*RestType_Fallback) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{121, 0}
+// Deprecated: Use RuleType_Definition_Eval_JQComparison.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_JQComparison) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1, 0}
 }
 
-func (x *RestType_Fallback) GetHttpCode() int32 {
+func (x *RuleType_Definition_Eval_JQComparison) GetIngested() *RuleType_Definition_Eval_JQComparison_Operator {
 	if x != nil {
-		return x.HttpCode
+		return x.Ingested
 	}
-	return 0
+	return nil
 }
 
-func (x *RestType_Fallback) GetBody() string {
+func (x *RuleType_Definition_Eval_JQComparison) GetProfile() *RuleType_Definition_Eval_JQComparison_Operator {
 	if x != nil {
-		return x.Body
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-type DiffType_Ecosystem struct {
+func (x *RuleType_Definition_Eval_JQComparison) GetConstant() *structpb.Value {
+	if x != nil {
+		return x.Constant
+	}
+	return nil
+}
+
+type RuleType_Definition_Eval_Rego struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the ecosystem.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// depfile is the file that contains the dependencies for this ecosystem
-	Depfile       string `protobuf:"bytes,2,opt,name=depfile,proto3" json:"depfile,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// type is the type of evaluation engine to use
+	// for rego. We currently have two modes of operation:
+	//   - deny-by-default: this is the default mode of operation
+	//     where we deny access by default and allow access only
+	//     if the profile explicitly allows it. It expects the
+	//     profile to set an `allow` variable to true or false.
+	//   - constraints: this is the mode of operation where we
+	//     allow access by default and deny access only if a
+	//     violation is found. It expects the profile to set a
+	//     `violations` variable with a "msg" field.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// def is the definition of the rego profile.
+	Def string `protobuf:"bytes,2,opt,name=def,proto3" json:"def,omitempty"`
+	// how are violations reported. This is only used if the
+	// `constraints` type is selected. The default is `text`
+	// which returns human-readable text. The other option is
+	// `json` which returns a JSON array containing the violations.
+	ViolationFormat *string `protobuf:"bytes,3,opt,name=violation_format,json=violationFormat,proto3,oneof" json:"violation_format,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DiffType_Ecosystem) Reset() {
-	*x = DiffType_Ecosystem{}
-	mi := &file_minder_v1_minder_proto_msgTypes[213]
+func (x *RuleType_Definition_Eval_Rego) Reset() {
+	*x = RuleType_Definition_Eval_Rego{}
+	mi := &file_minder_v1_minder_proto_msgTypes[272]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiffType_Ecosystem) String() string {
+func (x *RuleType_Definition_Eval_Rego) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffType_Ecosystem) ProtoMessage() {}
+func (*RuleType_Definition_Eval_Rego) ProtoMessage() {}
 
-func (x *DiffType_Ecosystem) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[213]
+func (x *RuleType_Definition_Eval_Rego) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[272]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13275,49 +17209,53 @@ func (x *DiffType_Ecosystem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffType_Ecosystem.ProtoReflect.Descriptor instead.
-func (*DiffType_Ecosystem) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{125, 0}
+// Deprecated: Use RuleType_Definition_Eval_Rego.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Rego) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1, 1}
 }
 
-func (x *DiffType_Ecosystem) GetName() string {
+func (x *RuleType_Definition_Eval_Rego) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *DiffType_Ecosystem) GetDepfile() string {
+func (x *RuleType_Definition_Eval_Rego) GetDef() string {
 	if x != nil {
-		return x.Depfile
+		return x.Def
 	}
 	return ""
 }
 
-// branch is the branch of the git repository, when applied to repository entities.
-// Has no meaning or effect on other entity types.
-type DepsType_RepoConfigs struct {
+func (x *RuleType_Definition_Eval_Rego) GetViolationFormat() string {
+	if x != nil && x.ViolationFormat != nil {
+		return *x.ViolationFormat
+	}
+	return ""
+}
+
+type RuleType_Definition_Eval_Vulncheck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Branch        string                 `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepsType_RepoConfigs) Reset() {
-	*x = DepsType_RepoConfigs{}
-	mi := &file_minder_v1_minder_proto_msgTypes[214]
+func (x *RuleType_Definition_Eval_Vulncheck) Reset() {
+	*x = RuleType_Definition_Eval_Vulncheck{}
+	mi := &file_minder_v1_minder_proto_msgTypes[273]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepsType_RepoConfigs) String() string {
+func (x *RuleType_Definition_Eval_Vulncheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepsType_RepoConfigs) ProtoMessage() {}
+func (*RuleType_Definition_Eval_Vulncheck) ProtoMessage() {}
 
-func (x *DepsType_RepoConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[214]
+func (x *RuleType_Definition_Eval_Vulncheck) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[273]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13328,41 +17266,35 @@ func (x *DepsType_RepoConfigs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepsType_RepoConfigs.ProtoReflect.Descriptor instead.
-func (*DepsType_RepoConfigs) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0}
-}
-
-func (x *DepsType_RepoConfigs) GetBranch() string {
-	if x != nil {
-		return x.Branch
-	}
-	return ""
+// Deprecated: Use RuleType_Definition_Eval_Vulncheck.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Vulncheck) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1, 2}
 }
 
-type DepsType_PullRequestConfigs struct {
+type RuleType_Definition_Eval_Trusty struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// filter is the filter to apply to the PRs.  The default value is "NEW_AND_UPDATED".
-	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// This is no longer used, but is still here for backwards
+	// compatibility with existing stored rules
+	Endpoint      string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepsType_PullRequestConfigs) Reset() {
-	*x = DepsType_PullRequestConfigs{}
-	mi := &file_minder_v1_minder_proto_msgTypes[215]
+func (x *RuleType_Definition_Eval_Trusty) Reset() {
+	*x = RuleType_Definition_Eval_Trusty{}
+	mi := &file_minder_v1_minder_proto_msgTypes[274]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepsType_PullRequestConfigs) String() string {
+func (x *RuleType_Definition_Eval_Trusty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepsType_PullRequestConfigs) ProtoMessage() {}
+func (*RuleType_Definition_Eval_Trusty) ProtoMessage() {}
 
-func (x *DepsType_PullRequestConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[215]
+func (x *RuleType_Definition_Eval_Trusty) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[274]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13373,52 +17305,40 @@ func (x *DepsType_PullRequestConfigs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepsType_PullRequestConfigs.ProtoReflect.Descriptor instead.
-func (*DepsType_PullRequestConfigs) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 1}
+// Deprecated: Use RuleType_Definition_Eval_Trusty.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Trusty) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1, 3}
 }
 
-func (x *DepsType_PullRequestConfigs) GetFilter() string {
+func (x *RuleType_Definition_Eval_Trusty) GetEndpoint() string {
 	if x != nil {
-		return x.Filter
+		return x.Endpoint
 	}
 	return ""
 }
 
-// Definition defines the rule type. It encompases the schema and the data evaluation.
-type RuleType_Definition struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// in_entity is the entity in which the rule is evaluated.
-	// This can be repository, build_environment or artifact.
-	InEntity string `protobuf:"bytes,1,opt,name=in_entity,json=inEntity,proto3" json:"in_entity,omitempty"`
-	// rule_schema is the schema of the rule. This is expressed in JSON Schema.
-	RuleSchema *structpb.Struct `protobuf:"bytes,2,opt,name=rule_schema,json=ruleSchema,proto3" json:"rule_schema,omitempty"`
-	// param_schema is the schema of the parameters that are passed to the rule.
-	// This is expressed in JSON Schema.
-	ParamSchema   *structpb.Struct               `protobuf:"bytes,3,opt,name=param_schema,json=paramSchema,proto3,oneof" json:"param_schema,omitempty"`
-	Ingest        *RuleType_Definition_Ingest    `protobuf:"bytes,4,opt,name=ingest,proto3" json:"ingest,omitempty"`
-	Eval          *RuleType_Definition_Eval      `protobuf:"bytes,5,opt,name=eval,proto3" json:"eval,omitempty"`
-	Remediate     *RuleType_Definition_Remediate `protobuf:"bytes,6,opt,name=remediate,proto3" json:"remediate,omitempty"`
-	Alert         *RuleType_Definition_Alert     `protobuf:"bytes,7,opt,name=alert,proto3" json:"alert,omitempty"`
+type RuleType_Definition_Eval_Homoglyphs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition) Reset() {
-	*x = RuleType_Definition{}
-	mi := &file_minder_v1_minder_proto_msgTypes[216]
+func (x *RuleType_Definition_Eval_Homoglyphs) Reset() {
+	*x = RuleType_Definition_Eval_Homoglyphs{}
+	mi := &file_minder_v1_minder_proto_msgTypes[275]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition) String() string {
+func (x *RuleType_Definition_Eval_Homoglyphs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition) ProtoMessage() {}
+func (*RuleType_Definition_Eval_Homoglyphs) ProtoMessage() {}
 
-func (x *RuleType_Definition) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[216]
+func (x *RuleType_Definition_Eval_Homoglyphs) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[275]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13429,102 +17349,100 @@ func (x *RuleType_Definition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0}
+// Deprecated: Use RuleType_Definition_Eval_Homoglyphs.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Homoglyphs) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1, 4}
 }
 
-func (x *RuleType_Definition) GetInEntity() string {
+func (x *RuleType_Definition_Eval_Homoglyphs) GetType() string {
 	if x != nil {
-		return x.InEntity
+		return x.Type
 	}
 	return ""
 }
 
-func (x *RuleType_Definition) GetRuleSchema() *structpb.Struct {
-	if x != nil {
-		return x.RuleSchema
-	}
-	return nil
+type RuleType_Definition_Eval_CEL struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// def is the CEL expression that evaluates the rule. It
+	// must evaluate to a boolean, where `true` means the
+	// entity conforms to the rule. The expression has access
+	// to the `ingested`, `profile`, `params` and `properties`
+	// variables.
+	Def string `protobuf:"bytes,1,opt,name=def,proto3" json:"def,omitempty"`
+	// failure_message is an optional CEL expression evaluating
+	// to a string. It is only evaluated when `def` evaluates to
+	// `false`, and its result is used as the failure message.
+	FailureMessage *string `protobuf:"bytes,2,opt,name=failure_message,json=failureMessage,proto3,oneof" json:"failure_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition) GetParamSchema() *structpb.Struct {
-	if x != nil {
-		return x.ParamSchema
-	}
-	return nil
+func (x *RuleType_Definition_Eval_CEL) Reset() {
+	*x = RuleType_Definition_Eval_CEL{}
+	mi := &file_minder_v1_minder_proto_msgTypes[276]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition) GetIngest() *RuleType_Definition_Ingest {
+func (x *RuleType_Definition_Eval_CEL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleType_Definition_Eval_CEL) ProtoMessage() {}
+
+func (x *RuleType_Definition_Eval_CEL) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[276]
 	if x != nil {
-		return x.Ingest
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RuleType_Definition) GetEval() *RuleType_Definition_Eval {
-	if x != nil {
-		return x.Eval
-	}
-	return nil
+// Deprecated: Use RuleType_Definition_Eval_CEL.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_CEL) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1, 5}
 }
 
-func (x *RuleType_Definition) GetRemediate() *RuleType_Definition_Remediate {
+func (x *RuleType_Definition_Eval_CEL) GetDef() string {
 	if x != nil {
-		return x.Remediate
+		return x.Def
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition) GetAlert() *RuleType_Definition_Alert {
-	if x != nil {
-		return x.Alert
+func (x *RuleType_Definition_Eval_CEL) GetFailureMessage() string {
+	if x != nil && x.FailureMessage != nil {
+		return *x.FailureMessage
 	}
-	return nil
+	return ""
 }
 
-// Ingest defines how the data is ingested.
-type RuleType_Definition_Ingest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the data ingestion.
-	// we currently support rest, artifact and builtin.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// rest is the rest data ingestion.
-	// this is only used if the type is rest.
-	Rest *RestType `protobuf:"bytes,3,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
-	// builtin is the builtin data ingestion.
-	Builtin *BuiltinType `protobuf:"bytes,4,opt,name=builtin,proto3,oneof" json:"builtin,omitempty"`
-	// artifact is the artifact data ingestion.
-	// artifact currently only applies to artifacts.
-	Artifact *ArtifactType `protobuf:"bytes,5,opt,name=artifact,proto3,oneof" json:"artifact,omitempty"`
-	// git is the git data ingestion.
-	// git currently only applies to repositories.
-	Git *GitType `protobuf:"bytes,6,opt,name=git,proto3,oneof" json:"git,omitempty"`
-	// diff is the diff data ingestion.
-	// diff currently only applies to pull_requests.
-	Diff *DiffType `protobuf:"bytes,7,opt,name=diff,proto3,oneof" json:"diff,omitempty"`
-	// deps is the deps data ingestion.
-	// deps currently only applies to repositories.
-	Deps          *DepsType `protobuf:"bytes,8,opt,name=deps,proto3,oneof" json:"deps,omitempty"`
+type RuleType_Definition_Eval_JQComparison_Operator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Def           string                 `protobuf:"bytes,1,opt,name=def,proto3" json:"def,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Ingest) Reset() {
-	*x = RuleType_Definition_Ingest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[217]
+func (x *RuleType_Definition_Eval_JQComparison_Operator) Reset() {
+	*x = RuleType_Definition_Eval_JQComparison_Operator{}
+	mi := &file_minder_v1_minder_proto_msgTypes[277]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Ingest) String() string {
+func (x *RuleType_Definition_Eval_JQComparison_Operator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Ingest) ProtoMessage() {}
+func (*RuleType_Definition_Eval_JQComparison_Operator) ProtoMessage() {}
 
-func (x *RuleType_Definition_Ingest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[217]
+func (x *RuleType_Definition_Eval_JQComparison_Operator) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[277]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13535,106 +17453,110 @@ func (x *RuleType_Definition_Ingest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Ingest.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Ingest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 0}
+// Deprecated: Use RuleType_Definition_Eval_JQComparison_Operator.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_JQComparison_Operator) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 1, 0, 0}
 }
 
-func (x *RuleType_Definition_Ingest) GetType() string {
+func (x *RuleType_Definition_Eval_JQComparison_Operator) GetDef() string {
 	if x != nil {
-		return x.Type
+		return x.Def
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Ingest) GetRest() *RestType {
-	if x != nil {
-		return x.Rest
-	}
-	return nil
+type RuleType_Definition_Remediate_GhBranchProtectionType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Ingest) GetBuiltin() *BuiltinType {
-	if x != nil {
-		return x.Builtin
-	}
-	return nil
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) Reset() {
+	*x = RuleType_Definition_Remediate_GhBranchProtectionType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[278]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Ingest) GetArtifact() *ArtifactType {
-	if x != nil {
-		return x.Artifact
-	}
-	return nil
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleType_Definition_Ingest) GetGit() *GitType {
+func (*RuleType_Definition_Remediate_GhBranchProtectionType) ProtoMessage() {}
+
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[278]
 	if x != nil {
-		return x.Git
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RuleType_Definition_Ingest) GetDiff() *DiffType {
-	if x != nil {
-		return x.Diff
-	}
-	return nil
+// Deprecated: Use RuleType_Definition_Remediate_GhBranchProtectionType.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_GhBranchProtectionType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 2, 0}
 }
 
-func (x *RuleType_Definition_Ingest) GetDeps() *DepsType {
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) GetPatch() string {
 	if x != nil {
-		return x.Deps
+		return x.Patch
 	}
-	return nil
+	return ""
 }
 
-// Eval defines the data evaluation definition.
-// This pertains to the way we traverse data from the upstream
-// endpoint and how we compare it to the rule.
-type RuleType_Definition_Eval struct {
+// the name stutters a bit but we already use a PullRequest message for handling PR entities
+type RuleType_Definition_Remediate_PullRequestRemediation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the data evaluation.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// jq is only used if the `jq` type is selected.
-	// It defines the comparisons that are made between
-	// the ingested data and the profile rule.
-	Jq []*RuleType_Definition_Eval_JQComparison `protobuf:"bytes,2,rep,name=jq,proto3" json:"jq,omitempty"`
-	// rego is only used if the `rego` type is selected.
-	Rego *RuleType_Definition_Eval_Rego `protobuf:"bytes,3,opt,name=rego,proto3,oneof" json:"rego,omitempty"`
-	// vulncheck is only used if the `vulncheck` type is selected.
-	Vulncheck *RuleType_Definition_Eval_Vulncheck `protobuf:"bytes,4,opt,name=vulncheck,proto3,oneof" json:"vulncheck,omitempty"`
-	// The trusty type is no longer used, but is still here for backwards
-	// compatibility with existing stored rules
-	Trusty *RuleType_Definition_Eval_Trusty `protobuf:"bytes,5,opt,name=trusty,proto3,oneof" json:"trusty,omitempty"`
-	// homoglyphs is only used if the `homoglyphs` type is selected.
-	Homoglyphs *RuleType_Definition_Eval_Homoglyphs `protobuf:"bytes,6,opt,name=homoglyphs,proto3,oneof" json:"homoglyphs,omitempty"`
-	// Data sources that the rule refers to. These are used to
-	// instantiate the relevant data sources for the rule and keep
-	// track of them as dependencies.
+	// the title of the PR
+	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
+	// creation of the PR.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the body of the PR
+	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
+	// creation of the PR.
+	Body     string                                                          `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Contents []*RuleType_Definition_Remediate_PullRequestRemediation_Content `protobuf:"bytes,3,rep,name=contents,proto3" json:"contents,omitempty"`
+	// the method to use to create the PR. For now, these are supported:
+	// -- minder.content - ensures that the content of the file is exactly as specified
 	//
-	// Note that the data source must exist in the project hierarchy
-	// in order to be used in the rule.
-	DataSources   []*DataSourceReference `protobuf:"bytes,7,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	//	refer to the Content message for more details
+	//
+	// -- minder.actions.replace_tags_with_sha - finds any github actions within a workflow
+	//
+	//	file and replaces the tag with the SHA
+	//
+	// -- minder.yq.evaluate - evaluates a yq expression on a file
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// params are unstructured parameters passed to the method. These are optional
+	// and evaluated by the method.
+	Params *structpb.Struct `protobuf:"bytes,6,opt,name=params,proto3" json:"params,omitempty"`
+	// If the method is minder.actions.replace_tags_with_sha, this is the configuration
+	// for that method
+	ActionsReplaceTagsWithSha *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha `protobuf:"bytes,5,opt,name=actions_replace_tags_with_sha,json=actionsReplaceTagsWithSha,proto3,oneof" json:"actions_replace_tags_with_sha,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval) Reset() {
-	*x = RuleType_Definition_Eval{}
-	mi := &file_minder_v1_minder_proto_msgTypes[218]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) Reset() {
+	*x = RuleType_Definition_Remediate_PullRequestRemediation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[279]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval) String() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_PullRequestRemediation) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[218]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[279]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13645,90 +17567,85 @@ func (x *RuleType_Definition_Eval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 1}
+// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_PullRequestRemediation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 2, 1}
 }
 
-func (x *RuleType_Definition_Eval) GetType() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetTitle() string {
 	if x != nil {
-		return x.Type
+		return x.Title
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Eval) GetJq() []*RuleType_Definition_Eval_JQComparison {
-	if x != nil {
-		return x.Jq
-	}
-	return nil
-}
-
-func (x *RuleType_Definition_Eval) GetRego() *RuleType_Definition_Eval_Rego {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetBody() string {
 	if x != nil {
-		return x.Rego
+		return x.Body
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Eval) GetVulncheck() *RuleType_Definition_Eval_Vulncheck {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetContents() []*RuleType_Definition_Remediate_PullRequestRemediation_Content {
 	if x != nil {
-		return x.Vulncheck
+		return x.Contents
 	}
 	return nil
 }
 
-func (x *RuleType_Definition_Eval) GetTrusty() *RuleType_Definition_Eval_Trusty {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetMethod() string {
 	if x != nil {
-		return x.Trusty
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Eval) GetHomoglyphs() *RuleType_Definition_Eval_Homoglyphs {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetParams() *structpb.Struct {
 	if x != nil {
-		return x.Homoglyphs
+		return x.Params
 	}
 	return nil
 }
 
-func (x *RuleType_Definition_Eval) GetDataSources() []*DataSourceReference {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetActionsReplaceTagsWithSha() *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha {
 	if x != nil {
-		return x.DataSources
+		return x.ActionsReplaceTagsWithSha
 	}
 	return nil
 }
 
-type RuleType_Definition_Remediate struct {
+type RuleType_Definition_Remediate_PullRequestRemediation_Content struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the remediation.
-	// * 'rest' can be used with any entity type.
-	// * 'gh_branch_protection' and 'pull_request' can only be used with the 'repository' entity type.
-	// * 'pull_request_comment' can only be used with the 'pull_request' entity type.
-	Type               string                                                `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Rest               *RestType                                             `protobuf:"bytes,2,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
-	GhBranchProtection *RuleType_Definition_Remediate_GhBranchProtectionType `protobuf:"bytes,3,opt,name=gh_branch_protection,json=ghBranchProtection,proto3,oneof" json:"gh_branch_protection,omitempty"`
-	PullRequest        *RuleType_Definition_Remediate_PullRequestRemediation `protobuf:"bytes,4,opt,name=pull_request,json=pullRequest,proto3,oneof" json:"pull_request,omitempty"`
-	PullRequestComment *RuleType_Definition_Alert_AlertTypePRComment         `protobuf:"bytes,5,opt,name=pull_request_comment,json=pullRequestComment,proto3,oneof" json:"pull_request_comment,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// the file to patch
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// how to patch the file. For now, only replace is supported
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// the content of the file
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// the GIT mode of the file. Not UNIX mode! String because the GH API also uses strings
+	// the usual modes are: 100644 for regular files, 100755 for executable files and
+	// 040000 for submodules (which we don't use but now you know the meaning of the 1 in 100644)
+	// see e.g. https://github.com/go-git/go-git/blob/32e0172851c35ae2fac495069c923330040903d2/plumbing/filemode/filemode.go#L16
+	Mode          *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate) Reset() {
-	*x = RuleType_Definition_Remediate{}
-	mi := &file_minder_v1_minder_proto_msgTypes[219]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) Reset() {
+	*x = RuleType_Definition_Remediate_PullRequestRemediation_Content{}
+	mi := &file_minder_v1_minder_proto_msgTypes[280]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate) String() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[219]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[280]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13739,73 +17656,63 @@ func (x *RuleType_Definition_Remediate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 2}
+// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_Content.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 2, 1, 0}
 }
 
-func (x *RuleType_Definition_Remediate) GetType() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetPath() string {
 	if x != nil {
-		return x.Type
+		return x.Path
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate) GetRest() *RestType {
-	if x != nil {
-		return x.Rest
-	}
-	return nil
-}
-
-func (x *RuleType_Definition_Remediate) GetGhBranchProtection() *RuleType_Definition_Remediate_GhBranchProtectionType {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetAction() string {
 	if x != nil {
-		return x.GhBranchProtection
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Remediate) GetPullRequest() *RuleType_Definition_Remediate_PullRequestRemediation {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetContent() string {
 	if x != nil {
-		return x.PullRequest
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Remediate) GetPullRequestComment() *RuleType_Definition_Alert_AlertTypePRComment {
-	if x != nil {
-		return x.PullRequestComment
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
 	}
-	return nil
+	return ""
 }
 
-type RuleType_Definition_Alert struct {
+type RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the alert.
-	// * 'security_advisory' can only be used with the 'repository' entity type.
-	// * 'pull_request_comment' can only be used with the 'pull_request' entity type.
-	Type               string                                        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	SecurityAdvisory   *RuleType_Definition_Alert_AlertTypeSA        `protobuf:"bytes,2,opt,name=security_advisory,json=securityAdvisory,proto3,oneof" json:"security_advisory,omitempty"`
-	PullRequestComment *RuleType_Definition_Alert_AlertTypePRComment `protobuf:"bytes,3,opt,name=pull_request_comment,json=pullRequestComment,proto3,oneof" json:"pull_request_comment,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// List of actions to exclude from the replacement
+	Exclude       []string `protobuf:"bytes,1,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Alert) Reset() {
-	*x = RuleType_Definition_Alert{}
-	mi := &file_minder_v1_minder_proto_msgTypes[220]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Reset() {
+	*x = RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha{}
+	mi := &file_minder_v1_minder_proto_msgTypes[281]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Alert) String() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Alert) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoMessage() {
+}
 
-func (x *RuleType_Definition_Alert) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[220]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[281]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13816,61 +17723,40 @@ func (x *RuleType_Definition_Alert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Alert.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Alert) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 3}
-}
-
-func (x *RuleType_Definition_Alert) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *RuleType_Definition_Alert) GetSecurityAdvisory() *RuleType_Definition_Alert_AlertTypeSA {
-	if x != nil {
-		return x.SecurityAdvisory
-	}
-	return nil
+// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 2, 1, 1}
 }
 
-func (x *RuleType_Definition_Alert) GetPullRequestComment() *RuleType_Definition_Alert_AlertTypePRComment {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) GetExclude() []string {
 	if x != nil {
-		return x.PullRequestComment
+		return x.Exclude
 	}
 	return nil
 }
 
-type RuleType_Definition_Eval_JQComparison struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Ingested points to the data retrieved in the `ingest` section
-	Ingested *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,1,opt,name=ingested,proto3" json:"ingested,omitempty"`
-	// Profile points to the profile itself.
-	// This is mutually exclusive with the `constant` field.
-	Profile *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-	// Constant points to a constant value.
-	// This is mutually exclusive with the `profile` field.
-	Constant      *structpb.Value `protobuf:"bytes,3,opt,name=constant,proto3" json:"constant,omitempty"`
+type RuleType_Definition_Alert_AlertTypeSA struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Severity      string                 `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) Reset() {
-	*x = RuleType_Definition_Eval_JQComparison{}
-	mi := &file_minder_v1_minder_proto_msgTypes[221]
+func (x *RuleType_Definition_Alert_AlertTypeSA) Reset() {
+	*x = RuleType_Definition_Alert_AlertTypeSA{}
+	mi := &file_minder_v1_minder_proto_msgTypes[282]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) String() string {
+func (x *RuleType_Definition_Alert_AlertTypeSA) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_JQComparison) ProtoMessage() {}
+func (*RuleType_Definition_Alert_AlertTypeSA) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_JQComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[221]
+func (x *RuleType_Definition_Alert_AlertTypeSA) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[282]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13881,71 +17767,44 @@ func (x *RuleType_Definition_Eval_JQComparison) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_JQComparison.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_JQComparison) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 1, 0}
-}
-
-func (x *RuleType_Definition_Eval_JQComparison) GetIngested() *RuleType_Definition_Eval_JQComparison_Operator {
-	if x != nil {
-		return x.Ingested
-	}
-	return nil
-}
-
-func (x *RuleType_Definition_Eval_JQComparison) GetProfile() *RuleType_Definition_Eval_JQComparison_Operator {
-	if x != nil {
-		return x.Profile
-	}
-	return nil
+// Deprecated: Use RuleType_Definition_Alert_AlertTypeSA.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert_AlertTypeSA) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 3, 0}
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) GetConstant() *structpb.Value {
+func (x *RuleType_Definition_Alert_AlertTypeSA) GetSeverity() string {
 	if x != nil {
-		return x.Constant
+		return x.Severity
 	}
-	return nil
+	return ""
 }
 
-type RuleType_Definition_Eval_Rego struct {
+type RuleType_Definition_Alert_AlertTypePRComment struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of evaluation engine to use
-	// for rego. We currently have two modes of operation:
-	//   - deny-by-default: this is the default mode of operation
-	//     where we deny access by default and allow access only
-	//     if the profile explicitly allows it. It expects the
-	//     profile to set an `allow` variable to true or false.
-	//   - constraints: this is the mode of operation where we
-	//     allow access by default and deny access only if a
-	//     violation is found. It expects the profile to set a
-	//     `violations` variable with a "msg" field.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// def is the definition of the rego profile.
-	Def string `protobuf:"bytes,2,opt,name=def,proto3" json:"def,omitempty"`
-	// how are violations reported. This is only used if the
-	// `constraints` type is selected. The default is `text`
-	// which returns human-readable text. The other option is
-	// `json` which returns a JSON array containing the violations.
-	ViolationFormat *string `protobuf:"bytes,3,opt,name=violation_format,json=violationFormat,proto3,oneof" json:"violation_format,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// review_message is the message to post in the PR review.
+	ReviewMessage string `protobuf:"bytes,1,opt,name=review_message,json=reviewMessage,proto3" json:"review_message,omitempty"`
+	// action is the action to use for the PR review (comment or request_changes).
+	// Default is comment.
+	Action        *string `protobuf:"bytes,2,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_Rego) Reset() {
-	*x = RuleType_Definition_Eval_Rego{}
-	mi := &file_minder_v1_minder_proto_msgTypes[222]
+func (x *RuleType_Definition_Alert_AlertTypePRComment) Reset() {
+	*x = RuleType_Definition_Alert_AlertTypePRComment{}
+	mi := &file_minder_v1_minder_proto_msgTypes[283]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_Rego) String() string {
+func (x *RuleType_Definition_Alert_AlertTypePRComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_Rego) ProtoMessage() {}
+func (*RuleType_Definition_Alert_AlertTypePRComment) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_Rego) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[222]
+func (x *RuleType_Definition_Alert_AlertTypePRComment) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[283]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13956,53 +17815,59 @@ func (x *RuleType_Definition_Eval_Rego) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Rego.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Rego) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 1, 1}
-}
-
-func (x *RuleType_Definition_Eval_Rego) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use RuleType_Definition_Alert_AlertTypePRComment.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert_AlertTypePRComment) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 3, 1}
 }
 
-func (x *RuleType_Definition_Eval_Rego) GetDef() string {
+func (x *RuleType_Definition_Alert_AlertTypePRComment) GetReviewMessage() string {
 	if x != nil {
-		return x.Def
+		return x.ReviewMessage
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Eval_Rego) GetViolationFormat() string {
-	if x != nil && x.ViolationFormat != nil {
-		return *x.ViolationFormat
+func (x *RuleType_Definition_Alert_AlertTypePRComment) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
 	}
 	return ""
 }
 
-type RuleType_Definition_Eval_Vulncheck struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type RuleType_Definition_Alert_AlertTypeWebhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// webhook is the name of the alert webhook of the project
+	// (or one of its parents) that receives the alert.
+	Webhook string `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// format is the format of the payload posted to the webhook.
+	// * 'slack' posts a message made of Slack blocks.
+	// * 'teams' posts a message with a Teams adaptive card.
+	// * 'json' posts a plain JSON document.
+	// Default is json.
+	Format *string `protobuf:"bytes,2,opt,name=format,proto3,oneof" json:"format,omitempty"`
+	// message is a template for the text of the alert. It is
+	// rendered with the rule and evaluation details. If unset,
+	// the short failure message of the rule type is used.
+	Message       *string `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_Vulncheck) Reset() {
-	*x = RuleType_Definition_Eval_Vulncheck{}
-	mi := &file_minder_v1_minder_proto_msgTypes[223]
+func (x *RuleType_Definition_Alert_AlertTypeWebhook) Reset() {
+	*x = RuleType_Definition_Alert_AlertTypeWebhook{}
+	mi := &file_minder_v1_minder_proto_msgTypes[284]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_Vulncheck) String() string {
+func (x *RuleType_Definition_Alert_AlertTypeWebhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_Vulncheck) ProtoMessage() {}
+func (*RuleType_Definition_Alert_AlertTypeWebhook) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_Vulncheck) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[223]
+func (x *RuleType_Definition_Alert_AlertTypeWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[284]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14013,35 +17878,65 @@ func (x *RuleType_Definition_Eval_Vulncheck) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Vulncheck.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Vulncheck) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 1, 2}
+// Deprecated: Use RuleType_Definition_Alert_AlertTypeWebhook.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert_AlertTypeWebhook) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 3, 2}
 }
 
-type RuleType_Definition_Eval_Trusty struct {
+func (x *RuleType_Definition_Alert_AlertTypeWebhook) GetWebhook() string {
+	if x != nil {
+		return x.Webhook
+	}
+	return ""
+}
+
+func (x *RuleType_Definition_Alert_AlertTypeWebhook) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return ""
+}
+
+func (x *RuleType_Definition_Alert_AlertTypeWebhook) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type RuleType_Definition_Alert_AlertTypeIssue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// This is no longer used, but is still here for backwards
-	// compatibility with existing stored rules
-	Endpoint      string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// title is a template for the title of the issue. If unset,
+	// the short failure message of the rule type is used.
+	Title *string `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// body is a template for the body of the issue. If unset,
+	// the guidance of the rule type is used.
+	Body *string `protobuf:"bytes,2,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// labels are added to the issue when it is opened.
+	Labels []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	// comment is a template for the comment added to the issue
+	// when the rule keeps failing with different details. If
+	// unset, the evaluation details are posted.
+	Comment       *string `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_Trusty) Reset() {
-	*x = RuleType_Definition_Eval_Trusty{}
-	mi := &file_minder_v1_minder_proto_msgTypes[224]
+func (x *RuleType_Definition_Alert_AlertTypeIssue) Reset() {
+	*x = RuleType_Definition_Alert_AlertTypeIssue{}
+	mi := &file_minder_v1_minder_proto_msgTypes[285]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_Trusty) String() string {
+func (x *RuleType_Definition_Alert_AlertTypeIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_Trusty) ProtoMessage() {}
+func (*RuleType_Definition_Alert_AlertTypeIssue) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_Trusty) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[224]
+func (x *RuleType_Definition_Alert_AlertTypeIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[285]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14052,40 +17947,71 @@ func (x *RuleType_Definition_Eval_Trusty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Trusty.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Trusty) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 1, 3}
+// Deprecated: Use RuleType_Definition_Alert_AlertTypeIssue.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert_AlertTypeIssue) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148, 0, 3, 3}
 }
 
-func (x *RuleType_Definition_Eval_Trusty) GetEndpoint() string {
+func (x *RuleType_Definition_Alert_AlertTypeIssue) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *RuleType_Definition_Alert_AlertTypeIssue) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *RuleType_Definition_Alert_AlertTypeIssue) GetLabels() []string {
 	if x != nil {
-		return x.Endpoint
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RuleType_Definition_Alert_AlertTypeIssue) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
 	}
 	return ""
 }
 
-type RuleType_Definition_Eval_Homoglyphs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+// Rule defines the individual call of a certain rule type.
+type Profile_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the type of the rule to be instantiated.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// params are the parameters that are passed to the rule.
+	// This is optional and depends on the rule type.
+	Params *structpb.Struct `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// def is the definition of the rule.
+	// This depends on the rule type.
+	Def *structpb.Struct `protobuf:"bytes,3,opt,name=def,proto3" json:"def,omitempty"`
+	// name is the descriptive name of the rule, not to be confused with type
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_Homoglyphs) Reset() {
-	*x = RuleType_Definition_Eval_Homoglyphs{}
-	mi := &file_minder_v1_minder_proto_msgTypes[225]
+func (x *Profile_Rule) Reset() {
+	*x = Profile_Rule{}
+	mi := &file_minder_v1_minder_proto_msgTypes[286]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_Homoglyphs) String() string {
+func (x *Profile_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_Homoglyphs) ProtoMessage() {}
+func (*Profile_Rule) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_Homoglyphs) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[225]
+func (x *Profile_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[286]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14096,84 +18022,68 @@ func (x *RuleType_Definition_Eval_Homoglyphs) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Homoglyphs.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Homoglyphs) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 1, 4}
+// Deprecated: Use Profile_Rule.ProtoReflect.Descriptor instead.
+func (*Profile_Rule) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149, 0}
 }
 
-func (x *RuleType_Definition_Eval_Homoglyphs) GetType() string {
+func (x *Profile_Rule) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-type RuleType_Definition_Eval_JQComparison_Operator struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Def           string                 `protobuf:"bytes,1,opt,name=def,proto3" json:"def,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RuleType_Definition_Eval_JQComparison_Operator) Reset() {
-	*x = RuleType_Definition_Eval_JQComparison_Operator{}
-	mi := &file_minder_v1_minder_proto_msgTypes[226]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RuleType_Definition_Eval_JQComparison_Operator) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RuleType_Definition_Eval_JQComparison_Operator) ProtoMessage() {}
-
-func (x *RuleType_Definition_Eval_JQComparison_Operator) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[226]
+func (x *Profile_Rule) GetParams() *structpb.Struct {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Params
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RuleType_Definition_Eval_JQComparison_Operator.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_JQComparison_Operator) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 1, 0, 0}
+func (x *Profile_Rule) GetDef() *structpb.Struct {
+	if x != nil {
+		return x.Def
+	}
+	return nil
 }
 
-func (x *RuleType_Definition_Eval_JQComparison_Operator) GetDef() string {
+func (x *Profile_Rule) GetName() string {
 	if x != nil {
-		return x.Def
+		return x.Name
 	}
 	return ""
 }
 
-type RuleType_Definition_Remediate_GhBranchProtectionType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+type Profile_Selector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is optional and use for updates to match upserts as well as read operations. It is ignored for creates.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// entity is the entity to select.
+	Entity string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// expr is the expression to select the entity.
+	Selector string `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	// description is the human-readable description of the selector.
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) Reset() {
-	*x = RuleType_Definition_Remediate_GhBranchProtectionType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[227]
+func (x *Profile_Selector) Reset() {
+	*x = Profile_Selector{}
+	mi := &file_minder_v1_minder_proto_msgTypes[287]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) String() string {
+func (x *Profile_Selector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_GhBranchProtectionType) ProtoMessage() {}
+func (*Profile_Selector) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[227]
+func (x *Profile_Selector) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[287]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14184,66 +18094,72 @@ func (x *RuleType_Definition_Remediate_GhBranchProtectionType) ProtoReflect() pr
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_GhBranchProtectionType.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_GhBranchProtectionType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 2, 0}
+// Deprecated: Use Profile_Selector.ProtoReflect.Descriptor instead.
+func (*Profile_Selector) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149, 1}
 }
 
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) GetPatch() string {
+func (x *Profile_Selector) GetId() string {
 	if x != nil {
-		return x.Patch
+		return x.Id
 	}
 	return ""
 }
 
-// the name stutters a bit but we already use a PullRequest message for handling PR entities
-type RuleType_Definition_Remediate_PullRequestRemediation struct {
+func (x *Profile_Selector) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *Profile_Selector) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *Profile_Selector) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// BlackoutWindow is a recurring period of time during which the
+// profile is not re-evaluated in the background.
+type Profile_BlackoutWindow struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the title of the PR
-	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
-	// creation of the PR.
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// the body of the PR
-	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
-	// creation of the PR.
-	Body     string                                                          `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Contents []*RuleType_Definition_Remediate_PullRequestRemediation_Content `protobuf:"bytes,3,rep,name=contents,proto3" json:"contents,omitempty"`
-	// the method to use to create the PR. For now, these are supported:
-	// -- minder.content - ensures that the content of the file is exactly as specified
-	//
-	//	refer to the Content message for more details
-	//
-	// -- minder.actions.replace_tags_with_sha - finds any github actions within a workflow
-	//
-	//	file and replaces the tag with the SHA
-	//
-	// -- minder.yq.evaluate - evaluates a yq expression on a file
-	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
-	// params are unstructured parameters passed to the method. These are optional
-	// and evaluated by the method.
-	Params *structpb.Struct `protobuf:"bytes,6,opt,name=params,proto3" json:"params,omitempty"`
-	// If the method is minder.actions.replace_tags_with_sha, this is the configuration
-	// for that method
-	ActionsReplaceTagsWithSha *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha `protobuf:"bytes,5,opt,name=actions_replace_tags_with_sha,json=actionsReplaceTagsWithSha,proto3,oneof" json:"actions_replace_tags_with_sha,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	// start is the time of day the window starts at, as HH:MM.
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the time of day the window ends at, as HH:MM. If end is
+	// before start, the window spans midnight.
+	End string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// days are the days of the week (mon, tue, wed, thu, fri, sat, sun)
+	// the window starts on. If empty, the window applies every day.
+	Days []string `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	// timezone is the IANA time zone of start and end. Defaults to UTC.
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) Reset() {
-	*x = RuleType_Definition_Remediate_PullRequestRemediation{}
-	mi := &file_minder_v1_minder_proto_msgTypes[228]
+func (x *Profile_BlackoutWindow) Reset() {
+	*x = Profile_BlackoutWindow{}
+	mi := &file_minder_v1_minder_proto_msgTypes[288]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) String() string {
+func (x *Profile_BlackoutWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_PullRequestRemediation) ProtoMessage() {}
+func (*Profile_BlackoutWindow) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[228]
+func (x *Profile_BlackoutWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[288]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14254,85 +18170,70 @@ func (x *RuleType_Definition_Remediate_PullRequestRemediation) ProtoReflect() pr
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_PullRequestRemediation) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 2, 1}
+// Deprecated: Use Profile_BlackoutWindow.ProtoReflect.Descriptor instead.
+func (*Profile_BlackoutWindow) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149, 2}
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetTitle() string {
+func (x *Profile_BlackoutWindow) GetStart() string {
 	if x != nil {
-		return x.Title
+		return x.Start
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetBody() string {
+func (x *Profile_BlackoutWindow) GetEnd() string {
 	if x != nil {
-		return x.Body
+		return x.End
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetContents() []*RuleType_Definition_Remediate_PullRequestRemediation_Content {
+func (x *Profile_BlackoutWindow) GetDays() []string {
 	if x != nil {
-		return x.Contents
+		return x.Days
 	}
 	return nil
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetMethod() string {
+func (x *Profile_BlackoutWindow) GetTimezone() string {
 	if x != nil {
-		return x.Method
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetParams() *structpb.Struct {
-	if x != nil {
-		return x.Params
-	}
-	return nil
-}
-
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetActionsReplaceTagsWithSha() *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha {
-	if x != nil {
-		return x.ActionsReplaceTagsWithSha
-	}
-	return nil
-}
-
-type RuleType_Definition_Remediate_PullRequestRemediation_Content struct {
+// Schedule controls the background re-evaluation of the profile by
+// the reminder service. Evaluations triggered by events from the
+// provider are not affected by the schedule.
+type Profile_Schedule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the file to patch
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// how to patch the file. For now, only replace is supported
-	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	// the content of the file
-	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	// the GIT mode of the file. Not UNIX mode! String because the GH API also uses strings
-	// the usual modes are: 100644 for regular files, 100755 for executable files and
-	// 040000 for submodules (which we don't use but now you know the meaning of the 1 in 100644)
-	// see e.g. https://github.com/go-git/go-git/blob/32e0172851c35ae2fac495069c923330040903d2/plumbing/filemode/filemode.go#L16
-	Mode          *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// interval is the minimum time between two re-evaluations of an
+	// entity against the profile, e.g. "1h" or "24h". If empty, the
+	// default of the reminder service applies.
+	Interval string `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// blackout_windows are the periods of time during which the
+	// profile is not re-evaluated.
+	BlackoutWindows []*Profile_BlackoutWindow `protobuf:"bytes,2,rep,name=blackout_windows,json=blackoutWindows,proto3" json:"blackout_windows,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) Reset() {
-	*x = RuleType_Definition_Remediate_PullRequestRemediation_Content{}
-	mi := &file_minder_v1_minder_proto_msgTypes[229]
+func (x *Profile_Schedule) Reset() {
+	*x = Profile_Schedule{}
+	mi := &file_minder_v1_minder_proto_msgTypes[289]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) String() string {
+func (x *Profile_Schedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoMessage() {}
+func (*Profile_Schedule) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[229]
+func (x *Profile_Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[289]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14343,63 +18244,48 @@ func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoRefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_Content.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 2, 1, 0}
-}
-
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use Profile_Schedule.ProtoReflect.Descriptor instead.
+func (*Profile_Schedule) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149, 3}
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetAction() string {
+func (x *Profile_Schedule) GetInterval() string {
 	if x != nil {
-		return x.Action
+		return x.Interval
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetContent() string {
+func (x *Profile_Schedule) GetBlackoutWindows() []*Profile_BlackoutWindow {
 	if x != nil {
-		return x.Content
+		return x.BlackoutWindows
 	}
-	return ""
-}
-
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetMode() string {
-	if x != nil && x.Mode != nil {
-		return *x.Mode
-	}
-	return ""
+	return nil
 }
 
-type RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha struct {
+type StructDataSource_Def struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of actions to exclude from the replacement
-	Exclude       []string `protobuf:"bytes,1,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	// Path is the path specification for the structured data source.
+	Path          *StructDataSource_Def_Path `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Reset() {
-	*x = RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha{}
-	mi := &file_minder_v1_minder_proto_msgTypes[230]
+func (x *StructDataSource_Def) Reset() {
+	*x = StructDataSource_Def{}
+	mi := &file_minder_v1_minder_proto_msgTypes[291]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) String() string {
+func (x *StructDataSource_Def) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoMessage() {
-}
+func (*StructDataSource_Def) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[230]
+func (x *StructDataSource_Def) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[291]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14410,40 +18296,41 @@ func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTags
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 2, 1, 1}
+// Deprecated: Use StructDataSource_Def.ProtoReflect.Descriptor instead.
+func (*StructDataSource_Def) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{252, 0}
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) GetExclude() []string {
+func (x *StructDataSource_Def) GetPath() *StructDataSource_Def_Path {
 	if x != nil {
-		return x.Exclude
+		return x.Path
 	}
 	return nil
 }
 
-type RuleType_Definition_Alert_AlertTypeSA struct {
+type StructDataSource_Def_Path struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Severity      string                 `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Alternatives  []string               `protobuf:"bytes,2,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) Reset() {
-	*x = RuleType_Definition_Alert_AlertTypeSA{}
-	mi := &file_minder_v1_minder_proto_msgTypes[231]
+func (x *StructDataSource_Def_Path) Reset() {
+	*x = StructDataSource_Def_Path{}
+	mi := &file_minder_v1_minder_proto_msgTypes[293]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) String() string {
+func (x *StructDataSource_Def_Path) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Alert_AlertTypeSA) ProtoMessage() {}
+func (*StructDataSource_Def_Path) ProtoMessage() {}
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[231]
+func (x *StructDataSource_Def_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[293]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14454,44 +18341,80 @@ func (x *RuleType_Definition_Alert_AlertTypeSA) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Alert_AlertTypeSA.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Alert_AlertTypeSA) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 3, 0}
+// Deprecated: Use StructDataSource_Def_Path.ProtoReflect.Descriptor instead.
+func (*StructDataSource_Def_Path) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{252, 0, 0}
 }
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) GetSeverity() string {
+func (x *StructDataSource_Def_Path) GetFileName() string {
 	if x != nil {
-		return x.Severity
+		return x.FileName
 	}
 	return ""
 }
 
-type RuleType_Definition_Alert_AlertTypePRComment struct {
+func (x *StructDataSource_Def_Path) GetAlternatives() []string {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+type RestDataSource_Def struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// review_message is the message to post in the PR review.
-	ReviewMessage string `protobuf:"bytes,1,opt,name=review_message,json=reviewMessage,proto3" json:"review_message,omitempty"`
-	// action is the action to use for the PR review (comment or request_changes).
-	// Default is comment.
-	Action        *string `protobuf:"bytes,2,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	// endpoint is the URL of the REST API. Note that endpoints are
+	// templates that can be parameterized with variables. Parametrization
+	// is done using RFC 6570.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// method is the HTTP method to use for the request.
+	// If left unset, it will default to "GET".
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// headers is a map of headers to send with the request.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*RestDataSource_Def_Bodyobj
+	//	*RestDataSource_Def_Bodystr
+	//	*RestDataSource_Def_BodyFromField
+	Body isRestDataSource_Def_Body `protobuf_oneof:"body"`
+	// parse is the parse configuration for the response.
+	// This allows us to serialize the response into a structured format,
+	// or not.
+	// If left unset, the response will be treated as a string.
+	// If set to "json", the response will be parsed as JSON.
+	Parse string `protobuf:"bytes,6,opt,name=parse,proto3" json:"parse,omitempty"`
+	// fallback is the fallback configuration for the response in case
+	// of an unexpected status code.
+	Fallback []*RestDataSource_Def_Fallback `protobuf:"bytes,7,rep,name=fallback,proto3" json:"fallback,omitempty"`
+	// expected_status is the expected status code for the response.
+	// This may be repeated to allow for multiple expected status codes.
+	// If left unset, it will default to 200.
+	ExpectedStatus []int32 `protobuf:"varint,8,rep,packed,name=expected_status,json=expectedStatus,proto3" json:"expected_status,omitempty"`
+	// input_schema is the schema for the input to the REST API.
+	InputSchema *structpb.Struct `protobuf:"bytes,9,opt,name=input_schema,json=inputSchema,proto3" json:"input_schema,omitempty"`
+	// cache is the caching configuration for the responses. Responses
+	// to GET requests are cached as their Cache-Control header allows,
+	// and revalidated with their ETag or Last-Modified headers.
+	Cache         *RestDataSource_Def_Cache `protobuf:"bytes,11,opt,name=cache,proto3" json:"cache,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) Reset() {
-	*x = RuleType_Definition_Alert_AlertTypePRComment{}
-	mi := &file_minder_v1_minder_proto_msgTypes[232]
+func (x *RestDataSource_Def) Reset() {
+	*x = RestDataSource_Def{}
+	mi := &file_minder_v1_minder_proto_msgTypes[294]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) String() string {
+func (x *RestDataSource_Def) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Alert_AlertTypePRComment) ProtoMessage() {}
+func (*RestDataSource_Def) ProtoMessage() {}
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[232]
+func (x *RestDataSource_Def) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[294]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14502,129 +18425,155 @@ func (x *RuleType_Definition_Alert_AlertTypePRComment) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Alert_AlertTypePRComment.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Alert_AlertTypePRComment) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128, 0, 3, 1}
+// Deprecated: Use RestDataSource_Def.ProtoReflect.Descriptor instead.
+func (*RestDataSource_Def) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{253, 0}
 }
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) GetReviewMessage() string {
+func (x *RestDataSource_Def) GetEndpoint() string {
 	if x != nil {
-		return x.ReviewMessage
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) GetAction() string {
-	if x != nil && x.Action != nil {
-		return *x.Action
+func (x *RestDataSource_Def) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
 	return ""
 }
 
-// Rule defines the individual call of a certain rule type.
-type Profile_Rule struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the rule to be instantiated.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// params are the parameters that are passed to the rule.
-	// This is optional and depends on the rule type.
-	Params *structpb.Struct `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
-	// def is the definition of the rule.
-	// This depends on the rule type.
-	Def *structpb.Struct `protobuf:"bytes,3,opt,name=def,proto3" json:"def,omitempty"`
-	// name is the descriptive name of the rule, not to be confused with type
-	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RestDataSource_Def) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
 }
 
-func (x *Profile_Rule) Reset() {
-	*x = Profile_Rule{}
-	mi := &file_minder_v1_minder_proto_msgTypes[233]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RestDataSource_Def) GetBody() isRestDataSource_Def_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
 }
 
-func (x *Profile_Rule) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RestDataSource_Def) GetBodyobj() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.Body.(*RestDataSource_Def_Bodyobj); ok {
+			return x.Bodyobj
+		}
+	}
+	return nil
 }
 
-func (*Profile_Rule) ProtoMessage() {}
-
-func (x *Profile_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[233]
+func (x *RestDataSource_Def) GetBodystr() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Body.(*RestDataSource_Def_Bodystr); ok {
+			return x.Bodystr
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Profile_Rule.ProtoReflect.Descriptor instead.
-func (*Profile_Rule) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{129, 0}
+func (x *RestDataSource_Def) GetBodyFromField() string {
+	if x != nil {
+		if x, ok := x.Body.(*RestDataSource_Def_BodyFromField); ok {
+			return x.BodyFromField
+		}
+	}
+	return ""
 }
 
-func (x *Profile_Rule) GetType() string {
+func (x *RestDataSource_Def) GetParse() string {
 	if x != nil {
-		return x.Type
+		return x.Parse
 	}
 	return ""
 }
 
-func (x *Profile_Rule) GetParams() *structpb.Struct {
+func (x *RestDataSource_Def) GetFallback() []*RestDataSource_Def_Fallback {
 	if x != nil {
-		return x.Params
+		return x.Fallback
 	}
 	return nil
 }
 
-func (x *Profile_Rule) GetDef() *structpb.Struct {
+func (x *RestDataSource_Def) GetExpectedStatus() []int32 {
 	if x != nil {
-		return x.Def
+		return x.ExpectedStatus
 	}
 	return nil
 }
 
-func (x *Profile_Rule) GetName() string {
+func (x *RestDataSource_Def) GetInputSchema() *structpb.Struct {
 	if x != nil {
-		return x.Name
+		return x.InputSchema
 	}
-	return ""
+	return nil
 }
 
-type Profile_Selector struct {
+func (x *RestDataSource_Def) GetCache() *RestDataSource_Def_Cache {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+type isRestDataSource_Def_Body interface {
+	isRestDataSource_Def_Body()
+}
+
+type RestDataSource_Def_Bodyobj struct {
+	// body is the body of the request.
+	Bodyobj *structpb.Struct `protobuf:"bytes,4,opt,name=bodyobj,proto3,oneof"`
+}
+
+type RestDataSource_Def_Bodystr struct {
+	// bodystr is the body of the request as a string.
+	Bodystr string `protobuf:"bytes,5,opt,name=bodystr,proto3,oneof"`
+}
+
+type RestDataSource_Def_BodyFromField struct {
+	// body_from_field is the field in the input to use as the body.
+	// If the value is an string, it will be used as the body, as is.
+	// If the value is an object, it will be serialized as JSON.
+	// If the value is not found in the input, the request will fail.
+	BodyFromField string `protobuf:"bytes,10,opt,name=body_from_field,json=bodyFromField,proto3,oneof"`
+}
+
+func (*RestDataSource_Def_Bodyobj) isRestDataSource_Def_Body() {}
+
+func (*RestDataSource_Def_Bodystr) isRestDataSource_Def_Body() {}
+
+func (*RestDataSource_Def_BodyFromField) isRestDataSource_Def_Body() {}
+
+type RestDataSource_RateLimit struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is optional and use for updates to match upserts as well as read operations. It is ignored for creates.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// entity is the entity to select.
-	Entity string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
-	// expr is the expression to select the entity.
-	Selector string `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
-	// description is the human-readable description of the selector.
-	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// requests_per_second is the rate at which requests are allowed.
+	RequestsPerSecond float64 `protobuf:"fixed64,1,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// burst is the number of requests allowed at once.
+	// If left unset, it will default to 1.
+	Burst         int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Profile_Selector) Reset() {
-	*x = Profile_Selector{}
-	mi := &file_minder_v1_minder_proto_msgTypes[234]
+func (x *RestDataSource_RateLimit) Reset() {
+	*x = RestDataSource_RateLimit{}
+	mi := &file_minder_v1_minder_proto_msgTypes[296]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile_Selector) String() string {
+func (x *RestDataSource_RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile_Selector) ProtoMessage() {}
+func (*RestDataSource_RateLimit) ProtoMessage() {}
 
-func (x *Profile_Selector) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[234]
+func (x *RestDataSource_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[296]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14635,62 +18584,48 @@ func (x *Profile_Selector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile_Selector.ProtoReflect.Descriptor instead.
-func (*Profile_Selector) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{129, 1}
-}
-
-func (x *Profile_Selector) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Profile_Selector) GetEntity() string {
-	if x != nil {
-		return x.Entity
-	}
-	return ""
+// Deprecated: Use RestDataSource_RateLimit.ProtoReflect.Descriptor instead.
+func (*RestDataSource_RateLimit) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{253, 2}
 }
 
-func (x *Profile_Selector) GetSelector() string {
+func (x *RestDataSource_RateLimit) GetRequestsPerSecond() float64 {
 	if x != nil {
-		return x.Selector
+		return x.RequestsPerSecond
 	}
-	return ""
+	return 0
 }
 
-func (x *Profile_Selector) GetDescription() string {
+func (x *RestDataSource_RateLimit) GetBurst() int32 {
 	if x != nil {
-		return x.Description
+		return x.Burst
 	}
-	return ""
-}
-
-type StructDataSource_Def struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Path is the path specification for the structured data source.
-	Path          *StructDataSource_Def_Path `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	return 0
+}
+
+type RestDataSource_Def_Fallback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HttpStatus    int32                  `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StructDataSource_Def) Reset() {
-	*x = StructDataSource_Def{}
-	mi := &file_minder_v1_minder_proto_msgTypes[236]
+func (x *RestDataSource_Def_Fallback) Reset() {
+	*x = RestDataSource_Def_Fallback{}
+	mi := &file_minder_v1_minder_proto_msgTypes[298]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StructDataSource_Def) String() string {
+func (x *RestDataSource_Def_Fallback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StructDataSource_Def) ProtoMessage() {}
+func (*RestDataSource_Def_Fallback) ProtoMessage() {}
 
-func (x *StructDataSource_Def) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[236]
+func (x *RestDataSource_Def_Fallback) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[298]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14701,41 +18636,52 @@ func (x *StructDataSource_Def) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StructDataSource_Def.ProtoReflect.Descriptor instead.
-func (*StructDataSource_Def) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{204, 0}
+// Deprecated: Use RestDataSource_Def_Fallback.ProtoReflect.Descriptor instead.
+func (*RestDataSource_Def_Fallback) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{253, 0, 1}
 }
 
-func (x *StructDataSource_Def) GetPath() *StructDataSource_Def_Path {
+func (x *RestDataSource_Def_Fallback) GetHttpStatus() int32 {
 	if x != nil {
-		return x.Path
+		return x.HttpStatus
 	}
-	return nil
+	return 0
 }
 
-type StructDataSource_Def_Path struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	Alternatives  []string               `protobuf:"bytes,2,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+func (x *RestDataSource_Def_Fallback) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type RestDataSource_Def_Cache struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disabled turns off the caching of the responses.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// ttl_seconds is how long responses are used without being
+	// revalidated, overriding the lifetime from the Cache-Control
+	// header of the responses.
+	TtlSeconds    int32 `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StructDataSource_Def_Path) Reset() {
-	*x = StructDataSource_Def_Path{}
-	mi := &file_minder_v1_minder_proto_msgTypes[238]
+func (x *RestDataSource_Def_Cache) Reset() {
+	*x = RestDataSource_Def_Cache{}
+	mi := &file_minder_v1_minder_proto_msgTypes[299]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StructDataSource_Def_Path) String() string {
+func (x *RestDataSource_Def_Cache) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StructDataSource_Def_Path) ProtoMessage() {}
+func (*RestDataSource_Def_Cache) ProtoMessage() {}
 
-func (x *StructDataSource_Def_Path) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[238]
+func (x *RestDataSource_Def_Cache) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[299]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14746,76 +18692,62 @@ func (x *StructDataSource_Def_Path) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StructDataSource_Def_Path.ProtoReflect.Descriptor instead.
-func (*StructDataSource_Def_Path) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{204, 0, 0}
+// Deprecated: Use RestDataSource_Def_Cache.ProtoReflect.Descriptor instead.
+func (*RestDataSource_Def_Cache) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{253, 0, 2}
 }
 
-func (x *StructDataSource_Def_Path) GetFileName() string {
+func (x *RestDataSource_Def_Cache) GetDisabled() bool {
 	if x != nil {
-		return x.FileName
+		return x.Disabled
 	}
-	return ""
+	return false
 }
 
-func (x *StructDataSource_Def_Path) GetAlternatives() []string {
+func (x *RestDataSource_Def_Cache) GetTtlSeconds() int32 {
 	if x != nil {
-		return x.Alternatives
+		return x.TtlSeconds
 	}
-	return nil
+	return 0
 }
 
-type RestDataSource_Def struct {
+type GraphQLDataSource_Def struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// endpoint is the URL of the REST API. Note that endpoints are
-	// templates that can be parameterized with variables. Parametrization
-	// is done using RFC 6570.
+	// endpoint is the URL of the GraphQL API.
 	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	// method is the HTTP method to use for the request.
-	// If left unset, it will default to "GET".
-	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	// headers is a map of headers to send with the request.
+	// query is the GraphQL document to execute. It must contain a
+	// single operation. The arguments of the function are passed as
+	// the variables of the operation, converted to the types the
+	// operation declares for them.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// headers are the headers to be sent to the GraphQL API.
 	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Types that are valid to be assigned to Body:
-	//
-	//	*RestDataSource_Def_Bodyobj
-	//	*RestDataSource_Def_Bodystr
-	//	*RestDataSource_Def_BodyFromField
-	Body isRestDataSource_Def_Body `protobuf_oneof:"body"`
-	// parse is the parse configuration for the response.
-	// This allows us to serialize the response into a structured format,
-	// or not.
-	// If left unset, the response will be treated as a string.
-	// If set to "json", the response will be parsed as JSON.
-	Parse string `protobuf:"bytes,6,opt,name=parse,proto3" json:"parse,omitempty"`
-	// fallback is the fallback configuration for the response in case
-	// of an unexpected status code.
-	Fallback []*RestDataSource_Def_Fallback `protobuf:"bytes,7,rep,name=fallback,proto3" json:"fallback,omitempty"`
-	// expected_status is the expected status code for the response.
-	// This may be repeated to allow for multiple expected status codes.
-	// If left unset, it will default to 200.
-	ExpectedStatus []int32 `protobuf:"varint,8,rep,packed,name=expected_status,json=expectedStatus,proto3" json:"expected_status,omitempty"`
-	// input_schema is the schema for the input to the REST API.
-	InputSchema   *structpb.Struct `protobuf:"bytes,9,opt,name=input_schema,json=inputSchema,proto3" json:"input_schema,omitempty"`
+	// input_schema is the schema for the arguments of the function.
+	// Each variable the operation requires must be a property of the
+	// schema.
+	InputSchema *structpb.Struct `protobuf:"bytes,4,opt,name=input_schema,json=inputSchema,proto3" json:"input_schema,omitempty"`
+	// pagination is the pagination configuration. If left unset, a
+	// single request is made.
+	Pagination    *GraphQLDataSource_Def_Pagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestDataSource_Def) Reset() {
-	*x = RestDataSource_Def{}
-	mi := &file_minder_v1_minder_proto_msgTypes[239]
+func (x *GraphQLDataSource_Def) Reset() {
+	*x = GraphQLDataSource_Def{}
+	mi := &file_minder_v1_minder_proto_msgTypes[300]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestDataSource_Def) String() string {
+func (x *GraphQLDataSource_Def) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestDataSource_Def) ProtoMessage() {}
+func (*GraphQLDataSource_Def) ProtoMessage() {}
 
-func (x *RestDataSource_Def) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[239]
+func (x *GraphQLDataSource_Def) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[300]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14826,145 +18758,151 @@ func (x *RestDataSource_Def) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestDataSource_Def.ProtoReflect.Descriptor instead.
-func (*RestDataSource_Def) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{205, 0}
+// Deprecated: Use GraphQLDataSource_Def.ProtoReflect.Descriptor instead.
+func (*GraphQLDataSource_Def) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{254, 0}
 }
 
-func (x *RestDataSource_Def) GetEndpoint() string {
+func (x *GraphQLDataSource_Def) GetEndpoint() string {
 	if x != nil {
 		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *RestDataSource_Def) GetMethod() string {
+func (x *GraphQLDataSource_Def) GetQuery() string {
 	if x != nil {
-		return x.Method
+		return x.Query
 	}
 	return ""
 }
 
-func (x *RestDataSource_Def) GetHeaders() map[string]string {
+func (x *GraphQLDataSource_Def) GetHeaders() map[string]string {
 	if x != nil {
 		return x.Headers
 	}
 	return nil
 }
 
-func (x *RestDataSource_Def) GetBody() isRestDataSource_Def_Body {
+func (x *GraphQLDataSource_Def) GetInputSchema() *structpb.Struct {
 	if x != nil {
-		return x.Body
+		return x.InputSchema
 	}
 	return nil
 }
 
-func (x *RestDataSource_Def) GetBodyobj() *structpb.Struct {
+func (x *GraphQLDataSource_Def) GetPagination() *GraphQLDataSource_Def_Pagination {
 	if x != nil {
-		if x, ok := x.Body.(*RestDataSource_Def_Bodyobj); ok {
-			return x.Bodyobj
-		}
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *RestDataSource_Def) GetBodystr() string {
-	if x != nil {
-		if x, ok := x.Body.(*RestDataSource_Def_Bodystr); ok {
-			return x.Bodystr
-		}
-	}
-	return ""
+type GraphQLDataSource_Def_Pagination struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path is the path to the connection to page through, as
+	// dot-separated fields under `data`, e.g. "repository.rulesets".
+	// The connection must select `pageInfo { hasNextPage endCursor }`,
+	// and the `nodes` or `edges` of all the pages are concatenated.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// cursor_variable is the variable of the operation which takes
+	// the cursor of the next page. If left unset, it will default to
+	// "cursor".
+	CursorVariable string `protobuf:"bytes,2,opt,name=cursor_variable,json=cursorVariable,proto3" json:"cursor_variable,omitempty"`
+	// max_pages is the maximum number of pages to fetch.
+	// If left unset, it will default to 10.
+	MaxPages      int32 `protobuf:"varint,3,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestDataSource_Def) GetBodyFromField() string {
+func (x *GraphQLDataSource_Def_Pagination) Reset() {
+	*x = GraphQLDataSource_Def_Pagination{}
+	mi := &file_minder_v1_minder_proto_msgTypes[303]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphQLDataSource_Def_Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphQLDataSource_Def_Pagination) ProtoMessage() {}
+
+func (x *GraphQLDataSource_Def_Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[303]
 	if x != nil {
-		if x, ok := x.Body.(*RestDataSource_Def_BodyFromField); ok {
-			return x.BodyFromField
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RestDataSource_Def) GetParse() string {
-	if x != nil {
-		return x.Parse
-	}
-	return ""
+// Deprecated: Use GraphQLDataSource_Def_Pagination.ProtoReflect.Descriptor instead.
+func (*GraphQLDataSource_Def_Pagination) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{254, 0, 1}
 }
 
-func (x *RestDataSource_Def) GetFallback() []*RestDataSource_Def_Fallback {
+func (x *GraphQLDataSource_Def_Pagination) GetPath() string {
 	if x != nil {
-		return x.Fallback
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *RestDataSource_Def) GetExpectedStatus() []int32 {
+func (x *GraphQLDataSource_Def_Pagination) GetCursorVariable() string {
 	if x != nil {
-		return x.ExpectedStatus
+		return x.CursorVariable
 	}
-	return nil
+	return ""
 }
 
-func (x *RestDataSource_Def) GetInputSchema() *structpb.Struct {
+func (x *GraphQLDataSource_Def_Pagination) GetMaxPages() int32 {
 	if x != nil {
-		return x.InputSchema
+		return x.MaxPages
 	}
-	return nil
-}
-
-type isRestDataSource_Def_Body interface {
-	isRestDataSource_Def_Body()
-}
-
-type RestDataSource_Def_Bodyobj struct {
-	// body is the body of the request.
-	Bodyobj *structpb.Struct `protobuf:"bytes,4,opt,name=bodyobj,proto3,oneof"`
-}
-
-type RestDataSource_Def_Bodystr struct {
-	// bodystr is the body of the request as a string.
-	Bodystr string `protobuf:"bytes,5,opt,name=bodystr,proto3,oneof"`
-}
-
-type RestDataSource_Def_BodyFromField struct {
-	// body_from_field is the field in the input to use as the body.
-	// If the value is an string, it will be used as the body, as is.
-	// If the value is an object, it will be serialized as JSON.
-	// If the value is not found in the input, the request will fail.
-	BodyFromField string `protobuf:"bytes,10,opt,name=body_from_field,json=bodyFromField,proto3,oneof"`
+	return 0
 }
 
-func (*RestDataSource_Def_Bodyobj) isRestDataSource_Def_Body() {}
-
-func (*RestDataSource_Def_Bodystr) isRestDataSource_Def_Body() {}
-
-func (*RestDataSource_Def_BodyFromField) isRestDataSource_Def_Body() {}
-
-type RestDataSource_Def_Fallback struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HttpStatus    int32                  `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
-	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SQLDataSource_Def struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is the SQL query to run, which must be a single
+	// statement. It is run in a read-only transaction, and may refer
+	// to the parameters as $1, $2, etc.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// params are the names of the arguments passed as the parameters
+	// of the query, in order, i.e. the first one is $1.
+	Params []string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+	// input_schema is the schema for the arguments of the function.
+	InputSchema *structpb.Struct `protobuf:"bytes,3,opt,name=input_schema,json=inputSchema,proto3" json:"input_schema,omitempty"`
+	// max_rows is the maximum number of rows to return.
+	// If left unset, it will default to 100.
+	MaxRows int32 `protobuf:"varint,4,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	// timeout_seconds is the maximum time the query may take.
+	// If left unset, it will default to 5 seconds.
+	TimeoutSeconds int32 `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RestDataSource_Def_Fallback) Reset() {
-	*x = RestDataSource_Def_Fallback{}
-	mi := &file_minder_v1_minder_proto_msgTypes[242]
+func (x *SQLDataSource_Def) Reset() {
+	*x = SQLDataSource_Def{}
+	mi := &file_minder_v1_minder_proto_msgTypes[304]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestDataSource_Def_Fallback) String() string {
+func (x *SQLDataSource_Def) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestDataSource_Def_Fallback) ProtoMessage() {}
+func (*SQLDataSource_Def) ProtoMessage() {}
 
-func (x *RestDataSource_Def_Fallback) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[242]
+func (x *SQLDataSource_Def) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[304]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14975,23 +18913,44 @@ func (x *RestDataSource_Def_Fallback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestDataSource_Def_Fallback.ProtoReflect.Descriptor instead.
-func (*RestDataSource_Def_Fallback) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{205, 0, 1}
+// Deprecated: Use SQLDataSource_Def.ProtoReflect.Descriptor instead.
+func (*SQLDataSource_Def) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{255, 0}
 }
 
-func (x *RestDataSource_Def_Fallback) GetHttpStatus() int32 {
+func (x *SQLDataSource_Def) GetQuery() string {
 	if x != nil {
-		return x.HttpStatus
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SQLDataSource_Def) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SQLDataSource_Def) GetInputSchema() *structpb.Struct {
+	if x != nil {
+		return x.InputSchema
+	}
+	return nil
+}
+
+func (x *SQLDataSource_Def) GetMaxRows() int32 {
+	if x != nil {
+		return x.MaxRows
 	}
 	return 0
 }
 
-func (x *RestDataSource_Def_Fallback) GetBody() string {
+func (x *SQLDataSource_Def) GetTimeoutSeconds() int32 {
 	if x != nil {
-		return x.Body
+		return x.TimeoutSeconds
 	}
-	return ""
+	return 0
 }
 
 var file_minder_v1_minder_proto_extTypes = []protoimpl.ExtensionInfo{
@@ -15331,7 +19290,25 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x14UpdateProfileRequest\x121\n" +
 	"\aprofile\x18\x01 \x01(\v2\x12.minder.v1.ProfileB\x03\xe0A\x02R\aprofileJ\x04\b\x02\x10\x03\"J\n" +
 	"\x15UpdateProfileResponse\x121\n" +
-	"\aprofile\x18\x01 \x01(\v2\x12.minder.v1.ProfileB\x03\xe0A\x02R\aprofile\"\xe3\x01\n" +
+	"\aprofile\x18\x01 \x01(\v2\x12.minder.v1.ProfileB\x03\xe0A\x02R\aprofile\"G\n" +
+	"\x12PlanProfileRequest\x121\n" +
+	"\aprofile\x18\x01 \x01(\v2\x12.minder.v1.ProfileB\x03\xe0A\x02R\aprofile\"\x98\x01\n" +
+	"\x14ProfilePlanSelection\x120\n" +
+	"\x06entity\x18\x01 \x01(\v2\x18.minder.v1.EntityTypedIdR\x06entity\x12'\n" +
+	"\x0fselected_before\x18\x02 \x01(\bR\x0eselectedBefore\x12%\n" +
+	"\x0eselected_after\x18\x03 \x01(\bR\rselectedAfter\"\x83\x02\n" +
+	"\x15ProfilePlanEvaluation\x120\n" +
+	"\x06entity\x18\x01 \x01(\v2\x18.minder.v1.EntityTypedIdR\x06entity\x12$\n" +
+	"\x0erule_type_name\x18\x02 \x01(\tR\fruleTypeName\x12\x1b\n" +
+	"\trule_name\x18\x03 \x01(\tR\bruleName\x12%\n" +
+	"\x0ecurrent_status\x18\x04 \x01(\tR\rcurrentStatus\x12%\n" +
+	"\x0eplanned_status\x18\x05 \x01(\tR\rplannedStatus\x12'\n" +
+	"\x0fplanned_details\x18\x06 \x01(\tR\x0eplannedDetails\"\x9a\x01\n" +
+	"\x13PlanProfileResponse\x12?\n" +
+	"\n" +
+	"selections\x18\x01 \x03(\v2\x1f.minder.v1.ProfilePlanSelectionR\n" +
+	"selections\x12B\n" +
+	"\vevaluations\x18\x02 \x03(\v2 .minder.v1.ProfilePlanEvaluationR\vevaluations\"\xe3\x01\n" +
 	"\x13PatchProfileRequest\x12,\n" +
 	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x127\n" +
 	"\x02id\x18\x02 \x01(\tB'\xe0A\x02\xbaH!r\x1f\x18\xc8\x012\x1a^[A-Za-z0-9][-/[:word:]]*$R\x02id\x12(\n" +
@@ -15428,7 +19405,55 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	" GetProfileStatusByProjectRequest\x12,\n" +
 	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\"i\n" +
 	"!GetProfileStatusByProjectResponse\x12D\n" +
-	"\x0eprofile_status\x18\x01 \x03(\v2\x18.minder.v1.ProfileStatusB\x03\xe0A\x02R\rprofileStatus\"I\n" +
+	"\x0eprofile_status\x18\x01 \x03(\v2\x18.minder.v1.ProfileStatusB\x03\xe0A\x02R\rprofileStatus\"\xa8\x04\n" +
+	"\rRuleExemption\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12,\n" +
+	"\acontext\x18\x02 \x01(\v2\x12.minder.v1.ContextR\acontext\x12'\n" +
+	"\aprofile\x18\x03 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xc8\x01R\aprofile\x12*\n" +
+	"\trule_name\x18\x04 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xc8\x01R\bruleName\x125\n" +
+	"\x06entity\x18\x05 \x01(\v2\x18.minder.v1.EntityTypedIdB\x03\xe0A\x02R\x06entity\x123\n" +
+	"\rjustification\x18\x06 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x80\bR\rjustification\x12)\n" +
+	"\bapprover\x18\a \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xc8\x01R\bapprover\x12D\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\texpiresAt\x12\"\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tB\x03\xe0A\x03R\tcreatedBy\x12>\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tupdatedAt\"\x8d\x01\n" +
+	"\x1aCreateRuleExemptionRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12A\n" +
+	"\texemption\x18\x02 \x01(\v2\x18.minder.v1.RuleExemptionB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\texemption\"U\n" +
+	"\x1bCreateRuleExemptionResponse\x126\n" +
+	"\texemption\x18\x01 \x01(\v2\x18.minder.v1.RuleExemptionR\texemption\"d\n" +
+	"\x17GetRuleExemptionRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\"R\n" +
+	"\x18GetRuleExemptionResponse\x126\n" +
+	"\texemption\x18\x01 \x01(\v2\x18.minder.v1.RuleExemptionR\texemption\"\x8c\x01\n" +
+	"\x19ListRuleExemptionsRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x18\n" +
+	"\aprofile\x18\x02 \x01(\tR\aprofile\x12'\n" +
+	"\x0finclude_expired\x18\x03 \x01(\bR\x0eincludeExpired\"V\n" +
+	"\x1aListRuleExemptionsResponse\x128\n" +
+	"\n" +
+	"exemptions\x18\x01 \x03(\v2\x18.minder.v1.RuleExemptionR\n" +
+	"exemptions\"\xf8\x01\n" +
+	"\x1aUpdateRuleExemptionRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12.\n" +
+	"\rjustification\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\rjustification\x12$\n" +
+	"\bapprover\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\bapprover\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"U\n" +
+	"\x1bUpdateRuleExemptionResponse\x126\n" +
+	"\texemption\x18\x01 \x01(\v2\x18.minder.v1.RuleExemptionR\texemption\"g\n" +
+	"\x1aDeleteRuleExemptionRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x1d\n" +
+	"\x1bDeleteRuleExemptionResponse\"I\n" +
 	"\x1cEntityAutoRegistrationConfig\x12\x1d\n" +
 	"\aenabled\x18\x01 \x01(\bH\x00R\aenabled\x88\x01\x01B\n" +
 	"\n" +
@@ -15452,7 +19477,16 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\t_endpointJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\bapp_nameR\x06app_idR\auser_id\"H\n" +
 	"\x14GitLabProviderConfig\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x14\n" +
-	"\x05group\x18\x02 \x01(\tR\x05group\"J\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\"s\n" +
+	"\x17BitbucketProviderConfig\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1e\n" +
+	"\n" +
+	"deployment\x18\x02 \x01(\tR\n" +
+	"deployment\x12\x1c\n" +
+	"\tworkspace\x18\x03 \x01(\tR\tworkspace\"U\n" +
+	"\x13GiteaProviderConfig\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\"\n" +
+	"\forganization\x18\x02 \x01(\tR\forganization\"J\n" +
 	"\x17DockerHubProviderConfig\x12!\n" +
 	"\tnamespace\x18\x01 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
 	"\n" +
@@ -15515,7 +19549,17 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\aresults\x18\x02 \x03(\v2\x1f.minder.v1.RuleEvaluationStatusR\aresults\x1a\xb0\x01\n" +
 	"\x17EntityEvaluationResults\x120\n" +
 	"\x06entity\x18\x01 \x01(\v2\x18.minder.v1.EntityTypedIdR\x06entity\x12c\n" +
-	"\bprofiles\x18\x02 \x03(\v2G.minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResultsR\bprofilesJ\x04\b\x01\x10\x02R\x06status\"\x90\x03\n" +
+	"\bprofiles\x18\x02 \x03(\v2G.minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResultsR\bprofilesJ\x04\b\x01\x10\x02R\x06status\"\xaf\x02\n" +
+	"\x1fGenerateComplianceReportRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x129\n" +
+	"\x06format\x18\x02 \x01(\x0e2!.minder.v1.ComplianceReportFormatR\x06format\x12B\n" +
+	"\aprofile\x18\x03 \x01(\tB&\xbaH#r!\x18\xc8\x012\x1c^([[:alnum:]][-[:word:]]*)?$H\x00R\aprofile\x12K\n" +
+	"\flabel_filter\x18\x04 \x01(\tB&\xbaH#r!\x18\xc8\x012\x1c^(\\*|[a-zA-Z][a-zA-Z0-9_]*)$H\x00R\vlabelFilterB\x12\n" +
+	"\x10profile_selector\"\xad\x01\n" +
+	" GenerateComplianceReportResponse\x12\x1d\n" +
+	"\acontent\x18\x01 \x01(\fB\x03\xe0A\x02R\acontent\x12&\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\x03\xe0A\x02R\vcontentType\x12B\n" +
+	"\fgenerated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\vgeneratedAt\"\x90\x03\n" +
 	"\bRestType\x12'\n" +
 	"\bendpoint\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\x18\x90\x03R\bendpoint\x12\"\n" +
 	"\x06method\x18\x02 \x01(\tB\n" +
@@ -15534,15 +19578,15 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\fArtifactType\"m\n" +
 	"\aGitType\x12+\n" +
 	"\tclone_url\x18\x01 \x01(\tB\x0e\xbaH\v\xd8\x01\x01r\x06\x18\xc8\x01\x88\x01\x01R\bcloneUrl\x125\n" +
-	"\x06branch\x18\x02 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x15\x18\xc8\x012\x10^[[:word:]./-]+$R\x06branch\"\xa3\x02\n" +
+	"\x06branch\x18\x02 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x15\x18\xc8\x012\x10^[[:word:]./-]+$R\x06branch\"\xa8\x02\n" +
 	"\bDiffType\x12=\n" +
 	"\n" +
 	"ecosystems\x18\x01 \x03(\v2\x1d.minder.v1.DiffType.EcosystemR\n" +
 	"ecosystems\x123\n" +
-	"\x04type\x18\x02 \x01(\tB\x1f\xbaH\x1c\xd8\x01\x01r\x17\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\x04type\x1a\xa2\x01\n" +
+	"\x04type\x18\x02 \x01(\tB\x1f\xbaH\x1c\xd8\x01\x01r\x17\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\x04type\x1a\xa7\x01\n" +
 	"\tEcosystem\x122\n" +
-	"\x04name\x18\x01 \x01(\tB\x1e\xbaH\x1br\x19\x10\x01\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\x04name\x12a\n" +
-	"\adepfile\x18\x02 \x01(\tBG\xbaHDrB\x10\x01\x18\xc8\x012;^(\\./)?([a-zA-Z0-9_\\-]+/)*[a-zA-Z0-9_\\-]+(\\.[a-zA-Z0-9]+)?$R\adepfile\"\xa3\x02\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xbaH\x1br\x19\x10\x01\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\x04name\x12f\n" +
+	"\adepfile\x18\x02 \x01(\tBL\xbaHIrG\x10\x01\x18\xc8\x012@^(\\./)?([a-zA-Z0-9_\\-]+/)*(\\*|[a-zA-Z0-9_\\-]+)(\\.[a-zA-Z0-9]+)*$R\adepfile\"\xa3\x02\n" +
 	"\bDepsType\x125\n" +
 	"\x04repo\x18\x01 \x01(\v2\x1f.minder.v1.DepsType.RepoConfigsH\x00R\x04repo\x128\n" +
 	"\x02pr\x18\x02 \x01(\v2&.minder.v1.DepsType.PullRequestConfigsH\x00R\x02pr\x1aD\n" +
@@ -15550,7 +19594,13 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x06branch\x18\x01 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x15\x18\xc8\x012\x10^[[:word:]./-]+$R\x06branch\x1aQ\n" +
 	"\x12PullRequestConfigs\x12;\n" +
 	"\x06filter\x18\x01 \x01(\tB#\xbaH \xd8\x01\x01r\x1bR\x0fNEW_AND_UPDATEDR\bNEW_ONLYR\x06filterB\r\n" +
-	"\ventity_type\"\x88\x02\n" +
+	"\ventity_type\"\xe8\x01\n" +
+	"\bSBOMType\x12?\n" +
+	"\x06source\x18\x01 \x01(\tB'\xbaH$\xd8\x01\x01r\x1fR\x04scanR\n" +
+	"repositoryR\vattestationR\x06source\x121\n" +
+	"\x06format\x18\x02 \x01(\tB\x19\xbaH\x16\xd8\x01\x01r\x11R\tcyclonedxR\x04spdxR\x06format\x121\n" +
+	"\x04path\x18\x03 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x15\x18\xc8\x012\x10^[[:word:]./-]+$R\x04path\x125\n" +
+	"\x06branch\x18\x04 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x15\x18\xc8\x012\x10^[[:word:]./-]+$R\x06branch\"\x88\x02\n" +
 	"\bSeverity\x12/\n" +
 	"\x05value\x18\x01 \x01(\x0e2\x19.minder.v1.Severity.ValueR\x05value\"\xca\x01\n" +
 	"\x05Value\x12\x15\n" +
@@ -15563,7 +19613,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\xea\xdc\x14\x06medium\x12\x18\n" +
 	"\n" +
 	"VALUE_HIGH\x10\x05\x1a\b\xea\xdc\x14\x04high\x12 \n" +
-	"\x0eVALUE_CRITICAL\x10\x06\x1a\f\xea\xdc\x14\bcritical\"\xed%\n" +
+	"\x0eVALUE_CRITICAL\x10\x06\x1a\f\xea\xdc\x14\bcritical\"\xc6,\n" +
 	"\bRuleType\x12&\n" +
 	"\aversion\x18\v \x01(\tB\f\xbaH\tr\a2\x05^v\\d$R\aversion\x12$\n" +
 	"\x04type\x18\f \x01(\tB\x10\xbaH\rr\v2\trule-typeR\x04type\x12 \n" +
@@ -15577,7 +19627,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xdc\vR\vdescription\x12)\n" +
 	"\bguidance\x18\x06 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xe8\aR\bguidance\x12/\n" +
 	"\bseverity\x18\a \x01(\v2\x13.minder.v1.SeverityR\bseverity\x12D\n" +
-	"\rrelease_phase\x18\t \x01(\x0e2\x1f.minder.v1.RuleTypeReleasePhaseR\freleasePhase\x1a\xe8 \n" +
+	"\rrelease_phase\x18\t \x01(\x0e2\x1f.minder.v1.RuleTypeReleasePhaseR\freleasePhase\x1a\xc1'\n" +
 	"\n" +
 	"Definition\x12;\n" +
 	"\tin_entity\x18\x01 \x01(\tB\x1e\xbaH\x1br\x19\x10\x01\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\binEntity\x128\n" +
@@ -15587,32 +19637,35 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x06ingest\x18\x04 \x01(\v2%.minder.v1.RuleType.Definition.IngestB\x03\xe0A\x02R\x06ingest\x12<\n" +
 	"\x04eval\x18\x05 \x01(\v2#.minder.v1.RuleType.Definition.EvalB\x03\xe0A\x02R\x04eval\x12F\n" +
 	"\tremediate\x18\x06 \x01(\v2(.minder.v1.RuleType.Definition.RemediateR\tremediate\x12:\n" +
-	"\x05alert\x18\a \x01(\v2$.minder.v1.RuleType.Definition.AlertR\x05alert\x1a\xb2\x03\n" +
-	"\x06Ingest\x12F\n" +
-	"\x04type\x18\x01 \x01(\tB2\xe0A\x02\xbaH,r*R\x04restR\bartifactR\abuiltinR\x03gitR\x04diffR\x04depsR\x04type\x12,\n" +
+	"\x05alert\x18\a \x01(\v2$.minder.v1.RuleType.Definition.AlertR\x05alert\x1a\xef\x03\n" +
+	"\x06Ingest\x12L\n" +
+	"\x04type\x18\x01 \x01(\tB8\xe0A\x02\xbaH2r0R\x04restR\bartifactR\abuiltinR\x03gitR\x04diffR\x04depsR\x04sbomR\x04type\x12,\n" +
 	"\x04rest\x18\x03 \x01(\v2\x13.minder.v1.RestTypeH\x00R\x04rest\x88\x01\x01\x125\n" +
 	"\abuiltin\x18\x04 \x01(\v2\x16.minder.v1.BuiltinTypeH\x01R\abuiltin\x88\x01\x01\x128\n" +
 	"\bartifact\x18\x05 \x01(\v2\x17.minder.v1.ArtifactTypeH\x02R\bartifact\x88\x01\x01\x12)\n" +
 	"\x03git\x18\x06 \x01(\v2\x12.minder.v1.GitTypeH\x03R\x03git\x88\x01\x01\x12,\n" +
 	"\x04diff\x18\a \x01(\v2\x13.minder.v1.DiffTypeH\x04R\x04diff\x88\x01\x01\x12,\n" +
-	"\x04deps\x18\b \x01(\v2\x13.minder.v1.DepsTypeH\x05R\x04deps\x88\x01\x01B\a\n" +
+	"\x04deps\x18\b \x01(\v2\x13.minder.v1.DepsTypeH\x05R\x04deps\x88\x01\x01\x12,\n" +
+	"\x04sbom\x18\t \x01(\v2\x13.minder.v1.SBOMTypeH\x06R\x04sbom\x88\x01\x01B\a\n" +
 	"\x05_restB\n" +
 	"\n" +
 	"\b_builtinB\v\n" +
 	"\t_artifactB\x06\n" +
 	"\x04_gitB\a\n" +
 	"\x05_diffB\a\n" +
-	"\x05_deps\x1a\xd0\t\n" +
-	"\x04Eval\x12E\n" +
-	"\x04type\x18\x01 \x01(\tB1\xe0A\x02\xbaH+r)R\x02jqR\x04regoR\tvulncheckR\x06trustyR\n" +
-	"homoglyphsR\x04type\x12@\n" +
+	"\x05_depsB\a\n" +
+	"\x05_sbom\x1a\x86\v\n" +
+	"\x04Eval\x12S\n" +
+	"\x04type\x18\x01 \x01(\tB?\xe0A\x02\xbaH9r7R\x02jqR\x04regoR\tvulncheckR\x06trustyR\n" +
+	"homoglyphsR\x03celR\alicenseR\x04type\x12@\n" +
 	"\x02jq\x18\x02 \x03(\v20.minder.v1.RuleType.Definition.Eval.JQComparisonR\x02jq\x12A\n" +
 	"\x04rego\x18\x03 \x01(\v2(.minder.v1.RuleType.Definition.Eval.RegoH\x00R\x04rego\x88\x01\x01\x12P\n" +
 	"\tvulncheck\x18\x04 \x01(\v2-.minder.v1.RuleType.Definition.Eval.VulncheckH\x01R\tvulncheck\x88\x01\x01\x12G\n" +
 	"\x06trusty\x18\x05 \x01(\v2*.minder.v1.RuleType.Definition.Eval.TrustyH\x02R\x06trusty\x88\x01\x01\x12S\n" +
 	"\n" +
 	"homoglyphs\x18\x06 \x01(\v2..minder.v1.RuleType.Definition.Eval.HomoglyphsH\x03R\n" +
-	"homoglyphs\x88\x01\x01\x12A\n" +
+	"homoglyphs\x88\x01\x01\x12>\n" +
+	"\x03cel\x18\b \x01(\v2'.minder.v1.RuleType.Definition.Eval.CELH\x04R\x03cel\x88\x01\x01\x12A\n" +
 	"\fdata_sources\x18\a \x03(\v2\x1e.minder.v1.DataSourceReferenceR\vdataSources\x1a\xd7\x02\n" +
 	"\fJQComparison\x12Z\n" +
 	"\bingested\x18\x01 \x01(\v29.minder.v1.RuleType.Definition.Eval.JQComparison.OperatorB\x03\xe0A\x02R\bingested\x12S\n" +
@@ -15630,12 +19683,17 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\bendpoint\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x88\x01\x01R\bendpoint\x1aL\n" +
 	"\n" +
 	"Homoglyphs\x12>\n" +
-	"\x04type\x18\x01 \x01(\tB*\xbaH'r%R\x14invisible_charactersR\rmixed_scriptsR\x04typeB\a\n" +
+	"\x04type\x18\x01 \x01(\tB*\xbaH'r%R\x14invisible_charactersR\rmixed_scriptsR\x04type\x1a^\n" +
+	"\x03CEL\x12\x15\n" +
+	"\x03def\x18\x01 \x01(\tB\x03\xe0A\x02R\x03def\x12,\n" +
+	"\x0ffailure_message\x18\x02 \x01(\tH\x00R\x0efailureMessage\x88\x01\x01B\x12\n" +
+	"\x10_failure_messageB\a\n" +
 	"\x05_regoB\f\n" +
 	"\n" +
 	"_vulncheckB\t\n" +
 	"\a_trustyB\r\n" +
-	"\v_homoglyphs\x1a\xb8\v\n" +
+	"\v_homoglyphsB\x06\n" +
+	"\x04_cel\x1a\xb8\v\n" +
 	"\tRemediate\x12\\\n" +
 	"\x04type\x18\x01 \x01(\tBH\xbaHE\xd8\x01\x01r@R\x04restR\x14gh_branch_protectionR\fpull_requestR\x14pull_request_commentR\x04type\x12,\n" +
 	"\x04rest\x18\x02 \x01(\v2\x13.minder.v1.RestTypeH\x00R\x04rest\x88\x01\x01\x12v\n" +
@@ -15664,21 +19722,44 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x05_restB\x17\n" +
 	"\x15_gh_branch_protectionB\x0f\n" +
 	"\r_pull_requestB\x17\n" +
-	"\x15_pull_request_comment\x1a\xc7\x04\n" +
-	"\x05Alert\x12E\n" +
-	"\x04type\x18\x01 \x01(\tB1\xbaH.\xd8\x01\x01r)R\x11security_advisoryR\x14pull_request_commentR\x04type\x12b\n" +
+	"\x15_pull_request_comment\x1a\xad\t\n" +
+	"\x05Alert\x12U\n" +
+	"\x04type\x18\x01 \x01(\tBA\xbaH>\xd8\x01\x01r9R\x11security_advisoryR\x14pull_request_commentR\awebhookR\x05issueR\x04type\x12b\n" +
 	"\x11security_advisory\x18\x02 \x01(\v20.minder.v1.RuleType.Definition.Alert.AlertTypeSAH\x00R\x10securityAdvisory\x88\x01\x01\x12n\n" +
-	"\x14pull_request_comment\x18\x03 \x01(\v27.minder.v1.RuleType.Definition.Alert.AlertTypePRCommentH\x01R\x12pullRequestComment\x88\x01\x01\x1a_\n" +
+	"\x14pull_request_comment\x18\x03 \x01(\v27.minder.v1.RuleType.Definition.Alert.AlertTypePRCommentH\x01R\x12pullRequestComment\x88\x01\x01\x12T\n" +
+	"\awebhook\x18\x04 \x01(\v25.minder.v1.RuleType.Definition.Alert.AlertTypeWebhookH\x02R\awebhook\x88\x01\x01\x12N\n" +
+	"\x05issue\x18\x05 \x01(\v23.minder.v1.RuleType.Definition.Alert.AlertTypeIssueH\x03R\x05issue\x88\x01\x01\x1a_\n" +
 	"\vAlertTypeSA\x12P\n" +
 	"\bseverity\x18\x01 \x01(\tB4\xbaH1\xd8\x01\x01r,R\aunknownR\x04infoR\x03lowR\x06mediumR\x04highR\bcriticalR\bseverity\x1a\x92\x01\n" +
 	"\x12AlertTypePRComment\x123\n" +
 	"\x0ereview_message\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x18\x80\x80\x04R\rreviewMessage\x12<\n" +
 	"\x06action\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1aR\acommentR\x0frequest_changesH\x00R\x06action\x88\x01\x01B\t\n" +
-	"\a_actionB\x14\n" +
+	"\a_action\x1a\xc8\x01\n" +
+	"\x10AlertTypeWebhook\x12<\n" +
+	"\awebhook\x18\x01 \x01(\tB\"\xe0A\x02\xbaH\x1cr\x1a\x18?2\x16^[A-Za-z][-[:word:]]*$R\awebhook\x126\n" +
+	"\x06format\x18\x02 \x01(\tB\x19\xbaH\x16r\x14R\x05slackR\x05teamsR\x04jsonH\x00R\x06format\x88\x01\x01\x12'\n" +
+	"\amessage\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x01R\amessage\x88\x01\x01B\t\n" +
+	"\a_formatB\n" +
+	"\n" +
+	"\b_message\x1a\xcc\x01\n" +
+	"\x0eAlertTypeIssue\x12#\n" +
+	"\x05title\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02H\x00R\x05title\x88\x01\x01\x12\"\n" +
+	"\x04body\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x18\x80\x80\x04H\x01R\x04body\x88\x01\x01\x12(\n" +
+	"\x06labels\x18\x03 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\n" +
+	"\"\x06r\x04\x10\x01\x182R\x06labels\x12(\n" +
+	"\acomment\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x18\x80\x80\x04H\x02R\acomment\x88\x01\x01B\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_bodyB\n" +
+	"\n" +
+	"\b_commentB\x14\n" +
 	"\x12_security_advisoryB\x17\n" +
-	"\x15_pull_request_commentB\x0f\n" +
+	"\x15_pull_request_commentB\n" +
+	"\n" +
+	"\b_webhookB\b\n" +
+	"\x06_issueB\x0f\n" +
 	"\r_param_schemaB\x05\n" +
-	"\x03_id\"\x82\f\n" +
+	"\x03_id\"\xaf\x0f\n" +
 	"\aProfile\x12,\n" +
 	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12 \n" +
 	"\x02id\x18\x02 \x01(\tB\v\xe0A\x03\xbaH\x05r\x03\xb0\x01\x01H\x00R\x02id\x88\x01\x01\x128\n" +
@@ -15700,7 +19781,8 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x04type\x18\n" +
 	" \x01(\tB\x0e\xbaH\vr\t2\aprofileR\x04type\x12&\n" +
 	"\aversion\x18\v \x01(\tB\f\xbaH\tr\a2\x05^v\\d$R\aversion\x12L\n" +
-	"\fdisplay_name\x18\r \x01(\tB)\xbaH&\xd8\x01\x01r!\x18\xe8\a2\x1c^[A-Za-z][-/'()[:word:] :]*$R\vdisplayName\x1a\xdb\x01\n" +
+	"\fdisplay_name\x18\r \x01(\tB)\xbaH&\xd8\x01\x01r!\x18\xe8\a2\x1c^[A-Za-z][-/'()[:word:] :]*$R\vdisplayName\x12<\n" +
+	"\bschedule\x18\x13 \x01(\v2\x1b.minder.v1.Profile.ScheduleH\x03R\bschedule\x88\x01\x01\x1a\xdb\x01\n" +
 	"\x04Rule\x128\n" +
 	"\x04type\x18\x01 \x01(\tB$\xbaH!\xd8\x01\x01r\x1c\x18\xc8\x012\x17^[A-Za-z][-/[:word:]]*$R\x04type\x12/\n" +
 	"\x06params\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x06params\x12)\n" +
@@ -15710,11 +19792,20 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\x06entity\x18\x02 \x01(\tB!\xbaH\x1e\xd8\x01\x01r\x19\x10\x01\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\x06entity\x12'\n" +
 	"\bselector\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xc8\x01R\bselector\x12N\n" +
-	"\vdescription\x18\x06 \x01(\tB,\xbaH)\xd8\x01\x01r$\x18\xe8\a2\x1f^[A-Za-z][-/.!?,:;'[:word:] ]*$R\vdescriptionJ\x04\b\x05\x10\x06R\acommentB\x05\n" +
+	"\vdescription\x18\x06 \x01(\tB,\xbaH)\xd8\x01\x01r$\x18\xe8\a2\x1f^[A-Za-z][-/.!?,:;'[:word:] ]*$R\vdescriptionJ\x04\b\x05\x10\x06R\acomment\x1a\xe9\x01\n" +
+	"\x0eBlackoutWindow\x12<\n" +
+	"\x05start\x18\x01 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x05start\x128\n" +
+	"\x03end\x18\x02 \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x03end\x12C\n" +
+	"\x04days\x18\x03 \x03(\tB/\xbaH,\x92\x01)\x18\x01\"%r#R\x03monR\x03tueR\x03wedR\x03thuR\x03friR\x03satR\x03sunR\x04days\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x1at\n" +
+	"\bSchedule\x12\x1a\n" +
+	"\binterval\x18\x01 \x01(\tR\binterval\x12L\n" +
+	"\x10blackout_windows\x18\x02 \x03(\v2!.minder.v1.Profile.BlackoutWindowR\x0fblackoutWindowsB\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_remediateB\b\n" +
-	"\x06_alert\"\x15\n" +
+	"\x06_alertB\v\n" +
+	"\t_schedule\"\x15\n" +
 	"\x13ListProjectsRequest\"K\n" +
 	"\x14ListProjectsResponse\x123\n" +
 	"\bprojects\x18\x01 \x03(\v2\x12.minder.v1.ProjectB\x03\xe0A\x02R\bprojects\"~\n" +
@@ -15750,7 +19841,102 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x12\x1c\n" +
 	"\trecursive\x18\x02 \x01(\bR\trecursive\"P\n" +
 	"\x19ListChildProjectsResponse\x123\n" +
-	"\bprojects\x18\x01 \x03(\v2\x12.minder.v1.ProjectB\x03\xe0A\x02R\bprojects\"\x8c\x01\n" +
+	"\bprojects\x18\x01 \x03(\v2\x12.minder.v1.ProjectB\x03\xe0A\x02R\bprojects\"\xc9\x01\n" +
+	"\fAlertWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\acontext\x18\x03 \x01(\v2\x12.minder.v1.ContextR\acontext\x12,\n" +
+	"\x12has_signing_secret\x18\x04 \x01(\bR\x10hasSigningSecret\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd4\x01\n" +
+	"\x19CreateAlertWebhookRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x126\n" +
+	"\x04name\x18\x02 \x01(\tB\"\xe0A\x02\xbaH\x1cr\x1a\x18?2\x16^[A-Za-z][-[:word:]]*$R\x04name\x12 \n" +
+	"\x03url\x18\x03 \x01(\tB\x0e\xe0A\x02\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x12/\n" +
+	"\x0esigning_secret\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\rsigningSecret\"Z\n" +
+	"\x1aCreateAlertWebhookResponse\x12<\n" +
+	"\ralert_webhook\x18\x01 \x01(\v2\x17.minder.v1.AlertWebhookR\falertWebhook\"H\n" +
+	"\x18ListAlertWebhooksRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\"[\n" +
+	"\x19ListAlertWebhooksResponse\x12>\n" +
+	"\x0ealert_webhooks\x18\x01 \x03(\v2\x17.minder.v1.AlertWebhookR\ralertWebhooks\"b\n" +
+	"\x19DeleteAlertWebhookRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\"0\n" +
+	"\x1aDeleteAlertWebhookResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xbb\x01\n" +
+	"\x11ProjectSyncSource\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12.\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tB\x0e\xe0A\x02\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\n" +
+	"repository\x12 \n" +
+	"\x06branch\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x06branch\x12\x1c\n" +
+	"\x04path\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x04path\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\tR\binterval\"R\n" +
+	"\x10ProjectSyncDrift\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\"\xc2\x02\n" +
+	"\x11ProjectSyncStatus\x124\n" +
+	"\x06source\x18\x01 \x01(\v2\x1c.minder.v1.ProjectSyncSourceR\x06source\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x16\n" +
+	"\x06commit\x18\x04 \x01(\tR\x06commit\x12<\n" +
+	"\flast_sync_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSyncAt\x12<\n" +
+	"\fnext_sync_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nextSyncAt\x121\n" +
+	"\x05drift\x18\a \x03(\v2\x1b.minder.v1.ProjectSyncDriftR\x05drift\"\x86\x01\n" +
+	"\x1bSetProjectSyncSourceRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x129\n" +
+	"\x06source\x18\x02 \x01(\v2\x1c.minder.v1.ProjectSyncSourceB\x03\xe0A\x02R\x06source\"T\n" +
+	"\x1cSetProjectSyncSourceResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.minder.v1.ProjectSyncStatusR\x06status\"K\n" +
+	"\x1bGetProjectSyncStatusRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\"T\n" +
+	"\x1cGetProjectSyncStatusResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.minder.v1.ProjectSyncStatusR\x06status\"N\n" +
+	"\x1eDeleteProjectSyncSourceRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\"!\n" +
+	"\x1fDeleteProjectSyncSourceResponse\"\xbb\x01\n" +
+	"\x11MarketplaceBundle\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bversions\x18\x03 \x03(\tR\bversions\x12-\n" +
+	"\x12subscribed_version\x18\x04 \x01(\tR\x11subscribedVersion\x12)\n" +
+	"\x10previous_version\x18\x05 \x01(\tR\x0fpreviousVersion\"B\n" +
+	"\x12ListBundlesRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\"M\n" +
+	"\x13ListBundlesResponse\x126\n" +
+	"\abundles\x18\x01 \x03(\v2\x1c.minder.v1.MarketplaceBundleR\abundles\"\xb8\x01\n" +
+	"\x16SubscribeBundleRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12*\n" +
+	"\tnamespace\x18\x02 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18?R\tnamespace\x12 \n" +
+	"\x04name\x18\x03 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18?R\x04name\x12\"\n" +
+	"\aversion\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aversion\"O\n" +
+	"\x17SubscribeBundleResponse\x124\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x1c.minder.v1.MarketplaceBundleR\x06bundle\"r\n" +
+	"\x14BundleResourceChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1a\n" +
+	"\bbreaking\x18\x04 \x01(\tR\bbreaking\"\xc3\x01\n" +
+	"!PreviewSubscriptionUpgradeRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12*\n" +
+	"\tnamespace\x18\x02 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18?R\tnamespace\x12 \n" +
+	"\x04name\x18\x03 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18?R\x04name\x12\"\n" +
+	"\aversion\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aversion\"\xa1\x01\n" +
+	"\"PreviewSubscriptionUpgradeResponse\x12!\n" +
+	"\ffrom_version\x18\x01 \x01(\tR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\tR\ttoVersion\x129\n" +
+	"\achanges\x18\x03 \x03(\v2\x1f.minder.v1.BundleResourceChangeR\achanges\"\x9f\x01\n" +
+	"!RollbackBundleSubscriptionRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12*\n" +
+	"\tnamespace\x18\x02 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18?R\tnamespace\x12 \n" +
+	"\x04name\x18\x03 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x10\x01\x18?R\x04name\"Z\n" +
+	"\"RollbackBundleSubscriptionResponse\x124\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x1c.minder.v1.MarketplaceBundleR\x06bundle\"\x8c\x01\n" +
 	"%CreateEntityReconciliationTaskRequest\x125\n" +
 	"\x06entity\x18\x01 \x01(\v2\x18.minder.v1.EntityTypedIdB\x03\xe0A\x02R\x06entity\x12,\n" +
 	"\acontext\x18\x02 \x01(\v2\x12.minder.v1.ContextR\acontext\"(\n" +
@@ -15938,7 +20124,20 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"evaluation\"\x81\x01\n" +
 	"\x1dListEvaluationHistoryResponse\x125\n" +
 	"\x04data\x18\x01 \x03(\v2\x1c.minder.v1.EvaluationHistoryB\x03\xe0A\x02R\x04data\x12)\n" +
-	"\x04page\x18\x02 \x01(\v2\x15.minder.v1.CursorPageR\x04page\"\xad\x03\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.minder.v1.CursorPageR\x04page\"\xfb\x02\n" +
+	"\x1dWatchEvaluationResultsRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12I\n" +
+	"\fprofile_name\x18\x02 \x03(\tB&\xbaH#\x92\x01 \"\x1er\x1c\x18\xc8\x012\x17^[A-Za-z][-/[:word:]]*$R\vprofileName\x12>\n" +
+	"\ventity_type\x18\x03 \x03(\tB\x1d\xbaH\x1a\x92\x01\x17\"\x15r\x13\x18\xc8\x012\x0e^[,[:word:]]*$R\n" +
+	"entityType\x12H\n" +
+	"\flabel_filter\x18\x04 \x03(\tB%\xbaH\"\x92\x01\x1f\"\x1dr\x1b\x18\xc8\x012\x16^(\\*|[a-z][a-z0-9_]*)$R\vlabelFilter\x125\n" +
+	"\x06status\x18\x05 \x03(\tB\x1d\xbaH\x1a\x92\x01\x17\"\x15r\x13\x18\xc8\x012\x0e^[,[:word:]]*$R\x06status\x12 \n" +
+	"\x06cursor\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\x06cursor\"\x80\x01\n" +
+	"\x1eWatchEvaluationResultsResponse\x12A\n" +
+	"\n" +
+	"evaluation\x18\x01 \x01(\v2\x1c.minder.v1.EvaluationHistoryB\x03\xe0A\x02R\n" +
+	"evaluation\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tB\x03\xe0A\x02R\x06cursor\"\xad\x03\n" +
 	"\x11EvaluationHistory\x12?\n" +
 	"\x06entity\x18\x01 \x01(\v2\".minder.v1.EvaluationHistoryEntityB\x03\xe0A\x02R\x06entity\x129\n" +
 	"\x04rule\x18\x02 \x01(\v2 .minder.v1.EvaluationHistoryRuleB\x03\xe0A\x02R\x04rule\x12?\n" +
@@ -16014,7 +20213,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x04type\x18\x02 \x01(\x0e2\x11.minder.v1.EntityR\x04type\x127\n" +
 	"\n" +
 	"properties\x18\x03 \x01(\v2\x17.google.protobuf.StructR\n" +
-	"properties\"\xe2\x02\n" +
+	"properties\"\xca\x03\n" +
 	"\n" +
 	"DataSource\x12)\n" +
 	"\aversion\x18\x01 \x01(\tB\x0f\xe0A\x02\xbaH\tr\a2\x05^v\\d$R\aversion\x12(\n" +
@@ -16025,7 +20224,10 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\n" +
 	"structured\x18\b \x01(\v2\x1b.minder.v1.StructDataSourceH\x00R\n" +
 	"structured\x12/\n" +
-	"\x04rest\x18\x06 \x01(\v2\x19.minder.v1.RestDataSourceH\x00R\x04restB\b\n" +
+	"\x04rest\x18\x06 \x01(\v2\x19.minder.v1.RestDataSourceH\x00R\x04rest\x128\n" +
+	"\agraphql\x18\t \x01(\v2\x1c.minder.v1.GraphQLDataSourceH\x00R\agraphql\x12,\n" +
+	"\x03sql\x18\n" +
+	" \x01(\v2\x18.minder.v1.SQLDataSourceH\x00R\x03sqlB\b\n" +
 	"\x06driver\"\xb3\x02\n" +
 	"\x10StructDataSource\x126\n" +
 	"\x03def\x18\x01 \x03(\v2$.minder.v1.StructDataSource.DefEntryR\x03def\x1a\x8d\x01\n" +
@@ -16036,10 +20238,12 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\falternatives\x18\x02 \x03(\tR\falternatives\x1aW\n" +
 	"\bDefEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
-	"\x05value\x18\x02 \x01(\v2\x1f.minder.v1.StructDataSource.DefR\x05value:\x028\x01\"\x9b\a\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.minder.v1.StructDataSource.DefR\x05value:\x028\x01\"\xeb\t\n" +
 	"\x0eRestDataSource\x124\n" +
 	"\x03def\x18\x01 \x03(\v2\".minder.v1.RestDataSource.DefEntryR\x03def\x12#\n" +
-	"\rprovider_auth\x18\x02 \x01(\bR\fproviderAuth\x1a\xd6\x05\n" +
+	"\rprovider_auth\x18\x02 \x01(\bR\fproviderAuth\x12B\n" +
+	"\n" +
+	"rate_limit\x18\x03 \x01(\v2#.minder.v1.RestDataSource.RateLimitR\trateLimit\x1a\xe7\x06\n" +
 	"\x03Def\x126\n" +
 	"\bendpoint\x18\x01 \x01(\tB\x1a\xe0A\x02\xbaH\x14r\x12\x18\xa0\x062\r^https?://.*$R\bendpoint\x12?\n" +
 	"\x06method\x18\x02 \x01(\tB'\xbaH$\xd8\x01\x01r\x1fR\x03GETR\x04POSTR\x03PUTR\x05PATCHR\x06DELETER\x06method\x12D\n" +
@@ -16051,7 +20255,8 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x05parse\x18\x06 \x01(\tB\x0e\xbaH\v\xd8\x01\x01r\x06R\x04jsonR\x05parse\x12B\n" +
 	"\bfallback\x18\a \x03(\v2&.minder.v1.RestDataSource.Def.FallbackR\bfallback\x12;\n" +
 	"\x0fexpected_status\x18\b \x03(\x05B\x12\xbaH\x0f\xd8\x01\x01\x92\x01\t\"\a\x1a\x05\x18\xd7\x04(dR\x0eexpectedStatus\x12:\n" +
-	"\finput_schema\x18\t \x01(\v2\x17.google.protobuf.StructR\vinputSchema\x1a:\n" +
+	"\finput_schema\x18\t \x01(\v2\x17.google.protobuf.StructR\vinputSchema\x129\n" +
+	"\x05cache\x18\v \x01(\v2#.minder.v1.RestDataSource.Def.CacheR\x05cache\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a[\n" +
@@ -16059,11 +20264,54 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\vhttp_status\x18\x01 \x01(\x05B\r\xbaH\n" +
 	"\xd8\x01\x01\x1a\x05\x18\xd7\x04(dR\n" +
 	"httpStatus\x12\x1f\n" +
-	"\x04body\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xe8\aR\x04bodyB\x06\n" +
+	"\x04body\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xe8\aR\x04body\x1aT\n" +
+	"\x05Cache\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12/\n" +
+	"\vttl_seconds\x18\x02 \x01(\x05B\x0e\xbaH\v\xd8\x01\x01\x1a\x06\x18\x80\xa3\x05(\x01R\n" +
+	"ttlSecondsB\x06\n" +
 	"\x04body\x1aU\n" +
 	"\bDefEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
-	"\x05value\x18\x02 \x01(\v2\x1d.minder.v1.RestDataSource.DefR\x05value:\x028\x01\"\x83\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.minder.v1.RestDataSource.DefR\x05value:\x028\x01\x1ay\n" +
+	"\tRateLimit\x12G\n" +
+	"\x13requests_per_second\x18\x01 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x88\xc3@!\x00\x00\x00\x00\x00\x00\x00\x00R\x11requestsPerSecond\x12#\n" +
+	"\x05burst\x18\x02 \x01(\x05B\r\xbaH\n" +
+	"\xd8\x01\x01\x1a\x05\x18\x90N(\x01R\x05burst\"\xe8\x05\n" +
+	"\x11GraphQLDataSource\x127\n" +
+	"\x03def\x18\x01 \x03(\v2%.minder.v1.GraphQLDataSource.DefEntryR\x03def\x12#\n" +
+	"\rprovider_auth\x18\x02 \x01(\bR\fproviderAuth\x1a\x9a\x04\n" +
+	"\x03Def\x126\n" +
+	"\bendpoint\x18\x01 \x01(\tB\x1a\xe0A\x02\xbaH\x14r\x12\x18\xa0\x062\r^https?://.*$R\bendpoint\x12#\n" +
+	"\x05query\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x90NR\x05query\x12G\n" +
+	"\aheaders\x18\x03 \x03(\v2-.minder.v1.GraphQLDataSource.Def.HeadersEntryR\aheaders\x12:\n" +
+	"\finput_schema\x18\x04 \x01(\v2\x17.google.protobuf.StructR\vinputSchema\x12K\n" +
+	"\n" +
+	"pagination\x18\x05 \x01(\v2+.minder.v1.GraphQLDataSource.Def.PaginationR\n" +
+	"pagination\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xa7\x01\n" +
+	"\n" +
+	"Pagination\x12!\n" +
+	"\x04path\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x04path\x12K\n" +
+	"\x0fcursor_variable\x18\x02 \x01(\tB\"\xbaH\x1f\xd8\x01\x01r\x1a2\x18^[_A-Za-z][_0-9A-Za-z]*$R\x0ecursorVariable\x12)\n" +
+	"\tmax_pages\x18\x03 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18d(\x01R\bmaxPages\x1aX\n" +
+	"\bDefEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .minder.v1.GraphQLDataSource.DefR\x05value:\x028\x01\"\xc9\x03\n" +
+	"\rSQLDataSource\x123\n" +
+	"\x03def\x18\x01 \x03(\v2!.minder.v1.SQLDataSource.DefEntryR\x03def\x128\n" +
+	"\x11connection_string\x18\x02 \x01(\tB\v\xe0A\x04\xbaH\x05r\x03\x18\xd0\x0fR\x10connectionString\x1a\xf2\x01\n" +
+	"\x03Def\x12#\n" +
+	"\x05query\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x90NR\x05query\x12)\n" +
+	"\x06params\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\"\ar\x05\x10\x01\x18\xc8\x01R\x06params\x12:\n" +
+	"\finput_schema\x18\x03 \x01(\v2\x17.google.protobuf.StructR\vinputSchema\x12(\n" +
+	"\bmax_rows\x18\x04 \x01(\x05B\r\xbaH\n" +
+	"\xd8\x01\x01\x1a\x05\x18\x90N(\x01R\amaxRows\x125\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18<(\x01R\x0etimeoutSeconds\x1aT\n" +
+	"\bDefEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.minder.v1.SQLDataSource.DefR\x05value:\x028\x01\"\x83\x01\n" +
 	"\x13DataSourceReference\x123\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xbaH\x1cr\x1a\x18\xc8\x012\x15^[a-z][-_/[:word:]]*$R\x04name\x127\n" +
 	"\x05alias\x18\x02 \x01(\tB!\xbaH\x1e\xd8\x01\x01r\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x05alias*b\n" +
@@ -16138,7 +20386,12 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x0eENTITY_RELEASE\x10\x05\x12\x17\n" +
 	"\x13ENTITY_PIPELINE_RUN\x10\x06\x12\x13\n" +
 	"\x0fENTITY_TASK_RUN\x10\a\x12\x10\n" +
-	"\fENTITY_BUILD\x10\b*\xf9\x01\n" +
+	"\fENTITY_BUILD\x10\b*\xac\x01\n" +
+	"\x16ComplianceReportFormat\x12(\n" +
+	"$COMPLIANCE_REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCOMPLIANCE_REPORT_FORMAT_SARIF\x10\x01\x12 \n" +
+	"\x1cCOMPLIANCE_REPORT_FORMAT_CSV\x10\x02\x12\"\n" +
+	"\x1eCOMPLIANCE_REPORT_FORMAT_OSCAL\x10\x03*\xf9\x01\n" +
 	"\x14RuleTypeReleasePhase\x12'\n" +
 	"#RULE_TYPE_RELEASE_PHASE_UNSPECIFIED\x10\x00\x12,\n" +
 	"\x1dRULE_TYPE_RELEASE_PHASE_ALPHA\x10\x01\x1a\t\xea\xdc\x14\x05alpha\x12*\n" +
@@ -16205,11 +20458,11 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"DeleteUser\x12\x1c.minder.v1.DeleteUserRequest\x1a\x1d.minder.v1.DeleteUserResponse\"\x1a\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02\x0e*\f/api/v1/user\x12\\\n" +
 	"\aGetUser\x12\x19.minder.v1.GetUserRequest\x1a\x1a.minder.v1.GetUserResponse\"\x1a\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/user\x12\x80\x01\n" +
 	"\x0fListInvitations\x12!.minder.v1.ListInvitationsRequest\x1a\".minder.v1.ListInvitationsResponse\"&\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/user/invitations\x12\x8c\x01\n" +
-	"\x11ResolveInvitation\x12#.minder.v1.ResolveInvitationRequest\x1a$.minder.v1.ResolveInvitationResponse\",\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02 \"\x1e/api/v1/user/invitation/{code}2\xd5\n" +
-	"\n" +
+	"\x11ResolveInvitation\x12#.minder.v1.ResolveInvitationRequest\x1a$.minder.v1.ResolveInvitationResponse\",\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02 \"\x1e/api/v1/user/invitation/{code}2\x88\x11\n" +
 	"\x0eProfileService\x12v\n" +
 	"\rCreateProfile\x12\x1f.minder.v1.CreateProfileRequest\x1a .minder.v1.CreateProfileResponse\"\"\xaa\xf8\x18\x040\x038\x1e\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/profile\x12v\n" +
-	"\rUpdateProfile\x12\x1f.minder.v1.UpdateProfileRequest\x1a .minder.v1.UpdateProfileResponse\"\"\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/api/v1/profile\x12|\n" +
+	"\rUpdateProfile\x12\x1f.minder.v1.UpdateProfileRequest\x1a .minder.v1.UpdateProfileResponse\"\"\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/api/v1/profile\x12u\n" +
+	"\vPlanProfile\x12\x1d.minder.v1.PlanProfileRequest\x1a\x1e.minder.v1.PlanProfileResponse\"'\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/profile/plan\x12|\n" +
 	"\fPatchProfile\x12\x1e.minder.v1.PatchProfileRequest\x1a\x1f.minder.v1.PatchProfileResponse\"+\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x1d:\x05patch2\x14/api/v1/profile/{id}\x12x\n" +
 	"\rDeleteProfile\x12\x1f.minder.v1.DeleteProfileRequest\x1a .minder.v1.DeleteProfileResponse\"$\xaa\xf8\x18\x040\x038 \x82\xd3\xe4\x93\x02\x16*\x14/api/v1/profile/{id}\x12q\n" +
 	"\fListProfiles\x12\x1e.minder.v1.ListProfilesRequest\x1a\x1f.minder.v1.ListProfilesResponse\" \xaa\xf8\x18\x040\x038\x1d\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/profiles\x12{\n" +
@@ -16217,7 +20470,12 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x10GetProfileByName\x12\".minder.v1.GetProfileByNameRequest\x1a#.minder.v1.GetProfileByNameResponse\".\xaa\xf8\x18\x040\x038\x1d\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/profile/name/{name=**}\x12\xa4\x01\n" +
 	"\x16GetProfileStatusByName\x12(.minder.v1.GetProfileStatusByNameRequest\x1a).minder.v1.GetProfileStatusByNameResponse\"5\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02'\x12%/api/v1/profile/name/{name=**}/status\x12\x94\x01\n" +
 	"\x14GetProfileStatusById\x12&.minder.v1.GetProfileStatusByIdRequest\x1a'.minder.v1.GetProfileStatusByIdResponse\"+\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/profile/{id}/status\x12\x9e\x01\n" +
-	"\x19GetProfileStatusByProject\x12+.minder.v1.GetProfileStatusByProjectRequest\x1a,.minder.v1.GetProfileStatusByProjectResponse\"&\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/profile_status2\xfd\a\n" +
+	"\x19GetProfileStatusByProject\x12+.minder.v1.GetProfileStatusByProjectRequest\x1a,.minder.v1.GetProfileStatusByProjectResponse\"&\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/profile_status\x12\x8a\x01\n" +
+	"\x13CreateRuleExemption\x12%.minder.v1.CreateRuleExemptionRequest\x1a&.minder.v1.CreateRuleExemptionResponse\"$\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/exemption\x12\x83\x01\n" +
+	"\x10GetRuleExemption\x12\".minder.v1.GetRuleExemptionRequest\x1a#.minder.v1.GetRuleExemptionResponse\"&\xaa\xf8\x18\x040\x038\x1d\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/exemption/{id}\x12\x85\x01\n" +
+	"\x12ListRuleExemptions\x12$.minder.v1.ListRuleExemptionsRequest\x1a%.minder.v1.ListRuleExemptionsResponse\"\"\xaa\xf8\x18\x040\x038\x1d\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/exemptions\x12\x8f\x01\n" +
+	"\x13UpdateRuleExemption\x12%.minder.v1.UpdateRuleExemptionRequest\x1a&.minder.v1.UpdateRuleExemptionResponse\")\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/api/v1/exemption/{id}\x12\x8c\x01\n" +
+	"\x13DeleteRuleExemption\x12%.minder.v1.DeleteRuleExemptionRequest\x1a&.minder.v1.DeleteRuleExemptionResponse\"&\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/exemption/{id}2\xfd\a\n" +
 	"\x11DataSourceService\x12\x83\x01\n" +
 	"\x10CreateDataSource\x12\".minder.v1.CreateDataSourceRequest\x1a#.minder.v1.CreateDataSourceResponse\"&\xaa\xf8\x18\x040\x038'\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/data_source\x12\x88\x01\n" +
 	"\x11GetDataSourceById\x12#.minder.v1.GetDataSourceByIdRequest\x1a$.minder.v1.GetDataSourceByIdResponse\"(\xaa\xf8\x18\x040\x038&\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/data_source/{id}\x12\x98\x01\n" +
@@ -16232,11 +20490,13 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x0fGetRuleTypeById\x12!.minder.v1.GetRuleTypeByIdRequest\x1a\".minder.v1.GetRuleTypeByIdResponse\"&\xaa\xf8\x18\x040\x038\x19\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/rule_type/{id}\x12{\n" +
 	"\x0eCreateRuleType\x12 .minder.v1.CreateRuleTypeRequest\x1a!.minder.v1.CreateRuleTypeResponse\"$\xaa\xf8\x18\x040\x038\x1a\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/rule_type\x12{\n" +
 	"\x0eUpdateRuleType\x12 .minder.v1.UpdateRuleTypeRequest\x1a!.minder.v1.UpdateRuleTypeResponse\"$\xaa\xf8\x18\x040\x038\x1b\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/v1/rule_type\x12}\n" +
-	"\x0eDeleteRuleType\x12 .minder.v1.DeleteRuleTypeRequest\x1a!.minder.v1.DeleteRuleTypeResponse\"&\xaa\xf8\x18\x040\x038\x1c\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/rule_type/{id}2\xc0\x03\n" +
+	"\x0eDeleteRuleType\x12 .minder.v1.DeleteRuleTypeRequest\x1a!.minder.v1.DeleteRuleTypeResponse\"&\xaa\xf8\x18\x040\x038\x1c\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/rule_type/{id}2\xf7\x05\n" +
 	"\x12EvalResultsService\x12\x8b\x01\n" +
 	"\x15ListEvaluationResults\x12'.minder.v1.ListEvaluationResultsRequest\x1a(.minder.v1.ListEvaluationResultsResponse\"\x1f\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/results\x12\x8b\x01\n" +
 	"\x15ListEvaluationHistory\x12'.minder.v1.ListEvaluationHistoryRequest\x1a(.minder.v1.ListEvaluationHistoryResponse\"\x1f\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/history\x12\x8d\x01\n" +
-	"\x14GetEvaluationHistory\x12&.minder.v1.GetEvaluationHistoryRequest\x1a'.minder.v1.GetEvaluationHistoryResponse\"$\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/history/{id}2\x8a\x05\n" +
+	"\x14GetEvaluationHistory\x12&.minder.v1.GetEvaluationHistoryRequest\x1a'.minder.v1.GetEvaluationHistoryResponse\"$\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/history/{id}\x12\x9b\x01\n" +
+	"\x18GenerateComplianceReport\x12*.minder.v1.GenerateComplianceReportRequest\x1a+.minder.v1.GenerateComplianceReportResponse\"&\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/results/report\x12\x96\x01\n" +
+	"\x16WatchEvaluationResults\x12(.minder.v1.WatchEvaluationResultsRequest\x1a).minder.v1.WatchEvaluationResultsResponse\"%\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/history:watch0\x012\x8a\x05\n" +
 	"\x12PermissionsService\x12q\n" +
 	"\tListRoles\x12\x1b.minder.v1.ListRolesRequest\x1a\x1c.minder.v1.ListRolesResponse\")\xaa\xf8\x18\x040\x038\x05\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/permissions/roles\x12\x95\x01\n" +
 	"\x13ListRoleAssignments\x12%.minder.v1.ListRoleAssignmentsRequest\x1a&.minder.v1.ListRoleAssignmentsResponse\"/\xaa\xf8\x18\x040\x038\x06\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/permissions/assignments\x12x\n" +
@@ -16245,14 +20505,24 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\n" +
 	"UpdateRole\x12\x1c.minder.v1.UpdateRoleRequest\x1a\x1d.minder.v1.UpdateRoleResponse\"-\xaa\xf8\x18\x040\x038%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/permissions/update\x12u\n" +
 	"\n" +
-	"RemoveRole\x12\x1c.minder.v1.RemoveRoleRequest\x1a\x1d.minder.v1.RemoveRoleResponse\"*\xaa\xf8\x18\x040\x038\b\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/permissions/remove2\xc5\a\n" +
+	"RemoveRole\x12\x1c.minder.v1.RemoveRoleRequest\x1a\x1d.minder.v1.RemoveRoleResponse\"*\xaa\xf8\x18\x040\x038\b\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/permissions/remove2\xd1\x13\n" +
 	"\x0fProjectsService\x12q\n" +
 	"\fListProjects\x12\x1e.minder.v1.ListProjectsRequest\x1a\x1f.minder.v1.ListProjectsResponse\" \xaa\xf8\x18\x040\x028\x02\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/projects\x12w\n" +
 	"\rCreateProject\x12\x1f.minder.v1.CreateProjectRequest\x1a .minder.v1.CreateProjectResponse\"#\xaa\xf8\x18\x040\x028\x01\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/projects\x12\x9e\x01\n" +
 	"\x11ListChildProjects\x12#.minder.v1.ListChildProjectsRequest\x1a$.minder.v1.ListChildProjectsResponse\">\xaa\xf8\x18\x040\x038\x02\x82\xd3\xe4\x93\x020\x12./api/v1/projects/{context.project_id}/children\x12t\n" +
 	"\rDeleteProject\x12\x1f.minder.v1.DeleteProjectRequest\x1a .minder.v1.DeleteProjectResponse\" \xaa\xf8\x18\x040\x038\x04\x82\xd3\xe4\x93\x02\x12*\x10/api/v1/projects\x12w\n" +
 	"\rUpdateProject\x12\x1f.minder.v1.UpdateProjectRequest\x1a .minder.v1.UpdateProjectResponse\"#\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/api/v1/projects\x12x\n" +
-	"\fPatchProject\x12\x1e.minder.v1.PatchProjectRequest\x1a\x1f.minder.v1.PatchProjectResponse\"'\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02\x19:\x05patch2\x10/api/v1/projects\x12\xbb\x01\n" +
+	"\fPatchProject\x12\x1e.minder.v1.PatchProjectRequest\x1a\x1f.minder.v1.PatchProjectResponse\"'\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02\x19:\x05patch2\x10/api/v1/projects\x12\x95\x01\n" +
+	"\x12CreateAlertWebhook\x12$.minder.v1.CreateAlertWebhookRequest\x1a%.minder.v1.CreateAlertWebhookResponse\"2\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/projects/alert_webhooks\x12\x8f\x01\n" +
+	"\x11ListAlertWebhooks\x12#.minder.v1.ListAlertWebhooksRequest\x1a$.minder.v1.ListAlertWebhooksResponse\"/\xaa\xf8\x18\x040\x038\x02\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/projects/alert_webhooks\x12\x99\x01\n" +
+	"\x12DeleteAlertWebhook\x12$.minder.v1.DeleteAlertWebhookRequest\x1a%.minder.v1.DeleteAlertWebhookResponse\"6\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02(*&/api/v1/projects/alert_webhooks/{name}\x12\x91\x01\n" +
+	"\x14SetProjectSyncSource\x12&.minder.v1.SetProjectSyncSourceRequest\x1a'.minder.v1.SetProjectSyncSourceResponse\"(\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/v1/projects/sync\x12\x8e\x01\n" +
+	"\x14GetProjectSyncStatus\x12&.minder.v1.GetProjectSyncStatusRequest\x1a'.minder.v1.GetProjectSyncStatusResponse\"%\xaa\xf8\x18\x040\x038\x02\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/projects/sync\x12\x97\x01\n" +
+	"\x17DeleteProjectSyncSource\x12).minder.v1.DeleteProjectSyncSourceRequest\x1a*.minder.v1.DeleteProjectSyncSourceResponse\"%\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/projects/sync\x12v\n" +
+	"\vListBundles\x12\x1d.minder.v1.ListBundlesRequest\x1a\x1e.minder.v1.ListBundlesResponse\"(\xaa\xf8\x18\x040\x038\x02\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/projects/bundles\x12\x92\x01\n" +
+	"\x0fSubscribeBundle\x12!.minder.v1.SubscribeBundleRequest\x1a\".minder.v1.SubscribeBundleResponse\"8\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/projects/bundles/subscription\x12\xb8\x01\n" +
+	"\x1aPreviewSubscriptionUpgrade\x12,.minder.v1.PreviewSubscriptionUpgradeRequest\x1a-.minder.v1.PreviewSubscriptionUpgradeResponse\"=\xaa\xf8\x18\x040\x038\x02\x82\xd3\xe4\x93\x02/\x12-/api/v1/projects/bundles/subscription/preview\x12\xbc\x01\n" +
+	"\x1aRollbackBundleSubscription\x12,.minder.v1.RollbackBundleSubscriptionRequest\x1a-.minder.v1.RollbackBundleSubscriptionResponse\"A\xaa\xf8\x18\x040\x038\x03\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/projects/bundles/subscription/rollback\x12\xbb\x01\n" +
 	"\x1eCreateEntityReconciliationTask\x120.minder.v1.CreateEntityReconciliationTaskRequest\x1a1.minder.v1.CreateEntityReconciliationTaskResponse\"4\xaa\xf8\x18\x040\x038#\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/projects/entity/reconcile2\xc4\b\n" +
 	"\x10ProvidersService\x12|\n" +
 	"\rPatchProvider\x12\x1f.minder.v1.PatchProviderRequest\x1a .minder.v1.PatchProviderResponse\"(\xaa\xf8\x18\x040\x038\x17\x82\xd3\xe4\x93\x02\x1a:\x05patch2\x11/api/v1/providers\x12v\n" +
@@ -16287,709 +20557,878 @@ func file_minder_v1_minder_proto_rawDescGZIP() []byte {
 	return file_minder_v1_minder_proto_rawDescData
 }
 
-var file_minder_v1_minder_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_minder_v1_minder_proto_msgTypes = make([]protoimpl.MessageInfo, 243)
+var file_minder_v1_minder_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_minder_v1_minder_proto_msgTypes = make([]protoimpl.MessageInfo, 306)
 var file_minder_v1_minder_proto_goTypes = []any{
-	(ObjectOwner)(0),                                   // 0: minder.v1.ObjectOwner
-	(Relation)(0),                                      // 1: minder.v1.Relation
-	(TargetResource)(0),                                // 2: minder.v1.TargetResource
-	(Entity)(0),                                        // 3: minder.v1.Entity
-	(RuleTypeReleasePhase)(0),                          // 4: minder.v1.RuleTypeReleasePhase
-	(ProviderType)(0),                                  // 5: minder.v1.ProviderType
-	(ProviderClass)(0),                                 // 6: minder.v1.ProviderClass
-	(AuthorizationFlow)(0),                             // 7: minder.v1.AuthorizationFlow
-	(CredentialsState)(0),                              // 8: minder.v1.CredentialsState
-	(Severity_Value)(0),                                // 9: minder.v1.Severity.Value
-	(*RpcOptions)(nil),                                 // 10: minder.v1.RpcOptions
-	(*Cursor)(nil),                                     // 11: minder.v1.Cursor
-	(*CursorPage)(nil),                                 // 12: minder.v1.CursorPage
-	(*GetVersionRequest)(nil),                          // 13: minder.v1.GetVersionRequest
-	(*GetVersionResponse)(nil),                         // 14: minder.v1.GetVersionResponse
-	(*ListArtifactsRequest)(nil),                       // 15: minder.v1.ListArtifactsRequest
-	(*ListArtifactsResponse)(nil),                      // 16: minder.v1.ListArtifactsResponse
-	(*Artifact)(nil),                                   // 17: minder.v1.Artifact
-	(*ArtifactVersion)(nil),                            // 18: minder.v1.ArtifactVersion
-	(*GetArtifactByIdRequest)(nil),                     // 19: minder.v1.GetArtifactByIdRequest
-	(*GetArtifactByIdResponse)(nil),                    // 20: minder.v1.GetArtifactByIdResponse
-	(*GetArtifactByNameRequest)(nil),                   // 21: minder.v1.GetArtifactByNameRequest
-	(*GetArtifactByNameResponse)(nil),                  // 22: minder.v1.GetArtifactByNameResponse
-	(*Release)(nil),                                    // 23: minder.v1.Release
-	(*PipelineRun)(nil),                                // 24: minder.v1.PipelineRun
-	(*TaskRun)(nil),                                    // 25: minder.v1.TaskRun
-	(*Build)(nil),                                      // 26: minder.v1.Build
-	(*GetInviteDetailsRequest)(nil),                    // 27: minder.v1.GetInviteDetailsRequest
-	(*GetInviteDetailsResponse)(nil),                   // 28: minder.v1.GetInviteDetailsResponse
-	(*CheckHealthRequest)(nil),                         // 29: minder.v1.CheckHealthRequest
-	(*CheckHealthResponse)(nil),                        // 30: minder.v1.CheckHealthResponse
-	(*GetAuthorizationURLRequest)(nil),                 // 31: minder.v1.GetAuthorizationURLRequest
-	(*GetAuthorizationURLResponse)(nil),                // 32: minder.v1.GetAuthorizationURLResponse
-	(*StoreProviderTokenRequest)(nil),                  // 33: minder.v1.StoreProviderTokenRequest
-	(*StoreProviderTokenResponse)(nil),                 // 34: minder.v1.StoreProviderTokenResponse
-	(*Project)(nil),                                    // 35: minder.v1.Project
-	(*ListRemoteRepositoriesFromProviderRequest)(nil),  // 36: minder.v1.ListRemoteRepositoriesFromProviderRequest
-	(*ListRemoteRepositoriesFromProviderResponse)(nil), // 37: minder.v1.ListRemoteRepositoriesFromProviderResponse
-	(*RegistrableUpstreamEntityRef)(nil),               // 38: minder.v1.RegistrableUpstreamEntityRef
-	(*UpstreamRepositoryRef)(nil),                      // 39: minder.v1.UpstreamRepositoryRef
-	(*Repository)(nil),                                 // 40: minder.v1.Repository
-	(*RegisterRepositoryRequest)(nil),                  // 41: minder.v1.RegisterRepositoryRequest
-	(*RegisterRepoResult)(nil),                         // 42: minder.v1.RegisterRepoResult
-	(*RegisterRepositoryResponse)(nil),                 // 43: minder.v1.RegisterRepositoryResponse
-	(*GetRepositoryByIdRequest)(nil),                   // 44: minder.v1.GetRepositoryByIdRequest
-	(*GetRepositoryByIdResponse)(nil),                  // 45: minder.v1.GetRepositoryByIdResponse
-	(*DeleteRepositoryByIdRequest)(nil),                // 46: minder.v1.DeleteRepositoryByIdRequest
-	(*DeleteRepositoryByIdResponse)(nil),               // 47: minder.v1.DeleteRepositoryByIdResponse
-	(*GetRepositoryByNameRequest)(nil),                 // 48: minder.v1.GetRepositoryByNameRequest
-	(*GetRepositoryByNameResponse)(nil),                // 49: minder.v1.GetRepositoryByNameResponse
-	(*DeleteRepositoryByNameRequest)(nil),              // 50: minder.v1.DeleteRepositoryByNameRequest
-	(*DeleteRepositoryByNameResponse)(nil),             // 51: minder.v1.DeleteRepositoryByNameResponse
-	(*ListRepositoriesRequest)(nil),                    // 52: minder.v1.ListRepositoriesRequest
-	(*ListRepositoriesResponse)(nil),                   // 53: minder.v1.ListRepositoriesResponse
-	(*ReconcileEntityRegistrationRequest)(nil),         // 54: minder.v1.ReconcileEntityRegistrationRequest
-	(*ReconcileEntityRegistrationResponse)(nil),        // 55: minder.v1.ReconcileEntityRegistrationResponse
-	(*VerifyProviderTokenFromRequest)(nil),             // 56: minder.v1.VerifyProviderTokenFromRequest
-	(*VerifyProviderTokenFromResponse)(nil),            // 57: minder.v1.VerifyProviderTokenFromResponse
-	(*VerifyProviderCredentialRequest)(nil),            // 58: minder.v1.VerifyProviderCredentialRequest
-	(*VerifyProviderCredentialResponse)(nil),           // 59: minder.v1.VerifyProviderCredentialResponse
-	(*CreateUserRequest)(nil),                          // 60: minder.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),                         // 61: minder.v1.CreateUserResponse
-	(*DeleteUserRequest)(nil),                          // 62: minder.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),                         // 63: minder.v1.DeleteUserResponse
-	(*UserRecord)(nil),                                 // 64: minder.v1.UserRecord
-	(*ProjectRole)(nil),                                // 65: minder.v1.ProjectRole
-	(*GetUserRequest)(nil),                             // 66: minder.v1.GetUserRequest
-	(*GetUserResponse)(nil),                            // 67: minder.v1.GetUserResponse
-	(*CreateDataSourceRequest)(nil),                    // 68: minder.v1.CreateDataSourceRequest
-	(*CreateDataSourceResponse)(nil),                   // 69: minder.v1.CreateDataSourceResponse
-	(*GetDataSourceByIdRequest)(nil),                   // 70: minder.v1.GetDataSourceByIdRequest
-	(*GetDataSourceByIdResponse)(nil),                  // 71: minder.v1.GetDataSourceByIdResponse
-	(*GetDataSourceByNameRequest)(nil),                 // 72: minder.v1.GetDataSourceByNameRequest
-	(*GetDataSourceByNameResponse)(nil),                // 73: minder.v1.GetDataSourceByNameResponse
-	(*ListDataSourcesRequest)(nil),                     // 74: minder.v1.ListDataSourcesRequest
-	(*ListDataSourcesResponse)(nil),                    // 75: minder.v1.ListDataSourcesResponse
-	(*UpdateDataSourceRequest)(nil),                    // 76: minder.v1.UpdateDataSourceRequest
-	(*UpdateDataSourceResponse)(nil),                   // 77: minder.v1.UpdateDataSourceResponse
-	(*DeleteDataSourceByIdRequest)(nil),                // 78: minder.v1.DeleteDataSourceByIdRequest
-	(*DeleteDataSourceByIdResponse)(nil),               // 79: minder.v1.DeleteDataSourceByIdResponse
-	(*DeleteDataSourceByNameRequest)(nil),              // 80: minder.v1.DeleteDataSourceByNameRequest
-	(*DeleteDataSourceByNameResponse)(nil),             // 81: minder.v1.DeleteDataSourceByNameResponse
-	(*CreateProfileRequest)(nil),                       // 82: minder.v1.CreateProfileRequest
-	(*CreateProfileResponse)(nil),                      // 83: minder.v1.CreateProfileResponse
-	(*UpdateProfileRequest)(nil),                       // 84: minder.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),                      // 85: minder.v1.UpdateProfileResponse
-	(*PatchProfileRequest)(nil),                        // 86: minder.v1.PatchProfileRequest
-	(*PatchProfileResponse)(nil),                       // 87: minder.v1.PatchProfileResponse
-	(*DeleteProfileRequest)(nil),                       // 88: minder.v1.DeleteProfileRequest
-	(*DeleteProfileResponse)(nil),                      // 89: minder.v1.DeleteProfileResponse
-	(*ListProfilesRequest)(nil),                        // 90: minder.v1.ListProfilesRequest
-	(*ListProfilesResponse)(nil),                       // 91: minder.v1.ListProfilesResponse
-	(*GetProfileByIdRequest)(nil),                      // 92: minder.v1.GetProfileByIdRequest
-	(*GetProfileByIdResponse)(nil),                     // 93: minder.v1.GetProfileByIdResponse
-	(*GetProfileByNameRequest)(nil),                    // 94: minder.v1.GetProfileByNameRequest
-	(*GetProfileByNameResponse)(nil),                   // 95: minder.v1.GetProfileByNameResponse
-	(*ProfileStatus)(nil),                              // 96: minder.v1.ProfileStatus
-	(*EvalResultAlert)(nil),                            // 97: minder.v1.EvalResultAlert
-	(*RuleEvaluationStatus)(nil),                       // 98: minder.v1.RuleEvaluationStatus
-	(*EntityTypedId)(nil),                              // 99: minder.v1.EntityTypedId
-	(*GetProfileStatusByNameRequest)(nil),              // 100: minder.v1.GetProfileStatusByNameRequest
-	(*GetProfileStatusByNameResponse)(nil),             // 101: minder.v1.GetProfileStatusByNameResponse
-	(*GetProfileStatusByIdRequest)(nil),                // 102: minder.v1.GetProfileStatusByIdRequest
-	(*GetProfileStatusByIdResponse)(nil),               // 103: minder.v1.GetProfileStatusByIdResponse
-	(*GetProfileStatusByProjectRequest)(nil),           // 104: minder.v1.GetProfileStatusByProjectRequest
-	(*GetProfileStatusByProjectResponse)(nil),          // 105: minder.v1.GetProfileStatusByProjectResponse
-	(*EntityAutoRegistrationConfig)(nil),               // 106: minder.v1.EntityAutoRegistrationConfig
-	(*AutoRegistration)(nil),                           // 107: minder.v1.AutoRegistration
-	(*ProviderConfig)(nil),                             // 108: minder.v1.ProviderConfig
-	(*RESTProviderConfig)(nil),                         // 109: minder.v1.RESTProviderConfig
-	(*GitHubProviderConfig)(nil),                       // 110: minder.v1.GitHubProviderConfig
-	(*GitHubAppProviderConfig)(nil),                    // 111: minder.v1.GitHubAppProviderConfig
-	(*GitLabProviderConfig)(nil),                       // 112: minder.v1.GitLabProviderConfig
-	(*DockerHubProviderConfig)(nil),                    // 113: minder.v1.DockerHubProviderConfig
-	(*GHCRProviderConfig)(nil),                         // 114: minder.v1.GHCRProviderConfig
-	(*Context)(nil),                                    // 115: minder.v1.Context
-	(*ContextV2)(nil),                                  // 116: minder.v1.ContextV2
-	(*ListRuleTypesRequest)(nil),                       // 117: minder.v1.ListRuleTypesRequest
-	(*ListRuleTypesResponse)(nil),                      // 118: minder.v1.ListRuleTypesResponse
-	(*GetRuleTypeByNameRequest)(nil),                   // 119: minder.v1.GetRuleTypeByNameRequest
-	(*GetRuleTypeByNameResponse)(nil),                  // 120: minder.v1.GetRuleTypeByNameResponse
-	(*GetRuleTypeByIdRequest)(nil),                     // 121: minder.v1.GetRuleTypeByIdRequest
-	(*GetRuleTypeByIdResponse)(nil),                    // 122: minder.v1.GetRuleTypeByIdResponse
-	(*CreateRuleTypeRequest)(nil),                      // 123: minder.v1.CreateRuleTypeRequest
-	(*CreateRuleTypeResponse)(nil),                     // 124: minder.v1.CreateRuleTypeResponse
-	(*UpdateRuleTypeRequest)(nil),                      // 125: minder.v1.UpdateRuleTypeRequest
-	(*UpdateRuleTypeResponse)(nil),                     // 126: minder.v1.UpdateRuleTypeResponse
-	(*DeleteRuleTypeRequest)(nil),                      // 127: minder.v1.DeleteRuleTypeRequest
-	(*DeleteRuleTypeResponse)(nil),                     // 128: minder.v1.DeleteRuleTypeResponse
-	(*ListEvaluationResultsRequest)(nil),               // 129: minder.v1.ListEvaluationResultsRequest
-	(*ListEvaluationResultsResponse)(nil),              // 130: minder.v1.ListEvaluationResultsResponse
-	(*RestType)(nil),                                   // 131: minder.v1.RestType
-	(*BuiltinType)(nil),                                // 132: minder.v1.BuiltinType
-	(*ArtifactType)(nil),                               // 133: minder.v1.ArtifactType
-	(*GitType)(nil),                                    // 134: minder.v1.GitType
-	(*DiffType)(nil),                                   // 135: minder.v1.DiffType
-	(*DepsType)(nil),                                   // 136: minder.v1.DepsType
-	(*Severity)(nil),                                   // 137: minder.v1.Severity
-	(*RuleType)(nil),                                   // 138: minder.v1.RuleType
-	(*Profile)(nil),                                    // 139: minder.v1.Profile
-	(*ListProjectsRequest)(nil),                        // 140: minder.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),                       // 141: minder.v1.ListProjectsResponse
-	(*CreateProjectRequest)(nil),                       // 142: minder.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil),                      // 143: minder.v1.CreateProjectResponse
-	(*DeleteProjectRequest)(nil),                       // 144: minder.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil),                      // 145: minder.v1.DeleteProjectResponse
-	(*UpdateProjectRequest)(nil),                       // 146: minder.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),                      // 147: minder.v1.UpdateProjectResponse
-	(*ProjectPatch)(nil),                               // 148: minder.v1.ProjectPatch
-	(*PatchProjectRequest)(nil),                        // 149: minder.v1.PatchProjectRequest
-	(*PatchProjectResponse)(nil),                       // 150: minder.v1.PatchProjectResponse
-	(*ListChildProjectsRequest)(nil),                   // 151: minder.v1.ListChildProjectsRequest
-	(*ListChildProjectsResponse)(nil),                  // 152: minder.v1.ListChildProjectsResponse
-	(*CreateEntityReconciliationTaskRequest)(nil),      // 153: minder.v1.CreateEntityReconciliationTaskRequest
-	(*CreateEntityReconciliationTaskResponse)(nil),     // 154: minder.v1.CreateEntityReconciliationTaskResponse
-	(*ListRolesRequest)(nil),                           // 155: minder.v1.ListRolesRequest
-	(*ListRolesResponse)(nil),                          // 156: minder.v1.ListRolesResponse
-	(*ListRoleAssignmentsRequest)(nil),                 // 157: minder.v1.ListRoleAssignmentsRequest
-	(*ListRoleAssignmentsResponse)(nil),                // 158: minder.v1.ListRoleAssignmentsResponse
-	(*AssignRoleRequest)(nil),                          // 159: minder.v1.AssignRoleRequest
-	(*AssignRoleResponse)(nil),                         // 160: minder.v1.AssignRoleResponse
-	(*UpdateRoleRequest)(nil),                          // 161: minder.v1.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil),                         // 162: minder.v1.UpdateRoleResponse
-	(*RemoveRoleRequest)(nil),                          // 163: minder.v1.RemoveRoleRequest
-	(*RemoveRoleResponse)(nil),                         // 164: minder.v1.RemoveRoleResponse
-	(*Role)(nil),                                       // 165: minder.v1.Role
-	(*RoleAssignment)(nil),                             // 166: minder.v1.RoleAssignment
-	(*ListInvitationsRequest)(nil),                     // 167: minder.v1.ListInvitationsRequest
-	(*ListInvitationsResponse)(nil),                    // 168: minder.v1.ListInvitationsResponse
-	(*ResolveInvitationRequest)(nil),                   // 169: minder.v1.ResolveInvitationRequest
-	(*ResolveInvitationResponse)(nil),                  // 170: minder.v1.ResolveInvitationResponse
-	(*Invitation)(nil),                                 // 171: minder.v1.Invitation
-	(*GetProviderRequest)(nil),                         // 172: minder.v1.GetProviderRequest
-	(*GetProviderResponse)(nil),                        // 173: minder.v1.GetProviderResponse
-	(*ListProvidersRequest)(nil),                       // 174: minder.v1.ListProvidersRequest
-	(*ListProvidersResponse)(nil),                      // 175: minder.v1.ListProvidersResponse
-	(*CreateProviderRequest)(nil),                      // 176: minder.v1.CreateProviderRequest
-	(*CreateProviderResponse)(nil),                     // 177: minder.v1.CreateProviderResponse
-	(*DeleteProviderRequest)(nil),                      // 178: minder.v1.DeleteProviderRequest
-	(*DeleteProviderResponse)(nil),                     // 179: minder.v1.DeleteProviderResponse
-	(*DeleteProviderByIDRequest)(nil),                  // 180: minder.v1.DeleteProviderByIDRequest
-	(*DeleteProviderByIDResponse)(nil),                 // 181: minder.v1.DeleteProviderByIDResponse
-	(*ListProviderClassesRequest)(nil),                 // 182: minder.v1.ListProviderClassesRequest
-	(*ProviderClassInfo)(nil),                          // 183: minder.v1.ProviderClassInfo
-	(*ListProviderClassesResponse)(nil),                // 184: minder.v1.ListProviderClassesResponse
-	(*PatchProviderRequest)(nil),                       // 185: minder.v1.PatchProviderRequest
-	(*PatchProviderResponse)(nil),                      // 186: minder.v1.PatchProviderResponse
-	(*AuthorizationParams)(nil),                        // 187: minder.v1.AuthorizationParams
-	(*ProviderParameter)(nil),                          // 188: minder.v1.ProviderParameter
-	(*GitHubAppParams)(nil),                            // 189: minder.v1.GitHubAppParams
-	(*Provider)(nil),                                   // 190: minder.v1.Provider
-	(*GetEvaluationHistoryRequest)(nil),                // 191: minder.v1.GetEvaluationHistoryRequest
-	(*ListEvaluationHistoryRequest)(nil),               // 192: minder.v1.ListEvaluationHistoryRequest
-	(*GetEvaluationHistoryResponse)(nil),               // 193: minder.v1.GetEvaluationHistoryResponse
-	(*ListEvaluationHistoryResponse)(nil),              // 194: minder.v1.ListEvaluationHistoryResponse
-	(*EvaluationHistory)(nil),                          // 195: minder.v1.EvaluationHistory
-	(*EvaluationHistoryEntity)(nil),                    // 196: minder.v1.EvaluationHistoryEntity
-	(*EvaluationHistoryRule)(nil),                      // 197: minder.v1.EvaluationHistoryRule
-	(*EvaluationHistoryStatus)(nil),                    // 198: minder.v1.EvaluationHistoryStatus
-	(*EvaluationHistoryRemediation)(nil),               // 199: minder.v1.EvaluationHistoryRemediation
-	(*EvaluationHistoryAlert)(nil),                     // 200: minder.v1.EvaluationHistoryAlert
-	(*EntityInstance)(nil),                             // 201: minder.v1.EntityInstance
-	(*ListEntitiesRequest)(nil),                        // 202: minder.v1.ListEntitiesRequest
-	(*ListEntitiesResponse)(nil),                       // 203: minder.v1.ListEntitiesResponse
-	(*GetEntityByIdRequest)(nil),                       // 204: minder.v1.GetEntityByIdRequest
-	(*GetEntityByIdResponse)(nil),                      // 205: minder.v1.GetEntityByIdResponse
-	(*GetEntityByNameRequest)(nil),                     // 206: minder.v1.GetEntityByNameRequest
-	(*GetEntityByNameResponse)(nil),                    // 207: minder.v1.GetEntityByNameResponse
-	(*DeleteEntityByIdRequest)(nil),                    // 208: minder.v1.DeleteEntityByIdRequest
-	(*DeleteEntityByIdResponse)(nil),                   // 209: minder.v1.DeleteEntityByIdResponse
-	(*RegisterEntityRequest)(nil),                      // 210: minder.v1.RegisterEntityRequest
-	(*RegisterEntityResponse)(nil),                     // 211: minder.v1.RegisterEntityResponse
-	(*UpstreamEntityRef)(nil),                          // 212: minder.v1.UpstreamEntityRef
-	(*DataSource)(nil),                                 // 213: minder.v1.DataSource
-	(*StructDataSource)(nil),                           // 214: minder.v1.StructDataSource
-	(*RestDataSource)(nil),                             // 215: minder.v1.RestDataSource
-	(*DataSourceReference)(nil),                        // 216: minder.v1.DataSourceReference
-	(*RegisterRepoResult_Status)(nil),                  // 217: minder.v1.RegisterRepoResult.Status
-	nil,                                                // 218: minder.v1.RuleEvaluationStatus.EntityInfoEntry
-	nil,                                                // 219: minder.v1.AutoRegistration.EntitiesEntry
-	(*ListEvaluationResultsResponse_EntityProfileEvaluationResults)(nil), // 220: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
-	(*ListEvaluationResultsResponse_EntityEvaluationResults)(nil),        // 221: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
-	(*RestType_Fallback)(nil),                                                              // 222: minder.v1.RestType.Fallback
-	(*DiffType_Ecosystem)(nil),                                                             // 223: minder.v1.DiffType.Ecosystem
-	(*DepsType_RepoConfigs)(nil),                                                           // 224: minder.v1.DepsType.RepoConfigs
-	(*DepsType_PullRequestConfigs)(nil),                                                    // 225: minder.v1.DepsType.PullRequestConfigs
-	(*RuleType_Definition)(nil),                                                            // 226: minder.v1.RuleType.Definition
-	(*RuleType_Definition_Ingest)(nil),                                                     // 227: minder.v1.RuleType.Definition.Ingest
-	(*RuleType_Definition_Eval)(nil),                                                       // 228: minder.v1.RuleType.Definition.Eval
-	(*RuleType_Definition_Remediate)(nil),                                                  // 229: minder.v1.RuleType.Definition.Remediate
-	(*RuleType_Definition_Alert)(nil),                                                      // 230: minder.v1.RuleType.Definition.Alert
-	(*RuleType_Definition_Eval_JQComparison)(nil),                                          // 231: minder.v1.RuleType.Definition.Eval.JQComparison
-	(*RuleType_Definition_Eval_Rego)(nil),                                                  // 232: minder.v1.RuleType.Definition.Eval.Rego
-	(*RuleType_Definition_Eval_Vulncheck)(nil),                                             // 233: minder.v1.RuleType.Definition.Eval.Vulncheck
-	(*RuleType_Definition_Eval_Trusty)(nil),                                                // 234: minder.v1.RuleType.Definition.Eval.Trusty
-	(*RuleType_Definition_Eval_Homoglyphs)(nil),                                            // 235: minder.v1.RuleType.Definition.Eval.Homoglyphs
-	(*RuleType_Definition_Eval_JQComparison_Operator)(nil),                                 // 236: minder.v1.RuleType.Definition.Eval.JQComparison.Operator
-	(*RuleType_Definition_Remediate_GhBranchProtectionType)(nil),                           // 237: minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
-	(*RuleType_Definition_Remediate_PullRequestRemediation)(nil),                           // 238: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
-	(*RuleType_Definition_Remediate_PullRequestRemediation_Content)(nil),                   // 239: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
-	(*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha)(nil), // 240: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
-	(*RuleType_Definition_Alert_AlertTypeSA)(nil),                                          // 241: minder.v1.RuleType.Definition.Alert.AlertTypeSA
-	(*RuleType_Definition_Alert_AlertTypePRComment)(nil),                                   // 242: minder.v1.RuleType.Definition.Alert.AlertTypePRComment
-	(*Profile_Rule)(nil),                                                                   // 243: minder.v1.Profile.Rule
-	(*Profile_Selector)(nil),                                                               // 244: minder.v1.Profile.Selector
-	nil,                                                                                    // 245: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
-	(*StructDataSource_Def)(nil),                                                           // 246: minder.v1.StructDataSource.Def
-	nil,                                                                                    // 247: minder.v1.StructDataSource.DefEntry
-	(*StructDataSource_Def_Path)(nil),                                                      // 248: minder.v1.StructDataSource.Def.Path
-	(*RestDataSource_Def)(nil),                                                             // 249: minder.v1.RestDataSource.Def
-	nil,                                                                                    // 250: minder.v1.RestDataSource.DefEntry
-	nil,                                                                                    // 251: minder.v1.RestDataSource.Def.HeadersEntry
-	(*RestDataSource_Def_Fallback)(nil),                                                    // 252: minder.v1.RestDataSource.Def.Fallback
-	(*timestamppb.Timestamp)(nil),                                                          // 253: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                                                                // 254: google.protobuf.Struct
-	(*fieldmaskpb.FieldMask)(nil),                                                          // 255: google.protobuf.FieldMask
-	(*structpb.Value)(nil),                                                                 // 256: google.protobuf.Value
-	(*descriptorpb.EnumValueOptions)(nil),                                                  // 257: google.protobuf.EnumValueOptions
-	(*descriptorpb.MethodOptions)(nil),                                                     // 258: google.protobuf.MethodOptions
+	(ObjectOwner)(0),                                                     // 0: minder.v1.ObjectOwner
+	(Relation)(0),                                                        // 1: minder.v1.Relation
+	(TargetResource)(0),                                                  // 2: minder.v1.TargetResource
+	(Entity)(0),                                                          // 3: minder.v1.Entity
+	(ComplianceReportFormat)(0),                                          // 4: minder.v1.ComplianceReportFormat
+	(RuleTypeReleasePhase)(0),                                            // 5: minder.v1.RuleTypeReleasePhase
+	(ProviderType)(0),                                                    // 6: minder.v1.ProviderType
+	(ProviderClass)(0),                                                   // 7: minder.v1.ProviderClass
+	(AuthorizationFlow)(0),                                               // 8: minder.v1.AuthorizationFlow
+	(CredentialsState)(0),                                                // 9: minder.v1.CredentialsState
+	(Severity_Value)(0),                                                  // 10: minder.v1.Severity.Value
+	(*RpcOptions)(nil),                                                   // 11: minder.v1.RpcOptions
+	(*Cursor)(nil),                                                       // 12: minder.v1.Cursor
+	(*CursorPage)(nil),                                                   // 13: minder.v1.CursorPage
+	(*GetVersionRequest)(nil),                                            // 14: minder.v1.GetVersionRequest
+	(*GetVersionResponse)(nil),                                           // 15: minder.v1.GetVersionResponse
+	(*ListArtifactsRequest)(nil),                                         // 16: minder.v1.ListArtifactsRequest
+	(*ListArtifactsResponse)(nil),                                        // 17: minder.v1.ListArtifactsResponse
+	(*Artifact)(nil),                                                     // 18: minder.v1.Artifact
+	(*ArtifactVersion)(nil),                                              // 19: minder.v1.ArtifactVersion
+	(*GetArtifactByIdRequest)(nil),                                       // 20: minder.v1.GetArtifactByIdRequest
+	(*GetArtifactByIdResponse)(nil),                                      // 21: minder.v1.GetArtifactByIdResponse
+	(*GetArtifactByNameRequest)(nil),                                     // 22: minder.v1.GetArtifactByNameRequest
+	(*GetArtifactByNameResponse)(nil),                                    // 23: minder.v1.GetArtifactByNameResponse
+	(*Release)(nil),                                                      // 24: minder.v1.Release
+	(*PipelineRun)(nil),                                                  // 25: minder.v1.PipelineRun
+	(*TaskRun)(nil),                                                      // 26: minder.v1.TaskRun
+	(*Build)(nil),                                                        // 27: minder.v1.Build
+	(*GetInviteDetailsRequest)(nil),                                      // 28: minder.v1.GetInviteDetailsRequest
+	(*GetInviteDetailsResponse)(nil),                                     // 29: minder.v1.GetInviteDetailsResponse
+	(*CheckHealthRequest)(nil),                                           // 30: minder.v1.CheckHealthRequest
+	(*CheckHealthResponse)(nil),                                          // 31: minder.v1.CheckHealthResponse
+	(*GetAuthorizationURLRequest)(nil),                                   // 32: minder.v1.GetAuthorizationURLRequest
+	(*GetAuthorizationURLResponse)(nil),                                  // 33: minder.v1.GetAuthorizationURLResponse
+	(*StoreProviderTokenRequest)(nil),                                    // 34: minder.v1.StoreProviderTokenRequest
+	(*StoreProviderTokenResponse)(nil),                                   // 35: minder.v1.StoreProviderTokenResponse
+	(*Project)(nil),                                                      // 36: minder.v1.Project
+	(*ListRemoteRepositoriesFromProviderRequest)(nil),                    // 37: minder.v1.ListRemoteRepositoriesFromProviderRequest
+	(*ListRemoteRepositoriesFromProviderResponse)(nil),                   // 38: minder.v1.ListRemoteRepositoriesFromProviderResponse
+	(*RegistrableUpstreamEntityRef)(nil),                                 // 39: minder.v1.RegistrableUpstreamEntityRef
+	(*UpstreamRepositoryRef)(nil),                                        // 40: minder.v1.UpstreamRepositoryRef
+	(*Repository)(nil),                                                   // 41: minder.v1.Repository
+	(*RegisterRepositoryRequest)(nil),                                    // 42: minder.v1.RegisterRepositoryRequest
+	(*RegisterRepoResult)(nil),                                           // 43: minder.v1.RegisterRepoResult
+	(*RegisterRepositoryResponse)(nil),                                   // 44: minder.v1.RegisterRepositoryResponse
+	(*GetRepositoryByIdRequest)(nil),                                     // 45: minder.v1.GetRepositoryByIdRequest
+	(*GetRepositoryByIdResponse)(nil),                                    // 46: minder.v1.GetRepositoryByIdResponse
+	(*DeleteRepositoryByIdRequest)(nil),                                  // 47: minder.v1.DeleteRepositoryByIdRequest
+	(*DeleteRepositoryByIdResponse)(nil),                                 // 48: minder.v1.DeleteRepositoryByIdResponse
+	(*GetRepositoryByNameRequest)(nil),                                   // 49: minder.v1.GetRepositoryByNameRequest
+	(*GetRepositoryByNameResponse)(nil),                                  // 50: minder.v1.GetRepositoryByNameResponse
+	(*DeleteRepositoryByNameRequest)(nil),                                // 51: minder.v1.DeleteRepositoryByNameRequest
+	(*DeleteRepositoryByNameResponse)(nil),                               // 52: minder.v1.DeleteRepositoryByNameResponse
+	(*ListRepositoriesRequest)(nil),                                      // 53: minder.v1.ListRepositoriesRequest
+	(*ListRepositoriesResponse)(nil),                                     // 54: minder.v1.ListRepositoriesResponse
+	(*ReconcileEntityRegistrationRequest)(nil),                           // 55: minder.v1.ReconcileEntityRegistrationRequest
+	(*ReconcileEntityRegistrationResponse)(nil),                          // 56: minder.v1.ReconcileEntityRegistrationResponse
+	(*VerifyProviderTokenFromRequest)(nil),                               // 57: minder.v1.VerifyProviderTokenFromRequest
+	(*VerifyProviderTokenFromResponse)(nil),                              // 58: minder.v1.VerifyProviderTokenFromResponse
+	(*VerifyProviderCredentialRequest)(nil),                              // 59: minder.v1.VerifyProviderCredentialRequest
+	(*VerifyProviderCredentialResponse)(nil),                             // 60: minder.v1.VerifyProviderCredentialResponse
+	(*CreateUserRequest)(nil),                                            // 61: minder.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                                           // 62: minder.v1.CreateUserResponse
+	(*DeleteUserRequest)(nil),                                            // 63: minder.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                                           // 64: minder.v1.DeleteUserResponse
+	(*UserRecord)(nil),                                                   // 65: minder.v1.UserRecord
+	(*ProjectRole)(nil),                                                  // 66: minder.v1.ProjectRole
+	(*GetUserRequest)(nil),                                               // 67: minder.v1.GetUserRequest
+	(*GetUserResponse)(nil),                                              // 68: minder.v1.GetUserResponse
+	(*CreateDataSourceRequest)(nil),                                      // 69: minder.v1.CreateDataSourceRequest
+	(*CreateDataSourceResponse)(nil),                                     // 70: minder.v1.CreateDataSourceResponse
+	(*GetDataSourceByIdRequest)(nil),                                     // 71: minder.v1.GetDataSourceByIdRequest
+	(*GetDataSourceByIdResponse)(nil),                                    // 72: minder.v1.GetDataSourceByIdResponse
+	(*GetDataSourceByNameRequest)(nil),                                   // 73: minder.v1.GetDataSourceByNameRequest
+	(*GetDataSourceByNameResponse)(nil),                                  // 74: minder.v1.GetDataSourceByNameResponse
+	(*ListDataSourcesRequest)(nil),                                       // 75: minder.v1.ListDataSourcesRequest
+	(*ListDataSourcesResponse)(nil),                                      // 76: minder.v1.ListDataSourcesResponse
+	(*UpdateDataSourceRequest)(nil),                                      // 77: minder.v1.UpdateDataSourceRequest
+	(*UpdateDataSourceResponse)(nil),                                     // 78: minder.v1.UpdateDataSourceResponse
+	(*DeleteDataSourceByIdRequest)(nil),                                  // 79: minder.v1.DeleteDataSourceByIdRequest
+	(*DeleteDataSourceByIdResponse)(nil),                                 // 80: minder.v1.DeleteDataSourceByIdResponse
+	(*DeleteDataSourceByNameRequest)(nil),                                // 81: minder.v1.DeleteDataSourceByNameRequest
+	(*DeleteDataSourceByNameResponse)(nil),                               // 82: minder.v1.DeleteDataSourceByNameResponse
+	(*CreateProfileRequest)(nil),                                         // 83: minder.v1.CreateProfileRequest
+	(*CreateProfileResponse)(nil),                                        // 84: minder.v1.CreateProfileResponse
+	(*UpdateProfileRequest)(nil),                                         // 85: minder.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),                                        // 86: minder.v1.UpdateProfileResponse
+	(*PlanProfileRequest)(nil),                                           // 87: minder.v1.PlanProfileRequest
+	(*ProfilePlanSelection)(nil),                                         // 88: minder.v1.ProfilePlanSelection
+	(*ProfilePlanEvaluation)(nil),                                        // 89: minder.v1.ProfilePlanEvaluation
+	(*PlanProfileResponse)(nil),                                          // 90: minder.v1.PlanProfileResponse
+	(*PatchProfileRequest)(nil),                                          // 91: minder.v1.PatchProfileRequest
+	(*PatchProfileResponse)(nil),                                         // 92: minder.v1.PatchProfileResponse
+	(*DeleteProfileRequest)(nil),                                         // 93: minder.v1.DeleteProfileRequest
+	(*DeleteProfileResponse)(nil),                                        // 94: minder.v1.DeleteProfileResponse
+	(*ListProfilesRequest)(nil),                                          // 95: minder.v1.ListProfilesRequest
+	(*ListProfilesResponse)(nil),                                         // 96: minder.v1.ListProfilesResponse
+	(*GetProfileByIdRequest)(nil),                                        // 97: minder.v1.GetProfileByIdRequest
+	(*GetProfileByIdResponse)(nil),                                       // 98: minder.v1.GetProfileByIdResponse
+	(*GetProfileByNameRequest)(nil),                                      // 99: minder.v1.GetProfileByNameRequest
+	(*GetProfileByNameResponse)(nil),                                     // 100: minder.v1.GetProfileByNameResponse
+	(*ProfileStatus)(nil),                                                // 101: minder.v1.ProfileStatus
+	(*EvalResultAlert)(nil),                                              // 102: minder.v1.EvalResultAlert
+	(*RuleEvaluationStatus)(nil),                                         // 103: minder.v1.RuleEvaluationStatus
+	(*EntityTypedId)(nil),                                                // 104: minder.v1.EntityTypedId
+	(*GetProfileStatusByNameRequest)(nil),                                // 105: minder.v1.GetProfileStatusByNameRequest
+	(*GetProfileStatusByNameResponse)(nil),                               // 106: minder.v1.GetProfileStatusByNameResponse
+	(*GetProfileStatusByIdRequest)(nil),                                  // 107: minder.v1.GetProfileStatusByIdRequest
+	(*GetProfileStatusByIdResponse)(nil),                                 // 108: minder.v1.GetProfileStatusByIdResponse
+	(*GetProfileStatusByProjectRequest)(nil),                             // 109: minder.v1.GetProfileStatusByProjectRequest
+	(*GetProfileStatusByProjectResponse)(nil),                            // 110: minder.v1.GetProfileStatusByProjectResponse
+	(*RuleExemption)(nil),                                                // 111: minder.v1.RuleExemption
+	(*CreateRuleExemptionRequest)(nil),                                   // 112: minder.v1.CreateRuleExemptionRequest
+	(*CreateRuleExemptionResponse)(nil),                                  // 113: minder.v1.CreateRuleExemptionResponse
+	(*GetRuleExemptionRequest)(nil),                                      // 114: minder.v1.GetRuleExemptionRequest
+	(*GetRuleExemptionResponse)(nil),                                     // 115: minder.v1.GetRuleExemptionResponse
+	(*ListRuleExemptionsRequest)(nil),                                    // 116: minder.v1.ListRuleExemptionsRequest
+	(*ListRuleExemptionsResponse)(nil),                                   // 117: minder.v1.ListRuleExemptionsResponse
+	(*UpdateRuleExemptionRequest)(nil),                                   // 118: minder.v1.UpdateRuleExemptionRequest
+	(*UpdateRuleExemptionResponse)(nil),                                  // 119: minder.v1.UpdateRuleExemptionResponse
+	(*DeleteRuleExemptionRequest)(nil),                                   // 120: minder.v1.DeleteRuleExemptionRequest
+	(*DeleteRuleExemptionResponse)(nil),                                  // 121: minder.v1.DeleteRuleExemptionResponse
+	(*EntityAutoRegistrationConfig)(nil),                                 // 122: minder.v1.EntityAutoRegistrationConfig
+	(*AutoRegistration)(nil),                                             // 123: minder.v1.AutoRegistration
+	(*ProviderConfig)(nil),                                               // 124: minder.v1.ProviderConfig
+	(*RESTProviderConfig)(nil),                                           // 125: minder.v1.RESTProviderConfig
+	(*GitHubProviderConfig)(nil),                                         // 126: minder.v1.GitHubProviderConfig
+	(*GitHubAppProviderConfig)(nil),                                      // 127: minder.v1.GitHubAppProviderConfig
+	(*GitLabProviderConfig)(nil),                                         // 128: minder.v1.GitLabProviderConfig
+	(*BitbucketProviderConfig)(nil),                                      // 129: minder.v1.BitbucketProviderConfig
+	(*GiteaProviderConfig)(nil),                                          // 130: minder.v1.GiteaProviderConfig
+	(*DockerHubProviderConfig)(nil),                                      // 131: minder.v1.DockerHubProviderConfig
+	(*GHCRProviderConfig)(nil),                                           // 132: minder.v1.GHCRProviderConfig
+	(*Context)(nil),                                                      // 133: minder.v1.Context
+	(*ContextV2)(nil),                                                    // 134: minder.v1.ContextV2
+	(*ListRuleTypesRequest)(nil),                                         // 135: minder.v1.ListRuleTypesRequest
+	(*ListRuleTypesResponse)(nil),                                        // 136: minder.v1.ListRuleTypesResponse
+	(*GetRuleTypeByNameRequest)(nil),                                     // 137: minder.v1.GetRuleTypeByNameRequest
+	(*GetRuleTypeByNameResponse)(nil),                                    // 138: minder.v1.GetRuleTypeByNameResponse
+	(*GetRuleTypeByIdRequest)(nil),                                       // 139: minder.v1.GetRuleTypeByIdRequest
+	(*GetRuleTypeByIdResponse)(nil),                                      // 140: minder.v1.GetRuleTypeByIdResponse
+	(*CreateRuleTypeRequest)(nil),                                        // 141: minder.v1.CreateRuleTypeRequest
+	(*CreateRuleTypeResponse)(nil),                                       // 142: minder.v1.CreateRuleTypeResponse
+	(*UpdateRuleTypeRequest)(nil),                                        // 143: minder.v1.UpdateRuleTypeRequest
+	(*UpdateRuleTypeResponse)(nil),                                       // 144: minder.v1.UpdateRuleTypeResponse
+	(*DeleteRuleTypeRequest)(nil),                                        // 145: minder.v1.DeleteRuleTypeRequest
+	(*DeleteRuleTypeResponse)(nil),                                       // 146: minder.v1.DeleteRuleTypeResponse
+	(*ListEvaluationResultsRequest)(nil),                                 // 147: minder.v1.ListEvaluationResultsRequest
+	(*ListEvaluationResultsResponse)(nil),                                // 148: minder.v1.ListEvaluationResultsResponse
+	(*GenerateComplianceReportRequest)(nil),                              // 149: minder.v1.GenerateComplianceReportRequest
+	(*GenerateComplianceReportResponse)(nil),                             // 150: minder.v1.GenerateComplianceReportResponse
+	(*RestType)(nil),                                                     // 151: minder.v1.RestType
+	(*BuiltinType)(nil),                                                  // 152: minder.v1.BuiltinType
+	(*ArtifactType)(nil),                                                 // 153: minder.v1.ArtifactType
+	(*GitType)(nil),                                                      // 154: minder.v1.GitType
+	(*DiffType)(nil),                                                     // 155: minder.v1.DiffType
+	(*DepsType)(nil),                                                     // 156: minder.v1.DepsType
+	(*SBOMType)(nil),                                                     // 157: minder.v1.SBOMType
+	(*Severity)(nil),                                                     // 158: minder.v1.Severity
+	(*RuleType)(nil),                                                     // 159: minder.v1.RuleType
+	(*Profile)(nil),                                                      // 160: minder.v1.Profile
+	(*ListProjectsRequest)(nil),                                          // 161: minder.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                                         // 162: minder.v1.ListProjectsResponse
+	(*CreateProjectRequest)(nil),                                         // 163: minder.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),                                        // 164: minder.v1.CreateProjectResponse
+	(*DeleteProjectRequest)(nil),                                         // 165: minder.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),                                        // 166: minder.v1.DeleteProjectResponse
+	(*UpdateProjectRequest)(nil),                                         // 167: minder.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),                                        // 168: minder.v1.UpdateProjectResponse
+	(*ProjectPatch)(nil),                                                 // 169: minder.v1.ProjectPatch
+	(*PatchProjectRequest)(nil),                                          // 170: minder.v1.PatchProjectRequest
+	(*PatchProjectResponse)(nil),                                         // 171: minder.v1.PatchProjectResponse
+	(*ListChildProjectsRequest)(nil),                                     // 172: minder.v1.ListChildProjectsRequest
+	(*ListChildProjectsResponse)(nil),                                    // 173: minder.v1.ListChildProjectsResponse
+	(*AlertWebhook)(nil),                                                 // 174: minder.v1.AlertWebhook
+	(*CreateAlertWebhookRequest)(nil),                                    // 175: minder.v1.CreateAlertWebhookRequest
+	(*CreateAlertWebhookResponse)(nil),                                   // 176: minder.v1.CreateAlertWebhookResponse
+	(*ListAlertWebhooksRequest)(nil),                                     // 177: minder.v1.ListAlertWebhooksRequest
+	(*ListAlertWebhooksResponse)(nil),                                    // 178: minder.v1.ListAlertWebhooksResponse
+	(*DeleteAlertWebhookRequest)(nil),                                    // 179: minder.v1.DeleteAlertWebhookRequest
+	(*DeleteAlertWebhookResponse)(nil),                                   // 180: minder.v1.DeleteAlertWebhookResponse
+	(*ProjectSyncSource)(nil),                                            // 181: minder.v1.ProjectSyncSource
+	(*ProjectSyncDrift)(nil),                                             // 182: minder.v1.ProjectSyncDrift
+	(*ProjectSyncStatus)(nil),                                            // 183: minder.v1.ProjectSyncStatus
+	(*SetProjectSyncSourceRequest)(nil),                                  // 184: minder.v1.SetProjectSyncSourceRequest
+	(*SetProjectSyncSourceResponse)(nil),                                 // 185: minder.v1.SetProjectSyncSourceResponse
+	(*GetProjectSyncStatusRequest)(nil),                                  // 186: minder.v1.GetProjectSyncStatusRequest
+	(*GetProjectSyncStatusResponse)(nil),                                 // 187: minder.v1.GetProjectSyncStatusResponse
+	(*DeleteProjectSyncSourceRequest)(nil),                               // 188: minder.v1.DeleteProjectSyncSourceRequest
+	(*DeleteProjectSyncSourceResponse)(nil),                              // 189: minder.v1.DeleteProjectSyncSourceResponse
+	(*MarketplaceBundle)(nil),                                            // 190: minder.v1.MarketplaceBundle
+	(*ListBundlesRequest)(nil),                                           // 191: minder.v1.ListBundlesRequest
+	(*ListBundlesResponse)(nil),                                          // 192: minder.v1.ListBundlesResponse
+	(*SubscribeBundleRequest)(nil),                                       // 193: minder.v1.SubscribeBundleRequest
+	(*SubscribeBundleResponse)(nil),                                      // 194: minder.v1.SubscribeBundleResponse
+	(*BundleResourceChange)(nil),                                         // 195: minder.v1.BundleResourceChange
+	(*PreviewSubscriptionUpgradeRequest)(nil),                            // 196: minder.v1.PreviewSubscriptionUpgradeRequest
+	(*PreviewSubscriptionUpgradeResponse)(nil),                           // 197: minder.v1.PreviewSubscriptionUpgradeResponse
+	(*RollbackBundleSubscriptionRequest)(nil),                            // 198: minder.v1.RollbackBundleSubscriptionRequest
+	(*RollbackBundleSubscriptionResponse)(nil),                           // 199: minder.v1.RollbackBundleSubscriptionResponse
+	(*CreateEntityReconciliationTaskRequest)(nil),                        // 200: minder.v1.CreateEntityReconciliationTaskRequest
+	(*CreateEntityReconciliationTaskResponse)(nil),                       // 201: minder.v1.CreateEntityReconciliationTaskResponse
+	(*ListRolesRequest)(nil),                                             // 202: minder.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),                                            // 203: minder.v1.ListRolesResponse
+	(*ListRoleAssignmentsRequest)(nil),                                   // 204: minder.v1.ListRoleAssignmentsRequest
+	(*ListRoleAssignmentsResponse)(nil),                                  // 205: minder.v1.ListRoleAssignmentsResponse
+	(*AssignRoleRequest)(nil),                                            // 206: minder.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),                                           // 207: minder.v1.AssignRoleResponse
+	(*UpdateRoleRequest)(nil),                                            // 208: minder.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),                                           // 209: minder.v1.UpdateRoleResponse
+	(*RemoveRoleRequest)(nil),                                            // 210: minder.v1.RemoveRoleRequest
+	(*RemoveRoleResponse)(nil),                                           // 211: minder.v1.RemoveRoleResponse
+	(*Role)(nil),                                                         // 212: minder.v1.Role
+	(*RoleAssignment)(nil),                                               // 213: minder.v1.RoleAssignment
+	(*ListInvitationsRequest)(nil),                                       // 214: minder.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),                                      // 215: minder.v1.ListInvitationsResponse
+	(*ResolveInvitationRequest)(nil),                                     // 216: minder.v1.ResolveInvitationRequest
+	(*ResolveInvitationResponse)(nil),                                    // 217: minder.v1.ResolveInvitationResponse
+	(*Invitation)(nil),                                                   // 218: minder.v1.Invitation
+	(*GetProviderRequest)(nil),                                           // 219: minder.v1.GetProviderRequest
+	(*GetProviderResponse)(nil),                                          // 220: minder.v1.GetProviderResponse
+	(*ListProvidersRequest)(nil),                                         // 221: minder.v1.ListProvidersRequest
+	(*ListProvidersResponse)(nil),                                        // 222: minder.v1.ListProvidersResponse
+	(*CreateProviderRequest)(nil),                                        // 223: minder.v1.CreateProviderRequest
+	(*CreateProviderResponse)(nil),                                       // 224: minder.v1.CreateProviderResponse
+	(*DeleteProviderRequest)(nil),                                        // 225: minder.v1.DeleteProviderRequest
+	(*DeleteProviderResponse)(nil),                                       // 226: minder.v1.DeleteProviderResponse
+	(*DeleteProviderByIDRequest)(nil),                                    // 227: minder.v1.DeleteProviderByIDRequest
+	(*DeleteProviderByIDResponse)(nil),                                   // 228: minder.v1.DeleteProviderByIDResponse
+	(*ListProviderClassesRequest)(nil),                                   // 229: minder.v1.ListProviderClassesRequest
+	(*ProviderClassInfo)(nil),                                            // 230: minder.v1.ProviderClassInfo
+	(*ListProviderClassesResponse)(nil),                                  // 231: minder.v1.ListProviderClassesResponse
+	(*PatchProviderRequest)(nil),                                         // 232: minder.v1.PatchProviderRequest
+	(*PatchProviderResponse)(nil),                                        // 233: minder.v1.PatchProviderResponse
+	(*AuthorizationParams)(nil),                                          // 234: minder.v1.AuthorizationParams
+	(*ProviderParameter)(nil),                                            // 235: minder.v1.ProviderParameter
+	(*GitHubAppParams)(nil),                                              // 236: minder.v1.GitHubAppParams
+	(*Provider)(nil),                                                     // 237: minder.v1.Provider
+	(*GetEvaluationHistoryRequest)(nil),                                  // 238: minder.v1.GetEvaluationHistoryRequest
+	(*ListEvaluationHistoryRequest)(nil),                                 // 239: minder.v1.ListEvaluationHistoryRequest
+	(*GetEvaluationHistoryResponse)(nil),                                 // 240: minder.v1.GetEvaluationHistoryResponse
+	(*ListEvaluationHistoryResponse)(nil),                                // 241: minder.v1.ListEvaluationHistoryResponse
+	(*WatchEvaluationResultsRequest)(nil),                                // 242: minder.v1.WatchEvaluationResultsRequest
+	(*WatchEvaluationResultsResponse)(nil),                               // 243: minder.v1.WatchEvaluationResultsResponse
+	(*EvaluationHistory)(nil),                                            // 244: minder.v1.EvaluationHistory
+	(*EvaluationHistoryEntity)(nil),                                      // 245: minder.v1.EvaluationHistoryEntity
+	(*EvaluationHistoryRule)(nil),                                        // 246: minder.v1.EvaluationHistoryRule
+	(*EvaluationHistoryStatus)(nil),                                      // 247: minder.v1.EvaluationHistoryStatus
+	(*EvaluationHistoryRemediation)(nil),                                 // 248: minder.v1.EvaluationHistoryRemediation
+	(*EvaluationHistoryAlert)(nil),                                       // 249: minder.v1.EvaluationHistoryAlert
+	(*EntityInstance)(nil),                                               // 250: minder.v1.EntityInstance
+	(*ListEntitiesRequest)(nil),                                          // 251: minder.v1.ListEntitiesRequest
+	(*ListEntitiesResponse)(nil),                                         // 252: minder.v1.ListEntitiesResponse
+	(*GetEntityByIdRequest)(nil),                                         // 253: minder.v1.GetEntityByIdRequest
+	(*GetEntityByIdResponse)(nil),                                        // 254: minder.v1.GetEntityByIdResponse
+	(*GetEntityByNameRequest)(nil),                                       // 255: minder.v1.GetEntityByNameRequest
+	(*GetEntityByNameResponse)(nil),                                      // 256: minder.v1.GetEntityByNameResponse
+	(*DeleteEntityByIdRequest)(nil),                                      // 257: minder.v1.DeleteEntityByIdRequest
+	(*DeleteEntityByIdResponse)(nil),                                     // 258: minder.v1.DeleteEntityByIdResponse
+	(*RegisterEntityRequest)(nil),                                        // 259: minder.v1.RegisterEntityRequest
+	(*RegisterEntityResponse)(nil),                                       // 260: minder.v1.RegisterEntityResponse
+	(*UpstreamEntityRef)(nil),                                            // 261: minder.v1.UpstreamEntityRef
+	(*DataSource)(nil),                                                   // 262: minder.v1.DataSource
+	(*StructDataSource)(nil),                                             // 263: minder.v1.StructDataSource
+	(*RestDataSource)(nil),                                               // 264: minder.v1.RestDataSource
+	(*GraphQLDataSource)(nil),                                            // 265: minder.v1.GraphQLDataSource
+	(*SQLDataSource)(nil),                                                // 266: minder.v1.SQLDataSource
+	(*DataSourceReference)(nil),                                          // 267: minder.v1.DataSourceReference
+	(*RegisterRepoResult_Status)(nil),                                    // 268: minder.v1.RegisterRepoResult.Status
+	nil,                                                                  // 269: minder.v1.RuleEvaluationStatus.EntityInfoEntry
+	nil,                                                                  // 270: minder.v1.AutoRegistration.EntitiesEntry
+	(*ListEvaluationResultsResponse_EntityProfileEvaluationResults)(nil), // 271: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
+	(*ListEvaluationResultsResponse_EntityEvaluationResults)(nil),        // 272: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
+	(*RestType_Fallback)(nil),                                            // 273: minder.v1.RestType.Fallback
+	(*DiffType_Ecosystem)(nil),                                           // 274: minder.v1.DiffType.Ecosystem
+	(*DepsType_RepoConfigs)(nil),                                         // 275: minder.v1.DepsType.RepoConfigs
+	(*DepsType_PullRequestConfigs)(nil),                                  // 276: minder.v1.DepsType.PullRequestConfigs
+	(*RuleType_Definition)(nil),                                          // 277: minder.v1.RuleType.Definition
+	(*RuleType_Definition_Ingest)(nil),                                   // 278: minder.v1.RuleType.Definition.Ingest
+	(*RuleType_Definition_Eval)(nil),                                     // 279: minder.v1.RuleType.Definition.Eval
+	(*RuleType_Definition_Remediate)(nil),                                // 280: minder.v1.RuleType.Definition.Remediate
+	(*RuleType_Definition_Alert)(nil),                                    // 281: minder.v1.RuleType.Definition.Alert
+	(*RuleType_Definition_Eval_JQComparison)(nil),                        // 282: minder.v1.RuleType.Definition.Eval.JQComparison
+	(*RuleType_Definition_Eval_Rego)(nil),                                // 283: minder.v1.RuleType.Definition.Eval.Rego
+	(*RuleType_Definition_Eval_Vulncheck)(nil),                           // 284: minder.v1.RuleType.Definition.Eval.Vulncheck
+	(*RuleType_Definition_Eval_Trusty)(nil),                              // 285: minder.v1.RuleType.Definition.Eval.Trusty
+	(*RuleType_Definition_Eval_Homoglyphs)(nil),                          // 286: minder.v1.RuleType.Definition.Eval.Homoglyphs
+	(*RuleType_Definition_Eval_CEL)(nil),                                 // 287: minder.v1.RuleType.Definition.Eval.CEL
+	(*RuleType_Definition_Eval_JQComparison_Operator)(nil),               // 288: minder.v1.RuleType.Definition.Eval.JQComparison.Operator
+	(*RuleType_Definition_Remediate_GhBranchProtectionType)(nil),         // 289: minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
+	(*RuleType_Definition_Remediate_PullRequestRemediation)(nil),         // 290: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
+	(*RuleType_Definition_Remediate_PullRequestRemediation_Content)(nil), // 291: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
+	(*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha)(nil), // 292: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
+	(*RuleType_Definition_Alert_AlertTypeSA)(nil),                                          // 293: minder.v1.RuleType.Definition.Alert.AlertTypeSA
+	(*RuleType_Definition_Alert_AlertTypePRComment)(nil),                                   // 294: minder.v1.RuleType.Definition.Alert.AlertTypePRComment
+	(*RuleType_Definition_Alert_AlertTypeWebhook)(nil),                                     // 295: minder.v1.RuleType.Definition.Alert.AlertTypeWebhook
+	(*RuleType_Definition_Alert_AlertTypeIssue)(nil),                                       // 296: minder.v1.RuleType.Definition.Alert.AlertTypeIssue
+	(*Profile_Rule)(nil),                     // 297: minder.v1.Profile.Rule
+	(*Profile_Selector)(nil),                 // 298: minder.v1.Profile.Selector
+	(*Profile_BlackoutWindow)(nil),           // 299: minder.v1.Profile.BlackoutWindow
+	(*Profile_Schedule)(nil),                 // 300: minder.v1.Profile.Schedule
+	nil,                                      // 301: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
+	(*StructDataSource_Def)(nil),             // 302: minder.v1.StructDataSource.Def
+	nil,                                      // 303: minder.v1.StructDataSource.DefEntry
+	(*StructDataSource_Def_Path)(nil),        // 304: minder.v1.StructDataSource.Def.Path
+	(*RestDataSource_Def)(nil),               // 305: minder.v1.RestDataSource.Def
+	nil,                                      // 306: minder.v1.RestDataSource.DefEntry
+	(*RestDataSource_RateLimit)(nil),         // 307: minder.v1.RestDataSource.RateLimit
+	nil,                                      // 308: minder.v1.RestDataSource.Def.HeadersEntry
+	(*RestDataSource_Def_Fallback)(nil),      // 309: minder.v1.RestDataSource.Def.Fallback
+	(*RestDataSource_Def_Cache)(nil),         // 310: minder.v1.RestDataSource.Def.Cache
+	(*GraphQLDataSource_Def)(nil),            // 311: minder.v1.GraphQLDataSource.Def
+	nil,                                      // 312: minder.v1.GraphQLDataSource.DefEntry
+	nil,                                      // 313: minder.v1.GraphQLDataSource.Def.HeadersEntry
+	(*GraphQLDataSource_Def_Pagination)(nil), // 314: minder.v1.GraphQLDataSource.Def.Pagination
+	(*SQLDataSource_Def)(nil),                // 315: minder.v1.SQLDataSource.Def
+	nil,                                      // 316: minder.v1.SQLDataSource.DefEntry
+	(*timestamppb.Timestamp)(nil),            // 317: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                  // 318: google.protobuf.Struct
+	(*fieldmaskpb.FieldMask)(nil),            // 319: google.protobuf.FieldMask
+	(*structpb.Value)(nil),                   // 320: google.protobuf.Value
+	(*descriptorpb.EnumValueOptions)(nil),    // 321: google.protobuf.EnumValueOptions
+	(*descriptorpb.MethodOptions)(nil),       // 322: google.protobuf.MethodOptions
 }
 var file_minder_v1_minder_proto_depIdxs = []int32{
 	2,   // 0: minder.v1.RpcOptions.target_resource:type_name -> minder.v1.TargetResource
 	1,   // 1: minder.v1.RpcOptions.relation:type_name -> minder.v1.Relation
-	11,  // 2: minder.v1.CursorPage.next:type_name -> minder.v1.Cursor
-	11,  // 3: minder.v1.CursorPage.prev:type_name -> minder.v1.Cursor
-	115, // 4: minder.v1.ListArtifactsRequest.context:type_name -> minder.v1.Context
-	17,  // 5: minder.v1.ListArtifactsResponse.results:type_name -> minder.v1.Artifact
-	18,  // 6: minder.v1.Artifact.versions:type_name -> minder.v1.ArtifactVersion
-	253, // 7: minder.v1.Artifact.created_at:type_name -> google.protobuf.Timestamp
-	115, // 8: minder.v1.Artifact.context:type_name -> minder.v1.Context
-	253, // 9: minder.v1.ArtifactVersion.created_at:type_name -> google.protobuf.Timestamp
-	115, // 10: minder.v1.GetArtifactByIdRequest.context:type_name -> minder.v1.Context
-	17,  // 11: minder.v1.GetArtifactByIdResponse.artifact:type_name -> minder.v1.Artifact
-	18,  // 12: minder.v1.GetArtifactByIdResponse.versions:type_name -> minder.v1.ArtifactVersion
-	115, // 13: minder.v1.GetArtifactByNameRequest.context:type_name -> minder.v1.Context
-	17,  // 14: minder.v1.GetArtifactByNameResponse.artifact:type_name -> minder.v1.Artifact
-	18,  // 15: minder.v1.GetArtifactByNameResponse.versions:type_name -> minder.v1.ArtifactVersion
-	253, // 16: minder.v1.GetInviteDetailsResponse.expires_at:type_name -> google.protobuf.Timestamp
-	115, // 17: minder.v1.GetAuthorizationURLRequest.context:type_name -> minder.v1.Context
-	254, // 18: minder.v1.GetAuthorizationURLRequest.config:type_name -> google.protobuf.Struct
-	115, // 19: minder.v1.StoreProviderTokenRequest.context:type_name -> minder.v1.Context
-	253, // 20: minder.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	253, // 21: minder.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	115, // 22: minder.v1.ListRemoteRepositoriesFromProviderRequest.context:type_name -> minder.v1.Context
-	39,  // 23: minder.v1.ListRemoteRepositoriesFromProviderResponse.results:type_name -> minder.v1.UpstreamRepositoryRef
-	38,  // 24: minder.v1.ListRemoteRepositoriesFromProviderResponse.entities:type_name -> minder.v1.RegistrableUpstreamEntityRef
-	212, // 25: minder.v1.RegistrableUpstreamEntityRef.entity:type_name -> minder.v1.UpstreamEntityRef
-	115, // 26: minder.v1.UpstreamRepositoryRef.context:type_name -> minder.v1.Context
-	115, // 27: minder.v1.Repository.context:type_name -> minder.v1.Context
-	253, // 28: minder.v1.Repository.created_at:type_name -> google.protobuf.Timestamp
-	253, // 29: minder.v1.Repository.updated_at:type_name -> google.protobuf.Timestamp
-	254, // 30: minder.v1.Repository.properties:type_name -> google.protobuf.Struct
-	39,  // 31: minder.v1.RegisterRepositoryRequest.repository:type_name -> minder.v1.UpstreamRepositoryRef
-	115, // 32: minder.v1.RegisterRepositoryRequest.context:type_name -> minder.v1.Context
-	212, // 33: minder.v1.RegisterRepositoryRequest.entity:type_name -> minder.v1.UpstreamEntityRef
-	40,  // 34: minder.v1.RegisterRepoResult.repository:type_name -> minder.v1.Repository
-	217, // 35: minder.v1.RegisterRepoResult.status:type_name -> minder.v1.RegisterRepoResult.Status
-	42,  // 36: minder.v1.RegisterRepositoryResponse.result:type_name -> minder.v1.RegisterRepoResult
-	115, // 37: minder.v1.GetRepositoryByIdRequest.context:type_name -> minder.v1.Context
-	40,  // 38: minder.v1.GetRepositoryByIdResponse.repository:type_name -> minder.v1.Repository
-	115, // 39: minder.v1.DeleteRepositoryByIdRequest.context:type_name -> minder.v1.Context
-	115, // 40: minder.v1.GetRepositoryByNameRequest.context:type_name -> minder.v1.Context
-	40,  // 41: minder.v1.GetRepositoryByNameResponse.repository:type_name -> minder.v1.Repository
-	115, // 42: minder.v1.DeleteRepositoryByNameRequest.context:type_name -> minder.v1.Context
-	115, // 43: minder.v1.ListRepositoriesRequest.context:type_name -> minder.v1.Context
-	40,  // 44: minder.v1.ListRepositoriesResponse.results:type_name -> minder.v1.Repository
-	115, // 45: minder.v1.ReconcileEntityRegistrationRequest.context:type_name -> minder.v1.Context
-	253, // 46: minder.v1.VerifyProviderTokenFromRequest.timestamp:type_name -> google.protobuf.Timestamp
-	115, // 47: minder.v1.VerifyProviderTokenFromRequest.context:type_name -> minder.v1.Context
-	115, // 48: minder.v1.VerifyProviderCredentialRequest.context:type_name -> minder.v1.Context
-	253, // 49: minder.v1.CreateUserResponse.created_at:type_name -> google.protobuf.Timestamp
-	115, // 50: minder.v1.CreateUserResponse.context:type_name -> minder.v1.Context
-	253, // 51: minder.v1.UserRecord.created_at:type_name -> google.protobuf.Timestamp
-	253, // 52: minder.v1.UserRecord.updated_at:type_name -> google.protobuf.Timestamp
-	165, // 53: minder.v1.ProjectRole.role:type_name -> minder.v1.Role
-	35,  // 54: minder.v1.ProjectRole.project:type_name -> minder.v1.Project
-	64,  // 55: minder.v1.GetUserResponse.user:type_name -> minder.v1.UserRecord
-	35,  // 56: minder.v1.GetUserResponse.projects:type_name -> minder.v1.Project
-	65,  // 57: minder.v1.GetUserResponse.project_roles:type_name -> minder.v1.ProjectRole
-	213, // 58: minder.v1.CreateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
-	213, // 59: minder.v1.CreateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
-	116, // 60: minder.v1.GetDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
-	213, // 61: minder.v1.GetDataSourceByIdResponse.data_source:type_name -> minder.v1.DataSource
-	116, // 62: minder.v1.GetDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
-	213, // 63: minder.v1.GetDataSourceByNameResponse.data_source:type_name -> minder.v1.DataSource
-	116, // 64: minder.v1.ListDataSourcesRequest.context:type_name -> minder.v1.ContextV2
-	213, // 65: minder.v1.ListDataSourcesResponse.data_sources:type_name -> minder.v1.DataSource
-	213, // 66: minder.v1.UpdateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
-	213, // 67: minder.v1.UpdateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
-	116, // 68: minder.v1.DeleteDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
-	116, // 69: minder.v1.DeleteDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
-	139, // 70: minder.v1.CreateProfileRequest.profile:type_name -> minder.v1.Profile
-	139, // 71: minder.v1.CreateProfileResponse.profile:type_name -> minder.v1.Profile
-	139, // 72: minder.v1.UpdateProfileRequest.profile:type_name -> minder.v1.Profile
-	139, // 73: minder.v1.UpdateProfileResponse.profile:type_name -> minder.v1.Profile
-	115, // 74: minder.v1.PatchProfileRequest.context:type_name -> minder.v1.Context
-	139, // 75: minder.v1.PatchProfileRequest.patch:type_name -> minder.v1.Profile
-	255, // 76: minder.v1.PatchProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
-	139, // 77: minder.v1.PatchProfileResponse.profile:type_name -> minder.v1.Profile
-	115, // 78: minder.v1.DeleteProfileRequest.context:type_name -> minder.v1.Context
-	115, // 79: minder.v1.ListProfilesRequest.context:type_name -> minder.v1.Context
-	139, // 80: minder.v1.ListProfilesResponse.profiles:type_name -> minder.v1.Profile
-	115, // 81: minder.v1.GetProfileByIdRequest.context:type_name -> minder.v1.Context
-	139, // 82: minder.v1.GetProfileByIdResponse.profile:type_name -> minder.v1.Profile
-	115, // 83: minder.v1.GetProfileByNameRequest.context:type_name -> minder.v1.Context
-	139, // 84: minder.v1.GetProfileByNameResponse.profile:type_name -> minder.v1.Profile
-	253, // 85: minder.v1.ProfileStatus.last_updated:type_name -> google.protobuf.Timestamp
-	253, // 86: minder.v1.EvalResultAlert.last_updated:type_name -> google.protobuf.Timestamp
-	253, // 87: minder.v1.RuleEvaluationStatus.last_updated:type_name -> google.protobuf.Timestamp
-	218, // 88: minder.v1.RuleEvaluationStatus.entity_info:type_name -> minder.v1.RuleEvaluationStatus.EntityInfoEntry
-	253, // 89: minder.v1.RuleEvaluationStatus.remediation_last_updated:type_name -> google.protobuf.Timestamp
-	97,  // 90: minder.v1.RuleEvaluationStatus.alert:type_name -> minder.v1.EvalResultAlert
-	137, // 91: minder.v1.RuleEvaluationStatus.severity:type_name -> minder.v1.Severity
-	4,   // 92: minder.v1.RuleEvaluationStatus.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
-	256, // 93: minder.v1.RuleEvaluationStatus.output:type_name -> google.protobuf.Value
-	3,   // 94: minder.v1.EntityTypedId.type:type_name -> minder.v1.Entity
-	115, // 95: minder.v1.GetProfileStatusByNameRequest.context:type_name -> minder.v1.Context
-	99,  // 96: minder.v1.GetProfileStatusByNameRequest.entity:type_name -> minder.v1.EntityTypedId
-	96,  // 97: minder.v1.GetProfileStatusByNameResponse.profile_status:type_name -> minder.v1.ProfileStatus
-	98,  // 98: minder.v1.GetProfileStatusByNameResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
-	115, // 99: minder.v1.GetProfileStatusByIdRequest.context:type_name -> minder.v1.Context
-	99,  // 100: minder.v1.GetProfileStatusByIdRequest.entity:type_name -> minder.v1.EntityTypedId
-	96,  // 101: minder.v1.GetProfileStatusByIdResponse.profile_status:type_name -> minder.v1.ProfileStatus
-	98,  // 102: minder.v1.GetProfileStatusByIdResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
-	115, // 103: minder.v1.GetProfileStatusByProjectRequest.context:type_name -> minder.v1.Context
-	96,  // 104: minder.v1.GetProfileStatusByProjectResponse.profile_status:type_name -> minder.v1.ProfileStatus
-	219, // 105: minder.v1.AutoRegistration.entities:type_name -> minder.v1.AutoRegistration.EntitiesEntry
-	107, // 106: minder.v1.ProviderConfig.auto_registration:type_name -> minder.v1.AutoRegistration
-	115, // 107: minder.v1.ListRuleTypesRequest.context:type_name -> minder.v1.Context
-	138, // 108: minder.v1.ListRuleTypesResponse.rule_types:type_name -> minder.v1.RuleType
-	115, // 109: minder.v1.GetRuleTypeByNameRequest.context:type_name -> minder.v1.Context
-	138, // 110: minder.v1.GetRuleTypeByNameResponse.rule_type:type_name -> minder.v1.RuleType
-	115, // 111: minder.v1.GetRuleTypeByIdRequest.context:type_name -> minder.v1.Context
-	138, // 112: minder.v1.GetRuleTypeByIdResponse.rule_type:type_name -> minder.v1.RuleType
-	138, // 113: minder.v1.CreateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
-	138, // 114: minder.v1.CreateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
-	138, // 115: minder.v1.UpdateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
-	138, // 116: minder.v1.UpdateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
-	115, // 117: minder.v1.DeleteRuleTypeRequest.context:type_name -> minder.v1.Context
-	115, // 118: minder.v1.ListEvaluationResultsRequest.context:type_name -> minder.v1.Context
-	99,  // 119: minder.v1.ListEvaluationResultsRequest.entity:type_name -> minder.v1.EntityTypedId
-	221, // 120: minder.v1.ListEvaluationResultsResponse.entities:type_name -> minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
-	222, // 121: minder.v1.RestType.fallback:type_name -> minder.v1.RestType.Fallback
-	223, // 122: minder.v1.DiffType.ecosystems:type_name -> minder.v1.DiffType.Ecosystem
-	224, // 123: minder.v1.DepsType.repo:type_name -> minder.v1.DepsType.RepoConfigs
-	225, // 124: minder.v1.DepsType.pr:type_name -> minder.v1.DepsType.PullRequestConfigs
-	9,   // 125: minder.v1.Severity.value:type_name -> minder.v1.Severity.Value
-	115, // 126: minder.v1.RuleType.context:type_name -> minder.v1.Context
-	226, // 127: minder.v1.RuleType.def:type_name -> minder.v1.RuleType.Definition
-	137, // 128: minder.v1.RuleType.severity:type_name -> minder.v1.Severity
-	4,   // 129: minder.v1.RuleType.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
-	115, // 130: minder.v1.Profile.context:type_name -> minder.v1.Context
-	243, // 131: minder.v1.Profile.repository:type_name -> minder.v1.Profile.Rule
-	243, // 132: minder.v1.Profile.build_environment:type_name -> minder.v1.Profile.Rule
-	243, // 133: minder.v1.Profile.artifact:type_name -> minder.v1.Profile.Rule
-	243, // 134: minder.v1.Profile.pull_request:type_name -> minder.v1.Profile.Rule
-	243, // 135: minder.v1.Profile.release:type_name -> minder.v1.Profile.Rule
-	243, // 136: minder.v1.Profile.pipeline_run:type_name -> minder.v1.Profile.Rule
-	243, // 137: minder.v1.Profile.task_run:type_name -> minder.v1.Profile.Rule
-	243, // 138: minder.v1.Profile.build:type_name -> minder.v1.Profile.Rule
-	244, // 139: minder.v1.Profile.selection:type_name -> minder.v1.Profile.Selector
-	35,  // 140: minder.v1.ListProjectsResponse.projects:type_name -> minder.v1.Project
-	115, // 141: minder.v1.CreateProjectRequest.context:type_name -> minder.v1.Context
-	35,  // 142: minder.v1.CreateProjectResponse.project:type_name -> minder.v1.Project
-	115, // 143: minder.v1.DeleteProjectRequest.context:type_name -> minder.v1.Context
-	115, // 144: minder.v1.UpdateProjectRequest.context:type_name -> minder.v1.Context
-	35,  // 145: minder.v1.UpdateProjectResponse.project:type_name -> minder.v1.Project
-	115, // 146: minder.v1.PatchProjectRequest.context:type_name -> minder.v1.Context
-	148, // 147: minder.v1.PatchProjectRequest.patch:type_name -> minder.v1.ProjectPatch
-	255, // 148: minder.v1.PatchProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35,  // 149: minder.v1.PatchProjectResponse.project:type_name -> minder.v1.Project
-	116, // 150: minder.v1.ListChildProjectsRequest.context:type_name -> minder.v1.ContextV2
-	35,  // 151: minder.v1.ListChildProjectsResponse.projects:type_name -> minder.v1.Project
-	99,  // 152: minder.v1.CreateEntityReconciliationTaskRequest.entity:type_name -> minder.v1.EntityTypedId
-	115, // 153: minder.v1.CreateEntityReconciliationTaskRequest.context:type_name -> minder.v1.Context
-	115, // 154: minder.v1.ListRolesRequest.context:type_name -> minder.v1.Context
-	165, // 155: minder.v1.ListRolesResponse.roles:type_name -> minder.v1.Role
-	115, // 156: minder.v1.ListRoleAssignmentsRequest.context:type_name -> minder.v1.Context
-	166, // 157: minder.v1.ListRoleAssignmentsResponse.role_assignments:type_name -> minder.v1.RoleAssignment
-	171, // 158: minder.v1.ListRoleAssignmentsResponse.invitations:type_name -> minder.v1.Invitation
-	115, // 159: minder.v1.AssignRoleRequest.context:type_name -> minder.v1.Context
-	166, // 160: minder.v1.AssignRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
-	166, // 161: minder.v1.AssignRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
-	171, // 162: minder.v1.AssignRoleResponse.invitation:type_name -> minder.v1.Invitation
-	115, // 163: minder.v1.UpdateRoleRequest.context:type_name -> minder.v1.Context
-	166, // 164: minder.v1.UpdateRoleResponse.role_assignments:type_name -> minder.v1.RoleAssignment
-	171, // 165: minder.v1.UpdateRoleResponse.invitations:type_name -> minder.v1.Invitation
-	115, // 166: minder.v1.RemoveRoleRequest.context:type_name -> minder.v1.Context
-	166, // 167: minder.v1.RemoveRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
-	166, // 168: minder.v1.RemoveRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
-	171, // 169: minder.v1.RemoveRoleResponse.invitation:type_name -> minder.v1.Invitation
-	171, // 170: minder.v1.ListInvitationsResponse.invitations:type_name -> minder.v1.Invitation
-	253, // 171: minder.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	253, // 172: minder.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
-	115, // 173: minder.v1.GetProviderRequest.context:type_name -> minder.v1.Context
-	190, // 174: minder.v1.GetProviderResponse.provider:type_name -> minder.v1.Provider
-	115, // 175: minder.v1.ListProvidersRequest.context:type_name -> minder.v1.Context
-	190, // 176: minder.v1.ListProvidersResponse.providers:type_name -> minder.v1.Provider
-	115, // 177: minder.v1.CreateProviderRequest.context:type_name -> minder.v1.Context
-	190, // 178: minder.v1.CreateProviderRequest.provider:type_name -> minder.v1.Provider
-	190, // 179: minder.v1.CreateProviderResponse.provider:type_name -> minder.v1.Provider
-	187, // 180: minder.v1.CreateProviderResponse.authorization:type_name -> minder.v1.AuthorizationParams
-	115, // 181: minder.v1.DeleteProviderRequest.context:type_name -> minder.v1.Context
-	115, // 182: minder.v1.DeleteProviderByIDRequest.context:type_name -> minder.v1.Context
-	115, // 183: minder.v1.ListProviderClassesRequest.context:type_name -> minder.v1.Context
-	5,   // 184: minder.v1.ProviderClassInfo.supported_provider_types:type_name -> minder.v1.ProviderType
-	7,   // 185: minder.v1.ProviderClassInfo.supported_auth_flows:type_name -> minder.v1.AuthorizationFlow
-	3,   // 186: minder.v1.ProviderClassInfo.supported_entities:type_name -> minder.v1.Entity
-	183, // 187: minder.v1.ListProviderClassesResponse.provider_class_infos:type_name -> minder.v1.ProviderClassInfo
-	115, // 188: minder.v1.PatchProviderRequest.context:type_name -> minder.v1.Context
-	190, // 189: minder.v1.PatchProviderRequest.patch:type_name -> minder.v1.Provider
-	255, // 190: minder.v1.PatchProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
-	190, // 191: minder.v1.PatchProviderResponse.provider:type_name -> minder.v1.Provider
-	189, // 192: minder.v1.ProviderParameter.github_app:type_name -> minder.v1.GitHubAppParams
-	5,   // 193: minder.v1.Provider.implements:type_name -> minder.v1.ProviderType
-	254, // 194: minder.v1.Provider.config:type_name -> google.protobuf.Struct
-	7,   // 195: minder.v1.Provider.auth_flows:type_name -> minder.v1.AuthorizationFlow
-	188, // 196: minder.v1.Provider.parameters:type_name -> minder.v1.ProviderParameter
-	115, // 197: minder.v1.GetEvaluationHistoryRequest.context:type_name -> minder.v1.Context
-	115, // 198: minder.v1.ListEvaluationHistoryRequest.context:type_name -> minder.v1.Context
-	253, // 199: minder.v1.ListEvaluationHistoryRequest.from:type_name -> google.protobuf.Timestamp
-	253, // 200: minder.v1.ListEvaluationHistoryRequest.to:type_name -> google.protobuf.Timestamp
-	11,  // 201: minder.v1.ListEvaluationHistoryRequest.cursor:type_name -> minder.v1.Cursor
-	195, // 202: minder.v1.GetEvaluationHistoryResponse.evaluation:type_name -> minder.v1.EvaluationHistory
-	195, // 203: minder.v1.ListEvaluationHistoryResponse.data:type_name -> minder.v1.EvaluationHistory
-	12,  // 204: minder.v1.ListEvaluationHistoryResponse.page:type_name -> minder.v1.CursorPage
-	196, // 205: minder.v1.EvaluationHistory.entity:type_name -> minder.v1.EvaluationHistoryEntity
-	197, // 206: minder.v1.EvaluationHistory.rule:type_name -> minder.v1.EvaluationHistoryRule
-	198, // 207: minder.v1.EvaluationHistory.status:type_name -> minder.v1.EvaluationHistoryStatus
-	200, // 208: minder.v1.EvaluationHistory.alert:type_name -> minder.v1.EvaluationHistoryAlert
-	199, // 209: minder.v1.EvaluationHistory.remediation:type_name -> minder.v1.EvaluationHistoryRemediation
-	253, // 210: minder.v1.EvaluationHistory.evaluated_at:type_name -> google.protobuf.Timestamp
-	3,   // 211: minder.v1.EvaluationHistoryEntity.type:type_name -> minder.v1.Entity
-	137, // 212: minder.v1.EvaluationHistoryRule.severity:type_name -> minder.v1.Severity
-	256, // 213: minder.v1.EvaluationHistoryStatus.output:type_name -> google.protobuf.Value
-	116, // 214: minder.v1.EntityInstance.context:type_name -> minder.v1.ContextV2
-	3,   // 215: minder.v1.EntityInstance.type:type_name -> minder.v1.Entity
-	254, // 216: minder.v1.EntityInstance.properties:type_name -> google.protobuf.Struct
-	116, // 217: minder.v1.ListEntitiesRequest.context:type_name -> minder.v1.ContextV2
-	3,   // 218: minder.v1.ListEntitiesRequest.entity_type:type_name -> minder.v1.Entity
-	11,  // 219: minder.v1.ListEntitiesRequest.cursor:type_name -> minder.v1.Cursor
-	201, // 220: minder.v1.ListEntitiesResponse.results:type_name -> minder.v1.EntityInstance
-	12,  // 221: minder.v1.ListEntitiesResponse.page:type_name -> minder.v1.CursorPage
-	116, // 222: minder.v1.GetEntityByIdRequest.context:type_name -> minder.v1.ContextV2
-	201, // 223: minder.v1.GetEntityByIdResponse.entity:type_name -> minder.v1.EntityInstance
-	116, // 224: minder.v1.GetEntityByNameRequest.context:type_name -> minder.v1.ContextV2
-	3,   // 225: minder.v1.GetEntityByNameRequest.entity_type:type_name -> minder.v1.Entity
-	201, // 226: minder.v1.GetEntityByNameResponse.entity:type_name -> minder.v1.EntityInstance
-	116, // 227: minder.v1.DeleteEntityByIdRequest.context:type_name -> minder.v1.ContextV2
-	116, // 228: minder.v1.RegisterEntityRequest.context:type_name -> minder.v1.ContextV2
-	3,   // 229: minder.v1.RegisterEntityRequest.entity_type:type_name -> minder.v1.Entity
-	245, // 230: minder.v1.RegisterEntityRequest.identifying_properties:type_name -> minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
-	201, // 231: minder.v1.RegisterEntityResponse.entity:type_name -> minder.v1.EntityInstance
-	116, // 232: minder.v1.UpstreamEntityRef.context:type_name -> minder.v1.ContextV2
-	3,   // 233: minder.v1.UpstreamEntityRef.type:type_name -> minder.v1.Entity
-	254, // 234: minder.v1.UpstreamEntityRef.properties:type_name -> google.protobuf.Struct
-	116, // 235: minder.v1.DataSource.context:type_name -> minder.v1.ContextV2
-	214, // 236: minder.v1.DataSource.structured:type_name -> minder.v1.StructDataSource
-	215, // 237: minder.v1.DataSource.rest:type_name -> minder.v1.RestDataSource
-	247, // 238: minder.v1.StructDataSource.def:type_name -> minder.v1.StructDataSource.DefEntry
-	250, // 239: minder.v1.RestDataSource.def:type_name -> minder.v1.RestDataSource.DefEntry
-	106, // 240: minder.v1.AutoRegistration.EntitiesEntry.value:type_name -> minder.v1.EntityAutoRegistrationConfig
-	96,  // 241: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.profile_status:type_name -> minder.v1.ProfileStatus
-	98,  // 242: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.results:type_name -> minder.v1.RuleEvaluationStatus
-	99,  // 243: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.entity:type_name -> minder.v1.EntityTypedId
-	220, // 244: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.profiles:type_name -> minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
-	254, // 245: minder.v1.RuleType.Definition.rule_schema:type_name -> google.protobuf.Struct
-	254, // 246: minder.v1.RuleType.Definition.param_schema:type_name -> google.protobuf.Struct
-	227, // 247: minder.v1.RuleType.Definition.ingest:type_name -> minder.v1.RuleType.Definition.Ingest
-	228, // 248: minder.v1.RuleType.Definition.eval:type_name -> minder.v1.RuleType.Definition.Eval
-	229, // 249: minder.v1.RuleType.Definition.remediate:type_name -> minder.v1.RuleType.Definition.Remediate
-	230, // 250: minder.v1.RuleType.Definition.alert:type_name -> minder.v1.RuleType.Definition.Alert
-	131, // 251: minder.v1.RuleType.Definition.Ingest.rest:type_name -> minder.v1.RestType
-	132, // 252: minder.v1.RuleType.Definition.Ingest.builtin:type_name -> minder.v1.BuiltinType
-	133, // 253: minder.v1.RuleType.Definition.Ingest.artifact:type_name -> minder.v1.ArtifactType
-	134, // 254: minder.v1.RuleType.Definition.Ingest.git:type_name -> minder.v1.GitType
-	135, // 255: minder.v1.RuleType.Definition.Ingest.diff:type_name -> minder.v1.DiffType
-	136, // 256: minder.v1.RuleType.Definition.Ingest.deps:type_name -> minder.v1.DepsType
-	231, // 257: minder.v1.RuleType.Definition.Eval.jq:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison
-	232, // 258: minder.v1.RuleType.Definition.Eval.rego:type_name -> minder.v1.RuleType.Definition.Eval.Rego
-	233, // 259: minder.v1.RuleType.Definition.Eval.vulncheck:type_name -> minder.v1.RuleType.Definition.Eval.Vulncheck
-	234, // 260: minder.v1.RuleType.Definition.Eval.trusty:type_name -> minder.v1.RuleType.Definition.Eval.Trusty
-	235, // 261: minder.v1.RuleType.Definition.Eval.homoglyphs:type_name -> minder.v1.RuleType.Definition.Eval.Homoglyphs
-	216, // 262: minder.v1.RuleType.Definition.Eval.data_sources:type_name -> minder.v1.DataSourceReference
-	131, // 263: minder.v1.RuleType.Definition.Remediate.rest:type_name -> minder.v1.RestType
-	237, // 264: minder.v1.RuleType.Definition.Remediate.gh_branch_protection:type_name -> minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
-	238, // 265: minder.v1.RuleType.Definition.Remediate.pull_request:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
-	242, // 266: minder.v1.RuleType.Definition.Remediate.pull_request_comment:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypePRComment
-	241, // 267: minder.v1.RuleType.Definition.Alert.security_advisory:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypeSA
-	242, // 268: minder.v1.RuleType.Definition.Alert.pull_request_comment:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypePRComment
-	236, // 269: minder.v1.RuleType.Definition.Eval.JQComparison.ingested:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
-	236, // 270: minder.v1.RuleType.Definition.Eval.JQComparison.profile:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
-	256, // 271: minder.v1.RuleType.Definition.Eval.JQComparison.constant:type_name -> google.protobuf.Value
-	239, // 272: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.contents:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
-	254, // 273: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.params:type_name -> google.protobuf.Struct
-	240, // 274: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.actions_replace_tags_with_sha:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
-	254, // 275: minder.v1.Profile.Rule.params:type_name -> google.protobuf.Struct
-	254, // 276: minder.v1.Profile.Rule.def:type_name -> google.protobuf.Struct
-	256, // 277: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry.value:type_name -> google.protobuf.Value
-	248, // 278: minder.v1.StructDataSource.Def.path:type_name -> minder.v1.StructDataSource.Def.Path
-	246, // 279: minder.v1.StructDataSource.DefEntry.value:type_name -> minder.v1.StructDataSource.Def
-	251, // 280: minder.v1.RestDataSource.Def.headers:type_name -> minder.v1.RestDataSource.Def.HeadersEntry
-	254, // 281: minder.v1.RestDataSource.Def.bodyobj:type_name -> google.protobuf.Struct
-	252, // 282: minder.v1.RestDataSource.Def.fallback:type_name -> minder.v1.RestDataSource.Def.Fallback
-	254, // 283: minder.v1.RestDataSource.Def.input_schema:type_name -> google.protobuf.Struct
-	249, // 284: minder.v1.RestDataSource.DefEntry.value:type_name -> minder.v1.RestDataSource.Def
-	257, // 285: minder.v1.name:extendee -> google.protobuf.EnumValueOptions
-	258, // 286: minder.v1.rpc_options:extendee -> google.protobuf.MethodOptions
-	10,  // 287: minder.v1.rpc_options:type_name -> minder.v1.RpcOptions
-	29,  // 288: minder.v1.HealthService.CheckHealth:input_type -> minder.v1.CheckHealthRequest
-	13,  // 289: minder.v1.HealthService.GetVersion:input_type -> minder.v1.GetVersionRequest
-	15,  // 290: minder.v1.ArtifactService.ListArtifacts:input_type -> minder.v1.ListArtifactsRequest
-	19,  // 291: minder.v1.ArtifactService.GetArtifactById:input_type -> minder.v1.GetArtifactByIdRequest
-	21,  // 292: minder.v1.ArtifactService.GetArtifactByName:input_type -> minder.v1.GetArtifactByNameRequest
-	31,  // 293: minder.v1.OAuthService.GetAuthorizationURL:input_type -> minder.v1.GetAuthorizationURLRequest
-	33,  // 294: minder.v1.OAuthService.StoreProviderToken:input_type -> minder.v1.StoreProviderTokenRequest
-	56,  // 295: minder.v1.OAuthService.VerifyProviderTokenFrom:input_type -> minder.v1.VerifyProviderTokenFromRequest
-	58,  // 296: minder.v1.OAuthService.VerifyProviderCredential:input_type -> minder.v1.VerifyProviderCredentialRequest
-	41,  // 297: minder.v1.RepositoryService.RegisterRepository:input_type -> minder.v1.RegisterRepositoryRequest
-	36,  // 298: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:input_type -> minder.v1.ListRemoteRepositoriesFromProviderRequest
-	52,  // 299: minder.v1.RepositoryService.ListRepositories:input_type -> minder.v1.ListRepositoriesRequest
-	44,  // 300: minder.v1.RepositoryService.GetRepositoryById:input_type -> minder.v1.GetRepositoryByIdRequest
-	48,  // 301: minder.v1.RepositoryService.GetRepositoryByName:input_type -> minder.v1.GetRepositoryByNameRequest
-	46,  // 302: minder.v1.RepositoryService.DeleteRepositoryById:input_type -> minder.v1.DeleteRepositoryByIdRequest
-	50,  // 303: minder.v1.RepositoryService.DeleteRepositoryByName:input_type -> minder.v1.DeleteRepositoryByNameRequest
-	60,  // 304: minder.v1.UserService.CreateUser:input_type -> minder.v1.CreateUserRequest
-	62,  // 305: minder.v1.UserService.DeleteUser:input_type -> minder.v1.DeleteUserRequest
-	66,  // 306: minder.v1.UserService.GetUser:input_type -> minder.v1.GetUserRequest
-	167, // 307: minder.v1.UserService.ListInvitations:input_type -> minder.v1.ListInvitationsRequest
-	169, // 308: minder.v1.UserService.ResolveInvitation:input_type -> minder.v1.ResolveInvitationRequest
-	82,  // 309: minder.v1.ProfileService.CreateProfile:input_type -> minder.v1.CreateProfileRequest
-	84,  // 310: minder.v1.ProfileService.UpdateProfile:input_type -> minder.v1.UpdateProfileRequest
-	86,  // 311: minder.v1.ProfileService.PatchProfile:input_type -> minder.v1.PatchProfileRequest
-	88,  // 312: minder.v1.ProfileService.DeleteProfile:input_type -> minder.v1.DeleteProfileRequest
-	90,  // 313: minder.v1.ProfileService.ListProfiles:input_type -> minder.v1.ListProfilesRequest
-	92,  // 314: minder.v1.ProfileService.GetProfileById:input_type -> minder.v1.GetProfileByIdRequest
-	94,  // 315: minder.v1.ProfileService.GetProfileByName:input_type -> minder.v1.GetProfileByNameRequest
-	100, // 316: minder.v1.ProfileService.GetProfileStatusByName:input_type -> minder.v1.GetProfileStatusByNameRequest
-	102, // 317: minder.v1.ProfileService.GetProfileStatusById:input_type -> minder.v1.GetProfileStatusByIdRequest
-	104, // 318: minder.v1.ProfileService.GetProfileStatusByProject:input_type -> minder.v1.GetProfileStatusByProjectRequest
-	68,  // 319: minder.v1.DataSourceService.CreateDataSource:input_type -> minder.v1.CreateDataSourceRequest
-	70,  // 320: minder.v1.DataSourceService.GetDataSourceById:input_type -> minder.v1.GetDataSourceByIdRequest
-	72,  // 321: minder.v1.DataSourceService.GetDataSourceByName:input_type -> minder.v1.GetDataSourceByNameRequest
-	74,  // 322: minder.v1.DataSourceService.ListDataSources:input_type -> minder.v1.ListDataSourcesRequest
-	76,  // 323: minder.v1.DataSourceService.UpdateDataSource:input_type -> minder.v1.UpdateDataSourceRequest
-	78,  // 324: minder.v1.DataSourceService.DeleteDataSourceById:input_type -> minder.v1.DeleteDataSourceByIdRequest
-	80,  // 325: minder.v1.DataSourceService.DeleteDataSourceByName:input_type -> minder.v1.DeleteDataSourceByNameRequest
-	117, // 326: minder.v1.RuleTypeService.ListRuleTypes:input_type -> minder.v1.ListRuleTypesRequest
-	119, // 327: minder.v1.RuleTypeService.GetRuleTypeByName:input_type -> minder.v1.GetRuleTypeByNameRequest
-	121, // 328: minder.v1.RuleTypeService.GetRuleTypeById:input_type -> minder.v1.GetRuleTypeByIdRequest
-	123, // 329: minder.v1.RuleTypeService.CreateRuleType:input_type -> minder.v1.CreateRuleTypeRequest
-	125, // 330: minder.v1.RuleTypeService.UpdateRuleType:input_type -> minder.v1.UpdateRuleTypeRequest
-	127, // 331: minder.v1.RuleTypeService.DeleteRuleType:input_type -> minder.v1.DeleteRuleTypeRequest
-	129, // 332: minder.v1.EvalResultsService.ListEvaluationResults:input_type -> minder.v1.ListEvaluationResultsRequest
-	192, // 333: minder.v1.EvalResultsService.ListEvaluationHistory:input_type -> minder.v1.ListEvaluationHistoryRequest
-	191, // 334: minder.v1.EvalResultsService.GetEvaluationHistory:input_type -> minder.v1.GetEvaluationHistoryRequest
-	155, // 335: minder.v1.PermissionsService.ListRoles:input_type -> minder.v1.ListRolesRequest
-	157, // 336: minder.v1.PermissionsService.ListRoleAssignments:input_type -> minder.v1.ListRoleAssignmentsRequest
-	159, // 337: minder.v1.PermissionsService.AssignRole:input_type -> minder.v1.AssignRoleRequest
-	161, // 338: minder.v1.PermissionsService.UpdateRole:input_type -> minder.v1.UpdateRoleRequest
-	163, // 339: minder.v1.PermissionsService.RemoveRole:input_type -> minder.v1.RemoveRoleRequest
-	140, // 340: minder.v1.ProjectsService.ListProjects:input_type -> minder.v1.ListProjectsRequest
-	142, // 341: minder.v1.ProjectsService.CreateProject:input_type -> minder.v1.CreateProjectRequest
-	151, // 342: minder.v1.ProjectsService.ListChildProjects:input_type -> minder.v1.ListChildProjectsRequest
-	144, // 343: minder.v1.ProjectsService.DeleteProject:input_type -> minder.v1.DeleteProjectRequest
-	146, // 344: minder.v1.ProjectsService.UpdateProject:input_type -> minder.v1.UpdateProjectRequest
-	149, // 345: minder.v1.ProjectsService.PatchProject:input_type -> minder.v1.PatchProjectRequest
-	153, // 346: minder.v1.ProjectsService.CreateEntityReconciliationTask:input_type -> minder.v1.CreateEntityReconciliationTaskRequest
-	185, // 347: minder.v1.ProvidersService.PatchProvider:input_type -> minder.v1.PatchProviderRequest
-	172, // 348: minder.v1.ProvidersService.GetProvider:input_type -> minder.v1.GetProviderRequest
-	174, // 349: minder.v1.ProvidersService.ListProviders:input_type -> minder.v1.ListProvidersRequest
-	176, // 350: minder.v1.ProvidersService.CreateProvider:input_type -> minder.v1.CreateProviderRequest
-	178, // 351: minder.v1.ProvidersService.DeleteProvider:input_type -> minder.v1.DeleteProviderRequest
-	180, // 352: minder.v1.ProvidersService.DeleteProviderByID:input_type -> minder.v1.DeleteProviderByIDRequest
-	182, // 353: minder.v1.ProvidersService.ListProviderClasses:input_type -> minder.v1.ListProviderClassesRequest
-	54,  // 354: minder.v1.ProvidersService.ReconcileEntityRegistration:input_type -> minder.v1.ReconcileEntityRegistrationRequest
-	27,  // 355: minder.v1.InviteService.GetInviteDetails:input_type -> minder.v1.GetInviteDetailsRequest
-	202, // 356: minder.v1.EntityInstanceService.ListEntities:input_type -> minder.v1.ListEntitiesRequest
-	204, // 357: minder.v1.EntityInstanceService.GetEntityById:input_type -> minder.v1.GetEntityByIdRequest
-	206, // 358: minder.v1.EntityInstanceService.GetEntityByName:input_type -> minder.v1.GetEntityByNameRequest
-	208, // 359: minder.v1.EntityInstanceService.DeleteEntityById:input_type -> minder.v1.DeleteEntityByIdRequest
-	210, // 360: minder.v1.EntityInstanceService.RegisterEntity:input_type -> minder.v1.RegisterEntityRequest
-	30,  // 361: minder.v1.HealthService.CheckHealth:output_type -> minder.v1.CheckHealthResponse
-	14,  // 362: minder.v1.HealthService.GetVersion:output_type -> minder.v1.GetVersionResponse
-	16,  // 363: minder.v1.ArtifactService.ListArtifacts:output_type -> minder.v1.ListArtifactsResponse
-	20,  // 364: minder.v1.ArtifactService.GetArtifactById:output_type -> minder.v1.GetArtifactByIdResponse
-	22,  // 365: minder.v1.ArtifactService.GetArtifactByName:output_type -> minder.v1.GetArtifactByNameResponse
-	32,  // 366: minder.v1.OAuthService.GetAuthorizationURL:output_type -> minder.v1.GetAuthorizationURLResponse
-	34,  // 367: minder.v1.OAuthService.StoreProviderToken:output_type -> minder.v1.StoreProviderTokenResponse
-	57,  // 368: minder.v1.OAuthService.VerifyProviderTokenFrom:output_type -> minder.v1.VerifyProviderTokenFromResponse
-	59,  // 369: minder.v1.OAuthService.VerifyProviderCredential:output_type -> minder.v1.VerifyProviderCredentialResponse
-	43,  // 370: minder.v1.RepositoryService.RegisterRepository:output_type -> minder.v1.RegisterRepositoryResponse
-	37,  // 371: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:output_type -> minder.v1.ListRemoteRepositoriesFromProviderResponse
-	53,  // 372: minder.v1.RepositoryService.ListRepositories:output_type -> minder.v1.ListRepositoriesResponse
-	45,  // 373: minder.v1.RepositoryService.GetRepositoryById:output_type -> minder.v1.GetRepositoryByIdResponse
-	49,  // 374: minder.v1.RepositoryService.GetRepositoryByName:output_type -> minder.v1.GetRepositoryByNameResponse
-	47,  // 375: minder.v1.RepositoryService.DeleteRepositoryById:output_type -> minder.v1.DeleteRepositoryByIdResponse
-	51,  // 376: minder.v1.RepositoryService.DeleteRepositoryByName:output_type -> minder.v1.DeleteRepositoryByNameResponse
-	61,  // 377: minder.v1.UserService.CreateUser:output_type -> minder.v1.CreateUserResponse
-	63,  // 378: minder.v1.UserService.DeleteUser:output_type -> minder.v1.DeleteUserResponse
-	67,  // 379: minder.v1.UserService.GetUser:output_type -> minder.v1.GetUserResponse
-	168, // 380: minder.v1.UserService.ListInvitations:output_type -> minder.v1.ListInvitationsResponse
-	170, // 381: minder.v1.UserService.ResolveInvitation:output_type -> minder.v1.ResolveInvitationResponse
-	83,  // 382: minder.v1.ProfileService.CreateProfile:output_type -> minder.v1.CreateProfileResponse
-	85,  // 383: minder.v1.ProfileService.UpdateProfile:output_type -> minder.v1.UpdateProfileResponse
-	87,  // 384: minder.v1.ProfileService.PatchProfile:output_type -> minder.v1.PatchProfileResponse
-	89,  // 385: minder.v1.ProfileService.DeleteProfile:output_type -> minder.v1.DeleteProfileResponse
-	91,  // 386: minder.v1.ProfileService.ListProfiles:output_type -> minder.v1.ListProfilesResponse
-	93,  // 387: minder.v1.ProfileService.GetProfileById:output_type -> minder.v1.GetProfileByIdResponse
-	95,  // 388: minder.v1.ProfileService.GetProfileByName:output_type -> minder.v1.GetProfileByNameResponse
-	101, // 389: minder.v1.ProfileService.GetProfileStatusByName:output_type -> minder.v1.GetProfileStatusByNameResponse
-	103, // 390: minder.v1.ProfileService.GetProfileStatusById:output_type -> minder.v1.GetProfileStatusByIdResponse
-	105, // 391: minder.v1.ProfileService.GetProfileStatusByProject:output_type -> minder.v1.GetProfileStatusByProjectResponse
-	69,  // 392: minder.v1.DataSourceService.CreateDataSource:output_type -> minder.v1.CreateDataSourceResponse
-	71,  // 393: minder.v1.DataSourceService.GetDataSourceById:output_type -> minder.v1.GetDataSourceByIdResponse
-	73,  // 394: minder.v1.DataSourceService.GetDataSourceByName:output_type -> minder.v1.GetDataSourceByNameResponse
-	75,  // 395: minder.v1.DataSourceService.ListDataSources:output_type -> minder.v1.ListDataSourcesResponse
-	77,  // 396: minder.v1.DataSourceService.UpdateDataSource:output_type -> minder.v1.UpdateDataSourceResponse
-	79,  // 397: minder.v1.DataSourceService.DeleteDataSourceById:output_type -> minder.v1.DeleteDataSourceByIdResponse
-	81,  // 398: minder.v1.DataSourceService.DeleteDataSourceByName:output_type -> minder.v1.DeleteDataSourceByNameResponse
-	118, // 399: minder.v1.RuleTypeService.ListRuleTypes:output_type -> minder.v1.ListRuleTypesResponse
-	120, // 400: minder.v1.RuleTypeService.GetRuleTypeByName:output_type -> minder.v1.GetRuleTypeByNameResponse
-	122, // 401: minder.v1.RuleTypeService.GetRuleTypeById:output_type -> minder.v1.GetRuleTypeByIdResponse
-	124, // 402: minder.v1.RuleTypeService.CreateRuleType:output_type -> minder.v1.CreateRuleTypeResponse
-	126, // 403: minder.v1.RuleTypeService.UpdateRuleType:output_type -> minder.v1.UpdateRuleTypeResponse
-	128, // 404: minder.v1.RuleTypeService.DeleteRuleType:output_type -> minder.v1.DeleteRuleTypeResponse
-	130, // 405: minder.v1.EvalResultsService.ListEvaluationResults:output_type -> minder.v1.ListEvaluationResultsResponse
-	194, // 406: minder.v1.EvalResultsService.ListEvaluationHistory:output_type -> minder.v1.ListEvaluationHistoryResponse
-	193, // 407: minder.v1.EvalResultsService.GetEvaluationHistory:output_type -> minder.v1.GetEvaluationHistoryResponse
-	156, // 408: minder.v1.PermissionsService.ListRoles:output_type -> minder.v1.ListRolesResponse
-	158, // 409: minder.v1.PermissionsService.ListRoleAssignments:output_type -> minder.v1.ListRoleAssignmentsResponse
-	160, // 410: minder.v1.PermissionsService.AssignRole:output_type -> minder.v1.AssignRoleResponse
-	162, // 411: minder.v1.PermissionsService.UpdateRole:output_type -> minder.v1.UpdateRoleResponse
-	164, // 412: minder.v1.PermissionsService.RemoveRole:output_type -> minder.v1.RemoveRoleResponse
-	141, // 413: minder.v1.ProjectsService.ListProjects:output_type -> minder.v1.ListProjectsResponse
-	143, // 414: minder.v1.ProjectsService.CreateProject:output_type -> minder.v1.CreateProjectResponse
-	152, // 415: minder.v1.ProjectsService.ListChildProjects:output_type -> minder.v1.ListChildProjectsResponse
-	145, // 416: minder.v1.ProjectsService.DeleteProject:output_type -> minder.v1.DeleteProjectResponse
-	147, // 417: minder.v1.ProjectsService.UpdateProject:output_type -> minder.v1.UpdateProjectResponse
-	150, // 418: minder.v1.ProjectsService.PatchProject:output_type -> minder.v1.PatchProjectResponse
-	154, // 419: minder.v1.ProjectsService.CreateEntityReconciliationTask:output_type -> minder.v1.CreateEntityReconciliationTaskResponse
-	186, // 420: minder.v1.ProvidersService.PatchProvider:output_type -> minder.v1.PatchProviderResponse
-	173, // 421: minder.v1.ProvidersService.GetProvider:output_type -> minder.v1.GetProviderResponse
-	175, // 422: minder.v1.ProvidersService.ListProviders:output_type -> minder.v1.ListProvidersResponse
-	177, // 423: minder.v1.ProvidersService.CreateProvider:output_type -> minder.v1.CreateProviderResponse
-	179, // 424: minder.v1.ProvidersService.DeleteProvider:output_type -> minder.v1.DeleteProviderResponse
-	181, // 425: minder.v1.ProvidersService.DeleteProviderByID:output_type -> minder.v1.DeleteProviderByIDResponse
-	184, // 426: minder.v1.ProvidersService.ListProviderClasses:output_type -> minder.v1.ListProviderClassesResponse
-	55,  // 427: minder.v1.ProvidersService.ReconcileEntityRegistration:output_type -> minder.v1.ReconcileEntityRegistrationResponse
-	28,  // 428: minder.v1.InviteService.GetInviteDetails:output_type -> minder.v1.GetInviteDetailsResponse
-	203, // 429: minder.v1.EntityInstanceService.ListEntities:output_type -> minder.v1.ListEntitiesResponse
-	205, // 430: minder.v1.EntityInstanceService.GetEntityById:output_type -> minder.v1.GetEntityByIdResponse
-	207, // 431: minder.v1.EntityInstanceService.GetEntityByName:output_type -> minder.v1.GetEntityByNameResponse
-	209, // 432: minder.v1.EntityInstanceService.DeleteEntityById:output_type -> minder.v1.DeleteEntityByIdResponse
-	211, // 433: minder.v1.EntityInstanceService.RegisterEntity:output_type -> minder.v1.RegisterEntityResponse
-	361, // [361:434] is the sub-list for method output_type
-	288, // [288:361] is the sub-list for method input_type
-	287, // [287:288] is the sub-list for extension type_name
-	285, // [285:287] is the sub-list for extension extendee
-	0,   // [0:285] is the sub-list for field type_name
+	12,  // 2: minder.v1.CursorPage.next:type_name -> minder.v1.Cursor
+	12,  // 3: minder.v1.CursorPage.prev:type_name -> minder.v1.Cursor
+	133, // 4: minder.v1.ListArtifactsRequest.context:type_name -> minder.v1.Context
+	18,  // 5: minder.v1.ListArtifactsResponse.results:type_name -> minder.v1.Artifact
+	19,  // 6: minder.v1.Artifact.versions:type_name -> minder.v1.ArtifactVersion
+	317, // 7: minder.v1.Artifact.created_at:type_name -> google.protobuf.Timestamp
+	133, // 8: minder.v1.Artifact.context:type_name -> minder.v1.Context
+	317, // 9: minder.v1.ArtifactVersion.created_at:type_name -> google.protobuf.Timestamp
+	133, // 10: minder.v1.GetArtifactByIdRequest.context:type_name -> minder.v1.Context
+	18,  // 11: minder.v1.GetArtifactByIdResponse.artifact:type_name -> minder.v1.Artifact
+	19,  // 12: minder.v1.GetArtifactByIdResponse.versions:type_name -> minder.v1.ArtifactVersion
+	133, // 13: minder.v1.GetArtifactByNameRequest.context:type_name -> minder.v1.Context
+	18,  // 14: minder.v1.GetArtifactByNameResponse.artifact:type_name -> minder.v1.Artifact
+	19,  // 15: minder.v1.GetArtifactByNameResponse.versions:type_name -> minder.v1.ArtifactVersion
+	317, // 16: minder.v1.GetInviteDetailsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	133, // 17: minder.v1.GetAuthorizationURLRequest.context:type_name -> minder.v1.Context
+	318, // 18: minder.v1.GetAuthorizationURLRequest.config:type_name -> google.protobuf.Struct
+	133, // 19: minder.v1.StoreProviderTokenRequest.context:type_name -> minder.v1.Context
+	317, // 20: minder.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	317, // 21: minder.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 22: minder.v1.ListRemoteRepositoriesFromProviderRequest.context:type_name -> minder.v1.Context
+	40,  // 23: minder.v1.ListRemoteRepositoriesFromProviderResponse.results:type_name -> minder.v1.UpstreamRepositoryRef
+	39,  // 24: minder.v1.ListRemoteRepositoriesFromProviderResponse.entities:type_name -> minder.v1.RegistrableUpstreamEntityRef
+	261, // 25: minder.v1.RegistrableUpstreamEntityRef.entity:type_name -> minder.v1.UpstreamEntityRef
+	133, // 26: minder.v1.UpstreamRepositoryRef.context:type_name -> minder.v1.Context
+	133, // 27: minder.v1.Repository.context:type_name -> minder.v1.Context
+	317, // 28: minder.v1.Repository.created_at:type_name -> google.protobuf.Timestamp
+	317, // 29: minder.v1.Repository.updated_at:type_name -> google.protobuf.Timestamp
+	318, // 30: minder.v1.Repository.properties:type_name -> google.protobuf.Struct
+	40,  // 31: minder.v1.RegisterRepositoryRequest.repository:type_name -> minder.v1.UpstreamRepositoryRef
+	133, // 32: minder.v1.RegisterRepositoryRequest.context:type_name -> minder.v1.Context
+	261, // 33: minder.v1.RegisterRepositoryRequest.entity:type_name -> minder.v1.UpstreamEntityRef
+	41,  // 34: minder.v1.RegisterRepoResult.repository:type_name -> minder.v1.Repository
+	268, // 35: minder.v1.RegisterRepoResult.status:type_name -> minder.v1.RegisterRepoResult.Status
+	43,  // 36: minder.v1.RegisterRepositoryResponse.result:type_name -> minder.v1.RegisterRepoResult
+	133, // 37: minder.v1.GetRepositoryByIdRequest.context:type_name -> minder.v1.Context
+	41,  // 38: minder.v1.GetRepositoryByIdResponse.repository:type_name -> minder.v1.Repository
+	133, // 39: minder.v1.DeleteRepositoryByIdRequest.context:type_name -> minder.v1.Context
+	133, // 40: minder.v1.GetRepositoryByNameRequest.context:type_name -> minder.v1.Context
+	41,  // 41: minder.v1.GetRepositoryByNameResponse.repository:type_name -> minder.v1.Repository
+	133, // 42: minder.v1.DeleteRepositoryByNameRequest.context:type_name -> minder.v1.Context
+	133, // 43: minder.v1.ListRepositoriesRequest.context:type_name -> minder.v1.Context
+	41,  // 44: minder.v1.ListRepositoriesResponse.results:type_name -> minder.v1.Repository
+	133, // 45: minder.v1.ReconcileEntityRegistrationRequest.context:type_name -> minder.v1.Context
+	317, // 46: minder.v1.VerifyProviderTokenFromRequest.timestamp:type_name -> google.protobuf.Timestamp
+	133, // 47: minder.v1.VerifyProviderTokenFromRequest.context:type_name -> minder.v1.Context
+	133, // 48: minder.v1.VerifyProviderCredentialRequest.context:type_name -> minder.v1.Context
+	317, // 49: minder.v1.CreateUserResponse.created_at:type_name -> google.protobuf.Timestamp
+	133, // 50: minder.v1.CreateUserResponse.context:type_name -> minder.v1.Context
+	317, // 51: minder.v1.UserRecord.created_at:type_name -> google.protobuf.Timestamp
+	317, // 52: minder.v1.UserRecord.updated_at:type_name -> google.protobuf.Timestamp
+	212, // 53: minder.v1.ProjectRole.role:type_name -> minder.v1.Role
+	36,  // 54: minder.v1.ProjectRole.project:type_name -> minder.v1.Project
+	65,  // 55: minder.v1.GetUserResponse.user:type_name -> minder.v1.UserRecord
+	36,  // 56: minder.v1.GetUserResponse.projects:type_name -> minder.v1.Project
+	66,  // 57: minder.v1.GetUserResponse.project_roles:type_name -> minder.v1.ProjectRole
+	262, // 58: minder.v1.CreateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
+	262, // 59: minder.v1.CreateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
+	134, // 60: minder.v1.GetDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
+	262, // 61: minder.v1.GetDataSourceByIdResponse.data_source:type_name -> minder.v1.DataSource
+	134, // 62: minder.v1.GetDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
+	262, // 63: minder.v1.GetDataSourceByNameResponse.data_source:type_name -> minder.v1.DataSource
+	134, // 64: minder.v1.ListDataSourcesRequest.context:type_name -> minder.v1.ContextV2
+	262, // 65: minder.v1.ListDataSourcesResponse.data_sources:type_name -> minder.v1.DataSource
+	262, // 66: minder.v1.UpdateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
+	262, // 67: minder.v1.UpdateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
+	134, // 68: minder.v1.DeleteDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
+	134, // 69: minder.v1.DeleteDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
+	160, // 70: minder.v1.CreateProfileRequest.profile:type_name -> minder.v1.Profile
+	160, // 71: minder.v1.CreateProfileResponse.profile:type_name -> minder.v1.Profile
+	160, // 72: minder.v1.UpdateProfileRequest.profile:type_name -> minder.v1.Profile
+	160, // 73: minder.v1.UpdateProfileResponse.profile:type_name -> minder.v1.Profile
+	160, // 74: minder.v1.PlanProfileRequest.profile:type_name -> minder.v1.Profile
+	104, // 75: minder.v1.ProfilePlanSelection.entity:type_name -> minder.v1.EntityTypedId
+	104, // 76: minder.v1.ProfilePlanEvaluation.entity:type_name -> minder.v1.EntityTypedId
+	88,  // 77: minder.v1.PlanProfileResponse.selections:type_name -> minder.v1.ProfilePlanSelection
+	89,  // 78: minder.v1.PlanProfileResponse.evaluations:type_name -> minder.v1.ProfilePlanEvaluation
+	133, // 79: minder.v1.PatchProfileRequest.context:type_name -> minder.v1.Context
+	160, // 80: minder.v1.PatchProfileRequest.patch:type_name -> minder.v1.Profile
+	319, // 81: minder.v1.PatchProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	160, // 82: minder.v1.PatchProfileResponse.profile:type_name -> minder.v1.Profile
+	133, // 83: minder.v1.DeleteProfileRequest.context:type_name -> minder.v1.Context
+	133, // 84: minder.v1.ListProfilesRequest.context:type_name -> minder.v1.Context
+	160, // 85: minder.v1.ListProfilesResponse.profiles:type_name -> minder.v1.Profile
+	133, // 86: minder.v1.GetProfileByIdRequest.context:type_name -> minder.v1.Context
+	160, // 87: minder.v1.GetProfileByIdResponse.profile:type_name -> minder.v1.Profile
+	133, // 88: minder.v1.GetProfileByNameRequest.context:type_name -> minder.v1.Context
+	160, // 89: minder.v1.GetProfileByNameResponse.profile:type_name -> minder.v1.Profile
+	317, // 90: minder.v1.ProfileStatus.last_updated:type_name -> google.protobuf.Timestamp
+	317, // 91: minder.v1.EvalResultAlert.last_updated:type_name -> google.protobuf.Timestamp
+	317, // 92: minder.v1.RuleEvaluationStatus.last_updated:type_name -> google.protobuf.Timestamp
+	269, // 93: minder.v1.RuleEvaluationStatus.entity_info:type_name -> minder.v1.RuleEvaluationStatus.EntityInfoEntry
+	317, // 94: minder.v1.RuleEvaluationStatus.remediation_last_updated:type_name -> google.protobuf.Timestamp
+	102, // 95: minder.v1.RuleEvaluationStatus.alert:type_name -> minder.v1.EvalResultAlert
+	158, // 96: minder.v1.RuleEvaluationStatus.severity:type_name -> minder.v1.Severity
+	5,   // 97: minder.v1.RuleEvaluationStatus.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
+	320, // 98: minder.v1.RuleEvaluationStatus.output:type_name -> google.protobuf.Value
+	3,   // 99: minder.v1.EntityTypedId.type:type_name -> minder.v1.Entity
+	133, // 100: minder.v1.GetProfileStatusByNameRequest.context:type_name -> minder.v1.Context
+	104, // 101: minder.v1.GetProfileStatusByNameRequest.entity:type_name -> minder.v1.EntityTypedId
+	101, // 102: minder.v1.GetProfileStatusByNameResponse.profile_status:type_name -> minder.v1.ProfileStatus
+	103, // 103: minder.v1.GetProfileStatusByNameResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
+	133, // 104: minder.v1.GetProfileStatusByIdRequest.context:type_name -> minder.v1.Context
+	104, // 105: minder.v1.GetProfileStatusByIdRequest.entity:type_name -> minder.v1.EntityTypedId
+	101, // 106: minder.v1.GetProfileStatusByIdResponse.profile_status:type_name -> minder.v1.ProfileStatus
+	103, // 107: minder.v1.GetProfileStatusByIdResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
+	133, // 108: minder.v1.GetProfileStatusByProjectRequest.context:type_name -> minder.v1.Context
+	101, // 109: minder.v1.GetProfileStatusByProjectResponse.profile_status:type_name -> minder.v1.ProfileStatus
+	133, // 110: minder.v1.RuleExemption.context:type_name -> minder.v1.Context
+	104, // 111: minder.v1.RuleExemption.entity:type_name -> minder.v1.EntityTypedId
+	317, // 112: minder.v1.RuleExemption.expires_at:type_name -> google.protobuf.Timestamp
+	317, // 113: minder.v1.RuleExemption.created_at:type_name -> google.protobuf.Timestamp
+	317, // 114: minder.v1.RuleExemption.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 115: minder.v1.CreateRuleExemptionRequest.context:type_name -> minder.v1.Context
+	111, // 116: minder.v1.CreateRuleExemptionRequest.exemption:type_name -> minder.v1.RuleExemption
+	111, // 117: minder.v1.CreateRuleExemptionResponse.exemption:type_name -> minder.v1.RuleExemption
+	133, // 118: minder.v1.GetRuleExemptionRequest.context:type_name -> minder.v1.Context
+	111, // 119: minder.v1.GetRuleExemptionResponse.exemption:type_name -> minder.v1.RuleExemption
+	133, // 120: minder.v1.ListRuleExemptionsRequest.context:type_name -> minder.v1.Context
+	111, // 121: minder.v1.ListRuleExemptionsResponse.exemptions:type_name -> minder.v1.RuleExemption
+	133, // 122: minder.v1.UpdateRuleExemptionRequest.context:type_name -> minder.v1.Context
+	317, // 123: minder.v1.UpdateRuleExemptionRequest.expires_at:type_name -> google.protobuf.Timestamp
+	111, // 124: minder.v1.UpdateRuleExemptionResponse.exemption:type_name -> minder.v1.RuleExemption
+	133, // 125: minder.v1.DeleteRuleExemptionRequest.context:type_name -> minder.v1.Context
+	270, // 126: minder.v1.AutoRegistration.entities:type_name -> minder.v1.AutoRegistration.EntitiesEntry
+	123, // 127: minder.v1.ProviderConfig.auto_registration:type_name -> minder.v1.AutoRegistration
+	133, // 128: minder.v1.ListRuleTypesRequest.context:type_name -> minder.v1.Context
+	159, // 129: minder.v1.ListRuleTypesResponse.rule_types:type_name -> minder.v1.RuleType
+	133, // 130: minder.v1.GetRuleTypeByNameRequest.context:type_name -> minder.v1.Context
+	159, // 131: minder.v1.GetRuleTypeByNameResponse.rule_type:type_name -> minder.v1.RuleType
+	133, // 132: minder.v1.GetRuleTypeByIdRequest.context:type_name -> minder.v1.Context
+	159, // 133: minder.v1.GetRuleTypeByIdResponse.rule_type:type_name -> minder.v1.RuleType
+	159, // 134: minder.v1.CreateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
+	159, // 135: minder.v1.CreateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
+	159, // 136: minder.v1.UpdateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
+	159, // 137: minder.v1.UpdateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
+	133, // 138: minder.v1.DeleteRuleTypeRequest.context:type_name -> minder.v1.Context
+	133, // 139: minder.v1.ListEvaluationResultsRequest.context:type_name -> minder.v1.Context
+	104, // 140: minder.v1.ListEvaluationResultsRequest.entity:type_name -> minder.v1.EntityTypedId
+	272, // 141: minder.v1.ListEvaluationResultsResponse.entities:type_name -> minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
+	133, // 142: minder.v1.GenerateComplianceReportRequest.context:type_name -> minder.v1.Context
+	4,   // 143: minder.v1.GenerateComplianceReportRequest.format:type_name -> minder.v1.ComplianceReportFormat
+	317, // 144: minder.v1.GenerateComplianceReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	273, // 145: minder.v1.RestType.fallback:type_name -> minder.v1.RestType.Fallback
+	274, // 146: minder.v1.DiffType.ecosystems:type_name -> minder.v1.DiffType.Ecosystem
+	275, // 147: minder.v1.DepsType.repo:type_name -> minder.v1.DepsType.RepoConfigs
+	276, // 148: minder.v1.DepsType.pr:type_name -> minder.v1.DepsType.PullRequestConfigs
+	10,  // 149: minder.v1.Severity.value:type_name -> minder.v1.Severity.Value
+	133, // 150: minder.v1.RuleType.context:type_name -> minder.v1.Context
+	277, // 151: minder.v1.RuleType.def:type_name -> minder.v1.RuleType.Definition
+	158, // 152: minder.v1.RuleType.severity:type_name -> minder.v1.Severity
+	5,   // 153: minder.v1.RuleType.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
+	133, // 154: minder.v1.Profile.context:type_name -> minder.v1.Context
+	297, // 155: minder.v1.Profile.repository:type_name -> minder.v1.Profile.Rule
+	297, // 156: minder.v1.Profile.build_environment:type_name -> minder.v1.Profile.Rule
+	297, // 157: minder.v1.Profile.artifact:type_name -> minder.v1.Profile.Rule
+	297, // 158: minder.v1.Profile.pull_request:type_name -> minder.v1.Profile.Rule
+	297, // 159: minder.v1.Profile.release:type_name -> minder.v1.Profile.Rule
+	297, // 160: minder.v1.Profile.pipeline_run:type_name -> minder.v1.Profile.Rule
+	297, // 161: minder.v1.Profile.task_run:type_name -> minder.v1.Profile.Rule
+	297, // 162: minder.v1.Profile.build:type_name -> minder.v1.Profile.Rule
+	298, // 163: minder.v1.Profile.selection:type_name -> minder.v1.Profile.Selector
+	300, // 164: minder.v1.Profile.schedule:type_name -> minder.v1.Profile.Schedule
+	36,  // 165: minder.v1.ListProjectsResponse.projects:type_name -> minder.v1.Project
+	133, // 166: minder.v1.CreateProjectRequest.context:type_name -> minder.v1.Context
+	36,  // 167: minder.v1.CreateProjectResponse.project:type_name -> minder.v1.Project
+	133, // 168: minder.v1.DeleteProjectRequest.context:type_name -> minder.v1.Context
+	133, // 169: minder.v1.UpdateProjectRequest.context:type_name -> minder.v1.Context
+	36,  // 170: minder.v1.UpdateProjectResponse.project:type_name -> minder.v1.Project
+	133, // 171: minder.v1.PatchProjectRequest.context:type_name -> minder.v1.Context
+	169, // 172: minder.v1.PatchProjectRequest.patch:type_name -> minder.v1.ProjectPatch
+	319, // 173: minder.v1.PatchProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	36,  // 174: minder.v1.PatchProjectResponse.project:type_name -> minder.v1.Project
+	134, // 175: minder.v1.ListChildProjectsRequest.context:type_name -> minder.v1.ContextV2
+	36,  // 176: minder.v1.ListChildProjectsResponse.projects:type_name -> minder.v1.Project
+	133, // 177: minder.v1.AlertWebhook.context:type_name -> minder.v1.Context
+	317, // 178: minder.v1.AlertWebhook.created_at:type_name -> google.protobuf.Timestamp
+	133, // 179: minder.v1.CreateAlertWebhookRequest.context:type_name -> minder.v1.Context
+	174, // 180: minder.v1.CreateAlertWebhookResponse.alert_webhook:type_name -> minder.v1.AlertWebhook
+	133, // 181: minder.v1.ListAlertWebhooksRequest.context:type_name -> minder.v1.Context
+	174, // 182: minder.v1.ListAlertWebhooksResponse.alert_webhooks:type_name -> minder.v1.AlertWebhook
+	133, // 183: minder.v1.DeleteAlertWebhookRequest.context:type_name -> minder.v1.Context
+	181, // 184: minder.v1.ProjectSyncStatus.source:type_name -> minder.v1.ProjectSyncSource
+	317, // 185: minder.v1.ProjectSyncStatus.last_sync_at:type_name -> google.protobuf.Timestamp
+	317, // 186: minder.v1.ProjectSyncStatus.next_sync_at:type_name -> google.protobuf.Timestamp
+	182, // 187: minder.v1.ProjectSyncStatus.drift:type_name -> minder.v1.ProjectSyncDrift
+	133, // 188: minder.v1.SetProjectSyncSourceRequest.context:type_name -> minder.v1.Context
+	181, // 189: minder.v1.SetProjectSyncSourceRequest.source:type_name -> minder.v1.ProjectSyncSource
+	183, // 190: minder.v1.SetProjectSyncSourceResponse.status:type_name -> minder.v1.ProjectSyncStatus
+	133, // 191: minder.v1.GetProjectSyncStatusRequest.context:type_name -> minder.v1.Context
+	183, // 192: minder.v1.GetProjectSyncStatusResponse.status:type_name -> minder.v1.ProjectSyncStatus
+	133, // 193: minder.v1.DeleteProjectSyncSourceRequest.context:type_name -> minder.v1.Context
+	133, // 194: minder.v1.ListBundlesRequest.context:type_name -> minder.v1.Context
+	190, // 195: minder.v1.ListBundlesResponse.bundles:type_name -> minder.v1.MarketplaceBundle
+	133, // 196: minder.v1.SubscribeBundleRequest.context:type_name -> minder.v1.Context
+	190, // 197: minder.v1.SubscribeBundleResponse.bundle:type_name -> minder.v1.MarketplaceBundle
+	133, // 198: minder.v1.PreviewSubscriptionUpgradeRequest.context:type_name -> minder.v1.Context
+	195, // 199: minder.v1.PreviewSubscriptionUpgradeResponse.changes:type_name -> minder.v1.BundleResourceChange
+	133, // 200: minder.v1.RollbackBundleSubscriptionRequest.context:type_name -> minder.v1.Context
+	190, // 201: minder.v1.RollbackBundleSubscriptionResponse.bundle:type_name -> minder.v1.MarketplaceBundle
+	104, // 202: minder.v1.CreateEntityReconciliationTaskRequest.entity:type_name -> minder.v1.EntityTypedId
+	133, // 203: minder.v1.CreateEntityReconciliationTaskRequest.context:type_name -> minder.v1.Context
+	133, // 204: minder.v1.ListRolesRequest.context:type_name -> minder.v1.Context
+	212, // 205: minder.v1.ListRolesResponse.roles:type_name -> minder.v1.Role
+	133, // 206: minder.v1.ListRoleAssignmentsRequest.context:type_name -> minder.v1.Context
+	213, // 207: minder.v1.ListRoleAssignmentsResponse.role_assignments:type_name -> minder.v1.RoleAssignment
+	218, // 208: minder.v1.ListRoleAssignmentsResponse.invitations:type_name -> minder.v1.Invitation
+	133, // 209: minder.v1.AssignRoleRequest.context:type_name -> minder.v1.Context
+	213, // 210: minder.v1.AssignRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
+	213, // 211: minder.v1.AssignRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
+	218, // 212: minder.v1.AssignRoleResponse.invitation:type_name -> minder.v1.Invitation
+	133, // 213: minder.v1.UpdateRoleRequest.context:type_name -> minder.v1.Context
+	213, // 214: minder.v1.UpdateRoleResponse.role_assignments:type_name -> minder.v1.RoleAssignment
+	218, // 215: minder.v1.UpdateRoleResponse.invitations:type_name -> minder.v1.Invitation
+	133, // 216: minder.v1.RemoveRoleRequest.context:type_name -> minder.v1.Context
+	213, // 217: minder.v1.RemoveRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
+	213, // 218: minder.v1.RemoveRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
+	218, // 219: minder.v1.RemoveRoleResponse.invitation:type_name -> minder.v1.Invitation
+	218, // 220: minder.v1.ListInvitationsResponse.invitations:type_name -> minder.v1.Invitation
+	317, // 221: minder.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	317, // 222: minder.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	133, // 223: minder.v1.GetProviderRequest.context:type_name -> minder.v1.Context
+	237, // 224: minder.v1.GetProviderResponse.provider:type_name -> minder.v1.Provider
+	133, // 225: minder.v1.ListProvidersRequest.context:type_name -> minder.v1.Context
+	237, // 226: minder.v1.ListProvidersResponse.providers:type_name -> minder.v1.Provider
+	133, // 227: minder.v1.CreateProviderRequest.context:type_name -> minder.v1.Context
+	237, // 228: minder.v1.CreateProviderRequest.provider:type_name -> minder.v1.Provider
+	237, // 229: minder.v1.CreateProviderResponse.provider:type_name -> minder.v1.Provider
+	234, // 230: minder.v1.CreateProviderResponse.authorization:type_name -> minder.v1.AuthorizationParams
+	133, // 231: minder.v1.DeleteProviderRequest.context:type_name -> minder.v1.Context
+	133, // 232: minder.v1.DeleteProviderByIDRequest.context:type_name -> minder.v1.Context
+	133, // 233: minder.v1.ListProviderClassesRequest.context:type_name -> minder.v1.Context
+	6,   // 234: minder.v1.ProviderClassInfo.supported_provider_types:type_name -> minder.v1.ProviderType
+	8,   // 235: minder.v1.ProviderClassInfo.supported_auth_flows:type_name -> minder.v1.AuthorizationFlow
+	3,   // 236: minder.v1.ProviderClassInfo.supported_entities:type_name -> minder.v1.Entity
+	230, // 237: minder.v1.ListProviderClassesResponse.provider_class_infos:type_name -> minder.v1.ProviderClassInfo
+	133, // 238: minder.v1.PatchProviderRequest.context:type_name -> minder.v1.Context
+	237, // 239: minder.v1.PatchProviderRequest.patch:type_name -> minder.v1.Provider
+	319, // 240: minder.v1.PatchProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	237, // 241: minder.v1.PatchProviderResponse.provider:type_name -> minder.v1.Provider
+	236, // 242: minder.v1.ProviderParameter.github_app:type_name -> minder.v1.GitHubAppParams
+	6,   // 243: minder.v1.Provider.implements:type_name -> minder.v1.ProviderType
+	318, // 244: minder.v1.Provider.config:type_name -> google.protobuf.Struct
+	8,   // 245: minder.v1.Provider.auth_flows:type_name -> minder.v1.AuthorizationFlow
+	235, // 246: minder.v1.Provider.parameters:type_name -> minder.v1.ProviderParameter
+	133, // 247: minder.v1.GetEvaluationHistoryRequest.context:type_name -> minder.v1.Context
+	133, // 248: minder.v1.ListEvaluationHistoryRequest.context:type_name -> minder.v1.Context
+	317, // 249: minder.v1.ListEvaluationHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	317, // 250: minder.v1.ListEvaluationHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	12,  // 251: minder.v1.ListEvaluationHistoryRequest.cursor:type_name -> minder.v1.Cursor
+	244, // 252: minder.v1.GetEvaluationHistoryResponse.evaluation:type_name -> minder.v1.EvaluationHistory
+	244, // 253: minder.v1.ListEvaluationHistoryResponse.data:type_name -> minder.v1.EvaluationHistory
+	13,  // 254: minder.v1.ListEvaluationHistoryResponse.page:type_name -> minder.v1.CursorPage
+	133, // 255: minder.v1.WatchEvaluationResultsRequest.context:type_name -> minder.v1.Context
+	244, // 256: minder.v1.WatchEvaluationResultsResponse.evaluation:type_name -> minder.v1.EvaluationHistory
+	245, // 257: minder.v1.EvaluationHistory.entity:type_name -> minder.v1.EvaluationHistoryEntity
+	246, // 258: minder.v1.EvaluationHistory.rule:type_name -> minder.v1.EvaluationHistoryRule
+	247, // 259: minder.v1.EvaluationHistory.status:type_name -> minder.v1.EvaluationHistoryStatus
+	249, // 260: minder.v1.EvaluationHistory.alert:type_name -> minder.v1.EvaluationHistoryAlert
+	248, // 261: minder.v1.EvaluationHistory.remediation:type_name -> minder.v1.EvaluationHistoryRemediation
+	317, // 262: minder.v1.EvaluationHistory.evaluated_at:type_name -> google.protobuf.Timestamp
+	3,   // 263: minder.v1.EvaluationHistoryEntity.type:type_name -> minder.v1.Entity
+	158, // 264: minder.v1.EvaluationHistoryRule.severity:type_name -> minder.v1.Severity
+	320, // 265: minder.v1.EvaluationHistoryStatus.output:type_name -> google.protobuf.Value
+	134, // 266: minder.v1.EntityInstance.context:type_name -> minder.v1.ContextV2
+	3,   // 267: minder.v1.EntityInstance.type:type_name -> minder.v1.Entity
+	318, // 268: minder.v1.EntityInstance.properties:type_name -> google.protobuf.Struct
+	134, // 269: minder.v1.ListEntitiesRequest.context:type_name -> minder.v1.ContextV2
+	3,   // 270: minder.v1.ListEntitiesRequest.entity_type:type_name -> minder.v1.Entity
+	12,  // 271: minder.v1.ListEntitiesRequest.cursor:type_name -> minder.v1.Cursor
+	250, // 272: minder.v1.ListEntitiesResponse.results:type_name -> minder.v1.EntityInstance
+	13,  // 273: minder.v1.ListEntitiesResponse.page:type_name -> minder.v1.CursorPage
+	134, // 274: minder.v1.GetEntityByIdRequest.context:type_name -> minder.v1.ContextV2
+	250, // 275: minder.v1.GetEntityByIdResponse.entity:type_name -> minder.v1.EntityInstance
+	134, // 276: minder.v1.GetEntityByNameRequest.context:type_name -> minder.v1.ContextV2
+	3,   // 277: minder.v1.GetEntityByNameRequest.entity_type:type_name -> minder.v1.Entity
+	250, // 278: minder.v1.GetEntityByNameResponse.entity:type_name -> minder.v1.EntityInstance
+	134, // 279: minder.v1.DeleteEntityByIdRequest.context:type_name -> minder.v1.ContextV2
+	134, // 280: minder.v1.RegisterEntityRequest.context:type_name -> minder.v1.ContextV2
+	3,   // 281: minder.v1.RegisterEntityRequest.entity_type:type_name -> minder.v1.Entity
+	301, // 282: minder.v1.RegisterEntityRequest.identifying_properties:type_name -> minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
+	250, // 283: minder.v1.RegisterEntityResponse.entity:type_name -> minder.v1.EntityInstance
+	134, // 284: minder.v1.UpstreamEntityRef.context:type_name -> minder.v1.ContextV2
+	3,   // 285: minder.v1.UpstreamEntityRef.type:type_name -> minder.v1.Entity
+	318, // 286: minder.v1.UpstreamEntityRef.properties:type_name -> google.protobuf.Struct
+	134, // 287: minder.v1.DataSource.context:type_name -> minder.v1.ContextV2
+	263, // 288: minder.v1.DataSource.structured:type_name -> minder.v1.StructDataSource
+	264, // 289: minder.v1.DataSource.rest:type_name -> minder.v1.RestDataSource
+	265, // 290: minder.v1.DataSource.graphql:type_name -> minder.v1.GraphQLDataSource
+	266, // 291: minder.v1.DataSource.sql:type_name -> minder.v1.SQLDataSource
+	303, // 292: minder.v1.StructDataSource.def:type_name -> minder.v1.StructDataSource.DefEntry
+	306, // 293: minder.v1.RestDataSource.def:type_name -> minder.v1.RestDataSource.DefEntry
+	307, // 294: minder.v1.RestDataSource.rate_limit:type_name -> minder.v1.RestDataSource.RateLimit
+	312, // 295: minder.v1.GraphQLDataSource.def:type_name -> minder.v1.GraphQLDataSource.DefEntry
+	316, // 296: minder.v1.SQLDataSource.def:type_name -> minder.v1.SQLDataSource.DefEntry
+	122, // 297: minder.v1.AutoRegistration.EntitiesEntry.value:type_name -> minder.v1.EntityAutoRegistrationConfig
+	101, // 298: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.profile_status:type_name -> minder.v1.ProfileStatus
+	103, // 299: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.results:type_name -> minder.v1.RuleEvaluationStatus
+	104, // 300: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.entity:type_name -> minder.v1.EntityTypedId
+	271, // 301: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.profiles:type_name -> minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
+	318, // 302: minder.v1.RuleType.Definition.rule_schema:type_name -> google.protobuf.Struct
+	318, // 303: minder.v1.RuleType.Definition.param_schema:type_name -> google.protobuf.Struct
+	278, // 304: minder.v1.RuleType.Definition.ingest:type_name -> minder.v1.RuleType.Definition.Ingest
+	279, // 305: minder.v1.RuleType.Definition.eval:type_name -> minder.v1.RuleType.Definition.Eval
+	280, // 306: minder.v1.RuleType.Definition.remediate:type_name -> minder.v1.RuleType.Definition.Remediate
+	281, // 307: minder.v1.RuleType.Definition.alert:type_name -> minder.v1.RuleType.Definition.Alert
+	151, // 308: minder.v1.RuleType.Definition.Ingest.rest:type_name -> minder.v1.RestType
+	152, // 309: minder.v1.RuleType.Definition.Ingest.builtin:type_name -> minder.v1.BuiltinType
+	153, // 310: minder.v1.RuleType.Definition.Ingest.artifact:type_name -> minder.v1.ArtifactType
+	154, // 311: minder.v1.RuleType.Definition.Ingest.git:type_name -> minder.v1.GitType
+	155, // 312: minder.v1.RuleType.Definition.Ingest.diff:type_name -> minder.v1.DiffType
+	156, // 313: minder.v1.RuleType.Definition.Ingest.deps:type_name -> minder.v1.DepsType
+	157, // 314: minder.v1.RuleType.Definition.Ingest.sbom:type_name -> minder.v1.SBOMType
+	282, // 315: minder.v1.RuleType.Definition.Eval.jq:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison
+	283, // 316: minder.v1.RuleType.Definition.Eval.rego:type_name -> minder.v1.RuleType.Definition.Eval.Rego
+	284, // 317: minder.v1.RuleType.Definition.Eval.vulncheck:type_name -> minder.v1.RuleType.Definition.Eval.Vulncheck
+	285, // 318: minder.v1.RuleType.Definition.Eval.trusty:type_name -> minder.v1.RuleType.Definition.Eval.Trusty
+	286, // 319: minder.v1.RuleType.Definition.Eval.homoglyphs:type_name -> minder.v1.RuleType.Definition.Eval.Homoglyphs
+	287, // 320: minder.v1.RuleType.Definition.Eval.cel:type_name -> minder.v1.RuleType.Definition.Eval.CEL
+	267, // 321: minder.v1.RuleType.Definition.Eval.data_sources:type_name -> minder.v1.DataSourceReference
+	151, // 322: minder.v1.RuleType.Definition.Remediate.rest:type_name -> minder.v1.RestType
+	289, // 323: minder.v1.RuleType.Definition.Remediate.gh_branch_protection:type_name -> minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
+	290, // 324: minder.v1.RuleType.Definition.Remediate.pull_request:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
+	294, // 325: minder.v1.RuleType.Definition.Remediate.pull_request_comment:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypePRComment
+	293, // 326: minder.v1.RuleType.Definition.Alert.security_advisory:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypeSA
+	294, // 327: minder.v1.RuleType.Definition.Alert.pull_request_comment:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypePRComment
+	295, // 328: minder.v1.RuleType.Definition.Alert.webhook:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypeWebhook
+	296, // 329: minder.v1.RuleType.Definition.Alert.issue:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypeIssue
+	288, // 330: minder.v1.RuleType.Definition.Eval.JQComparison.ingested:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
+	288, // 331: minder.v1.RuleType.Definition.Eval.JQComparison.profile:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
+	320, // 332: minder.v1.RuleType.Definition.Eval.JQComparison.constant:type_name -> google.protobuf.Value
+	291, // 333: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.contents:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
+	318, // 334: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.params:type_name -> google.protobuf.Struct
+	292, // 335: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.actions_replace_tags_with_sha:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
+	318, // 336: minder.v1.Profile.Rule.params:type_name -> google.protobuf.Struct
+	318, // 337: minder.v1.Profile.Rule.def:type_name -> google.protobuf.Struct
+	299, // 338: minder.v1.Profile.Schedule.blackout_windows:type_name -> minder.v1.Profile.BlackoutWindow
+	320, // 339: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry.value:type_name -> google.protobuf.Value
+	304, // 340: minder.v1.StructDataSource.Def.path:type_name -> minder.v1.StructDataSource.Def.Path
+	302, // 341: minder.v1.StructDataSource.DefEntry.value:type_name -> minder.v1.StructDataSource.Def
+	308, // 342: minder.v1.RestDataSource.Def.headers:type_name -> minder.v1.RestDataSource.Def.HeadersEntry
+	318, // 343: minder.v1.RestDataSource.Def.bodyobj:type_name -> google.protobuf.Struct
+	309, // 344: minder.v1.RestDataSource.Def.fallback:type_name -> minder.v1.RestDataSource.Def.Fallback
+	318, // 345: minder.v1.RestDataSource.Def.input_schema:type_name -> google.protobuf.Struct
+	310, // 346: minder.v1.RestDataSource.Def.cache:type_name -> minder.v1.RestDataSource.Def.Cache
+	305, // 347: minder.v1.RestDataSource.DefEntry.value:type_name -> minder.v1.RestDataSource.Def
+	313, // 348: minder.v1.GraphQLDataSource.Def.headers:type_name -> minder.v1.GraphQLDataSource.Def.HeadersEntry
+	318, // 349: minder.v1.GraphQLDataSource.Def.input_schema:type_name -> google.protobuf.Struct
+	314, // 350: minder.v1.GraphQLDataSource.Def.pagination:type_name -> minder.v1.GraphQLDataSource.Def.Pagination
+	311, // 351: minder.v1.GraphQLDataSource.DefEntry.value:type_name -> minder.v1.GraphQLDataSource.Def
+	318, // 352: minder.v1.SQLDataSource.Def.input_schema:type_name -> google.protobuf.Struct
+	315, // 353: minder.v1.SQLDataSource.DefEntry.value:type_name -> minder.v1.SQLDataSource.Def
+	321, // 354: minder.v1.name:extendee -> google.protobuf.EnumValueOptions
+	322, // 355: minder.v1.rpc_options:extendee -> google.protobuf.MethodOptions
+	11,  // 356: minder.v1.rpc_options:type_name -> minder.v1.RpcOptions
+	30,  // 357: minder.v1.HealthService.CheckHealth:input_type -> minder.v1.CheckHealthRequest
+	14,  // 358: minder.v1.HealthService.GetVersion:input_type -> minder.v1.GetVersionRequest
+	16,  // 359: minder.v1.ArtifactService.ListArtifacts:input_type -> minder.v1.ListArtifactsRequest
+	20,  // 360: minder.v1.ArtifactService.GetArtifactById:input_type -> minder.v1.GetArtifactByIdRequest
+	22,  // 361: minder.v1.ArtifactService.GetArtifactByName:input_type -> minder.v1.GetArtifactByNameRequest
+	32,  // 362: minder.v1.OAuthService.GetAuthorizationURL:input_type -> minder.v1.GetAuthorizationURLRequest
+	34,  // 363: minder.v1.OAuthService.StoreProviderToken:input_type -> minder.v1.StoreProviderTokenRequest
+	57,  // 364: minder.v1.OAuthService.VerifyProviderTokenFrom:input_type -> minder.v1.VerifyProviderTokenFromRequest
+	59,  // 365: minder.v1.OAuthService.VerifyProviderCredential:input_type -> minder.v1.VerifyProviderCredentialRequest
+	42,  // 366: minder.v1.RepositoryService.RegisterRepository:input_type -> minder.v1.RegisterRepositoryRequest
+	37,  // 367: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:input_type -> minder.v1.ListRemoteRepositoriesFromProviderRequest
+	53,  // 368: minder.v1.RepositoryService.ListRepositories:input_type -> minder.v1.ListRepositoriesRequest
+	45,  // 369: minder.v1.RepositoryService.GetRepositoryById:input_type -> minder.v1.GetRepositoryByIdRequest
+	49,  // 370: minder.v1.RepositoryService.GetRepositoryByName:input_type -> minder.v1.GetRepositoryByNameRequest
+	47,  // 371: minder.v1.RepositoryService.DeleteRepositoryById:input_type -> minder.v1.DeleteRepositoryByIdRequest
+	51,  // 372: minder.v1.RepositoryService.DeleteRepositoryByName:input_type -> minder.v1.DeleteRepositoryByNameRequest
+	61,  // 373: minder.v1.UserService.CreateUser:input_type -> minder.v1.CreateUserRequest
+	63,  // 374: minder.v1.UserService.DeleteUser:input_type -> minder.v1.DeleteUserRequest
+	67,  // 375: minder.v1.UserService.GetUser:input_type -> minder.v1.GetUserRequest
+	214, // 376: minder.v1.UserService.ListInvitations:input_type -> minder.v1.ListInvitationsRequest
+	216, // 377: minder.v1.UserService.ResolveInvitation:input_type -> minder.v1.ResolveInvitationRequest
+	83,  // 378: minder.v1.ProfileService.CreateProfile:input_type -> minder.v1.CreateProfileRequest
+	85,  // 379: minder.v1.ProfileService.UpdateProfile:input_type -> minder.v1.UpdateProfileRequest
+	87,  // 380: minder.v1.ProfileService.PlanProfile:input_type -> minder.v1.PlanProfileRequest
+	91,  // 381: minder.v1.ProfileService.PatchProfile:input_type -> minder.v1.PatchProfileRequest
+	93,  // 382: minder.v1.ProfileService.DeleteProfile:input_type -> minder.v1.DeleteProfileRequest
+	95,  // 383: minder.v1.ProfileService.ListProfiles:input_type -> minder.v1.ListProfilesRequest
+	97,  // 384: minder.v1.ProfileService.GetProfileById:input_type -> minder.v1.GetProfileByIdRequest
+	99,  // 385: minder.v1.ProfileService.GetProfileByName:input_type -> minder.v1.GetProfileByNameRequest
+	105, // 386: minder.v1.ProfileService.GetProfileStatusByName:input_type -> minder.v1.GetProfileStatusByNameRequest
+	107, // 387: minder.v1.ProfileService.GetProfileStatusById:input_type -> minder.v1.GetProfileStatusByIdRequest
+	109, // 388: minder.v1.ProfileService.GetProfileStatusByProject:input_type -> minder.v1.GetProfileStatusByProjectRequest
+	112, // 389: minder.v1.ProfileService.CreateRuleExemption:input_type -> minder.v1.CreateRuleExemptionRequest
+	114, // 390: minder.v1.ProfileService.GetRuleExemption:input_type -> minder.v1.GetRuleExemptionRequest
+	116, // 391: minder.v1.ProfileService.ListRuleExemptions:input_type -> minder.v1.ListRuleExemptionsRequest
+	118, // 392: minder.v1.ProfileService.UpdateRuleExemption:input_type -> minder.v1.UpdateRuleExemptionRequest
+	120, // 393: minder.v1.ProfileService.DeleteRuleExemption:input_type -> minder.v1.DeleteRuleExemptionRequest
+	69,  // 394: minder.v1.DataSourceService.CreateDataSource:input_type -> minder.v1.CreateDataSourceRequest
+	71,  // 395: minder.v1.DataSourceService.GetDataSourceById:input_type -> minder.v1.GetDataSourceByIdRequest
+	73,  // 396: minder.v1.DataSourceService.GetDataSourceByName:input_type -> minder.v1.GetDataSourceByNameRequest
+	75,  // 397: minder.v1.DataSourceService.ListDataSources:input_type -> minder.v1.ListDataSourcesRequest
+	77,  // 398: minder.v1.DataSourceService.UpdateDataSource:input_type -> minder.v1.UpdateDataSourceRequest
+	79,  // 399: minder.v1.DataSourceService.DeleteDataSourceById:input_type -> minder.v1.DeleteDataSourceByIdRequest
+	81,  // 400: minder.v1.DataSourceService.DeleteDataSourceByName:input_type -> minder.v1.DeleteDataSourceByNameRequest
+	135, // 401: minder.v1.RuleTypeService.ListRuleTypes:input_type -> minder.v1.ListRuleTypesRequest
+	137, // 402: minder.v1.RuleTypeService.GetRuleTypeByName:input_type -> minder.v1.GetRuleTypeByNameRequest
+	139, // 403: minder.v1.RuleTypeService.GetRuleTypeById:input_type -> minder.v1.GetRuleTypeByIdRequest
+	141, // 404: minder.v1.RuleTypeService.CreateRuleType:input_type -> minder.v1.CreateRuleTypeRequest
+	143, // 405: minder.v1.RuleTypeService.UpdateRuleType:input_type -> minder.v1.UpdateRuleTypeRequest
+	145, // 406: minder.v1.RuleTypeService.DeleteRuleType:input_type -> minder.v1.DeleteRuleTypeRequest
+	147, // 407: minder.v1.EvalResultsService.ListEvaluationResults:input_type -> minder.v1.ListEvaluationResultsRequest
+	239, // 408: minder.v1.EvalResultsService.ListEvaluationHistory:input_type -> minder.v1.ListEvaluationHistoryRequest
+	238, // 409: minder.v1.EvalResultsService.GetEvaluationHistory:input_type -> minder.v1.GetEvaluationHistoryRequest
+	149, // 410: minder.v1.EvalResultsService.GenerateComplianceReport:input_type -> minder.v1.GenerateComplianceReportRequest
+	242, // 411: minder.v1.EvalResultsService.WatchEvaluationResults:input_type -> minder.v1.WatchEvaluationResultsRequest
+	202, // 412: minder.v1.PermissionsService.ListRoles:input_type -> minder.v1.ListRolesRequest
+	204, // 413: minder.v1.PermissionsService.ListRoleAssignments:input_type -> minder.v1.ListRoleAssignmentsRequest
+	206, // 414: minder.v1.PermissionsService.AssignRole:input_type -> minder.v1.AssignRoleRequest
+	208, // 415: minder.v1.PermissionsService.UpdateRole:input_type -> minder.v1.UpdateRoleRequest
+	210, // 416: minder.v1.PermissionsService.RemoveRole:input_type -> minder.v1.RemoveRoleRequest
+	161, // 417: minder.v1.ProjectsService.ListProjects:input_type -> minder.v1.ListProjectsRequest
+	163, // 418: minder.v1.ProjectsService.CreateProject:input_type -> minder.v1.CreateProjectRequest
+	172, // 419: minder.v1.ProjectsService.ListChildProjects:input_type -> minder.v1.ListChildProjectsRequest
+	165, // 420: minder.v1.ProjectsService.DeleteProject:input_type -> minder.v1.DeleteProjectRequest
+	167, // 421: minder.v1.ProjectsService.UpdateProject:input_type -> minder.v1.UpdateProjectRequest
+	170, // 422: minder.v1.ProjectsService.PatchProject:input_type -> minder.v1.PatchProjectRequest
+	175, // 423: minder.v1.ProjectsService.CreateAlertWebhook:input_type -> minder.v1.CreateAlertWebhookRequest
+	177, // 424: minder.v1.ProjectsService.ListAlertWebhooks:input_type -> minder.v1.ListAlertWebhooksRequest
+	179, // 425: minder.v1.ProjectsService.DeleteAlertWebhook:input_type -> minder.v1.DeleteAlertWebhookRequest
+	184, // 426: minder.v1.ProjectsService.SetProjectSyncSource:input_type -> minder.v1.SetProjectSyncSourceRequest
+	186, // 427: minder.v1.ProjectsService.GetProjectSyncStatus:input_type -> minder.v1.GetProjectSyncStatusRequest
+	188, // 428: minder.v1.ProjectsService.DeleteProjectSyncSource:input_type -> minder.v1.DeleteProjectSyncSourceRequest
+	191, // 429: minder.v1.ProjectsService.ListBundles:input_type -> minder.v1.ListBundlesRequest
+	193, // 430: minder.v1.ProjectsService.SubscribeBundle:input_type -> minder.v1.SubscribeBundleRequest
+	196, // 431: minder.v1.ProjectsService.PreviewSubscriptionUpgrade:input_type -> minder.v1.PreviewSubscriptionUpgradeRequest
+	198, // 432: minder.v1.ProjectsService.RollbackBundleSubscription:input_type -> minder.v1.RollbackBundleSubscriptionRequest
+	200, // 433: minder.v1.ProjectsService.CreateEntityReconciliationTask:input_type -> minder.v1.CreateEntityReconciliationTaskRequest
+	232, // 434: minder.v1.ProvidersService.PatchProvider:input_type -> minder.v1.PatchProviderRequest
+	219, // 435: minder.v1.ProvidersService.GetProvider:input_type -> minder.v1.GetProviderRequest
+	221, // 436: minder.v1.ProvidersService.ListProviders:input_type -> minder.v1.ListProvidersRequest
+	223, // 437: minder.v1.ProvidersService.CreateProvider:input_type -> minder.v1.CreateProviderRequest
+	225, // 438: minder.v1.ProvidersService.DeleteProvider:input_type -> minder.v1.DeleteProviderRequest
+	227, // 439: minder.v1.ProvidersService.DeleteProviderByID:input_type -> minder.v1.DeleteProviderByIDRequest
+	229, // 440: minder.v1.ProvidersService.ListProviderClasses:input_type -> minder.v1.ListProviderClassesRequest
+	55,  // 441: minder.v1.ProvidersService.ReconcileEntityRegistration:input_type -> minder.v1.ReconcileEntityRegistrationRequest
+	28,  // 442: minder.v1.InviteService.GetInviteDetails:input_type -> minder.v1.GetInviteDetailsRequest
+	251, // 443: minder.v1.EntityInstanceService.ListEntities:input_type -> minder.v1.ListEntitiesRequest
+	253, // 444: minder.v1.EntityInstanceService.GetEntityById:input_type -> minder.v1.GetEntityByIdRequest
+	255, // 445: minder.v1.EntityInstanceService.GetEntityByName:input_type -> minder.v1.GetEntityByNameRequest
+	257, // 446: minder.v1.EntityInstanceService.DeleteEntityById:input_type -> minder.v1.DeleteEntityByIdRequest
+	259, // 447: minder.v1.EntityInstanceService.RegisterEntity:input_type -> minder.v1.RegisterEntityRequest
+	31,  // 448: minder.v1.HealthService.CheckHealth:output_type -> minder.v1.CheckHealthResponse
+	15,  // 449: minder.v1.HealthService.GetVersion:output_type -> minder.v1.GetVersionResponse
+	17,  // 450: minder.v1.ArtifactService.ListArtifacts:output_type -> minder.v1.ListArtifactsResponse
+	21,  // 451: minder.v1.ArtifactService.GetArtifactById:output_type -> minder.v1.GetArtifactByIdResponse
+	23,  // 452: minder.v1.ArtifactService.GetArtifactByName:output_type -> minder.v1.GetArtifactByNameResponse
+	33,  // 453: minder.v1.OAuthService.GetAuthorizationURL:output_type -> minder.v1.GetAuthorizationURLResponse
+	35,  // 454: minder.v1.OAuthService.StoreProviderToken:output_type -> minder.v1.StoreProviderTokenResponse
+	58,  // 455: minder.v1.OAuthService.VerifyProviderTokenFrom:output_type -> minder.v1.VerifyProviderTokenFromResponse
+	60,  // 456: minder.v1.OAuthService.VerifyProviderCredential:output_type -> minder.v1.VerifyProviderCredentialResponse
+	44,  // 457: minder.v1.RepositoryService.RegisterRepository:output_type -> minder.v1.RegisterRepositoryResponse
+	38,  // 458: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:output_type -> minder.v1.ListRemoteRepositoriesFromProviderResponse
+	54,  // 459: minder.v1.RepositoryService.ListRepositories:output_type -> minder.v1.ListRepositoriesResponse
+	46,  // 460: minder.v1.RepositoryService.GetRepositoryById:output_type -> minder.v1.GetRepositoryByIdResponse
+	50,  // 461: minder.v1.RepositoryService.GetRepositoryByName:output_type -> minder.v1.GetRepositoryByNameResponse
+	48,  // 462: minder.v1.RepositoryService.DeleteRepositoryById:output_type -> minder.v1.DeleteRepositoryByIdResponse
+	52,  // 463: minder.v1.RepositoryService.DeleteRepositoryByName:output_type -> minder.v1.DeleteRepositoryByNameResponse
+	62,  // 464: minder.v1.UserService.CreateUser:output_type -> minder.v1.CreateUserResponse
+	64,  // 465: minder.v1.UserService.DeleteUser:output_type -> minder.v1.DeleteUserResponse
+	68,  // 466: minder.v1.UserService.GetUser:output_type -> minder.v1.GetUserResponse
+	215, // 467: minder.v1.UserService.ListInvitations:output_type -> minder.v1.ListInvitationsResponse
+	217, // 468: minder.v1.UserService.ResolveInvitation:output_type -> minder.v1.ResolveInvitationResponse
+	84,  // 469: minder.v1.ProfileService.CreateProfile:output_type -> minder.v1.CreateProfileResponse
+	86,  // 470: minder.v1.ProfileService.UpdateProfile:output_type -> minder.v1.UpdateProfileResponse
+	90,  // 471: minder.v1.ProfileService.PlanProfile:output_type -> minder.v1.PlanProfileResponse
+	92,  // 472: minder.v1.ProfileService.PatchProfile:output_type -> minder.v1.PatchProfileResponse
+	94,  // 473: minder.v1.ProfileService.DeleteProfile:output_type -> minder.v1.DeleteProfileResponse
+	96,  // 474: minder.v1.ProfileService.ListProfiles:output_type -> minder.v1.ListProfilesResponse
+	98,  // 475: minder.v1.ProfileService.GetProfileById:output_type -> minder.v1.GetProfileByIdResponse
+	100, // 476: minder.v1.ProfileService.GetProfileByName:output_type -> minder.v1.GetProfileByNameResponse
+	106, // 477: minder.v1.ProfileService.GetProfileStatusByName:output_type -> minder.v1.GetProfileStatusByNameResponse
+	108, // 478: minder.v1.ProfileService.GetProfileStatusById:output_type -> minder.v1.GetProfileStatusByIdResponse
+	110, // 479: minder.v1.ProfileService.GetProfileStatusByProject:output_type -> minder.v1.GetProfileStatusByProjectResponse
+	113, // 480: minder.v1.ProfileService.CreateRuleExemption:output_type -> minder.v1.CreateRuleExemptionResponse
+	115, // 481: minder.v1.ProfileService.GetRuleExemption:output_type -> minder.v1.GetRuleExemptionResponse
+	117, // 482: minder.v1.ProfileService.ListRuleExemptions:output_type -> minder.v1.ListRuleExemptionsResponse
+	119, // 483: minder.v1.ProfileService.UpdateRuleExemption:output_type -> minder.v1.UpdateRuleExemptionResponse
+	121, // 484: minder.v1.ProfileService.DeleteRuleExemption:output_type -> minder.v1.DeleteRuleExemptionResponse
+	70,  // 485: minder.v1.DataSourceService.CreateDataSource:output_type -> minder.v1.CreateDataSourceResponse
+	72,  // 486: minder.v1.DataSourceService.GetDataSourceById:output_type -> minder.v1.GetDataSourceByIdResponse
+	74,  // 487: minder.v1.DataSourceService.GetDataSourceByName:output_type -> minder.v1.GetDataSourceByNameResponse
+	76,  // 488: minder.v1.DataSourceService.ListDataSources:output_type -> minder.v1.ListDataSourcesResponse
+	78,  // 489: minder.v1.DataSourceService.UpdateDataSource:output_type -> minder.v1.UpdateDataSourceResponse
+	80,  // 490: minder.v1.DataSourceService.DeleteDataSourceById:output_type -> minder.v1.DeleteDataSourceByIdResponse
+	82,  // 491: minder.v1.DataSourceService.DeleteDataSourceByName:output_type -> minder.v1.DeleteDataSourceByNameResponse
+	136, // 492: minder.v1.RuleTypeService.ListRuleTypes:output_type -> minder.v1.ListRuleTypesResponse
+	138, // 493: minder.v1.RuleTypeService.GetRuleTypeByName:output_type -> minder.v1.GetRuleTypeByNameResponse
+	140, // 494: minder.v1.RuleTypeService.GetRuleTypeById:output_type -> minder.v1.GetRuleTypeByIdResponse
+	142, // 495: minder.v1.RuleTypeService.CreateRuleType:output_type -> minder.v1.CreateRuleTypeResponse
+	144, // 496: minder.v1.RuleTypeService.UpdateRuleType:output_type -> minder.v1.UpdateRuleTypeResponse
+	146, // 497: minder.v1.RuleTypeService.DeleteRuleType:output_type -> minder.v1.DeleteRuleTypeResponse
+	148, // 498: minder.v1.EvalResultsService.ListEvaluationResults:output_type -> minder.v1.ListEvaluationResultsResponse
+	241, // 499: minder.v1.EvalResultsService.ListEvaluationHistory:output_type -> minder.v1.ListEvaluationHistoryResponse
+	240, // 500: minder.v1.EvalResultsService.GetEvaluationHistory:output_type -> minder.v1.GetEvaluationHistoryResponse
+	150, // 501: minder.v1.EvalResultsService.GenerateComplianceReport:output_type -> minder.v1.GenerateComplianceReportResponse
+	243, // 502: minder.v1.EvalResultsService.WatchEvaluationResults:output_type -> minder.v1.WatchEvaluationResultsResponse
+	203, // 503: minder.v1.PermissionsService.ListRoles:output_type -> minder.v1.ListRolesResponse
+	205, // 504: minder.v1.PermissionsService.ListRoleAssignments:output_type -> minder.v1.ListRoleAssignmentsResponse
+	207, // 505: minder.v1.PermissionsService.AssignRole:output_type -> minder.v1.AssignRoleResponse
+	209, // 506: minder.v1.PermissionsService.UpdateRole:output_type -> minder.v1.UpdateRoleResponse
+	211, // 507: minder.v1.PermissionsService.RemoveRole:output_type -> minder.v1.RemoveRoleResponse
+	162, // 508: minder.v1.ProjectsService.ListProjects:output_type -> minder.v1.ListProjectsResponse
+	164, // 509: minder.v1.ProjectsService.CreateProject:output_type -> minder.v1.CreateProjectResponse
+	173, // 510: minder.v1.ProjectsService.ListChildProjects:output_type -> minder.v1.ListChildProjectsResponse
+	166, // 511: minder.v1.ProjectsService.DeleteProject:output_type -> minder.v1.DeleteProjectResponse
+	168, // 512: minder.v1.ProjectsService.UpdateProject:output_type -> minder.v1.UpdateProjectResponse
+	171, // 513: minder.v1.ProjectsService.PatchProject:output_type -> minder.v1.PatchProjectResponse
+	176, // 514: minder.v1.ProjectsService.CreateAlertWebhook:output_type -> minder.v1.CreateAlertWebhookResponse
+	178, // 515: minder.v1.ProjectsService.ListAlertWebhooks:output_type -> minder.v1.ListAlertWebhooksResponse
+	180, // 516: minder.v1.ProjectsService.DeleteAlertWebhook:output_type -> minder.v1.DeleteAlertWebhookResponse
+	185, // 517: minder.v1.ProjectsService.SetProjectSyncSource:output_type -> minder.v1.SetProjectSyncSourceResponse
+	187, // 518: minder.v1.ProjectsService.GetProjectSyncStatus:output_type -> minder.v1.GetProjectSyncStatusResponse
+	189, // 519: minder.v1.ProjectsService.DeleteProjectSyncSource:output_type -> minder.v1.DeleteProjectSyncSourceResponse
+	192, // 520: minder.v1.ProjectsService.ListBundles:output_type -> minder.v1.ListBundlesResponse
+	194, // 521: minder.v1.ProjectsService.SubscribeBundle:output_type -> minder.v1.SubscribeBundleResponse
+	197, // 522: minder.v1.ProjectsService.PreviewSubscriptionUpgrade:output_type -> minder.v1.PreviewSubscriptionUpgradeResponse
+	199, // 523: minder.v1.ProjectsService.RollbackBundleSubscription:output_type -> minder.v1.RollbackBundleSubscriptionResponse
+	201, // 524: minder.v1.ProjectsService.CreateEntityReconciliationTask:output_type -> minder.v1.CreateEntityReconciliationTaskResponse
+	233, // 525: minder.v1.ProvidersService.PatchProvider:output_type -> minder.v1.PatchProviderResponse
+	220, // 526: minder.v1.ProvidersService.GetProvider:output_type -> minder.v1.GetProviderResponse
+	222, // 527: minder.v1.ProvidersService.ListProviders:output_type -> minder.v1.ListProvidersResponse
+	224, // 528: minder.v1.ProvidersService.CreateProvider:output_type -> minder.v1.CreateProviderResponse
+	226, // 529: minder.v1.ProvidersService.DeleteProvider:output_type -> minder.v1.DeleteProviderResponse
+	228, // 530: minder.v1.ProvidersService.DeleteProviderByID:output_type -> minder.v1.DeleteProviderByIDResponse
+	231, // 531: minder.v1.ProvidersService.ListProviderClasses:output_type -> minder.v1.ListProviderClassesResponse
+	56,  // 532: minder.v1.ProvidersService.ReconcileEntityRegistration:output_type -> minder.v1.ReconcileEntityRegistrationResponse
+	29,  // 533: minder.v1.InviteService.GetInviteDetails:output_type -> minder.v1.GetInviteDetailsResponse
+	252, // 534: minder.v1.EntityInstanceService.ListEntities:output_type -> minder.v1.ListEntitiesResponse
+	254, // 535: minder.v1.EntityInstanceService.GetEntityById:output_type -> minder.v1.GetEntityByIdResponse
+	256, // 536: minder.v1.EntityInstanceService.GetEntityByName:output_type -> minder.v1.GetEntityByNameResponse
+	258, // 537: minder.v1.EntityInstanceService.DeleteEntityById:output_type -> minder.v1.DeleteEntityByIdResponse
+	260, // 538: minder.v1.EntityInstanceService.RegisterEntity:output_type -> minder.v1.RegisterEntityResponse
+	448, // [448:539] is the sub-list for method output_type
+	357, // [357:448] is the sub-list for method input_type
+	356, // [356:357] is the sub-list for extension type_name
+	354, // [354:356] is the sub-list for extension extendee
+	0,   // [0:354] is the sub-list for field type_name
 }
 
 func init() { file_minder_v1_minder_proto_init() }
@@ -17001,46 +21440,55 @@ func file_minder_v1_minder_proto_init() {
 	file_minder_v1_minder_proto_msgTypes[23].OneofWrappers = []any{}
 	file_minder_v1_minder_proto_msgTypes[30].OneofWrappers = []any{}
 	file_minder_v1_minder_proto_msgTypes[57].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[88].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[96].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[98].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[99].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[100].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[101].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[103].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[104].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[105].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[119].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[92].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[111].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[113].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[114].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[115].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[116].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[120].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[121].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[122].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[136].OneofWrappers = []any{
 		(*ListEvaluationResultsRequest_Profile)(nil),
 		(*ListEvaluationResultsRequest_LabelFilter)(nil),
 	}
-	file_minder_v1_minder_proto_msgTypes[121].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[126].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[138].OneofWrappers = []any{
+		(*GenerateComplianceReportRequest_Profile)(nil),
+		(*GenerateComplianceReportRequest_LabelFilter)(nil),
+	}
+	file_minder_v1_minder_proto_msgTypes[140].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[145].OneofWrappers = []any{
 		(*DepsType_Repo)(nil),
 		(*DepsType_Pr)(nil),
 	}
-	file_minder_v1_minder_proto_msgTypes[128].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[129].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[138].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[156].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[178].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[148].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[149].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[158].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[202].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[224].OneofWrappers = []any{
 		(*ProviderParameter_GithubApp)(nil),
 	}
-	file_minder_v1_minder_proto_msgTypes[203].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[251].OneofWrappers = []any{
 		(*DataSource_Structured)(nil),
 		(*DataSource_Rest)(nil),
-	}
-	file_minder_v1_minder_proto_msgTypes[207].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[216].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[217].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[218].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[219].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[220].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[222].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[228].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[229].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[232].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[239].OneofWrappers = []any{
+		(*DataSource_Graphql)(nil),
+		(*DataSource_Sql)(nil),
+	}
+	file_minder_v1_minder_proto_msgTypes[257].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[266].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[267].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[268].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[269].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[270].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[272].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[276].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[279].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[280].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[283].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[284].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[285].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[294].OneofWrappers = []any{
 		(*RestDataSource_Def_Bodyobj)(nil),
 		(*RestDataSource_Def_Bodystr)(nil),
 		(*RestDataSource_Def_BodyFromField)(nil),
@@ -17050,8 +21498,8 @@ func file_minder_v1_minder_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_minder_v1_minder_proto_rawDesc), len(file_minder_v1_minder_proto_rawDesc)),
-			NumEnums:      10,
-			NumMessages:   243,
+			NumEnums:      11,
+			NumMessages:   306,
 			NumExtensions: 2,
 			NumServices:   14,
 		},
@@ -1193,6 +1193,7 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 const (
 	ProfileService_CreateProfile_FullMethodName             = "/minder.v1.ProfileService/CreateProfile"
 	ProfileService_UpdateProfile_FullMethodName             = "/minder.v1.ProfileService/UpdateProfile"
+	ProfileService_PlanProfile_FullMethodName               = "/minder.v1.ProfileService/PlanProfile"
 	ProfileService_PatchProfile_FullMethodName              = "/minder.v1.ProfileService/PatchProfile"
 	ProfileService_DeleteProfile_FullMethodName             = "/minder.v1.ProfileService/DeleteProfile"
 	ProfileService_ListProfiles_FullMethodName              = "/minder.v1.ProfileService/ListProfiles"
@@ -1201,6 +1202,11 @@ const (
 	ProfileService_GetProfileStatusByName_FullMethodName    = "/minder.v1.ProfileService/GetProfileStatusByName"
 	ProfileService_GetProfileStatusById_FullMethodName      = "/minder.v1.ProfileService/GetProfileStatusById"
 	ProfileService_GetProfileStatusByProject_FullMethodName = "/minder.v1.ProfileService/GetProfileStatusByProject"
+	ProfileService_CreateRuleExemption_FullMethodName       = "/minder.v1.ProfileService/CreateRuleExemption"
+	ProfileService_GetRuleExemption_FullMethodName          = "/minder.v1.ProfileService/GetRuleExemption"
+	ProfileService_ListRuleExemptions_FullMethodName        = "/minder.v1.ProfileService/ListRuleExemptions"
+	ProfileService_UpdateRuleExemption_FullMethodName       = "/minder.v1.ProfileService/UpdateRuleExemption"
+	ProfileService_DeleteRuleExemption_FullMethodName       = "/minder.v1.ProfileService/DeleteRuleExemption"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -1209,6 +1215,13 @@ const (
 type ProfileServiceClient interface {
 	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// PlanProfile lists the impact applying a profile would have, without
+	// applying it. The rules of the profile are evaluated without running
+	// their alerts or remediations, and rules which act on pull requests
+	// behave as with the profile_only action. Their ingesters and data
+	// sources do run: they call the provider and external services,
+	// including REST endpoints with the methods the rule types configure.
+	PlanProfile(ctx context.Context, in *PlanProfileRequest, opts ...grpc.CallOption) (*PlanProfileResponse, error)
 	PatchProfile(ctx context.Context, in *PatchProfileRequest, opts ...grpc.CallOption) (*PatchProfileResponse, error)
 	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error)
 	ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error)
@@ -1217,6 +1230,11 @@ type ProfileServiceClient interface {
 	GetProfileStatusByName(ctx context.Context, in *GetProfileStatusByNameRequest, opts ...grpc.CallOption) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(ctx context.Context, in *GetProfileStatusByIdRequest, opts ...grpc.CallOption) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(ctx context.Context, in *GetProfileStatusByProjectRequest, opts ...grpc.CallOption) (*GetProfileStatusByProjectResponse, error)
+	CreateRuleExemption(ctx context.Context, in *CreateRuleExemptionRequest, opts ...grpc.CallOption) (*CreateRuleExemptionResponse, error)
+	GetRuleExemption(ctx context.Context, in *GetRuleExemptionRequest, opts ...grpc.CallOption) (*GetRuleExemptionResponse, error)
+	ListRuleExemptions(ctx context.Context, in *ListRuleExemptionsRequest, opts ...grpc.CallOption) (*ListRuleExemptionsResponse, error)
+	UpdateRuleExemption(ctx context.Context, in *UpdateRuleExemptionRequest, opts ...grpc.CallOption) (*UpdateRuleExemptionResponse, error)
+	DeleteRuleExemption(ctx context.Context, in *DeleteRuleExemptionRequest, opts ...grpc.CallOption) (*DeleteRuleExemptionResponse, error)
 }
 
 type profileServiceClient struct {
@@ -1247,6 +1265,16 @@ func (c *profileServiceClient) UpdateProfile(ctx context.Context, in *UpdateProf
 	return out, nil
 }
 
+func (c *profileServiceClient) PlanProfile(ctx context.Context, in *PlanProfileRequest, opts ...grpc.CallOption) (*PlanProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanProfileResponse)
+	err := c.cc.Invoke(ctx, ProfileService_PlanProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileServiceClient) PatchProfile(ctx context.Context, in *PatchProfileRequest, opts ...grpc.CallOption) (*PatchProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PatchProfileResponse)
@@ -1327,12 +1355,69 @@ func (c *profileServiceClient) GetProfileStatusByProject(ctx context.Context, in
 	return out, nil
 }
 
+func (c *profileServiceClient) CreateRuleExemption(ctx context.Context, in *CreateRuleExemptionRequest, opts ...grpc.CallOption) (*CreateRuleExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRuleExemptionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_CreateRuleExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetRuleExemption(ctx context.Context, in *GetRuleExemptionRequest, opts ...grpc.CallOption) (*GetRuleExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRuleExemptionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetRuleExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListRuleExemptions(ctx context.Context, in *ListRuleExemptionsRequest, opts ...grpc.CallOption) (*ListRuleExemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuleExemptionsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListRuleExemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateRuleExemption(ctx context.Context, in *UpdateRuleExemptionRequest, opts ...grpc.CallOption) (*UpdateRuleExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRuleExemptionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_UpdateRuleExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteRuleExemption(ctx context.Context, in *DeleteRuleExemptionRequest, opts ...grpc.CallOption) (*DeleteRuleExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRuleExemptionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_DeleteRuleExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility.
 type ProfileServiceServer interface {
 	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// PlanProfile lists the impact applying a profile would have, without
+	// applying it. The rules of the profile are evaluated without running
+	// their alerts or remediations, and rules which act on pull requests
+	// behave as with the profile_only action. Their ingesters and data
+	// sources do run: they call the provider and external services,
+	// including REST endpoints with the methods the rule types configure.
+	PlanProfile(context.Context, *PlanProfileRequest) (*PlanProfileResponse, error)
 	PatchProfile(context.Context, *PatchProfileRequest) (*PatchProfileResponse, error)
 	DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
 	ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error)
@@ -1341,6 +1426,11 @@ type ProfileServiceServer interface {
 	GetProfileStatusByName(context.Context, *GetProfileStatusByNameRequest) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(context.Context, *GetProfileStatusByIdRequest) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error)
+	CreateRuleExemption(context.Context, *CreateRuleExemptionRequest) (*CreateRuleExemptionResponse, error)
+	GetRuleExemption(context.Context, *GetRuleExemptionRequest) (*GetRuleExemptionResponse, error)
+	ListRuleExemptions(context.Context, *ListRuleExemptionsRequest) (*ListRuleExemptionsResponse, error)
+	UpdateRuleExemption(context.Context, *UpdateRuleExemptionRequest) (*UpdateRuleExemptionResponse, error)
+	DeleteRuleExemption(context.Context, *DeleteRuleExemptionRequest) (*DeleteRuleExemptionResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -1357,6 +1447,9 @@ func (UnimplementedProfileServiceServer) CreateProfile(context.Context, *CreateP
 func (UnimplementedProfileServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedProfileServiceServer) PlanProfile(context.Context, *PlanProfileRequest) (*PlanProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlanProfile not implemented")
+}
 func (UnimplementedProfileServiceServer) PatchProfile(context.Context, *PatchProfileRequest) (*PatchProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PatchProfile not implemented")
 }
@@ -1381,6 +1474,21 @@ func (UnimplementedProfileServiceServer) GetProfileStatusById(context.Context, *
 func (UnimplementedProfileServiceServer) GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfileStatusByProject not implemented")
 }
+func (UnimplementedProfileServiceServer) CreateRuleExemption(context.Context, *CreateRuleExemptionRequest) (*CreateRuleExemptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRuleExemption not implemented")
+}
+func (UnimplementedProfileServiceServer) GetRuleExemption(context.Context, *GetRuleExemptionRequest) (*GetRuleExemptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRuleExemption not implemented")
+}
+func (UnimplementedProfileServiceServer) ListRuleExemptions(context.Context, *ListRuleExemptionsRequest) (*ListRuleExemptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRuleExemptions not implemented")
+}
+func (UnimplementedProfileServiceServer) UpdateRuleExemption(context.Context, *UpdateRuleExemptionRequest) (*UpdateRuleExemptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRuleExemption not implemented")
+}
+func (UnimplementedProfileServiceServer) DeleteRuleExemption(context.Context, *DeleteRuleExemptionRequest) (*DeleteRuleExemptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRuleExemption not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
@@ -1438,6 +1546,24 @@ func _ProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_PlanProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).PlanProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_PlanProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).PlanProfile(ctx, req.(*PlanProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfileService_PatchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PatchProfileRequest)
 	if err := dec(in); err != nil {
@@ -1582,6 +1708,96 @@ func _ProfileService_GetProfileStatusByProject_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_CreateRuleExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateRuleExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_CreateRuleExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateRuleExemption(ctx, req.(*CreateRuleExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetRuleExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetRuleExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetRuleExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetRuleExemption(ctx, req.(*GetRuleExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListRuleExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListRuleExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListRuleExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListRuleExemptions(ctx, req.(*ListRuleExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateRuleExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateRuleExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_UpdateRuleExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateRuleExemption(ctx, req.(*UpdateRuleExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteRuleExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteRuleExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DeleteRuleExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteRuleExemption(ctx, req.(*DeleteRuleExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1597,6 +1813,10 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _ProfileService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "PlanProfile",
+			Handler:    _ProfileService_PlanProfile_Handler,
+		},
 		{
 			MethodName: "PatchProfile",
 			Handler:    _ProfileService_PatchProfile_Handler,
@@ -1629,6 +1849,26 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfileStatusByProject",
 			Handler:    _ProfileService_GetProfileStatusByProject_Handler,
 		},
+		{
+			MethodName: "CreateRuleExemption",
+			Handler:    _ProfileService_CreateRuleExemption_Handler,
+		},
+		{
+			MethodName: "GetRuleExemption",
+			Handler:    _ProfileService_GetRuleExemption_Handler,
+		},
+		{
+			MethodName: "ListRuleExemptions",
+			Handler:    _ProfileService_ListRuleExemptions_Handler,
+		},
+		{
+			MethodName: "UpdateRuleExemption",
+			Handler:    _ProfileService_UpdateRuleExemption_Handler,
+		},
+		{
+			MethodName: "DeleteRuleExemption",
+			Handler:    _ProfileService_DeleteRuleExemption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -2257,9 +2497,11 @@ var RuleTypeService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EvalResultsService_ListEvaluationResults_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationResults"
-	EvalResultsService_ListEvaluationHistory_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationHistory"
-	EvalResultsService_GetEvaluationHistory_FullMethodName  = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_ListEvaluationResults_FullMethodName    = "/minder.v1.EvalResultsService/ListEvaluationResults"
+	EvalResultsService_ListEvaluationHistory_FullMethodName    = "/minder.v1.EvalResultsService/ListEvaluationHistory"
+	EvalResultsService_GetEvaluationHistory_FullMethodName     = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_GenerateComplianceReport_FullMethodName = "/minder.v1.EvalResultsService/GenerateComplianceReport"
+	EvalResultsService_WatchEvaluationResults_FullMethodName   = "/minder.v1.EvalResultsService/WatchEvaluationResults"
 )
 
 // EvalResultsServiceClient is the client API for EvalResultsService service.
@@ -2269,6 +2511,19 @@ type EvalResultsServiceClient interface {
 	ListEvaluationResults(ctx context.Context, in *ListEvaluationResultsRequest, opts ...grpc.CallOption) (*ListEvaluationResultsResponse, error)
 	ListEvaluationHistory(ctx context.Context, in *ListEvaluationHistoryRequest, opts ...grpc.CallOption) (*ListEvaluationHistoryResponse, error)
 	GetEvaluationHistory(ctx context.Context, in *GetEvaluationHistoryRequest, opts ...grpc.CallOption) (*GetEvaluationHistoryResponse, error)
+	// GenerateComplianceReport returns a point-in-time snapshot of the
+	// latest evaluation results of a project, in a format suitable for
+	// audits or for uploading to code scanning tools.
+	GenerateComplianceReport(ctx context.Context, in *GenerateComplianceReportRequest, opts ...grpc.CallOption) (*GenerateComplianceReportResponse, error)
+	// WatchEvaluationResults streams evaluations as they are stored. Over
+	// HTTP, set the `Accept: text/event-stream` header to receive the
+	// results as server-sent events.
+	//
+	// Evaluations stored by the server instance serving the stream are
+	// sent right away. When Minder runs several replicas, evaluations
+	// stored by other replicas are only sent on the next poll, every 10
+	// seconds.
+	WatchEvaluationResults(ctx context.Context, in *WatchEvaluationResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvaluationResultsResponse], error)
 }
 
 type evalResultsServiceClient struct {
@@ -2309,6 +2564,35 @@ func (c *evalResultsServiceClient) GetEvaluationHistory(ctx context.Context, in
 	return out, nil
 }
 
+func (c *evalResultsServiceClient) GenerateComplianceReport(ctx context.Context, in *GenerateComplianceReportRequest, opts ...grpc.CallOption) (*GenerateComplianceReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateComplianceReportResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_GenerateComplianceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evalResultsServiceClient) WatchEvaluationResults(ctx context.Context, in *WatchEvaluationResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvaluationResultsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EvalResultsService_ServiceDesc.Streams[0], EvalResultsService_WatchEvaluationResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEvaluationResultsRequest, WatchEvaluationResultsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EvalResultsService_WatchEvaluationResultsClient = grpc.ServerStreamingClient[WatchEvaluationResultsResponse]
+
 // EvalResultsServiceServer is the server API for EvalResultsService service.
 // All implementations must embed UnimplementedEvalResultsServiceServer
 // for forward compatibility.
@@ -2316,6 +2600,19 @@ type EvalResultsServiceServer interface {
 	ListEvaluationResults(context.Context, *ListEvaluationResultsRequest) (*ListEvaluationResultsResponse, error)
 	ListEvaluationHistory(context.Context, *ListEvaluationHistoryRequest) (*ListEvaluationHistoryResponse, error)
 	GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error)
+	// GenerateComplianceReport returns a point-in-time snapshot of the
+	// latest evaluation results of a project, in a format suitable for
+	// audits or for uploading to code scanning tools.
+	GenerateComplianceReport(context.Context, *GenerateComplianceReportRequest) (*GenerateComplianceReportResponse, error)
+	// WatchEvaluationResults streams evaluations as they are stored. Over
+	// HTTP, set the `Accept: text/event-stream` header to receive the
+	// results as server-sent events.
+	//
+	// Evaluations stored by the server instance serving the stream are
+	// sent right away. When Minder runs several replicas, evaluations
+	// stored by other replicas are only sent on the next poll, every 10
+	// seconds.
+	WatchEvaluationResults(*WatchEvaluationResultsRequest, grpc.ServerStreamingServer[WatchEvaluationResultsResponse]) error
 	mustEmbedUnimplementedEvalResultsServiceServer()
 }
 
@@ -2335,6 +2632,12 @@ func (UnimplementedEvalResultsServiceServer) ListEvaluationHistory(context.Conte
 func (UnimplementedEvalResultsServiceServer) GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEvaluationHistory not implemented")
 }
+func (UnimplementedEvalResultsServiceServer) GenerateComplianceReport(context.Context, *GenerateComplianceReportRequest) (*GenerateComplianceReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateComplianceReport not implemented")
+}
+func (UnimplementedEvalResultsServiceServer) WatchEvaluationResults(*WatchEvaluationResultsRequest, grpc.ServerStreamingServer[WatchEvaluationResultsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvaluationResults not implemented")
+}
 func (UnimplementedEvalResultsServiceServer) mustEmbedUnimplementedEvalResultsServiceServer() {}
 func (UnimplementedEvalResultsServiceServer) testEmbeddedByValue()                            {}
 
@@ -2410,6 +2713,35 @@ func _EvalResultsService_GetEvaluationHistory_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EvalResultsService_GenerateComplianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateComplianceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).GenerateComplianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_GenerateComplianceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).GenerateComplianceReport(ctx, req.(*GenerateComplianceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvalResultsService_WatchEvaluationResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEvaluationResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EvalResultsServiceServer).WatchEvaluationResults(m, &grpc.GenericServerStream[WatchEvaluationResultsRequest, WatchEvaluationResultsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EvalResultsService_WatchEvaluationResultsServer = grpc.ServerStreamingServer[WatchEvaluationResultsResponse]
+
 // EvalResultsService_ServiceDesc is the grpc.ServiceDesc for EvalResultsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2429,8 +2761,18 @@ var EvalResultsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvaluationHistory",
 			Handler:    _EvalResultsService_GetEvaluationHistory_Handler,
 		},
+		{
+			MethodName: "GenerateComplianceReport",
+			Handler:    _EvalResultsService_GenerateComplianceReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvaluationResults",
+			Handler:       _EvalResultsService_WatchEvaluationResults_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
 }
 
@@ -2695,6 +3037,16 @@ const (
 	ProjectsService_DeleteProject_FullMethodName                  = "/minder.v1.ProjectsService/DeleteProject"
 	ProjectsService_UpdateProject_FullMethodName                  = "/minder.v1.ProjectsService/UpdateProject"
 	ProjectsService_PatchProject_FullMethodName                   = "/minder.v1.ProjectsService/PatchProject"
+	ProjectsService_CreateAlertWebhook_FullMethodName             = "/minder.v1.ProjectsService/CreateAlertWebhook"
+	ProjectsService_ListAlertWebhooks_FullMethodName              = "/minder.v1.ProjectsService/ListAlertWebhooks"
+	ProjectsService_DeleteAlertWebhook_FullMethodName             = "/minder.v1.ProjectsService/DeleteAlertWebhook"
+	ProjectsService_SetProjectSyncSource_FullMethodName           = "/minder.v1.ProjectsService/SetProjectSyncSource"
+	ProjectsService_GetProjectSyncStatus_FullMethodName           = "/minder.v1.ProjectsService/GetProjectSyncStatus"
+	ProjectsService_DeleteProjectSyncSource_FullMethodName        = "/minder.v1.ProjectsService/DeleteProjectSyncSource"
+	ProjectsService_ListBundles_FullMethodName                    = "/minder.v1.ProjectsService/ListBundles"
+	ProjectsService_SubscribeBundle_FullMethodName                = "/minder.v1.ProjectsService/SubscribeBundle"
+	ProjectsService_PreviewSubscriptionUpgrade_FullMethodName     = "/minder.v1.ProjectsService/PreviewSubscriptionUpgrade"
+	ProjectsService_RollbackBundleSubscription_FullMethodName     = "/minder.v1.ProjectsService/RollbackBundleSubscription"
 	ProjectsService_CreateEntityReconciliationTask_FullMethodName = "/minder.v1.ProjectsService/CreateEntityReconciliationTask"
 )
 
@@ -2708,6 +3060,21 @@ type ProjectsServiceClient interface {
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	PatchProject(ctx context.Context, in *PatchProjectRequest, opts ...grpc.CallOption) (*PatchProjectResponse, error)
+	CreateAlertWebhook(ctx context.Context, in *CreateAlertWebhookRequest, opts ...grpc.CallOption) (*CreateAlertWebhookResponse, error)
+	ListAlertWebhooks(ctx context.Context, in *ListAlertWebhooksRequest, opts ...grpc.CallOption) (*ListAlertWebhooksResponse, error)
+	DeleteAlertWebhook(ctx context.Context, in *DeleteAlertWebhookRequest, opts ...grpc.CallOption) (*DeleteAlertWebhookResponse, error)
+	SetProjectSyncSource(ctx context.Context, in *SetProjectSyncSourceRequest, opts ...grpc.CallOption) (*SetProjectSyncSourceResponse, error)
+	GetProjectSyncStatus(ctx context.Context, in *GetProjectSyncStatusRequest, opts ...grpc.CallOption) (*GetProjectSyncStatusResponse, error)
+	DeleteProjectSyncSource(ctx context.Context, in *DeleteProjectSyncSourceRequest, opts ...grpc.CallOption) (*DeleteProjectSyncSourceResponse, error)
+	ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error)
+	SubscribeBundle(ctx context.Context, in *SubscribeBundleRequest, opts ...grpc.CallOption) (*SubscribeBundleResponse, error)
+	PreviewSubscriptionUpgrade(ctx context.Context, in *PreviewSubscriptionUpgradeRequest, opts ...grpc.CallOption) (*PreviewSubscriptionUpgradeResponse, error)
+	// RollbackBundleSubscription subscribes the project back to the version of
+	// the bundle it was subscribed to before its last upgrade, or downgrade.
+	// It fails with FAILED_PRECONDITION when the rollback has breaking rule
+	// type changes, such as removing a property an upgrade added to a rule
+	// schema. PreviewSubscriptionUpgrade of the previous version lists them.
+	RollbackBundleSubscription(ctx context.Context, in *RollbackBundleSubscriptionRequest, opts ...grpc.CallOption) (*RollbackBundleSubscriptionResponse, error)
 	CreateEntityReconciliationTask(ctx context.Context, in *CreateEntityReconciliationTaskRequest, opts ...grpc.CallOption) (*CreateEntityReconciliationTaskResponse, error)
 }
 
@@ -2779,6 +3146,106 @@ func (c *projectsServiceClient) PatchProject(ctx context.Context, in *PatchProje
 	return out, nil
 }
 
+func (c *projectsServiceClient) CreateAlertWebhook(ctx context.Context, in *CreateAlertWebhookRequest, opts ...grpc.CallOption) (*CreateAlertWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertWebhookResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_CreateAlertWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) ListAlertWebhooks(ctx context.Context, in *ListAlertWebhooksRequest, opts ...grpc.CallOption) (*ListAlertWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertWebhooksResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ListAlertWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) DeleteAlertWebhook(ctx context.Context, in *DeleteAlertWebhookRequest, opts ...grpc.CallOption) (*DeleteAlertWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertWebhookResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_DeleteAlertWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) SetProjectSyncSource(ctx context.Context, in *SetProjectSyncSourceRequest, opts ...grpc.CallOption) (*SetProjectSyncSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProjectSyncSourceResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_SetProjectSyncSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) GetProjectSyncStatus(ctx context.Context, in *GetProjectSyncStatusRequest, opts ...grpc.CallOption) (*GetProjectSyncStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectSyncStatusResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_GetProjectSyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) DeleteProjectSyncSource(ctx context.Context, in *DeleteProjectSyncSourceRequest, opts ...grpc.CallOption) (*DeleteProjectSyncSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProjectSyncSourceResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_DeleteProjectSyncSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBundlesResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_ListBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) SubscribeBundle(ctx context.Context, in *SubscribeBundleRequest, opts ...grpc.CallOption) (*SubscribeBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeBundleResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_SubscribeBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) PreviewSubscriptionUpgrade(ctx context.Context, in *PreviewSubscriptionUpgradeRequest, opts ...grpc.CallOption) (*PreviewSubscriptionUpgradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewSubscriptionUpgradeResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_PreviewSubscriptionUpgrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsServiceClient) RollbackBundleSubscription(ctx context.Context, in *RollbackBundleSubscriptionRequest, opts ...grpc.CallOption) (*RollbackBundleSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackBundleSubscriptionResponse)
+	err := c.cc.Invoke(ctx, ProjectsService_RollbackBundleSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectsServiceClient) CreateEntityReconciliationTask(ctx context.Context, in *CreateEntityReconciliationTaskRequest, opts ...grpc.CallOption) (*CreateEntityReconciliationTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateEntityReconciliationTaskResponse)
@@ -2799,6 +3266,21 @@ type ProjectsServiceServer interface {
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	PatchProject(context.Context, *PatchProjectRequest) (*PatchProjectResponse, error)
+	CreateAlertWebhook(context.Context, *CreateAlertWebhookRequest) (*CreateAlertWebhookResponse, error)
+	ListAlertWebhooks(context.Context, *ListAlertWebhooksRequest) (*ListAlertWebhooksResponse, error)
+	DeleteAlertWebhook(context.Context, *DeleteAlertWebhookRequest) (*DeleteAlertWebhookResponse, error)
+	SetProjectSyncSource(context.Context, *SetProjectSyncSourceRequest) (*SetProjectSyncSourceResponse, error)
+	GetProjectSyncStatus(context.Context, *GetProjectSyncStatusRequest) (*GetProjectSyncStatusResponse, error)
+	DeleteProjectSyncSource(context.Context, *DeleteProjectSyncSourceRequest) (*DeleteProjectSyncSourceResponse, error)
+	ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error)
+	SubscribeBundle(context.Context, *SubscribeBundleRequest) (*SubscribeBundleResponse, error)
+	PreviewSubscriptionUpgrade(context.Context, *PreviewSubscriptionUpgradeRequest) (*PreviewSubscriptionUpgradeResponse, error)
+	// RollbackBundleSubscription subscribes the project back to the version of
+	// the bundle it was subscribed to before its last upgrade, or downgrade.
+	// It fails with FAILED_PRECONDITION when the rollback has breaking rule
+	// type changes, such as removing a property an upgrade added to a rule
+	// schema. PreviewSubscriptionUpgrade of the previous version lists them.
+	RollbackBundleSubscription(context.Context, *RollbackBundleSubscriptionRequest) (*RollbackBundleSubscriptionResponse, error)
 	CreateEntityReconciliationTask(context.Context, *CreateEntityReconciliationTaskRequest) (*CreateEntityReconciliationTaskResponse, error)
 	mustEmbedUnimplementedProjectsServiceServer()
 }
@@ -2828,6 +3310,36 @@ func (UnimplementedProjectsServiceServer) UpdateProject(context.Context, *Update
 func (UnimplementedProjectsServiceServer) PatchProject(context.Context, *PatchProjectRequest) (*PatchProjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PatchProject not implemented")
 }
+func (UnimplementedProjectsServiceServer) CreateAlertWebhook(context.Context, *CreateAlertWebhookRequest) (*CreateAlertWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlertWebhook not implemented")
+}
+func (UnimplementedProjectsServiceServer) ListAlertWebhooks(context.Context, *ListAlertWebhooksRequest) (*ListAlertWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlertWebhooks not implemented")
+}
+func (UnimplementedProjectsServiceServer) DeleteAlertWebhook(context.Context, *DeleteAlertWebhookRequest) (*DeleteAlertWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlertWebhook not implemented")
+}
+func (UnimplementedProjectsServiceServer) SetProjectSyncSource(context.Context, *SetProjectSyncSourceRequest) (*SetProjectSyncSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProjectSyncSource not implemented")
+}
+func (UnimplementedProjectsServiceServer) GetProjectSyncStatus(context.Context, *GetProjectSyncStatusRequest) (*GetProjectSyncStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectSyncStatus not implemented")
+}
+func (UnimplementedProjectsServiceServer) DeleteProjectSyncSource(context.Context, *DeleteProjectSyncSourceRequest) (*DeleteProjectSyncSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProjectSyncSource not implemented")
+}
+func (UnimplementedProjectsServiceServer) ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBundles not implemented")
+}
+func (UnimplementedProjectsServiceServer) SubscribeBundle(context.Context, *SubscribeBundleRequest) (*SubscribeBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubscribeBundle not implemented")
+}
+func (UnimplementedProjectsServiceServer) PreviewSubscriptionUpgrade(context.Context, *PreviewSubscriptionUpgradeRequest) (*PreviewSubscriptionUpgradeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewSubscriptionUpgrade not implemented")
+}
+func (UnimplementedProjectsServiceServer) RollbackBundleSubscription(context.Context, *RollbackBundleSubscriptionRequest) (*RollbackBundleSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackBundleSubscription not implemented")
+}
 func (UnimplementedProjectsServiceServer) CreateEntityReconciliationTask(context.Context, *CreateEntityReconciliationTaskRequest) (*CreateEntityReconciliationTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateEntityReconciliationTask not implemented")
 }
@@ -2960,6 +3472,186 @@ func _ProjectsService_PatchProject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectsService_CreateAlertWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).CreateAlertWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_CreateAlertWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).CreateAlertWebhook(ctx, req.(*CreateAlertWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_ListAlertWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ListAlertWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ListAlertWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ListAlertWebhooks(ctx, req.(*ListAlertWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_DeleteAlertWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).DeleteAlertWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_DeleteAlertWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).DeleteAlertWebhook(ctx, req.(*DeleteAlertWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_SetProjectSyncSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectSyncSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).SetProjectSyncSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_SetProjectSyncSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).SetProjectSyncSource(ctx, req.(*SetProjectSyncSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_GetProjectSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).GetProjectSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_GetProjectSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).GetProjectSyncStatus(ctx, req.(*GetProjectSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_DeleteProjectSyncSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectSyncSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).DeleteProjectSyncSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_DeleteProjectSyncSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).DeleteProjectSyncSource(ctx, req.(*DeleteProjectSyncSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_ListBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).ListBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_ListBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).ListBundles(ctx, req.(*ListBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_SubscribeBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).SubscribeBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_SubscribeBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).SubscribeBundle(ctx, req.(*SubscribeBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_PreviewSubscriptionUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSubscriptionUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).PreviewSubscriptionUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_PreviewSubscriptionUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).PreviewSubscriptionUpgrade(ctx, req.(*PreviewSubscriptionUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectsService_RollbackBundleSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackBundleSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServiceServer).RollbackBundleSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectsService_RollbackBundleSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServiceServer).RollbackBundleSubscription(ctx, req.(*RollbackBundleSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectsService_CreateEntityReconciliationTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEntityReconciliationTaskRequest)
 	if err := dec(in); err != nil {
@@ -3009,6 +3701,46 @@ var ProjectsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PatchProject",
 			Handler:    _ProjectsService_PatchProject_Handler,
 		},
+		{
+			MethodName: "CreateAlertWebhook",
+			Handler:    _ProjectsService_CreateAlertWebhook_Handler,
+		},
+		{
+			MethodName: "ListAlertWebhooks",
+			Handler:    _ProjectsService_ListAlertWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteAlertWebhook",
+			Handler:    _ProjectsService_DeleteAlertWebhook_Handler,
+		},
+		{
+			MethodName: "SetProjectSyncSource",
+			Handler:    _ProjectsService_SetProjectSyncSource_Handler,
+		},
+		{
+			MethodName: "GetProjectSyncStatus",
+			Handler:    _ProjectsService_GetProjectSyncStatus_Handler,
+		},
+		{
+			MethodName: "DeleteProjectSyncSource",
+			Handler:    _ProjectsService_DeleteProjectSyncSource_Handler,
+		},
+		{
+			MethodName: "ListBundles",
+			Handler:    _ProjectsService_ListBundles_Handler,
+		},
+		{
+			MethodName: "SubscribeBundle",
+			Handler:    _ProjectsService_SubscribeBundle_Handler,
+		},
+		{
+			MethodName: "PreviewSubscriptionUpgrade",
+			Handler:    _ProjectsService_PreviewSubscriptionUpgrade_Handler,
+		},
+		{
+			MethodName: "RollbackBundleSubscription",
+			Handler:    _ProjectsService_RollbackBundleSubscription_Handler,
+		},
 		{
 			MethodName: "CreateEntityReconciliationTask",
 			Handler:    _ProjectsService_CreateEntityReconciliationTask_Handler,
@@ -434,6 +434,26 @@ func (mr *MockProfileServiceClientMockRecorder) CreateProfile(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).CreateProfile), varargs...)
 }
 
+// CreateRuleExemption mocks base method.
+func (m *MockProfileServiceClient) CreateRuleExemption(ctx context.Context, in *v1.CreateRuleExemptionRequest, opts ...grpc.CallOption) (*v1.CreateRuleExemptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRuleExemption", varargs...)
+	ret0, _ := ret[0].(*v1.CreateRuleExemptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRuleExemption indicates an expected call of CreateRuleExemption.
+func (mr *MockProfileServiceClientMockRecorder) CreateRuleExemption(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRuleExemption", reflect.TypeOf((*MockProfileServiceClient)(nil).CreateRuleExemption), varargs...)
+}
+
 // DeleteProfile mocks base method.
 func (m *MockProfileServiceClient) DeleteProfile(ctx context.Context, in *v1.DeleteProfileRequest, opts ...grpc.CallOption) (*v1.DeleteProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +474,26 @@ func (mr *MockProfileServiceClientMockRecorder) DeleteProfile(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).DeleteProfile), varargs...)
 }
 
+// DeleteRuleExemption mocks base method.
+func (m *MockProfileServiceClient) DeleteRuleExemption(ctx context.Context, in *v1.DeleteRuleExemptionRequest, opts ...grpc.CallOption) (*v1.DeleteRuleExemptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRuleExemption", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteRuleExemptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRuleExemption indicates an expected call of DeleteRuleExemption.
+func (mr *MockProfileServiceClientMockRecorder) DeleteRuleExemption(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRuleExemption", reflect.TypeOf((*MockProfileServiceClient)(nil).DeleteRuleExemption), varargs...)
+}
+
 // GetProfileById mocks base method.
 func (m *MockProfileServiceClient) GetProfileById(ctx context.Context, in *v1.GetProfileByIdRequest, opts ...grpc.CallOption) (*v1.GetProfileByIdResponse, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +594,26 @@ func (mr *MockProfileServiceClientMockRecorder) GetProfileStatusByProject(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileStatusByProject", reflect.TypeOf((*MockProfileServiceClient)(nil).GetProfileStatusByProject), varargs...)
 }
 
+// GetRuleExemption mocks base method.
+func (m *MockProfileServiceClient) GetRuleExemption(ctx context.Context, in *v1.GetRuleExemptionRequest, opts ...grpc.CallOption) (*v1.GetRuleExemptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRuleExemption", varargs...)
+	ret0, _ := ret[0].(*v1.GetRuleExemptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleExemption indicates an expected call of GetRuleExemption.
+func (mr *MockProfileServiceClientMockRecorder) GetRuleExemption(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleExemption", reflect.TypeOf((*MockProfileServiceClient)(nil).GetRuleExemption), varargs...)
+}
+
 // ListProfiles mocks base method.
 func (m *MockProfileServiceClient) ListProfiles(ctx context.Context, in *v1.ListProfilesRequest, opts ...grpc.CallOption) (*v1.ListProfilesResponse, error) {
 	m.ctrl.T.Helper()
@@ -574,6 +634,26 @@ func (mr *MockProfileServiceClientMockRecorder) ListProfiles(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfiles", reflect.TypeOf((*MockProfileServiceClient)(nil).ListProfiles), varargs...)
 }
 
+// ListRuleExemptions mocks base method.
+func (m *MockProfileServiceClient) ListRuleExemptions(ctx context.Context, in *v1.ListRuleExemptionsRequest, opts ...grpc.CallOption) (*v1.ListRuleExemptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRuleExemptions", varargs...)
+	ret0, _ := ret[0].(*v1.ListRuleExemptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleExemptions indicates an expected call of ListRuleExemptions.
+func (mr *MockProfileServiceClientMockRecorder) ListRuleExemptions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleExemptions", reflect.TypeOf((*MockProfileServiceClient)(nil).ListRuleExemptions), varargs...)
+}
+
 // PatchProfile mocks base method.
 func (m *MockProfileServiceClient) PatchProfile(ctx context.Context, in *v1.PatchProfileRequest, opts ...grpc.CallOption) (*v1.PatchProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -594,6 +674,26 @@ func (mr *MockProfileServiceClientMockRecorder) PatchProfile(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).PatchProfile), varargs...)
 }
 
+// PlanProfile mocks base method.
+func (m *MockProfileServiceClient) PlanProfile(ctx context.Context, in *v1.PlanProfileRequest, opts ...grpc.CallOption) (*v1.PlanProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PlanProfile", varargs...)
+	ret0, _ := ret[0].(*v1.PlanProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanProfile indicates an expected call of PlanProfile.
+func (mr *MockProfileServiceClientMockRecorder) PlanProfile(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).PlanProfile), varargs...)
+}
+
 // UpdateProfile mocks base method.
 func (m *MockProfileServiceClient) UpdateProfile(ctx context.Context, in *v1.UpdateProfileRequest, opts ...grpc.CallOption) (*v1.UpdateProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -614,6 +714,26 @@ func (mr *MockProfileServiceClientMockRecorder) UpdateProfile(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockProfileServiceClient)(nil).UpdateProfile), varargs...)
 }
 
+// UpdateRuleExemption mocks base method.
+func (m *MockProfileServiceClient) UpdateRuleExemption(ctx context.Context, in *v1.UpdateRuleExemptionRequest, opts ...grpc.CallOption) (*v1.UpdateRuleExemptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRuleExemption", varargs...)
+	ret0, _ := ret[0].(*v1.UpdateRuleExemptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRuleExemption indicates an expected call of UpdateRuleExemption.
+func (mr *MockProfileServiceClientMockRecorder) UpdateRuleExemption(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRuleExemption", reflect.TypeOf((*MockProfileServiceClient)(nil).UpdateRuleExemption), varargs...)
+}
+
 // MockProjectsServiceClient is a mock of ProjectsServiceClient interface.
 type MockProjectsServiceClient struct {
 	ctrl     *gomock.Controller
@@ -638,6 +758,26 @@ func (m *MockProjectsServiceClient) EXPECT() *MockProjectsServiceClientMockRecor
 	return m.recorder
 }
 
+// CreateAlertWebhook mocks base method.
+func (m *MockProjectsServiceClient) CreateAlertWebhook(ctx context.Context, in *v1.CreateAlertWebhookRequest, opts ...grpc.CallOption) (*v1.CreateAlertWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAlertWebhook", varargs...)
+	ret0, _ := ret[0].(*v1.CreateAlertWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertWebhook indicates an expected call of CreateAlertWebhook.
+func (mr *MockProjectsServiceClientMockRecorder) CreateAlertWebhook(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertWebhook", reflect.TypeOf((*MockProjectsServiceClient)(nil).CreateAlertWebhook), varargs...)
+}
+
 // CreateEntityReconciliationTask mocks base method.
 func (m *MockProjectsServiceClient) CreateEntityReconciliationTask(ctx context.Context, in *v1.CreateEntityReconciliationTaskRequest, opts ...grpc.CallOption) (*v1.CreateEntityReconciliationTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -678,6 +818,26 @@ func (mr *MockProjectsServiceClientMockRecorder) CreateProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectsServiceClient)(nil).CreateProject), varargs...)
 }
 
+// DeleteAlertWebhook mocks base method.
+func (m *MockProjectsServiceClient) DeleteAlertWebhook(ctx context.Context, in *v1.DeleteAlertWebhookRequest, opts ...grpc.CallOption) (*v1.DeleteAlertWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAlertWebhook", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteAlertWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlertWebhook indicates an expected call of DeleteAlertWebhook.
+func (mr *MockProjectsServiceClientMockRecorder) DeleteAlertWebhook(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertWebhook", reflect.TypeOf((*MockProjectsServiceClient)(nil).DeleteAlertWebhook), varargs...)
+}
+
 // DeleteProject mocks base method.
 func (m *MockProjectsServiceClient) DeleteProject(ctx context.Context, in *v1.DeleteProjectRequest, opts ...grpc.CallOption) (*v1.DeleteProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +858,86 @@ func (mr *MockProjectsServiceClientMockRecorder) DeleteProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectsServiceClient)(nil).DeleteProject), varargs...)
 }
 
+// DeleteProjectSyncSource mocks base method.
+func (m *MockProjectsServiceClient) DeleteProjectSyncSource(ctx context.Context, in *v1.DeleteProjectSyncSourceRequest, opts ...grpc.CallOption) (*v1.DeleteProjectSyncSourceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProjectSyncSource", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteProjectSyncSourceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectSyncSource indicates an expected call of DeleteProjectSyncSource.
+func (mr *MockProjectsServiceClientMockRecorder) DeleteProjectSyncSource(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSyncSource", reflect.TypeOf((*MockProjectsServiceClient)(nil).DeleteProjectSyncSource), varargs...)
+}
+
+// GetProjectSyncStatus mocks base method.
+func (m *MockProjectsServiceClient) GetProjectSyncStatus(ctx context.Context, in *v1.GetProjectSyncStatusRequest, opts ...grpc.CallOption) (*v1.GetProjectSyncStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectSyncStatus", varargs...)
+	ret0, _ := ret[0].(*v1.GetProjectSyncStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectSyncStatus indicates an expected call of GetProjectSyncStatus.
+func (mr *MockProjectsServiceClientMockRecorder) GetProjectSyncStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectSyncStatus", reflect.TypeOf((*MockProjectsServiceClient)(nil).GetProjectSyncStatus), varargs...)
+}
+
+// ListAlertWebhooks mocks base method.
+func (m *MockProjectsServiceClient) ListAlertWebhooks(ctx context.Context, in *v1.ListAlertWebhooksRequest, opts ...grpc.CallOption) (*v1.ListAlertWebhooksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAlertWebhooks", varargs...)
+	ret0, _ := ret[0].(*v1.ListAlertWebhooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertWebhooks indicates an expected call of ListAlertWebhooks.
+func (mr *MockProjectsServiceClientMockRecorder) ListAlertWebhooks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertWebhooks", reflect.TypeOf((*MockProjectsServiceClient)(nil).ListAlertWebhooks), varargs...)
+}
+
+// ListBundles mocks base method.
+func (m *MockProjectsServiceClient) ListBundles(ctx context.Context, in *v1.ListBundlesRequest, opts ...grpc.CallOption) (*v1.ListBundlesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBundles", varargs...)
+	ret0, _ := ret[0].(*v1.ListBundlesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBundles indicates an expected call of ListBundles.
+func (mr *MockProjectsServiceClientMockRecorder) ListBundles(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBundles", reflect.TypeOf((*MockProjectsServiceClient)(nil).ListBundles), varargs...)
+}
+
 // ListChildProjects mocks base method.
 func (m *MockProjectsServiceClient) ListChildProjects(ctx context.Context, in *v1.ListChildProjectsRequest, opts ...grpc.CallOption) (*v1.ListChildProjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -758,6 +998,86 @@ func (mr *MockProjectsServiceClientMockRecorder) PatchProject(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchProject", reflect.TypeOf((*MockProjectsServiceClient)(nil).PatchProject), varargs...)
 }
 
+// PreviewSubscriptionUpgrade mocks base method.
+func (m *MockProjectsServiceClient) PreviewSubscriptionUpgrade(ctx context.Context, in *v1.PreviewSubscriptionUpgradeRequest, opts ...grpc.CallOption) (*v1.PreviewSubscriptionUpgradeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewSubscriptionUpgrade", varargs...)
+	ret0, _ := ret[0].(*v1.PreviewSubscriptionUpgradeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewSubscriptionUpgrade indicates an expected call of PreviewSubscriptionUpgrade.
+func (mr *MockProjectsServiceClientMockRecorder) PreviewSubscriptionUpgrade(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewSubscriptionUpgrade", reflect.TypeOf((*MockProjectsServiceClient)(nil).PreviewSubscriptionUpgrade), varargs...)
+}
+
+// RollbackBundleSubscription mocks base method.
+func (m *MockProjectsServiceClient) RollbackBundleSubscription(ctx context.Context, in *v1.RollbackBundleSubscriptionRequest, opts ...grpc.CallOption) (*v1.RollbackBundleSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackBundleSubscription", varargs...)
+	ret0, _ := ret[0].(*v1.RollbackBundleSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackBundleSubscription indicates an expected call of RollbackBundleSubscription.
+func (mr *MockProjectsServiceClientMockRecorder) RollbackBundleSubscription(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBundleSubscription", reflect.TypeOf((*MockProjectsServiceClient)(nil).RollbackBundleSubscription), varargs...)
+}
+
+// SetProjectSyncSource mocks base method.
+func (m *MockProjectsServiceClient) SetProjectSyncSource(ctx context.Context, in *v1.SetProjectSyncSourceRequest, opts ...grpc.CallOption) (*v1.SetProjectSyncSourceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetProjectSyncSource", varargs...)
+	ret0, _ := ret[0].(*v1.SetProjectSyncSourceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectSyncSource indicates an expected call of SetProjectSyncSource.
+func (mr *MockProjectsServiceClientMockRecorder) SetProjectSyncSource(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectSyncSource", reflect.TypeOf((*MockProjectsServiceClient)(nil).SetProjectSyncSource), varargs...)
+}
+
+// SubscribeBundle mocks base method.
+func (m *MockProjectsServiceClient) SubscribeBundle(ctx context.Context, in *v1.SubscribeBundleRequest, opts ...grpc.CallOption) (*v1.SubscribeBundleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeBundle", varargs...)
+	ret0, _ := ret[0].(*v1.SubscribeBundleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeBundle indicates an expected call of SubscribeBundle.
+func (mr *MockProjectsServiceClientMockRecorder) SubscribeBundle(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeBundle", reflect.TypeOf((*MockProjectsServiceClient)(nil).SubscribeBundle), varargs...)
+}
+
 // UpdateProject mocks base method.
 func (m *MockProjectsServiceClient) UpdateProject(ctx context.Context, in *v1.UpdateProjectRequest, opts ...grpc.CallOption) (*v1.UpdateProjectResponse, error) {
 	m.ctrl.T.Helper()
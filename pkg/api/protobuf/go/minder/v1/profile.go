@@ -25,3 +25,11 @@ func (r *UpdateProfileRequest) GetContext() *Context {
 	}
 	return nil
 }
+
+// GetContext returns the context from the nested Profile
+func (r *PlanProfileRequest) GetContext() *Context {
+	if r != nil && r.Profile != nil {
+		return r.Profile.Context
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// minScheduleInterval is the shortest interval a profile schedule may ask for.
+	// The reminder service runs on a coarser cadence anyway.
+	minScheduleInterval = time.Minute
+	clockLayout         = "15:04"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Validate checks that the schedule can be applied. A nil schedule is valid.
+func (s *Profile_Schedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if _, err := s.GetIntervalDuration(); err != nil {
+		return err
+	}
+
+	for i, w := range s.GetBlackoutWindows() {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("blackout window %d is invalid: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// GetIntervalDuration returns the interval of the schedule, or zero if
+// the schedule does not set one.
+func (s *Profile_Schedule) GetIntervalDuration() (time.Duration, error) {
+	if s.GetInterval() == "" {
+		return 0, nil
+	}
+
+	interval, err := time.ParseDuration(s.GetInterval())
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval %q: %w", s.GetInterval(), err)
+	}
+	if interval < minScheduleInterval {
+		return 0, fmt.Errorf("interval %s is shorter than %s", interval, minScheduleInterval)
+	}
+
+	return interval, nil
+}
+
+// InBlackout returns true if t falls into any of the blackout windows
+// of the schedule.
+func (s *Profile_Schedule) InBlackout(t time.Time) bool {
+	for _, w := range s.GetBlackoutWindows() {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the blackout window is well-formed
+func (w *Profile_BlackoutWindow) Validate() error {
+	if _, err := parseClock(w.GetStart()); err != nil {
+		return fmt.Errorf("invalid start: %w", err)
+	}
+	if _, err := parseClock(w.GetEnd()); err != nil {
+		return fmt.Errorf("invalid end: %w", err)
+	}
+	for _, d := range w.GetDays() {
+		if _, ok := weekdays[d]; !ok {
+			return fmt.Errorf("invalid day %q", d)
+		}
+	}
+	if _, err := w.location(); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", w.GetTimezone(), err)
+	}
+	return nil
+}
+
+// Contains returns true if t falls into the blackout window. A window
+// whose end is not after its start spans midnight, and one whose end
+// equals its start lasts a whole day. Days refer to the day the window
+// starts on. Malformed windows never contain any time.
+func (w *Profile_BlackoutWindow) Contains(t time.Time) bool {
+	start, err := parseClock(w.GetStart())
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(w.GetEnd())
+	if err != nil {
+		return false
+	}
+	loc, err := w.location()
+	if err != nil {
+		return false
+	}
+
+	local := t.In(loc)
+	now := local.Hour()*60 + local.Minute()
+
+	if start < end {
+		return now >= start && now < end && w.onDay(local.Weekday())
+	}
+
+	// The window spans midnight, so it either started today or yesterday
+	if now >= start {
+		return w.onDay(local.Weekday())
+	}
+	return now < end && w.onDay(local.AddDate(0, 0, -1).Weekday())
+}
+
+func (w *Profile_BlackoutWindow) onDay(day time.Weekday) bool {
+	if len(w.GetDays()) == 0 {
+		return true
+	}
+	for _, d := range w.GetDays() {
+		if weekdays[d] == day {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Profile_BlackoutWindow) location() (*time.Location, error) {
+	if w.GetTimezone() == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(w.GetTimezone())
+}
+
+// parseClock returns the minutes since midnight of a HH:MM time of day
+func parseClock(s string) (int, error) {
+	if s == "" {
+		return 0, errors.New("time of day is required")
+	}
+	t, err := time.Parse(clockLayout, s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileSchedule_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		schedule *Profile_Schedule
+		errMsg   string
+	}{
+		{
+			name: "nil schedule",
+		},
+		{
+			name:     "empty schedule",
+			schedule: &Profile_Schedule{},
+		},
+		{
+			name: "valid schedule",
+			schedule: &Profile_Schedule{
+				Interval: "24h",
+				BlackoutWindows: []*Profile_BlackoutWindow{
+					{Start: "22:00", End: "06:00", Days: []string{"fri", "sat"}, Timezone: "Europe/Madrid"},
+				},
+			},
+		},
+		{
+			name:     "invalid interval",
+			schedule: &Profile_Schedule{Interval: "daily"},
+			errMsg:   "invalid interval",
+		},
+		{
+			name:     "interval too short",
+			schedule: &Profile_Schedule{Interval: "10s"},
+			errMsg:   "shorter than",
+		},
+		{
+			name: "missing start",
+			schedule: &Profile_Schedule{
+				BlackoutWindows: []*Profile_BlackoutWindow{{End: "06:00"}},
+			},
+			errMsg: "invalid start",
+		},
+		{
+			name: "invalid day",
+			schedule: &Profile_Schedule{
+				BlackoutWindows: []*Profile_BlackoutWindow{{Start: "01:00", End: "02:00", Days: []string{"monday"}}},
+			},
+			errMsg: "invalid day",
+		},
+		{
+			name: "invalid timezone",
+			schedule: &Profile_Schedule{
+				BlackoutWindows: []*Profile_BlackoutWindow{{Start: "01:00", End: "02:00", Timezone: "Mars/Olympus"}},
+			},
+			errMsg: "invalid timezone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.schedule.Validate()
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestProfileBlackoutWindow_Contains(t *testing.T) {
+	t.Parallel()
+
+	// 2026-01-02 is a Friday
+	friday := func(hour, minute int) time.Time {
+		return time.Date(2026, time.January, 2, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		window *Profile_BlackoutWindow
+		at     time.Time
+		want   bool
+	}{
+		{
+			name:   "inside same-day window",
+			window: &Profile_BlackoutWindow{Start: "09:00", End: "17:00"},
+			at:     friday(12, 0),
+			want:   true,
+		},
+		{
+			name:   "end is exclusive",
+			window: &Profile_BlackoutWindow{Start: "09:00", End: "17:00"},
+			at:     friday(17, 0),
+			want:   false,
+		},
+		{
+			name:   "other day",
+			window: &Profile_BlackoutWindow{Start: "09:00", End: "17:00", Days: []string{"mon"}},
+			at:     friday(12, 0),
+			want:   false,
+		},
+		{
+			name:   "before midnight of overnight window",
+			window: &Profile_BlackoutWindow{Start: "22:00", End: "06:00", Days: []string{"fri"}},
+			at:     friday(23, 0),
+			want:   true,
+		},
+		{
+			name:   "after midnight of overnight window started the day before",
+			window: &Profile_BlackoutWindow{Start: "22:00", End: "06:00", Days: []string{"thu"}},
+			at:     friday(5, 0),
+			want:   true,
+		},
+		{
+			name:   "after midnight of overnight window started on another day",
+			window: &Profile_BlackoutWindow{Start: "22:00", End: "06:00", Days: []string{"fri"}},
+			at:     friday(5, 0),
+			want:   false,
+		},
+		{
+			name:   "whole day window",
+			window: &Profile_BlackoutWindow{Start: "00:00", End: "00:00", Days: []string{"fri"}},
+			at:     friday(15, 30),
+			want:   true,
+		},
+		{
+			name:   "timezone",
+			window: &Profile_BlackoutWindow{Start: "09:00", End: "10:00", Timezone: "America/New_York"},
+			at:     friday(14, 30),
+			want:   true,
+		},
+		{
+			name:   "malformed window",
+			window: &Profile_BlackoutWindow{Start: "9am", End: "10:00"},
+			at:     friday(9, 30),
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.window.Contains(tt.at))
+		})
+	}
+}
@@ -6,6 +6,7 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -113,6 +114,52 @@ func (d *DockerHubProviderConfig) Validate() error {
 	return nil
 }
 
+// Ensure BitbucketProviderConfig implements the Validator interface
+var _ Validator = (*BitbucketProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (b *BitbucketProviderConfig) Validate() error {
+	switch b.GetDeployment() {
+	case "", "cloud":
+		return nil
+	case "server":
+		// There is no well-known endpoint for self-hosted deployments
+		if b.GetEndpoint() == "" {
+			return fmt.Errorf("endpoint is required for bitbucket server")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown deployment %q, must be one of cloud or server", b.GetDeployment())
+	}
+}
+
+// Ensure GiteaProviderConfig implements the Validator interface
+var _ Validator = (*GiteaProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (g *GiteaProviderConfig) Validate() error {
+	// Unlike other forges, Gitea is mostly self-hosted, so make sure
+	// we don't end up sending credentials somewhere unexpected.
+	if g.GetEndpoint() == "" {
+		return nil
+	}
+
+	u, err := url.Parse(g.GetEndpoint())
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("endpoint must be an http or https URL")
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("endpoint must have a host")
+	}
+
+	return nil
+}
+
 // Ensure Entity implements the Validator interface
 var _ Validator = (*Entity)(nil)
 
@@ -209,6 +256,10 @@ func (ev *RuleType_Definition_Eval) Validate() error {
 		if err := ev.GetRego().Validate(); err != nil {
 			return err
 		}
+	case "cel":
+		if err := ev.GetCel().Validate(); err != nil {
+			return err
+		}
 	case "jq":
 		if len(ev.GetJq()) == 0 {
 			return fmt.Errorf("%w: jq definition is empty", ErrInvalidRuleTypeDefinition)
@@ -257,6 +308,22 @@ func (rego *RuleType_Definition_Eval_Rego) Validate() error {
 	return nil
 }
 
+// Validate validates a rule type definition eval cel
+func (c *RuleType_Definition_Eval_CEL) Validate() error {
+	if c == nil {
+		return fmt.Errorf("%w: cel is nil", ErrInvalidRuleTypeDefinition)
+	}
+
+	if c.Def == "" {
+		return fmt.Errorf("%w: cel definition is empty", ErrInvalidRuleTypeDefinition)
+	}
+
+	// CEL compilation and type checking is done by the evaluator, as this
+	// package can't import the CEL environment without a circular dependency.
+
+	return nil
+}
+
 // Validate validates a rule type definition eval jq
 func (jq *RuleType_Definition_Eval_JQComparison) Validate() error {
 	if jq == nil {
@@ -368,6 +435,14 @@ func (alert *RuleType_Definition_Alert) Validate() error {
 		if err := alert.GetPullRequestComment().Validate(); err != nil {
 			return err
 		}
+	case "webhook":
+		if err := alert.GetWebhook().Validate(); err != nil {
+			return err
+		}
+	case "issue":
+		if err := alert.GetIssue().Validate(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("%w: alert type cannot be empty", ErrInvalidRuleTypeDefinition)
 	}
@@ -401,6 +476,48 @@ func (comment *RuleType_Definition_Alert_AlertTypePRComment) Validate() error {
 	return nil
 }
 
+// Validate validates a rule type alert webhook
+func (wh *RuleType_Definition_Alert_AlertTypeWebhook) Validate() error {
+	if wh == nil {
+		return fmt.Errorf("%w: webhook is nil", ErrInvalidRuleTypeDefinition)
+	}
+
+	if wh.GetWebhook() == "" {
+		return fmt.Errorf("%w: webhook name cannot be empty", ErrInvalidRuleTypeDefinition)
+	}
+
+	if wh.Message != nil {
+		if _, err := util.NewSafeTextTemplate(wh.Message, "message"); err != nil {
+			return fmt.Errorf("%w: webhook message is not parsable: %w", ErrInvalidRuleTypeDefinition, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates a rule type alert issue
+func (issue *RuleType_Definition_Alert_AlertTypeIssue) Validate() error {
+	if issue == nil {
+		return fmt.Errorf("%w: issue is nil", ErrInvalidRuleTypeDefinition)
+	}
+
+	templates := map[string]*string{
+		"title":   issue.Title,
+		"body":    issue.Body,
+		"comment": issue.Comment,
+	}
+	for name, tmpl := range templates {
+		if tmpl == nil {
+			continue
+		}
+		if _, err := util.NewSafeTextTemplate(tmpl, name); err != nil {
+			return fmt.Errorf("%w: issue %s is not parsable: %w", ErrInvalidRuleTypeDefinition, name, err)
+		}
+	}
+
+	return nil
+}
+
 // Validate validates a rule type definition remediate
 func (rem *RuleType_Definition_Remediate) Validate() error {
 	if rem == nil {
@@ -583,6 +700,10 @@ func (p *Profile) Validate() error {
 		}
 	}
 
+	if err := p.GetSchedule().Validate(); err != nil {
+		return fmt.Errorf("%w: schedule is invalid: %w", ErrValidationFailed, err)
+	}
+
 	return nil
 }
 
@@ -725,6 +846,123 @@ func (rest *RestDataSource_Def) Validate() error {
 	return nil
 }
 
+// Validate is the entrypoint for the actual driver's validation
+func (dsGraphQLDriver *DataSource_Graphql) Validate() error {
+	if dsGraphQLDriver == nil || dsGraphQLDriver.Graphql == nil {
+		return fmt.Errorf("%w: graphql driver is nil", ErrValidationFailed)
+	}
+
+	return dsGraphQLDriver.Graphql.Validate()
+}
+
+// Validate validates a GraphQL data source
+func (gql *GraphQLDataSource) Validate() error {
+	if gql == nil {
+		return fmt.Errorf("%w: graphql data source is nil", ErrValidationFailed)
+	}
+
+	if len(gql.GetDef()) == 0 {
+		return fmt.Errorf("%w: graphql definition is empty", ErrValidationFailed)
+	}
+
+	var errs []error
+	for i, def := range gql.GetDef() {
+		if i == "" {
+			errs = append(errs, fmt.Errorf("graphql function name %s is empty", i))
+		}
+
+		if err := def.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("graphql function %s is invalid: %w", i, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+// Validate validates a GraphQL function
+func (gql *GraphQLDataSource_Def) Validate() error {
+	if gql == nil {
+		return fmt.Errorf("%w: graphql function is nil", ErrValidationFailed)
+	}
+
+	if gql.GetEndpoint() == "" {
+		return fmt.Errorf("%w: graphql function endpoint is empty", ErrValidationFailed)
+	}
+
+	if gql.GetQuery() == "" {
+		return fmt.Errorf("%w: graphql function query is empty", ErrValidationFailed)
+	}
+
+	if gql.GetInputSchema() == nil {
+		return fmt.Errorf("%w: graphql function input schema is nil", ErrValidationFailed)
+	}
+
+	if gql.GetPagination() != nil && gql.GetPagination().GetPath() == "" {
+		return fmt.Errorf("%w: graphql function pagination path is empty", ErrValidationFailed)
+	}
+
+	return nil
+}
+
+// Validate is the entrypoint for the actual driver's validation
+func (dsSQLDriver *DataSource_Sql) Validate() error {
+	if dsSQLDriver == nil || dsSQLDriver.Sql == nil {
+		return fmt.Errorf("%w: sql driver is nil", ErrValidationFailed)
+	}
+
+	return dsSQLDriver.Sql.Validate()
+}
+
+// Validate validates a SQL data source. The connection string is not
+// required, since it is kept when updating a data source.
+func (sds *SQLDataSource) Validate() error {
+	if sds == nil {
+		return fmt.Errorf("%w: sql data source is nil", ErrValidationFailed)
+	}
+
+	if len(sds.GetDef()) == 0 {
+		return fmt.Errorf("%w: sql definition is empty", ErrValidationFailed)
+	}
+
+	var errs []error
+	for i, def := range sds.GetDef() {
+		if i == "" {
+			errs = append(errs, fmt.Errorf("sql function name %s is empty", i))
+		}
+
+		if err := def.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("sql function %s is invalid: %w", i, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+// Validate validates a SQL function
+func (sds *SQLDataSource_Def) Validate() error {
+	if sds == nil {
+		return fmt.Errorf("%w: sql function is nil", ErrValidationFailed)
+	}
+
+	if sds.GetQuery() == "" {
+		return fmt.Errorf("%w: sql function query is empty", ErrValidationFailed)
+	}
+
+	if sds.GetInputSchema() == nil {
+		return fmt.Errorf("%w: sql function input schema is nil", ErrValidationFailed)
+	}
+
+	return nil
+}
+
 // validate that the given key exists in the given properties.
 // they key must exist in the top level properties. It must contain a default
 // or be marked as required.
@@ -6,6 +6,7 @@ package v1
 import (
 	"testing"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -405,6 +406,56 @@ func TestRuleType_Definition_Alert_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid webhook",
+			alert: &RuleType_Definition_Alert{
+				Type:    "webhook",
+				Webhook: &RuleType_Definition_Alert_AlertTypeWebhook{Webhook: "team"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "webhook without a name",
+			alert: &RuleType_Definition_Alert{
+				Type:    "webhook",
+				Webhook: &RuleType_Definition_Alert_AlertTypeWebhook{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "webhook with an invalid message template",
+			alert: &RuleType_Definition_Alert{
+				Type: "webhook",
+				Webhook: &RuleType_Definition_Alert_AlertTypeWebhook{
+					Webhook: "team",
+					Message: proto.String("{{ .RuleName "),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid issue",
+			alert: &RuleType_Definition_Alert{
+				Type:  "issue",
+				Issue: &RuleType_Definition_Alert_AlertTypeIssue{Title: proto.String("{{ .RuleName }} failed")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing issue configuration",
+			alert: &RuleType_Definition_Alert{
+				Type: "issue",
+			},
+			wantErr: true,
+		},
+		{
+			name: "issue with an invalid body template",
+			alert: &RuleType_Definition_Alert{
+				Type:  "issue",
+				Issue: &RuleType_Definition_Alert_AlertTypeIssue{Body: proto.String("{{ end }}")},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// BitbucketConfig is the configuration for the Bitbucket OAuth providers
+type BitbucketConfig struct {
+	OAuthClientConfig `mapstructure:",squash"`
+
+	// WebhookSecrets is the configuration for the Bitbucket webhook secrets
+	// setup and verification. This is used to verify incoming webhook requests
+	// from Bitbucket, as well as to generate the webhook URL for Bitbucket to send
+	// events to.
+	WebhookSecrets `mapstructure:",squash"`
+
+	// Scopes is the list of scopes to request from the Bitbucket OAuth provider
+	Scopes []string `mapstructure:"scopes"`
+
+	// ServerURL is the base URL of a Bitbucket Server or Data Center
+	// instance, e.g. https://bitbucket.example.com. When set, the OAuth
+	// flow is performed against that instance instead of Bitbucket Cloud.
+	ServerURL string `mapstructure:"server_url"`
+}
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// GiteaConfig is the configuration for the Gitea (and Forgejo) OAuth providers
+type GiteaConfig struct {
+	OAuthClientConfig `mapstructure:",squash"`
+
+	// WebhookSecrets is the configuration for the Gitea webhook secrets
+	// setup and verification. This is used to sign and verify incoming
+	// webhook requests from Gitea.
+	WebhookSecrets `mapstructure:",squash"`
+
+	// Scopes is the list of scopes to request from the Gitea OAuth provider
+	Scopes []string `mapstructure:"scopes"`
+
+	// ServerURL is the base URL of the Gitea or Forgejo instance the OAuth
+	// application is registered in, e.g. https://git.example.com.
+	// Defaults to Codeberg.
+	ServerURL string `mapstructure:"server_url"`
+}
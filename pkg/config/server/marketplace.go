@@ -14,6 +14,11 @@ type ConfigBundleSource string
 const (
 	// TgzSource represents a bundle in a .tar.gz file
 	TgzSource ConfigBundleSource = "tgz"
+	// OCISource represents the versions of a bundle pushed to an OCI
+	// repository, one tag per version
+	OCISource ConfigBundleSource = "oci"
+	// HTTPSSource represents the bundles listed in an index served over HTTPS
+	HTTPSSource ConfigBundleSource = "https"
 	// Unknown is a default value
 	Unknown = "unknown"
 )
@@ -31,16 +36,42 @@ type MarketplaceConfig struct {
 
 // BundleSourceConfig holds details about where the bundle gets loaded from
 type BundleSourceConfig struct {
-	Type     string `mapstructure:"type"`
+	Type string `mapstructure:"type"`
+	// Location is the path of the tarball for tgz sources, the repository
+	// for oci sources and the URL of the index for https sources
 	Location string `mapstructure:"location"`
+	// Pins maps bundle versions, in the form namespace/name@version, to the
+	// digest their content must have. Versions which aren't pinned are
+	// accepted with any digest.
+	Pins map[string]string `mapstructure:"pins"`
+	// Verification holds the signer bundles must be signed by. It is
+	// required for oci and https sources, unless AllowUnverified is set.
+	Verification *BundleVerificationConfig `mapstructure:"verification"`
+	// AllowUnverified accepts the bundles of an oci or https source
+	// without checking their signatures, in place of Verification.
+	AllowUnverified bool `mapstructure:"allow_unverified"`
+}
+
+// BundleVerificationConfig holds the signer the bundles of a source must be
+// signed by, using sigstore
+type BundleVerificationConfig struct {
+	// SigstoreURL is the TUF repository of the sigstore instance used to
+	// verify the signatures. If empty, the public sigstore instance is used.
+	SigstoreURL string `mapstructure:"sigstore_url"`
+	// Issuer is the OIDC issuer of the signer's identity
+	Issuer string `mapstructure:"issuer"`
+	// Identity is the identity of the signer, e.g. the URL of the workflow
+	// which signed the bundles or an email address
+	Identity string `mapstructure:"identity"`
 }
 
 // GetType returns the source as an enum type, or error if invalid
 // TODO: investigate whether mapstructure would allow us to validate during
 // deserialization.
 func (b *BundleSourceConfig) GetType() (ConfigBundleSource, error) {
-	if b.Type == string(TgzSource) {
-		return TgzSource, nil
+	switch ConfigBundleSource(b.Type) {
+	case TgzSource, OCISource, HTTPSSource:
+		return ConfigBundleSource(b.Type), nil
 	}
 	return Unknown, fmt.Errorf("%w: %s", ErrInvalidBundleSource, b.Type)
 }
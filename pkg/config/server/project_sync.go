@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ProjectSyncConfig holds the config for syncing projects from git repositories
+type ProjectSyncConfig struct {
+	// Enabled allows projects to configure a repository to sync from
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// PollInterval is how often the server looks for projects due a sync
+	PollInterval time.Duration `mapstructure:"poll_interval" default:"1m"`
+	// MinInterval is the shortest interval a project may be synced at
+	MinInterval time.Duration `mapstructure:"min_interval" default:"5m"`
+	// BatchSize is the maximum number of projects synced on each poll
+	BatchSize int32 `mapstructure:"batch_size" default:"10"`
+}
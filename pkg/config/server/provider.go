@@ -9,6 +9,8 @@ type ProviderConfig struct {
 	GitHub    *GitHubConfig    `mapstructure:"github"`
 	Git       GitConfig        `mapstructure:"git"`
 	GitLab    *GitLabConfig    `mapstructure:"gitlab"`
+	Bitbucket *BitbucketConfig `mapstructure:"bitbucket"`
+	Gitea     *GiteaConfig     `mapstructure:"gitea"`
 }
 
 // GitConfig provides server-side configuration for Git operations like "clone"
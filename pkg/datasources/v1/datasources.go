@@ -19,6 +19,10 @@ const (
 	DataSourceDriverStruct = "structured"
 	// DataSourceDriverRest is the driver type for a REST data source.
 	DataSourceDriverRest = "rest"
+	// DataSourceDriverGraphQL is the driver type for a GraphQL data source.
+	DataSourceDriverGraphQL = "graphql"
+	// DataSourceDriverSQL is the driver type for a SQL data source.
+	DataSourceDriverSQL = "sql"
 )
 
 // DataSourceFuncKey is the key that uniquely identifies a data source function.
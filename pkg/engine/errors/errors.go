@@ -155,6 +155,12 @@ func NewErrEvaluationSkipped(sfmt string, args ...any) error {
 	return fmt.Errorf("%w: %s", interfaces.ErrEvaluationSkipped, msg)
 }
 
+// NewErrEvaluationWaived creates a new evaluation error for waived rules
+func NewErrEvaluationWaived(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return fmt.Errorf("%w: %s", interfaces.ErrEvaluationWaived, msg)
+}
+
 // ErrEvaluationSkipSilently specifies that the rule was evaluated but skipped silently.
 var ErrEvaluationSkipSilently = errors.New("evaluation skipped silently")
 
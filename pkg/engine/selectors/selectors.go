@@ -186,6 +186,24 @@ func pullRequestEnvFactory() (*cel.Env, error) {
 		"internal.SelectorPullRequest")
 }
 
+// pipelineRunEnvFactory is a factory for creating a CEL environment
+// for the SelectorPipelineRun type representing a pipeline run
+func pipelineRunEnvFactory() (*cel.Env, error) {
+	return newEnvForEntity(
+		"pipeline_run",
+		&internalpb.SelectorPipelineRun{},
+		"internal.SelectorPipelineRun")
+}
+
+// taskRunEnvFactory is a factory for creating a CEL environment
+// for the SelectorTaskRun type representing a task run
+func taskRunEnvFactory() (*cel.Env, error) {
+	return newEnvForEntity(
+		"task_run",
+		&internalpb.SelectorTaskRun{},
+		"internal.SelectorTaskRun")
+}
+
 // newEnvForEntity creates a new CEL environment for an entity. All environments are allowed to
 // use the generic "entity" variable plus the specific entity type is also declared as variable
 // with the appropriate type.
@@ -283,6 +301,8 @@ func NewEnv() *Env {
 		minderv1.Entity_ENTITY_REPOSITORIES:  repoEnvFactory,
 		minderv1.Entity_ENTITY_ARTIFACTS:     artifactEnvFactory,
 		minderv1.Entity_ENTITY_PULL_REQUESTS: pullRequestEnvFactory,
+		minderv1.Entity_ENTITY_PIPELINE_RUN:  pipelineRunEnvFactory,
+		minderv1.Entity_ENTITY_TASK_RUN:      taskRunEnvFactory,
 	}
 
 	entityEnvs := make(map[minderv1.Entity]*entityEnvCache, len(factoryMap))
@@ -516,6 +536,10 @@ func inputAsMap(se *internalpb.SelectorEntity) (map[string]any, error) {
 		value = se.GetArtifact()
 	case minderv1.Entity_ENTITY_PULL_REQUESTS:
 		value = se.GetPullRequest()
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		value = se.GetPipelineRun()
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		value = se.GetTaskRun()
 	default:
 		return nil, fmt.Errorf("unsupported entity type [%d]: %s", se.GetEntityType(), se.GetEntityType().ToString())
 	}
@@ -25,6 +25,8 @@ func TestNewSelectorEngine(t *testing.T) {
 	require.NotNil(t, env.entityEnvs)
 	require.NotNil(t, env.entityEnvs[minderv1.Entity_ENTITY_REPOSITORIES])
 	require.NotNil(t, env.entityEnvs[minderv1.Entity_ENTITY_ARTIFACTS])
+	require.NotNil(t, env.entityEnvs[minderv1.Entity_ENTITY_PIPELINE_RUN])
+	require.NotNil(t, env.entityEnvs[minderv1.Entity_ENTITY_TASK_RUN])
 }
 
 type testProviderSelectorBuilder func() *internalpb.SelectorProvider
@@ -43,6 +45,8 @@ type testRepoOption func(selRepo *internalpb.SelectorRepository)
 type testArtifactOption func(selArtifact *internalpb.SelectorArtifact)
 type testPrOption func(selPr *internalpb.SelectorPullRequest)
 type testGenericOption func(selGen *internalpb.SelectorGeneric)
+type testPipelineRunOption func(selRun *internalpb.SelectorPipelineRun)
+type testTaskRunOption func(selTask *internalpb.SelectorTaskRun)
 
 func newTestArtifactSelectorEntity(provSelBld testProviderSelectorBuilder, artifactOpts ...testArtifactOption) testSelectorEntityBuilder {
 	return func() *internalpb.SelectorEntity {
@@ -153,6 +157,81 @@ func newTestPullRequestSelectorEntity(provSelBld testProviderSelectorBuilder, pr
 	}
 }
 
+func newTestPipelineRunSelectorEntity(
+	provSelBld testProviderSelectorBuilder, runOpts ...testPipelineRunOption,
+) testSelectorEntityBuilder {
+	return func() *internalpb.SelectorEntity {
+		run := &internalpb.SelectorEntity{
+			EntityType: minderv1.Entity_ENTITY_PIPELINE_RUN,
+			Name:       "testorg/testrepo/actions/runs/123",
+			Entity: &internalpb.SelectorEntity_PipelineRun{
+				PipelineRun: &internalpb.SelectorPipelineRun{
+					Name:    "testorg/testrepo/actions/runs/123",
+					Trigger: "pull_request",
+					Actor:   "octocat",
+				},
+			},
+		}
+
+		for _, opt := range runOpts {
+			opt(run.Entity.(*internalpb.SelectorEntity_PipelineRun).PipelineRun)
+		}
+
+		provSel := provSelBld()
+		run.Provider = provSel
+		run.Entity.(*internalpb.SelectorEntity_PipelineRun).PipelineRun.Provider = provSel
+
+		return run
+	}
+}
+
+func pipelineRunFromFork(fromFork bool) testPipelineRunOption {
+	return func(selRun *internalpb.SelectorPipelineRun) {
+		selRun.IsFromFork = &fromFork
+	}
+}
+
+func newTestTaskRunSelectorEntity(provSelBld testProviderSelectorBuilder, taskOpts ...testTaskRunOption) testSelectorEntityBuilder {
+	return func() *internalpb.SelectorEntity {
+		task := &internalpb.SelectorEntity{
+			EntityType: minderv1.Entity_ENTITY_TASK_RUN,
+			Name:       "testorg/testrepo/actions/jobs/456",
+			Entity: &internalpb.SelectorEntity_TaskRun{
+				TaskRun: &internalpb.SelectorTaskRun{
+					Name:         "testorg/testrepo/actions/jobs/456",
+					RunnerLabels: []string{"self-hosted", "linux"},
+				},
+			},
+		}
+
+		for _, opt := range taskOpts {
+			opt(task.Entity.(*internalpb.SelectorEntity_TaskRun).TaskRun)
+		}
+
+		provSel := provSelBld()
+		task.Provider = provSel
+		task.Entity.(*internalpb.SelectorEntity_TaskRun).TaskRun.Provider = provSel
+
+		return task
+	}
+}
+
+func taskRunSelfHosted(selfHosted bool) testTaskRunOption {
+	return func(selTask *internalpb.SelectorTaskRun) {
+		selTask.IsSelfHosted = &selfHosted
+	}
+}
+
+func taskRunWithProperties(properties map[string]any) testTaskRunOption {
+	return func(selTask *internalpb.SelectorTaskRun) {
+		protoProperties, err := structpb.NewStruct(properties)
+		if err != nil {
+			panic(err)
+		}
+		selTask.Properties = protoProperties
+	}
+}
+
 func newTestGenericSelectorEntity(provSelBld testProviderSelectorBuilder, genericOpts ...testGenericOption) testSelectorEntityBuilder {
 	return func() *internalpb.SelectorEntity {
 		generic := &internalpb.SelectorEntity{
@@ -285,6 +364,51 @@ func TestSelectSelectorEntity(t *testing.T) {
 			selectorEntityBld: newTestPullRequestSelectorEntity(newGithubProviderSelector()),
 			selected:          false,
 		},
+		{
+			name: "Pipeline run from a fork triggered by a pull request",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_PIPELINE_RUN,
+					Selector: "pipeline_run.trigger == 'pull_request' && pipeline_run.is_from_fork == true",
+				},
+			},
+			selectorEntityBld: newTestPipelineRunSelectorEntity(newGithubProviderSelector(), pipelineRunFromFork(true)),
+			selected:          true,
+		},
+		{
+			name: "Pipeline run not from a fork",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_PIPELINE_RUN,
+					Selector: "pipeline_run.is_from_fork == true",
+				},
+			},
+			selectorEntityBld: newTestPipelineRunSelectorEntity(newGithubProviderSelector(), pipelineRunFromFork(false)),
+			selected:          false,
+		},
+		{
+			name: "Task run on a self-hosted runner",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_TASK_RUN,
+					Selector: "task_run.is_self_hosted == true && 'linux' in task_run.runner_labels",
+				},
+			},
+			selectorEntityBld: newTestTaskRunSelectorEntity(newGithubProviderSelector(), taskRunSelfHosted(true)),
+			selected:          true,
+		},
+		{
+			name: "Task run property expression",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_TASK_RUN,
+					Selector: "task_run.properties['github/runner_group_name'] == 'GitHub Actions'",
+				},
+			},
+			selectorEntityBld: newTestTaskRunSelectorEntity(newGithubProviderSelector(),
+				taskRunWithProperties(map[string]any{"github/runner_group_name": "Default"})),
+			selected: false,
+		},
 		{
 			name: "Simple true generic entity expression for repo entity type",
 			exprs: []models.ProfileSelector{
@@ -20,6 +20,9 @@ const (
 
 	// EvalStatusSkipped indicates the evaluation was skipped.
 	EvalStatusSkipped EvalStatus = "skipped"
+
+	// EvalStatusWaived indicates the rule was waived by an exemption.
+	EvalStatusWaived EvalStatus = "waived"
 )
 
 // EvaluationSnapshot represents a database-independent snapshot of evaluation state.
@@ -34,6 +34,20 @@ type Evaluator interface {
 	Eval(ctx context.Context, profile map[string]any, entity protoreflect.ProtoMessage, data *Ingested) (*EvaluationResult, error)
 }
 
+// ParamsEvaluator is an optional interface for evaluators which, on top of
+// the rule definition, also need access to the rule parameters set in the
+// profile. The rule type engine calls EvalWithParams instead of Eval when
+// the evaluator implements it.
+type ParamsEvaluator interface {
+	EvalWithParams(
+		ctx context.Context,
+		profile map[string]any,
+		params map[string]any,
+		entity protoreflect.ProtoMessage,
+		data *Ingested,
+	) (*EvaluationResult, error)
+}
+
 // Option is a function that takes an evaluator and does some
 // unspecified operation to it, returning an error in case of failure.
 type Option func(Evaluator) error
@@ -51,6 +65,10 @@ var ErrEvaluationFailed = errors.New("evaluation failure")
 // ErrEvaluationSkipped specifies that the rule was evaluated but skipped.
 var ErrEvaluationSkipped = errors.New("evaluation skipped")
 
+// ErrEvaluationWaived specifies that the rule was not evaluated because
+// an active exemption waives it for the entity.
+var ErrEvaluationWaived = errors.New("evaluation waived")
+
 // Ingested is the result of an ingester
 type Ingested struct {
 	// Object is the object that was ingested. Normally comes from an external
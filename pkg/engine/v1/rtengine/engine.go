@@ -174,6 +174,11 @@ func (r *RuleTypeEngine) Eval(
 
 	// Process evaluation
 	logger.Info().Msg("entity evaluation - evaluation started")
+	if pe, ok := r.ruleEvaluator.(interfaces.ParamsEvaluator); ok {
+		res, err := pe.EvalWithParams(ctx, ruleDef, ruleParams, entity, ingestData)
+		logger.Info().Msg("entity evaluation - evaluation completed")
+		return res, err
+	}
 	res, err := r.ruleEvaluator.Eval(ctx, ruleDef, entity, ingestData)
 	logger.Info().Msg("entity evaluation - evaluation completed")
 	return res, err
@@ -56,3 +56,37 @@ const (
 	// ReleaseCommitSHA represents the commit SHA of the release
 	ReleaseCommitSHA = "commit_sha"
 )
+
+// Pipeline run property keys. Task runs set them as well: the status, conclusion
+// and permissions are those of the task, the rest those of its pipeline run.
+const (
+	// PipelineRunPropertyWorkflowPath represents the path of the file defining the pipeline
+	PipelineRunPropertyWorkflowPath = "workflow_path"
+	// PipelineRunPropertyTrigger represents the event that triggered the run (e.g. 'pull_request')
+	PipelineRunPropertyTrigger = "trigger"
+	// PipelineRunPropertyActor represents the login of the user that triggered the run
+	PipelineRunPropertyActor = "actor"
+	// PipelineRunPropertyBranch represents the branch the run is for
+	PipelineRunPropertyBranch = "branch"
+	// PipelineRunPropertyCommitSHA represents the commit SHA the run is for
+	PipelineRunPropertyCommitSHA = "commit_sha"
+	// PipelineRunPropertyIsFromFork represents whether the run was triggered by a change from a fork
+	PipelineRunPropertyIsFromFork = "is_from_fork"
+	// PipelineRunPropertyStatus represents the status of the run (e.g. 'queued', 'completed')
+	PipelineRunPropertyStatus = "status"
+	// PipelineRunPropertyConclusion represents the outcome of a completed run (e.g. 'success')
+	PipelineRunPropertyConclusion = "conclusion"
+	// PipelineRunPropertyPermissions represents the permissions granted to the run's token,
+	// as declared in the pipeline definition. Unset if the defaults are used.
+	PipelineRunPropertyPermissions = "permissions"
+)
+
+// Task run property keys
+const (
+	// TaskRunPropertyPipelineRunID represents the upstream ID of the pipeline run the task belongs to
+	TaskRunPropertyPipelineRunID = "pipeline_run_id"
+	// TaskRunPropertyRunnerLabels represents the labels of the runner the task runs on
+	TaskRunPropertyRunnerLabels = "runner_labels"
+	// TaskRunPropertyIsSelfHosted represents whether the task runs on a self-hosted runner
+	TaskRunPropertyIsSelfHosted = "is_self_hosted"
+)
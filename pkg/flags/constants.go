@@ -8,6 +8,10 @@ const (
 	DockerHubProvider Experiment = "dockerhub_provider"
 	// GitLabProvider enables the GitLab provider.
 	GitLabProvider Experiment = "gitlab_provider"
+	// BitbucketProvider enables the Bitbucket provider.
+	BitbucketProvider Experiment = "bitbucket_provider"
+	// GiteaProvider enables the Gitea/Forgejo provider.
+	GiteaProvider Experiment = "gitea_provider"
 	// AlternateMessageDriver enables an an alternate message driver.
 	AlternateMessageDriver Experiment = "alternate_message_driver"
 	// ProjectCreateDelete enables creating top-level projects and deleting them.
@@ -18,4 +22,7 @@ const (
 	// to V0) when creating or updating rule types. When disabled, all rule
 	// types are treated as Rego V0.
 	RegoV1DualParse Experiment = "rego_v1_dual_parse"
+	// SQLDataSources enables SQL data sources, which connect to databases
+	// from the minder server.
+	SQLDataSources Experiment = "sql_datasources"
 )
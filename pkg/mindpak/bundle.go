@@ -69,9 +69,21 @@ func NewBundleFromTarGZ(path string) (*Bundle, error) {
 	}
 	defer file.Close()
 
-	gz, err := gzip.NewReader(file)
+	bundle, err := NewBundleFromTarGZReader(file)
 	if err != nil {
-		return nil, fmt.Errorf("error while creating gzip reader for %s: %w", path, err)
+		return nil, fmt.Errorf("error while loading %s: %w", path, err)
+	}
+	return bundle, nil
+}
+
+// NewBundleFromTarGZReader loads a bundle from a stream in the .tar.gz format
+// produced by `mindev bundle build`, such as a download from a registry. As
+// with NewBundleFromTarGZ, the entire contents of the bundle are loaded into
+// memory.
+func NewBundleFromTarGZReader(r io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating gzip reader: %w", err)
 	}
 	defer gz.Close()
 
@@ -85,7 +97,7 @@ func NewBundleFromTarGZ(path string) (*Bundle, error) {
 		Source: sourceFS,
 	}
 	if err := bundle.ReadSource(); err != nil {
-		return nil, fmt.Errorf("reading bundle data from %q: %w", path, err)
+		return nil, fmt.Errorf("reading bundle data: %w", err)
 	}
 
 	return bundle, nil
@@ -24,6 +24,15 @@ const (
 	ManifestFileName = "manifest.json"
 )
 
+const (
+	// OCIArtifactType is the artifact type of bundles pushed to an OCI registry
+	OCIArtifactType = "application/vnd.mindersec.mindpak.v1+json"
+
+	// OCILayerMediaType is the media type of the layer holding the bundle
+	// tarball in bundles pushed to an OCI registry
+	OCILayerMediaType = "application/vnd.mindersec.mindpak.layer.v1.tar+gzip"
+)
+
 const (
 	// SHA256 is the algorith name constant for the manifest and tests
 	SHA256 = HashAlgorithm("sha-256")
@@ -23,6 +23,10 @@ type BundleReader interface {
 	// read it from the bundle, parse it and return an instance of the profile
 	// struct
 	GetProfile(string) (*v1.Profile, error)
+	// ForEachProfile walks each profile in the bundle, attempts to read
+	// and parse the profile, and then applies the specified anonymous
+	// function to the profile
+	ForEachProfile(func(*v1.Profile) error) error
 	// ForEachRuleType walks each rule type in the bundle, attempts to read
 	// and parse the rule type, and then applies the specified anonymous
 	// function to the rule type
@@ -90,6 +94,22 @@ func (b *bundleReader) GetProfile(name string) (*v1.Profile, error) {
 	return profile, nil
 }
 
+func (b *bundleReader) ForEachProfile(fn func(*v1.Profile) error) error {
+	for _, profile := range b.original.Files.Profiles {
+		parsedProfile, err := b.GetProfile(profile.Name)
+		if err != nil {
+			return err
+		}
+
+		// apply operation from caller
+		if err := fn(parsedProfile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (b *bundleReader) ForEachRuleType(fn func(*v1.RuleType) error) error {
 	var err error
 	var file fs.File
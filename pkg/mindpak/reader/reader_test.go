@@ -83,6 +83,28 @@ func TestBundle_GetProfile(t *testing.T) {
 	}
 }
 
+func TestBundle_ForEachProfile(t *testing.T) {
+	t.Parallel()
+	results := []string{}
+	bundle := loadBundle(t, testDataPath)
+	err := bundle.ForEachProfile(func(profile *minderv1.Profile) error {
+		results = append(results, profile.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{expectedProfileName}, results)
+}
+
+func TestBundle_ForEachProfileError(t *testing.T) {
+	t.Parallel()
+	errorMessage := "oh no"
+	bundle := loadBundle(t, testDataPath)
+	err := bundle.ForEachProfile(func(_ *minderv1.Profile) error {
+		return errors.New(errorMessage)
+	})
+	require.ErrorContains(t, err, errorMessage)
+}
+
 func TestBundle_ForEachRuleType(t *testing.T) {
 	t.Parallel()
 	results := []string{}
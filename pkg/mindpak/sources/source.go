@@ -7,6 +7,10 @@ package sources
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
 
 	"github.com/mindersec/minder/pkg/mindpak"
 	"github.com/mindersec/minder/pkg/mindpak/reader"
@@ -24,11 +28,56 @@ type BundleSource interface {
 	ListBundles() ([]mindpak.BundleID, error)
 }
 
+// VersionedBundleSource is a BundleSource which holds several versions of
+// each of its bundles, such as a registry. GetBundle returns the latest
+// version of the bundle.
+type VersionedBundleSource interface {
+	BundleSource
+	// ListVersions lists the versions of a bundle, latest first
+	// ErrBundleNotFound is returned if the bundle cannot be found
+	ListVersions(id mindpak.BundleID) ([]string, error)
+	// GetBundleVersion fetches a specific version of a bundle
+	// ErrBundleVersionNotFound is returned if the version cannot be found
+	GetBundleVersion(id mindpak.BundleID, version string) (reader.BundleReader, error)
+}
+
 var (
 	// ErrBundleNotFound is returned when the specified bundle is not found
 	ErrBundleNotFound = errors.New("bundle not found")
+	// ErrBundleVersionNotFound is returned when the specified version of a
+	// bundle is not found
+	ErrBundleVersionNotFound = errors.New("bundle version not found")
 )
 
+// SortVersions sorts bundle versions latest first. Semantic versions, with or
+// without a leading v, sort before anything else, which is sorted in reverse
+// lexical order.
+func SortVersions(versions []string) {
+	slices.SortStableFunc(versions, func(a, b string) int {
+		sa, sb := canonicalVersion(a), canonicalVersion(b)
+		switch {
+		case sa != "" && sb != "":
+			return semver.Compare(sb, sa)
+		case sa != "":
+			return -1
+		case sb != "":
+			return 1
+		default:
+			return strings.Compare(b, a)
+		}
+	})
+}
+
+func canonicalVersion(version string) string {
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if !semver.IsValid(version) {
+		return ""
+	}
+	return version
+}
+
 // NewSourceFromTarGZ creates a singleBundleSource from a .tar.gz file
 func NewSourceFromTarGZ(path string) (BundleSource, error) {
 	bundle, err := mindpak.NewBundleFromTarGZ(path)
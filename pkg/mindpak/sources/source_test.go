@@ -73,6 +73,13 @@ func TestSingleBundleSource_LoadBundle(t *testing.T) {
 	}
 }
 
+func TestSortVersions(t *testing.T) {
+	t.Parallel()
+	versions := []string{"0.9.0", "latest", "v1.10.0", "1.2.0", "1.2.0-rc.1", "edge"}
+	sources.SortVersions(versions)
+	require.Equal(t, []string{"v1.10.0", "1.2.0", "1.2.0-rc.1", "0.9.0", "latest", "edge"}, versions)
+}
+
 const (
 	invalidPath    = "this is not a path"
 	sampleDataPath = "testdata/bundle.tar.gz"
@@ -135,6 +135,18 @@ func SelectorSliceFromDB(dbSelectors []db.ProfileSelector) []ProfileSelector {
 	return selectors
 }
 
+// SelectorSliceFromPB converts a slice of protobuf profile selectors to a slice of ProfileSelector
+func SelectorSliceFromPB(pbSelectors []*minderv1.Profile_Selector) []ProfileSelector {
+	selectors := make([]ProfileSelector, 0, len(pbSelectors))
+	for _, pbSelector := range pbSelectors {
+		selectors = append(selectors, ProfileSelector{
+			Entity:   minderv1.EntityFromString(pbSelector.GetEntity()),
+			Selector: pbSelector.GetSelector(),
+		})
+	}
+	return selectors
+}
+
 func profileSelectorFromDb(dbSelector db.ProfileSelector) ProfileSelector {
 	ent := minderv1.Entity_ENTITY_UNSPECIFIED
 	if dbSelector.Entity.Valid {
@@ -414,3 +414,50 @@ func TestSelectorSliceFromDB(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectorSliceFromPB(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []*minderv1.Profile_Selector
+		expected []models.ProfileSelector
+	}{
+		{
+			name:     "nil input returns empty slice",
+			input:    nil,
+			expected: []models.ProfileSelector{},
+		},
+		{
+			name: "selectors with and without entity",
+			input: []*minderv1.Profile_Selector{
+				{
+					Entity:   "repository",
+					Selector: "repository.name == 'my-repo'",
+				},
+				{
+					Selector: "entity.name != 'other'",
+				},
+			},
+			expected: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_REPOSITORIES,
+					Selector: "repository.name == 'my-repo'",
+				},
+				{
+					Entity:   minderv1.Entity_ENTITY_UNSPECIFIED,
+					Selector: "entity.name != 'other'",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := models.SelectorSliceFromPB(tt.input)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
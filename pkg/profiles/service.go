@@ -145,6 +145,11 @@ func (p *profileService) CreateProfile(
 		displayName = profile.GetName()
 	}
 
+	schedule, err := scheduleToDB(profile.GetSchedule())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating profile: %v", err)
+	}
+
 	params := db.CreateProfileParams{
 		ProjectID:      projectID,
 		Name:           name,
@@ -153,6 +158,7 @@ func (p *profileService) CreateProfile(
 		Remediate:      db.ValidateRemediateType(profile.GetRemediate()),
 		Alert:          db.ValidateAlertType(profile.GetAlert()),
 		SubscriptionID: uuid.NullUUID{UUID: subscriptionID, Valid: subscriptionID != uuid.Nil},
+		Schedule:       schedule,
 	}
 
 	// Create profile
@@ -242,6 +248,11 @@ func (p *profileService) UpdateProfile(
 		displayName = profile.GetName()
 	}
 
+	schedule, err := scheduleToDB(profile.GetSchedule())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error updating profile: %v", err)
+	}
+
 	// Update top-level profile db object
 	updatedProfile, err := qtx.UpdateProfile(ctx, db.UpdateProfileParams{
 		ProjectID:   projectID,
@@ -250,6 +261,7 @@ func (p *profileService) UpdateProfile(
 		Labels:      profile.GetLabels(),
 		Remediate:   db.ValidateRemediateType(profile.GetRemediate()),
 		Alert:       db.ValidateAlertType(profile.GetAlert()),
+		Schedule:    schedule,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error updating profile: %v", err)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"github.com/sqlc-dev/pqtype"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/mindersec/minder/internal/db"
@@ -214,6 +215,8 @@ func MergeDatabaseListIntoProfiles[T db.ProfileRow](ppl []T) map[string]*pb.Prof
 				newProfile.Alert = proto.String(string(db.ActionTypeOn))
 			}
 
+			newProfile.Schedule = scheduleFromDB(p.GetProfile().Schedule)
+
 			selectorsToProfile(newProfile, p.GetSelectors())
 
 			profiles[profileID] = newProfile
@@ -377,6 +380,8 @@ func dbProfileToPB(p db.Profile) *pb.Profile {
 		outprof.Alert = proto.String(string(db.ActionTypeOn))
 	}
 
+	outprof.Schedule = scheduleFromDB(p.Schedule)
+
 	return outprof
 }
 
@@ -472,3 +477,33 @@ func GetRulesFromProfileOfType(p *pb.Profile, rt *pb.RuleType) ([]*pb.Profile_Ru
 
 	return rules, nil
 }
+
+// scheduleToDB encodes the schedule of a profile for storage. A nil
+// schedule is stored as NULL.
+func scheduleToDB(schedule *pb.Profile_Schedule) (pqtype.NullRawMessage, error) {
+	if schedule == nil {
+		return pqtype.NullRawMessage{}, nil
+	}
+
+	raw, err := protojson.Marshal(schedule)
+	if err != nil {
+		return pqtype.NullRawMessage{}, fmt.Errorf("error marshalling schedule: %w", err)
+	}
+
+	return pqtype.NullRawMessage{RawMessage: raw, Valid: true}, nil
+}
+
+// scheduleFromDB decodes the stored schedule of a profile
+func scheduleFromDB(raw pqtype.NullRawMessage) *pb.Profile_Schedule {
+	if !raw.Valid {
+		return nil
+	}
+
+	schedule := &pb.Profile_Schedule{}
+	if err := protojson.Unmarshal(raw.RawMessage, schedule); err != nil {
+		log.Printf("error unmarshalling profile schedule: %v", err)
+		return nil
+	}
+
+	return schedule
+}
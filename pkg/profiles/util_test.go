@@ -7,9 +7,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/mindersec/minder/internal/db"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/profiles"
 )
@@ -779,3 +782,28 @@ func TestDeriveProfileNameFromDisplayName(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeDatabaseListIntoProfilesSchedule(t *testing.T) {
+	t.Parallel()
+
+	scheduled := uuid.New()
+	unscheduled := uuid.New()
+
+	profs := profiles.MergeDatabaseListIntoProfiles([]db.ListProfilesByProjectIDAndLabelRow{
+		{Profile: db.Profile{
+			ID:   scheduled,
+			Name: "nightly",
+			Schedule: pqtype.NullRawMessage{
+				RawMessage: []byte(`{"interval":"24h","blackoutWindows":[{"start":"09:00","end":"17:00","days":["mon"]}]}`),
+				Valid:      true,
+			},
+		}},
+		{Profile: db.Profile{ID: unscheduled, Name: "default"}},
+	})
+
+	schedule := profs[scheduled.String()].GetSchedule()
+	require.Equal(t, "24h", schedule.GetInterval())
+	require.Len(t, schedule.GetBlackoutWindows(), 1)
+	require.Equal(t, []string{"mon"}, schedule.GetBlackoutWindows()[0].GetDays())
+	require.Nil(t, profs[unscheduled.String()].GetSchedule())
+}
@@ -60,3 +60,17 @@ type GitLabCredential interface {
 	GitCredential
 	OAuth2TokenCredential
 }
+
+// GiteaCredential is the interface for credentials used when interacting with Gitea
+type GiteaCredential interface {
+	RestCredential
+	GitCredential
+	OAuth2TokenCredential
+}
+
+// BitbucketCredential is the interface for credentials used when interacting with Bitbucket
+type BitbucketCredential interface {
+	RestCredential
+	GitCredential
+	OAuth2TokenCredential
+}
@@ -181,6 +181,22 @@ type CommitStatusPublisher interface {
 	SetCommitStatus(ctx context.Context, owner, repo, ref string, status *github.RepoStatus) (*github.RepoStatus, error)
 }
 
+// IssuePublisher is the interface for providers that can open and close issues.
+//
+// This mirrors the GitHub API, and should be common across other Git Forge
+// providers.
+type IssuePublisher interface {
+	Provider
+	// CreateIssue opens an issue in the given repository
+	CreateIssue(ctx context.Context, owner, repo string, req *github.IssueRequest) (*github.Issue, error)
+	// GetIssue gets an issue
+	GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error)
+	// EditIssue updates an issue, e.g. to close or reopen it
+	EditIssue(ctx context.Context, owner, repo string, number int, req *github.IssueRequest) (*github.Issue, error)
+	// CreateIssueComment adds a comment to an issue
+	CreateIssueComment(ctx context.Context, owner, repo string, number int, comment string) (*github.IssueComment, error)
+}
+
 // ReviewPublisher is the interface for providers that can publish PR reviews
 type ReviewPublisher interface {
 	Provider
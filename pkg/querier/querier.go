@@ -90,7 +90,7 @@ func New(ctx context.Context, config *server.Config) (Store, Closer, error) {
 		querier:       store, // use store by default
 		ruleSvc:       ruletypes.NewRuleTypeService(nil),
 		profileSvc:    profiles.NewProfileService(evt, selectors.NewEnv()),
-		dataSourceSvc: datasourceservice.NewDataSourceService(store),
+		dataSourceSvc: datasourceservice.NewDataSourceService(store, nil),
 	}, dbCloser, nil
 }
 
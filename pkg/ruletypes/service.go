@@ -16,6 +16,7 @@ import (
 	"github.com/open-policy-agent/opa/v1/ast"
 
 	"github.com/mindersec/minder/internal/db"
+	celeval "github.com/mindersec/minder/internal/engine/eval/cel"
 	"github.com/mindersec/minder/internal/logger"
 	"github.com/mindersec/minder/internal/marketplaces/namespaces"
 	"github.com/mindersec/minder/internal/util"
@@ -111,6 +112,10 @@ func (s *ruleTypeService) CreateRuleType(
 	if err != nil {
 		return nil, errors.Join(ErrRuleTypeInvalid, err)
 	}
+
+	if err := validateCELDefinition(ruleType); err != nil {
+		return nil, errors.Join(ErrRuleTypeInvalid, err)
+	}
 	if uuidLike.MatchString(ruleType.Name) {
 		return nil, errors.Join(ErrRuleTypeInvalid, errors.New("rule type name must not be UUID-like"))
 	}
@@ -208,6 +213,10 @@ func (s *ruleTypeService) UpdateRuleType(
 		return nil, errors.Join(ErrRuleTypeInvalid, err)
 	}
 
+	if err := validateCELDefinition(ruleType); err != nil {
+		return nil, errors.Join(ErrRuleTypeInvalid, err)
+	}
+
 	ruleTypeName := ruleType.GetName()
 	ruleTypeDef := ruleType.GetDef()
 
@@ -353,6 +362,21 @@ func (s *ruleTypeService) validateAndDetectRegoVersion(ctx context.Context, rule
 	}
 }
 
+// validateCELDefinition compiles the CEL expressions of the rule type, if any,
+// so that syntax and type errors are reported at creation time rather than
+// when the rule is first evaluated.
+func validateCELDefinition(ruleType *pb.RuleType) error {
+	cfg := ruleType.GetDef().GetEval().GetCel()
+	if cfg == nil {
+		return nil
+	}
+
+	if _, err := celeval.NewCELEvaluator(cfg); err != nil {
+		return fmt.Errorf("cel definition is invalid: %w", err)
+	}
+	return nil
+}
+
 func getRuleTypeSeverity(severity *pb.Severity) (*db.Severity, error) {
 	sev := severity.InitializedStringValue()
 	var seval db.Severity
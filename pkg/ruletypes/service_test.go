@@ -338,6 +338,18 @@ func TestRuleTypeService(t *testing.T) {
 			ExpectedError: "rego definition is invalid",
 			TestMethod:    update,
 		},
+		{
+			Name:          "CreateRuleType rejects invalid cel expression",
+			RuleType:      newRuleType(withBasicStructure, withInvalidCEL),
+			ExpectedError: "cel definition is invalid",
+			TestMethod:    create,
+		},
+		{
+			Name:          "UpdateRuleType rejects invalid cel expression",
+			RuleType:      newRuleType(withBasicStructure, withInvalidCEL),
+			ExpectedError: "cel definition is invalid",
+			TestMethod:    update,
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -518,6 +530,15 @@ func withInvalidRego(ruleType *pb.RuleType) {
 	}
 }
 
+func withInvalidCEL(ruleType *pb.RuleType) {
+	ruleType.Def.Eval = &pb.RuleType_Definition_Eval{
+		Type: "cel",
+		Cel: &pb.RuleType_Definition_Eval_CEL{
+			Def: "ingested.enabled ==",
+		},
+	}
+}
+
 func withHierarchyGet(mock dbf.DBMock) {
 	mock.EXPECT().
 		GetParentProjects(gomock.Any(), gomock.Any()).
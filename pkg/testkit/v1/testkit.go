@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 
 	"github.com/mindersec/minder/internal/engine/ingester/git"
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 // TestKit implements a set of interfaces for testing
@@ -23,6 +24,11 @@ type TestKit struct {
 	httpStatus   int
 	httpBody     []byte
 	httpHeaders  map[string]string
+
+	// cassette replays the HTTP interactions, or records them when
+	// recordTo is set
+	cassette *Cassette
+	recordTo provv1.REST
 }
 
 // Option is a functional option type for TestKit
@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+
+	"github.com/google/go-github/v63/github"
+	"gopkg.in/yaml.v3"
+
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// ErrNoRecordedInteraction is returned when a replayed request doesn't
+// match any of the interactions of the cassette
+var ErrNoRecordedInteraction = errors.New("no recorded interaction for request")
+
+// Cassette is a recording of the HTTP interactions made through a
+// provider's REST trait, which can be replayed later on.
+type Cassette struct {
+	// BaseURL is the base URL of the provider the interactions were recorded from
+	BaseURL      string         `yaml:"base_url"`
+	Interactions []*Interaction `yaml:"interactions"`
+
+	mu sync.Mutex
+	// replayed is the set of interactions which were already replayed
+	replayed map[*Interaction]bool
+}
+
+// Interaction is an HTTP request and the response to it
+type Interaction struct {
+	Request  CassetteRequest  `yaml:"request"`
+	Response CassetteResponse `yaml:"response"`
+}
+
+// CassetteRequest is a recorded request. Headers are not recorded, since
+// they carry the provider's credentials.
+type CassetteRequest struct {
+	Method string `yaml:"method"`
+	URL    string `yaml:"url"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response
+type CassetteResponse struct {
+	Status  int                 `yaml:"status"`
+	Headers map[string][]string `yaml:"headers,omitempty"`
+	Body    string              `yaml:"body,omitempty"`
+	// Error is set when the provider returned the response together with
+	// an error, as the GitHub client does for non-2xx statuses
+	Error string `yaml:"error,omitempty"`
+}
+
+// NewCassette creates an empty cassette
+func NewCassette() *Cassette {
+	return &Cassette{}
+}
+
+// LoadCassette reads a cassette from a YAML file
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette: %w", err)
+	}
+
+	c := &Cassette{}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("error decoding cassette %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Save writes the cassette to a YAML file
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("error encoding cassette: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(path), data, 0600); err != nil {
+		return fmt.Errorf("error writing cassette: %w", err)
+	}
+	return nil
+}
+
+// WithCassette is a functional option to serve the HTTP requests made
+// through the REST trait from the interactions recorded in a cassette.
+// Requests are matched by method, URL and body; when several interactions
+// match, they are replayed in the order they were recorded, and the last
+// one is repeated once all of them were used.
+func WithCassette(c *Cassette) Option {
+	return func(tk *TestKit) {
+		tk.cassette = c
+	}
+}
+
+// WithRecorder is a functional option to proxy the HTTP requests made
+// through the REST trait to a real provider, recording the interactions
+// in a cassette.
+func WithRecorder(rest provv1.REST, c *Cassette) Option {
+	return func(tk *TestKit) {
+		tk.cassette = c
+		tk.recordTo = rest
+		c.BaseURL = rest.GetBaseURL()
+	}
+}
+
+func (tk *TestKit) record(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, doErr := tk.recordTo.Do(req.Context(), req)
+	if resp == nil {
+		// nothing to record, e.g. the server couldn't be reached
+		return nil, doErr
+	}
+
+	var ghErr *github.ErrorResponse
+	if doErr != nil && !errors.As(doErr, &ghErr) {
+		return resp, doErr
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	headers := resp.Header.Clone()
+	headers.Del("Set-Cookie")
+
+	interaction := &Interaction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Body:   string(body),
+		},
+		Response: CassetteResponse{
+			Status:  resp.StatusCode,
+			Headers: headers,
+			Body:    string(respBody),
+		},
+	}
+	if ghErr != nil {
+		interaction.Response.Error = ghErr.Message
+	}
+
+	tk.cassette.mu.Lock()
+	tk.cassette.Interactions = append(tk.cassette.Interactions, interaction)
+	tk.cassette.mu.Unlock()
+
+	return resp, doErr
+}
+
+// newRequest creates a request the way the recorded provider would have,
+// resolving the URL against its base URL and encoding the body as JSON
+func (c *Cassette) newRequest(method, rawURL string, body any) (*http.Request, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %w", err)
+	}
+	if !u.IsAbs() && c.BaseURL != "" {
+		base, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL: %w", err)
+		}
+		u = base.ResolveReference(u)
+	}
+
+	var r io.Reader
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		r = bytes.NewReader(b)
+	default:
+		buf := &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(body); err != nil {
+			return nil, fmt.Errorf("error encoding request body: %w", err)
+		}
+		r = buf
+	}
+
+	return http.NewRequest(method, u.String(), r)
+}
+
+func (tk *TestKit) replay(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := tk.cassette.match(req.Method, req.URL.String(), string(body))
+	if interaction == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoRecordedInteraction, req.Method, req.URL)
+	}
+
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+		StatusCode: interaction.Response.Status,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header(interaction.Response.Headers).Clone(),
+		Body:       io.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+		Request:    req,
+	}
+	if resp.Header == nil {
+		resp.Header = http.Header{}
+	}
+
+	if interaction.Response.Error != "" {
+		return resp, &github.ErrorResponse{Response: resp, Message: interaction.Response.Error}
+	}
+	return resp, nil
+}
+
+func (c *Cassette) match(method, reqURL, body string) *Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var last *Interaction
+	for _, i := range c.Interactions {
+		if i.Request.Method != method || i.Request.URL != reqURL || !sameBody(i.Request.Body, body) {
+			continue
+		}
+		if !c.replayed[i] {
+			if c.replayed == nil {
+				c.replayed = make(map[*Interaction]bool)
+			}
+			c.replayed[i] = true
+			return i
+		}
+		last = i
+	}
+	return last
+}
+
+// sameBody compares request bodies, ignoring the formatting of JSON bodies
+// since providers encode them differently
+func sameBody(recorded, body string) bool {
+	if recorded == body {
+		return true
+	}
+
+	var a, b any
+	if json.Unmarshal([]byte(recorded), &a) != nil || json.Unmarshal([]byte(body), &b) != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// readRequestBody reads the body of the request, leaving it in place
+// so that the request can still be sent
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mock_v1 "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	provider := mock_v1.NewMockREST(ctrl)
+	provider.EXPECT().GetBaseURL().Return("https://api.example.com/")
+	provider.EXPECT().NewRequest(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(method, url string, body any) (*http.Request, error) {
+			var r io.Reader
+			if body != nil {
+				r = strings.NewReader(`{"name":"main"}` + "\n")
+			}
+			return http.NewRequest(method, "https://api.example.com/"+url, r)
+		}).Times(3)
+	provider.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *http.Request) (*http.Response, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"path":"` + req.URL.Path + `"}`)),
+				Request:    req,
+			}
+			if strings.HasSuffix(req.URL.Path, "/missing") {
+				resp.StatusCode = http.StatusNotFound
+				return resp, &github.ErrorResponse{Response: resp, Message: "Not Found"}
+			}
+			return resp, nil
+		}).Times(3)
+
+	cassette := NewCassette()
+	recorder := NewTestKit(WithRecorder(provider, cassette))
+	ctx := context.Background()
+
+	record := func(method, url string, body any) {
+		req, err := recorder.NewRequest(method, url, body)
+		require.NoError(t, err)
+		_, _ = recorder.Do(ctx, req)
+	}
+	record(http.MethodGet, "repos/a", nil)
+	record(http.MethodPost, "repos/b", map[string]any{"name": "main"})
+	record(http.MethodGet, "repos/missing", nil)
+
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	require.NoError(t, cassette.Save(path))
+	loaded, err := LoadCassette(path)
+	require.NoError(t, err)
+	require.Len(t, loaded.Interactions, 3)
+
+	replayer := NewTestKit(WithCassette(loaded))
+	assert.Equal(t, "https://api.example.com/", replayer.GetBaseURL())
+
+	replay := func(method, url string, body any) (*http.Response, string, error) {
+		req, err := replayer.NewRequest(method, url, body)
+		require.NoError(t, err)
+		resp, err := replayer.Do(ctx, req)
+		if resp == nil {
+			return nil, "", err
+		}
+		out, readErr := io.ReadAll(resp.Body)
+		require.NoError(t, readErr)
+		return resp, string(out), err
+	}
+
+	resp, body, err := replay(http.MethodGet, "repos/a", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `{"path":"/repos/a"}`, body)
+
+	// the body is matched regardless of how it is encoded
+	_, body, err = replay(http.MethodPost, "repos/b", []byte(`{ "name": "main" }`))
+	require.NoError(t, err)
+	assert.Equal(t, `{"path":"/repos/b"}`, body)
+
+	// errors are replayed the way the GitHub client reports them
+	resp, _, err = replay(http.MethodGet, "repos/missing", nil)
+	var ghErr *github.ErrorResponse
+	require.True(t, errors.As(err, &ghErr))
+	assert.Equal(t, "Not Found", ghErr.Message)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	_, _, err = replay(http.MethodPost, "repos/b", map[string]any{"name": "other"})
+	assert.ErrorIs(t, err, ErrNoRecordedInteraction)
+}
+
+func TestCassetteReplayOrder(t *testing.T) {
+	t.Parallel()
+
+	interaction := func(body string) *Interaction {
+		return &Interaction{
+			Request:  CassetteRequest{Method: http.MethodGet, URL: "https://api.example.com/poll"},
+			Response: CassetteResponse{Status: http.StatusOK, Body: body},
+		}
+	}
+	tk := NewTestKit(WithCassette(&Cassette{
+		BaseURL:      "https://api.example.com/",
+		Interactions: []*Interaction{interaction("first"), interaction("second")},
+	}))
+
+	var bodies []string
+	for range 3 {
+		req, err := tk.NewRequest(http.MethodGet, "/poll", nil)
+		require.NoError(t, err)
+		resp, err := tk.Do(context.Background(), req)
+		require.NoError(t, err)
+		out, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(out))
+	}
+
+	// interactions are replayed in order, then the last one is repeated
+	assert.Equal(t, []string{"first", "second", "second"}, bodies)
+}
@@ -14,12 +14,22 @@ import (
 )
 
 // GetBaseURL implements the REST interface.
-func (*TestKit) GetBaseURL() string {
+func (tk *TestKit) GetBaseURL() string {
+	if tk.cassette != nil {
+		return tk.cassette.BaseURL
+	}
 	return ""
 }
 
 // NewRequest implements the REST interface.
-func (*TestKit) NewRequest(method, url string, body any) (*http.Request, error) {
+func (tk *TestKit) NewRequest(method, url string, body any) (*http.Request, error) {
+	if tk.recordTo != nil {
+		return tk.recordTo.NewRequest(method, url, body)
+	}
+	if tk.cassette != nil {
+		return tk.cassette.newRequest(method, url, body)
+	}
+
 	var r io.Reader
 	if body != nil {
 		r = bytes.NewReader(body.([]byte))
@@ -35,6 +45,13 @@ func (tk *TestKit) Do(ctx context.Context, req *http.Request) (*http.Response, e
 		Str("url", req.URL.String()).
 		Msg("HTTP request")
 
+	if tk.recordTo != nil {
+		return tk.record(req)
+	}
+	if tk.cassette != nil {
+		return tk.replay(req)
+	}
+
 	h := func(w http.ResponseWriter, _ *http.Request) {
 		for k, v := range tk.httpHeaders {
 			w.Header().Set(k, v)